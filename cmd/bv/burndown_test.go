@@ -94,3 +94,26 @@ func TestCalculateBurndownAt_NoProgressSetsOnTrackFalse(t *testing.T) {
 		t.Fatalf("OnTrack=true; want false")
 	}
 }
+
+func TestCalculateBurndownAt_Estimates(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hours := func(h int) *int { m := h * 60; return &m }
+	issues := []model.Issue{
+		{ID: "A", Title: "Done", Status: model.StatusClosed, IssueType: model.TypeTask, EstimatedMinutes: hours(4)},
+		{ID: "B", Title: "Remaining", Status: model.StatusOpen, IssueType: model.TypeTask, EstimatedMinutes: hours(6)},
+	}
+	sprint := &model.Sprint{ID: "sprint-1", StartDate: start, EndDate: start.AddDate(0, 0, 4), BeadIDs: []string{"A", "B"}}
+
+	out := calculateBurndownAt(sprint, issues, start.AddDate(0, 0, 1))
+	if out.Estimates == nil {
+		t.Fatal("Estimates is nil; want hour totals")
+	}
+	if out.Estimates.Unit != "hours" || out.Estimates.Completed != 4 || out.Estimates.Remaining != 6 {
+		t.Fatalf("Estimates=%+v; want 4h done, 6h remaining", *out.Estimates)
+	}
+
+	issues[0].EstimatedMinutes, issues[1].EstimatedMinutes = nil, nil
+	if out := calculateBurndownAt(sprint, issues, start.AddDate(0, 0, 1)); out.Estimates != nil {
+		t.Fatalf("Estimates=%+v; want nil without estimates", *out.Estimates)
+	}
+}
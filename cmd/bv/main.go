@@ -8,17 +8,25 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"golang.org/x/term"
 
+	"github.com/Dicklesworthstone/beads_viewer/internal/mcp"
+	"github.com/Dicklesworthstone/beads_viewer/internal/pages"
+	"github.com/Dicklesworthstone/beads_viewer/internal/web"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
@@ -27,6 +35,10 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/notify"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/publish"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
@@ -45,7 +57,7 @@ func main() {
 	checkUpdateFlag := flag.Bool("check-update", false, "Check if a new version is available")
 	rollbackFlag := flag.Bool("rollback", false, "Rollback to the previous version (from backup)")
 	yesFlag := flag.Bool("yes", false, "Skip confirmation prompts (use with --update)")
-	exportFile := flag.String("export-md", "", "Export issues to a Markdown file (e.g., report.md)")
+	exportFile := flag.String("export-md", "", "Export issues to a Markdown file (e.g., report.md; .html writes HTML; layout from .bv/templates/)")
 	robotHelp := flag.Bool("robot-help", false, "Show AI agent help")
 	robotInsights := flag.Bool("robot-insights", false, "Output graph analysis and insights as JSON for AI agents")
 	robotPlan := flag.Bool("robot-plan", false, "Output dependency-respecting execution plan as JSON for AI agents")
@@ -53,6 +65,7 @@ func main() {
 	robotTriage := flag.Bool("robot-triage", false, "Output unified triage as JSON (the mega-command for AI agents)")
 	robotTriageByTrack := flag.Bool("robot-triage-by-track", false, "Group triage recommendations by execution track (bv-87)")
 	robotTriageByLabel := flag.Bool("robot-triage-by-label", false, "Group triage recommendations by label (bv-87)")
+	triageProfileFlag := flag.String("triage-profile", "", "Triage weights profile from .bv/triage.yaml (default: the file's profile, or built-in weights)")
 	robotNext := flag.Bool("robot-next", false, "Output only the top pick recommendation as JSON (minimal triage)")
 	robotDiff := flag.Bool("robot-diff", false, "Output diff as JSON (use with --diff-since)")
 	robotRecipes := flag.Bool("robot-recipes", false, "Output available recipes as JSON for AI agents")
@@ -60,6 +73,12 @@ func main() {
 	robotLabelFlow := flag.Bool("robot-label-flow", false, "Output cross-label dependency flow as JSON for AI agents")
 	robotLabelAttention := flag.Bool("robot-label-attention", false, "Output attention-ranked labels as JSON for AI agents")
 	attentionLimit := flag.Int("attention-limit", 5, "Limit number of labels in --robot-label-attention output")
+	robotFocusRotation := flag.Bool("robot-focus-rotation", false, "Output this week's focus labels and starting issues as JSON (rotates weekly)")
+	focusNote := flag.String("focus-note", "", "Write this week's focus rotation as a Markdown planning note to file")
+	focusLabels := flag.Int("focus-labels", 3, "Number of labels in the weekly focus rotation")
+	compareRecipe := flag.String("compare-recipe", "", "Compare the issues selected by a recipe or query with --compare-with")
+	compareWith := flag.String("compare-with", "", "Second recipe or query for --compare-recipe")
+	robotCompare := flag.Bool("robot-compare", false, "Output the recipe comparison as JSON (use with --compare-recipe)")
 	robotAlerts := flag.Bool("robot-alerts", false, "Output alerts (drift + proactive) as JSON for AI agents")
 	// Smart suggestions (bv-180)
 	robotSuggest := flag.Bool("robot-suggest", false, "Output smart suggestions (duplicates, dependencies, labels, cycles) as JSON")
@@ -67,17 +86,18 @@ func main() {
 	suggestConfidence := flag.Float64("suggest-confidence", 0.0, "Minimum confidence for suggestions (0.0-1.0)")
 	suggestBead := flag.String("suggest-bead", "", "Filter suggestions for specific bead ID")
 	// Graph export (bv-136)
-	robotGraph := flag.Bool("robot-graph", false, "Output dependency graph as JSON/DOT/Mermaid for AI agents")
-	graphFormat := flag.String("graph-format", "json", "Graph output format: json, dot, mermaid")
+	robotGraph := flag.Bool("robot-graph", false, "Output dependency graph as JSON/DOT/Mermaid/PlantUML/D2 for AI agents")
+	graphFormat := flag.String("graph-format", "json", "Graph output format: json, dot, mermaid, plantuml, d2")
 	graphRoot := flag.String("graph-root", "", "Subgraph from specific root issue ID")
 	graphDepth := flag.Int("graph-depth", 0, "Max depth for subgraph (0 = unlimited)")
+	graphEpic := flag.String("graph-epic", "", "Subgraph of an epic and its descendants")
 	// Robot output filters (bv-84)
 	robotMinConf := flag.Float64("robot-min-confidence", 0.0, "Filter robot outputs by minimum confidence (0.0-1.0)")
 	robotMaxResults := flag.Int("robot-max-results", 0, "Limit robot output count (0 = use defaults)")
 	robotByLabel := flag.String("robot-by-label", "", "Filter robot outputs by label (exact match)")
 	robotByAssignee := flag.String("robot-by-assignee", "", "Filter robot outputs by assignee (exact match)")
 	// Label subgraph scoping (bv-122)
-	labelScope := flag.String("label", "", "Scope analysis to label's subgraph (affects --robot-insights, --robot-plan, --robot-priority)")
+	labelScope := flag.String("label", "", "Scope analysis to label's subgraph (affects --robot-insights, --robot-plan, --robot-priority, --bundle)")
 	alertSeverity := flag.String("severity", "", "Filter robot alerts by severity (info|warning|critical)")
 	alertType := flag.String("alert-type", "", "Filter robot alerts by alert type (e.g., stale_issue)")
 	alertLabel := flag.String("alert-label", "", "Filter robot alerts by label match")
@@ -86,16 +106,50 @@ func main() {
 	semanticQuery := flag.String("search", "", "Semantic search query (vector-based; builds/updates index on first run)")
 	robotSearch := flag.Bool("robot-search", false, "Output semantic search results as JSON for AI agents (use with --search)")
 	searchLimit := flag.Int("search-limit", 10, "Max results for --search/--robot-search")
+	searchMode := flag.String("search-mode", "hybrid", "Ranking for --search: hybrid (BM25 keywords fused with semantic), semantic, or text (keyword match over all fields; field:value terms imply text)")
+	duplicatesReport := flag.Bool("duplicates", false, "List pairs of open issues that are probably duplicates (semantic similarity)")
+	robotDuplicates := flag.Bool("robot-duplicates", false, "Output possible duplicate pairs as JSON for AI agents")
+	duplicatesThreshold := flag.Float64("duplicates-threshold", search.DefaultClusterThreshold, "Minimum similarity (0-1) for --duplicates/--robot-duplicates")
 	diffSince := flag.String("diff-since", "", "Show changes since historical point (commit SHA, branch, tag, or date)")
 	asOf := flag.String("as-of", "", "View state at point in time (commit SHA, branch, tag, or date)")
 	forceFullAnalysis := flag.Bool("force-full-analysis", false, "Compute all metrics regardless of graph size (may be slow for large graphs)")
 	profileStartup := flag.Bool("profile-startup", false, "Output detailed startup timing profile for diagnostics")
 	profileJSON := flag.Bool("profile-json", false, "Output profile in JSON format (use with --profile-startup)")
 	noHooks := flag.Bool("no-hooks", false, "Skip running hooks during export")
+	githubPush := flag.Bool("github-push", false, "Create/update GitHub issues from beads via gh (one-way; config in .bv/github.yaml)")
+	githubDryRun := flag.Bool("github-dry-run", false, "Preview what --github-push would create or update without calling GitHub write APIs")
+	githubRepo := flag.String("github-repo", "", "Target repository owner/name for --github-push (overrides .bv/github.yaml)")
+	publishTo := flag.String("publish", "", "Publish the issue report to confluence, notion, or both (comma-separated; config in .bv/publish.yaml)")
+	publishTitle := flag.String("publish-title", "", "Page title for --publish (overrides .bv/publish.yaml)")
+	githubIDs := flag.String("github-ids", "", "Comma-separated bead IDs to push (default: all loaded issues, after --recipe/--repo filters)")
+	replacePattern := flag.String("replace", "", "Regex to search-and-replace across issue text (previews a diff; add --replace-apply to write)")
+	replaceWith := flag.String("replace-with", "", "Replacement text for --replace ($1 refers to capture groups)")
+	replaceFields := flag.String("replace-fields", "", "Comma-separated fields for --replace (title,description,design,acceptance_criteria,notes,labels; default: all but labels)")
+	replaceApply := flag.Bool("replace-apply", false, "Write the --replace changes to the beads file instead of previewing them")
+	mergePair := flag.String("merge", "", "Merge two issues: <keep-id>,<superseded-id> (previews the result; add --merge-apply to write)")
+	mergeApply := flag.Bool("merge-apply", false, "Write the --merge result to the beads file instead of previewing it")
+	emailDigest := flag.String("email-digest", "", "Write an HTML email digest of recent activity to file (config in .bv/digest.yaml)")
+	digestSend := flag.Bool("digest-send", false, "Send the email digest via the SMTP settings in .bv/digest.yaml")
+	digestDays := flag.Int("digest-days", 0, "Lookback window in days for the email digest (default: digest.yaml days, or 7)")
+	sprintRetro := flag.String("sprint-retro", "", "Write a retrospective of a completed sprint to file (.md, or .html for HTML)")
+	retroSprint := flag.String("retro-sprint", "", "Sprint ID for --sprint-retro (default: the most recently ended sprint)")
+	exportFeed := flag.String("export-feed", "", "Write an Atom feed of issue changes and alerts to file (e.g., feed.xml)")
+	feedURL := flag.String("feed-url", "", "Public URL the feed/Pages site is served from, used for feed links")
+	stableExport := flag.Bool("stable", false, "Deterministic exports: stable ordering, no generation timestamps, dateless TUI export filenames")
 	workspaceConfig := flag.String("workspace", "", "Load issues from workspace config file (.bv/workspace.yaml)")
 	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-' or 'api')")
+	noWorkspace := flag.Bool("no-workspace", false, "Don't load a .bv/workspace.yaml found in or above the current directory")
+	includeHidden := flag.String("include-hidden", "", "Also load workspace repos marked hidden: comma-separated names/prefixes, or 'all'")
+	fromGitHub := flag.String("from-github", "", "Load issues from a GitHub repository (owner/repo) instead of .beads; token from GITHUB_TOKEN or GH_TOKEN")
+	sqliteStore := flag.Bool("sqlite-store", false, "Mirror the beads file into .bv/issues.db and reload incrementally (for very large repos)")
+	fromJira := flag.String("from-jira", "", "Load issues from a Jira export (JSON or .csv) instead of .beads")
+	jiraMapping := flag.String("jira-mapping", "", "Status/priority/type/link mapping for --from-jira (default: .bv/jira.yaml)")
 	saveBaseline := flag.String("save-baseline", "", "Save current metrics as baseline with optional description")
 	baselineInfo := flag.Bool("baseline-info", false, "Show information about the current baseline")
+	baselineName := flag.String("baseline", "", "Baseline for --check-drift/--baseline-info: active (default), latest, a snapshot date, or a file")
+	listBaselines := flag.Bool("list-baselines", false, "List the active baseline and dated snapshots in .bv/baselines")
+	useBaseline := flag.String("use-baseline", "", "Make a stored snapshot (date, latest, or file) the active baseline")
+	rotateBaselines := flag.Int("rotate-baselines", -1, "Delete all but the newest N dated baseline snapshots")
 	checkDrift := flag.Bool("check-drift", false, "Check for drift from baseline (exit codes: 0=OK, 1=critical, 2=warning)")
 	robotDriftCheck := flag.Bool("robot-drift", false, "Output drift check as JSON (use with --check-drift)")
 	robotHistory := flag.Bool("robot-history", false, "Output bead-to-commit correlations as JSON")
@@ -126,6 +180,14 @@ func main() {
 	feedbackIgnore := flag.String("feedback-ignore", "", "Record ignore feedback for issue ID (tunes recommendation weights)")
 	feedbackReset := flag.Bool("feedback-reset", false, "Reset all feedback data to defaults")
 	feedbackShow := flag.Bool("feedback-show", false, "Show current feedback status and weight adjustments")
+	// Work session flags
+	workStart := flag.String("work-start", "", "Start a work session on issue ID (stops any running session)")
+	workStop := flag.Bool("work-stop", false, "Stop the running work session")
+	robotEstimates := flag.Bool("robot-estimates", false, "Output estimate-vs-actual report from logged work sessions as JSON")
+	exportTimeLog := flag.String("export-timelog", "", "Export logged work time to CSV or Markdown by file extension (e.g., hours.csv)")
+	timeLogGroup := flag.String("timelog-group", "issue", "Group time report by: issue, assignee, or week (use with --export-timelog)")
+	timeLogSince := flag.String("timelog-since", "", "Only count time logged after this date, e.g. '30d' or '2024-01-01' (use with --export-timelog)")
+	exportQuality := flag.String("export-quality", "", "Export a text quality report (empty descriptions, TODO/TBD markers, unsplit epics) to CSV or Markdown by file extension")
 	// Priority brief export (bv-96)
 	priorityBrief := flag.String("priority-brief", "", "Export priority brief to Markdown file (e.g., brief.md)")
 	// Agent brief bundle (bv-131)
@@ -137,8 +199,59 @@ func main() {
 	pagesIncludeHistory := flag.Bool("pages-include-history", false, "Include git history for time-travel animation (bv-z38b)")
 	previewPages := flag.String("preview-pages", "", "Preview existing static site bundle")
 	pagesWizard := flag.Bool("pages", false, "Launch interactive Pages deployment wizard")
+	checkKeys := flag.Bool("check-keys", false, "Show the active TUI key bindings (.bv/keys.yaml) and report conflicts")
+	validateFile := flag.Bool("validate", false, "Check every beads JSONL record against the issue schema; exits 1 on errors")
+	robotValidate := flag.Bool("robot-validate", false, "Output --validate results as JSON for AI agents (same exit codes)")
+	serveAddr := flag.String("serve-addr", "", "Address for 'bv serve' to listen on, e.g. :8080 (default: first free localhost port from 9000)")
+	bundleOut := flag.String("bundle", "", "Write a read-only share bundle (.tar.gz: issues, analysis, static viewer); scope with --recipe/--label (also 'bv bundle <file>')")
+	announce := flag.Bool("announce", false, "Show a plain-text line describing keyboard focus and the selected issue, for screen readers (F3 toggles; also BV_ANNOUNCE=1)")
+	readOnly := flag.Bool("read-only", false, "Refuse everything that writes or edits: editor launch, exports, snapshots, comments, saved state (for shared terminals and demos; also BV_READ_ONLY=1)")
 	flag.Parse()
 
+	// "bv -" reads issues from stdin, e.g. `bd list --json | bv -`. Flags
+	// may also follow the dash.
+	fromStdin := false
+	if args := flag.Args(); len(args) > 0 && args[0] == "-" {
+		fromStdin = true
+		flag.CommandLine.Parse(args[1:])
+	}
+
+	// "bv mcp" serves issues and analysis to coding agents over the Model
+	// Context Protocol on stdin/stdout. Loading flags may follow.
+	mcpMode := false
+	if args := flag.Args(); !fromStdin && len(args) > 0 && args[0] == "mcp" {
+		mcpMode = true
+		flag.CommandLine.Parse(args[1:])
+	}
+
+	// "bv serve" serves a read-only web dashboard (board and graph) for
+	// people who don't use the terminal. Loading flags may follow.
+	serveMode := false
+	if args := flag.Args(); !fromStdin && !mcpMode && len(args) > 0 && args[0] == "serve" {
+		serveMode = true
+		flag.CommandLine.Parse(args[1:])
+	}
+
+	// "bv bundle <file.tar.gz>" writes the read-only share bundle, the same
+	// as --bundle. Scoping flags may go before or after the file name.
+	if args := flag.Args(); !fromStdin && !mcpMode && !serveMode && len(args) > 0 && args[0] == "bundle" {
+		flag.CommandLine.Parse(args[1:])
+		if rest := flag.Args(); len(rest) > 0 {
+			*bundleOut = rest[0]
+			flag.CommandLine.Parse(rest[1:])
+		}
+		if *bundleOut == "" {
+			fmt.Fprintln(os.Stderr, "Usage: bv bundle <file.tar.gz> [--recipe name] [--label name] [--pages-include-closed]")
+			os.Exit(2)
+		}
+	}
+
+	// Read-only mode is process-wide: every file write and side-effecting
+	// command checks it, so no feature needs its own switch
+	if *readOnly || os.Getenv("BV_READ_ONLY") == "1" {
+		readonly.Set(true)
+	}
+
 	// Ensure static export flags are retained even when build tags strip features in some environments.
 	_ = exportPages
 	_ = pagesTitle
@@ -165,7 +278,7 @@ func main() {
 	}
 
 	if *help {
-		fmt.Println("Usage: bv [options]")
+		fmt.Println("Usage: bv [options] [-]")
 		fmt.Println("\nA TUI viewer for beads issue tracker.")
 		flag.PrintDefaults()
 		os.Exit(0)
@@ -216,17 +329,31 @@ func main() {
 		fmt.Println("      - blockers_to_clear: Items that unblock the most downstream work")
 		fmt.Println("      - project_health: Counts, graph metrics, overall status")
 		fmt.Println("      - commands: Copy-paste commands for common next steps")
+		fmt.Println("      Weights come from .bv/triage.yaml: unblock, priority, staleness, quick_win,")
+		fmt.Println("      quick_win_max_depth and label_boosts, plus named profiles overriding them.")
+		fmt.Println("      --triage-profile <name> picks a profile; meta.profile reports the one used.")
 		fmt.Println("")
 		fmt.Println("  --robot-next")
 		fmt.Println("      Minimal triage: returns only the single top recommendation.")
 		fmt.Println("      Output includes: id, title, score, reasons, claim_command, show_command")
 		fmt.Println("      Use when you just need to know \"what should I work on next?\"")
 		fmt.Println("")
-		fmt.Println("  --search \"query\" [--robot-search]")
-		fmt.Println("      Semantic vector search over issue titles/descriptions.")
+		fmt.Println("  --search \"query\" [--robot-search] [--search-mode hybrid|semantic|text]")
+		fmt.Println("      Search over all issue text, including design, acceptance criteria,")
+		fmt.Println("      notes, labels and comments. The default hybrid mode fuses BM25 keyword")
+		fmt.Println("      ranking with semantic vector ranking, so exact matches stay on top;")
+		fmt.Println("      --search-mode semantic uses the vectors alone.")
 		fmt.Println("      Builds/updates a local on-disk vector index on first run.")
+		fmt.Println("      --search-mode text ranks keyword matches instead, weighting title and label")
+		fmt.Println("      hits above comments. Terms like comment:regression or notes:\"flaky test\"")
+		fmt.Println("      search one field (title, desc, design, ac, notes, label, comment).")
 		fmt.Println("      Use --robot-search to emit JSON for automation.")
 		fmt.Println("")
+		fmt.Println("  --duplicates | --robot-duplicates [--duplicates-threshold 0.8]")
+		fmt.Println("      Pairs of open issues whose embeddings are nearly identical, most similar")
+		fmt.Println("      first. Uses the same on-disk index as --search. Merge a pair with")
+		fmt.Println("      --merge <keep>,<other>.")
+		fmt.Println("")
 		fmt.Println("  --emit-script [--script-limit=N]")
 		fmt.Println("      Emits a shell script for top-N recommendations (default: 5).")
 		fmt.Println("      Includes hash/config header for deterministic ordering.")
@@ -263,6 +390,33 @@ func main() {
 		fmt.Println("      Returns the full sprint object with all fields.")
 		fmt.Println("      Example: bv --robot-sprint-show sprint-1")
 		fmt.Println("")
+		fmt.Println("  --work-start <id> / --work-stop")
+		fmt.Println("      Start or stop a focused work session (also 'W' in the TUI).")
+		fmt.Println("      Sessions are appended to the .beads/timelog.jsonl sidecar; starting a")
+		fmt.Println("      new session stops the running one.")
+		fmt.Println("      Example: bv --work-start bd-42")
+		fmt.Println("")
+		fmt.Println("  --robot-estimates")
+		fmt.Println("      Outputs estimate-vs-actual report from logged work sessions as JSON.")
+		fmt.Println("      Key fields:")
+		fmt.Println("      - items: issue_id, estimated_minutes, actual_minutes, ratio (actual/estimate)")
+		fmt.Println("      - summary: tracked_issues, total_actual_minutes, accuracy_ratio")
+		fmt.Println("      Example: bv --robot-estimates | jq '.items[] | select(.ratio > 1.5)'")
+		fmt.Println("")
+		fmt.Println("  --export-timelog <file> [--timelog-group issue|assignee|week] [--timelog-since 30d]")
+		fmt.Println("      Exports logged work time as CSV (.csv) or Markdown (any other extension).")
+		fmt.Println("      Columns: group, issues, sessions, minutes, hours (decimal); issue grouping")
+		fmt.Println("      adds title and assignee. Week grouping splits sessions at Monday boundaries.")
+		fmt.Println("      Example: bv --export-timelog hours.csv --timelog-group week --timelog-since 2024-06-01")
+		fmt.Println("")
+		fmt.Println("  --export-quality <file>")
+		fmt.Println("      Exports a text quality report over open issues as CSV (.csv) or Markdown.")
+		fmt.Println("      Flags empty descriptions, TBD/TBC/TODO/FIXME left in acceptance criteria,")
+		fmt.Println("      and epics with no child issues whose description exceeds epic_split_words")
+		fmt.Println("      (.bv/drift.yaml, default 300). With spell_check: true it also flags common")
+		fmt.Println("      misspellings and vague wording. The same findings appear as info alerts.")
+		fmt.Println("      Example: bv --export-quality quality.md")
+		fmt.Println("")
 		fmt.Println("  --robot-burndown <id|current>")
 		fmt.Println("      Outputs burndown data for a sprint as JSON.")
 		fmt.Println("      Use 'current' to get the active sprint, or specify sprint ID.")
@@ -320,6 +474,9 @@ func main() {
 		fmt.Println("")
 		fmt.Println("  --export-md <file>")
 		fmt.Println("      Generates a readable status report with Mermaid.js visualizations.")
+		fmt.Println("      A file ending in .html or .htm gets a self-contained HTML page instead.")
+		fmt.Println("      .bv/templates/report.md.tmpl or report.html.tmpl (Go templates) replace")
+		fmt.Println("      the built-in layout; other *.md.tmpl / *.html.tmpl files there are partials.")
 		fmt.Println("      Runs pre-export and post-export hooks if configured in .bv/hooks.yaml")
 		fmt.Println("")
 		fmt.Println("  --no-hooks")
@@ -332,6 +489,17 @@ func main() {
 		fmt.Println("      Environment variables: BV_EXPORT_PATH, BV_EXPORT_FORMAT,")
 		fmt.Println("        BV_ISSUE_COUNT, BV_TIMESTAMP")
 		fmt.Println("")
+		fmt.Println("  --read-only")
+		fmt.Println("      Guard mode for shared terminals and demos: nothing is written or launched.")
+		fmt.Println("      Exports, comments, work sessions, snapshots, saved TUI state, the editor,")
+		fmt.Println("      hooks, GitHub pushes and webhook alerts are all refused. BV_READ_ONLY=1")
+		fmt.Println("      does the same. Example: BV_READ_ONLY=1 bv")
+		fmt.Println("")
+		fmt.Println("  --announce")
+		fmt.Println("      Adds a plain-text line under the footer saying where keyboard focus is,")
+		fmt.Println("      what is selected and how to get back, for screen readers and braille")
+		fmt.Println("      displays. F3 toggles it in the TUI. BV_ANNOUNCE=1 does the same.")
+		fmt.Println("")
 		fmt.Println("  --diff-since <commit|date>")
 		fmt.Println("      Shows changes since a historical point.")
 		fmt.Println("      Accepts: SHA, branch name, tag, HEAD~N, or date (YYYY-MM-DD)")
@@ -375,22 +543,32 @@ func main() {
 		fmt.Println("      Key fields: rank, label, attention_score, normalized_score, reason, blocked_count, stale_count.")
 		fmt.Println("      Use to identify which labels need the most focus based on centrality and health factors.")
 		fmt.Println("")
+		fmt.Println("  --robot-focus-rotation [--focus-labels=N]")
+		fmt.Println("      Outputs this week's focus rotation as JSON: the N (default 3) labels most")
+		fmt.Println("      needing attention, each with the issues to start with. Suggestions are")
+		fmt.Println("      remembered in .bv/focus_rotation.json: a week keeps its labels, and labels")
+		fmt.Println("      focused in the previous two weeks rotate out unless nothing else needs work.")
+		fmt.Println("      Key fields: week, picks[{label, reason, last_focused, start_with[]}], skipped.")
+		fmt.Println("")
 		fmt.Println("  --robot-alerts")
 		fmt.Println("      Outputs drift + proactive alerts as JSON (staleness, cascades, density, cycles).")
 		fmt.Println("      Filters: --severity=<info|warning|critical>, --alert-type=<type>, --alert-label=<label>")
 		fmt.Println("      Fields: type, severity, message, issue_id, label, detected_at, details[].")
 		fmt.Println("")
-		fmt.Println("  --robot-graph [--graph-format=json|dot|mermaid] [--graph-root=ID] [--graph-depth=N]")
+		fmt.Println("  --robot-graph [--graph-format=json|dot|mermaid|plantuml|d2] [--graph-root=ID] [--graph-depth=N] [--graph-epic=ID]")
 		fmt.Println("      Outputs dependency graph in specified format (default: JSON adjacency).")
 		fmt.Println("      Formats:")
 		fmt.Println("        - json: Adjacency list with nodes[], edges[], metadata")
 		fmt.Println("        - dot: Graphviz DOT format (render with: dot -Tpng file.dot -o graph.png)")
 		fmt.Println("        - mermaid: Mermaid diagram format (paste into GitHub/markdown)")
+		fmt.Println("        - plantuml: PlantUML diagram (render with: plantuml -tsvg file.puml)")
+		fmt.Println("        - d2: D2 diagram (render with: d2 file.d2 graph.svg)")
 		fmt.Println("      Options:")
 		fmt.Println("        --label LABEL: Filter to issues with specific label")
 		fmt.Println("        --graph-root ID: Extract subgraph starting from root issue")
 		fmt.Println("        --graph-depth N: Limit subgraph depth (0 = unlimited)")
-		fmt.Println("      Fields: format, graph (string for dot/mermaid), nodes, edges, filters_applied, explanation")
+		fmt.Println("        --graph-epic ID: Limit to an epic and its descendants")
+		fmt.Println("      Fields: format, graph (string for dot/mermaid/plantuml/d2), nodes, edges, filters_applied, explanation")
 		fmt.Println("      Example: bv --robot-graph --graph-format=dot --label=api > api-deps.dot")
 		fmt.Println("")
 		fmt.Println("  --robot-insights")
@@ -417,6 +595,7 @@ func main() {
 		fmt.Println("  --robot-plan")
 		fmt.Println("      Execution tracks grouped for parallel work. Includes data_hash, analysis_config, status.")
 		fmt.Println("      plan.tracks[].items[].unblocks shows what completes next; summary.highest_impact surfaces best unblocker.")
+		fmt.Println("      waves.waves[] layers all open work into parallel waves, with durations from the recent close rate.")
 		fmt.Println("")
 		fmt.Println("  --robot-priority")
 		fmt.Println("      Priority recommendations with explanations. Includes data_hash, analysis_config, status.")
@@ -448,6 +627,7 @@ func main() {
 		fmt.Println("  --profile-startup")
 		fmt.Println("      Outputs detailed startup timing profile for diagnostics.")
 		fmt.Println("      Shows Phase 1 (blocking) and Phase 2 (async) breakdown.")
+		fmt.Println("      Lists TUI subsystems and which are deferred past the first frame.")
 		fmt.Println("      Provides recommendations based on timing analysis.")
 		fmt.Println("      Use with --profile-json for machine-readable output.")
 		fmt.Println("")
@@ -463,9 +643,66 @@ func main() {
 		fmt.Println("      Matches ID prefixes like 'api-', 'web-', or partial 'api'.")
 		fmt.Println("      Example: bv --workspace .bv/workspace.yaml --repo api")
 		fmt.Println("")
+		fmt.Println("  --include-hidden NAMES")
+		fmt.Println("      Also load workspace repos marked 'hidden: true' (skipped by default).")
+		fmt.Println("      Comma-separated repo names or prefixes, or 'all'.")
+		fmt.Println("      Repos marked 'read_only: true' load normally; the TUI disables work")
+		fmt.Println("      sessions and editing for their issues.")
+		fmt.Println("      Example: bv --workspace .bv/workspace.yaml --include-hidden personal")
+		fmt.Println("")
+		fmt.Println("  --from-github OWNER/REPO")
+		fmt.Println("      Load issues from GitHub Issues instead of .beads (for repos without beads).")
+		fmt.Println("      IDs become <repo>-<number>; labels map to type (bug, feature, epic, chore)")
+		fmt.Println("      and priority (P0-P4); '#N' references in bodies become related deps.")
+		fmt.Println("      Pull requests are skipped. Token from GITHUB_TOKEN or GH_TOKEN (optional")
+		fmt.Println("      for public repos). Works with the TUI and --robot-* outputs.")
+		fmt.Println("      Example: bv --from-github charmbracelet/bubbletea --robot-triage")
+		fmt.Println("")
+		fmt.Println("  --sqlite-store")
+		fmt.Println("      Mirror the beads JSONL into .bv/issues.db (SQLite). Each sync hashes")
+		fmt.Println("      every line but only decodes new or changed ones, and is skipped when")
+		fmt.Println("      the file's size and mtime are unchanged; live reload in the TUI merges")
+		fmt.Println("      just the changed issues. Useful for repos with tens of thousands of issues.")
+		fmt.Println("      Example: bv --sqlite-store")
+		fmt.Println("")
+		fmt.Println("  --from-jira FILE [--jira-mapping FILE]")
+		fmt.Println("      Load issues from a Jira export instead of .beads: the REST search JSON")
+		fmt.Println("      ({\"issues\": [...]}), a bare JSON array, or a CSV export (*.csv).")
+		fmt.Println("      Issue keys become IDs; 'Blocks' links become blocking deps, parents")
+		fmt.Println("      become parent-child deps, other links become related deps.")
+		fmt.Println("      Statuses, priorities, types and link types are mapped through")
+		fmt.Println("      .bv/jira.yaml (or --jira-mapping), merged over built-in defaults.")
+		fmt.Println("      Example: bv --from-jira ops-backlog.json --robot-insights")
+		fmt.Println("")
+		fmt.Println("  - (read issues from stdin)")
+		fmt.Println("      Load issues piped in on stdin instead of .beads: JSONL, or a single")
+		fmt.Println("      JSON array as printed by 'bd list --json'. Live reload is disabled and")
+		fmt.Println("      the TUI reads keys from the terminal. Flags may go before or after '-'.")
+		fmt.Println("      Example: bd list --json --status open | bv - --robot-triage")
+		fmt.Println("")
+		fmt.Println("  mcp")
+		fmt.Println("      Serve issues and analysis to coding agents over the Model Context")
+		fmt.Println("      Protocol (JSON-RPC on stdin/stdout). Tools: next_work, triage,")
+		fmt.Println("      list_issues, get_issue, graph_metrics, label_health. The beads file is")
+		fmt.Println("      re-read on each call; loading flags such as --workspace may follow.")
+		fmt.Println("      Example: claude mcp add bv -- bv mcp")
+		fmt.Println("")
+		fmt.Println("  serve")
+		fmt.Println("      Serve a read-only web dashboard with the kanban board and dependency")
+		fmt.Println("      graph, for people who don't use the terminal. The page is built into")
+		fmt.Println("      bv and follows edits to the beads file. Listens on localhost (first")
+		fmt.Println("      free port from 9000) unless --serve-addr is given.")
+		fmt.Println("      Example: bv serve --serve-addr :8080")
+		fmt.Println("")
+		fmt.Println("  bundle <file.tar.gz>")
+		fmt.Println("      Write a single compressed, read-only artifact for stakeholders without")
+		fmt.Println("      repo access (same as --bundle, see below). Scoping flags may follow.")
+		fmt.Println("      Example: bv bundle frontend.tar.gz -r high-impact --label frontend")
+		fmt.Println("")
 		fmt.Println("  --save-baseline \"description\"")
 		fmt.Println("      Save current metrics as a baseline snapshot.")
-		fmt.Println("      Stores graph stats, top metrics, and cycle info in .bv/baseline.json.")
+		fmt.Println("      Stores graph stats, top metrics, and cycle info in .bv/baseline.json,")
+		fmt.Println("      with a dated copy in .bv/baselines/.")
 		fmt.Println("      Use for drift detection: compare current state to saved baseline.")
 		fmt.Println("      Example: bv --save-baseline \"Before major refactor\"")
 		fmt.Println("")
@@ -473,13 +710,26 @@ func main() {
 		fmt.Println("      Show information about the saved baseline.")
 		fmt.Println("      Displays: creation date, git commit, graph stats, top metrics.")
 		fmt.Println("")
+		fmt.Println("  --list-baselines / --use-baseline <name> / --rotate-baselines N")
+		fmt.Println("      Every --save-baseline also keeps a dated copy in .bv/baselines/YYYYMMDD.json,")
+		fmt.Println("      and the TUI takes one automatically each day (daily_baselines and")
+		fmt.Println("      baseline_keep in .bv/drift.yaml; 30 are kept). List them, make one the")
+		fmt.Println("      active baseline, or prune to the newest N. --baseline <name> points")
+		fmt.Println("      --check-drift and --baseline-info at a snapshot without activating it;")
+		fmt.Println("      names are active, latest, a date (20250601 or 2025-06-01), or a file.")
+		fmt.Println("      In the TUI, Ctrl+B opens a picker comparing the current state to any of them.")
+		fmt.Println("      Example: bv --check-drift --baseline latest")
+		fmt.Println("")
 		fmt.Println("  --check-drift")
 		fmt.Println("      Check current metrics against saved baseline for drift.")
 		fmt.Println("      Exit codes for CI integration:")
 		fmt.Println("        0 = No critical or warning alerts (info-only OK)")
-		fmt.Println("        1 = Critical alerts (new cycles detected)")
+		fmt.Println("        1 = Critical alerts (new cycles, actionable collapse)")
 		fmt.Println("        2 = Warning alerts (blocked increase, density growth)")
 		fmt.Println("      Human-readable output by default, use --robot-drift for JSON.")
+		fmt.Println("      With a webhook in .bv/notify.yaml (webhook_url, or the URL in")
+		fmt.Println("      $BV_WEBHOOK_URL), new critical alerts are posted to Slack or Discord;")
+		fmt.Println("      each alert is posted once, at most every debounce_minutes (default 10).")
 		fmt.Println("")
 		fmt.Println("  --robot-drift")
 		fmt.Println("      Output drift check as JSON (use with --check-drift).")
@@ -508,6 +758,103 @@ func main() {
 		fmt.Println("      --pages-include-closed")
 		fmt.Println("          Include closed issues in export (default: open only)")
 		fmt.Println("")
+		fmt.Println("  --bundle <file.tar.gz>  (also: bv bundle <file.tar.gz>)")
+		fmt.Println("      Single compressed, read-only artifact for stakeholders without repo access:")
+		fmt.Println("      the --export-pages site (issues, precomputed analysis, HTML viewer) plus a")
+		fmt.Println("      README. Scope it with --recipe and --label; closed issues need")
+		fmt.Println("      --pages-include-closed. Dependencies on issues left out are dropped.")
+		fmt.Println("      Example: bv -r high-impact --label frontend --bundle frontend.tar.gz")
+		fmt.Println("")
+		fmt.Println("  --github-push [--github-dry-run] [--github-repo owner/name] [--github-ids a,b]")
+		fmt.Println("      One-way push of beads to GitHub issues via the gh CLI. Issues are matched")
+		fmt.Println("      to beads by a hidden body marker, so re-running updates instead of duplicating.")
+		fmt.Println("      Config (.bv/github.yaml): repo, project (Projects v2 number), project_owner,")
+		fmt.Println("      labels (rename map), priority_labels, type_labels, extra_labels, include_closed.")
+		fmt.Println("      --github-dry-run previews creates/updates (reads existing issues if gh is available).")
+		fmt.Println("      Example: bv -r actionable --github-push --github-dry-run")
+		fmt.Println("")
+		fmt.Println("  --publish confluence|notion[,...] [--publish-title <title>]")
+		fmt.Println("      Publish the --export-md report as a page, replacing the page with the same")
+		fmt.Println("      title. Confluence gets the HTML report in storage format; Notion gets the")
+		fmt.Println("      Markdown report as blocks in a database. Both honor .bv/templates/.")
+		fmt.Println("      Config (.bv/publish.yaml): title, confluence.{base_url, space, parent_id,")
+		fmt.Println("      username, token_env}, notion.{database_id, title_property, token_env}.")
+		fmt.Println("      Tokens default to $BV_CONFLUENCE_TOKEN and $BV_NOTION_TOKEN.")
+		fmt.Println("      Example: bv -r actionable --publish confluence,notion")
+		fmt.Println("")
+		fmt.Println("  --replace <regex> --replace-with <text> [--replace-fields f1,f2] [--replace-apply]")
+		fmt.Println("      Backlog-wide search-and-replace (e.g. a codename change). Prints a per-issue")
+		fmt.Println("      diff and writes nothing unless --replace-apply is given. Fields: title,")
+		fmt.Println("      description, design, acceptance_criteria, notes (default) and labels.")
+		fmt.Println("      Other fields are kept byte for byte; the write is atomic.")
+		fmt.Println("      Example: bv --replace '\\bFalcon\\b' --replace-with Osprey --replace-apply")
+		fmt.Println("")
+		fmt.Println("  --merge <keep-id>,<superseded-id> [--merge-apply]")
+		fmt.Println("      Fold a duplicate into the issue you keep: text fields are concatenated,")
+		fmt.Println("      labels and dependencies unioned, comments moved, the higher priority kept.")
+		fmt.Println("      The duplicate is closed, labeled 'superseded' and linked to the survivor;")
+		fmt.Println("      other issues' dependencies on it move to the survivor. Previews unless")
+		fmt.Println("      --merge-apply is given. In the TUI, mark two issues and press U.")
+		fmt.Println("      Example: bv --merge bv-12,bv-40 --merge-apply")
+		fmt.Println("")
+		fmt.Println("  --email-digest <file.html> [--digest-send] [--digest-days N]")
+		fmt.Println("      HTML email body summarizing the last N days: top alerts, new and closed")
+		fmt.Println("      issues, labels needing attention, and top triage picks.")
+		fmt.Println("      --digest-send mails it (with or without --email-digest) using .bv/digest.yaml:")
+		fmt.Println("      days, max_items, subject ('{project}' expands), smtp.host/port/username/from/to.")
+		fmt.Println("      The SMTP password is read from $BV_SMTP_PASSWORD (or smtp.password_env).")
+		fmt.Println("      Example: bv --email-digest digest.html --digest-days 14")
+		fmt.Println("")
+		fmt.Println("  --sprint-retro <file.md|file.html> [--retro-sprint <id>]")
+		fmt.Println("      Retrospective of a completed sprint (default: the one that ended last):")
+		fmt.Println("      planned vs delivered, scope added and removed after the start (from the")
+		fmt.Println("      git history of .beads/sprints.jsonl), the carry-over list, and velocity")
+		fmt.Println("      against up to four earlier sprints. HTML for .html/.htm, else Markdown.")
+		fmt.Println("      Example: bv --sprint-retro retro.md --retro-sprint sprint-12")
+		fmt.Println("")
+		fmt.Println("  --focus-note <file.md> [--focus-labels N]")
+		fmt.Println("      Markdown planning note for this week's focus rotation (see")
+		fmt.Println("      --robot-focus-rotation): each focus label with a checklist of issues")
+		fmt.Println("      to start with. Records the week so next week's suggestions rotate.")
+		fmt.Println("      Example: bv --focus-note focus.md")
+		fmt.Println("")
+		fmt.Println("  --compare-recipe <A> --compare-with <B> [--robot-compare]")
+		fmt.Println("      Compares the issues two saved views select: counts by status and priority,")
+		fmt.Println("      actionable/blocked/stale totals, average age, share of PageRank and top")
+		fmt.Println("      labels side by side, then which issues only one of them picks up.")
+		fmt.Println("      A and B are recipe names or, failing that, query expressions.")
+		fmt.Println("      --robot-compare prints the same as JSON (overlap in both/only_a/only_b).")
+		fmt.Println("      Example: bv --compare-recipe triage --compare-with 'priority<=1 and status=open'")
+		fmt.Println("")
+		fmt.Println("  --export-feed <file.xml> [--feed-url URL]")
+		fmt.Println("      Atom feed of backlog changes (issues created, closed, and updated, plus")
+		fmt.Println("      current alerts), newest first, for any feed reader. Entry IDs are stable, so")
+		fmt.Println("      regenerating on a schedule only surfaces new changes. --export-pages also")
+		fmt.Println("      writes feed.xml next to index.html; --feed-url makes its links absolute.")
+		fmt.Println("      Example: bv --export-feed feed.xml --feed-url https://acme.github.io/widgets/")
+		fmt.Println("")
+		fmt.Println("  --check-keys")
+		fmt.Println("      Prints the TUI key bindings in effect (defaults plus .bv/keys.yaml) in")
+		fmt.Println("      keys.yaml form, then any keys bound to two actions that can be active at")
+		fmt.Println("      once. Exits 1 on conflicts or an invalid keys.yaml.")
+		fmt.Println("")
+		fmt.Println("  --validate / --robot-validate")
+		fmt.Println("      Checks every record of the beads file (or stdin with 'bv - --validate')")
+		fmt.Println("      and reports each problem with its line number: malformed JSON, wrong field")
+		fmt.Println("      types, missing id/title/status/issue_type, bad enum values and duplicate IDs")
+		fmt.Println("      (errors: the loader drops or misreads these), plus unknown fields, priorities")
+		fmt.Println("      outside 0-4 and dependencies on missing IDs (warnings).")
+		fmt.Println("      Exit codes: 0 no errors, 1 errors found, 2 file could not be read.")
+		fmt.Println("      The TUI shows the same report in the validation panel (X).")
+		fmt.Println("")
+		fmt.Println("  --stable")
+		fmt.Println("      Deterministic exports for reports checked into git: applies to --export-md,")
+		fmt.Println("      --priority-brief, --agent-brief, --export-pages, --export-timelog, --export-quality,")
+		fmt.Println("      --email-digest, --sprint-retro, and --bundle.")
+		fmt.Println("      Drops generation timestamps (JSON generated_at uses the latest issue change),")
+		fmt.Println("      breaks ordering ties by ID, and rounds float scores.")
+		fmt.Println("      Example: bv --stable --agent-brief docs/brief")
+		fmt.Println("")
 		fmt.Println("  Drift Detection Configuration (.bv/drift.yaml)")
 		fmt.Println("      Customize drift detection thresholds:")
 		fmt.Println("      - density_warning_pct: 50    # Warn if density +50%")
@@ -682,6 +1029,63 @@ func main() {
 		}
 	}
 
+	// Handle work session commands
+	if *workStart != "" || *workStop {
+		beadsDir, err := loader.GetBeadsDir("")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting beads directory: %v\n", err)
+			os.Exit(1)
+		}
+
+		timeLog, err := analysis.LoadTimeLog(beadsDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading time log: %v\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now()
+		if *workStop {
+			stopped, err := timeLog.Stop(now)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error stopping work session: %v\n", err)
+				os.Exit(1)
+			}
+			if stopped == nil {
+				fmt.Println("No work session running.")
+			} else {
+				fmt.Printf("Stopped work on %s after %s\n", stopped.IssueID, stopped.Duration(now).Round(time.Second))
+			}
+			os.Exit(0)
+		}
+
+		issues, err := loader.LoadIssues("")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading issues: %v\n", err)
+			os.Exit(1)
+		}
+		found := false
+		for i := range issues {
+			if issues[i].ID == *workStart {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmt.Fprintf(os.Stderr, "Issue not found: %s\n", *workStart)
+			os.Exit(1)
+		}
+
+		if prev := timeLog.Active(); prev != nil {
+			fmt.Printf("Stopped work on %s after %s\n", prev.IssueID, prev.Duration(now).Round(time.Second))
+		}
+		if err := timeLog.Start(*workStart, now); err != nil {
+			fmt.Fprintf(os.Stderr, "Error starting work session: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Started work session on %s\n", *workStart)
+		os.Exit(0)
+	}
+
 	// Load recipes (needed for both --robot-recipes and --recipe)
 	recipeLoader, err := recipe.LoadDefault()
 	if err != nil {
@@ -715,7 +1119,129 @@ func main() {
 
 	// Get project directory for baseline operations (moved up to allow info check without loading issues)
 	projectDir, _ := os.Getwd()
-	baselinePath := baseline.DefaultPath(projectDir)
+	baselinePath, err := baseline.Resolve(projectDir, *baselineName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Handle --check-keys (before loading issues)
+	if *checkKeys {
+		keymap, err := ui.LoadKeymap(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Print(keymap.FormatBindings())
+		conflicts := keymap.Conflicts()
+		if len(conflicts) == 0 {
+			fmt.Println("\nNo conflicts.")
+			os.Exit(0)
+		}
+		fmt.Println("\nConflicts:")
+		for _, c := range conflicts {
+			fmt.Printf("  %s\n", c)
+		}
+		os.Exit(1)
+	}
+
+	// Handle --validate / --robot-validate (before loading issues, which skips bad records)
+	if *validateFile || *robotValidate {
+		var report loader.ValidationReport
+		var err error
+		if fromStdin {
+			var stdin io.Reader
+			var closeStdin func()
+			stdin, closeStdin, err = loader.DecompressStream(os.Stdin)
+			if err == nil {
+				report, err = loader.ValidateIssues(stdin)
+				closeStdin()
+			}
+		} else {
+			var beadsPath string
+			beadsDir, dirErr := loader.GetBeadsDir("")
+			if dirErr == nil {
+				beadsPath, dirErr = loader.FindJSONLPath(beadsDir)
+			}
+			if dirErr != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", dirErr)
+				os.Exit(2)
+			}
+			report, err = loader.ValidateIssueFile(beadsPath)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+
+		if *robotValidate {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding validation report: %v\n", err)
+				os.Exit(2)
+			}
+		} else {
+			fmt.Print(loader.FormatValidationReport(report))
+		}
+		if report.HasErrors() {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --list-baselines
+	if *listBaselines {
+		entries, err := baseline.List(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(entries) == 0 {
+			fmt.Println("No baselines found.")
+			fmt.Println("Create one with: bv --save-baseline \"description\"")
+			os.Exit(0)
+		}
+		fmt.Printf("%-10s %-17s %-9s %6s %6s %10s  %s\n", "NAME", "CREATED", "COMMIT", "OPEN", "BLOCK", "ACTIONABLE", "NOTE")
+		for _, e := range entries {
+			b := e.Baseline
+			sha := b.CommitSHA
+			if len(sha) > 8 {
+				sha = sha[:8]
+			}
+			fmt.Printf("%-10s %-17s %-9s %6d %6d %10d  %s\n", e.Name, b.CreatedAt.Local().Format("2006-01-02 15:04"),
+				sha, b.Stats.OpenCount, b.Stats.BlockedCount, b.Stats.ActionableCount, b.Description)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --use-baseline
+	if *useBaseline != "" {
+		path, err := baseline.Resolve(projectDir, *useBaseline)
+		if err == nil {
+			err = baseline.Activate(projectDir, path)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Active baseline is now %s\n", path)
+		os.Exit(0)
+	}
+
+	// Handle --rotate-baselines
+	if *rotateBaselines >= 0 {
+		removed, err := baseline.Rotate(projectDir, *rotateBaselines)
+		for _, path := range removed {
+			fmt.Printf("Removed %s\n", path)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Kept the newest %d baseline snapshot(s)\n", *rotateBaselines)
+		os.Exit(0)
+	}
 
 	// Handle --baseline-info
 	if *baselineInfo {
@@ -746,6 +1272,18 @@ func main() {
 			}
 			os.Exit(1)
 		}
+		if expr := activeRecipe.Filters.Query; expr != "" {
+			if _, err := query.Parse(expr); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: recipe %s has an invalid query: %v\n", activeRecipe.Name, err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	// With no source named, a workspace manifest here or above is the source
+	if *workspaceConfig == "" && !*noWorkspace && !fromStdin && *fromGitHub == "" && *fromJira == "" &&
+		!*sqliteStore && os.Getenv(loader.BeadsDirEnvVar) == "" {
+		*workspaceConfig = workspace.AutoDetectConfig(projectDir)
 	}
 
 	// Load issues from current directory or workspace (with timing for profile)
@@ -753,17 +1291,46 @@ func main() {
 	var issues []model.Issue
 	var beadsPath string
 	var workspaceInfo *workspace.LoadSummary
+	var workspaceLoader *workspace.AggregateLoader
+	var issueStore *loader.SQLiteStore
 
-	if *workspaceConfig != "" {
+	if fromStdin {
+		// Read a pipeline's output (JSONL or a JSON array) instead of .beads
+		if term.IsTerminal(int(os.Stdin.Fd())) {
+			fmt.Fprintln(os.Stderr, "Error: 'bv -' reads issues from stdin; pipe them in, e.g. bd list --json | bv -")
+			os.Exit(1)
+		}
+		var err error
+		issues, err = loader.ParseIssueStream(os.Stdin, loader.ParseOptions{})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading issues from stdin: %v\n", err)
+			os.Exit(1)
+		}
+		// Nothing to watch: the stream has already ended
+		beadsPath = ""
+	} else if *workspaceConfig != "" {
 		// Load from workspace configuration
-		loadedIssues, results, err := workspace.LoadAllFromConfig(context.Background(), *workspaceConfig)
+		var opts workspace.LoadOptions
+		if *includeHidden != "" {
+			opts.IncludeHidden = strings.Split(*includeHidden, ",")
+		}
+		wsLoader, err := workspace.NewAggregateLoaderFromConfig(*workspaceConfig, opts)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
 			os.Exit(1)
 		}
+		loadedIssues, results, err := wsLoader.LoadAll(context.Background())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
+			if workspace.Summarize(results).HiddenRepos > 0 {
+				fmt.Fprintln(os.Stderr, "Pass --include-hidden NAME (or 'all') to load hidden repos.")
+			}
+			os.Exit(1)
+		}
 		issues = loadedIssues
 		summary := workspace.Summarize(results)
 		workspaceInfo = &summary
+		workspaceLoader = wsLoader
 
 		// Print workspace loading summary
 		if summary.FailedRepos > 0 {
@@ -772,8 +1339,68 @@ func main() {
 				fmt.Fprintf(os.Stderr, "  - %s\n", name)
 			}
 		}
-		// No live reload for workspace mode (multiple files)
+		// No single file: the TUI watches each repo's file instead
+		beadsPath = ""
+	} else if *fromGitHub != "" {
+		// Load a repo that doesn't use beads straight from GitHub Issues
+		owner, repo, _ := strings.Cut(*fromGitHub, "/")
+		token := os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			token = os.Getenv("GH_TOKEN")
+		}
+		var err error
+		issues, err = loader.LoadFromGitHub(owner, repo, token)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading GitHub issues: %v\n", err)
+			os.Exit(1)
+		}
+		// A snapshot of GitHub: no file to watch or write to
+		beadsPath = ""
+	} else if *fromJira != "" {
+		// Load a Jira export through the (optional) mapping file
+		mappingPath := *jiraMapping
+		if mappingPath == "" {
+			cwd, _ := os.Getwd()
+			mappingPath = loader.JiraMappingPath(cwd)
+		} else if _, err := os.Stat(mappingPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading Jira mapping: %v\n", err)
+			os.Exit(1)
+		}
+		mapping, err := loader.LoadJiraMapping(mappingPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading Jira mapping: %v\n", err)
+			os.Exit(1)
+		}
+		issues, err = loader.LoadFromJira(*fromJira, mapping)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading Jira issues: %v\n", err)
+			os.Exit(1)
+		}
+		// A snapshot of Jira: no beads file to watch or write to
 		beadsPath = ""
+	} else if *sqliteStore && !readonly.Enabled() {
+		// Sync the SQLite mirror, decoding only lines changed since the last run.
+		// Read-only mode can't write the mirror, so it reads the beads file
+		// directly below instead.
+		beadsDir, err := loader.GetBeadsDir("")
+		if err == nil {
+			beadsPath, err = loader.FindJSONLPath(beadsDir)
+		}
+		if err == nil {
+			issueStore, err = loader.OpenSQLiteStore(loader.SQLiteStorePath(projectDir))
+		}
+		if err == nil {
+			_, err = issueStore.Sync(beadsPath, loader.ParseOptions{})
+		}
+		if err == nil {
+			issues, err = issueStore.LoadIssues()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading beads: %v\n", err)
+			fmt.Fprintln(os.Stderr, "Make sure you are in a project initialized with 'bd init'.")
+			os.Exit(1)
+		}
+		defer issueStore.Close()
 	} else {
 		// Load from single repo (original behavior)
 		var err error
@@ -796,6 +1423,70 @@ func main() {
 
 	issuesForSearch := issues
 
+	// Per-type analysis toggles: drop excluded types (e.g., container epics, chores)
+	// from graph metrics and triage. Configured via .bv/analysis.yaml. Exports,
+	// history and sprints keep every issue.
+	typeToggles, err := analysis.LoadTypeToggles(projectDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	// Triage weights: .bv/triage.yaml, optionally a named profile in it
+	triageProfile, err := analysis.LoadTriageProfile(projectDir, *triageProfileFlag)
+	if err != nil {
+		if *triageProfileFlag != "" {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v (using default triage weights)\n", err)
+	}
+
+	// The MCP server and web dashboard re-read the beads file on each request
+	// so clients see edits; other sources (stdin, workspace, GitHub, Jira)
+	// are served as loaded
+	reloadIssues := func() ([]model.Issue, error) {
+		if beadsPath == "" {
+			return issues, nil
+		}
+		loaded, err := loader.LoadIssuesFromFile(beadsPath)
+		if err != nil {
+			return nil, err
+		}
+		if *repoFilter != "" {
+			loaded = filterByRepo(loaded, *repoFilter)
+		}
+		return loaded, nil
+	}
+
+	if mcpMode {
+		if err := mcp.NewServer(analysis.NewLiveAnalysis(reloadIssues, typeToggles, &triageProfile), version.Version).Serve(context.Background(), os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error serving MCP: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if serveMode {
+		title := "beads"
+		if cwd, err := os.Getwd(); err == nil {
+			title = filepath.Base(cwd)
+		}
+		driftConfig, err := drift.LoadConfig(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading drift config: %v\n", err)
+			os.Exit(1)
+		}
+		srv := web.NewServer(analysis.NewLiveAnalysis(reloadIssues, typeToggles, &triageProfile), title)
+		srv.SetAlerts(func(issues []model.Issue) []drift.Alert {
+			return computeDriftAlerts(issues, driftConfig)
+		})
+		if err := runWebDashboard(srv, *serveAddr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error serving dashboard: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Stable data hash for robot outputs (after repo filter but before recipes/TUI)
 	dataHash := analysis.ComputeDataHash(issues)
 
@@ -828,55 +1519,97 @@ func main() {
 		}
 	}
 
+	// Drop the types excluded from analysis, now that --label has scoped the issues
+	analysisIssues := typeToggles.Apply(issues)
+
 	// Handle semantic search CLI (bv-9gf.3)
 	if *robotSearch && *semanticQuery == "" {
 		fmt.Fprintln(os.Stderr, "Error: --robot-search requires --search \"query\"")
 		os.Exit(1)
 	}
-	if *semanticQuery != "" {
-		cfg := search.EmbeddingConfigFromEnv()
-		embedder, err := search.NewEmbedderFromConfig(cfg)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
-
-		projectDir, err := os.Getwd()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
-		indexPath := search.DefaultIndexPath(projectDir, cfg)
-		idx, loaded, err := search.LoadOrNewVectorIndex(indexPath, embedder.Dim())
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
-
-		docs := search.DocumentsFromIssues(issuesForSearch)
-		if !*robotSearch && !loaded {
-			fmt.Fprintf(os.Stderr, "Building semantic index (%d issues)...\n", len(docs))
+	if *searchMode != "hybrid" && *searchMode != "semantic" && *searchMode != "text" {
+		fmt.Fprintf(os.Stderr, "Error: --search-mode must be hybrid, semantic or text, got %q\n", *searchMode)
+		os.Exit(1)
+	}
+	if *semanticQuery != "" && (*searchMode == "text" || search.ParseTextQuery(*semanticQuery).Scoped()) {
+		limit := *searchLimit
+		if limit <= 0 {
+			limit = 10
 		}
+		results := search.FullTextSearch(issuesForSearch, *semanticQuery, nil, limit)
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
-		syncStats, err := search.SyncVectorIndex(ctx, idx, embedder, docs, 64)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error building semantic index: %v\n", err)
-			os.Exit(1)
-		}
-		if !loaded || syncStats.Changed() {
-			if err := idx.Save(indexPath); err != nil {
-				fmt.Fprintf(os.Stderr, "Error saving semantic index: %v\n", err)
-				os.Exit(1)
-			}
+		titleByID := make(map[string]string, len(issuesForSearch))
+		for _, iss := range issuesForSearch {
+			titleByID[iss.ID] = iss.Title
 		}
 
-		qvecs, err := embedder.Embed(ctx, []string{*semanticQuery})
-		if err != nil || len(qvecs) != 1 {
-			if err == nil {
-				err = fmt.Errorf("embedder returned %d vectors for query", len(qvecs))
+		if *robotSearch {
+			type resultRow struct {
+				IssueID string  `json:"issue_id"`
+				Score   float64 `json:"score"`
+				Title   string  `json:"title,omitempty"`
+			}
+			out := struct {
+				GeneratedAt string                   `json:"generated_at"`
+				DataHash    string                   `json:"data_hash"`
+				Query       string                   `json:"query"`
+				Mode        string                   `json:"mode"`
+				Terms       search.TextQuery         `json:"terms"`
+				Boosts      map[search.Field]float64 `json:"boosts"`
+				Limit       int                      `json:"limit"`
+				Results     []resultRow              `json:"results"`
+				UsageHints  []string                 `json:"usage_hints"`
+			}{
+				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+				DataHash:    dataHash,
+				Query:       *semanticQuery,
+				Mode:        "text",
+				Terms:       search.ParseTextQuery(*semanticQuery),
+				Boosts:      search.DefaultFieldBoosts,
+				Limit:       limit,
+				UsageHints: []string{
+					"jq '.results[] | {id: .issue_id, score: .score, title: .title}' - Extract results",
+					"bv --search 'comment:regression' --robot-search - Search one field",
+				},
+			}
+			out.Results = make([]resultRow, 0, len(results))
+			for _, r := range results {
+				out.Results = append(out.Results, resultRow{
+					IssueID: r.IssueID,
+					Score:   r.Score,
+					Title:   titleByID[r.IssueID],
+				})
+			}
+
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(out); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding robot-search: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+
+		for _, r := range results {
+			fmt.Printf("%.4f\t%s\t%s\n", r.Score, r.IssueID, titleByID[r.IssueID])
+		}
+		os.Exit(0)
+	}
+	if *semanticQuery != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		sem, err := syncSemanticIndex(ctx, issuesForSearch, !*robotSearch)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		cfg, embedder, idx, indexPath, loaded, syncStats := sem.Config, sem.Embedder, sem.Index, sem.Path, sem.Loaded, sem.Stats
+
+		qvecs, err := embedder.Embed(ctx, []string{*semanticQuery})
+		if err != nil || len(qvecs) != 1 {
+			if err == nil {
+				err = fmt.Errorf("embedder returned %d vectors for query", len(qvecs))
 			}
 			fmt.Fprintf(os.Stderr, "Error embedding query: %v\n", err)
 			os.Exit(1)
@@ -886,7 +1619,12 @@ func main() {
 		if limit <= 0 {
 			limit = 10
 		}
-		results, err := idx.SearchTopK(qvecs[0], limit)
+		var results []search.SearchResult
+		if *searchMode == "hybrid" {
+			results, err = search.HybridSearch(search.NewBM25Index(issuesForSearch, nil), idx, qvecs[0], *semanticQuery, limit)
+		} else {
+			results, err = idx.SearchTopK(qvecs[0], limit)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error searching index: %v\n", err)
 			os.Exit(1)
@@ -907,6 +1645,7 @@ func main() {
 				GeneratedAt string                `json:"generated_at"`
 				DataHash    string                `json:"data_hash"`
 				Query       string                `json:"query"`
+				Mode        string                `json:"mode"`
 				Provider    search.Provider       `json:"provider"`
 				Model       string                `json:"model,omitempty"`
 				Dim         int                   `json:"dim"`
@@ -920,6 +1659,7 @@ func main() {
 				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
 				DataHash:    dataHash,
 				Query:       *semanticQuery,
+				Mode:        *searchMode,
 				Provider:    cfg.Provider,
 				Model:       cfg.Model,
 				Dim:         embedder.Dim(),
@@ -960,9 +1700,92 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --duplicates / --robot-duplicates: semantic near-duplicate pairs
+	if *duplicatesReport || *robotDuplicates {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		sem, err := syncSemanticIndex(ctx, issuesForSearch, !*robotDuplicates)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		// Only open issues are candidates, most recently updated first
+		byID := make(map[string]model.Issue, len(issuesForSearch))
+		var open []model.Issue
+		for _, iss := range issuesForSearch {
+			byID[iss.ID] = iss
+			if iss.Status != model.StatusClosed {
+				open = append(open, iss)
+			}
+		}
+		sort.SliceStable(open, func(i, j int) bool { return open[i].UpdatedAt.After(open[j].UpdatedAt) })
+		if len(open) > search.MaxClusterCandidates {
+			open = open[:search.MaxClusterCandidates]
+		}
+		ids := make([]string, len(open))
+		for i, iss := range open {
+			ids[i] = iss.ID
+		}
+		pairs := search.DuplicatePairs(sem.Index, ids, *duplicatesThreshold, 0)
+
+		if *robotDuplicates {
+			type pairRow struct {
+				search.DuplicatePair
+				TitleA string `json:"title_a"`
+				TitleB string `json:"title_b"`
+			}
+			out := struct {
+				GeneratedAt string          `json:"generated_at"`
+				DataHash    string          `json:"data_hash"`
+				Provider    search.Provider `json:"provider"`
+				Threshold   float64         `json:"threshold"`
+				Candidates  int             `json:"candidates"`
+				Pairs       []pairRow       `json:"pairs"`
+				UsageHints  []string        `json:"usage_hints"`
+			}{
+				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+				DataHash:    dataHash,
+				Provider:    sem.Config.Provider,
+				Threshold:   *duplicatesThreshold,
+				Candidates:  len(ids),
+				Pairs:       make([]pairRow, 0, len(pairs)),
+				UsageHints: []string{
+					"jq '.pairs[] | \"\\(.a) \\(.b) \\(.similarity)\"' - List pairs",
+					"bv --merge <a>,<b> - Preview merging b into a",
+				},
+			}
+			for _, p := range pairs {
+				out.Pairs = append(out.Pairs, pairRow{DuplicatePair: p, TitleA: byID[p.A].Title, TitleB: byID[p.B].Title})
+			}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(out); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding robot-duplicates: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+
+		if len(pairs) == 0 {
+			fmt.Printf("No possible duplicates among %d open issues (threshold %.2f)\n", len(ids), *duplicatesThreshold)
+			os.Exit(0)
+		}
+		fmt.Printf("%d possible duplicate pairs among %d open issues (threshold %.2f)\n\n", len(pairs), len(ids), *duplicatesThreshold)
+		for _, p := range pairs {
+			fmt.Printf("%.0f%%  %s  %s\n      %s  %s\n", p.Similarity*100, p.A, byID[p.A].Title, p.B, byID[p.B].Title)
+		}
+		os.Exit(0)
+	}
+
 	// Handle --pages wizard (bv-10g)
 	if *pagesWizard {
-		if err := runPagesWizard(issues, beadsPath); err != nil {
+		// Refuse before the prompts rather than at the first write
+		err := readonly.Check("deploying pages")
+		if err == nil {
+			err = runPagesWizard(issues, beadsPath, typeToggles, &triageProfile)
+		}
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -1023,53 +1846,12 @@ func main() {
 			}
 		}
 
-		// Build graph and compute stats
-		fmt.Println("  → Running graph analysis...")
-		analyzer := analysis.NewAnalyzer(exportIssues)
-		stats := analyzer.AnalyzeAsync(context.Background())
-		stats.WaitForPhase2()
-
-		// Compute triage
-		fmt.Println("  → Generating triage data...")
-		triage := analysis.ComputeTriage(exportIssues)
-
-		// Extract dependencies
-		var deps []*model.Dependency
-		for i := range exportIssues {
-			issue := &exportIssues[i]
-			for _, dep := range issue.Dependencies {
-				if dep == nil || !dep.Type.IsBlocking() {
-					continue
-				}
-				deps = append(deps, &model.Dependency{
-					IssueID:     issue.ID,
-					DependsOnID: dep.DependsOnID,
-					Type:        dep.Type,
-				})
-			}
-		}
-
-		// Create exporter
-		issuePointers := make([]*model.Issue, len(exportIssues))
-		for i := range exportIssues {
-			issuePointers[i] = &exportIssues[i]
+		var generatedAt time.Time
+		if *stableExport {
+			generatedAt = export.StableTime(issues)
 		}
-		exporter := export.NewSQLiteExporter(issuePointers, deps, stats, &triage)
-		if *pagesTitle != "" {
-			exporter.Config.Title = *pagesTitle
-		}
-
-		// Export SQLite database
-		fmt.Println("  → Writing database and JSON files...")
-		if err := exporter.Export(*exportPages); err != nil {
-			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
-			os.Exit(1)
-		}
-
-		// Copy viewer assets
-		fmt.Println("  → Copying viewer assets...")
-		if err := copyViewerAssets(*exportPages, *pagesTitle); err != nil {
-			fmt.Fprintf(os.Stderr, "Error copying assets: %v\n", err)
+		if err := writeStaticSite(*exportPages, exportIssues, *pagesTitle, *feedURL, *stableExport, generatedAt, projectDir, typeToggles, &triageProfile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
@@ -1079,7 +1861,7 @@ func main() {
 			if historyReport, err := generateHistoryForExport(issues); err == nil && historyReport != nil {
 				historyPath := filepath.Join(*exportPages, "data", "history.json")
 				if historyJSON, err := json.MarshalIndent(historyReport, "", "  "); err == nil {
-					if err := os.WriteFile(historyPath, historyJSON, 0644); err != nil {
+					if err := readonly.WriteFile(historyPath, historyJSON, 0644); err != nil {
 						fmt.Printf("  → Warning: failed to write history.json: %v\n", err)
 					} else {
 						fmt.Printf("  → history.json (%d commits)\n", len(historyReport.Commits))
@@ -1116,10 +1898,81 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --bundle: the static site packed into one shareable archive
+	if *bundleOut != "" {
+		var scope []string
+		bundleIssues := issues
+		if activeRecipe != nil {
+			filtered, err := applyRecipeFilters(bundleIssues, activeRecipe)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			bundleIssues = applyRecipeSort(filtered, activeRecipe)
+			scope = append(scope, "recipe "+activeRecipe.Name)
+		}
+		if *labelScope != "" {
+			var labeled []model.Issue
+			for _, issue := range bundleIssues {
+				for _, l := range issue.Labels {
+					if l == *labelScope {
+						labeled = append(labeled, issue)
+						break
+					}
+				}
+			}
+			bundleIssues = labeled
+			scope = append(scope, "label "+*labelScope)
+		}
+		if !*pagesIncludeClosed {
+			var openIssues []model.Issue
+			for _, issue := range bundleIssues {
+				if issue.Status != model.StatusClosed {
+					openIssues = append(openIssues, issue)
+				}
+			}
+			bundleIssues = openIssues
+			scope = append(scope, "open issues only")
+		}
+		if len(bundleIssues) == 0 {
+			fmt.Fprintln(os.Stderr, "Error: no issues in scope for the bundle")
+			os.Exit(1)
+		}
+		bundleIssues = export.ScopeBundleIssues(bundleIssues)
+
+		siteDir, err := readonly.MkdirTemp("", "bv-bundle-*")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		generatedAt := time.Now()
+		if *stableExport {
+			generatedAt = export.StableTime(bundleIssues)
+		}
+		fmt.Printf("Bundling %d issues...\n", len(bundleIssues))
+		err = writeStaticSite(siteDir, bundleIssues, *pagesTitle, *feedURL, *stableExport, generatedAt, projectDir, typeToggles, &triageProfile)
+		if err == nil {
+			err = export.WriteBundle(siteDir, *bundleOut, export.BundleInfo{
+				Title:       *pagesTitle,
+				IssueCount:  len(bundleIssues),
+				Scope:       strings.Join(scope, ", "),
+				GeneratedAt: generatedAt,
+			})
+		}
+		readonly.RemoveAll(siteDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✓ Bundle saved to %s\n", *bundleOut)
+		fmt.Println("  Recipients extract it and run: python3 -m http.server (see README.txt inside)")
+		os.Exit(0)
+	}
+
 	// Handle --robot-label-health
 	if *robotLabelHealth {
 		cfg := analysis.DefaultLabelHealthConfig()
-		results := analysis.ComputeAllLabelHealth(issues, cfg, time.Now().UTC(), nil)
+		results := analysis.ComputeAllLabelHealth(analysisIssues, cfg, time.Now().UTC(), nil)
 
 		output := struct {
 			GeneratedAt    string                       `json:"generated_at"`
@@ -1151,7 +2004,7 @@ func main() {
 	// Handle --robot-label-flow (can be used stand-alone to avoid full health computation)
 	if *robotLabelFlow {
 		cfg := analysis.DefaultLabelHealthConfig()
-		flow := analysis.ComputeCrossLabelFlow(issues, cfg)
+		flow := analysis.ComputeCrossLabelFlow(analysisIssues, cfg)
 		output := struct {
 			GeneratedAt string                     `json:"generated_at"`
 			DataHash    string                     `json:"data_hash"`
@@ -1181,7 +2034,7 @@ func main() {
 	// Handle --robot-label-attention (bv-121)
 	if *robotLabelAttention {
 		cfg := analysis.DefaultLabelHealthConfig()
-		result := analysis.ComputeLabelAttentionScores(issues, cfg, time.Now().UTC())
+		result := analysis.ComputeLabelAttentionScores(analysisIssues, cfg, time.Now().UTC())
 
 		// Apply limit
 		limit := *attentionLimit
@@ -1227,8 +2080,7 @@ func main() {
 
 		for i := 0; i < limit; i++ {
 			score := result.Labels[i]
-			// Build human-readable reason
-			reason := buildAttentionReason(score)
+			reason := score.Reason()
 			output.Labels = append(output.Labels, struct {
 				Rank            int     `json:"rank"`
 				Label           string  `json:"label"`
@@ -1263,9 +2115,108 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --robot-focus-rotation / --focus-note: weekly focus labels that rotate
+	if *robotFocusRotation || *focusNote != "" {
+		history, err := analysis.LoadFocusRotation(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		now := time.Now().UTC()
+		opts := analysis.DefaultFocusRotationOptions()
+		opts.Labels = *focusLabels
+		rotation := analysis.ComputeFocusRotation(analysisIssues,
+			analysis.ComputeLabelAttentionScores(analysisIssues, analysis.DefaultLabelHealthConfig(), now),
+			history, opts, now)
+
+		history.Record(rotation)
+		if err := analysis.SaveFocusRotation(projectDir, history); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not record focus rotation: %v\n", err)
+		}
+
+		if *focusNote != "" {
+			note := export.GenerateFocusNote(filepath.Base(projectDir), rotation)
+			if err := readonly.WriteFile(*focusNote, []byte(note), 0644); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing focus note: %v\n", err)
+				os.Exit(1)
+			}
+			if !*robotFocusRotation {
+				fmt.Printf("Focus note saved to %s\n", *focusNote)
+			}
+		}
+		if *robotFocusRotation {
+			output := struct {
+				DataHash string `json:"data_hash"`
+				analysis.FocusRotation
+				UsageHints []string `json:"usage_hints"`
+			}{
+				DataHash:      dataHash,
+				FocusRotation: rotation,
+				UsageHints: []string{
+					"jq '.picks[] | {label, reason}' - this week's focus labels",
+					"jq '.picks[].start_with[0].id' - first issue to pick up per label",
+					"jq '.skipped' - labels rotated out after recent focus",
+				},
+			}
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(output); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding focus rotation: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		os.Exit(0)
+	}
+
+	// Handle --compare-recipe: overlap and metrics of two saved views
+	if *compareRecipe != "" || *compareWith != "" {
+		if *compareRecipe == "" || *compareWith == "" {
+			fmt.Fprintln(os.Stderr, "Error: --compare-recipe and --compare-with must be used together")
+			os.Exit(1)
+		}
+		now := time.Now()
+		a, err := resolveSelection(*compareRecipe, recipeLoader, analysisIssues, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		b, err := resolveSelection(*compareWith, recipeLoader, analysisIssues, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		stats := analysis.NewAnalyzer(analysisIssues).Analyze()
+		cmp := analysis.CompareSelections(analysisIssues, a, b, stats.PageRank(), now.UTC())
+
+		if !*robotCompare {
+			fmt.Print(cmp.Summary())
+			os.Exit(0)
+		}
+		output := struct {
+			DataHash string `json:"data_hash"`
+			analysis.SelectionComparison
+			UsageHints []string `json:"usage_hints"`
+		}{
+			DataHash:            dataHash,
+			SelectionComparison: cmp,
+			UsageHints: []string{
+				"jq '.jaccard' - how similar the two selections are (1 = identical)",
+				"jq '.only_a, .only_b' - issues only one view picks up",
+				"jq '{a: .a.actionable, b: .b.actionable}' - actionable work in each view",
+			},
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding comparison: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Handle --robot-graph (bv-136)
 	if *robotGraph {
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		stats := analyzer.Analyze()
 
 		// Determine format
@@ -1275,6 +2226,10 @@ func main() {
 			format = export.GraphFormatDOT
 		case "mermaid":
 			format = export.GraphFormatMermaid
+		case "plantuml", "puml":
+			format = export.GraphFormatPlantUML
+		case "d2":
+			format = export.GraphFormatD2
 		default:
 			format = export.GraphFormatJSON
 		}
@@ -1282,12 +2237,13 @@ func main() {
 		config := export.GraphExportConfig{
 			Format:   format,
 			Label:    *labelScope,
+			Epic:     *graphEpic,
 			Root:     *graphRoot,
 			Depth:    *graphDepth,
 			DataHash: dataHash,
 		}
 
-		result, err := export.ExportGraph(issues, &stats, config)
+		result, err := export.ExportGraph(analysisIssues, &stats, config)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error exporting graph: %v\n", err)
 			os.Exit(1)
@@ -1311,40 +2267,11 @@ func main() {
 			os.Exit(1)
 		}
 
-		analyzer := analysis.NewAnalyzer(issues)
-		stats := analyzer.Analyze()
-
-		openCount, closedCount, blockedCount := 0, 0, 0
-		for _, issue := range issues {
-			switch issue.Status {
-			case model.StatusClosed:
-				closedCount++
-			case model.StatusBlocked:
-				blockedCount++
-			default:
-				openCount++
-			}
-		}
-		curStats := baseline.GraphStats{
-			NodeCount:       stats.NodeCount,
-			EdgeCount:       stats.EdgeCount,
-			Density:         stats.Density,
-			OpenCount:       openCount,
-			ClosedCount:     closedCount,
-			BlockedCount:    blockedCount,
-			CycleCount:      len(stats.Cycles()),
-			ActionableCount: len(analyzer.GetActionableIssues()),
-		}
-		bl := &baseline.Baseline{Stats: curStats}
-		cur := &baseline.Baseline{Stats: curStats, Cycles: stats.Cycles()}
-
-		calc := drift.NewCalculator(bl, cur, driftConfig)
-		calc.SetIssues(issues)
-		driftResult := calc.Calculate()
+		alerts := computeDriftAlerts(analysisIssues, driftConfig)
 
 		// Apply optional filters
-		filtered := driftResult.Alerts[:0]
-		for _, a := range driftResult.Alerts {
+		filtered := alerts[:0]
+		for _, a := range alerts {
 			if *alertSeverity != "" && string(a.Severity) != *alertSeverity {
 				continue
 			}
@@ -1365,7 +2292,7 @@ func main() {
 			}
 			filtered = append(filtered, a)
 		}
-		driftResult.Alerts = filtered
+		alerts = filtered
 
 		output := struct {
 			GeneratedAt string        `json:"generated_at"`
@@ -1381,14 +2308,14 @@ func main() {
 		}{
 			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
 			DataHash:    dataHash,
-			Alerts:      driftResult.Alerts,
+			Alerts:      alerts,
 			UsageHints: []string{
 				"--severity=warning --alert-type=stale_issue   # stale warnings only",
 				"--alert-type=blocking_cascade                 # high-unblock opportunities",
 				"jq '.alerts | map(.issue_id)'                # list impacted issues",
 			},
 		}
-		for _, a := range driftResult.Alerts {
+		for _, a := range alerts {
 			switch a.Severity {
 			case drift.SeverityCritical:
 				output.Summary.Critical++
@@ -1432,7 +2359,7 @@ func main() {
 			os.Exit(1)
 		}
 
-		output := analysis.GenerateRobotSuggestOutput(issues, config, dataHash)
+		output := analysis.GenerateRobotSuggestOutput(analysisIssues, config, dataHash)
 
 		encoder := json.NewEncoder(os.Stdout)
 		encoder.SetIndent("", "  ")
@@ -1445,68 +2372,39 @@ func main() {
 
 	// Handle --profile-startup
 	if *profileStartup {
-		runProfileStartup(issues, loadDuration, *profileJSON, *forceFullAnalysis)
+		runProfileStartup(analysisIssues, loadDuration, *profileJSON, *forceFullAnalysis)
 		os.Exit(0)
 	}
 
 	// Handle --save-baseline
 	if *saveBaseline != "" {
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		if *forceFullAnalysis {
 			cfg := analysis.FullAnalysisConfig()
 			analyzer.SetConfig(&cfg)
 		}
 		stats := analyzer.Analyze()
-
-		// Compute status counts from issues
-		openCount, closedCount, blockedCount := 0, 0, 0
-		for _, issue := range issues {
-			switch issue.Status {
-			case model.StatusOpen, model.StatusInProgress:
-				openCount++
-			case model.StatusClosed:
-				closedCount++
-			case model.StatusBlocked:
-				blockedCount++
-			}
-		}
-
-		// Get actionable count from analyzer
-		actionableCount := len(analyzer.GetActionableIssues())
-
-		// Get cycles (method returns a copy)
-		cycles := stats.Cycles()
-
-		// Build GraphStats from analysis
-		graphStats := baseline.GraphStats{
-			NodeCount:       stats.NodeCount,
-			EdgeCount:       stats.EdgeCount,
-			Density:         stats.Density,
-			OpenCount:       openCount,
-			ClosedCount:     closedCount,
-			BlockedCount:    blockedCount,
-			CycleCount:      len(cycles),
-			ActionableCount: actionableCount,
-		}
-
-		// Build TopMetrics from analysis (top 10 for each)
-		// Methods return copies of the maps
-		topMetrics := baseline.TopMetrics{
-			PageRank:     buildMetricItems(stats.PageRank(), 10),
-			Betweenness:  buildMetricItems(stats.Betweenness(), 10),
-			CriticalPath: buildMetricItems(stats.CriticalPathScore(), 10),
-			Hubs:         buildMetricItems(stats.Hubs(), 10),
-			Authorities:  buildMetricItems(stats.Authorities(), 10),
-		}
-
-		bl := baseline.New(graphStats, topMetrics, cycles, *saveBaseline)
+		snap := drift.Snapshot(analysisIssues, &stats, analyzer)
+		bl := baseline.New(snap.Stats, snap.TopMetrics, snap.Cycles, *saveBaseline)
 
 		if err := bl.Save(baselinePath); err != nil {
 			fmt.Fprintf(os.Stderr, "Error saving baseline: %v\n", err)
 			os.Exit(1)
 		}
+		// Keep a dated copy so this state can be compared against later
+		archived, err := bl.Archive(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not archive baseline: %v\n", err)
+		} else if driftConfig, err := drift.LoadConfig(projectDir); err == nil {
+			if _, err := baseline.Rotate(projectDir, driftConfig.BaselineKeep); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
 
 		fmt.Printf("Baseline saved to %s\n", baselinePath)
+		if archived != "" {
+			fmt.Printf("Snapshot archived to %s\n", archived)
+		}
 		fmt.Print(bl.Summary())
 		os.Exit(0)
 	}
@@ -1526,47 +2424,13 @@ func main() {
 		}
 
 		// Run analysis on current issues
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		if *forceFullAnalysis {
 			cfg := analysis.FullAnalysisConfig()
 			analyzer.SetConfig(&cfg)
 		}
 		stats := analyzer.Analyze()
-
-		// Compute status counts from issues
-		openCount, closedCount, blockedCount := 0, 0, 0
-		for _, issue := range issues {
-			switch issue.Status {
-			case model.StatusOpen, model.StatusInProgress:
-				openCount++
-			case model.StatusClosed:
-				closedCount++
-			case model.StatusBlocked:
-				blockedCount++
-			}
-		}
-		actionableCount := len(analyzer.GetActionableIssues())
-		cycles := stats.Cycles()
-
-		// Build current snapshot as baseline for comparison
-		currentStats := baseline.GraphStats{
-			NodeCount:       stats.NodeCount,
-			EdgeCount:       stats.EdgeCount,
-			Density:         stats.Density,
-			OpenCount:       openCount,
-			ClosedCount:     closedCount,
-			BlockedCount:    blockedCount,
-			CycleCount:      len(cycles),
-			ActionableCount: actionableCount,
-		}
-		currentMetrics := baseline.TopMetrics{
-			PageRank:     buildMetricItems(stats.PageRank(), 10),
-			Betweenness:  buildMetricItems(stats.Betweenness(), 10),
-			CriticalPath: buildMetricItems(stats.CriticalPathScore(), 10),
-			Hubs:         buildMetricItems(stats.Hubs(), 10),
-			Authorities:  buildMetricItems(stats.Authorities(), 10),
-		}
-		current := baseline.New(currentStats, currentMetrics, cycles, "current")
+		current := drift.Snapshot(analysisIssues, &stats, analyzer)
 
 		// Load drift config and run calculator
 		driftConfig, err := drift.LoadConfig(projectDir)
@@ -1578,6 +2442,15 @@ func main() {
 		calc := drift.NewCalculator(bl, current, driftConfig)
 		result := calc.Calculate()
 
+		// Post new critical alerts to the team webhook, if one is configured
+		if notifyConfig, err := notify.LoadConfig(projectDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		} else if notifyConfig.Enabled() {
+			if _, err := notify.New(projectDir, notifyConfig).Notify(result.Alerts); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: could not notify webhook: %v\n", err)
+			}
+		}
+
 		if *robotDriftCheck {
 			// JSON output
 			output := struct {
@@ -1621,7 +2494,7 @@ func main() {
 	}
 
 	if *robotInsights {
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		if *forceFullAnalysis {
 			cfg := analysis.FullAnalysisConfig()
 			analyzer.SetConfig(&cfg)
@@ -1631,7 +2504,7 @@ func main() {
 		insights := stats.GenerateInsights(50)
 
 		// Add project-level velocity snapshot (reuse triage computation for consistency)
-		if triage := analysis.ComputeTriage(issues); triage.ProjectHealth.Velocity != nil {
+		if triage := analysis.ComputeTriage(analysisIssues); triage.ProjectHealth.Velocity != nil {
 			v := triage.ProjectHealth.Velocity
 			snap := &analysis.VelocitySnapshot{
 				Closed7:   v.ClosedLast7Days,
@@ -1780,12 +2653,12 @@ func main() {
 	}
 
 	if *robotPlan {
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		// For --robot-plan we primarily need Phase 1 metrics (degree/topo/density).
 		// However, we still emit a stable status contract for agents. If the user
 		// explicitly asks for full analysis, honor it; otherwise, skip expensive
 		// centrality metrics and record the skip reasons deterministically.
-		cfg := analysis.ConfigForSize(len(issues), countEdges(issues))
+		cfg := analysis.ConfigForSize(len(analysisIssues), countEdges(analysisIssues))
 		if *forceFullAnalysis {
 			cfg = analysis.FullAnalysisConfig()
 		} else {
@@ -1818,6 +2691,7 @@ func main() {
 			LabelScope     string                  `json:"label_scope,omitempty"`   // bv-122: Label filter applied
 			LabelContext   *analysis.LabelHealth   `json:"label_context,omitempty"` // bv-122: Health context for scoped label
 			Plan           analysis.ExecutionPlan  `json:"plan"`
+			Waves          analysis.WavePlan       `json:"waves"`
 			UsageHints     []string                `json:"usage_hints"` // bv-84: Agent-friendly hints
 		}{
 			GeneratedAt:    time.Now().UTC().Format(time.RFC3339),
@@ -1827,12 +2701,15 @@ func main() {
 			LabelScope:     *labelScope,
 			LabelContext:   labelScopeContext,
 			Plan:           plan,
+			Waves:          analyzer.GetWavePlan(time.Now()),
 			UsageHints: []string{
 				"jq '.plan.tracks | length' - Number of parallel execution tracks",
 				"jq '.plan.tracks[0].items | map(.id)' - First track item IDs",
 				"jq '.plan.tracks[].items[] | select(.unblocks | length > 0)' - Items that unblock others",
 				"jq '.plan.summary' - High-level execution summary",
 				"jq '[.plan.tracks[].items[]] | length' - Total items across all tracks",
+				"jq '.waves.waves[] | {wave, ids: [.items[].id]}' - Issues that can run in parallel, wave by wave",
+				"jq '.waves.estimated_days' - Days until all open work is done at the recent close rate",
 			},
 		}
 
@@ -1846,8 +2723,8 @@ func main() {
 	}
 
 	if *robotPriority {
-		analyzer := analysis.NewAnalyzer(issues)
-		cfg := analysis.ConfigForSize(len(issues), countEdges(issues))
+		analyzer := analysis.NewAnalyzer(analysisIssues)
+		cfg := analysis.ConfigForSize(len(analysisIssues), countEdges(analysisIssues))
 		if *forceFullAnalysis {
 			cfg = analysis.FullAnalysisConfig()
 		}
@@ -1861,8 +2738,8 @@ func main() {
 
 		// Apply robot filters (bv-84)
 		filtered := make([]analysis.EnhancedPriorityRecommendation, 0, len(recommendations))
-		issueMap := make(map[string]model.Issue, len(issues))
-		for _, iss := range issues {
+		issueMap := make(map[string]model.Issue, len(analysisIssues))
+		for _, iss := range analysisIssues {
 			issueMap[iss.ID] = iss
 		}
 		for _, rec := range recommendations {
@@ -1963,7 +2840,7 @@ func main() {
 		output.Filters.MaxResults = maxResults
 		output.Filters.ByLabel = *robotByLabel
 		output.Filters.ByAssignee = *robotByAssignee
-		output.Summary.TotalIssues = len(issues)
+		output.Summary.TotalIssues = len(analysisIssues)
 		output.Summary.Recommendations = len(recommendations)
 		output.Summary.HighConfidence = highConfidence
 
@@ -1982,8 +2859,9 @@ func main() {
 			GroupByTrack:  *robotTriageByTrack,
 			GroupByLabel:  *robotTriageByLabel,
 			WaitForPhase2: true, // Triage needs full graph metrics
+			Profile:       &triageProfile,
 		}
-		triage := analysis.ComputeTriageWithOptions(issues, opts)
+		triage := analysis.ComputeTriageWithOptions(analysisIssues, opts)
 
 		// bv-90: Load feedback data for output
 		var feedbackInfo *analysis.FeedbackJSON
@@ -2086,7 +2964,7 @@ func main() {
 	// Handle --priority-brief flag (bv-96)
 	if *priorityBrief != "" {
 		fmt.Printf("Generating priority brief to %s...\n", *priorityBrief)
-		triage := analysis.ComputeTriage(issues)
+		triage := computeExportTriage(analysisIssues, *stableExport, &triageProfile)
 
 		// Marshal triage to JSON for the export function
 		triageJSON, err := json.Marshal(triage)
@@ -2098,6 +2976,7 @@ func main() {
 		// Generate the brief
 		config := export.DefaultPriorityBriefConfig()
 		config.DataHash = dataHash
+		config.Stable = *stableExport
 		brief, err := export.GeneratePriorityBriefFromTriageJSON(triageJSON, config)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error generating priority brief: %v\n", err)
@@ -2105,7 +2984,7 @@ func main() {
 		}
 
 		// Write to file
-		if err := os.WriteFile(*priorityBrief, []byte(brief), 0644); err != nil {
+		if err := readonly.WriteFile(*priorityBrief, []byte(brief), 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing priority brief: %v\n", err)
 			os.Exit(1)
 		}
@@ -2119,34 +2998,37 @@ func main() {
 		fmt.Printf("Generating agent brief bundle to %s/...\n", *agentBrief)
 
 		// Create output directory
-		if err := os.MkdirAll(*agentBrief, 0755); err != nil {
+		if err := readonly.MkdirAll(*agentBrief, 0755); err != nil {
 			fmt.Fprintf(os.Stderr, "Error creating directory: %v\n", err)
 			os.Exit(1)
 		}
 
 		// Generate triage data
-		triage := analysis.ComputeTriage(issues)
+		triage := computeExportTriage(analysisIssues, *stableExport, &triageProfile)
 		triageJSON, err := json.MarshalIndent(triage, "", "  ")
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error marshaling triage: %v\n", err)
 			os.Exit(1)
 		}
-		if err := os.WriteFile(filepath.Join(*agentBrief, "triage.json"), triageJSON, 0644); err != nil {
+		if err := readonly.WriteFile(filepath.Join(*agentBrief, "triage.json"), triageJSON, 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing triage.json: %v\n", err)
 			os.Exit(1)
 		}
 		fmt.Println("  → triage.json")
 
 		// Generate insights
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		stats := analyzer.Analyze()
 		insights := stats.GenerateInsights(50)
+		if *stableExport {
+			stabilizeInsights(&insights)
+		}
 		insightsJSON, err := json.MarshalIndent(insights, "", "  ")
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error marshaling insights: %v\n", err)
 			os.Exit(1)
 		}
-		if err := os.WriteFile(filepath.Join(*agentBrief, "insights.json"), insightsJSON, 0644); err != nil {
+		if err := readonly.WriteFile(filepath.Join(*agentBrief, "insights.json"), insightsJSON, 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing insights.json: %v\n", err)
 			os.Exit(1)
 		}
@@ -2155,12 +3037,13 @@ func main() {
 		// Generate priority brief
 		config := export.DefaultPriorityBriefConfig()
 		config.DataHash = dataHash
+		config.Stable = *stableExport
 		brief, err := export.GeneratePriorityBriefFromTriageJSON(triageJSON, config)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error generating brief: %v\n", err)
 			os.Exit(1)
 		}
-		if err := os.WriteFile(filepath.Join(*agentBrief, "brief.md"), []byte(brief), 0644); err != nil {
+		if err := readonly.WriteFile(filepath.Join(*agentBrief, "brief.md"), []byte(brief), 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing brief.md: %v\n", err)
 			os.Exit(1)
 		}
@@ -2168,13 +3051,17 @@ func main() {
 
 		// Generate jq helpers
 		helpers := generateJQHelpers()
-		if err := os.WriteFile(filepath.Join(*agentBrief, "helpers.md"), []byte(helpers), 0644); err != nil {
+		if err := readonly.WriteFile(filepath.Join(*agentBrief, "helpers.md"), []byte(helpers), 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing helpers.md: %v\n", err)
 			os.Exit(1)
 		}
 		fmt.Println("  → helpers.md")
 
 		// Generate meta.json with hash and config
+		generatedAt := time.Now().UTC()
+		if *stableExport {
+			generatedAt = export.StableTime(analysisIssues)
+		}
 		meta := struct {
 			GeneratedAt string   `json:"generated_at"`
 			DataHash    string   `json:"data_hash"`
@@ -2182,14 +3069,14 @@ func main() {
 			Version     string   `json:"version"`
 			Files       []string `json:"files"`
 		}{
-			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			GeneratedAt: generatedAt.Format(time.RFC3339),
 			DataHash:    dataHash,
-			IssueCount:  len(issues),
+			IssueCount:  len(analysisIssues),
 			Version:     "1.0.0",
 			Files:       []string{"triage.json", "insights.json", "brief.md", "helpers.md", "meta.json"},
 		}
 		metaJSON, _ := json.MarshalIndent(meta, "", "  ")
-		if err := os.WriteFile(filepath.Join(*agentBrief, "meta.json"), metaJSON, 0644); err != nil {
+		if err := readonly.WriteFile(filepath.Join(*agentBrief, "meta.json"), metaJSON, 0644); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing meta.json: %v\n", err)
 			os.Exit(1)
 		}
@@ -2201,7 +3088,7 @@ func main() {
 
 	// Handle --emit-script flag (bv-89)
 	if *emitScript {
-		triage := analysis.ComputeTriage(issues)
+		triage := analysis.ComputeTriageWithOptions(analysisIssues, analysis.TriageOptions{Profile: &triageProfile})
 
 		// Determine script limit
 		limit := *scriptLimit
@@ -2367,6 +3254,91 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --robot-estimates: estimate vs. logged work session time
+	if *robotEstimates {
+		beadsDir, err := loader.GetBeadsDir("")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting beads directory: %v\n", err)
+			os.Exit(1)
+		}
+		timeLog, err := analysis.LoadTimeLog(beadsDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading time log: %v\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now().UTC()
+		report := analysis.ComputeEstimateReport(issues, timeLog.Actuals(now), now)
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding estimate report: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --export-quality: text lint over open issues
+	if *exportQuality != "" {
+		cwd, _ := os.Getwd()
+		driftConfig, err := drift.LoadConfig(cwd)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading drift config: %v\n", err)
+			os.Exit(1)
+		}
+		report := analysis.ComputeQualityReportWithOptions(issues, driftConfig.QualityOptions(), time.Now())
+		if *stableExport {
+			report.GeneratedAt = time.Time{}
+		}
+		if err := export.SaveQualityReport(report, *exportQuality); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing quality report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Exported %d quality findings across %d open issues to %s\n", len(report.Findings), report.Checked, *exportQuality)
+		os.Exit(0)
+	}
+
+	// Handle --export-timelog: logged work time per issue/assignee/week
+	if *exportTimeLog != "" {
+		beadsDir, err := loader.GetBeadsDir("")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting beads directory: %v\n", err)
+			os.Exit(1)
+		}
+		timeLog, err := analysis.LoadTimeLog(beadsDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading time log: %v\n", err)
+			os.Exit(1)
+		}
+
+		now := time.Now()
+		var since *time.Time
+		if *timeLogSince != "" {
+			t, err := recipe.ParseRelativeTime(*timeLogSince, now)
+			if err != nil || t.IsZero() {
+				fmt.Fprintf(os.Stderr, "Invalid --timelog-since value: %s\n", *timeLogSince)
+				os.Exit(1)
+			}
+			since = &t
+		}
+
+		report, err := analysis.ComputeTimeReport(issues, timeLog, *timeLogGroup, since, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *stableExport {
+			report.GeneratedAt = time.Time{}
+		}
+		if err := export.SaveTimeReport(report, *exportTimeLog); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing time report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Exported %.2fh of logged time (%d rows by %s) to %s\n",
+			float64(report.TotalMinutes)/60, len(report.Rows), report.GroupBy, *exportTimeLog)
+		os.Exit(0)
+	}
+
 	// Handle --robot-sprint-list and --robot-sprint-show flags (bv-156)
 	if *robotSprintList || *robotSprintShow != "" {
 		cwd, err := os.Getwd()
@@ -2493,7 +3465,7 @@ func main() {
 		}
 
 		// Build graph stats for depth calculation
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		graphStats := analyzer.Analyze()
 
 		// Filter issues by label and sprint if specified
@@ -2518,7 +3490,7 @@ func main() {
 			}
 		}
 
-		for _, iss := range issues {
+		for _, iss := range analysisIssues {
 			// Filter by label
 			if *forecastLabel != "" {
 				hasLabel := false
@@ -2570,7 +3542,7 @@ func main() {
 				if iss.Status == model.StatusClosed {
 					continue
 				}
-				eta, err := analysis.EstimateETAForIssue(issues, &graphStats, iss.ID, agents, now)
+				eta, err := analysis.EstimateETAForIssue(analysisIssues, &graphStats, iss.ID, agents, now)
 				if err != nil {
 					continue
 				}
@@ -2578,7 +3550,7 @@ func main() {
 			}
 		} else {
 			// Single issue forecast
-			eta, err := analysis.EstimateETAForIssue(issues, &graphStats, *robotForecast, agents, now)
+			eta, err := analysis.EstimateETAForIssue(analysisIssues, &graphStats, *robotForecast, agents, now)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
@@ -2644,14 +3616,14 @@ func main() {
 	// Handle --robot-capacity flag (bv-160)
 	if *robotCapacity {
 		// Build graph stats for analysis
-		analyzer := analysis.NewAnalyzer(issues)
+		analyzer := analysis.NewAnalyzer(analysisIssues)
 		graphStats := analyzer.Analyze()
 
 		// Filter issues by label if specified
 		targetIssues := issues
 		if *capacityLabel != "" {
 			filtered := make([]model.Issue, 0)
-			for _, iss := range issues {
+			for _, iss := range analysisIssues {
 				for _, l := range iss.Labels {
 					if l == *capacityLabel {
 						filtered = append(filtered, iss)
@@ -2862,101 +3834,418 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
 			os.Exit(1)
 		}
-
-		gitLoader := loader.NewGitLoader(cwd)
-
-		// Load historical issues
-		historicalIssues, err := gitLoader.LoadAt(*diffSince)
+
+		if err := loader.CheckGit(cwd); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --diff-since reads git history: %v\n", err)
+			os.Exit(1)
+		}
+		gitLoader := loader.NewGitLoader(cwd)
+
+		// Load historical issues
+		historicalIssues, err := gitLoader.LoadAt(*diffSince)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *diffSince, err)
+			os.Exit(1)
+		}
+
+		// Get revision info for timestamp
+		revision, err := gitLoader.ResolveRevision(*diffSince)
+		if err != nil {
+			revision = *diffSince
+		}
+
+		// Create snapshots
+		fromSnapshot := analysis.NewSnapshotAt(historicalIssues, time.Time{}, revision)
+		toSnapshot := analysis.NewSnapshot(issues)
+
+		// Compute diff
+		diff := analysis.CompareSnapshots(fromSnapshot, toSnapshot)
+
+		if *robotDiff {
+			// JSON output
+			output := struct {
+				GeneratedAt      string                 `json:"generated_at"`
+				ResolvedRevision string                 `json:"resolved_revision"`
+				FromDataHash     string                 `json:"from_data_hash"`
+				ToDataHash       string                 `json:"to_data_hash"`
+				Diff             *analysis.SnapshotDiff `json:"diff"`
+			}{
+				GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+				ResolvedRevision: revision,
+				FromDataHash:     analysis.ComputeDataHash(historicalIssues),
+				ToDataHash:       dataHash,
+				Diff:             diff,
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(output); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding diff: %v\n", err)
+				os.Exit(1)
+			}
+		} else {
+			// Human-readable output
+			printDiffSummary(diff, *diffSince)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --as-of flag
+	if *asOf != "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+			os.Exit(1)
+		}
+
+		if err := loader.CheckGit(cwd); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --as-of reads git history: %v\n", err)
+			os.Exit(1)
+		}
+		gitLoader := loader.NewGitLoader(cwd)
+
+		// Load historical issues
+		historicalIssues, err := gitLoader.LoadAt(*asOf)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *asOf, err)
+			os.Exit(1)
+		}
+
+		if len(historicalIssues) == 0 {
+			fmt.Printf("No issues found at %s.\n", *asOf)
+			os.Exit(0)
+		}
+
+		// Launch TUI with historical issues (no live reload for historical view)
+		m := ui.NewModel(historicalIssues, activeRecipe, "")
+		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+
+		// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
+		if v := os.Getenv("BV_TUI_AUTOCLOSE_MS"); v != "" {
+			if ms, err := strconv.Atoi(v); err == nil && ms > 0 {
+				go func() {
+					delay := time.Duration(ms) * time.Millisecond
+					time.Sleep(delay)
+					p.Send(tea.Quit)
+					// Failsafe: hard exit soon after to avoid hanging tests
+					time.Sleep(2 * time.Second)
+					os.Exit(0)
+				}()
+			}
+		}
+		if _, err := p.Run(); err != nil {
+			fmt.Printf("Error running beads viewer: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --replace: guarded search-and-replace on the beads file
+	if *replacePattern != "" {
+		if beadsPath == "" {
+			fmt.Fprintln(os.Stderr, "Error: --replace edits the local beads file; it can't be used with stdin, --workspace or imported issues")
+			os.Exit(1)
+		}
+		re, err := regexp.Compile(*replacePattern)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --replace pattern: %v\n", err)
+			os.Exit(1)
+		}
+		spec := loader.ReplaceSpec{Pattern: re, Replacement: *replaceWith}
+		if *replaceFields != "" {
+			for _, f := range strings.Split(*replaceFields, ",") {
+				if f = strings.TrimSpace(f); f != "" {
+					spec.Fields = append(spec.Fields, f)
+				}
+			}
+		}
+
+		var plan loader.ReplacePlan
+		if *replaceApply {
+			plan, err = loader.ApplyReplace(beadsPath, spec)
+		} else {
+			plan, err = loader.PlanReplace(beadsPath, spec)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Print(loader.FormatReplacePlan(plan))
+		switch {
+		case len(plan.Changes) == 0:
+		case *replaceApply:
+			fmt.Printf("Updated %s\n", beadsPath)
+		default:
+			fmt.Println("Dry run: nothing was written. Re-run with --replace-apply to apply these changes.")
+		}
+		os.Exit(0)
+	}
+
+	// Handle --merge: fold one issue into another
+	if *mergePair != "" {
+		if beadsPath == "" {
+			fmt.Fprintln(os.Stderr, "Error: --merge edits the local beads file; it can't be used with stdin, --workspace or imported issues")
+			os.Exit(1)
+		}
+		keepID, dropID, ok := strings.Cut(*mergePair, ",")
+		keepID, dropID = strings.TrimSpace(keepID), strings.TrimSpace(dropID)
+		if !ok || keepID == "" || dropID == "" {
+			fmt.Fprintln(os.Stderr, "Error: --merge expects <keep-id>,<superseded-id>")
+			os.Exit(1)
+		}
+
+		var plan loader.MergePlan
+		var err error
+		if *mergeApply {
+			plan, err = loader.ApplyMerge(beadsPath, keepID, dropID, time.Now().UTC())
+		} else {
+			plan, err = loader.PlanMerge(beadsPath, keepID, dropID, time.Now().UTC())
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Print(loader.FormatMergePlan(plan))
+		if *mergeApply {
+			fmt.Printf("Updated %s\n", beadsPath)
+		} else {
+			fmt.Println("Dry run: nothing was written. Re-run with --merge-apply to apply the merge.")
+		}
+		os.Exit(0)
+	}
+
+	// Handle --github-push / --github-dry-run: one-way push to GitHub issues
+	if *githubPush || *githubDryRun {
+		cfg, err := export.LoadGitHubIssuesConfig(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *githubRepo != "" {
+			cfg.Repo = *githubRepo
+		}
+		if err := cfg.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		pushIssues := issues
+		if *githubIDs != "" {
+			byID := make(map[string]model.Issue, len(issues))
+			for _, issue := range issues {
+				byID[issue.ID] = issue
+			}
+			pushIssues = nil
+			for _, id := range strings.Split(*githubIDs, ",") {
+				id = strings.TrimSpace(id)
+				if id == "" {
+					continue
+				}
+				issue, ok := byID[id]
+				if !ok {
+					fmt.Fprintf(os.Stderr, "Issue not found: %s\n", id)
+					os.Exit(1)
+				}
+				pushIssues = append(pushIssues, issue)
+			}
+		}
+
+		remote, err := export.FetchGitHubIssues(cfg.Repo)
+		if err != nil {
+			if !*githubDryRun {
+				fmt.Fprintf(os.Stderr, "Error reading issues from %s: %v\n", cfg.Repo, err)
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Warning: could not read existing issues (%v); previewing as if none were pushed yet\n", err)
+		}
+
+		plan := export.PlanGitHubPush(pushIssues, remote, cfg)
+		fmt.Print(export.FormatGitHubPushPlan(plan))
+		if *githubDryRun {
+			fmt.Println("Dry run: nothing was changed on GitHub.")
+			os.Exit(0)
+		}
+
+		logf := func(format string, args ...interface{}) {
+			fmt.Printf("  → "+format+"\n", args...)
+		}
+		if err := export.ApplyGitHubPush(&plan, cfg, logf); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("Done!")
+		os.Exit(0)
+	}
+
+	// Handle --publish: report to Confluence and/or Notion
+	if *publishTo != "" {
+		cfg, err := publish.LoadConfig(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		title := cfg.PageTitle(filepath.Base(projectDir))
+		if *publishTitle != "" {
+			title = *publishTitle
+		}
+		reportOpts := export.MarkdownOptions{
+			Title:       title,
+			Stable:      *stableExport,
+			TemplateDir: export.ReportTemplatesDir(projectDir),
+		}
+
+		for _, target := range strings.Split(*publishTo, ",") {
+			target = strings.ToLower(strings.TrimSpace(target))
+			var result publish.Result
+			switch target {
+			case "":
+				continue
+			case publish.TargetConfluence:
+				html, rerr := export.RenderReport(issues, true, reportOpts)
+				if rerr != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", rerr)
+					os.Exit(1)
+				}
+				result, err = publish.PublishConfluence(cfg.Confluence, title, html)
+			case publish.TargetNotion:
+				md, rerr := export.RenderReport(issues, false, reportOpts)
+				if rerr != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", rerr)
+					os.Exit(1)
+				}
+				result, err = publish.PublishNotion(cfg.Notion, title, md)
+			default:
+				fmt.Fprintf(os.Stderr, "Unknown --publish target %q (use confluence or notion)\n", target)
+				os.Exit(1)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error publishing to %s: %v\n", target, err)
+				os.Exit(1)
+			}
+			verb := "Updated"
+			if result.Created {
+				verb = "Created"
+			}
+			fmt.Printf("%s %s page %q %s\n", verb, target, title, result.URL)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --email-digest / --digest-send: HTML summary of recent activity
+	if *emailDigest != "" || *digestSend {
+		cfg, err := export.LoadDigestConfig(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if *digestDays > 0 {
+			cfg.Days = *digestDays
+		}
+		if *digestSend {
+			if err := cfg.SMTP.Validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		driftConfig, err := drift.LoadConfig(projectDir)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *diffSince, err)
+			fmt.Fprintf(os.Stderr, "Error loading drift config: %v\n", err)
 			os.Exit(1)
 		}
 
-		// Get revision info for timestamp
-		revision, err := gitLoader.ResolveRevision(*diffSince)
+		now := time.Now()
+		if *stableExport {
+			now = export.StableTime(issues)
+		}
+		project := filepath.Base(projectDir)
+		digest := export.BuildDigest(project, issues,
+			computeDriftAlerts(issues, driftConfig),
+			analysis.ComputeLabelAttentionScores(issues, analysis.DefaultLabelHealthConfig(), now),
+			computeExportTriage(issues, *stableExport, &triageProfile),
+			cfg.Days, cfg.MaxItems, now)
+		if *stableExport {
+			digest.GeneratedAt = time.Time{}
+		}
+		body, err := export.GenerateDigestHTML(digest)
 		if err != nil {
-			revision = *diffSince
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
 		}
 
-		// Create snapshots
-		fromSnapshot := analysis.NewSnapshotAt(historicalIssues, time.Time{}, revision)
-		toSnapshot := analysis.NewSnapshot(issues)
-
-		// Compute diff
-		diff := analysis.CompareSnapshots(fromSnapshot, toSnapshot)
-
-		if *robotDiff {
-			// JSON output
-			output := struct {
-				GeneratedAt      string                 `json:"generated_at"`
-				ResolvedRevision string                 `json:"resolved_revision"`
-				FromDataHash     string                 `json:"from_data_hash"`
-				ToDataHash       string                 `json:"to_data_hash"`
-				Diff             *analysis.SnapshotDiff `json:"diff"`
-			}{
-				GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
-				ResolvedRevision: revision,
-				FromDataHash:     analysis.ComputeDataHash(historicalIssues),
-				ToDataHash:       dataHash,
-				Diff:             diff,
+		if *emailDigest != "" {
+			if err := readonly.WriteFile(*emailDigest, []byte(body), 0644); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing digest: %v\n", err)
+				os.Exit(1)
 			}
-
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(output); err != nil {
-				fmt.Fprintf(os.Stderr, "Error encoding diff: %v\n", err)
+			fmt.Printf("Digest saved to %s\n", *emailDigest)
+		}
+		if *digestSend {
+			if err := export.SendDigest(cfg.SMTP, cfg.DigestSubject(project), body); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
 			}
-		} else {
-			// Human-readable output
-			printDiffSummary(diff, *diffSince)
+			fmt.Printf("Digest sent to %s\n", strings.Join(cfg.SMTP.To, ", "))
 		}
 		os.Exit(0)
 	}
 
-	// Handle --as-of flag
-	if *asOf != "" {
-		cwd, err := os.Getwd()
+	// Handle --sprint-retro: retrospective of a completed sprint
+	if *sprintRetro != "" {
+		sprints, err := loader.LoadSprints(projectDir)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error loading sprints: %v\n", err)
 			os.Exit(1)
 		}
-
-		gitLoader := loader.NewGitLoader(cwd)
-
-		// Load historical issues
-		historicalIssues, err := gitLoader.LoadAt(*asOf)
+		now := time.Now()
+		target, err := findRetroSprint(sprints, *retroSprint, now)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *asOf, err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
-		if len(historicalIssues) == 0 {
-			fmt.Printf("No issues found at %s.\n", *asOf)
-			os.Exit(0)
+		issueMap := make(map[string]model.Issue, len(issues))
+		for _, iss := range issues {
+			issueMap[iss.ID] = iss
+		}
+		// Scope changes need git; without it the sprint's current issues count as planned
+		events, err := computeSprintScopeChanges(projectDir, target, issueMap, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: scope changes unavailable: %v\n", err)
+		}
+		changes := make([]export.RetroScopeChange, 0, len(events))
+		for _, e := range events {
+			changes = append(changes, export.RetroScopeChange{Date: e.Date, IssueID: e.IssueID, Action: e.Action})
 		}
 
-		// Launch TUI with historical issues (no live reload for historical view)
-		m := ui.NewModel(historicalIssues, activeRecipe, "")
-		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+		retro := export.BuildSprintRetro(*target, sprints, issues, changes, now)
+		if *stableExport {
+			retro.GeneratedAt = time.Time{}
+		}
+		if err := export.SaveSprintRetro(retro, *sprintRetro); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing retrospective: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Retrospective of %s saved to %s\n", target.Name, *sprintRetro)
+		os.Exit(0)
+	}
 
-		// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
-		if v := os.Getenv("BV_TUI_AUTOCLOSE_MS"); v != "" {
-			if ms, err := strconv.Atoi(v); err == nil && ms > 0 {
-				go func() {
-					delay := time.Duration(ms) * time.Millisecond
-					time.Sleep(delay)
-					p.Send(tea.Quit)
-					// Failsafe: hard exit soon after to avoid hanging tests
-					time.Sleep(2 * time.Second)
-					os.Exit(0)
-				}()
-			}
+	// Handle --export-feed: Atom feed of issue changes and alerts
+	if *exportFeed != "" {
+		driftConfig, err := drift.LoadConfig(projectDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading drift config: %v\n", err)
+			os.Exit(1)
 		}
-		if _, err := p.Run(); err != nil {
-			fmt.Printf("Error running beads viewer: %v\n", err)
+		feed, err := export.GenerateAtomFeed(issues, computeDriftAlerts(issues, driftConfig), export.AtomFeedConfig{BaseURL: *feedURL})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := readonly.WriteFile(*exportFeed, feed, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing feed: %v\n", err)
 			os.Exit(1)
 		}
+		fmt.Printf("Feed saved to %s\n", *exportFeed)
 		os.Exit(0)
 	}
 
@@ -2971,9 +4260,13 @@ func main() {
 			if err := hookLoader.Load(); err != nil {
 				fmt.Printf("Warning: failed to load hooks: %v\n", err)
 			} else if hookLoader.HasHooks() {
+				format := "markdown"
+				if ext := strings.ToLower(filepath.Ext(*exportFile)); ext == ".html" || ext == ".htm" {
+					format = "html"
+				}
 				ctx := hooks.ExportContext{
 					ExportPath:   *exportFile,
-					ExportFormat: "markdown",
+					ExportFormat: format,
 					IssueCount:   len(issues),
 					Timestamp:    time.Now(),
 				}
@@ -2988,7 +4281,8 @@ func main() {
 		}
 
 		// Perform the export
-		if err := export.SaveMarkdownToFile(issues, *exportFile); err != nil {
+		reportOpts := export.MarkdownOptions{Stable: *stableExport, TemplateDir: export.ReportTemplatesDir(cwd)}
+		if err := export.SaveReportToFile(issues, *exportFile, reportOpts); err != nil {
 			fmt.Printf("Error exporting: %v\n", err)
 			os.Exit(1)
 		}
@@ -3017,14 +4311,31 @@ func main() {
 
 	// Apply recipe filters and sorting if specified
 	if activeRecipe != nil {
-		issues = applyRecipeFilters(issues, activeRecipe)
-		issues = applyRecipeSort(issues, activeRecipe)
+		filtered, err := applyRecipeFilters(issues, activeRecipe)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		issues = applyRecipeSort(filtered, activeRecipe)
 	}
 
 	// Initial Model with live reload support
 	m := ui.NewModel(issues, activeRecipe, beadsPath)
 	defer m.Stop() // Clean up file watcher
 
+	if *stableExport {
+		m.EnableStableExport()
+	}
+	if *triageProfileFlag != "" {
+		m.SetTriageProfile(triageProfile)
+	}
+	if *announce || os.Getenv("BV_ANNOUNCE") == "1" {
+		m.EnableAnnounce()
+	}
+	if issueStore != nil {
+		m.UseIssueStore(issueStore)
+	}
+
 	// Enable workspace mode if loading from workspace config
 	if workspaceInfo != nil {
 		m.EnableWorkspaceMode(ui.WorkspaceInfo{
@@ -3033,11 +4344,28 @@ func main() {
 			FailedCount:  workspaceInfo.FailedRepos,
 			TotalIssues:  workspaceInfo.TotalIssues,
 			RepoPrefixes: workspaceInfo.RepoPrefixes,
+
+			ReadOnlyPrefixes: workspaceInfo.ReadOnlyPrefixes,
+			HiddenCount:      workspaceInfo.HiddenRepos,
+
+			Files: workspaceLoader.BeadsFiles(),
+			Reload: func(repos []string) ([]model.Issue, error) {
+				reloaded, err := workspaceLoader.ReloadRepos(repos)
+				if *repoFilter != "" {
+					reloaded = filterByRepo(reloaded, *repoFilter)
+				}
+				return reloaded, err
+			},
 		})
 	}
 
 	// Run Program
-	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	programOpts := []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+	if fromStdin {
+		// stdin held the issues, so read keys from the terminal instead
+		programOpts = append(programOpts, tea.WithInputTTY())
+	}
+	p := tea.NewProgram(m, programOpts...)
 
 	// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
 	if v := os.Getenv("BV_TUI_AUTOCLOSE_MS"); v != "" {
@@ -3052,10 +4380,86 @@ func main() {
 			}()
 		}
 	}
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	if err != nil {
 		fmt.Printf("Error running beads viewer: %v\n", err)
 		os.Exit(1)
 	}
+	// Remember split ratio, view, and filter for the next run
+	if fm, ok := final.(ui.Model); ok && !readonly.Enabled() {
+		if err := fm.SaveUIState(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not save TUI state: %v\n", err)
+		}
+	}
+}
+
+// computeExportTriage computes triage for file exports. Stable exports anchor
+// it to the data's latest change and drop compute timing so that re-exporting
+// unchanged issues yields identical files.
+func computeExportTriage(issues []model.Issue, stable bool, profile *analysis.TriageProfile) analysis.TriageResult {
+	opts := analysis.TriageOptions{Profile: profile}
+	if !stable {
+		return analysis.ComputeTriageWithOptions(issues, opts)
+	}
+	triage := analysis.ComputeTriageWithOptionsAndTime(issues, opts, export.StableTime(issues))
+	triage.Meta.ComputeTimeMs = 0
+	return triage
+}
+
+// stabilizeInsights rounds insight scores to 9 decimal places and re-ranks
+// them (score desc, then ID). Iterative metrics such as HITS sum in map order,
+// which otherwise leaves last-digit noise and swapped near-ties in stable exports.
+func stabilizeInsights(insights *analysis.Insights) {
+	for _, items := range [][]analysis.InsightItem{
+		insights.Bottlenecks, insights.Keystones, insights.Influencers, insights.Hubs,
+		insights.Authorities, insights.Cores, insights.Slack,
+	} {
+		for i := range items {
+			items[i].Value = math.Round(items[i].Value*1e9) / 1e9
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Value != items[j].Value {
+				return items[i].Value > items[j].Value
+			}
+			return items[i].ID < items[j].ID
+		})
+	}
+}
+
+// computeDriftAlerts runs drift detection for the current issues against
+// themselves as baseline, which leaves only the proactive alerts (staleness,
+// blocking cascades, cycles)
+func computeDriftAlerts(issues []model.Issue, driftConfig *drift.Config) []drift.Alert {
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	openCount, closedCount, blockedCount := 0, 0, 0
+	for _, issue := range issues {
+		switch issue.Status {
+		case model.StatusClosed:
+			closedCount++
+		case model.StatusBlocked:
+			blockedCount++
+		default:
+			openCount++
+		}
+	}
+	curStats := baseline.GraphStats{
+		NodeCount:       stats.NodeCount,
+		EdgeCount:       stats.EdgeCount,
+		Density:         stats.Density,
+		OpenCount:       openCount,
+		ClosedCount:     closedCount,
+		BlockedCount:    blockedCount,
+		CycleCount:      len(stats.Cycles()),
+		ActionableCount: len(analyzer.GetActionableIssues()),
+	}
+	bl := &baseline.Baseline{Stats: curStats}
+	cur := &baseline.Baseline{Stats: curStats, Cycles: stats.Cycles()}
+
+	calc := drift.NewCalculator(bl, cur, driftConfig)
+	calc.SetIssues(issues)
+	return calc.Calculate().Alerts
 }
 
 // countEdges counts blocking dependencies for config sizing
@@ -3242,15 +4646,43 @@ func naturalLess(s1, s2 string) bool {
 	return s1 < s2
 }
 
-// applyRecipeFilters filters issues based on recipe configuration
-func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
+// resolveSelection selects issues by recipe name, or failing that by query
+// expression, for --compare-recipe
+func resolveSelection(spec string, loader *recipe.Loader, issues []model.Issue, now time.Time) (analysis.Selection, error) {
+	if r := loader.Get(spec); r != nil {
+		selected, err := applyRecipeFilters(issues, r)
+		if err != nil {
+			return analysis.Selection{}, err
+		}
+		return analysis.Selection{Name: spec, Kind: "recipe", Issues: selected}, nil
+	}
+	q, err := query.ParseAt(spec, now)
+	if err != nil {
+		return analysis.Selection{}, fmt.Errorf("%q is neither a recipe nor a valid query: %w", spec, err)
+	}
+	return analysis.Selection{Name: spec, Kind: "query", Issues: q.Filter(issues)}, nil
+}
+
+// applyRecipeFilters filters issues based on recipe configuration. A recipe
+// whose query doesn't parse is refused rather than applied without it.
+func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) ([]model.Issue, error) {
 	if r == nil {
-		return issues
+		return issues, nil
 	}
 
 	f := r.Filters
 	now := time.Now()
 
+	// Optional query expression
+	var q *query.Query
+	if f.Query != "" {
+		parsed, err := query.ParseAt(f.Query, now)
+		if err != nil {
+			return nil, fmt.Errorf("recipe %s has an invalid query: %w", r.Name, err)
+		}
+		q = parsed
+	}
+
 	// Build a set of open blocker IDs for actionable filtering
 	openBlockers := make(map[string]bool)
 	for _, issue := range issues {
@@ -3403,10 +4835,20 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			}
 		}
 
+		// Overdue and DueWithin filters
+		if !f.MatchesDue(&issue, now) {
+			continue
+		}
+
+		// Query expression filter
+		if q != nil && !q.Match(&issue) {
+			continue
+		}
+
 		result = append(result, issue)
 	}
 
-	return result
+	return result, nil
 }
 
 // applyRecipeSort sorts issues based on recipe configuration
@@ -3437,6 +4879,12 @@ func applyRecipeSort(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			less = issues[i].CreatedAt.Before(issues[j].CreatedAt)
 		case "updated":
 			less = issues[i].UpdatedAt.Before(issues[j].UpdatedAt)
+		case "due":
+			// Undated issues go last in either direction, so no flip
+			if ascending {
+				return recipe.DueLess(&issues[i], &issues[j])
+			}
+			return recipe.DueLessDesc(&issues[i], &issues[j])
 		case "title":
 			less = strings.ToLower(issues[i].Title) < strings.ToLower(issues[j].Title)
 		case "id":
@@ -3494,6 +4942,9 @@ func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOut
 	// Calculate total including load
 	totalWithLoad := loadDuration + profile.Total
 
+	// Time the TUI's own startup, running the subsystems it defers
+	subsystems := ui.ProfileStartup(issues)
+
 	if jsonOutput {
 		// JSON output
 		output := struct {
@@ -3501,6 +4952,7 @@ func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOut
 			DataPath        string                   `json:"data_path"`
 			LoadJSONL       string                   `json:"load_jsonl"`
 			Profile         *analysis.StartupProfile `json:"profile"`
+			Subsystems      []ui.StartupTiming       `json:"subsystems"`
 			TotalWithLoad   string                   `json:"total_with_load"`
 			Recommendations []string                 `json:"recommendations"`
 		}{
@@ -3508,6 +4960,7 @@ func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOut
 			DataPath:        dataPath,
 			LoadJSONL:       loadDuration.String(),
 			Profile:         profile,
+			Subsystems:      subsystems,
 			TotalWithLoad:   totalWithLoad.String(),
 			Recommendations: generateProfileRecommendations(profile, loadDuration, totalWithLoad),
 		}
@@ -3520,12 +4973,12 @@ func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOut
 		}
 	} else {
 		// Human-readable output
-		printProfileReport(profile, loadDuration, totalWithLoad)
+		printProfileReport(profile, subsystems, loadDuration, totalWithLoad)
 	}
 }
 
 // printProfileReport outputs a human-readable startup profile
-func printProfileReport(profile *analysis.StartupProfile, loadDuration, totalWithLoad time.Duration) {
+func printProfileReport(profile *analysis.StartupProfile, subsystems []ui.StartupTiming, loadDuration, totalWithLoad time.Duration) {
 	fmt.Println("Startup Profile")
 	fmt.Println("===============")
 	fmt.Printf("Data: %d issues, %d dependencies, density=%.4f\n\n",
@@ -3552,6 +5005,22 @@ func printProfileReport(profile *analysis.StartupProfile, loadDuration, totalWit
 	// Total
 	fmt.Printf("Total startup:     %v\n\n", formatDuration(totalWithLoad))
 
+	// TUI subsystems
+	if len(subsystems) > 0 {
+		fmt.Println("TUI subsystems:")
+		var blocking time.Duration
+		for _, sub := range subsystems {
+			when := ""
+			if sub.Deferred() {
+				when = " (deferred)"
+			} else {
+				blocking += sub.Duration
+			}
+			fmt.Printf("  %-14s %v%s\n", sub.Name+":", formatDuration(sub.Duration), when)
+		}
+		fmt.Printf("  %-14s %v\n\n", "First frame:", formatDuration(blocking))
+	}
+
 	// Configuration used
 	fmt.Println("Configuration:")
 	fmt.Printf("  Size tier: %s\n", getSizeTier(profile.NodeCount))
@@ -3731,67 +5200,84 @@ func filterByRepo(issues []model.Issue, repoFilter string) []model.Issue {
 	return result
 }
 
-// buildMetricItems converts a metrics map to a sorted slice of MetricItems
-func buildMetricItems(metrics map[string]float64, limit int) []baseline.MetricItem {
-	if len(metrics) == 0 {
-		return nil
-	}
+// ============================================================================
+// Static Pages Export Helpers (bv-73f)
+// ============================================================================
 
-	// Convert to slice for sorting
-	items := make([]baseline.MetricItem, 0, len(metrics))
-	for id, value := range metrics {
-		items = append(items, baseline.MetricItem{ID: id, Value: value})
-	}
+// writeStaticSite exports the SQLite database, JSON data, viewer assets, and
+// Atom feed for exportIssues into outDir. Shared by --export-pages and --bundle.
+// A zero generatedAt stamps the export with the current time. Every issue is
+// exported; the type toggles only narrow the graph analysis and triage.
+func writeStaticSite(outDir string, exportIssues []model.Issue, title, feedURL string, stable bool, generatedAt time.Time, projectDir string, typeToggles analysis.TypeToggles, triageProfile *analysis.TriageProfile) error {
+	// Build graph and compute stats
+	fmt.Println("  → Running graph analysis...")
+	analysisIssues := typeToggles.Apply(exportIssues)
+	analyzer := analysis.NewAnalyzer(analysisIssues)
+	stats := analyzer.AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
 
-	// Sort by value descending
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].Value > items[j].Value
-	})
+	// Compute triage
+	fmt.Println("  → Generating triage data...")
+	triage := computeExportTriage(analysisIssues, stable, triageProfile)
 
-	// Limit to top N
-	if len(items) > limit {
-		items = items[:limit]
+	// Extract dependencies
+	var deps []*model.Dependency
+	for i := range exportIssues {
+		issue := &exportIssues[i]
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			deps = append(deps, &model.Dependency{
+				IssueID:     issue.ID,
+				DependsOnID: dep.DependsOnID,
+				Type:        dep.Type,
+			})
+		}
 	}
 
-	return items
-}
-
-// buildAttentionReason creates a human-readable reason for attention score
-func buildAttentionReason(score analysis.LabelAttentionScore) string {
-	var parts []string
-
-	// High PageRank
-	if score.PageRankSum > 0.5 {
-		parts = append(parts, "High PageRank")
+	// Create exporter
+	issuePointers := make([]*model.Issue, len(exportIssues))
+	for i := range exportIssues {
+		issuePointers[i] = &exportIssues[i]
 	}
-
-	// Blocked issues
-	if score.BlockedCount > 0 {
-		parts = append(parts, fmt.Sprintf("%d blocked", score.BlockedCount))
+	exporter := export.NewSQLiteExporter(issuePointers, deps, stats, &triage)
+	if title != "" {
+		exporter.Config.Title = title
 	}
-
-	// Stale issues
-	if score.StaleCount > 0 {
-		parts = append(parts, fmt.Sprintf("%d stale", score.StaleCount))
+	if !generatedAt.IsZero() {
+		exporter.Config.GeneratedAt = generatedAt
 	}
 
-	// Low velocity (VelocityFactor = ClosedLast30Days + 1, so 1.0 means zero closures)
-	if score.VelocityFactor <= 1.0 {
-		parts = append(parts, "low velocity")
+	// Export SQLite database
+	fmt.Println("  → Writing database and JSON files...")
+	if err := exporter.Export(outDir); err != nil {
+		return fmt.Errorf("exporting: %w", err)
 	}
 
-	// If no specific reasons, note the open count
-	if len(parts) == 0 {
-		return fmt.Sprintf("%d open issues", score.OpenCount)
+	// Copy viewer assets
+	fmt.Println("  → Copying viewer assets...")
+	if err := copyViewerAssets(outDir, title); err != nil {
+		return fmt.Errorf("copying assets: %w", err)
 	}
 
-	return strings.Join(parts, ", ")
+	// Atom feed of changes alongside the site, linked from index.html
+	fmt.Println("  → Writing Atom feed...")
+	if driftConfig, err := drift.LoadConfig(projectDir); err != nil {
+		fmt.Printf("  → Warning: failed to load drift config: %v\n", err)
+	} else {
+		feedCfg := export.AtomFeedConfig{Title: title, BaseURL: feedURL}
+		feed, err := export.GenerateAtomFeed(exportIssues, computeDriftAlerts(exportIssues, driftConfig), feedCfg)
+		if err == nil {
+			err = readonly.WriteFile(filepath.Join(outDir, export.AtomFeedFilename), feed, 0644)
+		}
+		if err != nil {
+			fmt.Printf("  → Warning: failed to write %s: %v\n", export.AtomFeedFilename, err)
+		}
+	}
+	return nil
 }
 
-// ============================================================================
-// Static Pages Export Helpers (bv-73f)
-// ============================================================================
-
 // copyViewerAssets copies the viewer HTML/JS/CSS assets to the output directory.
 // If title is provided, it replaces the default title in index.html.
 func copyViewerAssets(outputDir, title string) error {
@@ -3883,7 +5369,7 @@ func copyFile(src, dst string) error {
 	}
 	defer srcFile.Close()
 
-	dstFile, err := os.Create(dst)
+	dstFile, err := readonly.Create(dst)
 	if err != nil {
 		return err
 	}
@@ -3904,7 +5390,7 @@ func copyFileWithTitleReplacement(src, dst, title string) error {
 	result := strings.Replace(string(content), "<title>Beads Viewer</title>", "<title>"+title+"</title>", 1)
 	result = strings.Replace(result, `<h1 class="text-xl font-semibold">Beads Viewer</h1>`, `<h1 class="text-xl font-semibold">`+title+`</h1>`, 1)
 
-	return os.WriteFile(dst, []byte(result), 0644)
+	return readonly.WriteFile(dst, []byte(result), 0644)
 }
 
 // copyDir recursively copies a directory.
@@ -3914,7 +5400,7 @@ func copyDir(src, dst string) error {
 		return err
 	}
 
-	if err := os.MkdirAll(dst, srcInfo.Mode()); err != nil {
+	if err := readonly.MkdirAll(dst, srcInfo.Mode()); err != nil {
 		return err
 	}
 
@@ -3971,6 +5457,49 @@ func runPreviewServer(dir string) error {
 	return http.ListenAndServe(fmt.Sprintf(":%d", port), nil)
 }
 
+// runWebDashboard serves the dashboard on addr (default: the first free
+// preview port on localhost) until interrupted.
+func runWebDashboard(dashboard *web.Server, addr string) error {
+	if addr == "" {
+		port, err := pages.FindAvailablePort(pages.PreviewPortRangeStart, pages.PreviewPortRangeEnd)
+		if err != nil {
+			return fmt.Errorf("could not find available port: %w", err)
+		}
+		addr = fmt.Sprintf("127.0.0.1:%d", port)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	url := "http://" + listener.Addr().String()
+	if host, port, err := net.SplitHostPort(listener.Addr().String()); err == nil && (host == "::" || host == "0.0.0.0") {
+		url = "http://localhost:" + port
+	}
+	fmt.Printf("Dashboard running at %s\n", url)
+	fmt.Println("Press Ctrl+C to stop")
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		openBrowser(url)
+	}()
+
+	server := &http.Server{Handler: dashboard.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+	go func() {
+		<-stop
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
 // openBrowser opens the default browser to the given URL.
 func openBrowser(url string) {
 	var cmd string
@@ -4000,9 +5529,10 @@ func isCommandAvailable(name string) bool {
 	return err == nil
 }
 
-// runPagesWizard runs the interactive deployment wizard (bv-10g).
-func runPagesWizard(issues []model.Issue, beadsPath string) error {
-	wizard := export.NewWizard(beadsPath)
+// runPagesWizard runs the interactive deployment wizard (bv-10g). Like
+// --export-pages, the type toggles narrow only the analysis and triage.
+func runPagesWizard(issues []model.Issue, beadsPath string, typeToggles analysis.TypeToggles, triageProfile *analysis.TriageProfile) error {
+	wizard := pages.NewWizard(beadsPath)
 
 	// Run interactive wizard to collect configuration
 	_, err := wizard.Run()
@@ -4027,7 +5557,7 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	// Create temp directory for bundle
 	bundlePath := config.OutputPath
 	if bundlePath == "" {
-		tmpDir, err := os.MkdirTemp("", "bv-pages-*")
+		tmpDir, err := readonly.MkdirTemp("", "bv-pages-*")
 		if err != nil {
 			return fmt.Errorf("failed to create temp directory: %w", err)
 		}
@@ -4035,7 +5565,7 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	}
 
 	// Ensure output directory exists
-	if err := os.MkdirAll(bundlePath, 0755); err != nil {
+	if err := readonly.MkdirAll(bundlePath, 0755); err != nil {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
@@ -4047,13 +5577,14 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 
 	// Build graph and compute stats
 	fmt.Println("  -> Running graph analysis...")
-	analyzer := analysis.NewAnalyzer(exportIssues)
+	analysisIssues := typeToggles.Apply(exportIssues)
+	analyzer := analysis.NewAnalyzer(analysisIssues)
 	stats := analyzer.AnalyzeAsync(context.Background())
 	stats.WaitForPhase2()
 
 	// Compute triage
 	fmt.Println("  -> Generating triage data...")
-	triage := analysis.ComputeTriage(exportIssues)
+	triage := analysis.ComputeTriageWithOptions(analysisIssues, analysis.TriageOptions{Profile: triageProfile})
 
 	// Extract dependencies
 	var deps []*model.Dependency
@@ -4112,7 +5643,7 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 		wizard.PrintSuccess(result)
 	} else {
 		// Local export - just show success
-		result := &export.WizardResult{
+		result := &pages.WizardResult{
 			BundlePath:   bundlePath,
 			DeployTarget: "local",
 		}
@@ -4120,7 +5651,7 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	}
 
 	// Save config for next run
-	export.SaveWizardConfig(config)
+	pages.SaveWizardConfig(config)
 
 	return nil
 }
@@ -4145,6 +5676,33 @@ type BurndownOutput struct {
 	DailyPoints       []model.BurndownPoint `json:"daily_points"`
 	IdealLine         []model.BurndownPoint `json:"ideal_line"`
 	ScopeChanges      []ScopeChangeEvent    `json:"scope_changes,omitempty"`
+	// Points or hours done and remaining, when the sprint's issues are estimated
+	Estimates *analysis.EstimateTotals `json:"estimates,omitempty"`
+}
+
+// findRetroSprint returns the sprint with the given ID, or with an empty ID
+// the sprint that ended most recently before now
+func findRetroSprint(sprints []model.Sprint, id string, now time.Time) (*model.Sprint, error) {
+	var found *model.Sprint
+	for i := range sprints {
+		s := &sprints[i]
+		if id != "" {
+			if s.ID == id {
+				return s, nil
+			}
+			continue
+		}
+		if !s.EndDate.IsZero() && s.EndDate.Before(now) && (found == nil || s.EndDate.After(found.EndDate)) {
+			found = s
+		}
+	}
+	if id != "" {
+		return nil, fmt.Errorf("sprint not found: %s", id)
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no completed sprint in %s; pick one with --retro-sprint", filepath.Join(".beads", loader.SprintsFileName))
+	}
+	return found, nil
 }
 
 // ScopeChangeEvent represents when issues were added/removed from sprint
@@ -4174,8 +5732,8 @@ func computeSprintScopeChanges(repoPath string, sprint *model.Sprint, issueMap m
 	if sprint.StartDate.IsZero() || sprint.EndDate.IsZero() {
 		return nil, nil
 	}
-	if _, err := os.Stat(filepath.Join(repoPath, ".git")); err != nil {
-		// Not a git repository (common for ad-hoc exports/tests); scope changes are optional.
+	if err := loader.CheckGit(repoPath); err != nil {
+		// No git or not a repository (common for ad-hoc exports/tests); scope changes are optional.
 		return nil, nil
 	}
 
@@ -4453,6 +6011,12 @@ func calculateBurndownAt(sprint *model.Sprint, issues []model.Issue, now time.Ti
 	// Generate ideal line
 	idealLine := generateIdealLine(sprint, totalIssues)
 
+	var estimates *analysis.EstimateTotals
+	if unit := analysis.EstimateUnitFor(sprintIssues); unit != "" {
+		totals := analysis.TotalEstimates(sprintIssues, unit)
+		estimates = &totals
+	}
+
 	return BurndownOutput{
 		GeneratedAt:       now.UTC(),
 		SprintID:          sprint.ID,
@@ -4472,6 +6036,7 @@ func calculateBurndownAt(sprint *model.Sprint, issues []model.Issue, now time.Ti
 		DailyPoints:       dailyPoints,
 		IdealLine:         idealLine,
 		ScopeChanges:      nil,
+		Estimates:         estimates,
 	}
 }
 
@@ -4726,3 +6291,52 @@ func generateHistoryForExport(issues []model.Issue) (*TimeTravelHistory, error)
 		Commits:     commits,
 	}, nil
 }
+
+// semanticIndex is the project's on-disk vector index, synced with the
+// current issues
+type semanticIndex struct {
+	Config   search.EmbeddingConfig
+	Embedder search.Embedder
+	Index    *search.VectorIndex
+	Path     string
+	Loaded   bool // Read from disk rather than built from scratch
+	Stats    search.IndexSyncStats
+}
+
+// syncSemanticIndex loads the semantic index for the working directory,
+// embeds new or changed issues and saves it if anything changed. With
+// progress set, a first build is announced on stderr.
+func syncSemanticIndex(ctx context.Context, issues []model.Issue, progress bool) (semanticIndex, error) {
+	var sem semanticIndex
+	sem.Config = search.EmbeddingConfigFromEnv()
+	embedder, err := search.NewEmbedderFromConfig(sem.Config)
+	if err != nil {
+		return sem, err
+	}
+	sem.Embedder = embedder
+
+	projectDir, err := os.Getwd()
+	if err != nil {
+		return sem, err
+	}
+	sem.Path = search.DefaultIndexPath(projectDir, sem.Config)
+	sem.Index, sem.Loaded, err = search.LoadOrNewVectorIndex(sem.Path, embedder.Dim())
+	if err != nil {
+		return sem, err
+	}
+
+	docs := search.DocumentsFromIssues(issues)
+	if progress && !sem.Loaded {
+		fmt.Fprintf(os.Stderr, "Building semantic index (%d issues)...\n", len(docs))
+	}
+	sem.Stats, err = search.SyncVectorIndex(ctx, sem.Index, embedder, docs, 64)
+	if err != nil {
+		return sem, fmt.Errorf("building semantic index: %w", err)
+	}
+	if !sem.Loaded || sem.Stats.Changed() {
+		if err := sem.Index.Save(sem.Path); err != nil {
+			return sem, fmt.Errorf("saving semantic index: %w", err)
+		}
+	}
+	return sem, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -105,14 +106,14 @@ func TestApplyRecipeFilters_ActionableAndHasBlockers(t *testing.T) {
 			Actionable: ptrBool(true),
 		},
 	}
-	actionable := applyRecipeFilters(issues, r)
+	actionable := mustApplyRecipeFilters(t, issues, r)
 	if len(actionable) != 1 || actionable[0].ID != "A" {
 		t.Fatalf("expected only A actionable, got %#v", actionable)
 	}
 
 	r.Filters.Actionable = nil
 	r.Filters.HasBlockers = ptrBool(true)
-	blocked := applyRecipeFilters(issues, r)
+	blocked := mustApplyRecipeFilters(t, issues, r)
 	if len(blocked) != 1 || blocked[0].ID != "B" {
 		t.Fatalf("expected only B when HasBlockers=true, got %#v", blocked)
 	}
@@ -130,7 +131,7 @@ func TestApplyRecipeFilters_TitleAndPrefix(t *testing.T) {
 			IDPrefix:      "API",
 		},
 	}
-	got := applyRecipeFilters(issues, r)
+	got := mustApplyRecipeFilters(t, issues, r)
 	if len(got) != 1 || got[0].ID != "API-2" {
 		t.Fatalf("expected API-2 only, got %#v", got)
 	}
@@ -151,7 +152,7 @@ func TestApplyRecipeFilters_TagsAndDates(t *testing.T) {
 			UpdatedAfter: "1d",
 		},
 	}
-	got := applyRecipeFilters(issues, r)
+	got := mustApplyRecipeFilters(t, issues, r)
 	if len(got) != 0 {
 		t.Fatalf("expected all filtered out (exclude p0 and date), got %#v", got)
 	}
@@ -171,18 +172,44 @@ func TestApplyRecipeFilters_DatesBlockersAndPrefix(t *testing.T) {
 		HasBlockers:  ptrBool(true),
 		IDPrefix:     "API-2",
 	}}
-	got := applyRecipeFilters(issues, r)
+	got := mustApplyRecipeFilters(t, issues, r)
 	if len(got) != 1 || got[0].ID != "API-2" {
 		t.Fatalf("expected only API-2 to match blockers/date/prefix filters, got %#v", got)
 	}
 
 	r.Filters.HasBlockers = ptrBool(false)
-	got = applyRecipeFilters(issues, r)
+	got = mustApplyRecipeFilters(t, issues, r)
 	if len(got) != 0 {
 		t.Fatalf("expected blockers=false to exclude API-2, got %#v", got)
 	}
 }
 
+func TestApplyRecipeFilters_Query(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Fix login", Status: model.StatusOpen, Labels: []string{"backend"}},
+		{ID: "B", Title: "Polish", Status: model.StatusOpen},
+	}
+	r := &recipe.Recipe{Name: "backend", Filters: recipe.FilterConfig{Query: "label:backend AND status:open"}}
+	got := mustApplyRecipeFilters(t, issues, r)
+	if len(got) != 1 || got[0].ID != "A" {
+		t.Fatalf("expected only A to match the query, got %#v", got)
+	}
+
+	r.Filters.Query = "label:backend AND (status:open"
+	if got, err := applyRecipeFilters(issues, r); err == nil || got != nil {
+		t.Fatalf("expected an invalid query to refuse the recipe, got %#v, %v", got, err)
+	}
+}
+
+func mustApplyRecipeFilters(t *testing.T, issues []model.Issue, r *recipe.Recipe) []model.Issue {
+	t.Helper()
+	got, err := applyRecipeFilters(issues, r)
+	if err != nil {
+		t.Fatalf("applyRecipeFilters: %v", err)
+	}
+	return got
+}
+
 func TestApplyRecipeSort_DefaultsAndFields(t *testing.T) {
 	now := time.Now()
 	issues := []model.Issue{
@@ -230,6 +257,20 @@ func TestApplyRecipeSort_DefaultsAndFields(t *testing.T) {
 		t.Fatalf("id natural sort failed: got %v", []string{sortedIDs[0].ID, sortedIDs[1].ID, sortedIDs[2].ID})
 	}
 
+	// Due dates: undated issues last in both directions
+	due := func(days int) *time.Time { d := now.AddDate(0, 0, days); return &d }
+	dueIssues := []model.Issue{{ID: "none"}, {ID: "soon", DueDate: due(1)}, {ID: "late", DueDate: due(9)}}
+	for dir, want := range map[string]string{"asc": "soon,late,none", "desc": "late,soon,none"} {
+		r.Sort = recipe.SortConfig{Field: "due", Direction: dir}
+		var got []string
+		for _, issue := range applyRecipeSort(append([]model.Issue{}, dueIssues...), r) {
+			got = append(got, issue.ID)
+		}
+		if strings.Join(got, ",") != want {
+			t.Fatalf("due %s sort = %v, want %s", dir, got, want)
+		}
+	}
+
 	// Unknown field should preserve order
 	r.Sort = recipe.SortConfig{Field: "unknown"}
 	sorted = applyRecipeSort(append([]model.Issue{}, issues...), r)
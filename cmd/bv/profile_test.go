@@ -10,6 +10,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
 )
 
 // captureStdout runs f while capturing stdout to a string.
@@ -152,26 +153,17 @@ func TestPrintProfileReport(t *testing.T) {
 		Config:       cfg,
 	}
 	out := captureStdout(t, func() {
-		printProfileReport(profile, 2*time.Millisecond, 7*time.Millisecond)
+		printProfileReport(profile, []ui.StartupTiming{
+			{Name: "analysis", Duration: time.Millisecond, Trigger: "startup"},
+			{Name: "triage", Duration: 3 * time.Millisecond, Trigger: "idle"},
+		}, 2*time.Millisecond, 7*time.Millisecond)
 	})
-	if !strings.Contains(out, "Startup Profile") || !strings.Contains(out, "PageRank") {
+	if !strings.Contains(out, "Startup Profile") || !strings.Contains(out, "PageRank") ||
+		!strings.Contains(out, "triage:") || !strings.Contains(out, "(deferred)") {
 		t.Fatalf("printProfileReport missing expected text")
 	}
 }
 
-func TestBuildMetricItems(t *testing.T) {
-	items := buildMetricItems(map[string]float64{"A": 3, "B": 5, "C": 1}, 2)
-	if len(items) != 2 {
-		t.Fatalf("expected top 2 items, got %d", len(items))
-	}
-	if items[0].ID != "B" || items[1].ID != "A" {
-		t.Fatalf("items not sorted desc: %+v", items)
-	}
-	if buildMetricItems(nil, 3) != nil {
-		t.Fatalf("nil metrics should return nil")
-	}
-}
-
 func TestRepeatChar(t *testing.T) {
 	if got := repeatChar('x', 4); got != "xxxx" {
 		t.Fatalf("repeatChar mismatch: %q", got)
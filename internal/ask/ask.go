@@ -0,0 +1,372 @@
+// Package ask answers natural-language questions about the backlog with an
+// external LLM command, grounded in bv's own analysis.
+package ask
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// EnvCommand names the shell command that answers questions. It receives the
+// prompt on stdin and prints the answer, e.g. `llm -m gpt-4o` or `claude -p`.
+const EnvCommand = "BV_ASK_COMMAND"
+
+// DefaultTimeout bounds how long a single answer may take
+const DefaultTimeout = 2 * time.Minute
+
+// Prompt section caps, so large backlogs still fit a model's context
+const (
+	maxBlockers     = 10
+	maxCriticalPath = 10
+	maxCatalog      = 200
+	maxFlowPairs    = 8
+)
+
+// CommandFromEnv returns the configured answer command, or "" when Q&A is off
+func CommandFromEnv() string {
+	return strings.TrimSpace(os.Getenv(EnvCommand))
+}
+
+// RunCommand pipes prompt to command through the shell and returns what it
+// printed. Stderr is included in the error when the command fails.
+func RunCommand(ctx context.Context, command, prompt string) (string, error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	cmd := exec.CommandContext(ctx, shell, flag, command)
+	cmd.Stdin = strings.NewReader(prompt)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("%s timed out", EnvCommand)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+	answer := strings.TrimSpace(stdout.String())
+	if answer == "" {
+		return "", fmt.Errorf("%s printed no answer", EnvCommand)
+	}
+	return answer, nil
+}
+
+// BuildPrompt grounds question in the backlog: the blockers to clear, the
+// critical path, label flow for labels the question names, and a catalog of
+// open issues. The model is told to answer only from these facts and to
+// cite issue IDs in square brackets.
+func BuildPrompt(question string, issues []model.Issue) string {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	known := make(map[string]bool, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+		known[issues[i].ID] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("You are answering a question about a software project's issue backlog.\n")
+	b.WriteString("Answer only from the facts below; if they don't answer the question, say so.\n")
+	b.WriteString("Cite every issue you mention by its ID in square brackets, e.g. [" + exampleID(issues) + "].\n")
+	b.WriteString("Keep the answer short and plain text.\n\n")
+	fmt.Fprintf(&b, "Question: %s\n", strings.TrimSpace(question))
+
+	mentioned := Citations(question, known)
+	if len(mentioned) > 0 {
+		b.WriteString("\n## Issues named in the question\n")
+		for _, id := range mentioned {
+			writeIssueDetail(&b, issueMap[id])
+		}
+	}
+
+	labels := mentionedLabels(question, issues)
+	if len(labels) > 0 {
+		flow := analysis.ComputeCrossLabelFlow(issues, analysis.DefaultLabelHealthConfig())
+		b.WriteString("\n## Labels named in the question\n")
+		for _, label := range labels {
+			writeLabelSummary(&b, label, issues, issueMap, flow)
+		}
+	}
+
+	triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{BlockerN: maxBlockers, WaitForPhase2: true})
+	if len(triage.BlockersToClear) > 0 {
+		b.WriteString("\n## Blockers to clear (most issues unblocked first)\n")
+		for _, blocker := range triage.BlockersToClear {
+			fmt.Fprintf(&b, "- [%s] %s: unblocks %d (%s)", blocker.ID, blocker.Title, blocker.UnblocksCount, strings.Join(blocker.UnblocksIDs, ", "))
+			if blocker.Actionable {
+				b.WriteString("; ready to work on")
+			} else if len(blocker.BlockedBy) > 0 {
+				fmt.Fprintf(&b, "; itself blocked by %s", strings.Join(blocker.BlockedBy, ", "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	if path := criticalPath(stats.CriticalPathScore(), issueMap); len(path) > 0 {
+		b.WriteString("\n## Critical path (longest dependency chains first)\n")
+		for _, id := range path {
+			fmt.Fprintf(&b, "- [%s] %s (depth %.0f)\n", id, issueMap[id].Title, stats.GetCriticalPathScore(id))
+		}
+	}
+
+	if len(triage.Recommendations) > 0 {
+		b.WriteString("\n## Recommended next work\n")
+		for _, rec := range triage.Recommendations {
+			fmt.Fprintf(&b, "- [%s] %s", rec.ID, rec.Title)
+			if len(rec.Reasons) > 0 {
+				fmt.Fprintf(&b, ": %s", strings.Join(rec.Reasons, "; "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n## Open issues\n")
+	catalog := openCatalog(issues, labels)
+	for _, issue := range catalog {
+		writeIssueLine(&b, issue, issueMap)
+	}
+	if open := countOpen(issues); open > len(catalog) {
+		fmt.Fprintf(&b, "(%d more open issues not listed)\n", open-len(catalog))
+	}
+	return b.String()
+}
+
+// Citations returns the known issue IDs that text mentions, in order of
+// first mention. IDs may be bare or wrapped in brackets or punctuation.
+func Citations(text string, known map[string]bool) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, tok := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != '.'
+	}) {
+		tok = strings.TrimRight(tok, ".-_")
+		if known[tok] && !seen[tok] {
+			seen[tok] = true
+			ids = append(ids, tok)
+		}
+	}
+	return ids
+}
+
+// exampleID picks a real ID for the citation example, so the model copies
+// the project's ID format
+func exampleID(issues []model.Issue) string {
+	if len(issues) > 0 {
+		return issues[0].ID
+	}
+	return "bv-12"
+}
+
+// mentionedLabels returns the labels the question names as whole words,
+// sorted by name
+func mentionedLabels(question string, issues []model.Issue) []string {
+	lower := strings.ToLower(question)
+	found := make(map[string]bool)
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			if !found[label] && containsWord(lower, strings.ToLower(label)) {
+				found[label] = true
+			}
+		}
+	}
+	labels := make([]string, 0, len(found))
+	for label := range found {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// containsWord reports whether word occurs in s with no letter or digit
+// directly before or after it
+func containsWord(s, word string) bool {
+	if word == "" {
+		return false
+	}
+	for start := 0; ; {
+		i := strings.Index(s[start:], word)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(word)
+		if (i == 0 || !isWordByte(s[i-1])) && (end == len(s) || !isWordByte(s[end])) {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// writeLabelSummary lists a label's open and blocked issues and the labels
+// blocking it or blocked by it
+func writeLabelSummary(b *strings.Builder, label string, issues []model.Issue, issueMap map[string]*model.Issue, flow analysis.CrossLabelFlow) {
+	var open, blocked []string
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed || !hasLabel(issue, label) {
+			continue
+		}
+		open = append(open, issue.ID)
+		if len(openBlockers(issue, issueMap)) > 0 || issue.Status == model.StatusBlocked {
+			blocked = append(blocked, issue.ID)
+		}
+	}
+	fmt.Fprintf(b, "- %s: %d open, %d blocked", label, len(open), len(blocked))
+	if len(blocked) > 0 {
+		fmt.Fprintf(b, " (blocked: %s)", strings.Join(blocked, ", "))
+	}
+	b.WriteString("\n")
+	for _, dep := range flow.Dependencies {
+		var direction string
+		switch label {
+		case dep.ToLabel:
+			direction = fmt.Sprintf("  blocked by label %s", dep.FromLabel)
+		case dep.FromLabel:
+			direction = fmt.Sprintf("  blocks label %s", dep.ToLabel)
+		default:
+			continue
+		}
+		pairs := make([]string, 0, min(len(dep.BlockingPairs), maxFlowPairs))
+		for i, pair := range dep.BlockingPairs {
+			if i == maxFlowPairs {
+				pairs = append(pairs, "…")
+				break
+			}
+			pairs = append(pairs, pair.BlockerID+" blocks "+pair.BlockedID)
+		}
+		fmt.Fprintf(b, "%s: %s\n", direction, strings.Join(pairs, ", "))
+	}
+}
+
+// criticalPath returns the open issues with the highest critical-path
+// scores, deepest first (ties by ID)
+func criticalPath(scores map[string]float64, issueMap map[string]*model.Issue) []string {
+	var ids []string
+	for id, score := range scores {
+		if issue := issueMap[id]; issue != nil && issue.Status != model.StatusClosed && score > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > maxCriticalPath {
+		ids = ids[:maxCriticalPath]
+	}
+	return ids
+}
+
+// openCatalog returns the open issues to list, those with a named label
+// first, then by priority and ID, capped at maxCatalog
+func openCatalog(issues []model.Issue, labels []string) []*model.Issue {
+	inLabels := func(issue *model.Issue) bool {
+		for _, label := range labels {
+			if hasLabel(issue, label) {
+				return true
+			}
+		}
+		return false
+	}
+	var open []*model.Issue
+	for i := range issues {
+		if issues[i].Status != model.StatusClosed {
+			open = append(open, &issues[i])
+		}
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		if li, lj := inLabels(open[i]), inLabels(open[j]); li != lj {
+			return li
+		}
+		if open[i].Priority != open[j].Priority {
+			return open[i].Priority < open[j].Priority
+		}
+		return open[i].ID < open[j].ID
+	})
+	if len(open) > maxCatalog {
+		open = open[:maxCatalog]
+	}
+	return open
+}
+
+func countOpen(issues []model.Issue) int {
+	n := 0
+	for i := range issues {
+		if issues[i].Status != model.StatusClosed {
+			n++
+		}
+	}
+	return n
+}
+
+// writeIssueLine writes one catalog line: ID, status, priority, type, title,
+// labels, and open blockers
+func writeIssueLine(b *strings.Builder, issue *model.Issue, issueMap map[string]*model.Issue) {
+	fmt.Fprintf(b, "- [%s] %s P%d %s: %s", issue.ID, issue.Status, issue.Priority, issue.IssueType, issue.Title)
+	if len(issue.Labels) > 0 {
+		fmt.Fprintf(b, "; labels: %s", strings.Join(issue.Labels, ", "))
+	}
+	if blockers := openBlockers(issue, issueMap); len(blockers) > 0 {
+		fmt.Fprintf(b, "; blocked by: %s", strings.Join(blockers, ", "))
+	}
+	if issue.Assignee != "" {
+		fmt.Fprintf(b, "; assignee: %s", issue.Assignee)
+	}
+	b.WriteString("\n")
+}
+
+// writeIssueDetail writes an issue's catalog line followed by its description
+func writeIssueDetail(b *strings.Builder, issue *model.Issue) {
+	writeIssueLine(b, issue, nil)
+	if desc := strings.TrimSpace(issue.Description); desc != "" {
+		b.WriteString("  " + strings.ReplaceAll(desc, "\n", "\n  ") + "\n")
+	}
+}
+
+// openBlockers returns the IDs of issues blocking issue that aren't closed.
+// A nil issueMap reports every blocking dependency.
+func openBlockers(issue *model.Issue, issueMap map[string]*model.Issue) []string {
+	var ids []string
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if issueMap != nil {
+			if blocker := issueMap[dep.DependsOnID]; blocker == nil || blocker.Status == model.StatusClosed {
+				continue
+			}
+		}
+		ids = append(ids, dep.DependsOnID)
+	}
+	return ids
+}
+
+func hasLabel(issue *model.Issue, label string) bool {
+	for _, l := range issue.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
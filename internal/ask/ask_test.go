@@ -0,0 +1,86 @@
+package ask
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func blocks(id string) []*model.Dependency {
+	return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+}
+
+func TestBuildPrompt(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema migration", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1, Labels: []string{"backend"}},
+		{ID: "bv-2", Title: "Ship 1.0", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 0, Labels: []string{"release"}, Dependencies: blocks("bv-1")},
+		{ID: "bv-3", Title: "Changelog", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2, Labels: []string{"release"}, Dependencies: blocks("bv-2")},
+		{ID: "bv-4", Title: "Old work", Status: model.StatusClosed, IssueType: model.TypeTask, Priority: 2},
+	}
+	prompt := BuildPrompt("What's blocking the release label? See bv-2.", issues)
+
+	for _, want := range []string{
+		"Question: What's blocking the release label? See bv-2.",
+		"e.g. [bv-1]",
+		"## Issues named in the question\n- [bv-2]",
+		"- release: 2 open, 2 blocked (blocked: bv-2, bv-3)",
+		"blocked by label backend: bv-1 blocks bv-2",
+		"## Blockers to clear",
+		"- [bv-1] Schema migration: unblocks",
+		"## Critical path",
+		"- [bv-2] open P0 task: Ship 1.0; labels: release; blocked by: bv-1",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected the prompt to contain %q\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "Old work") {
+		t.Error("Closed issues should not be listed")
+	}
+	// Issues with a named label come first in the catalog
+	catalog := prompt[strings.Index(prompt, "## Open issues"):]
+	if strings.Index(catalog, "[bv-2]") > strings.Index(catalog, "[bv-1]") {
+		t.Errorf("Expected release issues before others in the catalog:\n%s", catalog)
+	}
+}
+
+func TestCitations(t *testing.T) {
+	known := map[string]bool{"bv-1": true, "bv-12": true, "bv-1.2": true}
+	got := Citations("Start with [bv-12], then bv-1. (bv-1.2) and bv-1 again; bv-99 is unknown.", known)
+	if want := []string{"bv-12", "bv-1", "bv-1.2"}; !slices.Equal(got, want) {
+		t.Errorf("Citations = %v, want %v", got, want)
+	}
+}
+
+func TestContainsWord(t *testing.T) {
+	cases := []struct {
+		s, word string
+		want    bool
+	}{
+		{"what blocks release?", "release", true},
+		{"prerelease work", "release", false},
+		{"the release-1 label", "release-1", true},
+		{"ui/ux polish", "ui", true},
+	}
+	for _, c := range cases {
+		if got := containsWord(c.s, c.word); got != c.want {
+			t.Errorf("containsWord(%q, %q) = %v, want %v", c.s, c.word, got, c.want)
+		}
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	answer, err := RunCommand(context.Background(), "tr a-z A-Z", "blocked by bv-1\n")
+	if err != nil || answer != "BLOCKED BY BV-1" {
+		t.Errorf("Expected the prompt piped through the command, got %q, %v", answer, err)
+	}
+	if _, err := RunCommand(context.Background(), "echo nope >&2; exit 3", "x"); err == nil || !strings.Contains(err.Error(), "nope") {
+		t.Errorf("Expected stderr in the error, got %v", err)
+	}
+	if _, err := RunCommand(context.Background(), "true", "x"); err == nil {
+		t.Error("Expected an error for an empty answer")
+	}
+}
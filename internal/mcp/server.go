@@ -0,0 +1,142 @@
+// Package mcp serves bv's analysis to coding agents over the Model Context
+// Protocol: newline-delimited JSON-RPC 2.0 on stdin and stdout. Agents call
+// tools such as next_work ("what should I work on next") instead of parsing
+// robot-mode output.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// ProtocolVersion is the MCP revision the server speaks
+const ProtocolVersion = "2024-11-05"
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// maxMessageSize bounds a single request line
+const maxMessageSize = 4 << 20
+
+// Server answers MCP requests about the issues analyzed by live
+type Server struct {
+	live    *analysis.LiveAnalysis
+	version string
+}
+
+// NewServer returns a server that asks live for the current analysis before
+// each tool call, so answers follow edits to the beads file. version is
+// reported to clients.
+func NewServer(live *analysis.LiveAnalysis, version string) *Server {
+	return &Server{live: live, version: version}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve reads requests from r and writes responses to w until r is
+// exhausted or ctx is done. Notifications get no response.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if resp := s.handle(line); resp != nil {
+			if err := enc.Encode(resp); err != nil {
+				return fmt.Errorf("writing response: %w", err)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// handle answers one message, or returns nil for a notification
+func (s *Server) handle(line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), codeParseError, "parse error: "+err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if len(req.ID) == 0 {
+			return nil
+		}
+		return errorResponse(req.ID, codeInvalidRequest, "invalid request")
+	}
+	if len(req.ID) == 0 {
+		// notifications/initialized and friends need no answer
+		return nil
+	}
+
+	var result any
+	var rerr *rpcError
+	switch req.Method {
+	case "initialize":
+		result = s.initialize()
+	case "ping":
+		result = struct{}{}
+	case "tools/list":
+		result = map[string]any{"tools": toolList()}
+	case "tools/call":
+		result, rerr = s.callTool(req.Params)
+	default:
+		rerr = &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+	if rerr != nil {
+		return &response{JSONRPC: "2.0", ID: req.ID, Error: rerr}
+	}
+	return &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, msg string) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: msg}}
+}
+
+func (s *Server) initialize() map[string]any {
+	return map[string]any{
+		"protocolVersion": ProtocolVersion,
+		"capabilities": map[string]any{
+			"tools": map[string]any{},
+		},
+		"serverInfo": map[string]any{
+			"name":    "bv",
+			"version": s.version,
+		},
+		"instructions": "Query the beads issue tracker of this project. Call next_work to pick a task, " +
+			"triage for the full ranked backlog, get_issue before starting one, and graph_metrics or " +
+			"label_health for planning.",
+	}
+}
@@ -0,0 +1,165 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func testIssues() []model.Issue {
+	return []model.Issue{
+		{ID: "api", Title: "Design the API", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"backend"}},
+		{ID: "client", Title: "Write the client", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"frontend"},
+			Dependencies: []*model.Dependency{{IssueID: "client", DependsOnID: "api", Type: model.DepBlocks}}},
+		{ID: "docs", Title: "Document the client", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeTask},
+	}
+}
+
+// roundTrip sends each message to a server over the issues and decodes the
+// responses
+func roundTrip(t *testing.T, issues []model.Issue, messages ...string) []map[string]any {
+	t.Helper()
+	s := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) { return issues, nil }, analysis.TypeToggles{}, nil), "test")
+	var out bytes.Buffer
+	if err := s.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out); err != nil {
+		t.Fatalf("Serve: %v", err)
+	}
+	var responses []map[string]any
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var resp map[string]any
+		if err := dec.Decode(&resp); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// toolText returns the text content of a tools/call response
+func toolText(t *testing.T, resp map[string]any) (string, bool) {
+	t.Helper()
+	result, ok := resp["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected a result, got %v", resp)
+	}
+	content := result["content"].([]any)
+	isError, _ := result["isError"].(bool)
+	return content[0].(map[string]any)["text"].(string), isError
+}
+
+func TestServe_HandshakeAndToolList(t *testing.T) {
+	responses := roundTrip(t, testIssues(),
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"agent","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"resources/list"}`,
+		`not json`,
+	)
+	if len(responses) != 4 {
+		t.Fatalf("expected 4 responses (the notification gets none), got %d: %v", len(responses), responses)
+	}
+
+	init := responses[0]["result"].(map[string]any)
+	if init["protocolVersion"] != ProtocolVersion {
+		t.Errorf("protocolVersion = %v", init["protocolVersion"])
+	}
+	if _, ok := init["capabilities"].(map[string]any)["tools"]; !ok {
+		t.Error("expected the tools capability")
+	}
+
+	var names []string
+	for _, tl := range responses[1]["result"].(map[string]any)["tools"].([]any) {
+		names = append(names, tl.(map[string]any)["name"].(string))
+	}
+	if got := strings.Join(names, ","); got != "next_work,triage,list_issues,get_issue,graph_metrics,label_health" {
+		t.Errorf("tools = %s", got)
+	}
+
+	if code := responses[2]["error"].(map[string]any)["code"].(float64); code != codeMethodNotFound {
+		t.Errorf("unknown method code = %v", code)
+	}
+	if code := responses[3]["error"].(map[string]any)["code"].(float64); code != codeParseError {
+		t.Errorf("bad JSON code = %v", code)
+	}
+}
+
+func TestTools_NextWorkAndGetIssue(t *testing.T) {
+	responses := roundTrip(t, testIssues(),
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"next_work"}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"get_issue","arguments":{"id":"client"}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"get_issue","arguments":{"id":"nope"}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"list_issues","arguments":{"label":"frontend"}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"bogus"}}`,
+	)
+
+	text, isError := toolText(t, responses[0])
+	var next struct {
+		TopPicks []struct {
+			ID           string `json:"id"`
+			ClaimCommand string `json:"claim_command"`
+		} `json:"top_picks"`
+	}
+	if err := json.Unmarshal([]byte(text), &next); err != nil || isError {
+		t.Fatalf("next_work: %v %s", err, text)
+	}
+	if len(next.TopPicks) == 0 || next.TopPicks[0].ID != "api" || !strings.Contains(next.TopPicks[0].ClaimCommand, "bd update api") {
+		t.Errorf("expected api as the top pick, got %+v", next.TopPicks)
+	}
+
+	text, _ = toolText(t, responses[1])
+	var issue struct {
+		BlockedBy []string           `json:"blocked_by"`
+		Metrics   map[string]float64 `json:"metrics"`
+	}
+	if err := json.Unmarshal([]byte(text), &issue); err != nil {
+		t.Fatalf("get_issue: %v", err)
+	}
+	if len(issue.BlockedBy) != 1 || issue.BlockedBy[0] != "api" {
+		t.Errorf("blocked_by = %v, want [api]", issue.BlockedBy)
+	}
+	if _, ok := issue.Metrics["pagerank"]; !ok {
+		t.Error("expected graph metrics for the issue")
+	}
+
+	if text, isError := toolText(t, responses[2]); !isError || !strings.Contains(text, `no issue "nope"`) {
+		t.Errorf("expected a tool error for an unknown ID, got %q", text)
+	}
+
+	if text, _ := toolText(t, responses[3]); !strings.Contains(text, `"total": 1`) || !strings.Contains(text, `"client"`) {
+		t.Errorf("list_issues by label = %s", text)
+	}
+
+	if code := responses[4]["error"].(map[string]any)["code"].(float64); code != codeInvalidParams {
+		t.Errorf("unknown tool code = %v", code)
+	}
+}
+
+func TestTools_ReportsLoadFailure(t *testing.T) {
+	issues := testIssues()
+	loads := 0
+	s := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) {
+		loads++
+		if loads > 1 {
+			return nil, errors.New("beads file vanished")
+		}
+		return issues, nil
+	}, analysis.TypeToggles{}, nil), "test")
+
+	if _, rerr := s.callTool(json.RawMessage(`{"name":"triage"}`)); rerr != nil {
+		t.Fatal(rerr)
+	}
+	result, rerr := s.callTool(json.RawMessage(`{"name":"triage"}`))
+	if rerr != nil {
+		t.Fatal(rerr)
+	}
+	if result.(map[string]any)["isError"] != true {
+		t.Error("expected a load failure to be reported as a tool error")
+	}
+}
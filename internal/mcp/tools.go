@@ -0,0 +1,414 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Default and maximum result counts for tools that take a limit
+const (
+	defaultListLimit   = 50
+	defaultMetricLimit = 10
+	maxLimit           = 500
+)
+
+// tool describes one callable tool; run computes its structured result
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+
+	run func(snap *analysis.LiveSnapshot, args toolArgs) (any, error)
+}
+
+// toolArgs holds every argument any tool accepts; each tool reads its own
+type toolArgs struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Label  string `json:"label"`
+	Query  string `json:"query"`
+	Limit  int    `json:"limit"`
+}
+
+func (a toolArgs) limit(def int) int {
+	if a.Limit <= 0 {
+		return def
+	}
+	return min(a.Limit, maxLimit)
+}
+
+func schema(props map[string]any, required ...string) map[string]any {
+	s := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+var (
+	limitProp = map[string]any{"type": "integer", "description": "Maximum number of results"}
+	labelProp = map[string]any{"type": "string", "description": "Only issues with this label (exact match)"}
+)
+
+var tools = []tool{
+	{
+		Name:        "next_work",
+		Description: "What to work on next: the top-ranked actionable issues with the reasons they rank high, plus the blockers whose completion unblocks the most work.",
+		InputSchema: schema(map[string]any{"limit": limitProp}),
+		run:         nextWork,
+	},
+	{
+		Name:        "triage",
+		Description: "Full triage: ranked recommendations with score breakdowns, quick wins, blockers to clear and project health. Same data as `bv --robot-triage`.",
+		InputSchema: schema(map[string]any{"label": labelProp, "limit": limitProp}),
+		run:         triage,
+	},
+	{
+		Name:        "list_issues",
+		Description: "List issues, optionally filtered by status, label or a case-insensitive substring of the ID or title.",
+		InputSchema: schema(map[string]any{
+			"status": map[string]any{"type": "string", "description": "open, in_progress, blocked or closed"},
+			"label":  labelProp,
+			"query":  map[string]any{"type": "string", "description": "Substring of the ID or title"},
+			"limit":  limitProp,
+		}),
+		run: listIssues,
+	},
+	{
+		Name:        "get_issue",
+		Description: "One issue in full, with its graph metrics, the open issues blocking it and the issues it blocks.",
+		InputSchema: schema(map[string]any{"id": map[string]any{"type": "string", "description": "Issue ID"}}, "id"),
+		run:         getIssue,
+	},
+	{
+		Name:        "graph_metrics",
+		Description: "Dependency graph metrics: top bottlenecks (betweenness), keystones (critical path), influencers (PageRank), hubs, authorities, articulation points and cycles.",
+		InputSchema: schema(map[string]any{"limit": limitProp}),
+		run:         graphMetrics,
+	},
+	{
+		Name:        "label_health",
+		Description: "Health of each label (velocity, staleness, blocked work) and the labels needing attention, or one label in detail.",
+		InputSchema: schema(map[string]any{"label": map[string]any{"type": "string", "description": "Show only this label in detail"}}),
+		run:         labelHealth,
+	},
+}
+
+func toolList() []tool {
+	return tools
+}
+
+type callParams struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// callTool runs a tool. Failures of the tool itself (an unknown issue ID,
+// unreadable beads file) are reported in the result, as MCP asks, so the
+// agent sees them; malformed calls are protocol errors.
+func (s *Server) callTool(raw json.RawMessage) (any, *rpcError) {
+	var params callParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	var t *tool
+	for i := range tools {
+		if tools[i].Name == params.Name {
+			t = &tools[i]
+			break
+		}
+	}
+	if t == nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + params.Name}
+	}
+	var args toolArgs
+	if len(params.Arguments) > 0 && string(params.Arguments) != "null" {
+		if err := json.Unmarshal(params.Arguments, &args); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "invalid arguments: " + err.Error()}
+		}
+	}
+
+	snap, err := s.live.Current()
+	if err != nil {
+		return toolError(fmt.Errorf("loading issues: %w", err)), nil
+	}
+	result, err := t.run(snap, args)
+	if err != nil {
+		return toolError(err), nil
+	}
+	text, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return toolError(err), nil
+	}
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": string(text)}},
+	}, nil
+}
+
+func toolError(err error) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": err.Error()}},
+		"isError": true,
+	}
+}
+
+// nextPick is a top pick with the commands to act on it
+type nextPick struct {
+	analysis.TopPick
+	ClaimCommand string `json:"claim_command"`
+	ShowCommand  string `json:"show_command"`
+}
+
+func nextWork(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	triage := snap.Triage
+	limit := args.limit(3)
+
+	picks := make([]nextPick, 0, limit)
+	for _, rec := range triage.Recommendations {
+		if len(picks) == limit {
+			break
+		}
+		picks = append(picks, nextPick{
+			TopPick: analysis.TopPick{
+				ID:       rec.ID,
+				Title:    rec.Title,
+				Score:    rec.Score,
+				Reasons:  rec.Reasons,
+				Unblocks: len(rec.UnblocksIDs),
+			},
+			ClaimCommand: fmt.Sprintf("bd update %s --status=in_progress", rec.ID),
+			ShowCommand:  fmt.Sprintf("bd show %s", rec.ID),
+		})
+	}
+	blockers := triage.BlockersToClear
+	if len(blockers) > limit {
+		blockers = blockers[:limit]
+	}
+
+	out := struct {
+		OpenCount       int                    `json:"open_count"`
+		ActionableCount int                    `json:"actionable_count"`
+		InProgressCount int                    `json:"in_progress_count"`
+		TopPicks        []nextPick             `json:"top_picks"`
+		BlockersToClear []analysis.BlockerItem `json:"blockers_to_clear"`
+		Message         string                 `json:"message,omitempty"`
+	}{
+		OpenCount:       triage.QuickRef.OpenCount,
+		ActionableCount: triage.QuickRef.ActionableCount,
+		InProgressCount: triage.QuickRef.InProgressCount,
+		TopPicks:        picks,
+		BlockersToClear: blockers,
+	}
+	if len(picks) == 0 {
+		out.Message = "No actionable items available"
+	}
+	return out, nil
+}
+
+func triage(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	result := *snap.Triage
+	if args.Label != "" {
+		var recs []analysis.Recommendation
+		for _, rec := range result.Recommendations {
+			if slices.Contains(rec.Labels, args.Label) {
+				recs = append(recs, rec)
+			}
+		}
+		result.Recommendations = recs
+	}
+	if limit := args.limit(0); limit > 0 && len(result.Recommendations) > limit {
+		result.Recommendations = result.Recommendations[:limit]
+	}
+	return result, nil
+}
+
+// issueSummary is the compact form of an issue in lists
+type issueSummary struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	Status   string   `json:"status"`
+	Priority int      `json:"priority"`
+	Type     string   `json:"type"`
+	Labels   []string `json:"labels,omitempty"`
+	Assignee string   `json:"assignee,omitempty"`
+}
+
+func summarize(issue model.Issue) issueSummary {
+	return issueSummary{
+		ID:       issue.ID,
+		Title:    issue.Title,
+		Status:   string(issue.Status),
+		Priority: issue.Priority,
+		Type:     string(issue.IssueType),
+		Labels:   issue.Labels,
+		Assignee: issue.Assignee,
+	}
+}
+
+func listIssues(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	query := strings.ToLower(args.Query)
+	var matched []issueSummary
+	for _, issue := range snap.Issues {
+		if args.Status != "" && string(issue.Status) != args.Status {
+			continue
+		}
+		if args.Label != "" && !slices.Contains(issue.Labels, args.Label) {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(issue.ID), query) &&
+			!strings.Contains(strings.ToLower(issue.Title), query) {
+			continue
+		}
+		matched = append(matched, summarize(issue))
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Priority != matched[j].Priority {
+			return matched[i].Priority < matched[j].Priority
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	total := len(matched)
+	if limit := args.limit(defaultListLimit); len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return struct {
+		Total  int            `json:"total"`
+		Issues []issueSummary `json:"issues"`
+	}{Total: total, Issues: matched}, nil
+}
+
+func getIssue(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	if args.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	byID := make(map[string]*model.Issue, len(snap.Issues))
+	for i := range snap.Issues {
+		byID[snap.Issues[i].ID] = &snap.Issues[i]
+	}
+	issue, ok := byID[args.ID]
+	if !ok {
+		return nil, fmt.Errorf("no issue %q", args.ID)
+	}
+
+	var blockedBy, blocks []string
+	for _, dep := range issue.Dependencies {
+		if dep == nil || dep.Type != model.DepBlocks {
+			continue
+		}
+		if blocker, ok := byID[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+			blockedBy = append(blockedBy, dep.DependsOnID)
+		}
+	}
+	for _, other := range snap.Issues {
+		for _, dep := range other.Dependencies {
+			if dep != nil && dep.Type == model.DepBlocks && dep.DependsOnID == issue.ID {
+				blocks = append(blocks, other.ID)
+			}
+		}
+	}
+	sort.Strings(blocks)
+
+	stats := snap.Stats
+	return struct {
+		Issue     *model.Issue       `json:"issue"`
+		BlockedBy []string           `json:"blocked_by"`
+		Blocks    []string           `json:"blocks"`
+		Metrics   map[string]float64 `json:"metrics"`
+	}{
+		Issue:     issue,
+		BlockedBy: blockedBy,
+		Blocks:    blocks,
+		Metrics: map[string]float64{
+			"pagerank":      stats.GetPageRankScore(issue.ID),
+			"betweenness":   stats.GetBetweennessScore(issue.ID),
+			"eigenvector":   stats.GetEigenvectorScore(issue.ID),
+			"hub":           stats.GetHubScore(issue.ID),
+			"authority":     stats.GetAuthorityScore(issue.ID),
+			"critical_path": stats.GetCriticalPathScore(issue.ID),
+		},
+	}, nil
+}
+
+// metricEntry is one issue's value of a graph metric
+type metricEntry struct {
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	Value float64 `json:"value"`
+}
+
+func graphMetrics(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	limit := args.limit(defaultMetricLimit)
+	insights := snap.Stats.GenerateInsights(limit)
+	titles := make(map[string]string, len(snap.Issues))
+	for _, issue := range snap.Issues {
+		titles[issue.ID] = issue.Title
+	}
+	entries := func(items []analysis.InsightItem) []metricEntry {
+		out := make([]metricEntry, len(items))
+		for i, item := range items {
+			out[i] = metricEntry{ID: item.ID, Title: titles[item.ID], Value: item.Value}
+		}
+		return out
+	}
+
+	return struct {
+		NodeCount      int                   `json:"node_count"`
+		EdgeCount      int                   `json:"edge_count"`
+		Bottlenecks    []metricEntry         `json:"bottlenecks"`
+		Keystones      []metricEntry         `json:"keystones"`
+		Influencers    []metricEntry         `json:"influencers"`
+		Hubs           []metricEntry         `json:"hubs"`
+		Authorities    []metricEntry         `json:"authorities"`
+		Articulation   []string              `json:"articulation_points"`
+		Cycles         [][]string            `json:"cycles"`
+		ClusterDensity float64               `json:"cluster_density"`
+		Status         analysis.MetricStatus `json:"status"`
+	}{
+		NodeCount:      snap.Stats.NodeCount,
+		EdgeCount:      snap.Stats.EdgeCount,
+		Bottlenecks:    entries(insights.Bottlenecks),
+		Keystones:      entries(insights.Keystones),
+		Influencers:    entries(insights.Influencers),
+		Hubs:           entries(insights.Hubs),
+		Authorities:    entries(insights.Authorities),
+		Articulation:   insights.Articulation,
+		Cycles:         insights.Cycles,
+		ClusterDensity: insights.ClusterDensity,
+		Status:         snap.Stats.Status(),
+	}, nil
+}
+
+func labelHealth(snap *analysis.LiveSnapshot, args toolArgs) (any, error) {
+	result := analysis.ComputeAllLabelHealth(snap.Issues, analysis.DefaultLabelHealthConfig(), time.Now().UTC(), snap.Stats)
+	if args.Label == "" {
+		return struct {
+			TotalLabels     int                     `json:"total_labels"`
+			HealthyCount    int                     `json:"healthy_count"`
+			WarningCount    int                     `json:"warning_count"`
+			CriticalCount   int                     `json:"critical_count"`
+			Summaries       []analysis.LabelSummary `json:"summaries"`
+			AttentionNeeded []string                `json:"attention_needed"`
+		}{
+			TotalLabels:     result.TotalLabels,
+			HealthyCount:    result.HealthyCount,
+			WarningCount:    result.WarningCount,
+			CriticalCount:   result.CriticalCount,
+			Summaries:       result.Summaries,
+			AttentionNeeded: result.AttentionNeeded,
+		}, nil
+	}
+	for _, lh := range result.Labels {
+		if lh.Label == args.Label {
+			return lh, nil
+		}
+	}
+	return nil, fmt.Errorf("no label %q", args.Label)
+}
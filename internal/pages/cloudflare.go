@@ -1,9 +1,9 @@
-// Package export provides data export functionality for bv.
+// Package pages deploys the static site export to GitHub Pages or Cloudflare Pages.
 //
 // This file implements Cloudflare wrangler CLI integration for deploying
 // static sites to Cloudflare Pages. It follows safety-first principles:
 // no auto-install without confirmation, clear prompts for authentication.
-package export
+package pages
 
 import (
 	"bufio"
@@ -14,6 +14,8 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // Package-level compiled regexes for Cloudflare operations (avoids recompilation per call)
@@ -199,7 +201,7 @@ func GenerateHeadersFile(bundlePath string) error {
 `
 
 	headersPath := filepath.Join(bundlePath, "_headers")
-	if err := os.WriteFile(headersPath, []byte(headersContent), 0644); err != nil {
+	if err := readonly.WriteFile(headersPath, []byte(headersContent), 0644); err != nil {
 		return fmt.Errorf("failed to write _headers file: %w", err)
 	}
 
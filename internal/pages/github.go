@@ -1,9 +1,9 @@
-// Package export provides data export functionality for bv.
+// Package pages deploys the static site export to GitHub Pages or Cloudflare Pages.
 //
 // This file implements GitHub CLI integration for deploying static sites
 // to GitHub Pages. It follows safety-first principles: no auto-install,
 // confirmation prompts for destructive operations.
-package export
+package pages
 
 import (
 	"bufio"
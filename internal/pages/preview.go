@@ -1,8 +1,8 @@
-// Package export provides data export functionality for bv.
+// Package pages deploys the static site export to GitHub Pages or Cloudflare Pages.
 //
 // This file implements a local preview server for static site bundles.
 // It serves files with no-cache headers and auto-opens the browser.
-package export
+package pages
 
 import (
 	"context"
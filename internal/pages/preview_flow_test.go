@@ -1,4 +1,4 @@
-package export
+package pages
 
 import (
 	"net"
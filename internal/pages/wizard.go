@@ -1,8 +1,8 @@
-// Package export provides data export functionality for bv.
+// Package pages deploys the static site export to GitHub Pages or Cloudflare Pages.
 //
 // This file implements the interactive deployment wizard for --pages flag.
 // It guides users through exporting and deploying static sites to GitHub Pages.
-package export
+package pages
 
 import (
 	"bufio"
@@ -12,6 +12,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // WizardConfig holds configuration for the deployment wizard.
@@ -580,7 +582,7 @@ func SaveWizardConfig(config *WizardConfig) error {
 
 	// Ensure directory exists
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := readonly.MkdirAll(dir, 0755); err != nil {
 		return err
 	}
 
@@ -589,5 +591,5 @@ func SaveWizardConfig(config *WizardConfig) error {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	return readonly.WriteFile(path, data, 0644)
 }
@@ -0,0 +1,219 @@
+// Package web serves a read-only dashboard of the issues to a browser: the
+// kanban board and the dependency graph, for people who never open a
+// terminal. The page and its assets are compiled into the binary.
+package web
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+//go:embed static
+var staticFiles embed.FS
+
+// Server answers dashboard requests about the issues analyzed by live
+type Server struct {
+	live   *analysis.LiveAnalysis
+	title  string
+	alerts func([]model.Issue) []drift.Alert
+}
+
+// NewServer returns a dashboard that asks live for the current analysis on
+// each request, so the page follows edits to the beads file. title names
+// the project.
+func NewServer(live *analysis.LiveAnalysis, title string) *Server {
+	return &Server{live: live, title: title}
+}
+
+// SetAlerts sets how the feed computes the current drift alerts; without it
+// the feed lists issue changes only
+func (s *Server) SetAlerts(alerts func([]model.Issue) []drift.Alert) {
+	s.alerts = alerts
+}
+
+// Handler returns the dashboard's routes: the embedded page at /, the board
+// as JSON at /api/board, the dependency graph as SVG at /api/graph.svg
+// (closed issues are left out unless ?closed=1) and the Atom feed of issue
+// changes and alerts at /feed.xml.
+func (s *Server) Handler() http.Handler {
+	static, err := fs.Sub(staticFiles, "static")
+	if err != nil {
+		panic(err) // the embedded tree is fixed at build time
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /", http.FileServer(http.FS(static)))
+	mux.HandleFunc("GET /api/board", s.handleBoard)
+	mux.HandleFunc("GET /api/graph.svg", s.handleGraph)
+	mux.HandleFunc("GET /"+export.AtomFeedFilename, s.handleFeed)
+	return mux
+}
+
+// Board is the JSON shape of /api/board
+type Board struct {
+	Title       string        `json:"title"`
+	DataHash    string        `json:"data_hash"`
+	GeneratedAt time.Time     `json:"generated_at"`
+	Columns     []BoardColumn `json:"columns"`
+}
+
+// BoardColumn is one status lane of the board
+type BoardColumn struct {
+	Status string `json:"status"`
+	Title  string `json:"title"`
+	Cards  []Card `json:"cards"`
+}
+
+// Card is an issue as shown on the board
+type Card struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Type      string   `json:"type"`
+	Priority  int      `json:"priority"`
+	Assignee  string   `json:"assignee,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	BlockedBy []string `json:"blocked_by,omitempty"`
+}
+
+// boardColumns are the lanes in display order, matching the TUI board
+var boardColumns = []struct {
+	status model.Status
+	title  string
+}{
+	{model.StatusOpen, "Open"},
+	{model.StatusInProgress, "In Progress"},
+	{model.StatusBlocked, "Blocked"},
+	{model.StatusClosed, "Closed"},
+}
+
+// buildBoard sorts the issues into status lanes, highest priority first and
+// newest first within a priority
+func buildBoard(title, hash string, issues []model.Issue) Board {
+	closed := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			closed[issue.ID] = true
+		}
+	}
+
+	lanes := make(map[model.Status][]model.Issue, len(boardColumns))
+	for _, issue := range issues {
+		lanes[issue.Status] = append(lanes[issue.Status], issue)
+	}
+
+	board := Board{Title: title, DataHash: hash, GeneratedAt: time.Now().UTC()}
+	for _, col := range boardColumns {
+		lane := lanes[col.status]
+		sort.SliceStable(lane, func(i, j int) bool {
+			if lane[i].Priority != lane[j].Priority {
+				return lane[i].Priority < lane[j].Priority
+			}
+			return lane[i].CreatedAt.After(lane[j].CreatedAt)
+		})
+		cards := make([]Card, 0, len(lane))
+		for _, issue := range lane {
+			card := Card{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Type:     string(issue.IssueType),
+				Priority: issue.Priority,
+				Assignee: issue.Assignee,
+				Labels:   issue.Labels,
+			}
+			for _, dep := range issue.Dependencies {
+				if dep != nil && dep.Type == model.DepBlocks && !closed[dep.DependsOnID] {
+					card.BlockedBy = append(card.BlockedBy, dep.DependsOnID)
+				}
+			}
+			cards = append(cards, card)
+		}
+		board.Columns = append(board.Columns, BoardColumn{Status: string(col.status), Title: col.title, Cards: cards})
+	}
+	return board
+}
+
+func (s *Server) handleBoard(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.live.Current()
+	if err != nil {
+		http.Error(w, "loading issues: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(buildBoard(s.title, snap.Hash, snap.Issues))
+}
+
+func (s *Server) handleGraph(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.live.Current()
+	if err != nil {
+		http.Error(w, "loading issues: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	issues := snap.Issues
+	if r.URL.Query().Get("closed") != "1" {
+		issues = make([]model.Issue, 0, len(snap.Issues))
+		for _, issue := range snap.Issues {
+			if issue.Status != model.StatusClosed {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	if len(issues) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var buf bytes.Buffer
+	err = export.WriteGraphSVG(&buf, export.GraphSnapshotOptions{
+		Title:    s.title,
+		Issues:   issues,
+		Stats:    snap.Stats,
+		DataHash: snap.Hash,
+	})
+	if err != nil {
+		http.Error(w, "rendering graph: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Write(buf.Bytes())
+}
+
+func (s *Server) handleFeed(w http.ResponseWriter, r *http.Request) {
+	snap, err := s.live.Current()
+	if err != nil {
+		http.Error(w, "loading issues: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var alerts []drift.Alert
+	if s.alerts != nil {
+		alerts = s.alerts(snap.Issues)
+	}
+
+	// Absolute links, so feed readers can follow entries back to the dashboard
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	feed, err := export.GenerateAtomFeed(snap.Issues, alerts, export.AtomFeedConfig{
+		Title:   s.title,
+		BaseURL: scheme + "://" + r.Host + "/",
+	})
+	if err != nil {
+		http.Error(w, "rendering feed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/atom+xml")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(feed)
+}
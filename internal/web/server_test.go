@@ -0,0 +1,133 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func testIssues() []model.Issue {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	return []model.Issue{
+		{ID: "api", Title: "Design the API", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, CreatedAt: now},
+		{ID: "auth", Title: "Add login", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeFeature, Labels: []string{"backend"}, CreatedAt: now},
+		{ID: "client", Title: "Write the client", Status: model.StatusInProgress, Priority: 2, IssueType: model.TypeTask, Assignee: "sam",
+			Dependencies: []*model.Dependency{
+				{IssueID: "client", DependsOnID: "api", Type: model.DepBlocks},
+				{IssueID: "client", DependsOnID: "docs", Type: model.DepBlocks},
+			}},
+		{ID: "docs", Title: "Document <everything>", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeTask},
+	}
+}
+
+func get(t *testing.T, h http.Handler, path string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Result()
+}
+
+func TestHandler_Board(t *testing.T) {
+	h := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) { return testIssues(), nil }, analysis.TypeToggles{}, nil), "demo").Handler()
+
+	resp := get(t, h, "/api/board")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	var board Board
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		t.Fatal(err)
+	}
+	if board.Title != "demo" || board.DataHash == "" {
+		t.Errorf("unexpected header %+v", board)
+	}
+
+	var lanes []string
+	for _, col := range board.Columns {
+		var ids []string
+		for _, c := range col.Cards {
+			ids = append(ids, c.ID)
+		}
+		lanes = append(lanes, col.Title+":"+strings.Join(ids, ","))
+	}
+	if got := strings.Join(lanes, " "); got != "Open:auth,api In Progress:client Blocked: Closed:docs" {
+		t.Errorf("lanes = %s", got)
+	}
+
+	client := board.Columns[1].Cards[0]
+	if len(client.BlockedBy) != 1 || client.BlockedBy[0] != "api" || client.Assignee != "sam" {
+		t.Errorf("expected client blocked only by open api, got %+v", client)
+	}
+}
+
+func TestHandler_GraphAndAssets(t *testing.T) {
+	issues := testIssues()
+	h := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) { return issues, nil }, analysis.TypeToggles{}, nil), "demo").Handler()
+
+	resp := get(t, h, "/api/graph.svg")
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "image/svg+xml" {
+		t.Fatalf("graph status = %d, type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	if !strings.Contains(string(body), "<svg") || !strings.Contains(string(body), "client") {
+		t.Error("expected an SVG containing the open issues")
+	}
+	if strings.Contains(string(body), ">docs<") {
+		t.Error("closed issues should be left out by default")
+	}
+	body, _ = io.ReadAll(get(t, h, "/api/graph.svg?closed=1").Body)
+	if !strings.Contains(string(body), ">docs<") {
+		t.Error("?closed=1 should include closed issues")
+	}
+
+	issues = issues[3:]
+	if resp := get(t, h, "/api/graph.svg"); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected no content when nothing is open, got %d", resp.StatusCode)
+	}
+
+	for _, path := range []string{"/", "/app.js", "/style.css"} {
+		if resp := get(t, h, path); resp.StatusCode != http.StatusOK {
+			t.Errorf("%s status = %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestHandler_Feed(t *testing.T) {
+	srv := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) { return testIssues(), nil }, analysis.TypeToggles{}, nil), "demo")
+	srv.SetAlerts(func(issues []model.Issue) []drift.Alert {
+		return []drift.Alert{{Type: drift.AlertStaleIssue, Severity: drift.SeverityWarning, Message: "api is stale", IssueID: "api"}}
+	})
+	resp := get(t, srv.Handler(), "/feed.xml")
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/atom+xml" {
+		t.Fatalf("feed status = %d, type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	for _, want := range []string{
+		"<title>demo</title>",
+		"Created auth: Add login",
+		"[warning] api is stale",
+		`href="http://example.com/feed.xml"`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("feed missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestHandler_LoadError(t *testing.T) {
+	h := NewServer(analysis.NewLiveAnalysis(func() ([]model.Issue, error) { return nil, errors.New("beads file vanished") }, analysis.TypeToggles{}, nil), "demo").Handler()
+	resp := get(t, h, "/api/board")
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusInternalServerError || !strings.Contains(string(body), "beads file vanished") {
+		t.Errorf("expected the load error, got %d %s", resp.StatusCode, body)
+	}
+}
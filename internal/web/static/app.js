@@ -0,0 +1,122 @@
+// bv dashboard: renders /api/board and /api/graph.svg, polling for changes.
+(function () {
+  'use strict';
+
+  const POLL_MS = 30000;
+  let lastHash = null;
+  let view = 'board';
+
+  function el(tag, className, text) {
+    const node = document.createElement(tag);
+    if (className) node.className = className;
+    if (text !== undefined) node.textContent = text;
+    return node;
+  }
+
+  function showError(message) {
+    const box = document.getElementById('error');
+    box.textContent = message;
+    box.hidden = !message;
+  }
+
+  function renderCard(card) {
+    const node = el('div', 'card');
+    node.appendChild(el('div', 'id', card.id));
+    node.appendChild(el('div', 'title', card.title));
+
+    const tags = el('div', 'tags');
+    tags.appendChild(el('span', 'tag priority', 'P' + card.priority));
+    if (card.type) tags.appendChild(el('span', 'tag', card.type));
+    if (card.assignee) tags.appendChild(el('span', 'tag', '@' + card.assignee));
+    (card.labels || []).forEach(function (label) {
+      tags.appendChild(el('span', 'tag', label));
+    });
+    node.appendChild(tags);
+
+    if (card.blocked_by && card.blocked_by.length) {
+      node.appendChild(el('div', 'blocked', 'Blocked by ' + card.blocked_by.join(', ')));
+    }
+    return node;
+  }
+
+  function renderBoard(board) {
+    const container = document.getElementById('board');
+    container.replaceChildren();
+    board.columns.forEach(function (column) {
+      const lane = el('div', 'column');
+      lane.style.setProperty('--lane', 'var(--' + column.status + ', var(--muted))');
+      const heading = el('h2', null, column.title);
+      heading.appendChild(el('span', 'count', String(column.cards.length)));
+      lane.appendChild(heading);
+      column.cards.forEach(function (card) {
+        lane.appendChild(renderCard(card));
+      });
+      container.appendChild(lane);
+    });
+
+    document.getElementById('title').textContent = board.title;
+    document.title = board.title + ' · bv';
+    const updated = new Date(board.generated_at).toLocaleTimeString();
+    document.getElementById('meta').textContent = 'data ' + board.data_hash.slice(0, 8) + ' · updated ' + updated;
+  }
+
+  function renderGraph() {
+    const container = document.getElementById('graph');
+    const closed = document.getElementById('show-closed').checked ? '1' : '0';
+    const url = 'api/graph.svg?closed=' + closed;
+    fetch(url).then(function (resp) {
+      if (resp.status === 204) {
+        container.replaceChildren(el('div', 'empty', 'No issues to graph.'));
+        return;
+      }
+      if (!resp.ok) {
+        return resp.text().then(function (text) { throw new Error(text); });
+      }
+      return resp.blob().then(function (blob) {
+        const old = container.querySelector('img');
+        if (old) URL.revokeObjectURL(old.src);
+        const img = el('img');
+        img.alt = 'Dependency graph';
+        img.src = URL.createObjectURL(blob);
+        container.replaceChildren(img);
+      });
+    }).catch(function (err) {
+      showError('Graph: ' + err.message);
+    });
+  }
+
+  function refresh() {
+    fetch('api/board').then(function (resp) {
+      if (!resp.ok) {
+        return resp.text().then(function (text) { throw new Error(text); });
+      }
+      return resp.json();
+    }).then(function (board) {
+      showError('');
+      if (board.data_hash === lastHash) return;
+      lastHash = board.data_hash;
+      renderBoard(board);
+      if (view === 'graph') renderGraph();
+    }).catch(function (err) {
+      showError('Board: ' + err.message);
+    });
+  }
+
+  function switchView(next) {
+    view = next;
+    document.querySelectorAll('.tab').forEach(function (tab) {
+      tab.classList.toggle('active', tab.dataset.view === next);
+    });
+    document.getElementById('view-board').hidden = next !== 'board';
+    document.getElementById('view-graph').hidden = next !== 'graph';
+    if (next === 'graph') renderGraph();
+  }
+
+  document.querySelectorAll('.tab').forEach(function (tab) {
+    tab.addEventListener('click', function () { switchView(tab.dataset.view); });
+  });
+  document.getElementById('show-closed').addEventListener('change', renderGraph);
+
+  refresh();
+  setInterval(refresh, POLL_MS);
+})();
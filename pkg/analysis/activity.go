@@ -0,0 +1,165 @@
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// ActivityConfig holds the staleness settings for the activity view.
+// It shares .bv/analysis.yaml with TypeToggles.
+type ActivityConfig struct {
+	// StaleDays is how long an open issue may go without updates before it
+	// is listed as stale (default DefaultStaleThresholdDays)
+	StaleDays int `yaml:"stale_days,omitempty" json:"stale_days,omitempty"`
+}
+
+// LoadActivityConfig reads the activity settings from .bv/analysis.yaml.
+// Returns the defaults if the file doesn't exist or leaves the keys unset.
+func LoadActivityConfig(projectDir string) (ActivityConfig, error) {
+	cfg := ActivityConfig{StaleDays: DefaultStaleThresholdDays}
+
+	data, err := os.ReadFile(TypeTogglesPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading analysis config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing analysis config: %w", err)
+	}
+	if cfg.StaleDays <= 0 {
+		cfg.StaleDays = DefaultStaleThresholdDays
+	}
+	return cfg, nil
+}
+
+// ActivityDay counts issues created and closed on one calendar day
+type ActivityDay struct {
+	Date    time.Time `json:"date"`
+	Created int       `json:"created"`
+	Closed  int       `json:"closed"`
+}
+
+// ActivityHeatmap is a calendar grid of daily activity, GitHub style:
+// Days runs Monday-first, one full week per 7 entries, ending with the week of "now".
+type ActivityHeatmap struct {
+	Start      time.Time     `json:"start"` // Monday of the first week
+	Weeks      int           `json:"weeks"`
+	Days       []ActivityDay `json:"days"`
+	MaxCreated int           `json:"max_created"`
+	MaxClosed  int           `json:"max_closed"`
+	MaxTotal   int           `json:"max_total"` // Highest created+closed on a single day
+}
+
+// Day returns the cell for a week (0 = oldest) and weekday (0 = Monday)
+func (h ActivityHeatmap) Day(week, weekday int) ActivityDay {
+	return h.Days[week*7+weekday]
+}
+
+// ComputeActivityHeatmap buckets creation and close dates for the last
+// `weeks` weeks (including the current one) by local calendar day
+func ComputeActivityHeatmap(issues []model.Issue, weeks int, now time.Time) ActivityHeatmap {
+	if weeks <= 0 {
+		weeks = 1
+	}
+	start := WeekStart(now).AddDate(0, 0, -7*(weeks-1))
+	h := ActivityHeatmap{Start: start, Weeks: weeks, Days: make([]ActivityDay, weeks*7)}
+	for i := range h.Days {
+		h.Days[i].Date = start.AddDate(0, 0, i)
+	}
+
+	// Index by calendar date rather than dividing durations, which DST would skew
+	index := make(map[string]int, len(h.Days))
+	for i := range h.Days {
+		index[h.Days[i].Date.Format("2006-01-02")] = i
+	}
+	dayIndex := func(t time.Time) int {
+		if t.IsZero() {
+			return -1
+		}
+		if i, ok := index[t.In(now.Location()).Format("2006-01-02")]; ok {
+			return i
+		}
+		return -1
+	}
+
+	for _, issue := range issues {
+		if i := dayIndex(issue.CreatedAt); i >= 0 {
+			h.Days[i].Created++
+		}
+		if issue.Status == model.StatusClosed && issue.ClosedAt != nil {
+			if i := dayIndex(*issue.ClosedAt); i >= 0 {
+				h.Days[i].Closed++
+			}
+		}
+	}
+
+	for _, d := range h.Days {
+		h.MaxCreated = max(h.MaxCreated, d.Created)
+		h.MaxClosed = max(h.MaxClosed, d.Closed)
+		h.MaxTotal = max(h.MaxTotal, d.Created+d.Closed)
+	}
+	return h
+}
+
+// StaleIssue is an open issue that has gone without updates past the threshold
+type StaleIssue struct {
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Status      model.Status `json:"status"`
+	Priority    int          `json:"priority"`
+	Assignee    string       `json:"assignee,omitempty"`
+	LastTouched time.Time    `json:"last_touched"`
+	DaysIdle    int          `json:"days_idle"`
+}
+
+// ComputeStaleIssues lists open issues not updated for at least staleDays,
+// stalest first. Like the label freshness metrics it goes by UpdatedAt,
+// falling back to CreatedAt for issues that were never updated.
+func ComputeStaleIssues(issues []model.Issue, staleDays int, now time.Time) []StaleIssue {
+	if staleDays <= 0 {
+		staleDays = DefaultStaleThresholdDays
+	}
+	var stale []StaleIssue
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		touched := issue.UpdatedAt
+		if touched.IsZero() {
+			touched = issue.CreatedAt
+		}
+		if touched.IsZero() {
+			continue
+		}
+		days := int(now.Sub(touched).Hours() / 24)
+		if days < staleDays {
+			continue
+		}
+		stale = append(stale, StaleIssue{
+			ID:          issue.ID,
+			Title:       issue.Title,
+			Status:      issue.Status,
+			Priority:    issue.Priority,
+			Assignee:    issue.Assignee,
+			LastTouched: touched,
+			DaysIdle:    days,
+		})
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].DaysIdle != stale[j].DaysIdle {
+			return stale[i].DaysIdle > stale[j].DaysIdle
+		}
+		if stale[i].Priority != stale[j].Priority {
+			return stale[i].Priority < stale[j].Priority
+		}
+		return stale[i].ID < stale[j].ID
+	})
+	return stale
+}
@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeActivityHeatmap(t *testing.T) {
+	// Wednesday
+	now := time.Date(2025, 6, 11, 15, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 6, 9, 10, 0, 0, 0, time.UTC)
+	closed := monday.Add(2 * time.Hour)
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, CreatedAt: monday},
+		{ID: "b", Status: model.StatusClosed, CreatedAt: monday, ClosedAt: &closed},
+		{ID: "c", Status: model.StatusOpen, CreatedAt: monday.AddDate(0, 0, -8)},  // previous week's Sunday
+		{ID: "d", Status: model.StatusOpen, CreatedAt: monday.AddDate(0, 0, -30)}, // before the window
+	}
+
+	h := ComputeActivityHeatmap(issues, 2, now)
+
+	if h.Weeks != 2 || len(h.Days) != 14 {
+		t.Fatalf("Expected 2 weeks of days, got %d weeks / %d days", h.Weeks, len(h.Days))
+	}
+	if !h.Start.Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected grid to start on Monday 2025-06-02, got %v", h.Start)
+	}
+	if d := h.Day(1, 0); d.Created != 2 || d.Closed != 1 {
+		t.Errorf("Expected this Monday created=2 closed=1, got %+v", d)
+	}
+	if d := h.Day(0, 0); d.Created != 0 {
+		t.Errorf("Expected previous Monday empty, got %+v", d)
+	}
+	if d := h.Day(0, 6); d.Created != 0 {
+		// monday-8d is Sunday 2025-06-01, which is before the window
+		t.Errorf("Expected days before the window to be ignored, got %+v", d)
+	}
+	if h.MaxCreated != 2 || h.MaxClosed != 1 || h.MaxTotal != 3 {
+		t.Errorf("Unexpected maxima: %+v", h)
+	}
+}
+
+func TestComputeStaleIssues(t *testing.T) {
+	now := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "fresh", Status: model.StatusOpen, UpdatedAt: now.AddDate(0, 0, -2)},
+		{ID: "old", Status: model.StatusOpen, Priority: 2, UpdatedAt: now.AddDate(0, 0, -40)},
+		{ID: "older", Status: model.StatusBlocked, Priority: 1, UpdatedAt: now.AddDate(0, 0, -60)},
+		{ID: "never-updated", Status: model.StatusOpen, Priority: 0, CreatedAt: now.AddDate(0, 0, -40)},
+		{ID: "done", Status: model.StatusClosed, UpdatedAt: now.AddDate(0, 0, -90)},
+	}
+
+	stale := ComputeStaleIssues(issues, 30, now)
+
+	var ids []string
+	for _, s := range stale {
+		ids = append(ids, s.ID)
+	}
+	want := []string{"older", "never-updated", "old"}
+	if len(ids) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("Expected %v (stalest first, then priority), got %v", want, ids)
+		}
+	}
+	if stale[0].DaysIdle != 60 {
+		t.Errorf("Expected 60 idle days, got %d", stale[0].DaysIdle)
+	}
+}
+
+func TestLoadActivityConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadActivityConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.StaleDays != DefaultStaleThresholdDays {
+		t.Errorf("Expected default %d, got %d", DefaultStaleThresholdDays, cfg.StaleDays)
+	}
+
+	os.MkdirAll(filepath.Join(dir, ".bv"), 0755)
+	if err := os.WriteFile(TypeTogglesPath(dir), []byte("exclude_types: [epic]\nstale_days: 21\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadActivityConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.StaleDays != 21 {
+		t.Errorf("Expected stale_days 21, got %d", cfg.StaleDays)
+	}
+	// The shared file must still load as type toggles
+	if toggles, err := LoadTypeToggles(dir); err != nil || !toggles.Excludes(model.TypeEpic) {
+		t.Errorf("Type toggles broken by stale_days key: %v %+v", err, toggles)
+	}
+}
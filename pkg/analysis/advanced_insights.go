@@ -125,6 +125,10 @@ type CriticalPath struct {
 	Length    int      `json:"length"`              // Number of nodes in path
 	IssueIDs  []string `json:"issue_ids"`           // Path from source to sink
 	Truncated bool     `json:"truncated,omitempty"` // True if path was capped
+
+	// Cumulative estimate of the whole path; unestimated issues count as zero
+	Estimate     float64      `json:"estimate,omitempty"`
+	EstimateUnit EstimateUnit `json:"estimate_unit,omitempty"`
 }
 
 // ParallelCutResult represents suggestions for parallel work maximization.
@@ -728,6 +732,7 @@ func (a *Analyzer) generateKPaths(k int, pathLengthCap int) *KPathsResult {
 
 	// Reconstruct paths from top k endpoints
 	var paths []CriticalPath
+	unit := a.estimateUnit()
 	usedSources := make(map[int]bool) // Avoid returning duplicate paths (same source)
 
 	for _, pe := range pathEnds {
@@ -761,6 +766,14 @@ func (a *Analyzer) generateKPaths(k int, pathLengthCap int) *KPathsResult {
 			usedSources[source] = true
 		}
 
+		estimate := 0.0
+		for _, idx := range pathIndices {
+			issue := a.issueMap[nodes[idx].id]
+			if v, ok := IssueEstimate(&issue, unit); ok {
+				estimate += v
+			}
+		}
+
 		// Convert indices to issue IDs
 		truncated := false
 		if len(pathIndices) > pathLengthCap {
@@ -778,6 +791,9 @@ func (a *Analyzer) generateKPaths(k int, pathLengthCap int) *KPathsResult {
 			Length:    len(issueIDs),
 			IssueIDs:  issueIDs,
 			Truncated: truncated,
+
+			Estimate:     estimate,
+			EstimateUnit: unit,
 		})
 	}
 
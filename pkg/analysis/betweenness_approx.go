@@ -1,6 +1,7 @@
 package analysis
 
 import (
+	"context"
 	"math/rand"
 	"sort"
 	"time"
@@ -66,6 +67,12 @@ type BetweennessResult struct {
 //   - "A Faster Algorithm for Betweenness Centrality" (Brandes, 2001)
 //   - "Approximating Betweenness Centrality" (Bader et al., 2007)
 func ApproxBetweenness(g *simple.DirectedGraph, sampleSize int, seed int64) BetweennessResult {
+	return approxBetweenness(context.Background(), g, sampleSize, seed)
+}
+
+// approxBetweenness is ApproxBetweenness that stops starting pivots once ctx
+// ends, returning the partial result with TimedOut set.
+func approxBetweenness(ctx context.Context, g *simple.DirectedGraph, sampleSize int, seed int64) BetweennessResult {
 	start := time.Now()
 	nodes := graph.NodesOf(g.Nodes())
 	n := len(nodes)
@@ -106,10 +113,19 @@ func ApproxBetweenness(g *simple.DirectedGraph, sampleSize int, seed int64) Betw
 	sem := make(chan struct{}, runtime.NumCPU())
 
 	for _, pivot := range pivots {
+		// Acquire a token before starting the goroutine, so at most
+		// NumCPU pivots are in flight and none start after cancellation
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			result.TimedOut = true
+			break
+		}
 		wg.Add(1)
 		go func(p graph.Node) {
 			defer wg.Done()
-			sem <- struct{}{} // Acquire token
 			defer func() { <-sem }()
 
 			// Compute local contribution
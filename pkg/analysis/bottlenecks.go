@@ -0,0 +1,143 @@
+package analysis
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// Bottleneck is an issue much of the dependency graph runs through: an
+// articulation point whose removal splits its part of the graph, or an
+// issue on many shortest paths between others
+type Bottleneck struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Status      string  `json:"status"`
+	CutOff      int     `json:"cut_off"`     // Issues separated from the largest remaining piece if it were removed
+	Pieces      int     `json:"pieces"`      // Pieces its connected part splits into without it; 1 if it is no articulation point
+	Betweenness float64 `json:"betweenness"` // As passed in; 0 if not computed
+}
+
+// Bottlenecks ranks the issues by how much removing them disconnects the
+// dependency graph (ignoring direction): articulation points first, by the
+// number of issues they cut off, then by betweenness. Issues that neither cut
+// anything off nor lie between others are left out.
+func (a *Analyzer) Bottlenecks(betweenness map[string]float64) []Bottleneck {
+	pieces := separatedPieces(a.undirected())
+
+	var result []Bottleneck
+	for id, issue := range a.issueMap {
+		b := Bottleneck{ID: id, Title: issue.Title, Status: string(issue.Status), Pieces: 1, Betweenness: betweenness[id]}
+		if node, ok := a.idToNode[id]; ok && len(pieces[node]) > 1 {
+			total, largest := 0, 0
+			for _, size := range pieces[node] {
+				total += size
+				largest = max(largest, size)
+			}
+			b.CutOff = total - largest
+			b.Pieces = len(pieces[node])
+		}
+		if b.CutOff == 0 && b.Betweenness <= 0 {
+			continue
+		}
+		result = append(result, b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CutOff != result[j].CutOff {
+			return result[i].CutOff > result[j].CutOff
+		}
+		if result[i].Betweenness != result[j].Betweenness {
+			return result[i].Betweenness > result[j].Betweenness
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// undirected returns the dependency graph with direction dropped
+func (a *Analyzer) undirected() *simple.UndirectedGraph {
+	u := simple.NewUndirectedGraph()
+
+	// Add nodes
+	nodes := a.g.Nodes()
+	for nodes.Next() {
+		n := nodes.Node()
+		u.AddNode(simple.Node(n.ID()))
+	}
+
+	// Add undirected edges for each directed edge
+	edges := a.g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		u.SetEdge(u.NewEdge(u.Node(e.From().ID()), u.Node(e.To().ID())))
+	}
+	return u
+}
+
+// separatedPieces finds, for every articulation point, the sizes of the
+// pieces its connected component falls into when it is removed. It is the
+// Tarjan walk of findArticulationPoints, also counting subtree sizes: a DFS
+// child whose subtree can't reach above the vertex becomes its own piece,
+// and whatever is left of the component forms one more.
+func separatedPieces(g *simple.UndirectedGraph) map[int64][]int {
+	var timeIdx int
+	disc := make(map[int64]int)
+	low := make(map[int64]int)
+	size := make(map[int64]int)
+	parent := make(map[int64]int64)
+	split := make(map[int64][]int)
+	var order []int64 // Vertices in discovery order
+
+	const noParent int64 = -1
+
+	var dfs func(v int64)
+	dfs = func(v int64) {
+		timeIdx++
+		disc[v] = timeIdx
+		low[v] = timeIdx
+		size[v] = 1
+		order = append(order, v)
+
+		it := g.From(v)
+		for it.Next() {
+			u := it.Node().ID()
+			if disc[u] == 0 {
+				parent[u] = v
+				dfs(u)
+				low[v] = min(low[v], low[u])
+				size[v] += size[u]
+				if low[u] >= disc[v] {
+					split[v] = append(split[v], size[u])
+				}
+			} else if u != parent[v] {
+				low[v] = min(low[v], disc[u])
+			}
+		}
+	}
+
+	pieces := make(map[int64][]int)
+	nodes := g.Nodes()
+	for nodes.Next() {
+		id := nodes.Node().ID()
+		if disc[id] != 0 {
+			continue
+		}
+		parent[id] = noParent
+		start := len(order)
+		dfs(id)
+		for _, v := range order[start:] {
+			parts := split[v]
+			rest := size[id] - 1
+			for _, p := range parts {
+				rest -= p
+			}
+			if rest > 0 {
+				parts = append(parts, rest)
+			}
+			if len(parts) > 1 {
+				pieces[v] = parts
+			}
+		}
+	}
+	return pieces
+}
@@ -0,0 +1,42 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBottlenecks(t *testing.T) {
+	blocks := func(id string, on ...string) model.Issue {
+		issue := model.Issue{ID: id, Title: id, Status: model.StatusOpen}
+		for _, dep := range on {
+			issue.Dependencies = append(issue.Dependencies, &model.Dependency{IssueID: id, DependsOnID: dep, Type: model.DepBlocks})
+		}
+		return issue
+	}
+	// hub joins three leaves and a chain hub-c1-c2-c3; t1/t2/t3 form a
+	// triangle with no articulation point; lone has no links
+	issues := []model.Issue{
+		blocks("hub"), blocks("l1", "hub"), blocks("l2", "hub"), blocks("l3", "hub"),
+		blocks("c1", "hub"), blocks("c2", "c1"), blocks("c3", "c2"),
+		blocks("t1", "t2"), blocks("t2", "t3"), blocks("t3", "t1"),
+		blocks("lone"),
+	}
+
+	got := NewAnalyzer(issues).Bottlenecks(map[string]float64{"t1": 0.5})
+	want := []Bottleneck{
+		{ID: "hub", CutOff: 3, Pieces: 4},
+		{ID: "c1", CutOff: 2, Pieces: 2},
+		{ID: "c2", CutOff: 1, Pieces: 2},
+		{ID: "t1", CutOff: 0, Pieces: 1, Betweenness: 0.5},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d bottlenecks, got %+v", len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.ID != w.ID || g.CutOff != w.CutOff || g.Pieces != w.Pieces || g.Betweenness != w.Betweenness {
+			t.Errorf("bottleneck %d = %+v, want %+v", i, g, w)
+		}
+	}
+}
@@ -172,6 +172,11 @@ func ComputeDataHash(issues []model.Issue) string {
 			h.Write([]byte(strconv.Itoa(*issue.EstimatedMinutes)))
 		}
 		h.Write([]byte{0})
+		if issue.Estimate != nil {
+			// Only when set, so hashes of unestimated issues stay the same
+			h.Write([]byte("pts:" + strconv.FormatFloat(*issue.Estimate, 'g', -1, 64)))
+			h.Write([]byte{0})
+		}
 		h.Write([]byte(issue.CreatedAt.UTC().Format(time.RFC3339Nano)))
 		h.Write([]byte{0})
 		h.Write([]byte(issue.UpdatedAt.UTC().Format(time.RFC3339Nano)))
@@ -233,6 +238,8 @@ type CachedAnalyzer struct {
 	dataHash   string // Hash of the issue data
 	configHash string // Hash of the configuration
 	cacheHit   bool   // Set by AnalyzeAsync to track if it was a cache hit
+	reuse      Reuse  // Set by ReanalyzeAsync
+	disk       *DiskCache
 }
 
 // NewCachedAnalyzer creates an analyzer that checks the cache before computing.
@@ -258,13 +265,55 @@ func (ca *CachedAnalyzer) SetConfig(config *AnalysisConfig) {
 	ca.configHash = ComputeConfigHash(config)
 }
 
+// SetDiskCache also looks analyses up on disk, where Persist saves them, so
+// they outlive the session. Pass nil to keep caching in memory only.
+func (ca *CachedAnalyzer) SetDiskCache(disk *DiskCache) {
+	ca.disk = disk
+}
+
+// lookup returns stats cached in memory or on disk under the combined key
+func (ca *CachedAnalyzer) lookup(fullHash string) (*GraphStats, bool) {
+	if stats, ok := ca.cache.GetByHash(fullHash); ok {
+		return stats, true
+	}
+	if ca.disk == nil {
+		return nil, false
+	}
+	stats, ok := ca.disk.Load(fullHash)
+	if ok {
+		ca.cache.SetByHash(fullHash, stats)
+	}
+	return stats, ok
+}
+
+// store caches stats in memory once Phase 2 completes; a cancelled run
+// stays uncached
+func (ca *CachedAnalyzer) store(fullHash string, stats *GraphStats) {
+	go func() {
+		stats.WaitForPhase2()
+		if stats.IsPhase2Ready() {
+			ca.cache.SetByHash(fullHash, stats)
+		}
+	}()
+}
+
+// Persist saves stats, the completed analysis of this analyzer's issues, to
+// the disk cache. It does nothing without a disk cache or when the stats came
+// from a cache. Saving is left to the caller, so a write never outlives it.
+func (ca *CachedAnalyzer) Persist(stats *GraphStats) error {
+	if ca.disk == nil || ca.cacheHit {
+		return nil
+	}
+	return ca.disk.Save(ca.dataHash+"|"+ca.configHash, stats)
+}
+
 // AnalyzeAsync returns cached stats if available, otherwise computes and caches.
 func (ca *CachedAnalyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
 	// Combined key: dataHash|configHash
 	fullHash := ca.dataHash + "|" + ca.configHash
 
 	// Check cache first
-	if stats, ok := ca.cache.GetByHash(fullHash); ok {
+	if stats, ok := ca.lookup(fullHash); ok {
 		ca.cacheHit = true
 		return stats
 	}
@@ -272,13 +321,26 @@ func (ca *CachedAnalyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
 	// Cache miss - compute fresh
 	ca.cacheHit = false
 	stats := ca.Analyzer.AnalyzeAsync(ctx)
+	ca.store(fullHash, stats)
+	return stats
+}
 
-	// Store in cache when Phase 2 completes
-	go func() {
-		stats.WaitForPhase2()
-		ca.cache.SetByHash(fullHash, stats)
-	}()
+// ReanalyzeAsync is AnalyzeAsync after an edit: on a cache miss it hands
+// the previous analyzer and its stats to Analyzer.ReanalyzeAsync, so only
+// what the edit touched is recomputed. Reused reports how much was kept.
+func (ca *CachedAnalyzer) ReanalyzeAsync(ctx context.Context, prev *Analyzer, prevStats *GraphStats) *GraphStats {
+	fullHash := ca.dataHash + "|" + ca.configHash
+
+	if stats, ok := ca.lookup(fullHash); ok {
+		ca.cacheHit = true
+		ca.reuse = ReuseAll
+		return stats
+	}
 
+	ca.cacheHit = false
+	stats, reuse := ca.Analyzer.ReanalyzeAsync(ctx, prev, prevStats)
+	ca.reuse = reuse
+	ca.store(fullHash, stats)
 	return stats
 }
 
@@ -312,6 +374,12 @@ func (ca *CachedAnalyzer) DataHash() string {
 	return ca.dataHash
 }
 
+// Reused reports how much of the previous analysis the last ReanalyzeAsync
+// call kept.
+func (ca *CachedAnalyzer) Reused() Reuse {
+	return ca.reuse
+}
+
 // WasCacheHit returns true if the last AnalyzeAsync call was a cache hit.
 func (ca *CachedAnalyzer) WasCacheHit() bool {
 	return ca.cacheHit
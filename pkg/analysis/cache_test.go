@@ -2,6 +2,7 @@ package analysis_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -317,3 +318,49 @@ func TestGlobalCache(t *testing.T) {
 	// Clean up
 	cache.Invalidate()
 }
+
+func TestAnalyzer_SetProgressReportsPhase2Steps(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	an := analysis.NewAnalyzer(issues)
+
+	var mu sync.Mutex
+	var reports [][2]int
+	an.SetProgress(func(done, total int) {
+		mu.Lock()
+		defer mu.Unlock()
+		reports = append(reports, [2]int{done, total})
+	})
+	an.AnalyzeAsync(context.Background()).WaitForPhase2()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reports) == 0 {
+		t.Fatal("expected progress reports")
+	}
+	if first := reports[0]; first != [2]int{0, analysis.Phase2Steps} {
+		t.Errorf("first report = %v, want [0 %d]", first, analysis.Phase2Steps)
+	}
+	if last := reports[len(reports)-1]; last != [2]int{analysis.Phase2Steps, analysis.Phase2Steps} {
+		t.Errorf("last report = %v, want [%d %d]", last, analysis.Phase2Steps, analysis.Phase2Steps)
+	}
+}
+
+func TestCachedAnalyzer_SkipsCancelledRun(t *testing.T) {
+	issues := []model.Issue{{ID: "A", Status: model.StatusOpen}}
+	cache := analysis.NewCache(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats := analysis.NewCachedAnalyzer(issues, cache).AnalyzeAsync(ctx)
+	stats.WaitForPhase2()
+	if stats.IsPhase2Ready() {
+		t.Skip("phase 2 finished before cancellation was observed")
+	}
+
+	if _, ok := cache.Get(issues); ok {
+		t.Error("a cancelled run should not be cached")
+	}
+}
@@ -0,0 +1,172 @@
+package analysis
+
+import (
+	"math/rand/v2"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/community"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// Cluster is a group of issues linked more densely to each other than to the
+// rest of the graph: usually an independent workstream
+type Cluster struct {
+	ID            int      `json:"id"` // Index in ClusterResult.Clusters; 0 is the largest
+	IssueIDs      []string `json:"issue_ids"`
+	Blocked       int      `json:"blocked"`        // Unclosed issues waiting on an unclosed blocker
+	BlockedPct    float64  `json:"blocked_pct"`    // Blocked share of the unclosed issues, 0-100
+	DominantLabel string   `json:"dominant_label"` // Most common label, empty if none
+	InternalEdges int      `json:"internal_edges"`
+	ExternalEdges int      `json:"external_edges"` // Dependencies to other clusters
+}
+
+// Size returns the number of issues in the cluster
+func (c Cluster) Size() int {
+	return len(c.IssueIDs)
+}
+
+// ClusterResult partitions the issues into clusters
+type ClusterResult struct {
+	Clusters   []Cluster      `json:"clusters"`   // Largest first; unlinked issues are clusters of one
+	Membership map[string]int `json:"membership"` // Issue ID -> cluster ID
+	Modularity float64        `json:"modularity"` // Quality of the partition, -0.5 to 1
+}
+
+// DetectClusters groups issues into communities of the blocking dependency
+// graph, ignoring direction, by Louvain modularity optimization
+func DetectClusters(issues []model.Issue) ClusterResult {
+	ids := make([]string, 0, len(issues))
+	byID := make(map[string]model.Issue, len(issues))
+	for _, issue := range issues {
+		if _, dup := byID[issue.ID]; dup {
+			continue
+		}
+		byID[issue.ID] = issue
+		ids = append(ids, issue.ID)
+	}
+	sort.Strings(ids)
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	// Undirected edges, each counted once
+	g := simple.NewUndirectedGraph()
+	for i := range ids {
+		g.AddNode(simple.Node(int64(i)))
+	}
+	for _, id := range ids {
+		for _, dep := range byID[id].Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if j, ok := index[dep.DependsOnID]; ok && j != index[id] {
+				g.SetEdge(g.NewEdge(simple.Node(int64(index[id])), simple.Node(int64(j))))
+			}
+		}
+	}
+
+	membership := make([]int, len(ids))
+	for i := range membership {
+		membership[i] = i
+	}
+	if g.Edges().Len() > 0 {
+		// A fixed seed keeps the clusters stable from run to run
+		reduced := community.Modularize(g, 1, rand.NewPCG(1, 2))
+		for c, nodes := range reduced.Communities() {
+			for _, n := range nodes {
+				membership[n.ID()] = len(ids) + c
+			}
+		}
+	}
+
+	// Build clusters, largest first, ties by smallest issue ID
+	groups := make(map[int][]string)
+	for i, c := range membership {
+		groups[c] = append(groups[c], ids[i])
+	}
+	var clusters []Cluster
+	for _, members := range groups {
+		clusters = append(clusters, Cluster{IssueIDs: members}) // Members are in ID order
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].IssueIDs) != len(clusters[j].IssueIDs) {
+			return len(clusters[i].IssueIDs) > len(clusters[j].IssueIDs)
+		}
+		return clusters[i].IssueIDs[0] < clusters[j].IssueIDs[0]
+	})
+	result := ClusterResult{Clusters: clusters, Membership: make(map[string]int, len(ids))}
+	for c := range clusters {
+		clusters[c].ID = c
+		for _, id := range clusters[c].IssueIDs {
+			result.Membership[id] = c
+		}
+	}
+
+	edges := g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		ca, cb := result.Membership[ids[e.From().ID()]], result.Membership[ids[e.To().ID()]]
+		if ca == cb {
+			clusters[ca].InternalEdges++
+		} else {
+			clusters[ca].ExternalEdges++
+			clusters[cb].ExternalEdges++
+		}
+	}
+	if g.Edges().Len() > 0 {
+		communities := make([][]graph.Node, len(clusters))
+		for c := range clusters {
+			for _, id := range clusters[c].IssueIDs {
+				communities[c] = append(communities[c], g.Node(int64(index[id])))
+			}
+		}
+		result.Modularity = community.Q(g, communities, 1)
+	}
+
+	for c := range clusters {
+		summarizeCluster(&clusters[c], byID)
+	}
+	return result
+}
+
+// summarizeCluster fills in the blocked count and dominant label
+func summarizeCluster(c *Cluster, byID map[string]model.Issue) {
+	labels := make(map[string]int)
+	unclosed := 0
+	for _, id := range c.IssueIDs {
+		issue := byID[id]
+		for _, label := range issue.Labels {
+			labels[label]++
+		}
+		if issue.Status.IsClosed() {
+			continue
+		}
+		unclosed++
+		blocked := issue.Status == model.StatusBlocked
+		for _, dep := range issue.Dependencies {
+			if blocked {
+				break
+			}
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := byID[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+				blocked = true
+			}
+		}
+		if blocked {
+			c.Blocked++
+		}
+	}
+	if unclosed > 0 {
+		c.BlockedPct = 100 * float64(c.Blocked) / float64(unclosed)
+	}
+	for label, n := range labels {
+		if n > labels[c.DominantLabel] || (n == labels[c.DominantLabel] && label < c.DominantLabel) {
+			c.DominantLabel = label
+		}
+	}
+}
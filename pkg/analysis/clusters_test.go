@@ -0,0 +1,70 @@
+package analysis
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDetectClusters(t *testing.T) {
+	issue := func(id string, status model.Status, labels []string, deps ...string) model.Issue {
+		is := model.Issue{ID: id, Status: status, Labels: labels}
+		for _, d := range deps {
+			is.Dependencies = append(is.Dependencies, &model.Dependency{IssueID: id, DependsOnID: d, Type: model.DepBlocks})
+		}
+		return is
+	}
+	api, ui := []string{"api"}, []string{"ui"}
+	issues := []model.Issue{
+		// Two tightly knit workstreams joined by a single dependency
+		issue("a-1", model.StatusClosed, api),
+		issue("a-2", model.StatusOpen, api, "a-1"), // Its only blocker is closed
+		issue("a-3", model.StatusOpen, []string{"api", "db"}, "a-1", "a-2"),
+		issue("a-4", model.StatusInProgress, api, "a-2", "a-3"),
+		issue("b-1", model.StatusOpen, ui, "a-4"),
+		issue("b-2", model.StatusOpen, ui, "b-1"),
+		issue("b-3", model.StatusBlocked, nil, "b-1", "b-2"),
+		issue("b-4", model.StatusOpen, ui, "b-2", "b-3"),
+		issue("c-1", model.StatusOpen, nil),
+		{ID: "c-2", Dependencies: []*model.Dependency{{DependsOnID: "c-1", Type: model.DepRelated}, {DependsOnID: "gone"}}},
+	}
+
+	got := DetectClusters(issues)
+	if len(got.Clusters) != 4 {
+		t.Fatalf("expected 2 workstreams and 2 unlinked issues, got %+v", got.Clusters)
+	}
+	want := []Cluster{
+		{ID: 0, IssueIDs: []string{"a-1", "a-2", "a-3", "a-4"}, Blocked: 2, BlockedPct: 200.0 / 3, DominantLabel: "api", InternalEdges: 5, ExternalEdges: 1},
+		{ID: 1, IssueIDs: []string{"b-1", "b-2", "b-3", "b-4"}, Blocked: 4, BlockedPct: 100, DominantLabel: "ui", InternalEdges: 5, ExternalEdges: 1},
+		{ID: 2, IssueIDs: []string{"c-1"}},
+		{ID: 3, IssueIDs: []string{"c-2"}},
+	}
+	if !reflect.DeepEqual(got.Clusters, want) {
+		t.Errorf("clusters:\n got %+v\nwant %+v", got.Clusters, want)
+	}
+	if got.Membership["b-3"] != 1 || got.Membership["c-2"] != 3 {
+		t.Errorf("membership = %v", got.Membership)
+	}
+	if got.Modularity < 0.3 {
+		t.Errorf("modularity %.3f is too low for two clear workstreams", got.Modularity)
+	}
+	if again := DetectClusters(issues); !reflect.DeepEqual(again, got) {
+		t.Error("clustering should be deterministic")
+	}
+}
+
+func TestDetectClustersWithoutEdges(t *testing.T) {
+	var issues []model.Issue
+	for i := 0; i < 3; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("x-%d", i), Status: model.StatusOpen})
+	}
+	got := DetectClusters(issues)
+	if len(got.Clusters) != 3 || got.Modularity != 0 || got.Clusters[0].IssueIDs[0] != "x-0" {
+		t.Errorf("unlinked issues should each be their own cluster: %+v", got)
+	}
+	if empty := DetectClusters(nil); len(empty.Clusters) != 0 {
+		t.Errorf("no issues, no clusters: %+v", empty)
+	}
+}
@@ -0,0 +1,282 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+// CycleCut is a blocking dependency proposed for removal to break cycles
+type CycleCut struct {
+	IssueID     string   `json:"issue_id"`      // Issue holding the dependency
+	DependsOnID string   `json:"depends_on_id"` // Issue it waits on
+	Cycle       []string `json:"cycle"`         // Shortest cycle through the edge, from IssueID back to it
+}
+
+// CycleBreakPlan is a set of cuts that leaves the dependency graph acyclic
+type CycleBreakPlan struct {
+	Cuts   []CycleCut `json:"cuts"`
+	Issues int        `json:"issues"` // Issues on at least one cycle
+}
+
+// PlanCycleCuts proposes a small set of blocking dependencies whose removal
+// breaks every dependency cycle. Finding the minimum such set (a minimum
+// feedback arc set) is NP-hard, so within each strongly connected component
+// it orders issues with the Eades–Lin–Smyth greedy heuristic, proposes the
+// dependencies that point backwards in that order, then puts back any cut
+// the others make unnecessary. The result is minimal: restoring any one cut
+// brings a cycle back. Cuts are sorted by issue, then target.
+func PlanCycleCuts(issues []model.Issue) CycleBreakPlan {
+	exists := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		exists[issue.ID] = true
+	}
+	out := make(map[string][]string)
+	seen := make(map[[2]string]bool)
+	var plan CycleBreakPlan
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || !exists[dep.DependsOnID] {
+				continue
+			}
+			edge := [2]string{issue.ID, dep.DependsOnID}
+			if seen[edge] {
+				continue
+			}
+			seen[edge] = true
+			if issue.ID == dep.DependsOnID {
+				// An issue waiting on itself is its own cycle
+				plan.Cuts = append(plan.Cuts, CycleCut{IssueID: issue.ID, DependsOnID: issue.ID, Cycle: []string{issue.ID, issue.ID}})
+				continue
+			}
+			out[issue.ID] = append(out[issue.ID], dep.DependsOnID)
+		}
+	}
+
+	onCycle := make(map[string]bool)
+	for _, cut := range plan.Cuts {
+		onCycle[cut.IssueID] = true
+	}
+	for _, scc := range stronglyConnected(out) {
+		if len(scc) < 2 {
+			continue
+		}
+		members := make(map[string]bool, len(scc))
+		for _, id := range scc {
+			members[id] = true
+			onCycle[id] = true
+		}
+		for _, edge := range minimalBackEdges(scc, members, out) {
+			plan.Cuts = append(plan.Cuts, CycleCut{
+				IssueID:     edge[0],
+				DependsOnID: edge[1],
+				Cycle:       append([]string{edge[0]}, shortestPath(edge[1], edge[0], members, out)...),
+			})
+		}
+	}
+	plan.Issues = len(onCycle)
+
+	sort.Slice(plan.Cuts, func(i, j int) bool {
+		if plan.Cuts[i].IssueID != plan.Cuts[j].IssueID {
+			return plan.Cuts[i].IssueID < plan.Cuts[j].IssueID
+		}
+		return plan.Cuts[i].DependsOnID < plan.Cuts[j].DependsOnID
+	})
+	return plan
+}
+
+// stronglyConnected returns the strongly connected components of the graph,
+// each sorted by ID
+func stronglyConnected(out map[string][]string) [][]string {
+	index := make(map[string]int64)
+	var nodes []string
+	g := simple.NewDirectedGraph()
+	node := func(id string) graph.Node {
+		i, ok := index[id]
+		if !ok {
+			i = int64(len(nodes))
+			index[id] = i
+			nodes = append(nodes, id)
+			g.AddNode(simple.Node(i))
+		}
+		return simple.Node(i)
+	}
+	for u, targets := range out {
+		for _, v := range targets {
+			g.SetEdge(g.NewEdge(node(u), node(v)))
+		}
+	}
+
+	var sccs [][]string
+	for _, component := range topo.TarjanSCC(g) {
+		scc := make([]string, len(component))
+		for i, n := range component {
+			scc[i] = nodes[n.ID()]
+		}
+		sort.Strings(scc)
+		sccs = append(sccs, scc)
+	}
+	return sccs
+}
+
+// minimalBackEdges orders a strongly connected component with the
+// Eades–Lin–Smyth heuristic (sinks last, sources first, otherwise the node
+// with the most outgoing over incoming edges next) and returns the edges
+// pointing backwards in that order, less any not needed to break a cycle
+func minimalBackEdges(scc []string, members map[string]bool, out map[string][]string) [][2]string {
+	indeg := make(map[string]int, len(scc))
+	outdeg := make(map[string]int, len(scc))
+	in := make(map[string][]string, len(scc))
+	for _, u := range scc {
+		for _, v := range out[u] {
+			if members[v] {
+				outdeg[u]++
+				indeg[v]++
+				in[v] = append(in[v], u)
+			}
+		}
+	}
+
+	removed := make(map[string]bool, len(scc))
+	remove := func(v string) {
+		removed[v] = true
+		for _, w := range out[v] {
+			if members[w] {
+				indeg[w]--
+			}
+		}
+		for _, u := range in[v] {
+			outdeg[u]--
+		}
+	}
+	var head, tail []string
+	for left := len(scc); left > 0; {
+		progress := true
+		for progress {
+			progress = false
+			for _, v := range scc {
+				if removed[v] {
+					continue
+				}
+				switch {
+				case outdeg[v] == 0:
+					tail = append(tail, v)
+				case indeg[v] == 0:
+					head = append(head, v)
+				default:
+					continue
+				}
+				remove(v)
+				left--
+				progress = true
+			}
+		}
+		if left == 0 {
+			break
+		}
+		best := ""
+		for _, v := range scc {
+			if !removed[v] && (best == "" || outdeg[v]-indeg[v] > outdeg[best]-indeg[best]) {
+				best = v
+			}
+		}
+		head = append(head, best)
+		remove(best)
+		left--
+	}
+	position := make(map[string]int, len(scc))
+	for i, v := range head {
+		position[v] = i
+	}
+	for i, v := range tail {
+		position[v] = len(scc) - 1 - i // Sinks were collected last-first
+	}
+
+	cut := make(map[[2]string]bool)
+	var cuts [][2]string
+	for _, u := range scc {
+		for _, v := range out[u] {
+			if members[v] && position[u] > position[v] {
+				cut[[2]string{u, v}] = true
+				cuts = append(cuts, [2]string{u, v})
+			}
+		}
+	}
+
+	// Put back cuts the others already make unnecessary
+	var minimal [][2]string
+	for _, edge := range cuts {
+		delete(cut, edge)
+		if !acyclicWithout(scc, members, out, cut) {
+			cut[edge] = true
+			minimal = append(minimal, edge)
+		}
+	}
+	return minimal
+}
+
+// acyclicWithout reports whether the component has no cycle once the cut
+// edges are removed (Kahn's algorithm)
+func acyclicWithout(scc []string, members map[string]bool, out map[string][]string, cut map[[2]string]bool) bool {
+	indeg := make(map[string]int, len(scc))
+	for _, u := range scc {
+		for _, v := range out[u] {
+			if members[v] && !cut[[2]string{u, v}] {
+				indeg[v]++
+			}
+		}
+	}
+	var queue []string
+	for _, v := range scc {
+		if indeg[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, v := range out[u] {
+			if members[v] && !cut[[2]string{u, v}] {
+				indeg[v]--
+				if indeg[v] == 0 {
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+	return visited == len(scc)
+}
+
+// shortestPath returns the nodes of a shortest path from one node to
+// another within members, both ends included, or nil if there is none
+func shortestPath(from, to string, members map[string]bool, out map[string][]string) []string {
+	prev := map[string]string{from: from}
+	queue := []string{from}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		if u == to {
+			var path []string
+			for v := to; v != from; v = prev[v] {
+				path = append(path, v)
+			}
+			path = append(path, from)
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path
+		}
+		for _, v := range out[u] {
+			if _, seen := prev[v]; !seen && members[v] {
+				prev[v] = u
+				queue = append(queue, v)
+			}
+		}
+	}
+	return nil
+}
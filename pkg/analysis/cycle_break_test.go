@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestPlanCycleCuts(t *testing.T) {
+	dep := func(id string, typ model.DependencyType) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: typ}
+	}
+	issues := []model.Issue{
+		// Two cycles through A→B: one cut breaks both
+		{ID: "A", Dependencies: []*model.Dependency{dep("B", model.DepBlocks)}},
+		{ID: "B", Dependencies: []*model.Dependency{dep("A", model.DepBlocks), dep("C", "")}},
+		{ID: "C", Dependencies: []*model.Dependency{dep("A", model.DepBlocks)}},
+		{ID: "D", Dependencies: []*model.Dependency{dep("D", model.DepBlocks), dep("gone", model.DepBlocks)}},
+		{ID: "E", Dependencies: []*model.Dependency{dep("F", model.DepBlocks)}},
+		{ID: "F", Dependencies: []*model.Dependency{dep("E", model.DepRelated)}}, // Not blocking
+	}
+
+	got := PlanCycleCuts(issues)
+	want := CycleBreakPlan{
+		Cuts: []CycleCut{
+			{IssueID: "A", DependsOnID: "B", Cycle: []string{"A", "B", "A"}},
+			{IssueID: "D", DependsOnID: "D", Cycle: []string{"D", "D"}},
+		},
+		Issues: 4,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PlanCycleCuts:\n got %+v\nwant %+v", got, want)
+	}
+
+	if plan := PlanCycleCuts(issues[4:]); len(plan.Cuts) != 0 || plan.Issues != 0 {
+		t.Errorf("acyclic graph should need no cuts: %+v", plan)
+	}
+}
+
+func TestPlanCycleCutsIsMinimal(t *testing.T) {
+	// A ring with chords back and forth, so cycles overlap heavily
+	const n = 12
+	id := func(i int) string { return fmt.Sprintf("bv-%02d", i%n) }
+	var issues []model.Issue
+	for i := 0; i < n; i++ {
+		issue := model.Issue{ID: id(i)}
+		for _, j := range []int{i + 1, i + 5, i + n - 3} {
+			issue.Dependencies = append(issue.Dependencies, &model.Dependency{DependsOnID: id(j), Type: model.DepBlocks})
+		}
+		issues = append(issues, issue)
+	}
+
+	plan := PlanCycleCuts(issues)
+	if plan.Issues != n || len(plan.Cuts) == 0 {
+		t.Fatalf("plan = %+v", plan)
+	}
+	cut := make(map[[2]string]bool)
+	for _, c := range plan.Cuts {
+		cut[[2]string{c.IssueID, c.DependsOnID}] = true
+		if len(c.Cycle) < 3 || c.Cycle[0] != c.IssueID || c.Cycle[1] != c.DependsOnID || c.Cycle[len(c.Cycle)-1] != c.IssueID {
+			t.Errorf("cut %s → %s has cycle %v", c.IssueID, c.DependsOnID, c.Cycle)
+		}
+	}
+	acyclic := func() bool {
+		var kept []model.Issue
+		for _, issue := range issues {
+			k := model.Issue{ID: issue.ID}
+			for _, d := range issue.Dependencies {
+				if !cut[[2]string{issue.ID, d.DependsOnID}] {
+					k.Dependencies = append(k.Dependencies, d)
+				}
+			}
+			kept = append(kept, k)
+		}
+		return len(PlanCycleCuts(kept).Cuts) == 0
+	}
+	if !acyclic() {
+		t.Fatal("applying every cut should leave no cycles")
+	}
+	for edge := range cut {
+		delete(cut, edge)
+		if acyclic() {
+			t.Errorf("cut %s → %s is unnecessary", edge[0], edge[1])
+		}
+		cut[edge] = true
+	}
+}
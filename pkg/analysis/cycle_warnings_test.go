@@ -4,8 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Dicklesworthstone/beads_viewer/internal/testutil"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
-	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
 )
 
 func TestDefaultCycleWarningConfig(t *testing.T) {
@@ -0,0 +1,135 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// maxDanglingSuggestions caps the existing IDs offered as repair targets
+const maxDanglingSuggestions = 3
+
+// DanglingDependency is a dependency on an issue ID that doesn't exist
+type DanglingDependency struct {
+	IssueID     string               `json:"issue_id"`   // Issue holding the dependency
+	MissingID   string               `json:"missing_id"` // Target that doesn't exist
+	Type        model.DependencyType `json:"type"`
+	Suggestions []string             `json:"suggestions,omitempty"` // Likely intended IDs, best first
+}
+
+// FindDanglingDependencies lists dependencies whose target isn't among
+// issues, each with up to three existing IDs it was probably meant to be
+// (typos, case slips, or a renamed prefix with the same number). Results are
+// sorted by issue, then missing ID.
+func FindDanglingDependencies(issues []model.Issue) []DanglingDependency {
+	ids := make([]string, 0, len(issues))
+	exists := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if !exists[issue.ID] {
+			exists[issue.ID] = true
+			ids = append(ids, issue.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	var result []DanglingDependency
+	seen := make(map[[2]string]bool)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.DependsOnID == "" || exists[dep.DependsOnID] {
+				continue
+			}
+			key := [2]string{issue.ID, dep.DependsOnID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, DanglingDependency{
+				IssueID:     issue.ID,
+				MissingID:   dep.DependsOnID,
+				Type:        dep.Type,
+				Suggestions: suggestIssueIDs(dep.DependsOnID, ids, issue.ID),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].IssueID != result[j].IssueID {
+			return result[i].IssueID < result[j].IssueID
+		}
+		return result[i].MissingID < result[j].MissingID
+	})
+	return result
+}
+
+// suggestIssueIDs ranks existing IDs close to missing, skipping self (an
+// issue can't depend on itself)
+func suggestIssueIDs(missing string, ids []string, self string) []string {
+	lowerMissing := strings.ToLower(missing)
+	_, missingNum, hasNum := cutLast(lowerMissing, "-")
+	// Allow roughly one typo per four characters, at least two
+	maxDist := max(2, len(missing)/4)
+
+	type candidate struct {
+		id    string
+		score int // Lower is better
+	}
+	var candidates []candidate
+	for _, id := range ids {
+		if id == self {
+			continue
+		}
+		lower := strings.ToLower(id)
+		switch {
+		case lower == lowerMissing:
+			candidates = append(candidates, candidate{id, 0})
+		case hasNum && strings.HasSuffix(lower, "-"+missingNum):
+			// Same number under another prefix, e.g. a renamed project
+			candidates = append(candidates, candidate{id, 1})
+		default:
+			if d := editDistance(lowerMissing, lower); d <= maxDist {
+				candidates = append(candidates, candidate{id, 1 + d})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+
+	var out []string
+	for i := 0; i < len(candidates) && i < maxDanglingSuggestions; i++ {
+		out = append(out, candidates[i].id)
+	}
+	return out
+}
+
+// cutLast splits s around the last sep
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// editDistance is the Levenshtein distance between a and b, by rune
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
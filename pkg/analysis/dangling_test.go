@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindDanglingDependencies(t *testing.T) {
+	dep := func(id string) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: model.DepBlocks}
+	}
+	issues := []model.Issue{
+		{ID: "bv-12", Dependencies: []*model.Dependency{dep("bv-1")}},
+		{ID: "bv-3", Dependencies: []*model.Dependency{dep("bv-33")}}, // Never suggested to itself
+		{ID: "bv-120"},
+		{ID: "bv-1"},
+		{ID: "api-7"},
+		{ID: "bv-30", Dependencies: []*model.Dependency{dep("bv-13"), dep("old-7"), dep("bv-13"), dep("zzz-999"), nil}},
+		{ID: "bv-31", Dependencies: []*model.Dependency{dep("BV-1")}},
+	}
+
+	got := FindDanglingDependencies(issues)
+	want := []DanglingDependency{
+		{IssueID: "bv-3", MissingID: "bv-33", Type: model.DepBlocks, Suggestions: []string{"bv-30", "bv-31", "bv-1"}},
+		{IssueID: "bv-30", MissingID: "bv-13", Type: model.DepBlocks, Suggestions: []string{"bv-1", "bv-12", "bv-3"}},
+		{IssueID: "bv-30", MissingID: "old-7", Type: model.DepBlocks, Suggestions: []string{"api-7"}},
+		{IssueID: "bv-30", MissingID: "zzz-999", Type: model.DepBlocks},
+		{IssueID: "bv-31", MissingID: "BV-1", Type: model.DepBlocks, Suggestions: []string{"bv-1", "bv-12", "bv-3"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindDanglingDependencies:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"bv-12", "bv-12", 0},
+		{"bv-12", "bv-21", 2},
+		{"kitten", "sitting", 3},
+		{"héllo", "hello", 1},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -7,8 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/internal/testutil"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
-	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
 )
 
 func TestDefaultDependencySuggestionConfig(t *testing.T) {
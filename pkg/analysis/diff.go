@@ -179,7 +179,7 @@ func CompareSnapshots(from, to *Snapshot) *SnapshotDiff {
 		// unless there are OTHER field changes. For simplicity in this diff, we exclude them from ModifiedIssues
 		// to keep the lists disjoint and clearer for the user.
 		if !isStatusChange {
-			changes := detectChanges(fromIssue, toIssue)
+			changes := DetectChanges(fromIssue, toIssue)
 			if len(changes) > 0 {
 				diff.ModifiedIssues = append(diff.ModifiedIssues, ModifiedIssue{
 					IssueID:  id,
@@ -218,8 +218,9 @@ func CompareSnapshots(from, to *Snapshot) *SnapshotDiff {
 	return diff
 }
 
-// detectChanges identifies what fields changed between two issues
-func detectChanges(from, to model.Issue) []FieldChange {
+// DetectChanges identifies what fields changed between two issues. Long text
+// fields are reported as "(modified)" rather than by value.
+func DetectChanges(from, to model.Issue) []FieldChange {
 	var changes []FieldChange
 
 	if from.Title != to.Title {
@@ -210,7 +210,7 @@ func TestDetectChanges(t *testing.T) {
 		Labels:   []string{"bug", "urgent"},
 	}
 
-	changes := detectChanges(from, to)
+	changes := DetectChanges(from, to)
 
 	// Should detect: title, status, priority, labels
 	if len(changes) != 4 {
@@ -0,0 +1,182 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// diskCacheVersion is bumped whenever the file format or the meaning of a
+// metric changes, so files written by an older bv are ignored
+const diskCacheVersion = 1
+
+// diskCacheKeep is how many analyses a cache directory holds; the oldest
+// beyond that are deleted on save
+const diskCacheKeep = 4
+
+// DiskCacheDir returns the directory analyses are persisted in, .bv/cache
+func DiskCacheDir(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", "cache")
+}
+
+// DiskCache persists complete analyses across sessions, one JSON file per
+// data and config hash, so starting on an unchanged repo skips Phase 2.
+// Unreadable or outdated files count as a miss.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a cache stored in dir, which is created on first save
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+// persistedStats is GraphStats as written to disk
+type persistedStats struct {
+	Version          int                `json:"version"`
+	OutDegree        map[string]int     `json:"out_degree"`
+	InDegree         map[string]int     `json:"in_degree"`
+	TopologicalOrder []string           `json:"topological_order"`
+	Density          float64            `json:"density"`
+	NodeCount        int                `json:"node_count"`
+	EdgeCount        int                `json:"edge_count"`
+	Config           AnalysisConfig     `json:"config"`
+	PageRank         map[string]float64 `json:"pagerank"`
+	Betweenness      map[string]float64 `json:"betweenness"`
+	Eigenvector      map[string]float64 `json:"eigenvector"`
+	Hubs             map[string]float64 `json:"hubs"`
+	Authorities      map[string]float64 `json:"authorities"`
+	CriticalPath     map[string]float64 `json:"critical_path"`
+	CoreNumber       map[string]int     `json:"core_number"`
+	Articulation     []string           `json:"articulation"`
+	Slack            map[string]float64 `json:"slack"`
+	Cycles           [][]string         `json:"cycles"`
+	Status           MetricStatus       `json:"status"`
+}
+
+// path returns the file for key, the data and config hashes joined by "|"
+func (c *DiskCache) path(key string) string {
+	return filepath.Join(c.dir, strings.ReplaceAll(key, "|", "-")+".json")
+}
+
+// Load returns the analysis saved under key, complete with Phase 2
+func (c *DiskCache) Load(key string) (*GraphStats, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var p persistedStats
+	if err := json.Unmarshal(data, &p); err != nil || p.Version != diskCacheVersion {
+		return nil, false
+	}
+
+	stats := &GraphStats{
+		OutDegree:         p.OutDegree,
+		InDegree:          p.InDegree,
+		TopologicalOrder:  p.TopologicalOrder,
+		Density:           p.Density,
+		NodeCount:         p.NodeCount,
+		EdgeCount:         p.EdgeCount,
+		Config:            p.Config,
+		phase2Ready:       true,
+		phase2Done:        make(chan struct{}),
+		pageRank:          p.PageRank,
+		betweenness:       p.Betweenness,
+		eigenvector:       p.Eigenvector,
+		hubs:              p.Hubs,
+		authorities:       p.Authorities,
+		criticalPathScore: p.CriticalPath,
+		coreNumber:        p.CoreNumber,
+		articulation:      make(map[string]bool, len(p.Articulation)),
+		slack:             p.Slack,
+		cycles:            p.Cycles,
+		status:            p.Status,
+	}
+	for _, id := range p.Articulation {
+		stats.articulation[id] = true
+	}
+	close(stats.phase2Done)
+	return stats, true
+}
+
+// Save writes a complete analysis under key, replacing the file atomically
+// so a concurrent session never reads half of it, then prunes old files.
+func (c *DiskCache) Save(key string, stats *GraphStats) error {
+	if !stats.IsPhase2Ready() {
+		return fmt.Errorf("analysis incomplete")
+	}
+	stats.mu.RLock()
+	p := persistedStats{
+		Version:          diskCacheVersion,
+		OutDegree:        stats.OutDegree,
+		InDegree:         stats.InDegree,
+		TopologicalOrder: stats.TopologicalOrder,
+		Density:          stats.Density,
+		NodeCount:        stats.NodeCount,
+		EdgeCount:        stats.EdgeCount,
+		Config:           stats.Config,
+		PageRank:         stats.pageRank,
+		Betweenness:      stats.betweenness,
+		Eigenvector:      stats.eigenvector,
+		Hubs:             stats.hubs,
+		Authorities:      stats.authorities,
+		CriticalPath:     stats.criticalPathScore,
+		CoreNumber:       stats.coreNumber,
+		Slack:            stats.slack,
+		Cycles:           stats.cycles,
+		Status:           stats.status,
+	}
+	for id := range stats.articulation {
+		p.Articulation = append(p.Articulation, id)
+	}
+	sort.Strings(p.Articulation)
+	data, err := json.Marshal(p)
+	stats.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("encoding analysis: %w", err)
+	}
+
+	if err := readonly.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("creating analysis cache: %w", err)
+	}
+	tmp, err := readonly.CreateTemp(c.dir, "analysis-*.tmp")
+	if err != nil {
+		return fmt.Errorf("writing analysis cache: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = readonly.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = readonly.Remove(tmp.Name())
+		return fmt.Errorf("writing analysis cache: %w", err)
+	}
+	c.prune()
+	return nil
+}
+
+// prune deletes all but the diskCacheKeep most recently written analyses
+func (c *DiskCache) prune() {
+	matches, _ := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if len(matches) <= diskCacheKeep {
+		return
+	}
+	modTimes := make(map[string]int64, len(matches))
+	for _, path := range matches {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime().UnixNano()
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return modTimes[matches[i]] > modTimes[matches[j]] })
+	for _, path := range matches[diskCacheKeep:] {
+		_ = readonly.Remove(path)
+	}
+}
@@ -0,0 +1,103 @@
+package analysis_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func diskCacheIssues() []model.Issue {
+	dep := func(id, on string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}}
+	}
+	return []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Dependencies: dep("A", "B")},
+		{ID: "B", Status: model.StatusOpen, Dependencies: dep("B", "C")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: dep("C", "A")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: dep("D", "C")},
+	}
+}
+
+func TestCachedAnalyzer_PersistsAcrossSessions(t *testing.T) {
+	dir := analysis.DiskCacheDir(t.TempDir())
+	issues := diskCacheIssues()
+
+	first := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	first.SetDiskCache(analysis.NewDiskCache(dir))
+	computed := first.AnalyzeAsync(context.Background())
+	computed.WaitForPhase2()
+	if first.WasCacheHit() {
+		t.Fatal("Nothing should be cached yet")
+	}
+	if err := first.Persist(computed); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new session: empty memory cache, same issues
+	second := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	second.SetDiskCache(analysis.NewDiskCache(dir))
+	loaded := second.AnalyzeAsync(context.Background())
+	if !second.WasCacheHit() || !loaded.IsPhase2Ready() {
+		t.Fatalf("Expected a complete analysis from disk (hit %v)", second.WasCacheHit())
+	}
+	if !reflect.DeepEqual(loaded.PageRank(), computed.PageRank()) ||
+		!reflect.DeepEqual(loaded.Cycles(), computed.Cycles()) ||
+		!reflect.DeepEqual(loaded.ArticulationPoints(), computed.ArticulationPoints()) ||
+		!reflect.DeepEqual(loaded.TopologicalOrder, computed.TopologicalOrder) ||
+		loaded.Status() != computed.Status() {
+		t.Error("Loaded analysis differs from the one saved")
+	}
+
+	// Editing an issue changes the key
+	issues[3].Title = "Edited"
+	third := analysis.NewCachedAnalyzer(issues, analysis.NewCache(time.Minute))
+	third.SetDiskCache(analysis.NewDiskCache(dir))
+	third.AnalyzeAsync(context.Background()).WaitForPhase2()
+	if third.WasCacheHit() {
+		t.Error("Edited issues should miss the cache")
+	}
+}
+
+func TestDiskCache_IgnoresBadFilesAndPrunes(t *testing.T) {
+	dir := t.TempDir()
+	cache := analysis.NewDiskCache(dir)
+	stats := analysis.NewAnalyzer(diskCacheIssues()).AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+
+	if err := os.WriteFile(filepath.Join(dir, "bad-key.json"), []byte(`{"version": 0}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Load("bad|key"); ok {
+		t.Error("A file from another version should be ignored")
+	}
+	os.Remove(filepath.Join(dir, "bad-key.json"))
+
+	for i, key := range []string{"k1|c", "k2|c", "k3|c", "k4|c", "k5|c"} {
+		if err := cache.Save(key, stats); err != nil {
+			t.Fatal(err)
+		}
+		mtime := time.Now().Add(time.Duration(i-10) * time.Second)
+		if err := os.Chtimes(filepath.Join(dir, key[:2]+"-c.json"), mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cache.Save("k6|c", stats); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 4 {
+		t.Errorf("Expected 4 analyses kept, got %v", files)
+	}
+	if _, ok := cache.Load("k6|c"); !ok {
+		t.Error("The newest analysis should be kept")
+	}
+	if _, ok := cache.Load("k1|c"); ok {
+		t.Error("The oldest analysis should be pruned")
+	}
+}
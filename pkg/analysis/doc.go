@@ -0,0 +1,38 @@
+// Package analysis computes graph metrics, triage, and plans for a beads
+// backlog. It depends only on pkg/model and pkg/readonly (the --read-only
+// guard around its cache and config writes), so other Go tools can embed
+// bv's analysis without the TUI or the CLI.
+//
+// # Entry points
+//
+// Load issues with pkg/loader (or build []model.Issue yourself), then:
+//
+//   - ComputeTriage / ComputeTriageWithOptions: the ranked recommendations,
+//     quick wins, and blockers behind bv --robot-triage.
+//   - NewAnalyzer(issues).Analyze: PageRank, betweenness, HITS, critical
+//     path, cycles, and density as a GraphStats. AnalyzeAsync returns the
+//     cheap metrics at once and fills in the rest in the background.
+//   - GraphStats.GenerateInsights: bottlenecks, keystones, hubs, and
+//     cycles ready for display.
+//   - Analyzer.GetExecutionPlan: parallel tracks of actionable work.
+//   - Analyzer.GetWavePlan: open work layered into parallel waves, with
+//     durations from the recent close rate.
+//   - Analyzer.Bottlenecks: articulation points ranked by how many issues
+//     removing them cuts off, then by betweenness.
+//   - ComputeLeadCycleTimes: lead and cycle time percentiles of closed
+//     issues, per label, per assignee and per week.
+//   - Analyzer.GenerateRecommendations: priority changes the graph suggests.
+//   - NewSnapshot and CompareSnapshots: what changed between two states of
+//     the backlog; DetectChanges does the same for one issue.
+//   - GenerateAllSuggestions: possible duplicates, missing dependencies,
+//     labels, and cycle warnings.
+//
+// # Stability
+//
+// The identifiers above, the types they return, and the JSON field names
+// of those types are the public API. From v0.11.0 on they only change
+// incompatibly with a major version bump; new fields and functions may be
+// added in minor releases. Identifiers ending in ForTest exist for bv's own
+// tests and carry no compatibility promise. Pieces that only the bv binary
+// needs live under internal/.
+package analysis
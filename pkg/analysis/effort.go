@@ -0,0 +1,168 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IssueEffort is the work recorded against an issue in git history
+type IssueEffort struct {
+	Commits      int `json:"commits"`
+	LinesChanged int `json:"lines_changed"`
+}
+
+// Add returns the sum of two efforts
+func (e IssueEffort) Add(o IssueEffort) IssueEffort {
+	return IssueEffort{Commits: e.Commits + o.Commits, LinesChanged: e.LinesChanged + o.LinesChanged}
+}
+
+// IsZero returns true if no work is recorded
+func (e IssueEffort) IsZero() bool {
+	return e.Commits == 0 && e.LinesChanged == 0
+}
+
+// ChainEffort estimates how much work remains along dependency chains.
+// An open issue is expected to take the median effort of closed issues of
+// its type, less what has already been committed against it; closed issues
+// need nothing more. Chains follow dependents, like impact depth.
+type ChainEffort struct {
+	// Remaining is each open issue's own expected remaining effort
+	Remaining map[string]IssueEffort
+	// Chain is the remaining effort of the heaviest chain from an issue
+	// through the issues waiting on it, the issue itself included
+	Chain map[string]IssueEffort
+	// next is the dependent continuing the heaviest chain
+	next map[string]string
+	// Samples is the number of closed issues with history behind the estimates
+	Samples int
+}
+
+// ComputeChainEffort rolls observed per-issue effort (from correlated
+// commits) up along dependency chains
+func ComputeChainEffort(issues []model.Issue, observed map[string]IssueEffort) ChainEffort {
+	ce := ChainEffort{
+		Remaining: make(map[string]IssueEffort),
+		Chain:     make(map[string]IssueEffort),
+		next:      make(map[string]string),
+	}
+
+	// Typical effort per type, from closed issues with history
+	byType := make(map[model.IssueType][]IssueEffort)
+	var all []IssueEffort
+	for _, issue := range issues {
+		if issue.Status != model.StatusClosed {
+			continue
+		}
+		if e := observed[issue.ID]; !e.IsZero() {
+			byType[issue.IssueType] = append(byType[issue.IssueType], e)
+			all = append(all, e)
+		}
+	}
+	ce.Samples = len(all)
+	overall := medianEffort(all)
+
+	open := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		open[issue.ID] = true
+		typical := overall
+		if samples := byType[issue.IssueType]; len(samples) > 0 {
+			typical = medianEffort(samples)
+		}
+		done := observed[issue.ID]
+		ce.Remaining[issue.ID] = IssueEffort{
+			Commits:      max(typical.Commits-done.Commits, 0),
+			LinesChanged: max(typical.LinesChanged-done.LinesChanged, 0),
+		}
+	}
+
+	// Open issues waiting on each issue
+	dependents := make(map[string][]string)
+	for _, issue := range issues {
+		if !open[issue.ID] {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepBlocks && open[dep.DependsOnID] {
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+	for id := range dependents {
+		sort.Strings(dependents[id])
+	}
+
+	// Memoized longest path; an edge back into the current path (a cycle)
+	// contributes nothing
+	visiting := make(map[string]bool)
+	var walk func(id string) IssueEffort
+	walk = func(id string) IssueEffort {
+		if e, ok := ce.Chain[id]; ok {
+			return e
+		}
+		if visiting[id] {
+			return IssueEffort{}
+		}
+		visiting[id] = true
+		var heaviest IssueEffort
+		for _, dep := range dependents[id] {
+			e := walk(dep)
+			if e.LinesChanged > heaviest.LinesChanged || (e.LinesChanged == heaviest.LinesChanged && e.Commits > heaviest.Commits) {
+				heaviest = e
+				ce.next[id] = dep
+			}
+		}
+		visiting[id] = false
+		total := ce.Remaining[id].Add(heaviest)
+		ce.Chain[id] = total
+		return total
+	}
+	for _, issue := range issues {
+		if open[issue.ID] {
+			walk(issue.ID)
+		}
+	}
+	return ce
+}
+
+// HeaviestChain returns the issues on the heaviest remaining chain starting
+// at id, id first
+func (ce ChainEffort) HeaviestChain(id string) []string {
+	if _, ok := ce.Chain[id]; !ok {
+		return nil
+	}
+	chain := []string{id}
+	seen := map[string]bool{id: true}
+	for next, ok := ce.next[id]; ok && !seen[next]; next, ok = ce.next[next] {
+		chain = append(chain, next)
+		seen[next] = true
+	}
+	return chain
+}
+
+// medianEffort takes the median commits and lines separately
+func medianEffort(samples []IssueEffort) IssueEffort {
+	if len(samples) == 0 {
+		return IssueEffort{}
+	}
+	commits := make([]int, len(samples))
+	lines := make([]int, len(samples))
+	for i, s := range samples {
+		commits[i] = s.Commits
+		lines[i] = s.LinesChanged
+	}
+	sort.Ints(commits)
+	sort.Ints(lines)
+	return IssueEffort{Commits: medianInt(commits), LinesChanged: medianInt(lines)}
+}
+
+func medianInt(sorted []int) int {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
@@ -0,0 +1,73 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func blocks(id, on string) []*model.Dependency {
+	return []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}}
+}
+
+func TestComputeChainEffort(t *testing.T) {
+	issues := []model.Issue{
+		// History: bugs take ~100 lines, features ~400
+		{ID: "old-bug-1", Status: model.StatusClosed, IssueType: model.TypeBug},
+		{ID: "old-bug-2", Status: model.StatusClosed, IssueType: model.TypeBug},
+		{ID: "old-feat", Status: model.StatusClosed, IssueType: model.TypeFeature},
+
+		// schema <- api <- ui, and schema <- fix (a light branch)
+		{ID: "schema", Status: model.StatusOpen, IssueType: model.TypeFeature},
+		{ID: "api", Status: model.StatusInProgress, IssueType: model.TypeFeature, Dependencies: blocks("api", "schema")},
+		{ID: "ui", Status: model.StatusOpen, IssueType: model.TypeFeature, Dependencies: blocks("ui", "api")},
+		{ID: "fix", Status: model.StatusOpen, IssueType: model.TypeBug, Dependencies: blocks("fix", "schema")},
+		{ID: "chore", Status: model.StatusOpen, IssueType: model.TypeChore},
+		{ID: "done", Status: model.StatusClosed, IssueType: model.TypeTask},
+	}
+	observed := map[string]IssueEffort{
+		"old-bug-1": {Commits: 1, LinesChanged: 80},
+		"old-bug-2": {Commits: 3, LinesChanged: 120},
+		"old-feat":  {Commits: 5, LinesChanged: 400},
+		"api":       {Commits: 4, LinesChanged: 300}, // partly done
+	}
+
+	ce := ComputeChainEffort(issues, observed)
+	if ce.Samples != 3 {
+		t.Errorf("Samples = %d, want 3", ce.Samples)
+	}
+
+	checks := map[string]IssueEffort{
+		"ui":     {Commits: 5, LinesChanged: 400},
+		"api":    {Commits: 1 + 5, LinesChanged: 100 + 400},
+		"schema": {Commits: 5 + 1 + 5, LinesChanged: 400 + 100 + 400},
+		"fix":    {Commits: 2, LinesChanged: 100},
+		"chore":  {Commits: 3, LinesChanged: 120}, // no chores closed: overall median
+	}
+	for id, want := range checks {
+		if got := ce.Chain[id]; got != want {
+			t.Errorf("Chain[%s] = %+v, want %+v", id, got, want)
+		}
+	}
+	if _, ok := ce.Chain["done"]; ok {
+		t.Error("closed issues have no remaining chain")
+	}
+	if got := strings.Join(ce.HeaviestChain("schema"), " "); got != "schema api ui" {
+		t.Errorf("HeaviestChain = %s", got)
+	}
+}
+
+func TestComputeChainEffort_CycleAndNoHistory(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Dependencies: blocks("a", "b")},
+		{ID: "b", Status: model.StatusOpen, Dependencies: blocks("b", "a")},
+	}
+	ce := ComputeChainEffort(issues, nil)
+	if ce.Samples != 0 || !ce.Chain["a"].IsZero() {
+		t.Errorf("expected no estimate without history, got %+v", ce.Chain)
+	}
+	if chain := ce.HeaviestChain("a"); len(chain) > 2 {
+		t.Errorf("a cycle must not loop, got %v", chain)
+	}
+}
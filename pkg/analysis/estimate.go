@@ -0,0 +1,138 @@
+package analysis
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// EstimateUnit is what a set of issues is estimated in
+type EstimateUnit string
+
+const (
+	EstimatePoints EstimateUnit = "points" // Issue.Estimate
+	EstimateHours  EstimateUnit = "hours"  // Issue.EstimatedMinutes / 60
+)
+
+// EstimateUnitFor picks the unit the issues are estimated in: story points if
+// any issue has them, else hours if any has estimated minutes, else "".
+// Points win because teams that point their work rarely time it as well.
+func EstimateUnitFor(issues []model.Issue) EstimateUnit {
+	unit := EstimateUnit("")
+	for i := range issues {
+		switch issueEstimateUnit(&issues[i]) {
+		case EstimatePoints:
+			return EstimatePoints
+		case EstimateHours:
+			unit = EstimateHours
+		}
+	}
+	return unit
+}
+
+// estimateUnit is EstimateUnitFor over the analyzer's issues
+func (a *Analyzer) estimateUnit() EstimateUnit {
+	unit := EstimateUnit("")
+	for _, issue := range a.issueMap {
+		switch issueEstimateUnit(&issue) {
+		case EstimatePoints:
+			return EstimatePoints
+		case EstimateHours:
+			unit = EstimateHours
+		}
+	}
+	return unit
+}
+
+// issueEstimateUnit returns the strongest unit the issue is estimated in
+func issueEstimateUnit(issue *model.Issue) EstimateUnit {
+	switch {
+	case issue.Estimate != nil && *issue.Estimate >= 0:
+		return EstimatePoints
+	case issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0:
+		return EstimateHours
+	}
+	return ""
+}
+
+// IssueEstimate returns the issue's estimate in unit, or false if it has none
+func IssueEstimate(issue *model.Issue, unit EstimateUnit) (float64, bool) {
+	switch unit {
+	case EstimatePoints:
+		if issue.Estimate != nil && *issue.Estimate >= 0 {
+			return *issue.Estimate, true
+		}
+	case EstimateHours:
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			return float64(*issue.EstimatedMinutes) / 60, true
+		}
+	}
+	return 0, false
+}
+
+// FormatEstimate renders an estimate total, e.g. "13 pts" or "6.5h"
+func FormatEstimate(v float64, unit EstimateUnit) string {
+	n := strconv.FormatFloat(v, 'f', 1, 64)
+	if v == float64(int64(v)) {
+		n = strconv.FormatInt(int64(v), 10)
+	}
+	switch unit {
+	case EstimatePoints:
+		if v == 1 {
+			return n + " pt"
+		}
+		return n + " pts"
+	case EstimateHours:
+		return n + "h"
+	}
+	return n
+}
+
+// EstimateTotals sums the estimates of a set of issues
+type EstimateTotals struct {
+	Unit        EstimateUnit `json:"unit"`
+	Total       float64      `json:"total"`
+	Completed   float64      `json:"completed"`
+	Remaining   float64      `json:"remaining"`
+	Unestimated int          `json:"unestimated"` // Issues with no estimate in Unit; they count as zero
+}
+
+// TotalEstimates totals the estimates of issues in unit, split into closed
+// and remaining work
+func TotalEstimates(issues []model.Issue, unit EstimateUnit) EstimateTotals {
+	totals := EstimateTotals{Unit: unit}
+	for i := range issues {
+		estimate, ok := IssueEstimate(&issues[i], unit)
+		if !ok {
+			totals.Unestimated++
+			continue
+		}
+		totals.Total += estimate
+		if issues[i].Status.IsClosed() {
+			totals.Completed += estimate
+		} else {
+			totals.Remaining += estimate
+		}
+	}
+	return totals
+}
+
+// medianEstimate returns the median estimate of the open issues in unit, or 0
+// if none is estimated
+func (a *Analyzer) medianEstimate(unit EstimateUnit) float64 {
+	var estimates []float64
+	for _, issue := range a.issueMap {
+		if issue.Status.IsClosed() {
+			continue
+		}
+		if estimate, ok := IssueEstimate(&issue, unit); ok && estimate > 0 {
+			estimates = append(estimates, estimate)
+		}
+	}
+	if len(estimates) == 0 {
+		return 0
+	}
+	sort.Float64s(estimates)
+	return estimates[len(estimates)/2]
+}
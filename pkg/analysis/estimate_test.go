@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestEstimateUnitAndTotals(t *testing.T) {
+	pts := func(v float64) *float64 { return &v }
+	mins := func(v int) *int { return &v }
+
+	timed := []model.Issue{
+		{ID: "a", Status: model.StatusClosed, EstimatedMinutes: mins(90)},
+		{ID: "b", Status: model.StatusOpen, EstimatedMinutes: mins(30)},
+		{ID: "c", Status: model.StatusOpen},
+	}
+	if got := EstimateUnitFor(timed); got != EstimateHours {
+		t.Fatalf("expected hours, got %q", got)
+	}
+	totals := TotalEstimates(timed, EstimateHours)
+	if totals.Total != 2 || totals.Completed != 1.5 || totals.Remaining != 0.5 || totals.Unestimated != 1 {
+		t.Errorf("unexpected hour totals %+v", totals)
+	}
+
+	pointed := append(timed, model.Issue{ID: "d", Status: model.StatusOpen, Estimate: pts(5)})
+	if got := EstimateUnitFor(pointed); got != EstimatePoints {
+		t.Fatalf("expected points to win over hours, got %q", got)
+	}
+	if got := EstimateUnitFor([]model.Issue{{ID: "x"}}); got != "" {
+		t.Errorf("expected no unit without estimates, got %q", got)
+	}
+
+	for _, tt := range []struct {
+		v    float64
+		unit EstimateUnit
+		want string
+	}{
+		{13, EstimatePoints, "13 pts"},
+		{1, EstimatePoints, "1 pt"},
+		{6.5, EstimateHours, "6.5h"},
+	} {
+		if got := FormatEstimate(tt.v, tt.unit); got != tt.want {
+			t.Errorf("FormatEstimate(%v, %q) = %q, want %q", tt.v, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestComputeTimeline_CriticalPathEstimate(t *testing.T) {
+	pts := func(v float64) *float64 { return &v }
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "a", Title: "A", Status: model.StatusOpen, CreatedAt: now, Estimate: pts(3)},
+		{ID: "b", Title: "B", Status: model.StatusOpen, CreatedAt: now, Estimate: pts(5),
+			Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepBlocks}}},
+		{ID: "c", Title: "C", Status: model.StatusOpen, CreatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "c", DependsOnID: "b", Type: model.DepBlocks}}},
+	}
+
+	tl := NewAnalyzer(issues).ComputeTimeline(now)
+	if len(tl.CriticalPath) != 3 {
+		t.Fatalf("expected a 3-issue critical path, got %v", tl.CriticalPath)
+	}
+	if tl.CriticalPathEstimate != 8 || tl.EstimateUnit != EstimatePoints {
+		t.Errorf("expected 8 points on the critical path, got %v %q", tl.CriticalPathEstimate, tl.EstimateUnit)
+	}
+}
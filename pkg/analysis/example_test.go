@@ -0,0 +1,42 @@
+package analysis_test
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// A bot can rank a backlog the same way bv --robot-triage does
+func ExampleComputeTriage() {
+	issues := []model.Issue{
+		{ID: "api", Title: "Design the API", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask},
+		{ID: "client", Title: "Write the client", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "client", DependsOnID: "api", Type: model.DepBlocks}}},
+		{ID: "docs", Title: "Document the client", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "docs", DependsOnID: "client", Type: model.DepBlocks}}},
+	}
+
+	triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{WaitForPhase2: true})
+	fmt.Println("actionable:", triage.QuickRef.ActionableCount, "blocked:", triage.QuickRef.BlockedCount)
+	for _, b := range triage.BlockersToClear {
+		fmt.Println("blocker:", b.ID, "unblocks", b.UnblocksIDs, "actionable:", b.Actionable)
+	}
+	// Output:
+	// actionable: 1 blocked: 2
+	// blocker: api unblocks [client] actionable: true
+	// blocker: client unblocks [docs] actionable: false
+}
+
+// The graph metrics are available on their own
+func ExampleAnalyzer_Analyze() {
+	issues := []model.Issue{
+		{ID: "a", Title: "A", Status: model.StatusOpen},
+		{ID: "b", Title: "B", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepBlocks}}},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	fmt.Println("order:", stats.TopologicalOrder, "cycles:", len(stats.Cycles()))
+	// Output:
+	// order: [a b] cycles: 0
+}
@@ -0,0 +1,7 @@
+package analysis
+
+// GenerateTrackIDForTest exposes generateTrackID to the analysis_test
+// package without adding it to the public API
+func GenerateTrackIDForTest(n int) string {
+	return generateTrackID(n)
+}
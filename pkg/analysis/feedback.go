@@ -9,6 +9,8 @@ import (
 	"path/filepath"
 	"sync"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // FeedbackFile is the name of the feedback sidecar file
@@ -114,7 +116,7 @@ func (f *FeedbackData) Save(beadsDir string) error {
 	}
 
 	path := filepath.Join(beadsDir, FeedbackFile)
-	if err := os.WriteFile(path, data, 0644); err != nil {
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
 		return fmt.Errorf("failed to write feedback file: %w", err)
 	}
 
@@ -0,0 +1,359 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// FocusRotationFilename is the per-project file under .bv/ that remembers
+// which labels were suggested as weekly focus, so suggestions rotate
+const FocusRotationFilename = "focus_rotation.json"
+
+// maxFocusHistory bounds the remembered weeks
+const maxFocusHistory = 26
+
+// FocusRotationPath returns the focus rotation history path for a project
+func FocusRotationPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", FocusRotationFilename)
+}
+
+// FocusWeek records the labels suggested for one week
+type FocusWeek struct {
+	Week   string    `json:"week"`  // ISO week, e.g. 2025-W23
+	Start  time.Time `json:"start"` // Monday 00:00 UTC
+	Labels []string  `json:"labels"`
+}
+
+// FocusRotationHistory is the list of past focus weeks, oldest first
+type FocusRotationHistory struct {
+	Weeks []FocusWeek `json:"weeks"`
+}
+
+// LoadFocusRotation reads the focus history from .bv/focus_rotation.json.
+// Returns an empty history if the file doesn't exist.
+func LoadFocusRotation(projectDir string) (FocusRotationHistory, error) {
+	var history FocusRotationHistory
+	data, err := os.ReadFile(FocusRotationPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return history, nil
+		}
+		return history, fmt.Errorf("reading focus rotation: %w", err)
+	}
+	if err := json.Unmarshal(data, &history); err != nil {
+		return FocusRotationHistory{}, fmt.Errorf("parsing focus rotation: %w", err)
+	}
+	return history, nil
+}
+
+// SaveFocusRotation writes the focus history to .bv/focus_rotation.json
+func SaveFocusRotation(projectDir string, history FocusRotationHistory) error {
+	path := FocusRotationPath(projectDir)
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating focus rotation directory: %w", err)
+	}
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding focus rotation: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing focus rotation: %w", err)
+	}
+	return nil
+}
+
+// Record stores the rotation's labels as its week's focus, replacing an
+// earlier record of the same week
+func (h *FocusRotationHistory) Record(r FocusRotation) {
+	week := FocusWeek{Week: r.Week, Start: r.WeekStart}
+	for _, pick := range r.Picks {
+		week.Labels = append(week.Labels, pick.Label)
+	}
+
+	weeks := h.Weeks[:0:0]
+	for _, w := range h.Weeks {
+		if !w.Start.Equal(week.Start) {
+			weeks = append(weeks, w)
+		}
+	}
+	weeks = append(weeks, week)
+	sort.SliceStable(weeks, func(i, j int) bool { return weeks[i].Start.Before(weeks[j].Start) })
+	if len(weeks) > maxFocusHistory {
+		weeks = weeks[len(weeks)-maxFocusHistory:]
+	}
+	h.Weeks = weeks
+}
+
+// FocusRotationOptions controls ComputeFocusRotation
+type FocusRotationOptions struct {
+	Labels         int // Labels to focus on (default 3)
+	IssuesPerLabel int // Starting issues per label (default 3)
+	CooldownWeeks  int // Weeks before a focused label is suggested again (0 = no cooldown)
+}
+
+// DefaultFocusRotationOptions returns the weekly defaults
+func DefaultFocusRotationOptions() FocusRotationOptions {
+	return FocusRotationOptions{Labels: 3, IssuesPerLabel: 3, CooldownWeeks: 2}
+}
+
+// FocusIssue is an open, unblocked issue suggested as a starting point
+// within a focus label
+type FocusIssue struct {
+	ID       string       `json:"id"`
+	Title    string       `json:"title"`
+	Status   model.Status `json:"status"`
+	Priority int          `json:"priority"`
+	Unblocks int          `json:"unblocks"`
+	Reason   string       `json:"reason"`
+}
+
+// FocusPick is one label chosen for the week
+type FocusPick struct {
+	Label          string       `json:"label"`
+	AttentionRank  int          `json:"attention_rank"`
+	AttentionScore float64      `json:"attention_score"`
+	Reason         string       `json:"reason"`
+	LastFocused    string       `json:"last_focused,omitempty"` // ISO week it was last suggested
+	StartWith      []FocusIssue `json:"start_with"`
+}
+
+// FocusSkip is a label that ranked high enough but was focused recently
+type FocusSkip struct {
+	Label         string `json:"label"`
+	AttentionRank int    `json:"attention_rank"`
+	LastFocused   string `json:"last_focused"`
+}
+
+// FocusRotation is the suggested focus for one week
+type FocusRotation struct {
+	Week        string      `json:"week"`
+	WeekStart   time.Time   `json:"week_start"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Carried     bool        `json:"carried"` // Labels reused from earlier this week
+	Picks       []FocusPick `json:"picks"`
+	Skipped     []FocusSkip `json:"skipped,omitempty"`
+}
+
+// weekStart returns the Monday 00:00 UTC of t's week
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+func isoWeekName(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// ComputeFocusRotation picks the labels most needing attention this week,
+// skipping labels suggested within the cooldown so the focus rotates. A
+// week already in the history keeps its labels; only the starting issues
+// are refreshed. Labels without open issues are never picked.
+func ComputeFocusRotation(issues []model.Issue, attention LabelAttentionResult, history FocusRotationHistory, opts FocusRotationOptions, now time.Time) FocusRotation {
+	defaults := DefaultFocusRotationOptions()
+	if opts.Labels <= 0 {
+		opts.Labels = defaults.Labels
+	}
+	if opts.IssuesPerLabel <= 0 {
+		opts.IssuesPerLabel = defaults.IssuesPerLabel
+	}
+	if opts.CooldownWeeks < 0 {
+		opts.CooldownWeeks = 0
+	}
+
+	start := weekStart(now)
+	result := FocusRotation{
+		Week:        isoWeekName(start),
+		WeekStart:   start,
+		GeneratedAt: now,
+		Picks:       []FocusPick{},
+	}
+
+	// Most recent earlier week each label was focused
+	lastFocused := make(map[string]FocusWeek)
+	var thisWeek *FocusWeek
+	for i, w := range history.Weeks {
+		if w.Start.Equal(start) {
+			thisWeek = &history.Weeks[i]
+			continue
+		}
+		if !w.Start.Before(start) {
+			continue
+		}
+		for _, label := range w.Labels {
+			if prev, ok := lastFocused[label]; !ok || w.Start.After(prev.Start) {
+				lastFocused[label] = w
+			}
+		}
+	}
+
+	byLabel := make(map[string]LabelAttentionScore, len(attention.Labels))
+	var candidates []LabelAttentionScore
+	for _, score := range attention.Labels {
+		if score.OpenCount == 0 {
+			continue
+		}
+		byLabel[score.Label] = score
+		candidates = append(candidates, score)
+	}
+
+	var chosen []LabelAttentionScore
+	if thisWeek != nil {
+		result.Carried = true
+		for _, label := range thisWeek.Labels {
+			if score, ok := byLabel[label]; ok {
+				chosen = append(chosen, score)
+			}
+		}
+	} else {
+		var cooling []LabelAttentionScore
+		for _, score := range candidates {
+			if len(chosen) == opts.Labels {
+				break
+			}
+			if w, ok := lastFocused[score.Label]; ok && int(start.Sub(w.Start).Hours()/(24*7)) <= opts.CooldownWeeks {
+				cooling = append(cooling, score)
+				continue
+			}
+			chosen = append(chosen, score)
+		}
+
+		// Too few fresh labels: fall back to the longest-rested ones
+		sort.SliceStable(cooling, func(i, j int) bool {
+			return lastFocused[cooling[i].Label].Start.Before(lastFocused[cooling[j].Label].Start)
+		})
+		for len(chosen) < opts.Labels && len(cooling) > 0 {
+			chosen = append(chosen, cooling[0])
+			cooling = cooling[1:]
+		}
+		sort.SliceStable(cooling, func(i, j int) bool { return cooling[i].Rank < cooling[j].Rank })
+		for _, score := range cooling {
+			result.Skipped = append(result.Skipped, FocusSkip{
+				Label:         score.Label,
+				AttentionRank: score.Rank,
+				LastFocused:   lastFocused[score.Label].Week,
+			})
+		}
+	}
+
+	starts := focusStartingIssues(issues, now)
+	for _, score := range chosen {
+		pick := FocusPick{
+			Label:          score.Label,
+			AttentionRank:  score.Rank,
+			AttentionScore: score.AttentionScore,
+			Reason:         score.Reason(),
+			LastFocused:    lastFocused[score.Label].Week,
+			StartWith:      []FocusIssue{},
+		}
+		for _, fi := range starts {
+			if len(pick.StartWith) == opts.IssuesPerLabel {
+				break
+			}
+			if hasLabel(fi.labels, score.Label) {
+				pick.StartWith = append(pick.StartWith, fi.FocusIssue)
+			}
+		}
+		result.Picks = append(result.Picks, pick)
+	}
+	return result
+}
+
+type labeledFocusIssue struct {
+	FocusIssue
+	labels  []string
+	updated time.Time
+}
+
+// focusStartingIssues ranks the open, unblocked issues as places to start:
+// by how much open work each unblocks, then priority, then the longest
+// untouched
+func focusStartingIssues(issues []model.Issue, now time.Time) []labeledFocusIssue {
+	open := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if issue.Status != model.StatusClosed {
+			open[issue.ID] = true
+		}
+	}
+	unblocks := make(map[string]int)
+	for _, issue := range issues {
+		if !open[issue.ID] {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepBlocks && open[dep.DependsOnID] {
+				unblocks[dep.DependsOnID]++
+			}
+		}
+	}
+
+	var ranked []labeledFocusIssue
+	for _, issue := range issues {
+		if !open[issue.ID] || len(issue.Labels) == 0 {
+			continue
+		}
+		if isBlockedByOpen(issue, open) {
+			continue
+		}
+
+		var reasons []string
+		if n := unblocks[issue.ID]; n > 0 {
+			reasons = append(reasons, fmt.Sprintf("unblocks %d", n))
+		}
+		if issue.Status == model.StatusInProgress {
+			reasons = append(reasons, "in progress")
+		}
+		if !issue.UpdatedAt.IsZero() {
+			if days := int(now.Sub(issue.UpdatedAt).Hours() / 24); days >= 14 {
+				reasons = append(reasons, fmt.Sprintf("untouched %dd", days))
+			}
+		}
+		reasons = append(reasons, fmt.Sprintf("P%d", issue.Priority))
+
+		ranked = append(ranked, labeledFocusIssue{
+			FocusIssue: FocusIssue{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Status:   issue.Status,
+				Priority: issue.Priority,
+				Unblocks: unblocks[issue.ID],
+				Reason:   strings.Join(reasons, ", "),
+			},
+			labels:  issue.Labels,
+			updated: issue.UpdatedAt,
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Unblocks != b.Unblocks {
+			return a.Unblocks > b.Unblocks
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		if !a.updated.Equal(b.updated) {
+			return a.updated.Before(b.updated)
+		}
+		return a.ID < b.ID
+	})
+	return ranked
+}
+
+func isBlockedByOpen(issue model.Issue, open map[string]bool) bool {
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type == model.DepBlocks && open[dep.DependsOnID] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// focusTestIssues gives each label a root issue blocking two others, so
+// every label has the same attention score and ranks alphabetically
+func focusTestIssues(labels ...string) []model.Issue {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var issues []model.Issue
+	for _, label := range labels {
+		root := label + "-root"
+		issues = append(issues, model.Issue{ID: root, Title: "Root of " + label, Status: model.StatusOpen, Priority: 2, Labels: []string{label}, CreatedAt: created, UpdatedAt: created})
+		for _, leaf := range []string{"-a", "-b"} {
+			issues = append(issues, model.Issue{ID: label + leaf, Title: label + leaf, Status: model.StatusOpen, Priority: 1, Labels: []string{label}, CreatedAt: created, UpdatedAt: created,
+				Dependencies: []*model.Dependency{{IssueID: label + leaf, DependsOnID: root, Type: model.DepBlocks}}})
+		}
+	}
+	return issues
+}
+
+func focusLabels(r FocusRotation) string {
+	var labels []string
+	for _, p := range r.Picks {
+		labels = append(labels, p.Label)
+	}
+	return strings.Join(labels, ",")
+}
+
+func TestComputeFocusRotation_Rotates(t *testing.T) {
+	issues := focusTestIssues("api", "db", "docs", "ops", "ui")
+	now := time.Date(2025, 6, 4, 15, 0, 0, 0, time.UTC) // Wednesday of 2025-W23
+	attention := ComputeLabelAttentionScores(issues, DefaultLabelHealthConfig(), now)
+	opts := FocusRotationOptions{Labels: 2, CooldownWeeks: 1}
+
+	var history FocusRotationHistory
+	week1 := ComputeFocusRotation(issues, attention, history, opts, now)
+	if week1.Week != "2025-W23" || !week1.WeekStart.Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("week = %s from %v", week1.Week, week1.WeekStart)
+	}
+	if got := focusLabels(week1); got != "api,db" {
+		t.Fatalf("week 1 focus = %s", got)
+	}
+	pick := week1.Picks[0]
+	if len(pick.StartWith) != 1 || pick.StartWith[0].ID != "api-root" || pick.StartWith[0].Unblocks != 2 {
+		t.Errorf("expected only the unblocked root to start with, got %+v", pick.StartWith)
+	}
+	history.Record(week1)
+
+	// Later the same week the labels stay put
+	again := ComputeFocusRotation(issues, attention, history, opts, now.Add(48*time.Hour))
+	if !again.Carried || focusLabels(again) != "api,db" {
+		t.Errorf("same week should keep its focus, got %s carried=%v", focusLabels(again), again.Carried)
+	}
+
+	// Next week the recently focused labels rotate out
+	week2 := ComputeFocusRotation(issues, attention, history, opts, now.Add(7*24*time.Hour))
+	if got := focusLabels(week2); got != "docs,ops" {
+		t.Errorf("week 2 focus = %s", got)
+	}
+	if len(week2.Skipped) != 2 || week2.Skipped[0].Label != "api" || week2.Skipped[0].LastFocused != "2025-W23" {
+		t.Errorf("expected api and db skipped, got %+v", week2.Skipped)
+	}
+	history.Record(week2)
+
+	// Two weeks on, the cooldown of one week has passed for api and db
+	week3 := ComputeFocusRotation(issues, attention, history, opts, now.Add(14*24*time.Hour))
+	if got := focusLabels(week3); got != "api,db" {
+		t.Errorf("week 3 focus = %s", got)
+	}
+	if week3.Picks[0].LastFocused != "2025-W23" {
+		t.Errorf("expected the last focus week, got %q", week3.Picks[0].LastFocused)
+	}
+}
+
+func TestComputeFocusRotation_FallsBackToLongestRested(t *testing.T) {
+	issues := focusTestIssues("api", "db")
+	now := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	attention := ComputeLabelAttentionScores(issues, DefaultLabelHealthConfig(), now)
+	history := FocusRotationHistory{Weeks: []FocusWeek{
+		{Week: "2025-W21", Start: time.Date(2025, 5, 19, 0, 0, 0, 0, time.UTC), Labels: []string{"db"}},
+		{Week: "2025-W22", Start: time.Date(2025, 5, 26, 0, 0, 0, 0, time.UTC), Labels: []string{"api"}},
+	}}
+
+	r := ComputeFocusRotation(issues, attention, history, FocusRotationOptions{Labels: 1, CooldownWeeks: 2}, now)
+	if got := focusLabels(r); got != "db" {
+		t.Errorf("expected the longest-rested label, got %s", got)
+	}
+	if len(r.Skipped) != 1 || r.Skipped[0].Label != "api" {
+		t.Errorf("skipped = %+v", r.Skipped)
+	}
+}
+
+func TestFocusRotationHistory_RecordAndPersist(t *testing.T) {
+	dir := t.TempDir()
+	history, err := LoadFocusRotation(dir)
+	if err != nil || len(history.Weeks) != 0 {
+		t.Fatalf("expected an empty history, got %+v, %v", history, err)
+	}
+
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxFocusHistory+3; i++ {
+		ws := start.AddDate(0, 0, 7*i)
+		history.Record(FocusRotation{Week: isoWeekName(ws), WeekStart: ws, Picks: []FocusPick{{Label: "api"}}})
+	}
+	last := start.AddDate(0, 0, 7*(maxFocusHistory+2))
+	history.Record(FocusRotation{Week: isoWeekName(last), WeekStart: last, Picks: []FocusPick{{Label: "ui"}}})
+	if len(history.Weeks) != maxFocusHistory {
+		t.Fatalf("expected the history capped at %d weeks, got %d", maxFocusHistory, len(history.Weeks))
+	}
+	if got := history.Weeks[len(history.Weeks)-1].Labels; len(got) != 1 || got[0] != "ui" {
+		t.Errorf("re-recording a week should replace it, got %v", got)
+	}
+
+	if err := SaveFocusRotation(dir, history); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadFocusRotation(dir)
+	if err != nil || len(loaded.Weeks) != maxFocusHistory || !loaded.Weeks[0].Start.Equal(history.Weeks[0].Start) {
+		t.Errorf("round trip lost data: %+v, %v", loaded.Weeks[0], err)
+	}
+}
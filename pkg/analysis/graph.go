@@ -401,6 +401,7 @@ type Analyzer struct {
 	nodeToID map[int64]string
 	issueMap map[string]model.Issue
 	config   *AnalysisConfig // Optional custom config, nil means use size-based defaults
+	progress func(done, total int)
 }
 
 // SetConfig sets a custom analysis configuration.
@@ -409,6 +410,17 @@ func (a *Analyzer) SetConfig(config *AnalysisConfig) {
 	a.config = config
 }
 
+// Phase2Steps is the number of Phase 2 steps reported to a progress callback:
+// PageRank, betweenness, eigenvector, HITS, critical path, cycles, and the
+// k-core/articulation/slack signals. Skipped metrics still count as a step.
+const Phase2Steps = 7
+
+// SetProgress sets a callback that Phase 2 calls, from its own goroutine,
+// as each step finishes (done of Phase2Steps). Pass nil to stop reporting.
+func (a *Analyzer) SetProgress(progress func(done, total int)) {
+	a.progress = progress
+}
+
 func NewAnalyzer(issues []model.Issue) *Analyzer {
 	g := simple.NewDirectedGraph()
 	// Pre-allocate maps for efficiency
@@ -469,24 +481,42 @@ func NewAnalyzer(issues []model.Issue) *Analyzer {
 // If SetConfig was called, uses that config. Otherwise uses ConfigForSize() to
 // automatically select appropriate algorithms based on graph size.
 func (a *Analyzer) AnalyzeAsync(ctx context.Context) *GraphStats {
-	var config AnalysisConfig
+	return a.AnalyzeAsyncWithConfig(ctx, a.analysisConfig())
+}
+
+// analysisConfig returns the config set with SetConfig, or the size-based
+// default for this graph
+func (a *Analyzer) analysisConfig() AnalysisConfig {
 	if a.config != nil {
-		config = *a.config
-	} else {
-		nodeCount := len(a.issueMap)
-		edgeCount := a.g.Edges().Len()
-		config = ConfigForSize(nodeCount, edgeCount)
+		return *a.config
 	}
-	return a.AnalyzeAsyncWithConfig(ctx, config)
+	return ConfigForSize(len(a.issueMap), a.g.Edges().Len())
 }
 
 // AnalyzeAsyncWithConfig performs graph analysis with a custom configuration.
 // This allows callers to override the default size-based algorithm selection.
 func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisConfig) *GraphStats {
+	stats, empty := a.newGraphStats(config)
+	if empty {
+		return stats
+	}
+
+	// Phase 1: Fast metrics (degree centrality, topo sort, density)
+	a.computePhase1(stats)
+
+	// Phase 2: Expensive metrics in background goroutine
+	go a.computePhase2(ctx, stats, config)
+
+	return stats
+}
+
+// newGraphStats allocates stats with Phase 2 pending. An empty graph has
+// nothing to compute, so its stats come back already complete and empty is set.
+func (a *Analyzer) newGraphStats(config AnalysisConfig) (stats *GraphStats, empty bool) {
 	nodeCount := len(a.issueMap)
 	edgeCount := a.g.Edges().Len()
 
-	stats := &GraphStats{
+	stats = &GraphStats{
 		OutDegree:         make(map[string]int),
 		InDegree:          make(map[string]int),
 		NodeCount:         nodeCount,
@@ -524,16 +554,9 @@ func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisCo
 		}
 		stats.phase2Ready = true
 		close(stats.phase2Done)
-		return stats
+		return stats, true
 	}
-
-	// Phase 1: Fast metrics (degree centrality, topo sort, density)
-	a.computePhase1(stats)
-
-	// Phase 2: Expensive metrics in background goroutine
-	go a.computePhase2(ctx, stats, config)
-
-	return stats
+	return stats, false
 }
 
 // Analyze performs synchronous graph analysis (for backward compatibility).
@@ -666,7 +689,7 @@ func (a *Analyzer) computePhase1WithProfile(stats *GraphStats, profile *StartupP
 
 	// Topological Sort
 	topoStart := time.Now()
-	sorted, err := topo.Sort(a.g)
+	sorted, err := topo.SortStabilized(a.g, sortNodesByID)
 	if err == nil {
 		for i := len(sorted) - 1; i >= 0; i-- {
 			stats.TopologicalOrder = append(stats.TopologicalOrder, a.nodeToID[sorted[i].ID()])
@@ -699,27 +722,30 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 	actualBetweennessSample := 0
 	cyclesTruncated := false
 
+	step := 0
+	advance := func() {
+		step++
+		if a.progress != nil && ctx.Err() == nil {
+			a.progress(step, Phase2Steps)
+		}
+	}
+	if a.progress != nil {
+		a.progress(0, Phase2Steps)
+	}
+
 	// PageRank
 	if ctx.Err() == nil && config.ComputePageRank {
 		prStart := time.Now()
-		prDone := make(chan map[int64]float64, 1)
-		go func() {
-			defer func() {
-				if r := recover(); r != nil {
-					// Panic -> implicitly causes timeout in parent
-				}
-			}()
-			prDone <- computePageRank(a.g, 0.85, 1e-6)
-		}()
-
-		timer := time.NewTimer(config.PageRankTimeout)
-		select {
-		case pr := <-prDone:
-			timer.Stop()
-			for id, score := range pr {
-				localPageRank[a.nodeToID[id]] = score
-			}
-		case <-timer.C:
+		pr, timedOut, cancelled := runMetric(ctx, config.PageRankTimeout, func(context.Context) map[int64]float64 {
+			return computePageRank(a.g, 0.85, 1e-6)
+		})
+		if cancelled {
+			return
+		}
+		for id, score := range pr {
+			localPageRank[a.nodeToID[id]] = score
+		}
+		if timedOut {
 			profile.PageRankTO = true
 			if len(a.issueMap) > 0 {
 				uniform := 1.0 / float64(len(a.issueMap))
@@ -727,59 +753,44 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 					localPageRank[id] = uniform
 				}
 			}
-		case <-ctx.Done():
-			timer.Stop()
-			// Abort immediately
-			return
 		}
 		profile.PageRank = time.Since(prStart)
 	}
 
+	advance()
+
 	// Betweenness
 	if ctx.Err() == nil && config.ComputeBetweenness {
 		bwStart := time.Now()
-		bwDone := make(chan BetweennessResult, 1)
-		go func() {
-			defer func() {
-				if r := recover(); r != nil {
-					// Panic -> implicitly causes timeout in parent
-				}
-			}()
+		result, timedOut, cancelled := runMetric(ctx, config.BetweennessTimeout, func(jobCtx context.Context) BetweennessResult {
 			// Choose algorithm based on mode
 			if config.BetweennessMode == BetweennessApproximate && config.BetweennessSampleSize > 0 {
-				bwDone <- ApproxBetweenness(a.g, config.BetweennessSampleSize, 1)
-			} else {
-				// Exact mode or mode not set (default to exact)
-				exact := network.Betweenness(a.g)
-				bwDone <- BetweennessResult{
-					Scores:     exact,
-					Mode:       BetweennessExact,
-					TotalNodes: a.g.Nodes().Len(),
-				}
+				return approxBetweenness(jobCtx, a.g, config.BetweennessSampleSize, 1)
 			}
-		}()
-
-		timer := time.NewTimer(config.BetweennessTimeout)
-		select {
-		case result := <-bwDone:
-			timer.Stop()
-			for id, score := range result.Scores {
-				localBetweenness[a.nodeToID[id]] = score
+			// Exact mode or mode not set (default to exact)
+			return BetweennessResult{
+				Scores:     network.Betweenness(a.g),
+				Mode:       BetweennessExact,
+				TotalNodes: a.g.Nodes().Len(),
 			}
-			// Track if approximation was used
-			if result.Mode == BetweennessApproximate {
-				betweennessIsApprox = true
-				actualBetweennessSample = result.SampleSize
-			}
-		case <-timer.C:
-			profile.BetweennessTO = true
-		case <-ctx.Done():
-			timer.Stop()
+		})
+		if cancelled {
 			return
 		}
+		profile.BetweennessTO = timedOut
+		for id, score := range result.Scores {
+			localBetweenness[a.nodeToID[id]] = score
+		}
+		// Track if approximation was used
+		if result.Mode == BetweennessApproximate {
+			betweennessIsApprox = true
+			actualBetweennessSample = result.SampleSize
+		}
 		profile.Betweenness = time.Since(bwStart)
 	}
 
+	advance()
+
 	// Eigenvector
 	if ctx.Err() == nil && config.ComputeEigenvector {
 		evStart := time.Now()
@@ -789,36 +800,27 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 		profile.Eigenvector = time.Since(evStart)
 	}
 
+	advance()
+
 	// HITS
 	if ctx.Err() == nil && config.ComputeHITS && a.g.Edges().Len() > 0 {
 		hitsStart := time.Now()
-		hitsDone := make(chan map[int64]network.HubAuthority, 1)
-		go func() {
-			defer func() {
-				if r := recover(); r != nil {
-					// Panic -> implicitly causes timeout in parent
-				}
-			}()
-			hitsDone <- network.HITS(a.g, 1e-3)
-		}()
-
-		timer := time.NewTimer(config.HITSTimeout)
-		select {
-		case hubAuth := <-hitsDone:
-			timer.Stop()
-			for id, ha := range hubAuth {
-				localHubs[a.nodeToID[id]] = ha.Hub
-				localAuthorities[a.nodeToID[id]] = ha.Authority
-			}
-		case <-timer.C:
-			profile.HITSTO = true
-		case <-ctx.Done():
-			timer.Stop()
+		hubAuth, timedOut, cancelled := runMetric(ctx, config.HITSTimeout, func(context.Context) map[int64]network.HubAuthority {
+			return network.HITS(a.g, 1e-3)
+		})
+		if cancelled {
 			return
 		}
+		profile.HITSTO = timedOut
+		for id, ha := range hubAuth {
+			localHubs[a.nodeToID[id]] = ha.Hub
+			localAuthorities[a.nodeToID[id]] = ha.Authority
+		}
 		profile.HITS = time.Since(hitsStart)
 	}
 
+	advance()
+
 	// Critical Path
 	if ctx.Err() == nil && config.ComputeCriticalPath {
 		cpStart := time.Now()
@@ -829,6 +831,8 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 		profile.CriticalPath = time.Since(cpStart)
 	}
 
+	advance()
+
 	// Cycles
 	if ctx.Err() == nil && config.ComputeCycles {
 		cyclesStart := time.Now()
@@ -847,44 +851,35 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 		}
 
 		if hasCycles {
-			cyclesDone := make(chan [][]graph.Node, 1)
-			go func() {
-				defer func() {
-					if r := recover(); r != nil {
-						// Panic -> implicitly causes timeout in parent
-					}
-				}()
-				cyclesDone <- findCyclesSafe(a.g, maxCycles)
-			}()
-
-			timer := time.NewTimer(config.CyclesTimeout)
-			select {
-			case cycles := <-cyclesDone:
-				timer.Stop()
+			cycles, timedOut, cancelled := runMetric(ctx, config.CyclesTimeout, func(context.Context) [][]graph.Node {
+				return findCyclesSafe(a.g, maxCycles)
+			})
+			if cancelled {
+				return
+			}
+			profile.CyclesTO = timedOut
+			if !timedOut {
 				profile.CycleCount = len(cycles)
-				cyclesToProcess := cycles
-				if len(cyclesToProcess) > maxCycles {
-					cyclesToProcess = cyclesToProcess[:maxCycles]
-					cyclesTruncated = true
-				}
+			}
+			cyclesToProcess := cycles
+			if len(cyclesToProcess) > maxCycles {
+				cyclesToProcess = cyclesToProcess[:maxCycles]
+				cyclesTruncated = true
+			}
 
-				for _, cycle := range cyclesToProcess {
-					var cycleIDs []string
-					for _, n := range cycle {
-						cycleIDs = append(cycleIDs, a.nodeToID[n.ID()])
-					}
-					localCycles = append(localCycles, cycleIDs)
+			for _, cycle := range cyclesToProcess {
+				var cycleIDs []string
+				for _, n := range cycle {
+					cycleIDs = append(cycleIDs, a.nodeToID[n.ID()])
 				}
-			case <-timer.C:
-				profile.CyclesTO = true
-			case <-ctx.Done():
-				timer.Stop()
-				return
+				localCycles = append(localCycles, cycleIDs)
 			}
 		}
 		profile.Cycles = time.Since(cyclesStart)
 	}
 
+	advance()
+
 	// Check cancellation before advanced signals
 	if ctx.Err() != nil {
 		return
@@ -900,6 +895,8 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 	localSlack = a.computeSlack()
 	profile.Slack = time.Since(slackStart)
 
+	advance()
+
 	// Atomic assignment
 	stats.mu.Lock()
 	stats.pageRank = localPageRank
@@ -962,7 +959,7 @@ func (a *Analyzer) computePhase1(stats *GraphStats) {
 	}
 
 	// Topological Sort (fast for DAGs)
-	sorted, err := topo.Sort(a.g)
+	sorted, err := topo.SortStabilized(a.g, sortNodesByID)
 	if err == nil {
 		for i := len(sorted) - 1; i >= 0; i-- {
 			stats.TopologicalOrder = append(stats.TopologicalOrder, a.nodeToID[sorted[i].ID()])
@@ -986,25 +983,7 @@ func (a *Analyzer) computePhase2(ctx context.Context, stats *GraphStats, config
 	// Recover from panics to prevent crashing the entire application
 	defer func() {
 		if r := recover(); r != nil {
-			stats.mu.Lock()
-			defer stats.mu.Unlock()
-
-			reason := fmt.Sprintf("panic: %v", r)
-			failEntry := statusEntry{State: "panic", Reason: reason}
-
-			// Mark all as failed so UI knows
-			stats.status = MetricStatus{
-				PageRank:     failEntry,
-				Betweenness:  failEntry,
-				Eigenvector:  failEntry,
-				HITS:         failEntry,
-				Critical:     failEntry,
-				Cycles:       failEntry,
-				KCore:        failEntry,
-				Articulation: failEntry,
-				Slack:        failEntry,
-			}
-			stats.phase2Ready = true
+			stats.recordPanic(r)
 		}
 	}()
 
@@ -1014,6 +993,32 @@ func (a *Analyzer) computePhase2(ctx context.Context, stats *GraphStats, config
 	a.computePhase2WithProfile(ctx, stats, config, dummyProfile)
 }
 
+// recordPanic marks every Phase 2 metric as failed so the UI knows
+func (s *GraphStats) recordPanic(r any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	failEntry := statusEntry{State: "panic", Reason: fmt.Sprintf("panic: %v", r)}
+	s.status = MetricStatus{
+		PageRank:     failEntry,
+		Betweenness:  failEntry,
+		Eigenvector:  failEntry,
+		HITS:         failEntry,
+		Critical:     failEntry,
+		Cycles:       failEntry,
+		KCore:        failEntry,
+		Articulation: failEntry,
+		Slack:        failEntry,
+	}
+	s.phase2Ready = true
+}
+
+// sortNodesByID orders nodes by graph ID (issue load order). gonum iterates
+// nodes in map order, so unstabilized sorts vary from run to run.
+func sortNodesByID(nodes []graph.Node) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+}
+
 func (a *Analyzer) computeHeights(sorted []graph.Node) map[string]float64 {
 	heights := make(map[int64]float64)
 	impactScores := make(map[string]float64)
@@ -1040,22 +1045,7 @@ func (a *Analyzer) computeHeights(sorted []graph.Node) map[string]float64 {
 
 // computeCoreAndArticulation builds an undirected view to derive k-core numbers and articulation points.
 func (a *Analyzer) computeCoreAndArticulation() (map[string]int, map[string]bool) {
-	u := simple.NewUndirectedGraph()
-
-	// Add nodes
-	nodes := a.g.Nodes()
-	for nodes.Next() {
-		n := nodes.Node()
-		u.AddNode(simple.Node(n.ID()))
-	}
-
-	// Add undirected edges for each directed edge
-	edges := a.g.Edges()
-	for edges.Next() {
-		e := edges.Edge()
-		u.SetEdge(u.NewEdge(u.Node(e.From().ID()), u.Node(e.To().ID())))
-	}
-
+	u := a.undirected()
 	core := computeKCore(u)
 	art := findArticulationPoints(u)
 
@@ -1079,7 +1069,7 @@ func (a *Analyzer) computeSlack() map[string]float64 {
 
 	// Topological order (dependencies first)
 	var order []string
-	sorted, err := topo.Sort(a.g)
+	sorted, err := topo.SortStabilized(a.g, sortNodesByID)
 	if err == nil {
 		for i := len(sorted) - 1; i >= 0; i-- {
 			order = append(order, a.nodeToID[sorted[i].ID()])
@@ -0,0 +1,280 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GraphDelta describes how the dependency graph changed between two analyzers.
+// Only issue IDs and blocking edges count: editing a title or status leaves
+// the delta empty, since no graph metric depends on them.
+type GraphDelta struct {
+	Added   []string // Issues new to the graph
+	Removed []string // Issues no longer in the graph
+	Rewired []string // Issues whose blocking dependencies changed
+}
+
+// Structural reports whether the graph itself changed
+func (d GraphDelta) Structural() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Rewired) > 0
+}
+
+// Reuse says how much of a previous analysis ReanalyzeAsync kept
+type Reuse int
+
+const (
+	ReuseNone    Reuse = iota // Everything was recomputed
+	ReusePartial              // Betweenness and cycles were recomputed only where the graph changed
+	ReuseAll                  // The graph was unchanged, so the previous stats were returned
+)
+
+// incrementalMaxShare is the share of the graph past which recomputing the
+// changed components costs about as much as a full run
+const incrementalMaxShare = 0.5
+
+// DiffGraph compares the graphs of prev and next. The ID lists are sorted.
+func DiffGraph(prev, next *Analyzer) GraphDelta {
+	var delta GraphDelta
+	for id := range next.issueMap {
+		if _, ok := prev.issueMap[id]; !ok {
+			delta.Added = append(delta.Added, id)
+		} else if !equalStrings(prev.dependsOn(id), next.dependsOn(id)) {
+			delta.Rewired = append(delta.Rewired, id)
+		}
+	}
+	for id := range prev.issueMap {
+		if _, ok := next.issueMap[id]; !ok {
+			delta.Removed = append(delta.Removed, id)
+		}
+	}
+	sort.Strings(delta.Added)
+	sort.Strings(delta.Removed)
+	sort.Strings(delta.Rewired)
+	return delta
+}
+
+// dependsOn returns the sorted IDs of the issues id has a blocking edge to
+func (a *Analyzer) dependsOn(id string) []string {
+	var ids []string
+	from := a.g.From(a.idToNode[id])
+	for from.Next() {
+		ids = append(ids, a.nodeToID[from.Node().ID()])
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// neighbors returns the IDs of the issues joined to id by an edge either way
+func (a *Analyzer) neighbors(id string) []string {
+	nid, ok := a.idToNode[id]
+	if !ok {
+		return nil
+	}
+	var ids []string
+	from := a.g.From(nid)
+	for from.Next() {
+		ids = append(ids, a.nodeToID[from.Node().ID()])
+	}
+	to := a.g.To(nid)
+	for to.Next() {
+		ids = append(ids, a.nodeToID[to.Node().ID()])
+	}
+	return ids
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReanalyzeAsync analyzes the graph after an edit, reusing prevStats, the
+// analysis of prev, where the edit can't have changed it. An unchanged graph
+// gets prevStats back as is. Otherwise betweenness and cycles, the expensive
+// metrics, are recomputed only for the weakly connected components the edit
+// touched, since neither crosses from one component to another; PageRank,
+// eigenvector, HITS and the cheap signals are global and always recomputed.
+// Anything prevStats can't vouch for (incomplete, a different config,
+// approximate betweenness, truncated cycles) falls back to AnalyzeAsync.
+func (a *Analyzer) ReanalyzeAsync(ctx context.Context, prev *Analyzer, prevStats *GraphStats) (*GraphStats, Reuse) {
+	config := a.analysisConfig()
+	if prev == nil || prevStats == nil || !prevStats.IsPhase2Ready() || prevStats.Config != config {
+		return a.AnalyzeAsyncWithConfig(ctx, config), ReuseNone
+	}
+	status := prevStats.Status()
+	if status.PageRank.State == "panic" {
+		return a.AnalyzeAsyncWithConfig(ctx, config), ReuseNone
+	}
+
+	delta := DiffGraph(prev, a)
+	if !delta.Structural() {
+		return prevStats, ReuseAll
+	}
+
+	if config.ComputeBetweenness && (config.BetweennessMode == BetweennessApproximate || status.Betweenness.State != "computed" || status.Betweenness.Reason != "") {
+		return a.AnalyzeAsyncWithConfig(ctx, config), ReuseNone
+	}
+	if config.ComputeCycles && (status.Cycles.State != "computed" || strings.Contains(status.Cycles.Reason, "truncated")) {
+		return a.AnalyzeAsyncWithConfig(ctx, config), ReuseNone
+	}
+	affected := a.affectedBy(prev, delta)
+	if float64(len(affected)) > incrementalMaxShare*float64(len(a.issueMap)) {
+		return a.AnalyzeAsyncWithConfig(ctx, config), ReuseNone
+	}
+
+	stats, empty := a.newGraphStats(config)
+	if empty {
+		return stats, ReuseNone
+	}
+	a.computePhase1(stats)
+	go a.computePhase2Incremental(ctx, stats, config, prevStats, affected)
+	return stats, ReusePartial
+}
+
+// affectedBy returns the issues in every component of a's graph that delta
+// touched, ordered as they were loaded. A component is touched if it holds an
+// added or rewired issue, either end of an edge that was added or dropped,
+// or an old neighbor of a removed issue; every other component is identical
+// to one in prev.
+func (a *Analyzer) affectedBy(prev *Analyzer, delta GraphDelta) []string {
+	var seeds []string
+	seeds = append(seeds, delta.Added...)
+	for _, id := range delta.Rewired {
+		seeds = append(seeds, id)
+		seeds = append(seeds, prev.dependsOn(id)...)
+		seeds = append(seeds, a.dependsOn(id)...)
+	}
+	for _, id := range delta.Removed {
+		seeds = append(seeds, prev.neighbors(id)...)
+	}
+
+	affected := make(map[string]bool)
+	var queue []string
+	for _, id := range seeds {
+		if _, ok := a.issueMap[id]; ok && !affected[id] {
+			affected[id] = true
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range a.neighbors(id) {
+			if !affected[next] {
+				affected[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(affected))
+	for id := range affected {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return a.idToNode[ids[i]] < a.idToNode[ids[j]] })
+	return ids
+}
+
+// computePhase2Incremental is computePhase2 for ReanalyzeAsync: the global
+// metrics run over the whole graph, betweenness and cycles over the affected
+// issues only, and prevStats fills in the rest.
+func (a *Analyzer) computePhase2Incremental(ctx context.Context, stats *GraphStats, config AnalysisConfig, prevStats *GraphStats, affected []string) {
+	defer close(stats.phase2Done)
+	defer func() {
+		if r := recover(); r != nil {
+			stats.recordPanic(r)
+		}
+	}()
+
+	global := config
+	global.ComputeBetweenness = false
+	global.ComputeCycles = false
+	whole := &GraphStats{}
+	a.computePhase2WithProfile(ctx, whole, global, &StartupProfile{})
+
+	local := config
+	local.ComputePageRank = false
+	local.ComputeEigenvector = false
+	local.ComputeHITS = false
+	local.ComputeCriticalPath = false
+	issues := make([]model.Issue, len(affected))
+	for i, id := range affected {
+		issues[i] = a.issueMap[id]
+	}
+	part := &GraphStats{}
+	NewAnalyzer(issues).computePhase2WithProfile(ctx, part, local, &StartupProfile{})
+
+	// A cancelled run leaves Phase 2 unfinished, as computePhase2 does
+	if !whole.phase2Ready || !part.phase2Ready {
+		return
+	}
+
+	isAffected := make(map[string]bool, len(affected))
+	for _, id := range affected {
+		isAffected[id] = true
+	}
+	unchanged := func(id string) bool {
+		_, ok := a.issueMap[id]
+		return ok && !isAffected[id]
+	}
+
+	betweenness := make(map[string]float64)
+	for id, score := range prevStats.Betweenness() {
+		if unchanged(id) {
+			betweenness[id] = score
+		}
+	}
+	for id, score := range part.betweenness {
+		betweenness[id] = score
+	}
+
+	var cycles [][]string
+	for _, cycle := range prevStats.Cycles() {
+		if len(cycle) > 0 && unchanged(cycle[0]) {
+			cycles = append(cycles, cycle)
+		}
+	}
+	cycles = append(cycles, part.cycles...)
+	// Shortest first, as findCyclesSafe orders them
+	sort.SliceStable(cycles, func(i, j int) bool { return len(cycles[i]) < len(cycles[j]) })
+	status := whole.status
+	status.Betweenness = part.status.Betweenness
+	status.Cycles = part.status.Cycles
+	maxCycles := config.MaxCyclesToStore
+	if maxCycles == 0 {
+		maxCycles = 100
+	}
+	if len(cycles) > maxCycles {
+		cycles = cycles[:maxCycles]
+		if !strings.Contains(status.Cycles.Reason, "truncated") {
+			if status.Cycles.Reason != "" {
+				status.Cycles.Reason += "; "
+			}
+			status.Cycles.Reason += "truncated"
+		}
+	}
+
+	stats.mu.Lock()
+	stats.pageRank = whole.pageRank
+	stats.betweenness = betweenness
+	stats.eigenvector = whole.eigenvector
+	stats.hubs = whole.hubs
+	stats.authorities = whole.authorities
+	stats.criticalPathScore = whole.criticalPathScore
+	stats.coreNumber = whole.coreNumber
+	stats.articulation = whole.articulation
+	stats.slack = whole.slack
+	stats.cycles = cycles
+	stats.status = status
+	stats.phase2Ready = true
+	stats.mu.Unlock()
+}
@@ -0,0 +1,134 @@
+package analysis
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDiffGraph(t *testing.T) {
+	prev := NewAnalyzer([]model.Issue{
+		{ID: "a"},
+		{ID: "b", Dependencies: blocks("b", "a")},
+		{ID: "c", Dependencies: blocks("c", "gone")}, // Dangling, so no edge
+		{ID: "d"},
+	})
+	next := NewAnalyzer([]model.Issue{
+		{ID: "a", Title: "Retitled"},
+		{ID: "b", Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepRelated}}},
+		{ID: "c", Dependencies: blocks("c", "gone")},
+		{ID: "e", Dependencies: blocks("e", "a")},
+	})
+
+	delta := DiffGraph(prev, next)
+	got := strings.Join(delta.Added, ",") + "|" + strings.Join(delta.Removed, ",") + "|" + strings.Join(delta.Rewired, ",")
+	if got != "e|d|b" {
+		t.Errorf("DiffGraph = %s, want e|d|b", got)
+	}
+	if !delta.Structural() {
+		t.Error("Expected a structural delta")
+	}
+	if DiffGraph(prev, prev).Structural() {
+		t.Error("A graph diffed with itself should be unchanged")
+	}
+}
+
+func TestReanalyzeAsyncMatchesFullAnalysis(t *testing.T) {
+	// Four components: chains a and b, cycle c, star d
+	issues := []model.Issue{
+		{ID: "a1", Dependencies: blocks("a1", "a2")},
+		{ID: "a2", Dependencies: blocks("a2", "a3")},
+		{ID: "a3", Dependencies: blocks("a3", "a4")},
+		{ID: "a4"},
+		{ID: "b1", Dependencies: blocks("b1", "b2")},
+		{ID: "b2", Dependencies: blocks("b2", "b3")},
+		{ID: "b3"},
+		{ID: "c1", Dependencies: blocks("c1", "c2")},
+		{ID: "c2", Dependencies: blocks("c2", "c3")},
+		{ID: "c3", Dependencies: blocks("c3", "c1")},
+		{ID: "d0"},
+		{ID: "d1", Dependencies: blocks("d1", "d0")},
+		{ID: "d2", Dependencies: blocks("d2", "d0")},
+		{ID: "d3", Dependencies: blocks("d3", "d0")},
+	}
+	prev := NewAnalyzer(issues)
+	prevStats := prev.AnalyzeAsync(context.Background())
+	prevStats.WaitForPhase2()
+
+	edit := func(name string, change func([]model.Issue) []model.Issue, want Reuse) {
+		t.Helper()
+		issues = change(append([]model.Issue(nil), issues...))
+		next := NewAnalyzer(issues)
+		stats, reuse := next.ReanalyzeAsync(context.Background(), prev, prevStats)
+		stats.WaitForPhase2()
+		if reuse != want {
+			t.Errorf("%s: reuse = %d, want %d", name, reuse, want)
+		}
+
+		full := NewAnalyzer(issues).AnalyzeAsync(context.Background())
+		full.WaitForPhase2()
+		for metric, pair := range map[string][2]map[string]float64{
+			"betweenness": {stats.Betweenness(), full.Betweenness()},
+			"pagerank":    {stats.PageRank(), full.PageRank()},
+			"critical":    {stats.CriticalPathScore(), full.CriticalPathScore()},
+		} {
+			for id := range issuesByID(issues) {
+				if math.Abs(pair[0][id]-pair[1][id]) > 1e-9 {
+					t.Errorf("%s: %s[%s] = %v, full analysis gives %v", name, metric, id, pair[0][id], pair[1][id])
+				}
+			}
+		}
+		if got, want := cycleKeys(stats.Cycles()), cycleKeys(full.Cycles()); got != want {
+			t.Errorf("%s: cycles = %s, full analysis gives %s", name, got, want)
+		}
+		prev, prevStats = next, stats
+	}
+
+	edit("retitle", func(is []model.Issue) []model.Issue {
+		is[0].Title = "Renamed"
+		is[4].Status = model.StatusClosed
+		return is
+	}, ReuseAll)
+	edit("remove b2", func(is []model.Issue) []model.Issue {
+		return append(is[:5], is[6:]...)
+	}, ReusePartial)
+	edit("break cycle", func(is []model.Issue) []model.Issue {
+		is[8].Dependencies = nil // c3
+		return is
+	}, ReusePartial)
+	edit("rewire d3", func(is []model.Issue) []model.Issue {
+		is[12].Dependencies = blocks("d3", "d1")
+		return is
+	}, ReusePartial)
+	edit("cut a2 from a3", func(is []model.Issue) []model.Issue {
+		is[1].Dependencies = nil // a3 loses the paths through it
+		return is
+	}, ReusePartial)
+	edit("join everything", func(is []model.Issue) []model.Issue {
+		is[3].Dependencies = append(blocks("a4", "b3"), blocks("a4", "c1")[0], blocks("a4", "d0")[0])
+		return is
+	}, ReuseNone)
+}
+
+func issuesByID(issues []model.Issue) map[string]bool {
+	ids := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		ids[issue.ID] = true
+	}
+	return ids
+}
+
+func cycleKeys(cycles [][]string) string {
+	keys := make([]string, 0, len(cycles))
+	for _, cycle := range cycles {
+		ids := append([]string(nil), cycle...)
+		sort.Strings(ids)
+		keys = append(keys, strings.Join(ids, ">"))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, " ")
+}
@@ -2067,6 +2067,39 @@ func (r *LabelAttentionResult) GetLabelAttention(label string) *LabelAttentionSc
 	return nil
 }
 
+// Reason explains a label's attention score in a few words, e.g.
+// "2 blocked, 3 stale, low velocity"
+func (s LabelAttentionScore) Reason() string {
+	var parts []string
+
+	// High PageRank
+	if s.PageRankSum > 0.5 {
+		parts = append(parts, "High PageRank")
+	}
+
+	// Blocked issues
+	if s.BlockedCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d blocked", s.BlockedCount))
+	}
+
+	// Stale issues
+	if s.StaleCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d stale", s.StaleCount))
+	}
+
+	// Low velocity (VelocityFactor = ClosedLast30Days + 1, so 1.0 means zero closures)
+	if s.VelocityFactor <= 1.0 {
+		parts = append(parts, "low velocity")
+	}
+
+	// If no specific reasons, note the open count
+	if len(parts) == 0 {
+		return fmt.Sprintf("%d open issues", s.OpenCount)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 // ============================================================================
 // Historical Velocity Computation (bv-123)
 // ============================================================================
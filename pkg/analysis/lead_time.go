@@ -0,0 +1,202 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// LifecycleTimes are when git history saw an issue created, started
+// (moved to in_progress) and closed; zero where unknown
+type LifecycleTimes struct {
+	Created time.Time
+	Started time.Time
+	Closed  time.Time
+}
+
+// DurationBuckets are the upper bounds, in days, of the histogram buckets
+// in DurationStats; the last bucket is everything longer
+var DurationBuckets = []float64{1, 3, 7, 14, 28}
+
+// DurationStats summarizes a set of durations, all in days
+type DurationStats struct {
+	Count   int     `json:"count"`
+	Mean    float64 `json:"mean_days"`
+	P50     float64 `json:"p50_days"`
+	P85     float64 `json:"p85_days"`
+	P95     float64 `json:"p95_days"`
+	Max     float64 `json:"max_days"`
+	Buckets []int   `json:"buckets"` // Counts per DurationBuckets, plus one for longer
+}
+
+// LeadCycleGroup is the lead and cycle time of the issues sharing a label
+// or assignee (or of all issues)
+type LeadCycleGroup struct {
+	Key   string        `json:"key"`
+	Lead  DurationStats `json:"lead_time"`
+	Cycle DurationStats `json:"cycle_time"`
+}
+
+// LeadCycleWeek is the median lead and cycle time of the issues closed in
+// one week
+type LeadCycleWeek struct {
+	Week     time.Time `json:"week"` // Monday the week starts, UTC
+	Closed   int       `json:"closed"`
+	LeadP50  float64   `json:"lead_p50_days"`
+	CycleP50 float64   `json:"cycle_p50_days"`
+}
+
+// LeadCycleReport holds lead time (created → closed) and cycle time
+// (in_progress → closed) of closed issues, overall, per label, per assignee
+// and per week
+type LeadCycleReport struct {
+	Overall    LeadCycleGroup   `json:"overall"`
+	ByLabel    []LeadCycleGroup `json:"by_label"`
+	ByAssignee []LeadCycleGroup `json:"by_assignee"`
+	Trend      []LeadCycleWeek  `json:"trend"`
+}
+
+// leadCycleTrendWeeks is how many weeks of trend the report keeps
+const leadCycleTrendWeeks = 12
+
+// ComputeLeadCycleTimes measures closed issues. Lead time runs from
+// created_at to closed_at, falling back to the times in lifecycle (from the
+// correlation event stream) where the issue lacks them. Cycle time needs the
+// start, which only lifecycle records, so issues never seen in_progress have
+// a lead time but no cycle time.
+func ComputeLeadCycleTimes(issues []model.Issue, lifecycle map[string]LifecycleTimes, now time.Time) LeadCycleReport {
+	type sample struct {
+		lead, cycle float64
+		hasCycle    bool
+		closed      time.Time
+	}
+	var samples []sample
+	var owners, labels [][]string // Group keys per sample
+	for _, issue := range issues {
+		if issue.Status != model.StatusClosed {
+			continue
+		}
+		lc := lifecycle[issue.ID]
+		created, closed := issue.CreatedAt, lc.Closed
+		if issue.ClosedAt != nil {
+			closed = *issue.ClosedAt
+		}
+		if created.IsZero() {
+			created = lc.Created
+		}
+		if created.IsZero() || closed.IsZero() || closed.Before(created) {
+			continue
+		}
+		s := sample{lead: closed.Sub(created).Hours() / 24, closed: closed}
+		if !lc.Started.IsZero() && !closed.Before(lc.Started) {
+			s.cycle, s.hasCycle = closed.Sub(lc.Started).Hours()/24, true
+		}
+		samples = append(samples, s)
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = "(unassigned)"
+		}
+		owners = append(owners, []string{assignee})
+		labels = append(labels, issue.Labels)
+	}
+
+	group := func(key string, idx []int) LeadCycleGroup {
+		var lead, cycle []float64
+		for _, i := range idx {
+			lead = append(lead, samples[i].lead)
+			if samples[i].hasCycle {
+				cycle = append(cycle, samples[i].cycle)
+			}
+		}
+		return LeadCycleGroup{Key: key, Lead: durationStats(lead), Cycle: durationStats(cycle)}
+	}
+	groupBy := func(keys [][]string) []LeadCycleGroup {
+		members := make(map[string][]int)
+		for i, ks := range keys {
+			for _, k := range ks {
+				members[k] = append(members[k], i)
+			}
+		}
+		var groups []LeadCycleGroup
+		for k, idx := range members {
+			groups = append(groups, group(k, idx))
+		}
+		sort.Slice(groups, func(i, j int) bool {
+			if groups[i].Lead.Count != groups[j].Lead.Count {
+				return groups[i].Lead.Count > groups[j].Lead.Count
+			}
+			return groups[i].Key < groups[j].Key
+		})
+		return groups
+	}
+
+	all := make([]int, len(samples))
+	for i := range samples {
+		all[i] = i
+	}
+	report := LeadCycleReport{
+		Overall:    group("all", all),
+		ByLabel:    groupBy(labels),
+		ByAssignee: groupBy(owners),
+	}
+
+	// Weekly trend by close date, oldest week first
+	thisWeek := weekStart(now)
+	for w := leadCycleTrendWeeks - 1; w >= 0; w-- {
+		start := thisWeek.AddDate(0, 0, -7*w)
+		end := start.AddDate(0, 0, 7)
+		var lead, cycle []float64
+		for _, s := range samples {
+			if s.closed.Before(start) || !s.closed.Before(end) {
+				continue
+			}
+			lead = append(lead, s.lead)
+			if s.hasCycle {
+				cycle = append(cycle, s.cycle)
+			}
+		}
+		report.Trend = append(report.Trend, LeadCycleWeek{
+			Week:     start,
+			Closed:   len(lead),
+			LeadP50:  durationStats(lead).P50,
+			CycleP50: durationStats(cycle).P50,
+		})
+	}
+	return report
+}
+
+// durationStats summarizes durations in days
+func durationStats(days []float64) DurationStats {
+	stats := DurationStats{Count: len(days), Buckets: make([]int, len(DurationBuckets)+1)}
+	if len(days) == 0 {
+		return stats
+	}
+	sorted := append([]float64(nil), days...)
+	sort.Float64s(sorted)
+	total := 0.0
+	for _, d := range sorted {
+		total += d
+		bucket := sort.SearchFloat64s(DurationBuckets, d)
+		if bucket < len(DurationBuckets) && DurationBuckets[bucket] == d {
+			bucket++ // Bounds are exclusive: exactly one day is in 1-3d
+		}
+		stats.Buckets[bucket]++
+	}
+	stats.Mean = total / float64(len(sorted))
+	stats.P50 = percentile(sorted, 50)
+	stats.P85 = percentile(sorted, 85)
+	stats.P95 = percentile(sorted, 95)
+	stats.Max = sorted[len(sorted)-1]
+	return stats
+}
+
+// percentile takes the nearest-rank percentile p of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(0, min(rank, len(sorted))-1)]
+}
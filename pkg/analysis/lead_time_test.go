@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeLeadCycleTimes(t *testing.T) {
+	now := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC) // A Thursday
+	at := func(daysAgo float64) time.Time { return now.Add(-time.Duration(daysAgo * 24 * float64(time.Hour))) }
+	closedAt := func(daysAgo float64) *time.Time { c := at(daysAgo); return &c }
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusClosed, CreatedAt: at(10), ClosedAt: closedAt(8), Assignee: "ann", Labels: []string{"api"}},
+		{ID: "b", Status: model.StatusClosed, CreatedAt: at(12), ClosedAt: closedAt(2), Assignee: "ann", Labels: []string{"api", "ui"}},
+		{ID: "c", Status: model.StatusClosed, CreatedAt: at(5), ClosedAt: closedAt(1), Labels: []string{"ui"}},
+		// No closed_at: the close comes from git history
+		{ID: "d", Status: model.StatusClosed, CreatedAt: at(30)},
+		// Open issues and issues without any close time are left out
+		{ID: "e", Status: model.StatusOpen, CreatedAt: at(3)},
+		{ID: "f", Status: model.StatusClosed, CreatedAt: at(3)},
+	}
+	lifecycle := map[string]LifecycleTimes{
+		"a": {Started: at(9)},
+		"b": {Started: at(4)},
+		"d": {Closed: at(15), Started: at(16)},
+	}
+	r := ComputeLeadCycleTimes(issues, lifecycle, now)
+
+	lead := r.Overall.Lead
+	if lead.Count != 4 || lead.P50 != 4 || lead.Max != 15 || lead.Mean != (2+10+4+15)/4.0 {
+		t.Errorf("Expected leads 2, 4, 10, 15 days, got %+v", lead)
+	}
+	if got := lead.Buckets; len(got) != len(DurationBuckets)+1 || got[1] != 1 || got[2] != 1 || got[3] != 1 || got[4] != 1 {
+		t.Errorf("Expected one lead in each of 1-3d, 3-7d, 7-14d and 14-28d, got %v", got)
+	}
+	cycle := r.Overall.Cycle
+	if cycle.Count != 3 || cycle.P50 != 1 || cycle.P95 != 2 {
+		t.Errorf("Expected cycles 1, 1, 2 days (c never started), got %+v", cycle)
+	}
+
+	if len(r.ByAssignee) != 2 || r.ByAssignee[0].Key != "(unassigned)" || r.ByAssignee[0].Lead.Count != 2 || r.ByAssignee[1].Key != "ann" {
+		t.Errorf("Expected unassigned (2) then ann (2), got %+v", r.ByAssignee)
+	}
+	if len(r.ByLabel) != 2 || r.ByLabel[0].Key != "api" || r.ByLabel[1].Lead.Count != 2 {
+		t.Errorf("Expected api and ui with two issues each, got %+v", r.ByLabel)
+	}
+
+	if len(r.Trend) != leadCycleTrendWeeks {
+		t.Fatalf("Expected %d weeks of trend, got %d", leadCycleTrendWeeks, len(r.Trend))
+	}
+	thisWeek, lastWeek := r.Trend[len(r.Trend)-1], r.Trend[len(r.Trend)-2]
+	if thisWeek.Week.Weekday() != time.Monday || thisWeek.Closed != 2 || thisWeek.LeadP50 != 4 {
+		t.Errorf("Expected b and c closed this week with median lead 4 days, got %+v", thisWeek)
+	}
+	if lastWeek.Closed != 1 || lastWeek.CycleP50 != 1 {
+		t.Errorf("Expected a closed last week after a day in progress, got %+v", lastWeek)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for p, want := range map[float64]float64{50: 5, 85: 9, 95: 10, 100: 10, 0: 1} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("p%.0f = %v, want %v", p, got, want)
+		}
+	}
+}
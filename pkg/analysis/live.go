@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// LiveSnapshot is the analysis of one version of the issues
+type LiveSnapshot struct {
+	Hash   string
+	Issues []model.Issue // Every loaded issue, including types excluded from analysis
+	Stats  *GraphStats
+	Triage *TriageResult
+}
+
+// LiveAnalysis serves the analysis of issues that may change between
+// requests, as in bv mcp and bv serve. It reloads the issues on each call
+// and recomputes the graph metrics and triage only when the data changed.
+type LiveAnalysis struct {
+	load    func() ([]model.Issue, error)
+	toggles TypeToggles
+	profile *TriageProfile
+
+	mu     sync.Mutex
+	cached *LiveSnapshot
+}
+
+// NewLiveAnalysis returns a LiveAnalysis over the issues returned by load.
+// The type toggles narrow the graph metrics and triage as in robot mode;
+// a nil profile uses the default triage weights.
+func NewLiveAnalysis(load func() ([]model.Issue, error), toggles TypeToggles, profile *TriageProfile) *LiveAnalysis {
+	return &LiveAnalysis{load: load, toggles: toggles, profile: profile}
+}
+
+// Current loads the issues and returns their analysis, reusing the last
+// snapshot when the data is unchanged
+func (l *LiveAnalysis) Current() (*LiveSnapshot, error) {
+	issues, err := l.load()
+	if err != nil {
+		return nil, err
+	}
+	hash := ComputeDataHash(issues)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cached != nil && l.cached.Hash == hash {
+		return l.cached, nil
+	}
+	analyzed := l.toggles.Apply(issues)
+	stats := NewAnalyzer(analyzed).AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+	triage := ComputeTriageWithOptions(analyzed, TriageOptions{WaitForPhase2: true, Profile: l.profile})
+	l.cached = &LiveSnapshot{Hash: hash, Issues: issues, Stats: stats, Triage: &triage}
+	return l.cached, nil
+}
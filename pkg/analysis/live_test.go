@@ -0,0 +1,39 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestLiveAnalysis_Current(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api", Title: "Design the API", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask},
+		{ID: "client", Title: "Write the client", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "client", DependsOnID: "api", Type: model.DepBlocks}}},
+		{ID: "v1", Title: "Ship v1", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeEpic},
+	}
+	live := NewLiveAnalysis(func() ([]model.Issue, error) { return issues, nil },
+		TypeToggles{ExcludeTypes: []model.IssueType{model.TypeEpic}}, nil)
+
+	first, err := live.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second, _ := live.Current(); first != second {
+		t.Error("expected unchanged data to reuse the analysis")
+	}
+	if len(first.Issues) != 3 {
+		t.Errorf("snapshot should keep every issue, got %d", len(first.Issues))
+	}
+	for _, rec := range first.Triage.Recommendations {
+		if rec.ID == "v1" {
+			t.Error("excluded epic should not be triaged")
+		}
+	}
+
+	issues = issues[:2]
+	if third, _ := live.Current(); third == first || len(third.Issues) != 2 {
+		t.Error("expected changed data to be analyzed again")
+	}
+}
@@ -331,9 +331,3 @@ func generateTrackID(n int) string {
 
 	return "track-" + string(letters)
 }
-
-// GenerateTrackIDForTest is exposed for tests only.
-// Do not use outside tests; keep generateTrackID unexported in production.
-func GenerateTrackIDForTest(n int) string {
-	return generateTrackID(n)
-}
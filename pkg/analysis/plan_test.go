@@ -1,7 +1,9 @@
 package analysis_test
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -502,4 +504,63 @@ func TestGetExecutionPlanLegacyDependencyGrouping(t *testing.T) {
 	if len(plan.Tracks) != 1 {
 		t.Errorf("Expected 1 track (grouped via legacy dependency), got %d tracks", len(plan.Tracks))
 	}
-}
\ No newline at end of file
+}
+func TestGetWavePlan(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	recent := now.AddDate(0, 0, -3)
+	old := now.AddDate(0, 0, -90)
+	issues := []model.Issue{
+		{ID: "done-1", Status: model.StatusClosed, ClosedAt: &recent},
+		{ID: "done-2", Status: model.StatusClosed, UpdatedAt: recent},
+		{ID: "done-3", Status: model.StatusClosed, ClosedAt: &old}, // Outside the velocity window
+		{ID: "A", Title: "Schema", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("done-1")},
+		{ID: "B", Title: "Auth", Status: model.StatusInProgress, Priority: 0},
+		{ID: "C", Title: "API", Status: model.StatusOpen, Priority: 1, Dependencies: blocks("A", "B", "A")},
+		{ID: "D", Title: "Client", Status: model.StatusOpen, Priority: 1, Dependencies: blocks("A")},
+		{ID: "E", Title: "Release", Status: model.StatusOpen, Priority: 1, Dependencies: blocks("C", "D")},
+		{ID: "X", Status: model.StatusOpen, Dependencies: blocks("Y")},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: blocks("X")},
+		{ID: "Z", Status: model.StatusOpen, Dependencies: blocks("Y")},
+	}
+
+	plan := analysis.NewAnalyzer(issues).GetWavePlan(now)
+	var got [][]string
+	for _, w := range plan.Waves {
+		var ids []string
+		for _, item := range w.Items {
+			ids = append(ids, item.ID)
+		}
+		got = append(got, ids)
+	}
+	if want := [][]string{{"B", "A"}, {"C", "D"}, {"E"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("waves = %v, want %v", got, want)
+	}
+	if c := plan.Waves[1].Items[0]; c.ID != "C" || !reflect.DeepEqual(c.WaitsOn, []string{"A", "B"}) {
+		t.Errorf("C should wait on A and B once each: %+v", c)
+	}
+	if plan.VelocitySamples != 2 || plan.ClosesPerDay != 2.0/30 {
+		t.Errorf("velocity = %v from %d closures, want 2/30 from 2", plan.ClosesPerDay, plan.VelocitySamples)
+	}
+	if w := plan.Waves[2]; w.StartDay != 60 || w.EstimatedDays != 15 || plan.EstimatedDays != 75 {
+		t.Errorf("wave 3 starts day %v for %v days, plan %v days; want 60, 15, 75", w.StartDay, w.EstimatedDays, plan.EstimatedDays)
+	}
+	if plan.MaxParallel != 2 {
+		t.Errorf("MaxParallel = %d, want 2", plan.MaxParallel)
+	}
+	if !reflect.DeepEqual(plan.Unschedulable, []string{"X", "Y", "Z"}) {
+		t.Errorf("Unschedulable = %v, want the cycle and what waits on it", plan.Unschedulable)
+	}
+
+	// Nothing closed recently: fall back to the default rate
+	quiet := analysis.NewAnalyzer(issues[3:8]).GetWavePlan(now)
+	if quiet.VelocitySamples != 0 || quiet.ClosesPerDay != analysis.DefaultClosesPerDay {
+		t.Errorf("quiet backlog velocity = %v from %d", quiet.ClosesPerDay, quiet.VelocitySamples)
+	}
+}
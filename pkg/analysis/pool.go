@@ -0,0 +1,74 @@
+package analysis
+
+import (
+	"context"
+	"runtime"
+	"time"
+)
+
+// metricPool runs the Phase 2 metrics of every analysis in the process.
+// A burst of reloads then queues behind a fixed number of workers instead of
+// piling up goroutines that fight over the CPU, and a run cancelled while
+// its metrics wait never starts them.
+var metricPool = newWorkerPool(max(2, runtime.GOMAXPROCS(0)))
+
+// workerPool bounds how many jobs run at once
+type workerPool struct {
+	slots chan struct{}
+}
+
+func newWorkerPool(size int) *workerPool {
+	return &workerPool{slots: make(chan struct{}, size)}
+}
+
+// submit runs fn on a free worker, waiting for one unless ctx ends first.
+// It returns false, without running fn, if ctx ended.
+func (p *workerPool) submit(ctx context.Context, fn func()) bool {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	if ctx.Err() != nil {
+		<-p.slots
+		return false
+	}
+	go func() {
+		defer func() { <-p.slots }()
+		fn()
+	}()
+	return true
+}
+
+// runMetric computes one Phase 2 metric on metricPool and waits up to
+// timeout for it; the time spent waiting for a worker doesn't count. The
+// context passed to compute ends when the metric times out or ctx ends, so
+// metrics that check it stop early and free their worker; the others run to
+// completion in the background. A panic in compute counts as a timeout.
+func runMetric[T any](ctx context.Context, timeout time.Duration, compute func(context.Context) T) (result T, timedOut, cancelled bool) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan T, 1)
+	if !metricPool.submit(jobCtx, func() {
+		defer func() {
+			if r := recover(); r != nil {
+				// Panic -> implicitly causes timeout in parent
+			}
+		}()
+		done <- compute(jobCtx)
+	}) {
+		return result, false, true
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case result = <-done:
+		return result, false, false
+	case <-timer.C:
+		return result, true, false
+	case <-ctx.Done():
+		return result, false, true
+	}
+}
@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWorkerPoolBoundsAndCancels(t *testing.T) {
+	pool := newWorkerPool(2)
+	release := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		if !pool.submit(context.Background(), func() { <-release }) {
+			t.Fatal("Expected a free worker")
+		}
+	}
+
+	// Both workers are busy: a third job waits, and gives up with its context
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	ran := false
+	if pool.submit(ctx, func() { ran = true }) || ran {
+		t.Error("Expected the job to be dropped once its context ended")
+	}
+
+	close(release)
+	done := make(chan struct{})
+	if !pool.submit(context.Background(), func() { close(done) }) {
+		t.Fatal("Expected a worker once the others finished")
+	}
+	<-done
+}
+
+func TestRunMetricCancelsJobOnTimeout(t *testing.T) {
+	stopped := make(chan struct{})
+	_, timedOut, cancelled := runMetric(context.Background(), 10*time.Millisecond, func(ctx context.Context) int {
+		<-ctx.Done()
+		close(stopped)
+		return 0
+	})
+	if !timedOut || cancelled {
+		t.Errorf("timedOut = %v, cancelled = %v; want a timeout", timedOut, cancelled)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Error("Expected the metric's context to end with the timeout")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, cancelled := runMetric(ctx, time.Second, func(context.Context) int { return 1 }); !cancelled {
+		t.Error("Expected a cancelled run to report cancellation")
+	}
+
+	if got, timedOut, _ := runMetric(context.Background(), time.Second, func(context.Context) int { return 7 }); got != 7 || timedOut {
+		t.Errorf("runMetric = %d (timed out %v), want 7", got, timedOut)
+	}
+}
+
+func TestApproxBetweennessStopsWhenCancelled(t *testing.T) {
+	g := NewAnalyzer(generateChainGraph(200)).g
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result := approxBetweenness(ctx, g, 50, 1); !result.TimedOut || len(result.Scores) != 0 {
+		t.Errorf("Expected no pivots after cancellation, got %d scores (timed out %v)", len(result.Scores), result.TimedOut)
+	}
+}
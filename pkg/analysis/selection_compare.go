@@ -0,0 +1,224 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Selection is a named subset of issues, e.g. the result of a recipe or query
+type Selection struct {
+	Name   string
+	Kind   string // "recipe" or "query"
+	Issues []model.Issue
+}
+
+// LabelTally is a label and how many selected issues carry it
+type LabelTally struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// SelectionMetrics aggregates one side of a comparison
+type SelectionMetrics struct {
+	Name          string         `json:"name"`
+	Kind          string         `json:"kind"`
+	Count         int            `json:"count"`
+	ByStatus      map[string]int `json:"by_status"`
+	ByPriority    map[string]int `json:"by_priority"`
+	Actionable    int            `json:"actionable"`     // Open with no open blockers
+	Blocked       int            `json:"blocked"`        // Open with open blockers
+	Stale         int            `json:"stale"`          // Open and untouched for the stale threshold
+	AvgAgeDays    float64        `json:"avg_age_days"`   // Mean age of the open issues
+	PageRankShare float64        `json:"pagerank_share"` // Share of the project's total PageRank
+	TopLabels     []LabelTally   `json:"top_labels"`
+}
+
+// SelectionComparison reports how two selections overlap and differ
+type SelectionComparison struct {
+	GeneratedAt time.Time        `json:"generated_at"`
+	A           SelectionMetrics `json:"a"`
+	B           SelectionMetrics `json:"b"`
+	Both        []string         `json:"both"`
+	OnlyA       []string         `json:"only_a"`
+	OnlyB       []string         `json:"only_b"`
+	Jaccard     float64          `json:"jaccard"` // |A∩B| / |A∪B|, 1 when both are empty
+}
+
+// maxTopLabels bounds SelectionMetrics.TopLabels
+const maxTopLabels = 5
+
+// CompareSelections compares two subsets of all. pageRank weighs each
+// side's share of the dependency graph's importance; it may be nil.
+func CompareSelections(all []model.Issue, a, b Selection, pageRank map[string]float64, now time.Time) SelectionComparison {
+	open := make(map[string]bool, len(all))
+	for _, issue := range all {
+		if issue.Status != model.StatusClosed {
+			open[issue.ID] = true
+		}
+	}
+	var totalRank float64
+	for _, v := range pageRank {
+		totalRank += v
+	}
+
+	cmp := SelectionComparison{
+		GeneratedAt: now,
+		A:           selectionMetrics(a, open, pageRank, totalRank, now),
+		B:           selectionMetrics(b, open, pageRank, totalRank, now),
+		Both:        []string{},
+		OnlyA:       []string{},
+		OnlyB:       []string{},
+	}
+
+	inA := make(map[string]bool, len(a.Issues))
+	for _, issue := range a.Issues {
+		inA[issue.ID] = true
+	}
+	inB := make(map[string]bool, len(b.Issues))
+	for _, issue := range b.Issues {
+		inB[issue.ID] = true
+	}
+	for id := range inA {
+		if inB[id] {
+			cmp.Both = append(cmp.Both, id)
+		} else {
+			cmp.OnlyA = append(cmp.OnlyA, id)
+		}
+	}
+	for id := range inB {
+		if !inA[id] {
+			cmp.OnlyB = append(cmp.OnlyB, id)
+		}
+	}
+	sort.Strings(cmp.Both)
+	sort.Strings(cmp.OnlyA)
+	sort.Strings(cmp.OnlyB)
+
+	if union := len(cmp.Both) + len(cmp.OnlyA) + len(cmp.OnlyB); union > 0 {
+		cmp.Jaccard = float64(len(cmp.Both)) / float64(union)
+	} else {
+		cmp.Jaccard = 1
+	}
+	return cmp
+}
+
+func selectionMetrics(s Selection, open map[string]bool, pageRank map[string]float64, totalRank float64, now time.Time) SelectionMetrics {
+	m := SelectionMetrics{
+		Name:       s.Name,
+		Kind:       s.Kind,
+		Count:      len(s.Issues),
+		ByStatus:   make(map[string]int),
+		ByPriority: make(map[string]int),
+		TopLabels:  []LabelTally{},
+	}
+
+	staleAfter := time.Duration(DefaultStaleThresholdDays) * 24 * time.Hour
+	labels := make(map[string]int)
+	var rank, ageDays float64
+	var aged int
+	for _, issue := range s.Issues {
+		m.ByStatus[string(issue.Status)]++
+		m.ByPriority[fmt.Sprintf("P%d", issue.Priority)]++
+		rank += pageRank[issue.ID]
+		for _, label := range issue.Labels {
+			labels[label]++
+		}
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+
+		if isBlockedByOpen(issue, open) {
+			m.Blocked++
+		} else {
+			m.Actionable++
+		}
+		if !issue.UpdatedAt.IsZero() && now.Sub(issue.UpdatedAt) > staleAfter {
+			m.Stale++
+		}
+		if !issue.CreatedAt.IsZero() {
+			ageDays += now.Sub(issue.CreatedAt).Hours() / 24
+			aged++
+		}
+	}
+	if aged > 0 {
+		m.AvgAgeDays = ageDays / float64(aged)
+	}
+	if totalRank > 0 {
+		m.PageRankShare = rank / totalRank
+	}
+
+	for label, count := range labels {
+		m.TopLabels = append(m.TopLabels, LabelTally{Label: label, Count: count})
+	}
+	sort.Slice(m.TopLabels, func(i, j int) bool {
+		if m.TopLabels[i].Count != m.TopLabels[j].Count {
+			return m.TopLabels[i].Count > m.TopLabels[j].Count
+		}
+		return m.TopLabels[i].Label < m.TopLabels[j].Label
+	})
+	if len(m.TopLabels) > maxTopLabels {
+		m.TopLabels = m.TopLabels[:maxTopLabels]
+	}
+	return m
+}
+
+// Summary returns a human-readable side-by-side report
+func (c SelectionComparison) Summary() string {
+	var sb strings.Builder
+	title := fmt.Sprintf("Comparing %s %q with %s %q", c.A.Kind, c.A.Name, c.B.Kind, c.B.Name)
+	sb.WriteString(title + "\n")
+	sb.WriteString(strings.Repeat("=", len([]rune(title))) + "\n\n")
+
+	row := func(name, a, b string) {
+		sb.WriteString(fmt.Sprintf("  %-16s %12s %12s\n", name, a, b))
+	}
+	row("", "A", "B")
+	row("Issues", fmt.Sprint(c.A.Count), fmt.Sprint(c.B.Count))
+	row("Actionable", fmt.Sprint(c.A.Actionable), fmt.Sprint(c.B.Actionable))
+	row("Blocked", fmt.Sprint(c.A.Blocked), fmt.Sprint(c.B.Blocked))
+	row("Stale", fmt.Sprint(c.A.Stale), fmt.Sprint(c.B.Stale))
+	for p := 0; p <= 4; p++ {
+		key := fmt.Sprintf("P%d", p)
+		if c.A.ByPriority[key]+c.B.ByPriority[key] > 0 {
+			row(key, fmt.Sprint(c.A.ByPriority[key]), fmt.Sprint(c.B.ByPriority[key]))
+		}
+	}
+	row("Avg age (days)", fmt.Sprintf("%.1f", c.A.AvgAgeDays), fmt.Sprintf("%.1f", c.B.AvgAgeDays))
+	row("PageRank share", fmt.Sprintf("%.0f%%", c.A.PageRankShare*100), fmt.Sprintf("%.0f%%", c.B.PageRankShare*100))
+
+	sb.WriteString(fmt.Sprintf("\nOverlap: %d in both, %d only in A, %d only in B (Jaccard %.2f)\n",
+		len(c.Both), len(c.OnlyA), len(c.OnlyB), c.Jaccard))
+	writeIDs := func(label string, ids []string) {
+		if len(ids) == 0 {
+			return
+		}
+		shown := ids
+		if len(shown) > 20 {
+			shown = shown[:20]
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s", label, strings.Join(shown, ", ")))
+		if len(ids) > len(shown) {
+			sb.WriteString(fmt.Sprintf(" … +%d more", len(ids)-len(shown)))
+		}
+		sb.WriteString("\n")
+	}
+	writeIDs("Only in A", c.OnlyA)
+	writeIDs("Only in B", c.OnlyB)
+
+	labels := func(m SelectionMetrics) string {
+		var parts []string
+		for _, l := range m.TopLabels {
+			parts = append(parts, fmt.Sprintf("%s (%d)", l.Label, l.Count))
+		}
+		if len(parts) == 0 {
+			return "none"
+		}
+		return strings.Join(parts, ", ")
+	}
+	sb.WriteString(fmt.Sprintf("\nTop labels\n  A: %s\n  B: %s\n", labels(c.A), labels(c.B)))
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCompareSelections(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -40)
+	recent := now.AddDate(0, 0, -2)
+	all := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Priority: 0, Labels: []string{"api"}, CreatedAt: old, UpdatedAt: old},
+		{ID: "b", Status: model.StatusOpen, Priority: 1, Labels: []string{"api", "ui"}, CreatedAt: recent, UpdatedAt: recent,
+			Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepBlocks}}},
+		{ID: "c", Status: model.StatusClosed, Priority: 2, Labels: []string{"ui"}, CreatedAt: old, UpdatedAt: old},
+	}
+	pageRank := map[string]float64{"a": 0.5, "b": 0.3, "c": 0.2}
+
+	cmp := CompareSelections(all,
+		Selection{Name: "urgent", Kind: "recipe", Issues: all[:2]},
+		Selection{Name: "label=ui", Kind: "query", Issues: all[1:]},
+		pageRank, now)
+
+	if strings.Join(cmp.Both, ",") != "b" || strings.Join(cmp.OnlyA, ",") != "a" || strings.Join(cmp.OnlyB, ",") != "c" {
+		t.Errorf("overlap = %v / %v / %v", cmp.Both, cmp.OnlyA, cmp.OnlyB)
+	}
+	if cmp.Jaccard < 0.33 || cmp.Jaccard > 0.34 {
+		t.Errorf("jaccard = %v, want 1/3", cmp.Jaccard)
+	}
+
+	a := cmp.A
+	if a.Count != 2 || a.Actionable != 1 || a.Blocked != 1 || a.Stale != 1 {
+		t.Errorf("A metrics = %+v", a)
+	}
+	if a.ByPriority["P0"] != 1 || a.ByPriority["P1"] != 1 {
+		t.Errorf("A by priority = %v", a.ByPriority)
+	}
+	if a.PageRankShare < 0.79 || a.PageRankShare > 0.81 {
+		t.Errorf("A pagerank share = %v", a.PageRankShare)
+	}
+	if a.AvgAgeDays != 21 {
+		t.Errorf("A avg age = %v", a.AvgAgeDays)
+	}
+	if len(a.TopLabels) != 2 || a.TopLabels[0] != (LabelTally{Label: "api", Count: 2}) {
+		t.Errorf("A top labels = %+v", a.TopLabels)
+	}
+
+	// Closed issues count toward status but not actionable work
+	b := cmp.B
+	if b.ByStatus["closed"] != 1 || b.Actionable != 0 || b.Blocked != 1 {
+		t.Errorf("B metrics = %+v", b)
+	}
+
+	summary := cmp.Summary()
+	for _, want := range []string{`recipe "urgent"`, `query "label=ui"`, "Only in A: a", "Only in B: c", "Jaccard 0.33"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary missing %q:\n%s", want, summary)
+		}
+	}
+}
+
+func TestCompareSelections_Empty(t *testing.T) {
+	cmp := CompareSelections(nil, Selection{Name: "x"}, Selection{Name: "y"}, nil, time.Now())
+	if cmp.Jaccard != 1 || cmp.Both == nil || cmp.A.PageRankShare != 0 {
+		t.Errorf("empty comparison = %+v", cmp)
+	}
+}
@@ -0,0 +1,125 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// maxForecastSprints caps a forecast so a slowing team still gets an answer
+const maxForecastSprints = 99
+
+// SprintVelocity is how many issues one sprint delivered
+type SprintVelocity struct {
+	SprintID  string    `json:"sprint_id"`
+	Name      string    `json:"name"`
+	EndDate   time.Time `json:"end_date"`
+	Delivered int       `json:"delivered"`
+}
+
+// ComputeSprintVelocity returns the velocity of the last n sprints that
+// ended by now, oldest first. A sprint delivers the issues it lists that
+// were closed by its end date; include narrows them, e.g. to one label, and
+// nil counts them all.
+func ComputeSprintVelocity(sprints []model.Sprint, issues []model.Issue, include func(*model.Issue) bool, n int, now time.Time) []SprintVelocity {
+	var ended []model.Sprint
+	for _, s := range sprints {
+		if !s.EndDate.IsZero() && !s.EndDate.After(now) {
+			ended = append(ended, s)
+		}
+	}
+	sort.SliceStable(ended, func(i, j int) bool { return ended[i].EndDate.Before(ended[j].EndDate) })
+	if n > 0 && len(ended) > n {
+		ended = ended[len(ended)-n:]
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	velocities := make([]SprintVelocity, 0, len(ended))
+	for _, s := range ended {
+		v := SprintVelocity{SprintID: s.ID, Name: s.Name, EndDate: s.EndDate}
+		for _, id := range s.BeadIDs {
+			issue, ok := byID[id]
+			if !ok || !issue.Status.IsClosed() || (include != nil && !include(issue)) {
+				continue
+			}
+			if issue.ClosedAt == nil || !issue.ClosedAt.After(s.EndDate) {
+				v.Delivered++
+			}
+		}
+		velocities = append(velocities, v)
+	}
+	return velocities
+}
+
+// SprintForecast projects how many sprints the remaining issues take
+type SprintForecast struct {
+	// RollingAvg[i] is the average delivery of the window sprints ending at i
+	RollingAvg []float64 `json:"rolling_avg"`
+	// The least-squares trend line of delivery: Slope*i + Intercept
+	Slope     float64 `json:"slope"`
+	Intercept float64 `json:"intercept"`
+	Remaining int     `json:"remaining"`
+	// SprintsLeft is 0 when nothing remains and -1 when nothing was ever
+	// delivered, so there is no pace to project
+	SprintsLeft int `json:"sprints_left"`
+}
+
+// ForecastSprints fits a trend line to the velocities and counts the
+// sprints until remaining issues are delivered. Each future sprint delivers
+// what the trend line projects, but never less than half the latest rolling
+// average, so a declining trend slows the forecast without stalling it.
+func ForecastSprints(velocities []SprintVelocity, remaining, window int) SprintForecast {
+	f := SprintForecast{Remaining: remaining}
+	if window < 1 {
+		window = 1
+	}
+	sum := 0.0
+	for i, v := range velocities {
+		sum += float64(v.Delivered)
+		if i >= window {
+			sum -= float64(velocities[i-window].Delivered)
+		}
+		f.RollingAvg = append(f.RollingAvg, sum/float64(min(i+1, window)))
+	}
+	f.Slope, f.Intercept = velocityTrend(velocities)
+
+	switch {
+	case remaining <= 0:
+		return f
+	case len(velocities) == 0 || f.RollingAvg[len(f.RollingAvg)-1] == 0:
+		f.SprintsLeft = -1
+		return f
+	}
+	floor := f.RollingAvg[len(f.RollingAvg)-1] / 2
+	left := float64(remaining)
+	for x := len(velocities); left > 0 && f.SprintsLeft < maxForecastSprints; x++ {
+		left -= math.Max(f.Slope*float64(x)+f.Intercept, floor)
+		f.SprintsLeft++
+	}
+	return f
+}
+
+// velocityTrend fits delivered = slope*i + intercept by least squares
+func velocityTrend(velocities []SprintVelocity) (slope, intercept float64) {
+	n := float64(len(velocities))
+	if n == 0 {
+		return 0, 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for i, v := range velocities {
+		x, y := float64(i), float64(v.Delivered)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	if denom := n*sumXX - sumX*sumX; denom != 0 {
+		slope = (n*sumXY - sumX*sumY) / denom
+	}
+	return slope, (sumY - slope*sumX) / n
+}
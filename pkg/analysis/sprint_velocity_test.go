@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeSprintVelocity(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC) }
+	closed := func(d int) *time.Time { t := day(d); return &t }
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusClosed, ClosedAt: closed(3), Labels: []string{"api"}},
+		{ID: "b", Status: model.StatusClosed, ClosedAt: closed(4)},
+		{ID: "c", Status: model.StatusClosed, ClosedAt: closed(20), Labels: []string{"api"}}, // Closed after its sprint
+		{ID: "d", Status: model.StatusClosed, ClosedAt: closed(12), Labels: []string{"api"}},
+		{ID: "e", Status: model.StatusOpen, Labels: []string{"api"}},
+	}
+	sprints := []model.Sprint{
+		{ID: "s2", Name: "Sprint 2", EndDate: day(14), BeadIDs: []string{"c", "d", "e"}},
+		{ID: "s1", Name: "Sprint 1", EndDate: day(7), BeadIDs: []string{"a", "b", "c"}},
+		{ID: "s3", Name: "Sprint 3", EndDate: day(28), BeadIDs: []string{"e"}}, // Not ended yet
+	}
+
+	all := ComputeSprintVelocity(sprints, issues, nil, 0, day(21))
+	if len(all) != 2 || all[0].SprintID != "s1" || all[1].SprintID != "s2" {
+		t.Fatalf("velocities = %+v, want s1 then s2", all)
+	}
+	if all[0].Delivered != 2 || all[1].Delivered != 1 {
+		t.Errorf("delivered = %d, %d; want 2, 1", all[0].Delivered, all[1].Delivered)
+	}
+
+	hasAPI := func(i *model.Issue) bool { return len(i.Labels) > 0 && i.Labels[0] == "api" }
+	api := ComputeSprintVelocity(sprints, issues, hasAPI, 1, day(21))
+	if len(api) != 1 || api[0].SprintID != "s2" || api[0].Delivered != 1 {
+		t.Errorf("last api sprint = %+v, want s2 delivering 1", api)
+	}
+}
+
+func TestForecastSprints(t *testing.T) {
+	vel := func(delivered ...int) []SprintVelocity {
+		out := make([]SprintVelocity, len(delivered))
+		for i, d := range delivered {
+			out[i].Delivered = d
+		}
+		return out
+	}
+
+	f := ForecastSprints(vel(2, 4, 6), 17, 2)
+	if f.Slope != 2 || f.Intercept != 2 {
+		t.Errorf("trend = %vx + %v, want 2x + 2", f.Slope, f.Intercept)
+	}
+	if want := []float64{2, 3, 5}; len(f.RollingAvg) != 3 || f.RollingAvg[0] != want[0] || f.RollingAvg[1] != want[1] || f.RollingAvg[2] != want[2] {
+		t.Errorf("rolling average = %v, want %v", f.RollingAvg, want)
+	}
+	// The trend projects 8 then 10
+	if f.SprintsLeft != 2 {
+		t.Errorf("sprints left = %d, want 2", f.SprintsLeft)
+	}
+
+	// A falling trend is floored at half the latest rolling average
+	if f := ForecastSprints(vel(6, 4, 2), 3, 1); f.SprintsLeft != 3 {
+		t.Errorf("falling trend: sprints left = %d, want 3", f.SprintsLeft)
+	}
+	if f := ForecastSprints(vel(0, 0), 5, 3); f.SprintsLeft != -1 {
+		t.Errorf("no delivery: sprints left = %d, want -1", f.SprintsLeft)
+	}
+	if f := ForecastSprints(vel(3), 0, 3); f.SprintsLeft != 0 {
+		t.Errorf("nothing remaining: sprints left = %d, want 0", f.SprintsLeft)
+	}
+}
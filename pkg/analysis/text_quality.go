@@ -0,0 +1,161 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// QualityKind names a text quality problem
+type QualityKind string
+
+const (
+	QualityEmptyDescription QualityKind = "empty_description"
+	QualityUnresolvedMarker QualityKind = "unresolved_marker"
+	QualityUnsplitEpic      QualityKind = "unsplit_epic"
+	QualitySpelling         QualityKind = "spelling"
+	QualityVagueWording     QualityKind = "vague_wording"
+)
+
+// qualityKindOrder is the order kinds are reported in
+var qualityKindOrder = map[QualityKind]int{
+	QualityEmptyDescription: 0,
+	QualityUnresolvedMarker: 1,
+	QualityUnsplitEpic:      2,
+	QualitySpelling:         3,
+	QualityVagueWording:     4,
+}
+
+// DefaultEpicSplitWords is the description length, in words, above which an
+// epic without child issues is flagged for splitting
+const DefaultEpicSplitWords = 300
+
+// placeholderMarker matches placeholder words left in acceptance criteria.
+// Only the upper-case forms count, so prose like "a todo list" is fine.
+var placeholderMarker = regexp.MustCompile(`\b(TBD|TBC|TODO|FIXME)\b`)
+
+// QualityFinding is one text quality problem on an open issue
+type QualityFinding struct {
+	IssueID string      `json:"issue_id"`
+	Title   string      `json:"title"`
+	Kind    QualityKind `json:"kind"`
+	Message string      `json:"message"`
+}
+
+// QualityOptions configures ComputeQualityReportWithOptions
+type QualityOptions struct {
+	// EpicSplitWords flags childless epics longer than this; non-positive uses DefaultEpicSplitWords
+	EpicSplitWords int
+
+	// SpellCheck adds the spelling and vague-wording pass over titles and descriptions
+	SpellCheck bool
+}
+
+// QualityReport lists text quality problems across open issues
+type QualityReport struct {
+	GeneratedAt  time.Time           `json:"generated_at"`
+	Checked      int                 `json:"checked"` // Open issues examined
+	SpellChecked bool                `json:"spell_checked"`
+	Counts       map[QualityKind]int `json:"counts"`
+	Findings     []QualityFinding    `json:"findings"`
+}
+
+// ComputeQualityReport flags open issues with an empty description,
+// placeholder markers (TBD, TODO, ...) in their acceptance criteria, or epics
+// whose description runs past epicSplitWords without any child issues.
+// A non-positive epicSplitWords uses DefaultEpicSplitWords.
+func ComputeQualityReport(issues []model.Issue, epicSplitWords int, now time.Time) QualityReport {
+	return ComputeQualityReportWithOptions(issues, QualityOptions{EpicSplitWords: epicSplitWords}, now)
+}
+
+// ComputeQualityReportWithOptions is ComputeQualityReport with the optional
+// spell/style pass. Spelling covers a built-in list of common misspellings and
+// doubled words; style flags vague phrases such as "etc" or "as needed".
+func ComputeQualityReportWithOptions(issues []model.Issue, opts QualityOptions, now time.Time) QualityReport {
+	epicSplitWords := opts.EpicSplitWords
+	if epicSplitWords <= 0 {
+		epicSplitWords = DefaultEpicSplitWords
+	}
+
+	hasChildren := make(map[string]bool)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				hasChildren[dep.DependsOnID] = true
+			}
+		}
+	}
+
+	report := QualityReport{
+		GeneratedAt:  now,
+		SpellChecked: opts.SpellCheck,
+		Counts:       make(map[QualityKind]int),
+		Findings:     []QualityFinding{},
+	}
+	add := func(issue model.Issue, kind QualityKind, msg string) {
+		report.Findings = append(report.Findings, QualityFinding{
+			IssueID: issue.ID,
+			Title:   issue.Title,
+			Kind:    kind,
+			Message: msg,
+		})
+		report.Counts[kind]++
+	}
+
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		report.Checked++
+
+		if strings.TrimSpace(issue.Description) == "" {
+			add(issue, QualityEmptyDescription, fmt.Sprintf("%s has no description", issue.ID))
+		}
+
+		if markers := uniqueMarkers(issue.AcceptanceCriteria); len(markers) > 0 {
+			add(issue, QualityUnresolvedMarker, fmt.Sprintf("%s acceptance criteria still say %s", issue.ID, strings.Join(markers, ", ")))
+		}
+
+		if issue.IssueType == model.TypeEpic && !hasChildren[issue.ID] {
+			if words := len(strings.Fields(issue.Description)); words > epicSplitWords {
+				add(issue, QualityUnsplitEpic, fmt.Sprintf("Epic %s runs %d words with no child issues; consider splitting it", issue.ID, words))
+			}
+		}
+
+		if opts.SpellCheck {
+			text := issue.Title + "\n" + issue.Description
+			if problems := spellingProblems(text); len(problems) > 0 {
+				add(issue, QualitySpelling, fmt.Sprintf("%s spelling: %s", issue.ID, strings.Join(problems, ", ")))
+			}
+			if phrases := vagueWording(text); len(phrases) > 0 {
+				add(issue, QualityVagueWording, fmt.Sprintf("%s uses vague wording: %s", issue.ID, strings.Join(phrases, ", ")))
+			}
+		}
+	}
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Kind != b.Kind {
+			return qualityKindOrder[a.Kind] < qualityKindOrder[b.Kind]
+		}
+		return a.IssueID < b.IssueID
+	})
+	return report
+}
+
+// uniqueMarkers returns the distinct placeholder markers in text, in order of appearance
+func uniqueMarkers(text string) []string {
+	var markers []string
+	seen := make(map[string]bool)
+	for _, m := range placeholderMarker.FindAllString(text, -1) {
+		if !seen[m] {
+			seen[m] = true
+			markers = append(markers, m)
+		}
+	}
+	return markers
+}
@@ -0,0 +1,49 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeQualityReport(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	longText := strings.Repeat("word ", 12)
+	issues := []model.Issue{
+		{ID: "q-empty", Title: "Empty", Status: model.StatusOpen, Description: "  \n"},
+		{ID: "q-closed", Title: "Closed and empty", Status: model.StatusClosed},
+		{ID: "q-tbd", Title: "Placeholders", Status: model.StatusInProgress, Description: "Fine",
+			AcceptanceCriteria: "p95 latency TBD\nTODO: pick a target\nAnother TBD\nkeep a todo list"},
+		{ID: "q-epic", Title: "Big epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Description: longText},
+		{ID: "q-split", Title: "Split epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Description: longText},
+		{ID: "q-child", Title: "Child", Status: model.StatusOpen, Description: "Part of q-split",
+			Dependencies: []*model.Dependency{{DependsOnID: "q-split", Type: model.DepParentChild}}},
+	}
+
+	report := ComputeQualityReport(issues, 10, now)
+	if report.Checked != 5 || !report.GeneratedAt.Equal(now) {
+		t.Errorf("Checked = %d, GeneratedAt = %v", report.Checked, report.GeneratedAt)
+	}
+	var got []string
+	for _, f := range report.Findings {
+		got = append(got, fmt.Sprintf("%s:%s", f.Kind, f.IssueID))
+	}
+	want := "empty_description:q-empty unresolved_marker:q-tbd unsplit_epic:q-epic"
+	if strings.Join(got, " ") != want {
+		t.Errorf("Findings = %v, want %s", got, want)
+	}
+	if msg := report.Findings[1].Message; !strings.Contains(msg, "TBD, TODO") {
+		t.Errorf("Markers should be listed once each, in order: %q", msg)
+	}
+	if report.Counts[QualityUnsplitEpic] != 1 || report.Counts[QualityEmptyDescription] != 1 {
+		t.Errorf("Counts = %v", report.Counts)
+	}
+
+	// The default threshold leaves a 12-word epic alone
+	if report := ComputeQualityReport(issues, 0, now); report.Counts[QualityUnsplitEpic] != 0 {
+		t.Errorf("Default threshold should not flag short epics, got %v", report.Counts)
+	}
+}
@@ -0,0 +1,221 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// commonMisspellings maps frequent misspellings to their correction. The list
+// favours words that show up in engineering backlogs; anything not listed is
+// assumed correct, so project jargon and identifiers never trip the check.
+var commonMisspellings = map[string]string{
+	"accomodate":     "accommodate",
+	"acheive":        "achieve",
+	"acess":          "access",
+	"accross":        "across",
+	"adress":         "address",
+	"agressive":      "aggressive",
+	"alot":           "a lot",
+	"aproach":        "approach",
+	"apparantly":     "apparently",
+	"appearence":     "appearance",
+	"arguement":      "argument",
+	"asynchonous":    "asynchronous",
+	"attatch":        "attach",
+	"authenication":  "authentication",
+	"availabe":       "available",
+	"backwords":      "backwards",
+	"begining":       "beginning",
+	"beleive":        "believe",
+	"buisness":       "business",
+	"calender":       "calendar",
+	"cancelation":    "cancellation",
+	"catagory":       "category",
+	"comming":        "coming",
+	"commited":       "committed",
+	"comparision":    "comparison",
+	"compatability":  "compatibility",
+	"compatable":     "compatible",
+	"completly":      "completely",
+	"concious":       "conscious",
+	"configuraton":   "configuration",
+	"consistant":     "consistent",
+	"contiguration":  "configuration",
+	"curent":         "current",
+	"definately":     "definitely",
+	"dependancy":     "dependency",
+	"dependancies":   "dependencies",
+	"dependant":      "dependent",
+	"desription":     "description",
+	"destory":        "destroy",
+	"diffrent":       "different",
+	"disapear":       "disappear",
+	"embarass":       "embarrass",
+	"enviroment":     "environment",
+	"exeption":       "exception",
+	"existance":      "existence",
+	"existant":       "existent",
+	"explaination":   "explanation",
+	"familar":        "familiar",
+	"finaly":         "finally",
+	"flakey":         "flaky",
+	"foward":         "forward",
+	"fucntion":       "function",
+	"funtion":        "function",
+	"goverment":      "government",
+	"gaurantee":      "guarantee",
+	"guarentee":      "guarantee",
+	"happend":        "happened",
+	"heirarchy":      "hierarchy",
+	"immediatly":     "immediately",
+	"implemention":   "implementation",
+	"implmentation":  "implementation",
+	"independant":    "independent",
+	"indexs":         "indexes",
+	"infomation":     "information",
+	"initialise":     "initialize",
+	"intial":         "initial",
+	"intergration":   "integration",
+	"intermittant":   "intermittent",
+	"irrelevent":     "irrelevant",
+	"lenght":         "length",
+	"libary":         "library",
+	"maintainance":   "maintenance",
+	"maintenence":    "maintenance",
+	"managment":      "management",
+	"mesage":         "message",
+	"messsage":       "message",
+	"millenium":      "millennium",
+	"neccessary":     "necessary",
+	"necesary":       "necessary",
+	"noticable":      "noticeable",
+	"occassion":      "occasion",
+	"occured":        "occurred",
+	"occurence":      "occurrence",
+	"occurrance":     "occurrence",
+	"ommit":          "omit",
+	"paramater":      "parameter",
+	"paramter":       "parameter",
+	"particulary":    "particularly",
+	"perfomance":     "performance",
+	"performence":    "performance",
+	"permision":      "permission",
+	"persistant":     "persistent",
+	"posible":        "possible",
+	"prefered":       "preferred",
+	"prefrence":      "preference",
+	"priviledge":     "privilege",
+	"privilage":      "privilege",
+	"probaly":        "probably",
+	"proccess":       "process",
+	"publically":     "publicly",
+	"reciept":        "receipt",
+	"recieve":        "receive",
+	"recieved":       "received",
+	"recomend":       "recommend",
+	"recommand":      "recommend",
+	"refered":        "referred",
+	"refrence":       "reference",
+	"relevent":       "relevant",
+	"reponse":        "response",
+	"repositry":      "repository",
+	"requirment":     "requirement",
+	"resouce":        "resource",
+	"responsability": "responsibility",
+	"retreive":       "retrieve",
+	"retrive":        "retrieve",
+	"seperate":       "separate",
+	"seperately":     "separately",
+	"sucessful":      "successful",
+	"succesful":      "successful",
+	"successfull":    "successful",
+	"supress":        "suppress",
+	"suprise":        "surprise",
+	"synchonous":     "synchronous",
+	"teh":            "the",
+	"threshhold":     "threshold",
+	"tommorow":       "tomorrow",
+	"truely":         "truly",
+	"unecessary":     "unnecessary",
+	"untill":         "until",
+	"usefull":        "useful",
+	"vaild":          "valid",
+	"wich":           "which",
+	"wierd":          "weird",
+	"writting":       "writing",
+}
+
+// vaguePhrases are wordings that leave an agent guessing about scope or
+// intent. Each is matched case-insensitively on word boundaries.
+var vaguePhrases = []string{
+	"and/or",
+	"as appropriate",
+	"as needed",
+	"etc",
+	"if possible",
+	"or something",
+	"somehow",
+	"some kind of",
+	"and so on",
+}
+
+var (
+	wordToken     = regexp.MustCompile(`[A-Za-z]+(?:'[A-Za-z]+)?`)
+	vaguePhraseRE = func() *regexp.Regexp {
+		quoted := make([]string, len(vaguePhrases))
+		for i, p := range vaguePhrases {
+			quoted[i] = regexp.QuoteMeta(p)
+		}
+		return regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+	}()
+	// codeSpan strips inline code and fenced blocks so identifiers are not checked
+	codeSpan = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+// spellingProblems lists misspelt words ("recieve → receive") and doubled
+// words ("the the") in text, each reported once in order of appearance.
+func spellingProblems(text string) []string {
+	text = codeSpan.ReplaceAllString(text, " ")
+	var problems []string
+	seen := make(map[string]bool)
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			problems = append(problems, p)
+		}
+	}
+
+	prev := ""
+	for _, loc := range wordToken.FindAllStringIndex(text, -1) {
+		word := text[loc[0]:loc[1]]
+		lower := strings.ToLower(word)
+		if fix, ok := commonMisspellings[lower]; ok {
+			add(fmt.Sprintf("%s → %s", word, fix))
+		}
+		// Only flag a repeat when nothing but spaces separates the pair
+		if prev != "" && lower == strings.ToLower(prev) && len(lower) > 1 {
+			add(fmt.Sprintf("doubled %q", prev+" "+word))
+		}
+		prev = word
+		if end := loc[1]; end < len(text) && text[end] != ' ' {
+			prev = ""
+		}
+	}
+	return problems
+}
+
+// vagueWording lists the distinct vague phrases used in text, lower-cased
+func vagueWording(text string) []string {
+	text = codeSpan.ReplaceAllString(text, " ")
+	var found []string
+	seen := make(map[string]bool)
+	for _, m := range vaguePhraseRE.FindAllString(text, -1) {
+		m = strings.ToLower(m)
+		if !seen[m] {
+			seen[m] = true
+			found = append(found, m)
+		}
+	}
+	return found
+}
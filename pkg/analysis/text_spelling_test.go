@@ -0,0 +1,61 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSpellingProblems(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Recieve events and retreive the the payload", `Recieve → receive, retreive → retrieve, doubled "the the"`},
+		{"Fix the dependancy. The dependancy graph", "dependancy → dependency"},
+		{"It ends with that. That is fine", ""},
+		{"Rename `recieve` and\n```\nteh teh\n```\nship", ""},
+		{"Kubernetes sidecar for bv", ""},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(spellingProblems(tt.text), ", "); got != tt.want {
+			t.Errorf("spellingProblems(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestVagueWording(t *testing.T) {
+	got := vagueWording("Add retries As needed, logging etc. and/or metrics; retry as needed")
+	if strings.Join(got, "|") != "as needed|etc|and/or" {
+		t.Errorf("vagueWording = %v", got)
+	}
+	if got := vagueWording("Handle `etc` files and the etcd client"); len(got) != 0 {
+		t.Errorf("Code spans and longer words should not match: %v", got)
+	}
+}
+
+func TestComputeQualityReportSpellCheck(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "s-1", Title: "Seperate config loading", Status: model.StatusOpen, Description: "Split it somehow"},
+		{ID: "s-2", Title: "Clean", Status: model.StatusOpen, Description: "Nothing to see"},
+		{ID: "s-3", Title: "Closed teh typo", Status: model.StatusClosed, Description: "etc"},
+	}
+
+	if report := ComputeQualityReport(issues, 0, now); len(report.Findings) != 0 || report.SpellChecked {
+		t.Errorf("Spell check should be opt-in, got %+v", report.Findings)
+	}
+
+	report := ComputeQualityReportWithOptions(issues, QualityOptions{SpellCheck: true}, now)
+	if !report.SpellChecked || report.Counts[QualitySpelling] != 1 || report.Counts[QualityVagueWording] != 1 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	if f := report.Findings[0]; f.Kind != QualitySpelling || f.Message != "s-1 spelling: Seperate → separate" {
+		t.Errorf("Spelling finding = %+v", f)
+	}
+	if f := report.Findings[1]; f.Kind != QualityVagueWording || f.Message != "s-1 uses vague wording: somehow" {
+		t.Errorf("Vague wording finding = %+v", f)
+	}
+}
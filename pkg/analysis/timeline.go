@@ -0,0 +1,105 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TimelineEntry is a single bar on the timeline: an issue spanning from its
+// creation to its close date (or to "now" while it is still open).
+type TimelineEntry struct {
+	ID       string       `json:"id"`
+	Title    string       `json:"title"`
+	Status   model.Status `json:"status"`
+	Priority int          `json:"priority"`
+	Start    time.Time    `json:"start"`
+	End      time.Time    `json:"end"`
+	Ongoing  bool         `json:"ongoing"`            // True if the issue is not closed (End is "now")
+	Critical bool         `json:"critical,omitempty"` // True if the issue is on the critical path
+}
+
+// Duration returns the span covered by the entry
+func (e TimelineEntry) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// Timeline lays issues out horizontally by created/closed dates and carries
+// the longest open dependency chain so views can highlight schedule risk.
+type Timeline struct {
+	Start        time.Time       `json:"start"`
+	End          time.Time       `json:"end"`
+	CriticalPath []string        `json:"critical_path"` // Issue IDs from source to sink
+	Entries      []TimelineEntry `json:"entries"`
+
+	// Cumulative estimate of the critical path, if the issues are estimated
+	CriticalPathEstimate float64      `json:"critical_path_estimate,omitempty"`
+	EstimateUnit         EstimateUnit `json:"estimate_unit,omitempty"`
+}
+
+// ComputeTimeline builds a timeline for all issues known to the analyzer.
+// Entries are ordered by start date (then ID) for determinism. Issues without
+// a creation date are skipped since they cannot be placed on the axis.
+func (a *Analyzer) ComputeTimeline(now time.Time) Timeline {
+	tl := Timeline{CriticalPath: []string{}}
+
+	if kp := a.generateKPaths(1, 0); kp != nil && len(kp.Paths) > 0 {
+		tl.CriticalPath = kp.Paths[0].IssueIDs
+		tl.CriticalPathEstimate = kp.Paths[0].Estimate
+		tl.EstimateUnit = kp.Paths[0].EstimateUnit
+	}
+	critical := make(map[string]bool, len(tl.CriticalPath))
+	for _, id := range tl.CriticalPath {
+		critical[id] = true
+	}
+
+	for id, issue := range a.issueMap {
+		if issue.CreatedAt.IsZero() {
+			continue
+		}
+		entry := TimelineEntry{
+			ID:       id,
+			Title:    issue.Title,
+			Status:   issue.Status,
+			Priority: issue.Priority,
+			Start:    issue.CreatedAt,
+			End:      now,
+			Ongoing:  true,
+			Critical: critical[id],
+		}
+		if issue.Status == model.StatusClosed {
+			entry.Ongoing = false
+			switch {
+			case issue.ClosedAt != nil && !issue.ClosedAt.IsZero():
+				entry.End = *issue.ClosedAt
+			case !issue.UpdatedAt.IsZero():
+				entry.End = issue.UpdatedAt
+			default:
+				entry.End = issue.CreatedAt
+			}
+		}
+		if entry.End.Before(entry.Start) {
+			entry.End = entry.Start
+		}
+		tl.Entries = append(tl.Entries, entry)
+	}
+
+	sort.Slice(tl.Entries, func(i, j int) bool {
+		if !tl.Entries[i].Start.Equal(tl.Entries[j].Start) {
+			return tl.Entries[i].Start.Before(tl.Entries[j].Start)
+		}
+		return tl.Entries[i].ID < tl.Entries[j].ID
+	})
+
+	for i, e := range tl.Entries {
+		if i == 0 || e.Start.Before(tl.Start) {
+			tl.Start = e.Start
+		}
+		if i == 0 || e.End.After(tl.End) {
+			tl.End = e.End
+		}
+	}
+
+	return tl
+}
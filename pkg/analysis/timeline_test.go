@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeTimeline_SpansAndCriticalPath(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := base.AddDate(0, 0, 30)
+	closedAt := base.AddDate(0, 0, 5)
+
+	issues := []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen, CreatedAt: base},
+		{ID: "B", Title: "Mid", Status: model.StatusOpen, CreatedAt: base.AddDate(0, 0, 2),
+			Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Leaf", Status: model.StatusOpen, CreatedAt: base.AddDate(0, 0, 3),
+			Dependencies: []*model.Dependency{{IssueID: "C", DependsOnID: "B", Type: model.DepBlocks}}},
+		{ID: "D", Title: "Done", Status: model.StatusClosed, CreatedAt: base.AddDate(0, 0, 1), ClosedAt: &closedAt},
+		{ID: "E", Title: "Undated", Status: model.StatusOpen},
+	}
+
+	tl := NewAnalyzer(issues).ComputeTimeline(now)
+
+	if len(tl.Entries) != 4 {
+		t.Fatalf("Expected 4 dated entries, got %d", len(tl.Entries))
+	}
+	wantOrder := []string{"A", "D", "B", "C"}
+	for i, id := range wantOrder {
+		if tl.Entries[i].ID != id {
+			t.Errorf("Entry %d: expected %s, got %s", i, id, tl.Entries[i].ID)
+		}
+	}
+
+	if got := tl.CriticalPath; len(got) != 3 || got[0] != "A" || got[2] != "C" {
+		t.Errorf("Expected critical path A→B→C, got %v", got)
+	}
+
+	for _, e := range tl.Entries {
+		switch e.ID {
+		case "D":
+			if e.Ongoing || !e.End.Equal(closedAt) || e.Critical {
+				t.Errorf("Closed entry wrong: %+v", e)
+			}
+		case "A", "B", "C":
+			if !e.Ongoing || !e.End.Equal(now) || !e.Critical {
+				t.Errorf("Open critical entry wrong: %+v", e)
+			}
+		}
+	}
+
+	if !tl.Start.Equal(base) || !tl.End.Equal(now) {
+		t.Errorf("Unexpected bounds %v..%v", tl.Start, tl.End)
+	}
+}
+
+func TestComputeTimeline_Empty(t *testing.T) {
+	tl := NewAnalyzer(nil).ComputeTimeline(time.Now())
+	if len(tl.Entries) != 0 || len(tl.CriticalPath) != 0 {
+		t.Errorf("Expected empty timeline, got %+v", tl)
+	}
+}
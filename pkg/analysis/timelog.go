@@ -0,0 +1,251 @@
+package analysis
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// TimeLogFile is the name of the work-session sidecar file in the beads directory
+const TimeLogFile = "timelog.jsonl"
+
+// Time log actions
+const (
+	TimeLogStart = "start"
+	TimeLogStop  = "stop"
+)
+
+// TimeLogEvent is a single line in the time log. The log is append-only so an
+// interrupted session (crash, closed terminal) is still recovered on next load.
+type TimeLogEvent struct {
+	IssueID   string    `json:"issue_id"`
+	Action    string    `json:"action"` // "start" or "stop"
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WorkSession is a contiguous span of focused work on one issue
+type WorkSession struct {
+	IssueID string     `json:"issue_id"`
+	Start   time.Time  `json:"start"`
+	End     *time.Time `json:"end,omitempty"` // nil while the session is running
+}
+
+// Active returns true if the session has not been stopped
+func (s WorkSession) Active() bool {
+	return s.End == nil
+}
+
+// Duration returns the session length, measuring running sessions up to now
+func (s WorkSession) Duration(now time.Time) time.Duration {
+	end := now
+	if s.End != nil {
+		end = *s.End
+	}
+	if end.Before(s.Start) {
+		return 0
+	}
+	return end.Sub(s.Start)
+}
+
+// TimeLog holds work sessions replayed from the sidecar file.
+// At most one session is active at a time; starting a new one stops the previous.
+type TimeLog struct {
+	Sessions []WorkSession
+	path     string
+}
+
+// NewTimeLog returns an empty time log backed by the beads directory
+func NewTimeLog(beadsDir string) *TimeLog {
+	return &TimeLog{path: filepath.Join(beadsDir, TimeLogFile)}
+}
+
+// LoadTimeLog reads the time log from the beads directory.
+// Returns an empty log if the file doesn't exist; malformed lines are skipped.
+func LoadTimeLog(beadsDir string) (*TimeLog, error) {
+	l := NewTimeLog(beadsDir)
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return l, nil
+		}
+		return nil, fmt.Errorf("failed to read time log: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var ev TimeLogEvent
+		if err := json.Unmarshal(line, &ev); err != nil {
+			continue
+		}
+		l.apply(ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read time log: %w", err)
+	}
+	return l, nil
+}
+
+// apply replays one event onto the in-memory sessions
+func (l *TimeLog) apply(ev TimeLogEvent) {
+	switch ev.Action {
+	case TimeLogStart:
+		if ev.IssueID == "" {
+			return
+		}
+		l.stopActive(ev.Timestamp)
+		l.Sessions = append(l.Sessions, WorkSession{IssueID: ev.IssueID, Start: ev.Timestamp})
+	case TimeLogStop:
+		l.stopActive(ev.Timestamp)
+	}
+}
+
+func (l *TimeLog) stopActive(at time.Time) {
+	if s := l.Active(); s != nil {
+		end := at
+		s.End = &end
+	}
+}
+
+// Active returns the running session, or nil if none
+func (l *TimeLog) Active() *WorkSession {
+	if n := len(l.Sessions); n > 0 && l.Sessions[n-1].Active() {
+		return &l.Sessions[n-1]
+	}
+	return nil
+}
+
+// Start begins a work session on the issue, stopping any running session first
+func (l *TimeLog) Start(issueID string, now time.Time) error {
+	if issueID == "" {
+		return fmt.Errorf("issue ID cannot be empty")
+	}
+	ev := TimeLogEvent{IssueID: issueID, Action: TimeLogStart, Timestamp: now}
+	if err := l.append(ev); err != nil {
+		return err
+	}
+	l.apply(ev)
+	return nil
+}
+
+// Stop ends the running session and returns it, or nil if nothing was running
+func (l *TimeLog) Stop(now time.Time) (*WorkSession, error) {
+	active := l.Active()
+	if active == nil {
+		return nil, nil
+	}
+	ev := TimeLogEvent{IssueID: active.IssueID, Action: TimeLogStop, Timestamp: now}
+	if err := l.append(ev); err != nil {
+		return nil, err
+	}
+	l.apply(ev)
+	stopped := *active
+	return &stopped, nil
+}
+
+func (l *TimeLog) append(ev TimeLogEvent) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal time log event: %w", err)
+	}
+	f, err := readonly.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open time log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write time log: %w", err)
+	}
+	return nil
+}
+
+// Actuals returns total logged time per issue, counting running sessions up to now
+func (l *TimeLog) Actuals(now time.Time) map[string]time.Duration {
+	actuals := make(map[string]time.Duration)
+	for _, s := range l.Sessions {
+		actuals[s.IssueID] += s.Duration(now)
+	}
+	return actuals
+}
+
+// EstimateVsActual compares an issue's estimate to the time logged against it
+type EstimateVsActual struct {
+	IssueID          string       `json:"issue_id"`
+	Title            string       `json:"title"`
+	Status           model.Status `json:"status"`
+	EstimatedMinutes int          `json:"estimated_minutes,omitempty"`
+	ActualMinutes    int          `json:"actual_minutes"`
+	// Ratio is actual/estimate (>1 means over estimate); 0 when there is no estimate
+	Ratio float64 `json:"ratio,omitempty"`
+}
+
+// EstimateReport summarizes estimate accuracy across issues with logged time
+type EstimateReport struct {
+	GeneratedAt time.Time          `json:"generated_at"`
+	Items       []EstimateVsActual `json:"items"`
+	Summary     EstimateSummary    `json:"summary"`
+}
+
+// EstimateSummary holds aggregate estimate-vs-actual figures
+type EstimateSummary struct {
+	TrackedIssues         int `json:"tracked_issues"`          // Issues with any logged time
+	EstimatedIssues       int `json:"estimated_issues"`        // Tracked issues that also have an estimate
+	TotalActualMinutes    int `json:"total_actual_minutes"`    // All logged time
+	TotalEstimatedMinutes int `json:"total_estimated_minutes"` // Estimates of tracked issues
+	// AccuracyRatio is actual/estimate over issues having both (0 if none)
+	AccuracyRatio float64 `json:"accuracy_ratio"`
+}
+
+// ComputeEstimateReport joins logged actuals with issue estimates.
+// Items are sorted by actual time (descending), then ID.
+func ComputeEstimateReport(issues []model.Issue, actuals map[string]time.Duration, now time.Time) EstimateReport {
+	report := EstimateReport{GeneratedAt: now, Items: []EstimateVsActual{}}
+
+	var bothActual, bothEstimate int
+	for _, issue := range issues {
+		actual, ok := actuals[issue.ID]
+		if !ok {
+			continue
+		}
+		item := EstimateVsActual{
+			IssueID:       issue.ID,
+			Title:         issue.Title,
+			Status:        issue.Status,
+			ActualMinutes: int(actual.Round(time.Minute) / time.Minute),
+		}
+		report.Summary.TrackedIssues++
+		report.Summary.TotalActualMinutes += item.ActualMinutes
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			item.EstimatedMinutes = *issue.EstimatedMinutes
+			item.Ratio = float64(item.ActualMinutes) / float64(item.EstimatedMinutes)
+			report.Summary.EstimatedIssues++
+			report.Summary.TotalEstimatedMinutes += item.EstimatedMinutes
+			bothActual += item.ActualMinutes
+			bothEstimate += item.EstimatedMinutes
+		}
+		report.Items = append(report.Items, item)
+	}
+	if bothEstimate > 0 {
+		report.Summary.AccuracyRatio = float64(bothActual) / float64(bothEstimate)
+	}
+
+	sort.Slice(report.Items, func(i, j int) bool {
+		if report.Items[i].ActualMinutes != report.Items[j].ActualMinutes {
+			return report.Items[i].ActualMinutes > report.Items[j].ActualMinutes
+		}
+		return report.Items[i].IssueID < report.Items[j].IssueID
+	})
+	return report
+}
@@ -0,0 +1,156 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Time report groupings
+const (
+	TimeGroupIssue    = "issue"
+	TimeGroupAssignee = "assignee"
+	TimeGroupWeek     = "week"
+)
+
+// unassignedGroup is the assignee group for issues without an owner
+const unassignedGroup = "(unassigned)"
+
+// TimeReportRow is one aggregated line of a time report
+type TimeReportRow struct {
+	Group    string `json:"group"`              // Issue ID, assignee, or week start (YYYY-MM-DD)
+	Title    string `json:"title,omitempty"`    // Issue title (issue grouping only)
+	Assignee string `json:"assignee,omitempty"` // Issue assignee (issue grouping only)
+	Issues   int    `json:"issues"`             // Distinct issues contributing time
+	Sessions int    `json:"sessions"`           // Sessions (or week slices of sessions) counted
+	Minutes  int    `json:"minutes"`
+}
+
+// TimeReport aggregates logged work sessions for invoicing or effort review
+type TimeReport struct {
+	GeneratedAt  time.Time       `json:"generated_at"`
+	GroupBy      string          `json:"group_by"`
+	Since        *time.Time      `json:"since,omitempty"`
+	Rows         []TimeReportRow `json:"rows"`
+	TotalMinutes int             `json:"total_minutes"`
+}
+
+// ValidTimeGroup reports whether groupBy is a supported time report grouping
+func ValidTimeGroup(groupBy string) bool {
+	switch groupBy {
+	case TimeGroupIssue, TimeGroupAssignee, TimeGroupWeek:
+		return true
+	}
+	return false
+}
+
+// WeekStart returns midnight on the Monday of t's week, in t's location
+func WeekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// ComputeTimeReport totals logged time grouped by issue, assignee, or week.
+// Sessions are clipped to since (if non-nil) and to now for running sessions;
+// with week grouping, sessions spanning a week boundary are split across weeks.
+// Rows are sorted by minutes (descending) except week rows, which are chronological.
+func ComputeTimeReport(issues []model.Issue, log *TimeLog, groupBy string, since *time.Time, now time.Time) (TimeReport, error) {
+	if !ValidTimeGroup(groupBy) {
+		return TimeReport{}, fmt.Errorf("invalid time report grouping %q (use issue, assignee, or week)", groupBy)
+	}
+	report := TimeReport{GeneratedAt: now, GroupBy: groupBy, Since: since, Rows: []TimeReportRow{}}
+	if log == nil {
+		return report, nil
+	}
+
+	issueByID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueByID[issues[i].ID] = &issues[i]
+	}
+
+	type bucket struct {
+		row      TimeReportRow
+		duration time.Duration
+		issues   map[string]bool
+	}
+	buckets := make(map[string]*bucket)
+	var total time.Duration
+
+	add := func(key, issueID string, d time.Duration) {
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucket{row: TimeReportRow{Group: key}, issues: make(map[string]bool)}
+			if groupBy == TimeGroupIssue {
+				if issue := issueByID[issueID]; issue != nil {
+					b.row.Title = issue.Title
+					b.row.Assignee = issue.Assignee
+				}
+			}
+			buckets[key] = b
+		}
+		b.duration += d
+		b.row.Sessions++
+		b.issues[issueID] = true
+		total += d
+	}
+
+	for _, s := range log.Sessions {
+		start := s.Start
+		end := now
+		if s.End != nil {
+			end = *s.End
+		}
+		if since != nil && start.Before(*since) {
+			start = *since
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		switch groupBy {
+		case TimeGroupIssue:
+			add(s.IssueID, s.IssueID, end.Sub(start))
+		case TimeGroupAssignee:
+			key := unassignedGroup
+			if issue := issueByID[s.IssueID]; issue != nil && strings.TrimSpace(issue.Assignee) != "" {
+				key = issue.Assignee
+			}
+			add(key, s.IssueID, end.Sub(start))
+		case TimeGroupWeek:
+			for cur := start; cur.Before(end); {
+				week := WeekStart(cur)
+				next := week.AddDate(0, 0, 7)
+				sliceEnd := end
+				if next.Before(end) {
+					sliceEnd = next
+				}
+				add(week.Format("2006-01-02"), s.IssueID, sliceEnd.Sub(cur))
+				cur = sliceEnd
+			}
+		}
+	}
+
+	for _, b := range buckets {
+		b.row.Minutes = durationMinutes(b.duration)
+		b.row.Issues = len(b.issues)
+		report.Rows = append(report.Rows, b.row)
+	}
+	report.TotalMinutes = durationMinutes(total)
+
+	sort.Slice(report.Rows, func(i, j int) bool {
+		a, b := report.Rows[i], report.Rows[j]
+		if groupBy != TimeGroupWeek && a.Minutes != b.Minutes {
+			return a.Minutes > b.Minutes
+		}
+		return a.Group < b.Group
+	})
+	return report, nil
+}
+
+func durationMinutes(d time.Duration) int {
+	return int(d.Round(time.Minute) / time.Minute)
+}
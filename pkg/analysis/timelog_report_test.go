@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func timeReportFixture() ([]model.Issue, *TimeLog) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Auth", Assignee: "alice"},
+		{ID: "B", Title: "Billing", Assignee: "bob"},
+		{ID: "C", Title: "Cleanup"},
+	}
+	// Sunday 2025-06-08 23:00 → Monday 01:00 spans a week boundary
+	at := func(day, hour int) time.Time { return time.Date(2025, 6, day, hour, 0, 0, 0, time.UTC) }
+	end := func(day, hour int) *time.Time { t := at(day, hour); return &t }
+	l := &TimeLog{Sessions: []WorkSession{
+		{IssueID: "A", Start: at(3, 9), End: end(3, 11)},
+		{IssueID: "B", Start: at(4, 9), End: end(4, 10)},
+		{IssueID: "A", Start: at(8, 23), End: end(9, 1)},
+		{IssueID: "C", Start: at(10, 9)}, // running
+	}}
+	return issues, l
+}
+
+func TestComputeTimeReport_Groupings(t *testing.T) {
+	issues, l := timeReportFixture()
+	now := time.Date(2025, 6, 10, 9, 30, 0, 0, time.UTC)
+
+	byIssue, err := ComputeTimeReport(issues, l, TimeGroupIssue, nil, now)
+	if err != nil {
+		t.Fatalf("ComputeTimeReport: %v", err)
+	}
+	if byIssue.TotalMinutes != 330 {
+		t.Errorf("Expected 330 total minutes, got %d", byIssue.TotalMinutes)
+	}
+	if len(byIssue.Rows) != 3 || byIssue.Rows[0].Group != "A" || byIssue.Rows[0].Minutes != 240 || byIssue.Rows[0].Sessions != 2 {
+		t.Fatalf("Unexpected issue rows: %+v", byIssue.Rows)
+	}
+	if byIssue.Rows[0].Title != "Auth" || byIssue.Rows[0].Assignee != "alice" {
+		t.Errorf("Issue rows should carry title and assignee: %+v", byIssue.Rows[0])
+	}
+
+	byAssignee, _ := ComputeTimeReport(issues, l, TimeGroupAssignee, nil, now)
+	got := map[string]int{}
+	for _, r := range byAssignee.Rows {
+		got[r.Group] = r.Minutes
+	}
+	if got["alice"] != 240 || got["bob"] != 60 || got[unassignedGroup] != 30 {
+		t.Errorf("Unexpected assignee totals: %v", got)
+	}
+
+	byWeek, _ := ComputeTimeReport(issues, l, TimeGroupWeek, nil, now)
+	if len(byWeek.Rows) != 2 {
+		t.Fatalf("Expected 2 weeks, got %+v", byWeek.Rows)
+	}
+	if byWeek.Rows[0].Group != "2025-06-02" || byWeek.Rows[0].Minutes != 240 {
+		t.Errorf("First week should hold 4h including the Sunday slice: %+v", byWeek.Rows[0])
+	}
+	if byWeek.Rows[1].Group != "2025-06-09" || byWeek.Rows[1].Minutes != 90 || byWeek.Rows[1].Issues != 2 {
+		t.Errorf("Second week should hold the Monday slice and running session: %+v", byWeek.Rows[1])
+	}
+}
+
+func TestComputeTimeReport_SinceAndValidation(t *testing.T) {
+	issues, l := timeReportFixture()
+	now := time.Date(2025, 6, 10, 9, 30, 0, 0, time.UTC)
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	report, err := ComputeTimeReport(issues, l, TimeGroupIssue, &since, now)
+	if err != nil {
+		t.Fatalf("ComputeTimeReport: %v", err)
+	}
+	if report.TotalMinutes != 90 {
+		t.Errorf("Expected sessions clipped to 90 minutes since %s, got %d", since.Format("2006-01-02"), report.TotalMinutes)
+	}
+
+	if _, err := ComputeTimeReport(issues, l, "month", nil, now); err == nil {
+		t.Error("Expected error for unsupported grouping")
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	sunday := time.Date(2025, 6, 8, 23, 0, 0, 0, time.UTC)
+	if got := WeekStart(sunday); !got.Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("WeekStart(Sunday) = %v", got)
+	}
+	monday := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	if got := WeekStart(monday); !got.Equal(monday) {
+		t.Errorf("WeekStart(Monday) = %v", got)
+	}
+}
@@ -0,0 +1,120 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTimeLog_StartStopAndReload(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+	l, err := LoadTimeLog(dir)
+	if err != nil {
+		t.Fatalf("LoadTimeLog on empty dir: %v", err)
+	}
+	if l.Active() != nil {
+		t.Fatal("Expected no active session")
+	}
+
+	if err := l.Start("bd-1", base); err != nil {
+		t.Fatal(err)
+	}
+	// Starting another issue implicitly stops the first
+	if err := l.Start("bd-2", base.Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	stopped, err := l.Stop(base.Add(45 * time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stopped == nil || stopped.IssueID != "bd-2" {
+		t.Fatalf("Expected bd-2 session stopped, got %+v", stopped)
+	}
+	if s, _ := l.Stop(base.Add(time.Hour)); s != nil {
+		t.Errorf("Stop with nothing running should return nil, got %+v", s)
+	}
+	if err := l.Start("bd-1", base.Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reload from disk replays the same sessions, including the running one
+	reloaded, err := LoadTimeLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.Sessions) != 3 {
+		t.Fatalf("Expected 3 sessions after reload, got %d", len(reloaded.Sessions))
+	}
+	if a := reloaded.Active(); a == nil || a.IssueID != "bd-1" {
+		t.Fatalf("Expected running bd-1 session, got %+v", a)
+	}
+
+	actuals := reloaded.Actuals(base.Add(2*time.Hour + 10*time.Minute))
+	if actuals["bd-1"] != 40*time.Minute {
+		t.Errorf("bd-1 actual = %v, want 40m", actuals["bd-1"])
+	}
+	if actuals["bd-2"] != 15*time.Minute {
+		t.Errorf("bd-2 actual = %v, want 15m", actuals["bd-2"])
+	}
+}
+
+func TestLoadTimeLog_SkipsMalformedLines(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"issue_id":"bd-1","action":"start","timestamp":"2025-06-02T09:00:00Z"}
+not json
+{"issue_id":"bd-1","action":"stop","timestamp":"2025-06-02T09:20:00Z"}
+`
+	if err := os.WriteFile(filepath.Join(dir, TimeLogFile), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l, err := LoadTimeLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Sessions) != 1 || l.Sessions[0].Active() {
+		t.Fatalf("Expected one closed session, got %+v", l.Sessions)
+	}
+	if d := l.Sessions[0].Duration(time.Now()); d != 20*time.Minute {
+		t.Errorf("Duration = %v, want 20m", d)
+	}
+}
+
+func TestComputeEstimateReport(t *testing.T) {
+	est := func(m int) *int { return &m }
+	issues := []model.Issue{
+		{ID: "a", Title: "Over", Status: model.StatusClosed, EstimatedMinutes: est(60)},
+		{ID: "b", Title: "Under", Status: model.StatusOpen, EstimatedMinutes: est(120)},
+		{ID: "c", Title: "No estimate", Status: model.StatusOpen},
+		{ID: "d", Title: "Untracked", Status: model.StatusOpen, EstimatedMinutes: est(30)},
+	}
+	actuals := map[string]time.Duration{
+		"a": 90 * time.Minute,
+		"b": 60 * time.Minute,
+		"c": 10 * time.Minute,
+	}
+
+	report := ComputeEstimateReport(issues, actuals, time.Now())
+
+	if len(report.Items) != 3 {
+		t.Fatalf("Expected 3 tracked items, got %d", len(report.Items))
+	}
+	if report.Items[0].IssueID != "a" || report.Items[0].Ratio != 1.5 {
+		t.Errorf("Expected a first with ratio 1.5, got %+v", report.Items[0])
+	}
+	s := report.Summary
+	if s.TrackedIssues != 3 || s.EstimatedIssues != 2 {
+		t.Errorf("Unexpected counts: %+v", s)
+	}
+	if s.TotalActualMinutes != 160 || s.TotalEstimatedMinutes != 180 {
+		t.Errorf("Unexpected totals: %+v", s)
+	}
+	// (90+60)/(60+120)
+	if s.AccuracyRatio < 0.83 || s.AccuracyRatio > 0.84 {
+		t.Errorf("AccuracyRatio = %.3f, want ~0.833", s.AccuracyRatio)
+	}
+}
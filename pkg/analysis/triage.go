@@ -35,6 +35,7 @@ type TriageMeta struct {
 	Phase2Ready   bool      `json:"phase2_ready"`
 	IssueCount    int       `json:"issue_count"`
 	ComputeTimeMs int64     `json:"compute_time_ms"`
+	Profile       string    `json:"profile,omitempty"` // Triage profile from .bv/triage.yaml, if one was used
 }
 
 // QuickRef provides at-a-glance summary for fast decisions
@@ -285,6 +286,9 @@ type TriageOptions struct {
 	// bv-87: Track/label-aware recommendation grouping for multi-agent coordination
 	GroupByTrack bool // Group recommendations by execution track (connected component)
 	GroupByLabel bool // Group recommendations by primary label
+
+	// Profile holds the scoring weights from .bv/triage.yaml; nil uses the defaults
+	Profile *TriageProfile
 }
 
 // TrackRecommendationGroup groups recommendations by execution track (bv-87)
@@ -359,7 +363,13 @@ func ComputeTriageWithOptionsAndTime(issues []model.Issue, opts TriageOptions, n
 	counts := computeCounts(issues, analyzer)
 
 	// Compute enhanced triage scores (bv-147)
-	triageScores := computeTriageScoresFromImpact(impactScores, unblocksMap, analyzer, DefaultTriageScoringOptions())
+	scoringOpts := DefaultTriageScoringOptions()
+	profileName := ""
+	if opts.Profile != nil {
+		scoringOpts = opts.Profile.ScoringOptions()
+		profileName = opts.Profile.Name
+	}
+	triageScores := computeTriageScoresFromImpact(impactScores, unblocksMap, analyzer, scoringOpts)
 
 	// Build recommendations using enhanced scores (bv-148)
 	recommendations := buildRecommendationsFromTriageScores(triageScores, analyzer, unblocksMap, opts.TopN)
@@ -399,6 +409,7 @@ func ComputeTriageWithOptionsAndTime(issues []model.Issue, opts TriageOptions, n
 			Phase2Ready:   stats.IsPhase2Ready(),
 			IssueCount:    len(issues),
 			ComputeTimeMs: elapsed.Milliseconds(),
+			Profile:       profileName,
 		},
 		QuickRef: QuickRef{
 			OpenCount:       counts.Open,
@@ -613,9 +624,12 @@ func buildBlockersToClear(analyzer *Analyzer, unblocksMap map[string][]string, l
 		})
 	}
 
-	// Sort by unblocks count descending
+	// Sort by unblocks count descending, then ID for a stable order
 	sort.Slice(blockers, func(i, j int) bool {
-		return len(blockers[i].unblocks) > len(blockers[j].unblocks)
+		if len(blockers[i].unblocks) != len(blockers[j].unblocks) {
+			return len(blockers[i].unblocks) > len(blockers[j].unblocks)
+		}
+		return blockers[i].id < blockers[j].id
 	})
 
 	result := make([]BlockerItem, 0, limit)
@@ -717,6 +731,8 @@ type TriageScore struct {
 type TriageFactors struct {
 	UnblockBoost   float64 `json:"unblock_boost"`             // Boost for items that unblock many others
 	QuickWinBoost  float64 `json:"quick_win_boost"`           // Boost for low-effort high-impact items
+	EffortBoost    float64 `json:"effort_boost,omitempty"`    // Boost for estimates under the median, penalty for those over
+	LabelBoost     float64 `json:"label_boost,omitempty"`     // Configured boost for the issue's labels
 	LabelHealth    float64 `json:"label_health,omitempty"`    // Phase 2: Label health factor
 	ClaimPenalty   float64 `json:"claim_penalty,omitempty"`   // Phase 3: Penalty for claimed items
 	AttentionScore float64 `json:"attention_score,omitempty"` // Phase 4: Attention-weighted health
@@ -728,6 +744,15 @@ type TriageScoringOptions struct {
 	BaseScoreWeight    float64 // Default 0.70
 	UnblockBoostWeight float64 // Default 0.15
 	QuickWinWeight     float64 // Default 0.15
+	EffortWeight       float64 // Default 0.10; largest effort boost or penalty
+
+	// Impact score reweighting: when set, these replace WeightPriorityBoost
+	// and WeightStaleness in the base score (see TriageWeights)
+	PriorityWeight  *float64
+	StalenessWeight *float64
+
+	// LabelBoosts are added to the score of issues with the label
+	LabelBoosts map[string]float64
 
 	// Thresholds
 	UnblockThreshold int // Min unblocks to get full boost (default 5)
@@ -746,6 +771,7 @@ func DefaultTriageScoringOptions() TriageScoringOptions {
 		BaseScoreWeight:    0.70,
 		UnblockBoostWeight: 0.15,
 		QuickWinWeight:     0.15,
+		EffortWeight:       0.10,
 		UnblockThreshold:   5,
 		QuickWinMaxDepth:   2,
 		// All optional features off by default (MVP mode)
@@ -786,10 +812,17 @@ func computeTriageScoresFromImpact(baseScores []ImpactScore, unblocksMap map[str
 		}
 	}
 
+	// Estimates are weighed against the typical open issue
+	unit := analyzer.estimateUnit()
+	medianEstimate := analyzer.medianEstimate(unit)
+
 	// Build triage scores
 	triageScores := make([]TriageScore, 0, len(baseScores))
 	for _, base := range baseScores {
 		ts := computeSingleTriageScore(base, unblocksMap, maxUnblocks, analyzer, opts)
+		if medianEstimate > 0 && opts.EffortWeight > 0 {
+			applyEffortBoost(&ts, analyzer, unit, medianEstimate, opts.EffortWeight)
+		}
 		triageScores = append(triageScores, ts)
 	}
 
@@ -810,6 +843,15 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 	applied := []string{"base"}
 	pending := []string{}
 
+	// Reweight the impact score's priority and staleness components
+	baseScore := base.Score
+	if opts.PriorityWeight != nil {
+		baseScore += base.Breakdown.PriorityBoostNorm * (*opts.PriorityWeight - WeightPriorityBoost)
+	}
+	if opts.StalenessWeight != nil {
+		baseScore += base.Breakdown.StalenessNorm * (*opts.StalenessWeight - WeightStaleness)
+	}
+
 	// Calculate unblock boost
 	unblocks := unblocksMap[base.IssueID]
 	if len(unblocks) > 0 {
@@ -830,7 +872,7 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 			// Lower depth = higher quick win potential
 			depthFactor := 1.0 - float64(blockerDepth)/float64(opts.QuickWinMaxDepth+1)
 			// Combine with base score for impact consideration
-			factors.QuickWinBoost = depthFactor * base.Score * opts.QuickWinWeight
+			factors.QuickWinBoost = depthFactor * baseScore * opts.QuickWinWeight
 			if factors.QuickWinBoost > opts.QuickWinWeight {
 				factors.QuickWinBoost = opts.QuickWinWeight // Cap at max weight
 			}
@@ -838,6 +880,18 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 		}
 	}
 
+	// Configured label boosts
+	if len(opts.LabelBoosts) > 0 {
+		if issue := analyzer.GetIssue(base.IssueID); issue != nil {
+			for _, label := range issue.Labels {
+				factors.LabelBoost += opts.LabelBoosts[label]
+			}
+		}
+		if factors.LabelBoost != 0 {
+			applied = append(applied, "label_boost")
+		}
+	}
+
 	// Track pending features
 	if !opts.EnableLabelHealth {
 		pending = append(pending, "label_health")
@@ -850,7 +904,7 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 	}
 
 	// Calculate final triage score
-	triageScore := base.Score*opts.BaseScoreWeight + factors.UnblockBoost + factors.QuickWinBoost
+	triageScore := baseScore*opts.BaseScoreWeight + factors.UnblockBoost + factors.QuickWinBoost + factors.LabelBoost
 
 	// Future phases (when enabled):
 	// Phase 2: triageScore += factors.LabelHealth * labelHealthWeight
@@ -871,6 +925,27 @@ func computeSingleTriageScore(base ImpactScore, unblocksMap map[string][]string,
 	}
 }
 
+// applyEffortBoost weights a triage score by the issue's estimate: half the
+// median estimate or less earns the full weight, twice the median or more
+// loses it, on a log scale in between. Unestimated issues are left alone.
+func applyEffortBoost(ts *TriageScore, analyzer *Analyzer, unit EstimateUnit, median, weight float64) {
+	issue := analyzer.GetIssue(ts.IssueID)
+	if issue == nil {
+		return
+	}
+	estimate, ok := IssueEstimate(issue, unit)
+	if !ok {
+		return
+	}
+	ratio := 1.0 // Zero-point work counts as the smallest
+	if estimate > 0 {
+		ratio = math.Max(-1, math.Min(1, math.Log2(median/estimate)))
+	}
+	ts.TriageFactors.EffortBoost = ratio * weight
+	ts.TriageScore += ts.TriageFactors.EffortBoost
+	ts.FactorsApplied = append(ts.FactorsApplied, "effort")
+}
+
 // GetBlockerDepth returns the depth of the blocker chain for an issue
 // Returns 0 if no blockers, 1 if blocked by one level, etc.
 // Returns -1 if the issue is part of a cycle
@@ -992,6 +1067,11 @@ func GenerateTriageReasons(ctx TriageReasonContext) TriageReasons {
 		}
 	}
 
+	// Configured label boosts (.bv/triage.yaml)
+	if ctx.TriageScore != nil && ctx.TriageScore.TriageFactors.LabelBoost != 0 {
+		reasons = append(reasons, fmt.Sprintf("🏷️ Label boost %+.2f from the triage profile", ctx.TriageScore.TriageFactors.LabelBoost))
+	}
+
 	// 3. Graph metrics (bottleneck/centrality)
 	if ctx.TriageScore != nil {
 		bd := ctx.TriageScore.Breakdown
@@ -0,0 +1,155 @@
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TriageConfigFilename is the per-project triage weights file under .bv/
+const TriageConfigFilename = "triage.yaml"
+
+// DefaultTriageProfile names the built-in weights
+const DefaultTriageProfile = "default"
+
+// TriageWeights are the tunable triage scoring weights. Unblock and QuickWin
+// cap their boosts; Priority and Staleness replace WeightPriorityBoost and
+// WeightStaleness in the impact score. LabelBoosts are added to the score of
+// issues carrying the label, and may be negative to demote them.
+type TriageWeights struct {
+	Unblock          float64            `yaml:"unblock" json:"unblock"`
+	Priority         float64            `yaml:"priority" json:"priority"`
+	Staleness        float64            `yaml:"staleness" json:"staleness"`
+	QuickWin         float64            `yaml:"quick_win" json:"quick_win"`
+	QuickWinMaxDepth int                `yaml:"quick_win_max_depth" json:"quick_win_max_depth"`
+	LabelBoosts      map[string]float64 `yaml:"label_boosts,omitempty" json:"label_boosts,omitempty"`
+}
+
+// DefaultTriageWeights returns the weights ComputeTriage uses without a config
+func DefaultTriageWeights() TriageWeights {
+	opts := DefaultTriageScoringOptions()
+	return TriageWeights{
+		Unblock:          opts.UnblockBoostWeight,
+		Priority:         WeightPriorityBoost,
+		Staleness:        WeightStaleness,
+		QuickWin:         opts.QuickWinWeight,
+		QuickWinMaxDepth: opts.QuickWinMaxDepth,
+	}
+}
+
+// TriageProfile is the set of weights triage runs with
+type TriageProfile struct {
+	Name    string        `json:"name"`
+	Weights TriageWeights `json:"weights"`
+}
+
+// ScoringOptions returns the scoring options for the profile's weights
+func (p TriageProfile) ScoringOptions() TriageScoringOptions {
+	opts := DefaultTriageScoringOptions()
+	w := p.Weights
+	opts.UnblockBoostWeight = w.Unblock
+	opts.QuickWinWeight = w.QuickWin
+	opts.QuickWinMaxDepth = w.QuickWinMaxDepth
+	opts.PriorityWeight = &w.Priority
+	opts.StalenessWeight = &w.Staleness
+	opts.LabelBoosts = w.LabelBoosts
+	return opts
+}
+
+// triageFile is the layout of .bv/triage.yaml: top-level weights override the
+// defaults, and each named profile overrides the top-level weights
+type triageFile struct {
+	Profile       string               `yaml:"profile"`
+	Profiles      map[string]yaml.Node `yaml:"profiles"`
+	TriageWeights `yaml:",inline"`
+}
+
+// TriageConfigPath returns the triage config path for a project
+func TriageConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", TriageConfigFilename)
+}
+
+// LoadTriageProfile loads the named profile from .bv/triage.yaml, or the
+// file's "profile" when name is empty. Returns the default weights if the
+// file doesn't exist.
+func LoadTriageProfile(projectDir, name string) (TriageProfile, error) {
+	profile := TriageProfile{Name: DefaultTriageProfile, Weights: DefaultTriageWeights()}
+
+	data, err := os.ReadFile(TriageConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			if name != "" && name != DefaultTriageProfile {
+				return profile, fmt.Errorf("triage profile %q: no %s", name, filepath.Join(".bv", TriageConfigFilename))
+			}
+			return profile, nil
+		}
+		return profile, fmt.Errorf("reading triage config: %w", err)
+	}
+
+	file := triageFile{TriageWeights: DefaultTriageWeights()}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return profile, fmt.Errorf("parsing triage config: %w", err)
+	}
+	if name == "" {
+		name = file.Profile
+	}
+	weights := file.TriageWeights
+	if name != "" && name != DefaultTriageProfile {
+		node, ok := file.Profiles[name]
+		if !ok {
+			return profile, fmt.Errorf("triage profile %q not found (have: %s)", name, strings.Join(triageProfileNames(file.Profiles), ", "))
+		}
+		weights.LabelBoosts = copyLabelBoosts(weights.LabelBoosts)
+		if err := node.Decode(&weights); err != nil {
+			return profile, fmt.Errorf("parsing triage profile %q: %w", name, err)
+		}
+	} else {
+		name = DefaultTriageProfile
+	}
+	if err := weights.Validate(); err != nil {
+		return profile, fmt.Errorf("invalid triage profile %q: %w", name, err)
+	}
+	return TriageProfile{Name: name, Weights: weights}, nil
+}
+
+// Validate rejects negative weights; label boosts may be negative
+func (w TriageWeights) Validate() error {
+	weights := []struct {
+		name  string
+		value float64
+	}{{"unblock", w.Unblock}, {"priority", w.Priority}, {"staleness", w.Staleness}, {"quick_win", w.QuickWin}}
+	for _, weight := range weights {
+		if weight.value < 0 {
+			return fmt.Errorf("%s must not be negative (got %g)", weight.name, weight.value)
+		}
+	}
+	if w.QuickWinMaxDepth < 0 {
+		return fmt.Errorf("quick_win_max_depth must not be negative (got %d)", w.QuickWinMaxDepth)
+	}
+	return nil
+}
+
+// triageProfileNames lists the named profiles, sorted, with the default first
+func triageProfileNames(profiles map[string]yaml.Node) []string {
+	names := make([]string, 0, len(profiles)+1)
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultTriageProfile}, names...)
+}
+
+func copyLabelBoosts(boosts map[string]float64) map[string]float64 {
+	if boosts == nil {
+		return nil
+	}
+	out := make(map[string]float64, len(boosts))
+	for k, v := range boosts {
+		out[k] = v
+	}
+	return out
+}
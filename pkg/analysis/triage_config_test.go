@@ -0,0 +1,127 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func writeTriageConfig(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(TriageConfigPath(dir), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadTriageProfile(t *testing.T) {
+	profile, err := LoadTriageProfile(t.TempDir(), "")
+	if err != nil || profile.Name != DefaultTriageProfile || profile.Weights.Unblock != 0.15 || profile.Weights.Priority != WeightPriorityBoost {
+		t.Fatalf("missing file should give the defaults: %+v, %v", profile, err)
+	}
+	if _, err := LoadTriageProfile(t.TempDir(), "release"); err == nil {
+		t.Error("a named profile without a config file should be an error")
+	}
+
+	dir := writeTriageConfig(t, `
+profile: release
+unblock: 0.3
+label_boosts:
+  customer: 0.1
+profiles:
+  release:
+    staleness: 0
+    label_boosts:
+      nice-to-have: -0.2
+  cleanup:
+    staleness: 0.4
+`)
+	release, err := LoadTriageProfile(dir, "")
+	if err != nil {
+		t.Fatalf("LoadTriageProfile: %v", err)
+	}
+	w := release.Weights
+	if release.Name != "release" || w.Unblock != 0.3 || w.Staleness != 0 || w.QuickWin != 0.15 {
+		t.Errorf("release should inherit top-level and default weights: %+v", release)
+	}
+	if w.LabelBoosts["customer"] != 0.1 || w.LabelBoosts["nice-to-have"] != -0.2 {
+		t.Errorf("label boosts should merge: %v", w.LabelBoosts)
+	}
+
+	cleanup, err := LoadTriageProfile(dir, "cleanup")
+	if err != nil || cleanup.Weights.Staleness != 0.4 || len(cleanup.Weights.LabelBoosts) != 1 {
+		t.Errorf("cleanup should not see release's label boosts: %+v, %v", cleanup, err)
+	}
+	if def, _ := LoadTriageProfile(dir, DefaultTriageProfile); def.Weights.Unblock != 0.3 || def.Weights.Staleness != WeightStaleness {
+		t.Errorf("the default profile is the top-level weights: %+v", def)
+	}
+
+	_, err = LoadTriageProfile(dir, "nope")
+	if err == nil || !strings.Contains(err.Error(), "default, cleanup, release") {
+		t.Errorf("unknown profile should list the profiles, got %v", err)
+	}
+
+	bad := writeTriageConfig(t, "quick_win: -1\n")
+	if _, err := LoadTriageProfile(bad, ""); err == nil || !strings.Contains(err.Error(), "quick_win must not be negative") {
+		t.Errorf("negative weight should be rejected, got %v", err)
+	}
+}
+
+func TestComputeTriage_Profile(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "a", Title: "Urgent fix", Status: model.StatusOpen, Priority: 0, UpdatedAt: now},
+		{ID: "b", Title: "Customer ask", Status: model.StatusOpen, Priority: 3, Labels: []string{"customer"}, UpdatedAt: now},
+	}
+	scoreOf := func(triage TriageResult, id string) float64 {
+		for _, rec := range triage.Recommendations {
+			if rec.ID == id {
+				return rec.Score
+			}
+		}
+		t.Fatalf("%s not recommended", id)
+		return 0
+	}
+
+	base := ComputeTriageWithOptionsAndTime(issues, TriageOptions{}, now)
+	def := TriageProfile{Name: DefaultTriageProfile, Weights: DefaultTriageWeights()}
+	same := ComputeTriageWithOptionsAndTime(issues, TriageOptions{Profile: &def}, now)
+	for _, id := range []string{"a", "b"} {
+		if scoreOf(base, id) != scoreOf(same, id) {
+			t.Errorf("default weights should reproduce the built-in score for %s", id)
+		}
+	}
+	if base.Meta.Profile != "" || same.Meta.Profile != DefaultTriageProfile {
+		t.Errorf("meta.profile = %q / %q", base.Meta.Profile, same.Meta.Profile)
+	}
+	if base.Recommendations[0].ID != "a" {
+		t.Fatalf("P0 should lead without boosts, got %s", base.Recommendations[0].ID)
+	}
+
+	boosted := def
+	boosted.Name = "customers"
+	boosted.Weights.Priority = 0
+	boosted.Weights.LabelBoosts = map[string]float64{"customer": 0.5}
+	triage := ComputeTriageWithOptionsAndTime(issues, TriageOptions{Profile: &boosted}, now)
+	if triage.Recommendations[0].ID != "b" {
+		t.Errorf("the label boost should put b first, got %s", triage.Recommendations[0].ID)
+	}
+	if scoreOf(triage, "a") >= scoreOf(base, "a") {
+		t.Error("a zero priority weight should lower a P0 issue's score")
+	}
+	found := false
+	for _, reason := range triage.Recommendations[0].Reasons {
+		found = found || strings.Contains(reason, "Label boost +0.50")
+	}
+	if !found {
+		t.Errorf("the boost should be explained: %v", triage.Recommendations[0].Reasons)
+	}
+}
@@ -1173,3 +1173,37 @@ func TestTriageGroupByTrack_TopPickHasHighestScore(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeTriageScores_EffortBoost(t *testing.T) {
+	pts := func(v float64) *float64 { return &v }
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "small", Title: "Small", Status: model.StatusOpen, Priority: 2, UpdatedAt: now, Estimate: pts(1)},
+		{ID: "medium", Title: "Medium", Status: model.StatusOpen, Priority: 2, UpdatedAt: now, Estimate: pts(3)},
+		{ID: "large", Title: "Large", Status: model.StatusOpen, Priority: 2, UpdatedAt: now, Estimate: pts(13)},
+		{ID: "none", Title: "Unestimated", Status: model.StatusOpen, Priority: 2, UpdatedAt: now},
+	}
+
+	byID := make(map[string]TriageScore)
+	for _, s := range ComputeTriageScores(issues) {
+		byID[s.IssueID] = s
+	}
+	weight := DefaultTriageScoringOptions().EffortWeight
+	if got := byID["small"].TriageFactors.EffortBoost; got != weight {
+		t.Errorf("expected the full effort boost %v for a third of the median, got %v", weight, got)
+	}
+	if got := byID["medium"].TriageFactors.EffortBoost; got != 0 {
+		t.Errorf("expected no effort boost at the median, got %v", got)
+	}
+	if got := byID["large"].TriageFactors.EffortBoost; got != -weight {
+		t.Errorf("expected the full effort penalty for over four times the median, got %v", got)
+	}
+	if byID["small"].TriageScore <= byID["large"].TriageScore {
+		t.Errorf("expected small work to outrank large work, got %v <= %v", byID["small"].TriageScore, byID["large"].TriageScore)
+	}
+	for _, f := range byID["none"].FactorsApplied {
+		if f == "effort" {
+			t.Error("expected no effort factor on an unestimated issue")
+		}
+	}
+}
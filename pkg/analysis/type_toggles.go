@@ -0,0 +1,124 @@
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// TypeTogglesFilename is the per-project analysis config file under .bv/
+const TypeTogglesFilename = "analysis.yaml"
+
+// TypeToggles controls which issue types participate in graph metrics and triage.
+// Container-style issues (epics used purely for grouping, chores) tend to sit at
+// the root of many dependency chains and dominate PageRank, which skews
+// recommendations away from real work.
+type TypeToggles struct {
+	// ExcludeTypes lists issue types that are dropped before analysis
+	ExcludeTypes []model.IssueType `yaml:"exclude_types,omitempty" json:"exclude_types,omitempty"`
+}
+
+// TypeTogglesPath returns the config path for a project
+func TypeTogglesPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", TypeTogglesFilename)
+}
+
+// LoadTypeToggles loads type toggles from .bv/analysis.yaml.
+// Returns an empty (no-op) config if the file doesn't exist.
+func LoadTypeToggles(projectDir string) (TypeToggles, error) {
+	var toggles TypeToggles
+
+	data, err := os.ReadFile(TypeTogglesPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return toggles, nil
+		}
+		return toggles, fmt.Errorf("reading analysis config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &toggles); err != nil {
+		return toggles, fmt.Errorf("parsing analysis config: %w", err)
+	}
+	if err := toggles.Validate(); err != nil {
+		return toggles, fmt.Errorf("invalid analysis config: %w", err)
+	}
+	return toggles, nil
+}
+
+// Validate normalizes type names and rejects unknown types
+func (t *TypeToggles) Validate() error {
+	for i, typ := range t.ExcludeTypes {
+		normalized := model.IssueType(strings.ToLower(strings.TrimSpace(string(typ))))
+		if !normalized.IsValid() {
+			return fmt.Errorf("exclude_types: unknown issue type %q", typ)
+		}
+		t.ExcludeTypes[i] = normalized
+	}
+	return nil
+}
+
+// IsEmpty returns true if no types are excluded
+func (t TypeToggles) IsEmpty() bool {
+	return len(t.ExcludeTypes) == 0
+}
+
+// Excludes returns true if the given issue type is excluded from analysis
+func (t TypeToggles) Excludes(typ model.IssueType) bool {
+	for _, excluded := range t.ExcludeTypes {
+		if excluded == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the subset of issues that should participate in analysis.
+// Dependencies pointing at excluded issues are dropped as well, so excluded
+// containers neither gain centrality nor block their children.
+// The input slice is not modified; if nothing is excluded it is returned as-is.
+func (t TypeToggles) Apply(issues []model.Issue) []model.Issue {
+	if t.IsEmpty() {
+		return issues
+	}
+
+	excludedIDs := make(map[string]bool)
+	for _, issue := range issues {
+		if t.Excludes(issue.IssueType) {
+			excludedIDs[issue.ID] = true
+		}
+	}
+	if len(excludedIDs) == 0 {
+		return issues
+	}
+
+	kept := make([]model.Issue, 0, len(issues)-len(excludedIDs))
+	for _, issue := range issues {
+		if excludedIDs[issue.ID] {
+			continue
+		}
+
+		hasExcludedDep := false
+		for _, dep := range issue.Dependencies {
+			if dep != nil && excludedIDs[dep.DependsOnID] {
+				hasExcludedDep = true
+				break
+			}
+		}
+		if hasExcludedDep {
+			filtered := make([]*model.Dependency, 0, len(issue.Dependencies))
+			for _, dep := range issue.Dependencies {
+				if dep != nil && excludedIDs[dep.DependsOnID] {
+					continue
+				}
+				filtered = append(filtered, dep)
+			}
+			issue.Dependencies = filtered
+		}
+		kept = append(kept, issue)
+	}
+	return kept
+}
@@ -0,0 +1,85 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTypeToggles_ApplyDropsExcludedTypesAndEdges(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "epic-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "task-1", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{
+				{IssueID: "task-1", DependsOnID: "epic-1", Type: model.DepBlocks},
+				{IssueID: "task-1", DependsOnID: "task-2", Type: model.DepBlocks},
+			}},
+		{ID: "task-2", Title: "Other", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+
+	toggles := TypeToggles{ExcludeTypes: []model.IssueType{model.TypeEpic}}
+	got := toggles.Apply(issues)
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 issues after excluding epics, got %d", len(got))
+	}
+	for _, issue := range got {
+		if issue.IssueType == model.TypeEpic {
+			t.Errorf("Epic %s should have been excluded", issue.ID)
+		}
+	}
+	if len(got[0].Dependencies) != 1 || got[0].Dependencies[0].DependsOnID != "task-2" {
+		t.Errorf("Expected only the task-2 dependency to survive, got %+v", got[0].Dependencies)
+	}
+	// Input must not be mutated
+	if len(issues[1].Dependencies) != 2 {
+		t.Errorf("Apply mutated input dependencies: %d", len(issues[1].Dependencies))
+	}
+}
+
+func TestTypeToggles_EmptyIsNoop(t *testing.T) {
+	issues := []model.Issue{{ID: "a", Title: "A", IssueType: model.TypeChore}}
+	got := TypeToggles{}.Apply(issues)
+	if len(got) != 1 {
+		t.Fatalf("Expected no-op for empty toggles, got %d issues", len(got))
+	}
+}
+
+func TestLoadTypeToggles(t *testing.T) {
+	dir := t.TempDir()
+
+	// Missing file returns empty config
+	toggles, err := LoadTypeToggles(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error for missing config: %v", err)
+	}
+	if !toggles.IsEmpty() {
+		t.Errorf("Expected empty toggles, got %v", toggles.ExcludeTypes)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(TypeTogglesPath(dir), []byte("exclude_types: [Chore, epic]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	toggles, err = LoadTypeToggles(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !toggles.Excludes(model.TypeChore) || !toggles.Excludes(model.TypeEpic) {
+		t.Errorf("Expected chore and epic excluded, got %v", toggles.ExcludeTypes)
+	}
+	if toggles.Excludes(model.TypeBug) {
+		t.Error("Bug should not be excluded")
+	}
+
+	if err := os.WriteFile(TypeTogglesPath(dir), []byte("exclude_types: [widget]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTypeToggles(dir); err == nil {
+		t.Error("Expected error for unknown issue type")
+	}
+}
@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+)
+
+// WaveVelocityWindowDays is how far back closures count toward the close rate
+const WaveVelocityWindowDays = 30
+
+// DefaultClosesPerDay is the close rate assumed when nothing closed in the
+// window: one issue a week
+const DefaultClosesPerDay = 1.0 / 7
+
+// WaveItem is an open issue scheduled in a wave
+type WaveItem struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	Priority int      `json:"priority"`
+	Status   string   `json:"status"`
+	WaitsOn  []string `json:"waits_on,omitempty"` // Open blockers, all in earlier waves
+}
+
+// ExecutionWave is a set of issues that can all be worked on in parallel
+// once the earlier waves are done
+type ExecutionWave struct {
+	Wave          int        `json:"wave"` // 1 is actionable now
+	Items         []WaveItem `json:"items"`
+	StartDay      float64    `json:"start_day"`      // Days from now the wave can start, at the close rate
+	EstimatedDays float64    `json:"estimated_days"` // Days to close the whole wave, at the close rate
+}
+
+// WavePlan layers the open work topologically into waves
+type WavePlan struct {
+	Waves           []ExecutionWave `json:"waves"`
+	ClosesPerDay    float64         `json:"closes_per_day"`
+	VelocitySamples int             `json:"velocity_samples"` // Closures in the window; 0 means DefaultClosesPerDay was assumed
+	EstimatedDays   float64         `json:"estimated_days"`   // Until every scheduled wave is done
+	MaxParallel     int             `json:"max_parallel"`     // Size of the widest wave
+	Unschedulable   []string        `json:"unschedulable,omitempty"`
+}
+
+// GetWavePlan layers the open issues into waves: wave 1 is everything
+// actionable now, and each later wave holds the issues whose open blockers
+// are all in earlier waves, so an issue sits in the wave after its longest
+// chain of open blockers. Each wave is given a duration from the recent
+// close rate (closures in the last WaveVelocityWindowDays), assuming a wave
+// finishes before the next starts. Issues on or behind a dependency cycle
+// can't be layered and are listed as unschedulable.
+func (a *Analyzer) GetWavePlan(now time.Time) WavePlan {
+	blockers := make(map[string][]string)
+	dependents := make(map[string][]string)
+	var open []string
+	for id, issue := range a.issueMap {
+		if issue.Status.IsClosed() {
+			continue
+		}
+		open = append(open, id)
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || seen[dep.DependsOnID] {
+				continue
+			}
+			if blocker, ok := a.issueMap[dep.DependsOnID]; ok && !blocker.Status.IsClosed() {
+				seen[dep.DependsOnID] = true
+				blockers[id] = append(blockers[id], dep.DependsOnID)
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], id)
+			}
+		}
+	}
+	sort.Strings(open)
+
+	waiting := make(map[string]int, len(open))
+	var layer []string
+	for _, id := range open {
+		waiting[id] = len(blockers[id])
+		if waiting[id] == 0 {
+			layer = append(layer, id)
+		}
+	}
+
+	plan := WavePlan{}
+	plan.ClosesPerDay, plan.VelocitySamples = a.closesPerDay(now)
+	scheduled := 0
+	for len(layer) > 0 {
+		wave := ExecutionWave{Wave: len(plan.Waves) + 1, StartDay: plan.EstimatedDays}
+		var next []string
+		for _, id := range layer {
+			issue := a.issueMap[id]
+			waitsOn := append([]string(nil), blockers[id]...)
+			sort.Strings(waitsOn)
+			wave.Items = append(wave.Items, WaveItem{
+				ID:       id,
+				Title:    issue.Title,
+				Priority: issue.Priority,
+				Status:   string(issue.Status),
+				WaitsOn:  waitsOn,
+			})
+			for _, d := range dependents[id] {
+				waiting[d]--
+				if waiting[d] == 0 {
+					next = append(next, d)
+				}
+			}
+		}
+		sort.Slice(wave.Items, func(i, j int) bool {
+			if wave.Items[i].Priority != wave.Items[j].Priority {
+				return wave.Items[i].Priority < wave.Items[j].Priority
+			}
+			return wave.Items[i].ID < wave.Items[j].ID
+		})
+		wave.EstimatedDays = float64(len(wave.Items)) / plan.ClosesPerDay
+		plan.EstimatedDays += wave.EstimatedDays
+		plan.MaxParallel = max(plan.MaxParallel, len(wave.Items))
+		plan.Waves = append(plan.Waves, wave)
+		scheduled += len(layer)
+		sort.Strings(next)
+		layer = next
+	}
+
+	if scheduled < len(open) {
+		for _, id := range open {
+			if waiting[id] > 0 {
+				plan.Unschedulable = append(plan.Unschedulable, id)
+			}
+		}
+	}
+	return plan
+}
+
+// closesPerDay returns the recent close rate and the closures it is based on
+func (a *Analyzer) closesPerDay(now time.Time) (float64, int) {
+	since := now.AddDate(0, 0, -WaveVelocityWindowDays)
+	closed := 0
+	for _, issue := range a.issueMap {
+		if !issue.Status.IsClosed() {
+			continue
+		}
+		// Robust closure time: use ClosedAt if available, else UpdatedAt
+		closedAt := issue.UpdatedAt
+		if issue.ClosedAt != nil {
+			closedAt = *issue.ClosedAt
+		}
+		if !closedAt.Before(since) && !closedAt.After(now) {
+			closed++
+		}
+	}
+	if closed == 0 {
+		return DefaultClosesPerDay, 0
+	}
+	return float64(closed) / WaveVelocityWindowDays, closed
+}
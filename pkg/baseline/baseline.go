@@ -11,6 +11,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // Baseline represents a snapshot of project metrics at a point in time
@@ -94,7 +96,7 @@ func DefaultPath(projectDir string) string {
 func (b *Baseline) Save(path string) error {
 	// Ensure parent directory exists
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := readonly.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("creating directory: %w", err)
 	}
 
@@ -104,7 +106,7 @@ func (b *Baseline) Save(path string) error {
 		return fmt.Errorf("encoding baseline: %w", err)
 	}
 
-	if err := os.WriteFile(path, data, 0644); err != nil {
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
 		return fmt.Errorf("writing baseline: %w", err)
 	}
 
@@ -0,0 +1,206 @@
+package baseline
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// ArchiveDirname is the directory under .bv holding dated snapshots
+const ArchiveDirname = "baselines"
+
+// ActiveName names the baseline in .bv/baseline.json, which drift checks
+// compare against by default
+const ActiveName = "active"
+
+// LatestName resolves to the newest dated snapshot
+const LatestName = "latest"
+
+// DefaultKeep is how many dated snapshots are kept when rotating
+const DefaultKeep = 30
+
+// snapshotLayout names snapshots after the day they were taken
+const snapshotLayout = "20060102"
+
+// ArchiveDir returns the dated snapshot directory for a project
+func ArchiveDir(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ArchiveDirname)
+}
+
+// SnapshotName returns the name of the snapshot for day, e.g. "20250601"
+func SnapshotName(day time.Time) string {
+	return day.Format(snapshotLayout)
+}
+
+// SnapshotPath returns the file for the snapshot taken on day
+func SnapshotPath(projectDir string, day time.Time) string {
+	return filepath.Join(ArchiveDir(projectDir), SnapshotName(day)+".json")
+}
+
+// HasSnapshot checks if a snapshot was taken on day
+func HasSnapshot(projectDir string, day time.Time) bool {
+	return Exists(SnapshotPath(projectDir, day))
+}
+
+// Archive saves b as the snapshot for the day it was created, replacing
+// any earlier snapshot from that day
+func (b *Baseline) Archive(projectDir string) (string, error) {
+	path := SnapshotPath(projectDir, b.CreatedAt)
+	if err := b.Save(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Entry is a stored baseline
+type Entry struct {
+	Name     string
+	Path     string
+	Baseline *Baseline
+}
+
+// Active checks if this is the baseline in .bv/baseline.json
+func (e Entry) Active() bool {
+	return e.Name == ActiveName
+}
+
+// snapshotFiles returns the names of dated snapshots, newest first
+func snapshotFiles(projectDir string) ([]string, error) {
+	entries, err := os.ReadDir(ArchiveDir(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading baselines: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok || e.IsDir() {
+			continue
+		}
+		if _, err := time.Parse(snapshotLayout, name); err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names, nil
+}
+
+// List returns the active baseline, if any, followed by the dated
+// snapshots, newest first. Snapshots that can't be read are skipped.
+func List(projectDir string) ([]Entry, error) {
+	var list []Entry
+	if path := DefaultPath(projectDir); Exists(path) {
+		b, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, Entry{Name: ActiveName, Path: path, Baseline: b})
+	}
+
+	names, err := snapshotFiles(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		path := filepath.Join(ArchiveDir(projectDir), name+".json")
+		b, err := Load(path)
+		if err != nil {
+			continue
+		}
+		list = append(list, Entry{Name: name, Path: path, Baseline: b})
+	}
+	return list, nil
+}
+
+// Resolve returns the file of a stored baseline: "" or "active" for
+// .bv/baseline.json, "latest" for the newest snapshot, a snapshot date
+// (20250601 or 2025-06-01), or a path to a baseline file
+func Resolve(projectDir, name string) (string, error) {
+	switch name {
+	case "", ActiveName:
+		return DefaultPath(projectDir), nil
+	case LatestName:
+		names, err := snapshotFiles(projectDir)
+		if err != nil {
+			return "", err
+		}
+		if len(names) == 0 {
+			return "", fmt.Errorf("no baseline snapshots in %s", ArchiveDir(projectDir))
+		}
+		return filepath.Join(ArchiveDir(projectDir), names[0]+".json"), nil
+	}
+
+	day := strings.ReplaceAll(name, "-", "")
+	if _, err := time.Parse(snapshotLayout, day); err == nil {
+		path := filepath.Join(ArchiveDir(projectDir), day+".json")
+		if !Exists(path) {
+			return "", fmt.Errorf("no baseline snapshot for %s", name)
+		}
+		return path, nil
+	}
+	if Exists(name) {
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown baseline %q (use active, latest, a date like 20250601, or a file)", name)
+}
+
+// Rotate deletes all but the newest keep snapshots and returns the files
+// removed. The active baseline is never touched.
+func Rotate(projectDir string, keep int) ([]string, error) {
+	if keep < 0 {
+		keep = 0
+	}
+	names, err := snapshotFiles(projectDir)
+	if err != nil || len(names) <= keep {
+		return nil, err
+	}
+	var removed []string
+	for _, name := range names[keep:] {
+		path := filepath.Join(ArchiveDir(projectDir), name+".json")
+		if err := readonly.Remove(path); err != nil {
+			return removed, fmt.Errorf("removing baseline %s: %w", name, err)
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
+
+// Activate makes the baseline at path the active one, so drift checks
+// compare against it
+func Activate(projectDir, path string) error {
+	b, err := Load(path)
+	if err != nil {
+		return err
+	}
+	return b.Save(DefaultPath(projectDir))
+}
+
+// TopItems converts a metrics map to its top limit items, highest first
+func TopItems(metrics map[string]float64, limit int) []MetricItem {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	items := make([]MetricItem, 0, len(metrics))
+	for id, value := range metrics {
+		items = append(items, MetricItem{ID: id, Value: value})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Value != items[j].Value {
+			return items[i].Value > items[j].Value
+		}
+		return items[i].ID < items[j].ID
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
@@ -0,0 +1,108 @@
+package baseline
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func saveSnapshot(t *testing.T, dir string, day time.Time, open int) {
+	t.Helper()
+	b := &Baseline{Version: CurrentVersion, CreatedAt: day, Stats: GraphStats{OpenCount: open}}
+	if _, err := b.Archive(dir); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveListResolve(t *testing.T) {
+	dir := t.TempDir()
+	if entries, err := List(dir); err != nil || len(entries) != 0 {
+		t.Fatalf("expected no baselines, got %v, %v", entries, err)
+	}
+
+	day := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	saveSnapshot(t, dir, day, 1)
+	saveSnapshot(t, dir, day.AddDate(0, 0, 1), 2)
+	saveSnapshot(t, dir, day.Add(5*time.Hour), 3) // Replaces the first
+	active := &Baseline{Version: CurrentVersion, CreatedAt: day, Description: "release"}
+	if err := active.Save(DefaultPath(dir)); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name)
+	}
+	if strings.Join(names, ",") != "active,20250602,20250601" {
+		t.Fatalf("entries = %v", names)
+	}
+	if !entries[0].Active() || entries[2].Baseline.Stats.OpenCount != 3 {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+
+	cases := map[string]string{
+		"":           DefaultPath(dir),
+		"active":     DefaultPath(dir),
+		"latest":     SnapshotPath(dir, day.AddDate(0, 0, 1)),
+		"20250601":   SnapshotPath(dir, day),
+		"2025-06-01": SnapshotPath(dir, day),
+	}
+	for name, want := range cases {
+		if got, err := Resolve(dir, name); err != nil || got != want {
+			t.Errorf("Resolve(%q) = %s, %v; want %s", name, got, err, want)
+		}
+	}
+	if _, err := Resolve(dir, "20240101"); err == nil {
+		t.Error("expected an error for a missing snapshot")
+	}
+	if _, err := Resolve(dir, "yesterday"); err == nil {
+		t.Error("expected an error for an unknown name")
+	}
+
+	if err := Activate(dir, SnapshotPath(dir, day.AddDate(0, 0, 1))); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := Load(DefaultPath(dir)); b.Stats.OpenCount != 2 {
+		t.Errorf("activated baseline has %d open, want 2", b.Stats.OpenCount)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	dir := t.TempDir()
+	day := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		saveSnapshot(t, dir, day.AddDate(0, 0, i), i)
+	}
+
+	removed, err := Rotate(dir, 2)
+	if err != nil || len(removed) != 3 {
+		t.Fatalf("Rotate removed %v, %v", removed, err)
+	}
+	if filepath.Base(removed[0]) != "20250603.json" {
+		t.Errorf("expected the oldest removed, got %v", removed)
+	}
+	if entries, _ := List(dir); len(entries) != 2 || entries[0].Name != "20250605" {
+		t.Errorf("remaining = %+v", entries)
+	}
+	if removed, _ := Rotate(dir, 5); len(removed) != 0 {
+		t.Errorf("nothing to rotate, removed %v", removed)
+	}
+}
+
+func TestTopItems(t *testing.T) {
+	items := TopItems(map[string]float64{"A": 3, "B": 5, "C": 1}, 2)
+	if len(items) != 2 {
+		t.Fatalf("expected top 2 items, got %d", len(items))
+	}
+	if items[0].ID != "B" || items[1].ID != "A" {
+		t.Fatalf("items not sorted desc: %+v", items)
+	}
+	if TopItems(nil, 3) != nil {
+		t.Fatalf("nil metrics should return nil")
+	}
+}
@@ -0,0 +1,73 @@
+package correlation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"time"
+)
+
+// BeadChange is one commit's edit to a bead's line in the beads file, with
+// the full JSON on each side so callers can compare every field
+type BeadChange struct {
+	CommitSHA string    `json:"commit_sha"`
+	CommitMsg string    `json:"commit_message"`
+	Author    string    `json:"author"`
+	Timestamp time.Time `json:"timestamp"`
+	Before    string    `json:"before,omitempty"` // Empty when the commit created the bead
+	After     string    `json:"after,omitempty"`  // Empty when the commit removed it
+}
+
+// ExtractChanges returns every commit that changed beadID's line in the
+// beads file, oldest first. opts.BeadID is ignored.
+func (e *Extractor) ExtractChanges(ctx context.Context, beadID string, opts ExtractOptions) ([]BeadChange, error) {
+	opts.BeadID = beadID
+	var changes []BeadChange
+	err := e.runGitLog(ctx, opts, func(info commitInfo, diff []byte) {
+		before, after := beadLines(diff, beadID)
+		if before == after {
+			return // Only moved within the file, or not touched at all
+		}
+		changes = append(changes, BeadChange{
+			CommitSHA: info.SHA,
+			CommitMsg: info.Message,
+			Author:    info.Author,
+			Timestamp: info.Timestamp,
+			Before:    before,
+			After:     after,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Oldest first (git log returns newest first)
+	for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+		changes[i], changes[j] = changes[j], changes[i]
+	}
+	return changes, nil
+}
+
+// beadLines finds the removed and added JSON lines for beadID in a diff
+func beadLines(diff []byte, beadID string) (before, after string) {
+	scanner := bufio.NewScanner(bytes.NewReader(diff))
+	const maxCapacity = 1024 * 1024 * 10 // 10MB, as in parseDiff
+	scanner.Buffer(make([]byte, 64*1024), maxCapacity)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "-{") && !strings.HasPrefix(line, "+{") {
+			continue
+		}
+		snap, ok := parseBeadJSON(line[1:])
+		if !ok || snap.ID != beadID {
+			continue
+		}
+		if line[0] == '-' {
+			before = line[1:]
+		} else {
+			after = line[1:]
+		}
+	}
+	return before, after
+}
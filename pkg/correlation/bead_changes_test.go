@@ -0,0 +1,25 @@
+package correlation
+
+import "testing"
+
+func TestBeadLines(t *testing.T) {
+	diff := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
+--- a/.beads/beads.jsonl
++++ b/.beads/beads.jsonl
+@@ -1,2 +1,2 @@
+-{"id":"bv-1","title":"One","status":"open"}
++{"id":"bv-1","title":"One","status":"closed"}
+ {"id":"bv-10","title":"Ten","status":"open"}
++{"id":"bv-2","title":"Two","status":"open"}
+`)
+	before, after := beadLines(diff, "bv-1")
+	if before != `{"id":"bv-1","title":"One","status":"open"}` || after != `{"id":"bv-1","title":"One","status":"closed"}` {
+		t.Errorf("Expected bv-1's old and new lines, got %q and %q", before, after)
+	}
+	if before, after := beadLines(diff, "bv-2"); before != "" || after == "" {
+		t.Errorf("Expected bv-2 to be created, got %q and %q", before, after)
+	}
+	if before, after := beadLines(diff, "bv-10"); before != "" || after != "" {
+		t.Errorf("Expected context lines to be ignored, got %q and %q", before, after)
+	}
+}
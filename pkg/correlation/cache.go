@@ -283,15 +283,17 @@ func hashBeads(beads []BeadInfo) string {
 func hashOptions(opts CorrelatorOptions) string {
 	// Serialize options to JSON for consistent hashing
 	data, err := json.Marshal(struct {
-		BeadID string
-		Since  *time.Time
-		Until  *time.Time
-		Limit  int
+		BeadID  string
+		Since   *time.Time
+		Until   *time.Time
+		Limit   int
+		Weights *HeuristicWeights
 	}{
-		BeadID: opts.BeadID,
-		Since:  opts.Since,
-		Until:  opts.Until,
-		Limit:  opts.Limit,
+		BeadID:  opts.BeadID,
+		Since:   opts.Since,
+		Until:   opts.Until,
+		Limit:   opts.Limit,
+		Weights: opts.Weights,
 	})
 	if err != nil {
 		return "default"
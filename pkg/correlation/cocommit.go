@@ -4,6 +4,7 @@ package correlation
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"os/exec"
 	"path/filepath"
@@ -18,11 +19,17 @@ var renamePattern = regexp.MustCompile(`\{[^}]* => ([^}]*)\}`)
 // CoCommitExtractor extracts files that were changed in the same commit as bead changes
 type CoCommitExtractor struct {
 	repoPath string
+	weights  HeuristicWeights
 }
 
 // NewCoCommitExtractor creates a new co-commit extractor
 func NewCoCommitExtractor(repoPath string) *CoCommitExtractor {
-	return &CoCommitExtractor{repoPath: repoPath}
+	return &CoCommitExtractor{repoPath: repoPath, weights: DefaultHeuristicWeights()}
+}
+
+// SetWeights replaces the heuristic weights used for confidence scoring
+func (c *CoCommitExtractor) SetWeights(weights HeuristicWeights) {
+	c.weights = weights
 }
 
 // codeFileExtensions lists file extensions considered "code files"
@@ -110,7 +117,7 @@ func (c *CoCommitExtractor) ExtractCoCommittedFiles(event BeadEvent) ([]FileChan
 
 // CreateCorrelatedCommit creates a CorrelatedCommit with confidence scoring
 func (c *CoCommitExtractor) CreateCorrelatedCommit(event BeadEvent, files []FileChange) CorrelatedCommit {
-	confidence := c.calculateConfidence(event, files)
+	confidence, breakdown := c.scoreConfidence(event, files)
 	reason := c.generateReason(event, files, confidence)
 
 	return CorrelatedCommit{
@@ -125,6 +132,7 @@ func (c *CoCommitExtractor) CreateCorrelatedCommit(event BeadEvent, files []File
 		Method:      MethodCoCommitted,
 		Confidence:  confidence,
 		Reason:      reason,
+		Breakdown:   breakdown,
 	}
 }
 
@@ -252,33 +260,36 @@ func extractNewPath(path string) string {
 
 // calculateConfidence computes the confidence score for a co-commit correlation
 func (c *CoCommitExtractor) calculateConfidence(event BeadEvent, files []FileChange) float64 {
+	confidence, _ := c.scoreConfidence(event, files)
+	return confidence
+}
+
+// scoreConfidence computes the confidence of a co-commit correlation along
+// with the factors it's made of
+func (c *CoCommitExtractor) scoreConfidence(event BeadEvent, files []FileChange) (float64, []ConfidenceFactor) {
+	var score confidenceScore
+	w := c.weights
+
 	// Base confidence for co-committed files
-	confidence := 0.95
+	score.add(SignalCoCommit, w.CoCommitBase, fmt.Sprintf("same commit as the status change to %s", event.EventType))
 
 	// Bonus: commit message mentions bead ID
 	if containsBeadID(event.CommitMsg, event.BeadID) {
-		confidence += 0.04
+		score.add(SignalExplicitID, w.IDInMessage, "commit message names "+event.BeadID)
 	}
 
 	// Penalty: shotgun commit (>20 files)
 	if len(files) > 20 {
-		confidence -= 0.10
+		score.add(SignalFilePaths, -w.LargeCommit, fmt.Sprintf("large commit (%d files)", len(files)))
 	}
 
 	// Penalty: only test files
 	if allTestFiles(files) {
-		confidence -= 0.05
+		score.add(SignalFilePaths, -w.TestFilesOnly, "only test files")
 	}
 
-	// Clamp to [0, 1]
-	if confidence > 1.0 {
-		confidence = 1.0
-	}
-	if confidence < 0.0 {
-		confidence = 0.0
-	}
-
-	return confidence
+	confidence := score.clamp(0.0, 1.0)
+	return confidence, score.factors
 }
 
 // generateReason creates a human-readable explanation for the correlation
@@ -384,14 +395,39 @@ func shortSHA(sha string) string {
 
 // ExtractAllCoCommits extracts co-committed files for all events with status changes
 func (c *CoCommitExtractor) ExtractAllCoCommits(events []BeadEvent) ([]CorrelatedCommit, error) {
+	return c.ExtractAllCoCommitsContext(context.Background(), events, nil)
+}
+
+// ExtractAllCoCommitsContext is ExtractAllCoCommits with cancellation, checked
+// between commits, and a progress callback counting the claim and close
+// events processed so far
+func (c *CoCommitExtractor) ExtractAllCoCommitsContext(ctx context.Context, events []BeadEvent, progress func(done, total int)) ([]CorrelatedCommit, error) {
 	var commits []CorrelatedCommit
 	fileCache := make(map[string][]FileChange) // Cache file lookups by SHA
 
+	total := 0
+	for _, event := range events {
+		if event.EventType == EventClaimed || event.EventType == EventClosed {
+			total++
+		}
+	}
+	done := 0
+	if progress != nil {
+		progress(0, total)
+	}
+
 	for _, event := range events {
 		// Only process status change events
 		if event.EventType != EventClaimed && event.EventType != EventClosed {
 			continue
 		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		done++
+		if progress != nil {
+			progress(done, total)
+		}
 
 		// Use cached files if available, otherwise fetch from git
 		files, cached := fileCache[event.CommitSHA]
@@ -1,6 +1,8 @@
 package correlation
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -475,4 +477,27 @@ func TestExtractNewPath_ComplexCases(t *testing.T) {
 			t.Errorf("extractNewPath(%q) = %q; want %q", tc.input, got, tc.expected)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestExtractAllCoCommitsContext_Cancelled(t *testing.T) {
+	c := NewCoCommitExtractor("/tmp/test")
+	events := []BeadEvent{
+		{BeadID: "bv-1", EventType: EventCreated, CommitSHA: "abc"},
+		{BeadID: "bv-1", EventType: EventClaimed, CommitSHA: "def"},
+		{BeadID: "bv-1", EventType: EventClosed, CommitSHA: "ghi"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var reports [][2]int
+	_, err := c.ExtractAllCoCommitsContext(ctx, events, func(done, total int) {
+		reports = append(reports, [2]int{done, total})
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	// Only the status events count, and none were processed
+	if len(reports) != 1 || reports[0] != [2]int{0, 2} {
+		t.Errorf("reports = %v, want [[0 2]]", reports)
+	}
+}
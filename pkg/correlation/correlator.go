@@ -2,10 +2,12 @@
 package correlation
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"time"
 )
@@ -35,10 +37,23 @@ type CorrelatorOptions struct {
 	Since  *time.Time // Only events after this time
 	Until  *time.Time // Only events before this time
 	Limit  int        // Max commits to process (0 = no limit)
+
+	// Weights tunes the confidence heuristics (nil = DefaultHeuristicWeights)
+	Weights *HeuristicWeights
+
+	// Progress, if set, is called as co-committed files are read, one step
+	// per claim or close event. Reading git log before that isn't counted.
+	Progress func(done, total int)
 }
 
 // GenerateReport generates a complete history report
 func (c *Correlator) GenerateReport(beads []BeadInfo, opts CorrelatorOptions) (*HistoryReport, error) {
+	return c.GenerateReportContext(context.Background(), beads, opts)
+}
+
+// GenerateReportContext is GenerateReport that stops, returning an error
+// wrapping ctx's, when ctx is done
+func (c *Correlator) GenerateReportContext(ctx context.Context, beads []BeadInfo, opts CorrelatorOptions) (*HistoryReport, error) {
 	// Build extract options
 	extractOpts := ExtractOptions{
 		Since:  opts.Since,
@@ -48,13 +63,19 @@ func (c *Correlator) GenerateReport(beads []BeadInfo, opts CorrelatorOptions) (*
 	}
 
 	// Extract lifecycle events from git history
-	events, err := c.extractor.Extract(extractOpts)
+	events, err := c.extractor.ExtractContext(ctx, extractOpts)
 	if err != nil {
 		return nil, fmt.Errorf("extracting events: %w", err)
 	}
 
 	// Extract co-committed files
-	commits, err := c.coCommitter.ExtractAllCoCommits(events)
+	coCommitter := c.coCommitter
+	if opts.Weights != nil {
+		weighted := *c.coCommitter
+		weighted.SetWeights(*opts.Weights)
+		coCommitter = &weighted
+	}
+	commits, err := coCommitter.ExtractAllCoCommitsContext(ctx, events, opts.Progress)
 	if err != nil {
 		return nil, fmt.Errorf("extracting co-commits: %w", err)
 	}
@@ -71,6 +92,9 @@ func (c *Correlator) GenerateReport(beads []BeadInfo, opts CorrelatorOptions) (*
 		histories = filtered
 	}
 
+	// Link branches, pull requests and merges
+	linkDelivery(ctx, c.repoPath, histories, opts)
+
 	// Build commit index
 	commitIndex := c.buildCommitIndex(histories)
 
@@ -302,6 +326,10 @@ func (c *Correlator) calculateDataHash(beads []BeadInfo) string {
 
 // ValidateRepository checks if the repository is valid for correlation
 func ValidateRepository(repoPath string) error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("git is not installed; history correlation needs it in PATH")
+	}
+
 	// Check if git directory exists
 	gitDir := filepath.Join(repoPath, ".git")
 	if _, err := os.Stat(gitDir); os.IsNotExist(err) {
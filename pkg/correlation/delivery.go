@@ -0,0 +1,284 @@
+package correlation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BranchRef is a git branch whose name mentions a bead
+type BranchRef struct {
+	Name   string `json:"name"`
+	Remote bool   `json:"remote"` // A remote-tracking branch such as origin/bv-12-login
+}
+
+// PullRequestRef is a pull request that carried a bead's work
+type PullRequestRef struct {
+	Number   int       `json:"number"`
+	URL      string    `json:"url,omitempty"`
+	Branch   string    `json:"branch,omitempty"`    // Head branch, when the merge commit names it
+	MergeSHA string    `json:"merge_sha,omitempty"` // Commit that landed it
+	MergedAt time.Time `json:"merged_at,omitempty"`
+	Source   string    `json:"source"` // "merge-commit", "squash" or "trailer"
+}
+
+// MergeRef is a merge commit that brought a bead's branch in
+type MergeRef struct {
+	SHA    string    `json:"sha"`
+	Branch string    `json:"branch"`
+	Into   string    `json:"into,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// DeliveryChain links a bead to the branches, pull requests and merges
+// that carried its work: bead → branch → PR → merge
+type DeliveryChain struct {
+	Branches     []BranchRef      `json:"branches,omitempty"`
+	PullRequests []PullRequestRef `json:"pull_requests,omitempty"`
+	Merges       []MergeRef       `json:"merges,omitempty"`
+}
+
+// IsEmpty reports whether nothing was linked
+func (d *DeliveryChain) IsEmpty() bool {
+	return d == nil || (len(d.Branches) == 0 && len(d.PullRequests) == 0 && len(d.Merges) == 0)
+}
+
+var (
+	// Merge pull request #123 from owner/branch (GitHub)
+	prMergePattern = regexp.MustCompile(`^Merge pull request #(\d+) from (\S+)`)
+	// Merge branch 'feature' [of url] [into main] (git, GitLab)
+	branchMergePattern = regexp.MustCompile(`^Merge (?:remote-tracking )?branch '([^']+)'(?: of \S+)?(?: into (\S+))?`)
+	// Subject ending in (#123), as GitHub squash merges write it
+	squashPattern = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+	// PR: #123, Pull-Request: https://github.com/o/r/pull/123
+	prTrailerPattern = regexp.MustCompile(`(?im)^(?:PR|Pull[- ]Request):\s*(\S+)\s*$`)
+	prURLPattern     = regexp.MustCompile(`/pulls?/(\d+)`)
+	githubRemote     = regexp.MustCompile(`github\.com[:/]([^/]+)/([^/\s]+?)(?:\.git)?/?$`)
+)
+
+// mentionsBeadID reports whether text names beadID on its own: not as part
+// of a longer ID, so bv-1 isn't found in bv-12
+func mentionsBeadID(text, beadID string) bool {
+	if beadID == "" {
+		return false
+	}
+	lower, id := strings.ToLower(text), strings.ToLower(beadID)
+	for start := 0; ; {
+		i := strings.Index(lower[start:], id)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(id)
+		if (i == 0 || !isIDChar(lower[i-1])) && (end == len(lower) || !isIDChar(lower[end])) {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+func isIDChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+// linkDelivery fills in each history's delivery chain from the repository's
+// branches and merge commits and from the messages of its correlated
+// commits. Git failures leave chains empty rather than failing the report.
+func linkDelivery(ctx context.Context, repoPath string, histories map[string]BeadHistory, opts CorrelatorOptions) {
+	branches := listBranches(ctx, repoPath)
+	merges := listMerges(ctx, repoPath, opts)
+	prURL := pullRequestURLBase(ctx, repoPath)
+
+	for id, h := range histories {
+		chain := &DeliveryChain{}
+		branchSet := make(map[string]bool)
+		for _, b := range branches {
+			if mentionsBeadID(b.Name, id) {
+				chain.Branches = append(chain.Branches, b)
+				branchSet[shortBranch(b.Name)] = true
+			}
+		}
+
+		prs := make(map[int]PullRequestRef)
+		addPR := func(pr PullRequestRef) {
+			if old, ok := prs[pr.Number]; ok {
+				// Keep what the earlier source knew
+				if pr.URL == "" {
+					pr.URL = old.URL
+				}
+				if pr.Branch == "" {
+					pr.Branch = old.Branch
+				}
+				if pr.MergeSHA == "" {
+					pr.MergeSHA, pr.MergedAt, pr.Source = old.MergeSHA, old.MergedAt, old.Source
+				}
+			}
+			if pr.URL == "" && prURL != "" {
+				pr.URL = fmt.Sprintf("%s/%d", prURL, pr.Number)
+			}
+			prs[pr.Number] = pr
+		}
+
+		for _, mc := range merges {
+			linked := branchSet[shortBranch(mc.branch)] || mentionsBeadID(mc.branch, id) || mentionsBeadID(mc.body, id)
+			if !linked {
+				continue
+			}
+			if mc.prNumber > 0 {
+				addPR(PullRequestRef{Number: mc.prNumber, Branch: mc.branch, MergeSHA: mc.sha, MergedAt: mc.at, Source: "merge-commit"})
+			} else {
+				chain.Merges = append(chain.Merges, MergeRef{SHA: mc.sha, Branch: mc.branch, Into: mc.into, At: mc.at})
+			}
+		}
+
+		for _, c := range h.Commits {
+			subject, _, _ := strings.Cut(c.Message, "\n")
+			if m := squashPattern.FindStringSubmatch(subject); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				addPR(PullRequestRef{Number: n, MergeSHA: c.SHA, MergedAt: c.Timestamp, Source: "squash"})
+			}
+			for _, m := range prTrailerPattern.FindAllStringSubmatch(c.Message, -1) {
+				if pr, ok := parsePRReference(m[1]); ok {
+					addPR(pr)
+				}
+			}
+		}
+
+		for _, pr := range prs {
+			chain.PullRequests = append(chain.PullRequests, pr)
+		}
+		sort.Slice(chain.PullRequests, func(i, j int) bool { return chain.PullRequests[i].Number < chain.PullRequests[j].Number })
+		sort.Slice(chain.Merges, func(i, j int) bool { return chain.Merges[i].At.Before(chain.Merges[j].At) })
+
+		if chain.IsEmpty() {
+			h.Delivery = nil
+		} else {
+			h.Delivery = chain
+		}
+		histories[id] = h
+	}
+}
+
+// parsePRReference reads "#123" or a pull request URL from a trailer
+func parsePRReference(ref string) (PullRequestRef, bool) {
+	if n, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil && n > 0 {
+		return PullRequestRef{Number: n, Source: "trailer"}, true
+	}
+	if m := prURLPattern.FindStringSubmatch(ref); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return PullRequestRef{Number: n, URL: ref, Source: "trailer"}, true
+	}
+	return PullRequestRef{}, false
+}
+
+// shortBranch drops the remote from a branch name: origin/x → x
+func shortBranch(name string) string {
+	if _, rest, ok := strings.Cut(name, "/"); ok && strings.HasPrefix(name, "origin/") {
+		return rest
+	}
+	return name
+}
+
+// listBranches returns the local and remote-tracking branches
+func listBranches(ctx context.Context, repoPath string) []BranchRef {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--format=%(refname)", "refs/heads", "refs/remotes")
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	var branches []BranchRef
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		ref := scanner.Text()
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			branches = append(branches, BranchRef{Name: strings.TrimPrefix(ref, "refs/heads/")})
+		case strings.HasPrefix(ref, "refs/remotes/") && !strings.HasSuffix(ref, "/HEAD"):
+			branches = append(branches, BranchRef{Name: strings.TrimPrefix(ref, "refs/remotes/"), Remote: true})
+		}
+	}
+	return branches
+}
+
+// mergeCommit is a merge commit with what its message says was merged
+type mergeCommit struct {
+	sha      string
+	at       time.Time
+	branch   string
+	into     string
+	prNumber int
+	body     string
+}
+
+// listMerges returns the merge commits that name a pull request or branch
+func listMerges(ctx context.Context, repoPath string, opts CorrelatorOptions) []mergeCommit {
+	args := []string{"log", "--merges", "--format=%H%x00%aI%x00%B%x1e"}
+	if opts.Since != nil {
+		args = append(args, "--since="+opts.Since.Format(time.RFC3339))
+	}
+	if opts.Until != nil {
+		args = append(args, "--until="+opts.Until.Format(time.RFC3339))
+	}
+	if opts.Limit > 0 {
+		args = append(args, fmt.Sprintf("-n%d", opts.Limit))
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var merges []mergeCommit
+	for _, record := range strings.Split(string(out), "\x1e") {
+		parts := strings.SplitN(strings.TrimLeft(record, "\n"), "\x00", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			continue
+		}
+		subject, body, _ := strings.Cut(strings.TrimSpace(parts[2]), "\n")
+		mc := mergeCommit{sha: parts[0], at: at, body: body}
+		if m := prMergePattern.FindStringSubmatch(subject); m != nil {
+			mc.prNumber, _ = strconv.Atoi(m[1])
+			// owner/branch: the head branch is what follows the owner
+			if _, branch, ok := strings.Cut(m[2], "/"); ok {
+				mc.branch = branch
+			} else {
+				mc.branch = m[2]
+			}
+		} else if m := branchMergePattern.FindStringSubmatch(subject); m != nil {
+			mc.branch, mc.into = m[1], m[2]
+		} else {
+			continue
+		}
+		merges = append(merges, mc)
+	}
+	return merges
+}
+
+// pullRequestURLBase returns https://github.com/owner/repo/pull when origin
+// is on GitHub, so bare PR numbers can link somewhere
+func pullRequestURLBase(ctx context.Context, repoPath string) string {
+	cmd := exec.CommandContext(ctx, "git", "remote", "get-url", "origin")
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	m := githubRemote.FindStringSubmatch(strings.TrimSpace(string(out)))
+	if m == nil {
+		return ""
+	}
+	return fmt.Sprintf("https://github.com/%s/%s/pull", m[1], m[2])
+}
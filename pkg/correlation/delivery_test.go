@@ -0,0 +1,105 @@
+package correlation
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestMentionsBeadID(t *testing.T) {
+	tests := []struct {
+		text, id string
+		want     bool
+	}{
+		{"bv-1-login", "bv-1", true},
+		{"feature/BV-1", "bv-1", true},
+		{"bv-12-login", "bv-1", false},
+		{"abv-1", "bv-1", false},
+		{"fix bv-12 then bv-1.", "bv-1", true},
+		{"anything", "", false},
+	}
+	for _, tt := range tests {
+		if got := mentionsBeadID(tt.text, tt.id); got != tt.want {
+			t.Errorf("mentionsBeadID(%q, %q) = %v, want %v", tt.text, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestLinkDelivery(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commitFile := func(name, msg string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(msg), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", ".")
+		git("commit", "-q", "-m", msg)
+	}
+	git("init", "-q", "-b", "main")
+	git("config", "user.email", "test@test.com")
+	git("config", "user.name", "Test User")
+	git("remote", "add", "origin", "git@github.com:acme/app.git")
+	commitFile("README", "Initial commit")
+
+	git("checkout", "-q", "-b", "bv-1-login")
+	commitFile("login.go", "Add login")
+	git("checkout", "-q", "main")
+	git("merge", "-q", "--no-ff", "-m", "Merge pull request #7 from acme/bv-1-login", "bv-1-login")
+
+	git("checkout", "-q", "-b", "feature/bv-12")
+	commitFile("export.go", "Add export")
+	git("checkout", "-q", "main")
+	git("merge", "-q", "--no-ff", "-m", "Merge branch 'feature/bv-12'", "feature/bv-12")
+
+	histories := map[string]BeadHistory{
+		"bv-1": {BeadID: "bv-1", Commits: []CorrelatedCommit{
+			{SHA: "abc", Message: "Tidy login (#9)"},
+			{SHA: "def", Message: "Follow-up\n\nPR: #7"},
+		}},
+		"bv-12": {BeadID: "bv-12"},
+		"bv-3":  {BeadID: "bv-3"},
+	}
+	linkDelivery(context.Background(), dir, histories, CorrelatorOptions{})
+
+	d := histories["bv-1"].Delivery
+	if d == nil {
+		t.Fatal("Expected bv-1 to have a delivery chain")
+	}
+	if len(d.Branches) != 1 || d.Branches[0].Name != "bv-1-login" || d.Branches[0].Remote {
+		t.Errorf("Expected the local bv-1-login branch, got %+v", d.Branches)
+	}
+	if len(d.PullRequests) != 2 {
+		t.Fatalf("Expected PRs #7 and #9, got %+v", d.PullRequests)
+	}
+	pr := d.PullRequests[0]
+	if pr.Number != 7 || pr.Source != "merge-commit" || pr.Branch != "bv-1-login" || pr.MergeSHA == "" {
+		t.Errorf("Expected #7 from its merge commit, trailer folded in, got %+v", pr)
+	}
+	if pr.URL != "https://github.com/acme/app/pull/7" {
+		t.Errorf("Expected a GitHub URL from origin, got %q", pr.URL)
+	}
+	if d.PullRequests[1].Number != 9 || d.PullRequests[1].Source != "squash" || d.PullRequests[1].MergeSHA != "abc" {
+		t.Errorf("Expected #9 from the squash suffix, got %+v", d.PullRequests[1])
+	}
+	if len(d.Merges) != 0 {
+		t.Errorf("Expected bv-1 not to pick up bv-12's merge, got %+v", d.Merges)
+	}
+
+	d = histories["bv-12"].Delivery
+	if d == nil || len(d.Merges) != 1 || d.Merges[0].Branch != "feature/bv-12" || len(d.PullRequests) != 0 {
+		t.Fatalf("Expected bv-12 merged from feature/bv-12 without a PR, got %+v", d)
+	}
+	if histories["bv-3"].Delivery != nil {
+		t.Errorf("Expected no chain for bv-3, got %+v", histories["bv-3"].Delivery)
+	}
+}
@@ -0,0 +1,24 @@
+// Package correlation links beads to the git commits that created, changed,
+// and closed them, for bead history and code attribution. It reads git
+// through the git binary and depends only on the standard library, so it
+// can be embedded without the rest of bv.
+//
+// # Entry points
+//
+//   - NewCorrelator(repoPath).GenerateReport: a HistoryReport with each
+//     bead's lifecycle events and correlated commits, as behind
+//     bv --robot-history. CorrelatorOptions narrows it to one bead or a
+//     time range.
+//   - NewCachedCorrelator and NewIncrementalCorrelator: the same report,
+//     reused or updated from the last run instead of rescanning history.
+//   - NewReverseLookup: which beads a commit touched.
+//   - ValidateRepository: whether a path can be correlated at all.
+//
+// # Stability
+//
+// The identifiers above, HistoryReport and the types it contains, and
+// their JSON field names are the public API. From v0.11.0 on they only
+// change incompatibly with a major version bump. The extractors, matchers,
+// and scorers the correlator is built from are exported for tuning but may
+// change in minor releases.
+package correlation
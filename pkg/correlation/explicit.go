@@ -349,6 +349,11 @@ func (m *ExplicitMatcher) CreateCorrelatedCommit(match ExplicitMatch, coCommitte
 		Method:      MethodExplicitID,
 		Confidence:  match.Confidence,
 		Reason:      reason,
+		Breakdown: []ConfidenceFactor{{
+			Signal: SignalExplicitID,
+			Delta:  match.Confidence,
+			Detail: fmt.Sprintf("message references %s (%s)", match.BeadID, match.MatchType),
+		}},
 	}
 }
 
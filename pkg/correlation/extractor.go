@@ -4,6 +4,7 @@ package correlation
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -79,53 +80,73 @@ type beadSnapshot struct {
 
 // Extract extracts bead lifecycle events from git history
 func (e *Extractor) Extract(opts ExtractOptions) ([]BeadEvent, error) {
+	return e.ExtractContext(context.Background(), opts)
+}
+
+// ExtractContext is Extract that kills git log and returns ctx's error when
+// ctx is done
+func (e *Extractor) ExtractContext(ctx context.Context, opts ExtractOptions) ([]BeadEvent, error) {
+	var events []BeadEvent
+	err := e.runGitLog(ctx, opts, func(info commitInfo, diff []byte) {
+		events = append(events, e.parseDiff(diff, info, opts.BeadID)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort chronologically (git log returns newest first)
+	reverseEvents(events)
+
+	return events, nil
+}
+
+// runGitLog runs git log with patches over the beads file and hands each
+// commit's metadata and diff to handle, newest first
+func (e *Extractor) runGitLog(ctx context.Context, opts ExtractOptions, handle func(info commitInfo, diff []byte)) error {
 	// Build git log command
 	logArgs := e.buildGitLogArgs(opts)
 
 	// Inject config to disable colors (essential for parsing)
 	args := append([]string{"-c", "color.ui=false"}, logArgs...)
 
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Dir = e.repoPath
 
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return nil, fmt.Errorf("creating stdout pipe: %w", err)
+		return fmt.Errorf("creating stdout pipe: %w", err)
 	}
 
 	if err := cmd.Start(); err != nil {
-		return nil, fmt.Errorf("starting git log: %w", err)
+		return fmt.Errorf("starting git log: %w", err)
 	}
 
 	// Parse output stream
-	events, parseErr := e.parseGitLogOutput(stdout, opts.BeadID)
+	parseErr := scanGitLog(stdout, handle)
 
 	// If parsing failed, ensure we drain the pipe or kill the process to avoid deadlock
 	// where git log is blocked writing to full pipe while we wait for it to exit.
+	if ctx.Err() != nil {
+		_ = cmd.Wait()
+		return ctx.Err()
+	}
 	if parseErr != nil {
 		// Try to kill the process to unblock the write
 		_ = cmd.Process.Kill()
 		// We still need to wait to clean up zombies, but now it should exit quickly
 		_ = cmd.Wait()
-		return nil, fmt.Errorf("parsing git log output: %w", parseErr)
+		return fmt.Errorf("parsing git log output: %w", parseErr)
 	}
 
 	if err := cmd.Wait(); err != nil {
 		// If git log failed (non-zero exit), prefer that error unless we have a parsing error
 		if exitErr, ok := err.(*exec.ExitError); ok {
-			return nil, fmt.Errorf("git log failed: %s", string(exitErr.Stderr))
+			return fmt.Errorf("git log failed: %s", string(exitErr.Stderr))
 		}
-		return nil, fmt.Errorf("git log failed: %w", err)
-	}
-
-	if parseErr != nil {
-		return nil, fmt.Errorf("parsing git log output: %w", parseErr)
+		return fmt.Errorf("git log failed: %w", err)
 	}
 
-	// Sort chronologically (git log returns newest first)
-	reverseEvents(events)
-
-	return events, nil
+	return nil
 }
 
 // buildGitLogArgs constructs the git log command arguments
@@ -187,7 +208,18 @@ func insertBefore(slice []string, marker, value string) []string {
 // parseGitLogOutput parses the combined commit info and diff output from a stream
 func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadEvent, error) {
 	var events []BeadEvent
+	err := scanGitLog(r, func(info commitInfo, diff []byte) {
+		events = append(events, e.parseDiff(diff, info, filterBeadID)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
 
+// scanGitLog splits git log output into commits, calling handle with each
+// commit's metadata and non-empty diff in the order they appear
+func scanGitLog(r io.Reader, handle func(info commitInfo, diff []byte)) error {
 	// Use bufio.Reader instead of Scanner to handle long lines
 	const maxScanTokenSize = 10 * 1024 * 1024 // 10MB
 	reader := bufio.NewReaderSize(r, maxScanTokenSize)
@@ -202,8 +234,7 @@ func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadE
 		}
 		diffBytes := diffBuffer.Bytes()
 		if len(diffBytes) > 0 {
-			diffEvents := e.parseDiff(diffBytes, *currentCommit, filterBeadID)
-			events = append(events, diffEvents...)
+			handle(*currentCommit, diffBytes)
 		}
 		diffBuffer.Reset()
 	}
@@ -215,7 +246,7 @@ func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadE
 			if err == io.EOF {
 				break
 			}
-			return nil, err
+			return err
 		}
 
 		if isPrefix {
@@ -224,7 +255,7 @@ func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadE
 			for isPrefix {
 				_, isPrefix, err = reader.ReadLine()
 				if err != nil && err != io.EOF {
-					return nil, err
+					return err
 				}
 				if err == io.EOF {
 					break
@@ -264,7 +295,7 @@ func (e *Extractor) parseGitLogOutput(r io.Reader, filterBeadID string) ([]BeadE
 	// Process final commit
 	processCommit()
 
-	return events, nil
+	return nil
 }
 
 // commitPattern matches the start of a commit in our custom log format
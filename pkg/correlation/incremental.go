@@ -3,6 +3,7 @@ package correlation
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -173,6 +174,9 @@ func (ic *IncrementalCorrelator) tryIncrementalUpdate(existing *HistoryReport, b
 
 	// Extract co-commits from new events
 	coCommitter := NewCoCommitExtractor(ic.cache.repoPath)
+	if opts.Weights != nil {
+		coCommitter.SetWeights(*opts.Weights)
+	}
 	newCorrelatedCommits, err := coCommitter.ExtractAllCoCommits(newEvents)
 	if err != nil {
 		return nil, fmt.Errorf("extracting co-commits: %w", err)
@@ -181,6 +185,9 @@ func (ic *IncrementalCorrelator) tryIncrementalUpdate(existing *HistoryReport, b
 	// Merge new data with existing report
 	merged := mergeReports(existing, beads, newEvents, newCorrelatedCommits)
 
+	// New commits can bring new branches, merges and squashed PRs
+	linkDelivery(context.Background(), ic.cache.repoPath, merged.Histories, opts)
+
 	return &IncrementalUpdateResult{
 		Report:            merged,
 		WasIncremental:    true,
@@ -296,6 +303,7 @@ func mergeReports(existing *HistoryReport, beads []BeadInfo, newEvents []BeadEve
 			Commits:    commitsCopy,
 			CycleTime:  h.CycleTime,
 			LastAuthor: h.LastAuthor,
+			Delivery:   h.Delivery,
 		}
 	}
 
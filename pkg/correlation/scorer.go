@@ -3,6 +3,7 @@ package correlation
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 )
@@ -37,6 +38,99 @@ var MethodRanges = map[CorrelationMethod]ConfidenceRange{
 	},
 }
 
+// Signals a confidence factor can come from
+const (
+	SignalCoCommit   = "co_commit"   // Changed in the same commit as the bead
+	SignalExplicitID = "explicit_id" // Commit message names the bead
+	SignalFilePaths  = "file_paths"  // What the commit's files suggest
+	SignalTemporal   = "temporal"    // Timing and who else the author was working on
+	SignalCap        = "cap"         // The method's confidence bounds
+)
+
+// ConfidenceFactor is one heuristic's contribution to a commit's confidence.
+// A commit's factors add up to its confidence.
+type ConfidenceFactor struct {
+	Signal string  `json:"signal"`
+	Delta  float64 `json:"delta"`
+	Detail string  `json:"detail"`
+}
+
+// HeuristicWeights tunes how much each correlation heuristic adds to a
+// commit's confidence. Penalties are given as positive amounts.
+type HeuristicWeights struct {
+	// Co-committed
+	CoCommitBase  float64 // Changed in the same commit as the bead's status
+	IDInMessage   float64 // Commit message names the bead
+	LargeCommit   float64 // Penalty: more than 20 files
+	TestFilesOnly float64 // Penalty: only test files
+
+	// Temporal
+	TemporalBase  float64 // By the bead's claimer while it was in progress
+	FocusedAuthor float64 // Author had only this bead active; half for two
+	BusyAuthor    float64 // Penalty: author had more than three beads active
+	ShortWindow   float64 // Bead in progress under 4 hours; half under a day
+	LongWindow    float64 // Penalty: in progress over a week; a third over 3 days
+	PathMatch     float64 // Changed files match keywords in the bead's title
+}
+
+// DefaultHeuristicWeights returns the weights used when none are configured
+func DefaultHeuristicWeights() HeuristicWeights {
+	return HeuristicWeights{
+		CoCommitBase:  0.95,
+		IDInMessage:   0.04,
+		LargeCommit:   0.10,
+		TestFilesOnly: 0.05,
+		TemporalBase:  0.50,
+		FocusedAuthor: 0.20,
+		BusyAuthor:    0.10,
+		ShortWindow:   0.10,
+		LongWindow:    0.15,
+		PathMatch:     0.15,
+	}
+}
+
+// methodSignal is the signal a correlation method mainly rests on
+func methodSignal(method CorrelationMethod) string {
+	switch method {
+	case MethodExplicitID:
+		return SignalExplicitID
+	case MethodTemporalAuthor:
+		return SignalTemporal
+	default:
+		return SignalCoCommit
+	}
+}
+
+// confidenceScore adds up confidence factors
+type confidenceScore struct {
+	factors []ConfidenceFactor
+}
+
+// add records a factor, skipping ones that change nothing
+func (s *confidenceScore) add(signal string, delta float64, detail string) {
+	if delta != 0 {
+		s.factors = append(s.factors, ConfidenceFactor{Signal: signal, Delta: delta, Detail: detail})
+	}
+}
+
+// clamp totals the factors, bounded to [lo, hi], recording any capping as a
+// factor of its own so the breakdown still adds up
+func (s *confidenceScore) clamp(lo, hi float64) float64 {
+	total := 0.0
+	for _, f := range s.factors {
+		total += f.Delta
+	}
+	bounded := clamp(total, lo, hi)
+	if diff := bounded - total; math.Abs(diff) > 1e-9 {
+		detail := fmt.Sprintf("capped at %.0f%%", hi*100)
+		if diff > 0 {
+			detail = fmt.Sprintf("raised to the %.0f%% floor", lo*100)
+		}
+		s.add(SignalCap, diff, detail)
+	}
+	return bounded
+}
+
 // Scorer provides methods for calculating and combining confidence scores.
 type Scorer struct{}
 
@@ -246,6 +340,22 @@ func (s *Scorer) MergeCommits(sources ...[]CorrelatedCommit) []CorrelatedCommit
 		}
 		result.Method = commits[highestIdx].Method
 
+		// Explain from the strongest method, crediting the rest as corroboration
+		result.Breakdown = append([]ConfidenceFactor(nil), commits[highestIdx].Breakdown...)
+		if extra := result.Confidence - signals[highestIdx].Confidence; extra > 0 {
+			var others []string
+			for i, c := range commits {
+				if i != highestIdx {
+					others = append(others, c.Method.String())
+				}
+			}
+			result.Breakdown = append(result.Breakdown, ConfidenceFactor{
+				Signal: methodSignal(commits[highestIdx].Method),
+				Delta:  extra,
+				Detail: "also found by " + strings.Join(others, ", "),
+			})
+		}
+
 		// Merge file changes (dedupe by path)
 		seenFiles := make(map[string]bool)
 		var allFiles []FileChange
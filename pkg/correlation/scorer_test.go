@@ -463,3 +463,82 @@ func TestMergeCommits_FilesDeduped(t *testing.T) {
 		t.Errorf("MergeCommits() files = %d, want 3", len(got[0].Files))
 	}
 }
+
+func sumFactors(factors []ConfidenceFactor) float64 {
+	total := 0.0
+	for _, f := range factors {
+		total += f.Delta
+	}
+	return total
+}
+
+func TestConfidenceBreakdown_AddsUp(t *testing.T) {
+	c := NewCoCommitExtractor("/test/repo")
+	files := make([]FileChange, 25)
+	for i := range files {
+		files[i] = FileChange{Path: "pkg/file_test.go"}
+	}
+	commit := c.CreateCorrelatedCommit(BeadEvent{BeadID: "bv-1", EventType: EventClosed, CommitMsg: "close bv-1"}, files)
+	if len(commit.Breakdown) != 4 {
+		t.Fatalf("Expected base, ID, large commit and test-only factors, got %+v", commit.Breakdown)
+	}
+	if got := sumFactors(commit.Breakdown); got < commit.Confidence-1e-9 || got > commit.Confidence+1e-9 {
+		t.Errorf("Breakdown sums to %v, confidence is %v", got, commit.Confidence)
+	}
+	if commit.Breakdown[1].Signal != SignalExplicitID || commit.Breakdown[2].Signal != SignalFilePaths {
+		t.Errorf("Unexpected signals: %+v", commit.Breakdown)
+	}
+
+	// Temporal scores above 85% are capped, and the cap is a factor too
+	tc := NewTemporalCorrelator("/test/repo")
+	tc.activeByAuth = map[string]int{"dev@test.com": 1}
+	now := time.Now()
+	window := TemporalWindow{AuthorEmail: "dev@test.com", Start: now.Add(-time.Hour), End: now}
+	conf, factors := tc.scoreTemporalConfidence(window, []FileChange{{Path: "pkg/auth/login.go"}}, []string{"auth"})
+	if conf != 0.85 {
+		t.Errorf("Expected the temporal cap of 0.85, got %v", conf)
+	}
+	last := factors[len(factors)-1]
+	if last.Signal != SignalCap || last.Delta >= 0 {
+		t.Errorf("Expected a negative cap factor last, got %+v", last)
+	}
+	if got := sumFactors(factors); got < conf-1e-9 || got > conf+1e-9 {
+		t.Errorf("Breakdown sums to %v, confidence is %v", got, conf)
+	}
+}
+
+func TestHeuristicWeights_Custom(t *testing.T) {
+	c := NewCoCommitExtractor("/test/repo")
+	w := DefaultHeuristicWeights()
+	w.CoCommitBase = 0.80
+	w.IDInMessage = 0
+	c.SetWeights(w)
+
+	commit := c.CreateCorrelatedCommit(BeadEvent{BeadID: "bv-1", EventType: EventClosed, CommitMsg: "close bv-1"}, []FileChange{{Path: "main.go"}})
+	if commit.Confidence != 0.80 {
+		t.Errorf("Expected the tuned base of 0.80, got %v", commit.Confidence)
+	}
+	if len(commit.Breakdown) != 1 {
+		t.Errorf("Expected a zero weight to leave no factor, got %+v", commit.Breakdown)
+	}
+}
+
+func TestMergeCommits_Breakdown(t *testing.T) {
+	s := NewScorer()
+	co := CorrelatedCommit{SHA: "abc", BeadID: "bv-1", Method: MethodCoCommitted, Confidence: 0.95,
+		Breakdown: []ConfidenceFactor{{Signal: SignalCoCommit, Delta: 0.95}}}
+	ex := CorrelatedCommit{SHA: "abc", BeadID: "bv-1", Method: MethodExplicitID, Confidence: 0.90,
+		Breakdown: []ConfidenceFactor{{Signal: SignalExplicitID, Delta: 0.90}}}
+
+	merged := s.MergeCommits([]CorrelatedCommit{co}, []CorrelatedCommit{ex})
+	if len(merged) != 1 {
+		t.Fatalf("Expected one merged commit, got %d", len(merged))
+	}
+	b := merged[0].Breakdown
+	if len(b) != 2 || b[0].Signal != SignalCoCommit || !strings.Contains(b[1].Detail, "explicit_id") {
+		t.Errorf("Expected the co-commit factor plus corroboration, got %+v", b)
+	}
+	if got := sumFactors(b); got < merged[0].Confidence-1e-9 || got > merged[0].Confidence+1e-9 {
+		t.Errorf("Breakdown sums to %v, confidence is %v", got, merged[0].Confidence)
+	}
+}
@@ -17,6 +17,7 @@ type TemporalCorrelator struct {
 	coCommitter  *CoCommitExtractor // For getting file changes
 	seenCommits  map[string]bool    // Track commits already correlated by higher-confidence methods
 	activeByAuth map[string]int     // Count of active beads per author (for confidence scoring)
+	weights      HeuristicWeights
 }
 
 // NewTemporalCorrelator creates a new temporal correlator
@@ -26,9 +27,15 @@ func NewTemporalCorrelator(repoPath string) *TemporalCorrelator {
 		coCommitter:  NewCoCommitExtractor(repoPath),
 		seenCommits:  make(map[string]bool),
 		activeByAuth: make(map[string]int),
+		weights:      DefaultHeuristicWeights(),
 	}
 }
 
+// SetWeights replaces the heuristic weights used for confidence scoring
+func (t *TemporalCorrelator) SetWeights(weights HeuristicWeights) {
+	t.weights = weights
+}
+
 // SetSeenCommits marks commits that were already correlated via higher-confidence methods
 func (t *TemporalCorrelator) SetSeenCommits(commits []CorrelatedCommit) {
 	for _, c := range commits {
@@ -118,7 +125,7 @@ func (t *TemporalCorrelator) FindCommitsInWindow(window TemporalWindow) ([]Corre
 		}
 
 		// Calculate dynamic confidence
-		confidence := t.calculateTemporalConfidence(window, files, pathHints)
+		confidence, breakdown := t.scoreTemporalConfidence(window, files, pathHints)
 		reason := t.generateTemporalReason(window, files, pathHints)
 
 		commits = append(commits, CorrelatedCommit{
@@ -132,6 +139,7 @@ func (t *TemporalCorrelator) FindCommitsInWindow(window TemporalWindow) ([]Corre
 			Method:      MethodTemporalAuthor,
 			Confidence:  confidence,
 			Reason:      reason,
+			Breakdown:   breakdown,
 		})
 	}
 
@@ -160,37 +168,47 @@ func (t *TemporalCorrelator) touchesBeadsFile(sha string) bool {
 
 // calculateTemporalConfidence computes dynamic confidence for temporal correlation
 func (t *TemporalCorrelator) calculateTemporalConfidence(window TemporalWindow, files []FileChange, pathHints []string) float64 {
-	base := 0.50
+	confidence, _ := t.scoreTemporalConfidence(window, files, pathHints)
+	return confidence
+}
+
+// scoreTemporalConfidence computes the confidence of a temporal correlation
+// along with the factors it's made of
+func (t *TemporalCorrelator) scoreTemporalConfidence(window TemporalWindow, files []FileChange, pathHints []string) (float64, []ConfidenceFactor) {
+	var score confidenceScore
+	w := t.weights
+	score.add(SignalTemporal, w.TemporalBase, "by the bead's claimer while it was in progress")
 
 	// Factor 1: How many beads was this author working on?
 	activeBeads := t.activeByAuth[window.AuthorEmail]
 	if activeBeads <= 1 {
-		base += 0.20 // Only one bead = higher confidence
+		score.add(SignalTemporal, w.FocusedAuthor, "author had only this bead active") // Only one bead = higher confidence
 	} else if activeBeads == 2 {
-		base += 0.10
+		score.add(SignalTemporal, w.FocusedAuthor/2, "author had 2 beads active")
 	} else if activeBeads > 3 {
-		base -= 0.10 // Many beads = lower confidence
+		score.add(SignalTemporal, -w.BusyAuthor, fmt.Sprintf("author had %d beads active", activeBeads)) // Many beads = lower confidence
 	}
 
 	// Factor 2: How long is the time window?
 	windowDuration := window.End.Sub(window.Start)
 	if windowDuration < 4*time.Hour {
-		base += 0.10 // Short window = more focused
+		score.add(SignalTemporal, w.ShortWindow, "short window (<4h)") // Short window = more focused
 	} else if windowDuration < 24*time.Hour {
-		base += 0.05
+		score.add(SignalTemporal, w.ShortWindow/2, "window under a day")
 	} else if windowDuration > 7*24*time.Hour {
-		base -= 0.15 // Week+ window = lots of potential commits
+		score.add(SignalTemporal, -w.LongWindow, fmt.Sprintf("long window (%dd)", int(windowDuration.Hours()/24))) // Week+ window = lots of potential commits
 	} else if windowDuration > 3*24*time.Hour {
-		base -= 0.05
+		score.add(SignalTemporal, -w.LongWindow/3, fmt.Sprintf("window of %dd", int(windowDuration.Hours()/24)))
 	}
 
 	// Factor 3: Do commit files match path hints from bead title?
 	if len(pathHints) > 0 && pathsMatchHints(files, pathHints) {
-		base += 0.15 // File paths match keywords in title
+		score.add(SignalFilePaths, w.PathMatch, "file paths match bead title keywords")
 	}
 
 	// Clamp to [0.20, 0.85] - temporal correlation should never be too confident
-	return clamp(base, 0.20, 0.85)
+	confidence := score.clamp(0.20, 0.85)
+	return confidence, score.factors
 }
 
 // generateTemporalReason creates a human-readable explanation for the correlation
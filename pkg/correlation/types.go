@@ -83,17 +83,18 @@ type FileChange struct {
 
 // CorrelatedCommit represents a code commit linked to a bead with confidence metadata
 type CorrelatedCommit struct {
-	BeadID      string            `json:"-"` // Internal use for linking
-	SHA         string            `json:"sha"`
-	ShortSHA    string            `json:"short_sha"`
-	Message     string            `json:"message"`
-	Author      string            `json:"author"`
-	AuthorEmail string            `json:"author_email"`
-	Timestamp   time.Time         `json:"timestamp"`
-	Files       []FileChange      `json:"files"`
-	Method      CorrelationMethod `json:"method"`
-	Confidence  float64           `json:"confidence"` // 0.0 to 1.0
-	Reason      string            `json:"reason"`     // Human-readable explanation
+	BeadID      string             `json:"-"` // Internal use for linking
+	SHA         string             `json:"sha"`
+	ShortSHA    string             `json:"short_sha"`
+	Message     string             `json:"message"`
+	Author      string             `json:"author"`
+	AuthorEmail string             `json:"author_email"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Files       []FileChange       `json:"files"`
+	Method      CorrelationMethod  `json:"method"`
+	Confidence  float64            `json:"confidence"`          // 0.0 to 1.0
+	Reason      string             `json:"reason"`              // Human-readable explanation
+	Breakdown   []ConfidenceFactor `json:"breakdown,omitempty"` // What Confidence is made of
 }
 
 // BeadMilestones contains key lifecycle timestamps for quick access
@@ -121,6 +122,7 @@ type BeadHistory struct {
 	Commits    []CorrelatedCommit `json:"commits"`     // Related code commits
 	CycleTime  *CycleTime         `json:"cycle_time"`  // nil if not yet closed
 	LastAuthor string             `json:"last_author"` // Most recent committer
+	Delivery   *DeliveryChain     `json:"delivery,omitempty"`
 }
 
 // CommitIndex provides O(1) lookup from commit SHA to bead IDs
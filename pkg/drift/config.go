@@ -5,6 +5,9 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 	"gopkg.in/yaml.v3"
 )
 
@@ -28,6 +31,9 @@ type Config struct {
 	// ActionableDecreaseWarningPct triggers warning when actionable decreases by this pct
 	ActionableDecreaseWarningPct float64 `yaml:"actionable_decrease_warning_pct" json:"actionable_decrease_warning_pct"`
 
+	// ActionableCollapseCriticalPct triggers critical when actionable decreases by this pct (0 = off)
+	ActionableCollapseCriticalPct float64 `yaml:"actionable_collapse_critical_pct" json:"actionable_collapse_critical_pct"`
+
 	// ActionableIncreaseInfoPct triggers info when actionable changes by this pct
 	ActionableIncreaseInfoPct float64 `yaml:"actionable_increase_info_pct" json:"actionable_increase_info_pct"`
 
@@ -45,6 +51,36 @@ type Config struct {
 	BlockingCascadeInfo    int `yaml:"blocking_cascade_info_threshold" json:"blocking_cascade_info_threshold"`
 	BlockingCascadeWarning int `yaml:"blocking_cascade_warning_threshold" json:"blocking_cascade_warning_threshold"`
 
+	// EpicSplitWords flags epics without child issues whose description is longer than this
+	EpicSplitWords int `yaml:"epic_split_words" json:"epic_split_words"`
+
+	// StaleEpicDays warns about an open epic this many days after its last
+	// child issue closed
+	StaleEpicDays int `yaml:"stale_epic_days" json:"stale_epic_days"`
+
+	// PriorityInversionGap warns when an open issue blocks one at least this
+	// many priority levels more urgent (P3 blocking P1 is a gap of 2)
+	PriorityInversionGap int `yaml:"priority_inversion_gap" json:"priority_inversion_gap"`
+
+	// OrphanClusterMinSize flags dependency-connected groups of at least this
+	// many open issues in which none has a label or assignee
+	OrphanClusterMinSize int `yaml:"orphan_cluster_min_size" json:"orphan_cluster_min_size"`
+
+	// OverdueWarningCount warns once this many open issues are past their
+	// due date; OverdueCriticalCount makes it critical
+	OverdueWarningCount  int `yaml:"overdue_warning_count" json:"overdue_warning_count"`
+	OverdueCriticalCount int `yaml:"overdue_critical_count" json:"overdue_critical_count"`
+
+	// SpellCheck enables the spelling and vague-wording pass over titles and descriptions
+	SpellCheck bool `yaml:"spell_check" json:"spell_check"`
+
+	// DailyBaselines saves a dated baseline snapshot in .bv/baselines the
+	// first time the TUI finishes analysis each day
+	DailyBaselines bool `yaml:"daily_baselines" json:"daily_baselines"`
+
+	// BaselineKeep is how many dated snapshots to keep (default 30)
+	BaselineKeep int `yaml:"baseline_keep" json:"baseline_keep"`
+
 	// Alert type enable/disable flags (bv-167)
 	// Disabled alert types will not generate alerts
 	DisabledAlerts []string `yaml:"disabled_alerts,omitempty" json:"disabled_alerts,omitempty"`
@@ -80,6 +116,14 @@ func DefaultConfig() *Config {
 		InProgressStaleMultiplier:    0.5, // In-progress thresholds are half as long
 		BlockingCascadeInfo:          3,   // Info alert when unblocks >=3
 		BlockingCascadeWarning:       5,   // Warning when unblocks >=5
+		EpicSplitWords:               300, // Childless epics over 300 words should be split
+		StaleEpicDays:                7,   // Warn a week after an epic's last child closed
+		PriorityInversionGap:         2,   // Warn when e.g. a P3 blocks a P1
+		OrphanClusterMinSize:         3,   // Unowned groups of 3+ connected issues
+		OverdueWarningCount:          1,   // Warn as soon as anything is overdue
+		OverdueCriticalCount:         5,   // Critical once 5+ issues are overdue
+		DailyBaselines:               true,
+		BaselineKeep:                 baseline.DefaultKeep,
 	}
 }
 
@@ -129,7 +173,7 @@ func SaveConfig(projectDir string, config *Config) error {
 
 	// Ensure directory exists
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := readonly.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("creating config directory: %w", err)
 	}
 
@@ -142,7 +186,7 @@ func SaveConfig(projectDir string, config *Config) error {
 	header := "# Drift detection thresholds\n# See: bv --help for drift detection options\n\n"
 	content := header + string(data)
 
-	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+	if err := readonly.WriteFile(path, []byte(content), 0644); err != nil {
 		return fmt.Errorf("writing drift config: %w", err)
 	}
 
@@ -161,6 +205,27 @@ func (c *Config) Validate() error {
 	if c.InProgressStaleMultiplier == 0 {
 		c.InProgressStaleMultiplier = DefaultConfig().InProgressStaleMultiplier
 	}
+	if c.EpicSplitWords == 0 {
+		c.EpicSplitWords = DefaultConfig().EpicSplitWords
+	}
+	if c.BaselineKeep == 0 {
+		c.BaselineKeep = DefaultConfig().BaselineKeep
+	}
+	if c.StaleEpicDays == 0 {
+		c.StaleEpicDays = DefaultConfig().StaleEpicDays
+	}
+	if c.PriorityInversionGap == 0 {
+		c.PriorityInversionGap = DefaultConfig().PriorityInversionGap
+	}
+	if c.OrphanClusterMinSize == 0 {
+		c.OrphanClusterMinSize = DefaultConfig().OrphanClusterMinSize
+	}
+	if c.OverdueWarningCount == 0 {
+		c.OverdueWarningCount = DefaultConfig().OverdueWarningCount
+	}
+	if c.OverdueCriticalCount == 0 {
+		c.OverdueCriticalCount = DefaultConfig().OverdueCriticalCount
+	}
 
 	if c.DensityWarningPct < 0 || c.DensityWarningPct > 1000 {
 		return fmt.Errorf("density_warning_pct must be between 0 and 1000")
@@ -180,6 +245,12 @@ func (c *Config) Validate() error {
 	if c.ActionableDecreaseWarningPct < 0 || c.ActionableDecreaseWarningPct > 100 {
 		return fmt.Errorf("actionable_decrease_warning_pct must be between 0 and 100")
 	}
+	if c.BaselineKeep < 0 {
+		return fmt.Errorf("baseline_keep must be non-negative")
+	}
+	if c.ActionableCollapseCriticalPct < 0 || c.ActionableCollapseCriticalPct > 100 {
+		return fmt.Errorf("actionable_collapse_critical_pct must be between 0 and 100")
+	}
 	if c.ActionableIncreaseInfoPct < 0 || c.ActionableIncreaseInfoPct > 1000 {
 		return fmt.Errorf("actionable_increase_info_pct must be between 0 and 1000")
 	}
@@ -201,6 +272,24 @@ func (c *Config) Validate() error {
 	if c.BlockingCascadeWarning < c.BlockingCascadeInfo {
 		return fmt.Errorf("blocking_cascade_warning_threshold must be >= blocking_cascade_info_threshold")
 	}
+	if c.EpicSplitWords < 0 {
+		return fmt.Errorf("epic_split_words must be non-negative")
+	}
+	if c.StaleEpicDays < 0 {
+		return fmt.Errorf("stale_epic_days must be non-negative")
+	}
+	if c.PriorityInversionGap < 1 || c.PriorityInversionGap > 4 {
+		return fmt.Errorf("priority_inversion_gap must be between 1 and 4")
+	}
+	if c.OrphanClusterMinSize < 2 {
+		return fmt.Errorf("orphan_cluster_min_size must be at least 2")
+	}
+	if c.OverdueWarningCount < 1 {
+		return fmt.Errorf("overdue_warning_count must be at least 1")
+	}
+	if c.OverdueCriticalCount < c.OverdueWarningCount {
+		return fmt.Errorf("overdue_critical_count must be >= overdue_warning_count")
+	}
 	// Validate label overrides (bv-167)
 	for label, lc := range c.LabelOverrides {
 		if lc == nil {
@@ -219,6 +308,11 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// QualityOptions returns the text quality settings for analysis.ComputeQualityReportWithOptions
+func (c *Config) QualityOptions() analysis.QualityOptions {
+	return analysis.QualityOptions{EpicSplitWords: c.EpicSplitWords, SpellCheck: c.SpellCheck}
+}
+
 // IsAlertDisabled returns true if the given alert type is in the disabled list (bv-167)
 func (c *Config) IsAlertDisabled(alertType string) bool {
 	for _, disabled := range c.DisabledAlerts {
@@ -301,6 +395,7 @@ edge_growth_info_pct: 25   # Info if edges change by 25%+
 # Issue status thresholds
 blocked_increase_threshold: 5    # Warn if 5+ more issues are blocked
 actionable_decrease_warning_pct: 30  # Warn if actionable drops 30%+
+# actionable_collapse_critical_pct: 75 # Critical if actionable drops 75%+ (off by default)
 actionable_increase_info_pct: 20     # Info if actionable changes 20%+
 
 # Metric change thresholds
@@ -315,12 +410,38 @@ in_progress_stale_multiplier: 0.5  # In-progress items age twice as fast
 blocking_cascade_info_threshold: 3   # Info alert if completing an issue unblocks 3+ items
 blocking_cascade_warning_threshold: 5 # Warning if unblocks 5+ items
 
+# Text quality (info alerts on open issues)
+epic_split_words: 300            # Flag childless epics whose description exceeds 300 words
+spell_check: false               # Also flag common misspellings and vague wording ("etc", "as needed")
+
+# Backlog hygiene
+stale_epic_days: 7               # Warn if an epic stays open 7+ days after its last child closed
+priority_inversion_gap: 2        # Warn if an issue blocks one 2+ priority levels more urgent (P3 blocking P1)
+orphan_cluster_min_size: 3       # Info if 3+ connected open issues have no labels or assignee
+
+# Due dates (open issues past due_date)
+overdue_warning_count: 1         # Warn once 1+ issues are overdue
+overdue_critical_count: 5        # Critical once 5+ issues are overdue
+
+# Baseline snapshots (.bv/baselines/YYYYMMDD.json)
+daily_baselines: true            # Snapshot metrics the first time the TUI runs each day
+baseline_keep: 30                # Dated snapshots to keep; older ones are deleted
+
 # Disable specific alert types (bv-167)
 # Uncomment to disable:
 # disabled_alerts:
 #   - stale_issue
 #   - new_cycle
 #   - blocking_cascade
+#   - empty_description
+#   - unresolved_marker
+#   - unsplit_epic
+#   - spelling
+#   - vague_wording
+#   - stale_epic
+#   - priority_inversion
+#   - orphan_cluster
+#   - overdue
 
 # Per-label staleness overrides (bv-167)
 # Use tighter thresholds for urgent/priority labels
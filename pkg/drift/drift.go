@@ -4,6 +4,7 @@ package drift
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -38,6 +39,15 @@ const (
 	AlertHighImpactUnblock  AlertType = "high_impact_unblock"
 	AlertAbandonedClaim     AlertType = "abandoned_claim"
 	AlertPotentialDuplicate AlertType = "potential_duplicate"
+	AlertEmptyDescription   AlertType = "empty_description"
+	AlertUnresolvedMarker   AlertType = "unresolved_marker"
+	AlertUnsplitEpic        AlertType = "unsplit_epic"
+	AlertSpelling           AlertType = "spelling"
+	AlertVagueWording       AlertType = "vague_wording"
+	AlertStaleEpic          AlertType = "stale_epic"
+	AlertPriorityInversion  AlertType = "priority_inversion"
+	AlertOrphanCluster      AlertType = "orphan_cluster"
+	AlertOverdue            AlertType = "overdue"
 )
 
 // Alert represents a single drift detection alert
@@ -58,6 +68,12 @@ type Alert struct {
 	DownstreamPrioritySum int `json:"downstream_priority_sum,omitempty"`
 }
 
+// AlertKey identifies an alert across recalculations, for tracking which
+// alerts were dismissed or already notified
+func AlertKey(a Alert) string {
+	return fmt.Sprintf("%s:%s:%s", a.Type, a.Severity, a.IssueID)
+}
+
 // Result contains the complete drift analysis
 type Result struct {
 	// HasDrift is true if any alerts were generated
@@ -128,6 +144,18 @@ func (c *Calculator) Calculate() *Result {
 	// Check blocking cascades (uses current issues if provided)
 	c.checkBlockingCascade(result)
 
+	// Check issue text quality (uses current issues if provided)
+	c.checkTextQuality(result)
+
+	// Check backlog hygiene: finished epics left open, low-priority work
+	// blocking urgent work, and unowned clusters (uses current issues if provided)
+	c.checkStaleEpics(result)
+	c.checkPriorityInversions(result)
+	c.checkOrphanClusters(result)
+
+	// Check open issues past their due date (uses current issues if provided)
+	c.checkOverdue(result)
+
 	// Compute summary
 	for _, alert := range result.Alerts {
 		switch alert.Severity {
@@ -309,7 +337,17 @@ func (c *Calculator) checkActionable(result *Result) {
 
 	if blAction > 0 {
 		pct := float64(delta) / float64(blAction) * 100
-		if pct <= -c.config.ActionableDecreaseWarningPct {
+		if c.config.ActionableCollapseCriticalPct > 0 && pct <= -c.config.ActionableCollapseCriticalPct {
+			result.Alerts = append(result.Alerts, Alert{
+				Type:        AlertActionableChange,
+				Severity:    SeverityCritical,
+				Message:     fmt.Sprintf("Actionable issues collapsed by %d (%.1f%%)", -delta, -pct),
+				BaselineVal: float64(blAction),
+				CurrentVal:  float64(curAction),
+				Delta:       float64(delta),
+				DetectedAt:  time.Now().UTC(),
+			})
+		} else if pct <= -c.config.ActionableDecreaseWarningPct {
 			result.Alerts = append(result.Alerts, Alert{
 				Type:        AlertActionableChange,
 				Severity:    SeverityWarning,
@@ -512,6 +550,262 @@ func (c *Calculator) checkBlockingCascade(result *Result) {
 	}
 }
 
+// checkTextQuality raises info alerts for open issues with empty descriptions,
+// placeholder markers in acceptance criteria, or long epics with no children.
+// No-op if issues were not provided.
+func (c *Calculator) checkTextQuality(result *Result) {
+	if len(c.issues) == 0 {
+		return
+	}
+	alertTypes := map[analysis.QualityKind]AlertType{
+		analysis.QualityEmptyDescription: AlertEmptyDescription,
+		analysis.QualityUnresolvedMarker: AlertUnresolvedMarker,
+		analysis.QualityUnsplitEpic:      AlertUnsplitEpic,
+		analysis.QualitySpelling:         AlertSpelling,
+		analysis.QualityVagueWording:     AlertVagueWording,
+	}
+
+	now := time.Now().UTC()
+	report := analysis.ComputeQualityReportWithOptions(c.issues, c.config.QualityOptions(), now)
+	for _, f := range report.Findings {
+		alertType := alertTypes[f.Kind]
+		if c.config.IsAlertDisabled(string(alertType)) {
+			continue
+		}
+		result.Alerts = append(result.Alerts, Alert{
+			Type:       alertType,
+			Severity:   SeverityInfo,
+			Message:    f.Message,
+			IssueID:    f.IssueID,
+			DetectedAt: now,
+		})
+	}
+}
+
+// checkStaleEpics warns about open epics whose child issues have all been
+// closed for at least StaleEpicDays: the epic is done but nobody closed it.
+// No-op if issues were not provided.
+func (c *Calculator) checkStaleEpics(result *Result) {
+	if c.config.IsAlertDisabled(string(AlertStaleEpic)) || len(c.issues) == 0 {
+		return
+	}
+
+	children := make(map[string][]model.Issue)
+	for _, issue := range c.issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], issue)
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, epic := range c.issues {
+		kids := children[epic.ID]
+		if epic.IssueType != model.TypeEpic || epic.Status.IsClosed() || len(kids) == 0 {
+			continue
+		}
+		var lastClosed time.Time
+		done := true
+		for _, kid := range kids {
+			if !kid.Status.IsClosed() {
+				done = false
+				break
+			}
+			closedAt := kid.UpdatedAt
+			if kid.ClosedAt != nil {
+				closedAt = *kid.ClosedAt
+			}
+			if closedAt.After(lastClosed) {
+				lastClosed = closedAt
+			}
+		}
+		if !done || lastClosed.IsZero() {
+			continue
+		}
+		days := now.Sub(lastClosed).Hours() / 24.0
+		if days < float64(c.config.StaleEpicDays) {
+			continue
+		}
+		result.Alerts = append(result.Alerts, Alert{
+			Type:       AlertStaleEpic,
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("Epic %s is still open %.0f days after its %d child issue(s) closed", epic.ID, days, len(kids)),
+			IssueID:    epic.ID,
+			DetectedAt: now,
+			Details: []string{
+				fmt.Sprintf("status=%s", epic.Status),
+				fmt.Sprintf("last_child_closed=%s", lastClosed.Format(time.RFC3339)),
+			},
+		})
+	}
+}
+
+// checkPriorityInversions warns about open issues that block open issues at
+// least PriorityInversionGap priority levels more urgent than themselves,
+// e.g. a P3 chore holding up a P0 fix. One alert per blocker lists everything
+// it inverts. No-op if issues were not provided.
+func (c *Calculator) checkPriorityInversions(result *Result) {
+	if c.config.IsAlertDisabled(string(AlertPriorityInversion)) || len(c.issues) == 0 || c.config.PriorityInversionGap <= 0 {
+		return
+	}
+
+	issueMap := make(map[string]model.Issue, len(c.issues))
+	for _, issue := range c.issues {
+		issueMap[issue.ID] = issue
+	}
+
+	inverted := make(map[string][]string)
+	var blockers []string
+	for _, blocked := range c.issues {
+		if blocked.Status.IsClosed() {
+			continue
+		}
+		for _, dep := range blocked.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok || blocker.Status.IsClosed() || blocker.Priority-blocked.Priority < c.config.PriorityInversionGap {
+				continue
+			}
+			if _, seen := inverted[blocker.ID]; !seen {
+				blockers = append(blockers, blocker.ID)
+			}
+			inverted[blocker.ID] = append(inverted[blocker.ID],
+				fmt.Sprintf("%s (P%d) waits on %s (P%d)", blocked.ID, blocked.Priority, blocker.ID, blocker.Priority))
+		}
+	}
+
+	now := time.Now().UTC()
+	sort.Strings(blockers)
+	for _, id := range blockers {
+		details := inverted[id]
+		sort.Strings(details)
+		result.Alerts = append(result.Alerts, Alert{
+			Type:       AlertPriorityInversion,
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("P%d issue %s blocks %d more urgent issue(s)", issueMap[id].Priority, id, len(details)),
+			IssueID:    id,
+			DetectedAt: now,
+			Details:    details,
+		})
+	}
+}
+
+// checkOrphanClusters flags groups of at least OrphanClusterMinSize open
+// issues connected to each other by dependencies but to nothing else, where
+// no issue has a label or an assignee: work that nobody owns or can find by
+// label. The alert's IssueID is the cluster's smallest ID, so dismissing it
+// sticks while the cluster lasts. No-op if issues were not provided.
+func (c *Calculator) checkOrphanClusters(result *Result) {
+	if c.config.IsAlertDisabled(string(AlertOrphanCluster)) || len(c.issues) == 0 || c.config.OrphanClusterMinSize <= 0 {
+		return
+	}
+
+	// Union-find over dependencies between open issues
+	parent := make(map[string]string)
+	for _, issue := range c.issues {
+		if !issue.Status.IsClosed() {
+			parent[issue.ID] = issue.ID
+		}
+	}
+	var find func(id string) string
+	find = func(id string) string {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	for _, issue := range c.issues {
+		if _, open := parent[issue.ID]; !open {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil {
+				continue
+			}
+			if _, open := parent[dep.DependsOnID]; open {
+				parent[find(issue.ID)] = find(dep.DependsOnID)
+			}
+		}
+	}
+
+	clusters := make(map[string][]string)
+	owned := make(map[string]bool)
+	for _, issue := range c.issues {
+		if _, open := parent[issue.ID]; !open {
+			continue
+		}
+		root := find(issue.ID)
+		clusters[root] = append(clusters[root], issue.ID)
+		if len(issue.Labels) > 0 || issue.Assignee != "" {
+			owned[root] = true
+		}
+	}
+
+	var orphans [][]string
+	for root, ids := range clusters {
+		if !owned[root] && len(ids) >= c.config.OrphanClusterMinSize {
+			sort.Strings(ids)
+			orphans = append(orphans, ids)
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i][0] < orphans[j][0] })
+
+	now := time.Now().UTC()
+	for _, ids := range orphans {
+		result.Alerts = append(result.Alerts, Alert{
+			Type:       AlertOrphanCluster,
+			Severity:   SeverityInfo,
+			Message:    fmt.Sprintf("%d connected issues around %s have no labels or assignee", len(ids), ids[0]),
+			IssueID:    ids[0],
+			DetectedAt: now,
+			Details:    ids,
+		})
+	}
+}
+
+// checkOverdue raises one alert once the number of open issues past their
+// due date reaches OverdueWarningCount, critical at OverdueCriticalCount.
+// Details list the overdue issues, longest overdue first. No-op if issues
+// were not provided.
+func (c *Calculator) checkOverdue(result *Result) {
+	if c.config.IsAlertDisabled(string(AlertOverdue)) || len(c.issues) == 0 || c.config.OverdueWarningCount <= 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	var overdue []model.Issue
+	for _, issue := range c.issues {
+		if issue.DueStateAt(now) == model.DueOverdue {
+			overdue = append(overdue, issue)
+		}
+	}
+	if len(overdue) < c.config.OverdueWarningCount {
+		return
+	}
+	sort.SliceStable(overdue, func(i, j int) bool { return overdue[i].DueDate.Before(*overdue[j].DueDate) })
+
+	severity := SeverityWarning
+	if len(overdue) >= c.config.OverdueCriticalCount {
+		severity = SeverityCritical
+	}
+	details := make([]string, len(overdue))
+	for i, issue := range overdue {
+		details[i] = fmt.Sprintf("%s due %s (%.0f days overdue)", issue.ID,
+			issue.DueDate.Format("2006-01-02"), now.Sub(*issue.DueDate).Hours()/24)
+	}
+	result.Alerts = append(result.Alerts, Alert{
+		Type:       AlertOverdue,
+		Severity:   severity,
+		Message:    fmt.Sprintf("%d open issue(s) are past their due date", len(overdue)),
+		CurrentVal: float64(len(overdue)),
+		DetectedAt: now,
+		Details:    details,
+	})
+}
+
 // cycleKey creates a normalized key for a cycle for comparison.
 // It rotates the cycle so the lexicographically smallest element is first,
 // preserving the order (direction) of elements.
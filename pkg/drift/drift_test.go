@@ -257,6 +257,187 @@ func TestCalculatorBlockingCascade(t *testing.T) {
 	}
 }
 
+func TestCalculatorTextQuality(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "No description", Status: model.StatusOpen},
+		{ID: "B", Title: "Placeholder", Status: model.StatusOpen, Description: "ok", AcceptanceCriteria: "TBD"},
+		{ID: "C", Title: "Closed", Status: model.StatusClosed},
+		{ID: "D", Title: "Seperate parser", Status: model.StatusOpen, Description: "Tidy up etc"},
+	}
+	bl := &baseline.Baseline{Stats: baseline.GraphStats{}}
+	current := &baseline.Baseline{Stats: baseline.GraphStats{}}
+	cfg := DefaultConfig()
+
+	qualityAlerts := func() []string {
+		calc := NewCalculator(bl, current, cfg)
+		calc.SetIssues(issues)
+		var got []string
+		for _, a := range calc.Calculate().Alerts {
+			switch a.Type {
+			case AlertEmptyDescription, AlertUnresolvedMarker, AlertUnsplitEpic, AlertSpelling, AlertVagueWording:
+				if a.Severity != SeverityInfo {
+					t.Errorf("%s alert should be info, got %s", a.Type, a.Severity)
+				}
+				got = append(got, string(a.Type)+":"+a.IssueID)
+			}
+		}
+		return got
+	}
+
+	if got := strings.Join(qualityAlerts(), " "); got != "empty_description:A unresolved_marker:B" {
+		t.Errorf("quality alerts = %s", got)
+	}
+	cfg.DisabledAlerts = []string{"empty_description"}
+	if got := strings.Join(qualityAlerts(), " "); got != "unresolved_marker:B" {
+		t.Errorf("disabled empty_description should be skipped, got %s", got)
+	}
+	cfg.SpellCheck = true
+	if got := strings.Join(qualityAlerts(), " "); got != "unresolved_marker:B spelling:D vague_wording:D" {
+		t.Errorf("spell_check should add spelling and vague wording alerts, got %s", got)
+	}
+}
+
+func TestCalculatorBacklogHygiene(t *testing.T) {
+	now := time.Now().UTC()
+	closedLongAgo := now.Add(-10 * 24 * time.Hour)
+	closedToday := now.Add(-time.Hour)
+	child := func(id, parent string, closedAt time.Time) model.Issue {
+		return model.Issue{ID: id, Status: model.StatusClosed, ClosedAt: &closedAt, Labels: []string{"x"},
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}}
+	}
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		// Epics: DONE's children closed 10 days ago, RECENT's today, OPEN has an open child
+		{ID: "DONE", IssueType: model.TypeEpic, Status: model.StatusOpen, Labels: []string{"x"}},
+		child("DONE.1", "DONE", closedLongAgo),
+		child("DONE.2", "DONE", closedLongAgo),
+		{ID: "RECENT", IssueType: model.TypeEpic, Status: model.StatusOpen, Labels: []string{"x"}},
+		child("RECENT.1", "RECENT", closedToday),
+		{ID: "OPEN", IssueType: model.TypeEpic, Status: model.StatusOpen, Labels: []string{"x"}},
+		child("OPEN.1", "OPEN", closedLongAgo),
+		{ID: "OPEN.2", Status: model.StatusOpen, Labels: []string{"x"},
+			Dependencies: []*model.Dependency{{DependsOnID: "OPEN", Type: model.DepParentChild}}},
+
+		// Priorities: P3 CHORE blocks P0 FIX and P1 FEAT (gap 3 and 2); P2 NEAR blocks P1 FEAT (gap 1)
+		{ID: "CHORE", Priority: 3, Status: model.StatusOpen, Assignee: "sam"},
+		{ID: "NEAR", Priority: 2, Status: model.StatusOpen, Assignee: "sam"},
+		{ID: "FIX", Priority: 0, Status: model.StatusOpen, Assignee: "sam", Dependencies: blocks("CHORE")},
+		{ID: "FEAT", Priority: 1, Status: model.StatusOpen, Assignee: "sam",
+			Dependencies: append(blocks("CHORE"), blocks("NEAR")...)},
+
+		// Orphans: O1-O2-O3 unowned; P1-P2-P3 has one labelled issue; Q1-Q2 too small
+		{ID: "O1", Status: model.StatusOpen},
+		{ID: "O2", Status: model.StatusOpen, Dependencies: blocks("O1")},
+		{ID: "O3", Status: model.StatusBlocked, Dependencies: blocks("O2")},
+		{ID: "P1", Status: model.StatusOpen},
+		{ID: "P2", Status: model.StatusOpen, Dependencies: blocks("P1")},
+		{ID: "P3", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: blocks("P2")},
+		{ID: "Q1", Status: model.StatusOpen},
+		{ID: "Q2", Status: model.StatusOpen, Dependencies: blocks("Q1")},
+	}
+	bl := &baseline.Baseline{Stats: baseline.GraphStats{}}
+	current := &baseline.Baseline{Stats: baseline.GraphStats{}}
+	cfg := DefaultConfig()
+
+	hygieneAlerts := func() map[AlertType][]Alert {
+		calc := NewCalculator(bl, current, cfg)
+		calc.SetIssues(issues)
+		got := make(map[AlertType][]Alert)
+		for _, a := range calc.Calculate().Alerts {
+			switch a.Type {
+			case AlertStaleEpic, AlertPriorityInversion, AlertOrphanCluster:
+				got[a.Type] = append(got[a.Type], a)
+			}
+		}
+		return got
+	}
+
+	got := hygieneAlerts()
+	if epics := got[AlertStaleEpic]; len(epics) != 1 || epics[0].IssueID != "DONE" || epics[0].Severity != SeverityWarning {
+		t.Errorf("stale_epic alerts = %+v, want one warning for DONE", epics)
+	}
+	inversions := got[AlertPriorityInversion]
+	if len(inversions) != 1 || inversions[0].IssueID != "CHORE" || len(inversions[0].Details) != 2 {
+		t.Fatalf("priority_inversion alerts = %+v, want one for CHORE listing FEAT and FIX", inversions)
+	}
+	if d := strings.Join(inversions[0].Details, "; "); d != "FEAT (P1) waits on CHORE (P3); FIX (P0) waits on CHORE (P3)" {
+		t.Errorf("priority_inversion details = %s", d)
+	}
+	orphans := got[AlertOrphanCluster]
+	if len(orphans) != 1 || orphans[0].IssueID != "O1" || strings.Join(orphans[0].Details, ",") != "O1,O2,O3" || orphans[0].Severity != SeverityInfo {
+		t.Errorf("orphan_cluster alerts = %+v, want one info for O1,O2,O3", orphans)
+	}
+
+	// Thresholds
+	cfg.StaleEpicDays = 0
+	cfg.PriorityInversionGap = 1
+	cfg.OrphanClusterMinSize = 2
+	got = hygieneAlerts()
+	if n := len(got[AlertStaleEpic]); n != 2 {
+		t.Errorf("stale_epic_days 0: %d alerts, want DONE and RECENT", n)
+	}
+	if n := len(got[AlertPriorityInversion]); n != 2 {
+		t.Errorf("priority_inversion_gap 1: %d alerts, want CHORE and NEAR", n)
+	}
+	if n := len(got[AlertOrphanCluster]); n != 2 {
+		t.Errorf("orphan_cluster_min_size 2: %d alerts, want O and Q clusters", n)
+	}
+
+	cfg.DisabledAlerts = []string{"stale_epic", "priority_inversion", "orphan_cluster"}
+	if got := hygieneAlerts(); len(got) != 0 {
+		t.Errorf("disabled hygiene alerts still raised: %+v", got)
+	}
+}
+
+func TestCalculatorOverdue(t *testing.T) {
+	now := time.Now().UTC()
+	daysAgo := func(d int) *time.Time { t := now.AddDate(0, 0, -d); return &t }
+	issues := []model.Issue{
+		{ID: "LATE", Status: model.StatusOpen, DueDate: daysAgo(2)},
+		{ID: "LATER", Status: model.StatusInProgress, DueDate: daysAgo(9)},
+		{ID: "DONE", Status: model.StatusClosed, DueDate: daysAgo(5)},
+		{ID: "AHEAD", Status: model.StatusOpen, DueDate: daysAgo(-3)},
+		{ID: "UNDATED", Status: model.StatusOpen},
+	}
+	cfg := DefaultConfig()
+	overdueAlerts := func() []Alert {
+		calc := NewCalculator(&baseline.Baseline{}, &baseline.Baseline{}, cfg)
+		calc.SetIssues(issues)
+		var got []Alert
+		for _, a := range calc.Calculate().Alerts {
+			if a.Type == AlertOverdue {
+				got = append(got, a)
+			}
+		}
+		return got
+	}
+
+	got := overdueAlerts()
+	if len(got) != 1 || got[0].Severity != SeverityWarning || got[0].CurrentVal != 2 {
+		t.Fatalf("overdue alerts = %+v, want one warning counting 2", got)
+	}
+	if len(got[0].Details) != 2 || !strings.HasPrefix(got[0].Details[0], "LATER due") || !strings.HasSuffix(got[0].Details[0], "(9 days overdue)") {
+		t.Errorf("details = %v, want LATER (9 days) before LATE", got[0].Details)
+	}
+
+	cfg.OverdueCriticalCount = 2
+	if got := overdueAlerts(); len(got) != 1 || got[0].Severity != SeverityCritical {
+		t.Errorf("overdue_critical_count 2: %+v, want critical", got)
+	}
+	cfg.OverdueWarningCount, cfg.OverdueCriticalCount = 3, 3
+	if got := overdueAlerts(); len(got) != 0 {
+		t.Errorf("overdue_warning_count 3: %+v, want none for 2 overdue", got)
+	}
+
+	bad := DefaultConfig()
+	bad.OverdueWarningCount, bad.OverdueCriticalCount = 4, 2
+	if bad.Validate() == nil {
+		t.Error("overdue_critical_count below overdue_warning_count should be invalid")
+	}
+}
+
 // TestCalculatorBlockingCascadeWithPriorities verifies the downstream priority sum calculation (bv-165)
 func TestCalculatorBlockingCascadeWithPriorities(t *testing.T) {
 	issues := []model.Issue{
@@ -667,6 +848,9 @@ func TestConfigValidate(t *testing.T) {
 		{"actionable decrease > 100", &Config{DensityWarningPct: 50, ActionableDecreaseWarningPct: 150}, true},
 		{"negative actionable increase", &Config{DensityWarningPct: 50, ActionableIncreaseInfoPct: -10}, true},
 		{"negative pagerank change", &Config{DensityWarningPct: 50, PageRankChangeWarningPct: -20}, true},
+		{"negative stale epic days", &Config{DensityWarningPct: 50, StaleEpicDays: -1}, true},
+		{"priority inversion gap > 4", &Config{DensityWarningPct: 50, PriorityInversionGap: 5}, true},
+		{"orphan cluster of 1", &Config{DensityWarningPct: 50, OrphanClusterMinSize: 1}, true},
 	}
 
 	for _, tt := range tests {
@@ -809,6 +993,30 @@ func TestCheckActionable_DecreaseWarning(t *testing.T) {
 	}
 }
 
+// TestCheckActionable_CollapseCritical tests that a collapse is critical
+// once actionable_collapse_critical_pct is set
+func TestCheckActionable_CollapseCritical(t *testing.T) {
+	bl := &baseline.Baseline{Stats: baseline.GraphStats{NodeCount: 100, ActionableCount: 100}}
+	current := &baseline.Baseline{Stats: baseline.GraphStats{NodeCount: 100, ActionableCount: 20}}
+
+	cfg := DefaultConfig()
+	cfg.ActionableCollapseCriticalPct = 75
+	result := NewCalculator(bl, current, cfg).Calculate()
+
+	var alerts []Alert
+	for _, alert := range result.Alerts {
+		if alert.Type == AlertActionableChange {
+			alerts = append(alerts, alert)
+		}
+	}
+	if len(alerts) != 1 || alerts[0].Severity != SeverityCritical {
+		t.Fatalf("expected one critical actionable alert for an 80%% drop, got %+v", alerts)
+	}
+	if result.ExitCode() != 1 {
+		t.Errorf("exit code = %d, want 1", result.ExitCode())
+	}
+}
+
 // TestCheckActionable_InfoDecrease tests moderate decrease yields info alert (not warning)
 func TestCheckActionable_InfoDecrease(t *testing.T) {
 	t.Log("Testing checkActionable with 25% decrease (info alert)")
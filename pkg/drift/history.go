@@ -0,0 +1,190 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// HistoryFilename is the alert log under .bv/
+const HistoryFilename = "alerts.json"
+
+// MaxHistory caps the alert log; the oldest resolved alerts are dropped first
+const MaxHistory = 500
+
+// AlertRecord is one occurrence of an alert, from when it was first raised
+// until it stopped being raised. An alert that resolves and comes back
+// starts a new record.
+type AlertRecord struct {
+	Key        string     `json:"key"` // AlertKey of the alert
+	Type       AlertType  `json:"type"`
+	Severity   Severity   `json:"severity"`
+	Message    string     `json:"message"`
+	IssueID    string     `json:"issue_id,omitempty"`
+	FirstSeen  time.Time  `json:"first_seen"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+
+	// Set when someone dismisses the alert; it stays dismissed until it resolves
+	DismissedAt *time.Time `json:"dismissed_at,omitempty"`
+	DismissedBy string     `json:"dismissed_by,omitempty"`
+}
+
+// Active returns true if the alert is still being raised
+func (r AlertRecord) Active() bool {
+	return r.ResolvedAt == nil
+}
+
+// History is the alert log persisted in .bv/alerts.json
+type History struct {
+	Alerts []AlertRecord `json:"alerts"`
+}
+
+// HistoryPath returns the path to .bv/alerts.json
+func HistoryPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", HistoryFilename)
+}
+
+// LoadHistory reads the alert log; a missing file is an empty log
+func LoadHistory(projectDir string) (*History, error) {
+	h := &History{}
+	data, err := os.ReadFile(HistoryPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return h, nil
+		}
+		return h, fmt.Errorf("reading alert history: %w", err)
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return &History{}, fmt.Errorf("parsing alert history: %w", err)
+	}
+	return h, nil
+}
+
+// SaveHistory writes the alert log to .bv
+func SaveHistory(projectDir string, h *History) error {
+	path := HistoryPath(projectDir)
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating .bv directory: %w", err)
+	}
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding alert history: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing alert history: %w", err)
+	}
+	return nil
+}
+
+// open returns the index of the active record for key, or -1
+func (h *History) open(key string) int {
+	for i := len(h.Alerts) - 1; i >= 0; i-- {
+		if h.Alerts[i].Key == key && h.Alerts[i].Active() {
+			return i
+		}
+	}
+	return -1
+}
+
+// record starts a record for a newly raised alert
+func (h *History) record(a Alert, now time.Time) int {
+	h.Alerts = append(h.Alerts, AlertRecord{
+		Key:       AlertKey(a),
+		Type:      a.Type,
+		Severity:  a.Severity,
+		Message:   a.Message,
+		IssueID:   a.IssueID,
+		FirstSeen: now,
+	})
+	return len(h.Alerts) - 1
+}
+
+// Update logs the alerts raised now: new ones start a record, and records
+// of alerts no longer raised are marked resolved. Reports whether the log
+// changed.
+func (h *History) Update(alerts []Alert, now time.Time) bool {
+	changed := false
+	raised := make(map[string]bool, len(alerts))
+	for _, a := range alerts {
+		key := AlertKey(a)
+		if raised[key] {
+			continue
+		}
+		raised[key] = true
+		if i := h.open(key); i >= 0 {
+			// Messages carry counts and ages; keep the latest
+			if h.Alerts[i].Message != a.Message {
+				h.Alerts[i].Message = a.Message
+				changed = true
+			}
+			continue
+		}
+		h.record(a, now)
+		changed = true
+	}
+
+	for i := range h.Alerts {
+		if h.Alerts[i].Active() && !raised[h.Alerts[i].Key] {
+			resolved := now
+			h.Alerts[i].ResolvedAt = &resolved
+			changed = true
+		}
+	}
+
+	if len(h.Alerts) > MaxHistory {
+		h.trim()
+		changed = true
+	}
+	return changed
+}
+
+// trim drops the oldest resolved records until the log fits MaxHistory
+func (h *History) trim() {
+	drop := len(h.Alerts) - MaxHistory
+	kept := h.Alerts[:0]
+	for _, r := range h.Alerts {
+		if drop > 0 && !r.Active() {
+			drop--
+			continue
+		}
+		kept = append(kept, r)
+	}
+	h.Alerts = kept
+}
+
+// Dismiss acknowledges an alert on behalf of by. It stays dismissed, across
+// reloads and sessions, until it resolves.
+func (h *History) Dismiss(a Alert, by string, now time.Time) {
+	i := h.open(AlertKey(a))
+	if i < 0 {
+		i = h.record(a, now)
+	}
+	h.Alerts[i].DismissedAt = &now
+	h.Alerts[i].DismissedBy = by
+}
+
+// Dismissed returns the keys of active alerts that were dismissed
+func (h *History) Dismissed() map[string]bool {
+	keys := make(map[string]bool)
+	for _, r := range h.Alerts {
+		if r.Active() && r.DismissedAt != nil {
+			keys[r.Key] = true
+		}
+	}
+	return keys
+}
+
+// Recent returns the records newest first
+func (h *History) Recent() []AlertRecord {
+	records := make([]AlertRecord, len(h.Alerts))
+	copy(records, h.Alerts)
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].FirstSeen.After(records[j].FirstSeen)
+	})
+	return records
+}
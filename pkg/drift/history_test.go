@@ -0,0 +1,97 @@
+package drift
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestHistory_LifecycleAndPersistence(t *testing.T) {
+	dir := t.TempDir()
+	cycle := Alert{Type: AlertNewCycle, Severity: SeverityCritical, Message: "1 new cycle(s) detected"}
+	stale := Alert{Type: AlertStaleIssue, Severity: SeverityWarning, Message: "Issue bv-7 inactive for 15 days", IssueID: "bv-7"}
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 9, 0, 0, 0, time.UTC) }
+
+	h, err := LoadHistory(dir)
+	if err != nil || len(h.Alerts) != 0 {
+		t.Fatalf("missing file: %+v, %v", h, err)
+	}
+	if !h.Update([]Alert{cycle, stale, stale}, day(1)) || len(h.Alerts) != 2 {
+		t.Fatalf("first update: %+v", h.Alerts)
+	}
+	h.Dismiss(stale, "ann", day(2))
+	if err := SaveHistory(dir, h); err != nil {
+		t.Fatal(err)
+	}
+
+	// Dismissals survive a reload of the log
+	h, err = LoadHistory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := h.Dismissed(); !d[AlertKey(stale)] || d[AlertKey(cycle)] {
+		t.Errorf("Dismissed() = %v, want only the stale alert", d)
+	}
+
+	// Still raised with a newer message: same record, message updated
+	stale.Message = "Issue bv-7 inactive for 17 days"
+	if !h.Update([]Alert{cycle, stale}, day(3)) || len(h.Alerts) != 2 || h.Alerts[1].Message != stale.Message {
+		t.Errorf("ongoing alerts should keep their record: %+v", h.Alerts)
+	}
+	if h.Update([]Alert{cycle, stale}, day(3)) {
+		t.Error("an unchanged update should report no change")
+	}
+
+	// The stale alert resolves, then comes back undismissed as a new record
+	h.Update([]Alert{cycle}, day(4))
+	if r := h.Alerts[1]; r.Active() || !r.ResolvedAt.Equal(day(4)) || r.DismissedBy != "ann" {
+		t.Errorf("resolved record = %+v", r)
+	}
+	h.Update([]Alert{cycle, stale}, day(5))
+	if len(h.Alerts) != 3 || h.Dismissed()[AlertKey(stale)] {
+		t.Errorf("returning alert should start a new undismissed record: %+v", h.Alerts)
+	}
+
+	recent := h.Recent()
+	if !recent[0].FirstSeen.Equal(day(5)) || !recent[2].FirstSeen.Equal(day(1)) {
+		t.Errorf("Recent() not newest first: %+v", recent)
+	}
+	if r := h.Alerts[0]; r.Key != AlertKey(cycle) || !r.Active() || !r.FirstSeen.Equal(day(1)) {
+		t.Errorf("the cycle alert has been active since day 1: %+v", r)
+	}
+}
+
+func TestHistory_DismissBeforeRecorded(t *testing.T) {
+	h := &History{}
+	a := Alert{Type: AlertBlockingCascade, Severity: SeverityInfo, Message: "Completing A unblocks 3", IssueID: "A"}
+	h.Dismiss(a, "sam", time.Now())
+	if len(h.Alerts) != 1 || !h.Dismissed()[AlertKey(a)] {
+		t.Fatalf("dismissing an unrecorded alert should record it: %+v", h.Alerts)
+	}
+	h.Update([]Alert{a}, time.Now())
+	if len(h.Alerts) != 1 || !h.Dismissed()[AlertKey(a)] {
+		t.Errorf("update should keep the dismissed record: %+v", h.Alerts)
+	}
+}
+
+func TestHistory_TrimDropsOldestResolved(t *testing.T) {
+	h := &History{}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	keep := Alert{Type: AlertNewCycle, Severity: SeverityCritical, Message: "cycle"}
+	h.Update([]Alert{keep}, start)
+	for i := 0; i < MaxHistory+10; i++ {
+		a := Alert{Type: AlertStaleIssue, Severity: SeverityWarning, Message: "stale", IssueID: fmt.Sprintf("x-%d", i)}
+		now := start.Add(time.Duration(i+1) * time.Hour)
+		h.Update([]Alert{keep, a}, now)
+	}
+	if len(h.Alerts) != MaxHistory {
+		t.Fatalf("history has %d records, want %d", len(h.Alerts), MaxHistory)
+	}
+	if h.Alerts[0].Key != AlertKey(keep) || !h.Alerts[0].Active() {
+		t.Errorf("the active alert should survive trimming: %+v", h.Alerts[0])
+	}
+	// 510 stale records were logged beside the active one; the 11 oldest had to go
+	if h.Alerts[1].IssueID != "x-11" {
+		t.Errorf("oldest resolved should go first, got %s", h.Alerts[1].IssueID)
+	}
+}
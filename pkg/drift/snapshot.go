@@ -0,0 +1,50 @@
+package drift
+
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// snapshotTopN is how many items of each metric a snapshot keeps
+const snapshotTopN = 10
+
+// Snapshot captures the current metrics in baseline form, for saving or
+// comparing against a stored baseline. It carries no timestamp or git
+// details; pass its fields to baseline.New to stamp one for saving.
+func Snapshot(issues []model.Issue, stats *analysis.GraphStats, analyzer *analysis.Analyzer) *baseline.Baseline {
+	openCount, closedCount, blockedCount := 0, 0, 0
+	for _, issue := range issues {
+		switch issue.Status {
+		case model.StatusOpen, model.StatusInProgress:
+			openCount++
+		case model.StatusClosed:
+			closedCount++
+		case model.StatusBlocked:
+			blockedCount++
+		}
+	}
+	cycles := stats.Cycles()
+
+	return &baseline.Baseline{
+		Version: baseline.CurrentVersion,
+		Stats: baseline.GraphStats{
+			NodeCount:       stats.NodeCount,
+			EdgeCount:       stats.EdgeCount,
+			Density:         stats.Density,
+			OpenCount:       openCount,
+			ClosedCount:     closedCount,
+			BlockedCount:    blockedCount,
+			CycleCount:      len(cycles),
+			ActionableCount: len(analyzer.GetActionableIssues()),
+		},
+		TopMetrics: baseline.TopMetrics{
+			PageRank:     baseline.TopItems(stats.PageRank(), snapshotTopN),
+			Betweenness:  baseline.TopItems(stats.Betweenness(), snapshotTopN),
+			CriticalPath: baseline.TopItems(stats.CriticalPathScore(), snapshotTopN),
+			Hubs:         baseline.TopItems(stats.Hubs(), snapshotTopN),
+			Authorities:  baseline.TopItems(stats.Authorities(), snapshotTopN),
+		},
+		Cycles: cycles,
+	}
+}
@@ -0,0 +1,44 @@
+package drift
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSnapshot_CountsAndMetricItems(t *testing.T) {
+	// Twelve open issues all blocked by "core", which must lead PageRank
+	issues := []model.Issue{{ID: "core", Status: model.StatusInProgress}}
+	for i := 1; i <= 12; i++ {
+		id := fmt.Sprintf("leaf-%02d", i)
+		issues = append(issues, model.Issue{ID: id, Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: "core", Type: model.DepBlocks}}})
+	}
+	issues = append(issues,
+		model.Issue{ID: "done", Status: model.StatusClosed},
+		model.Issue{ID: "stuck", Status: model.StatusBlocked},
+	)
+
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+	snap := Snapshot(issues, &stats, analyzer)
+
+	if s := snap.Stats; s.OpenCount != 13 || s.ClosedCount != 1 || s.BlockedCount != 1 || s.NodeCount != len(issues) {
+		t.Errorf("unexpected counts: %+v", s)
+	}
+
+	pageRank := snap.TopMetrics.PageRank
+	if len(pageRank) != snapshotTopN {
+		t.Fatalf("expected the top %d PageRank items, got %d", snapshotTopN, len(pageRank))
+	}
+	if pageRank[0].ID != "core" {
+		t.Errorf("expected core to lead PageRank, got %+v", pageRank[0])
+	}
+	for i := 1; i < len(pageRank); i++ {
+		if pageRank[i].Value > pageRank[i-1].Value {
+			t.Fatalf("PageRank items not sorted desc: %+v", pageRank)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Package export provides data export functionality for bv.
+//
+// This file implements the Atom feed of backlog changes: issue creations,
+// closures, and edits plus current drift alerts, so anyone can follow the
+// backlog from a feed reader without credentials.
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// AtomFeedFilename is the feed file written alongside a static Pages export
+const AtomFeedFilename = "feed.xml"
+
+// AtomFeedConfig configures the Atom feed of backlog changes
+type AtomFeedConfig struct {
+	Title string // Feed title (default "Backlog changes")
+
+	// BaseURL is where the Pages site (and feed.xml) is published, e.g.
+	// https://acme.github.io/widgets/. Optional: without it links are relative.
+	BaseURL string
+
+	Limit int // Max entries, newest first (default 50)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Updated    string         `xml:"updated"`
+	Link       *atomLink      `xml:"link,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    string         `xml:"summary"`
+}
+
+// feedEvent is one change worth announcing, before it becomes an Atom entry
+type feedEvent struct {
+	kind    string // created, closed, updated, alert
+	at      time.Time
+	id      string // stable entry ID
+	title   string
+	summary string
+	issueID string
+	terms   []string
+}
+
+// GenerateAtomFeed renders issue changes (created, closed, updated) and the
+// current alerts as an Atom 1.0 feed. Issue events are dated by their own
+// timestamps and alerts by the latest data change, so regenerating the feed
+// from unchanged data yields the same document and readers see no duplicates.
+func GenerateAtomFeed(issues []model.Issue, alerts []drift.Alert, cfg AtomFeedConfig) ([]byte, error) {
+	if cfg.Title == "" {
+		cfg.Title = "Backlog changes"
+	}
+	if cfg.Limit <= 0 {
+		cfg.Limit = 50
+	}
+	base := cfg.BaseURL
+	if base != "" && !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	updated := StableTime(issues)
+
+	var events []feedEvent
+	for _, issue := range issues {
+		terms := append([]string{string(issue.Status), fmt.Sprintf("P%d", issue.Priority)}, issue.Labels...)
+		if !issue.CreatedAt.IsZero() {
+			events = append(events, feedEvent{
+				kind:    "created",
+				at:      issue.CreatedAt,
+				id:      "urn:bv:" + issue.ID + ":created",
+				title:   fmt.Sprintf("Created %s: %s", issue.ID, issue.Title),
+				summary: issueFeedSummary(issue),
+				issueID: issue.ID,
+				terms:   terms,
+			})
+		}
+		if issue.Status == model.StatusClosed && issue.ClosedAt != nil {
+			events = append(events, feedEvent{
+				kind:    "closed",
+				at:      *issue.ClosedAt,
+				id:      fmt.Sprintf("urn:bv:%s:closed:%d", issue.ID, issue.ClosedAt.Unix()),
+				title:   fmt.Sprintf("Closed %s: %s", issue.ID, issue.Title),
+				summary: issueFeedSummary(issue),
+				issueID: issue.ID,
+				terms:   terms,
+			})
+		}
+		// The latest edit, unless it is just the create or close itself
+		if isSeparateUpdate(issue) {
+			events = append(events, feedEvent{
+				kind:    "updated",
+				at:      issue.UpdatedAt,
+				id:      fmt.Sprintf("urn:bv:%s:updated:%d", issue.ID, issue.UpdatedAt.Unix()),
+				title:   fmt.Sprintf("Updated %s: %s", issue.ID, issue.Title),
+				summary: issueFeedSummary(issue),
+				issueID: issue.ID,
+				terms:   terms,
+			})
+		}
+	}
+	for _, a := range alerts {
+		// Project-wide alerts (density, cycles) are keyed by type alone
+		id := fmt.Sprintf("urn:bv:alert:%s", a.Type)
+		if a.IssueID != "" {
+			id += ":" + a.IssueID
+		} else if a.Label != "" {
+			id += ":label:" + a.Label
+		}
+		events = append(events, feedEvent{
+			kind:    "alert",
+			at:      updated,
+			id:      id,
+			title:   fmt.Sprintf("[%s] %s", a.Severity, a.Message),
+			summary: strings.Join(a.Details, "\n"),
+			issueID: a.IssueID,
+			terms:   []string{"alert", string(a.Type), string(a.Severity)},
+		})
+	}
+
+	// Newest first; alerts lead ties since they describe the current state
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.After(events[j].at)
+		}
+		if (events[i].kind == "alert") != (events[j].kind == "alert") {
+			return events[i].kind == "alert"
+		}
+		return events[i].id < events[j].id
+	})
+	if len(events) > cfg.Limit {
+		events = events[:cfg.Limit]
+	}
+
+	feed := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Title:   cfg.Title,
+		ID:      "urn:bv:feed:" + url.PathEscape(strings.ToLower(cfg.Title)),
+		Updated: updated.Format(time.RFC3339),
+		Author:  atomAuthor{Name: "bv"},
+	}
+	if base != "" {
+		feed.ID = base + AtomFeedFilename
+		feed.Links = []atomLink{
+			{Href: base + AtomFeedFilename, Rel: "self", Type: "application/atom+xml"},
+			{Href: base, Rel: "alternate", Type: "text/html"},
+		}
+	}
+	for _, e := range events {
+		entry := atomEntry{
+			Title:   e.title,
+			ID:      e.id,
+			Updated: e.at.UTC().Format(time.RFC3339),
+			Summary: e.summary,
+		}
+		for _, term := range e.terms {
+			entry.Categories = append(entry.Categories, atomCategory{Term: term})
+		}
+		if e.issueID != "" {
+			entry.Link = &atomLink{Href: base + "#/issue/" + url.PathEscape(e.issueID), Rel: "alternate"}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding Atom feed: %w", err)
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+// isSeparateUpdate reports whether an issue's UpdatedAt marks an edit of its
+// own rather than the create/close timestamp (allowing a minute of slack)
+func isSeparateUpdate(issue model.Issue) bool {
+	if issue.UpdatedAt.IsZero() || issue.UpdatedAt.Sub(issue.CreatedAt) < time.Minute {
+		return false
+	}
+	if issue.ClosedAt != nil {
+		d := issue.UpdatedAt.Sub(*issue.ClosedAt)
+		if d > -time.Minute && d < time.Minute {
+			return false
+		}
+	}
+	return true
+}
+
+func issueFeedSummary(issue model.Issue) string {
+	parts := []string{fmt.Sprintf("%s · P%d · %s", issue.Status, issue.Priority, issue.IssueType)}
+	if issue.Assignee != "" {
+		parts = append(parts, "@"+issue.Assignee)
+	}
+	if len(issue.Labels) > 0 {
+		parts = append(parts, strings.Join(issue.Labels, ", "))
+	}
+	summary := strings.Join(parts, " · ")
+	if issue.Description != "" {
+		summary += "\n\n" + truncateString(issue.Description, 280)
+	}
+	return summary
+}
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func atomTestIssues() []model.Issue {
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	closed := base.Add(48 * time.Hour)
+	return []model.Issue{
+		{ID: "a-1", Title: "Created only", Status: model.StatusOpen, Priority: 1, CreatedAt: base, UpdatedAt: base},
+		{ID: "a-2", Title: "Edited later", Status: model.StatusInProgress, Priority: 2, CreatedAt: base.Add(time.Hour), UpdatedAt: base.Add(72 * time.Hour), Labels: []string{"api"}},
+		{ID: "a/3", Title: "Shipped <fast>", Status: model.StatusClosed, CreatedAt: base.Add(2 * time.Hour), UpdatedAt: closed, ClosedAt: &closed},
+	}
+}
+
+func TestGenerateAtomFeed(t *testing.T) {
+	alerts := []drift.Alert{
+		{Type: drift.AlertStaleIssue, Severity: drift.SeverityWarning, Message: "a-1 is stale", IssueID: "a-1"},
+		{Type: drift.AlertDensityGrowth, Severity: drift.SeverityInfo, Message: "Density changed"},
+	}
+	out, err := GenerateAtomFeed(atomTestIssues(), alerts, AtomFeedConfig{BaseURL: "https://acme.github.io/widgets"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var feed atomFeed
+	if err := xml.Unmarshal(out, &feed); err != nil {
+		t.Fatalf("Feed is not valid XML: %v\n%s", err, out)
+	}
+	if feed.Title != "Backlog changes" || feed.ID != "https://acme.github.io/widgets/feed.xml" {
+		t.Errorf("Unexpected feed header: title=%q id=%q", feed.Title, feed.ID)
+	}
+	// Latest change is a-2's edit
+	if feed.Updated != "2025-06-04T09:00:00Z" {
+		t.Errorf("Feed updated = %q", feed.Updated)
+	}
+
+	var ids []string
+	for _, e := range feed.Entries {
+		ids = append(ids, e.ID)
+	}
+	want := []string{
+		"urn:bv:alert:density_growth",
+		"urn:bv:alert:stale_issue:a-1",
+		"urn:bv:a-2:updated:1749027600",
+		"urn:bv:a/3:closed:1748941200",
+		"urn:bv:a/3:created",
+		"urn:bv:a-2:created",
+		"urn:bv:a-1:created",
+	}
+	if strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Errorf("Entries = %v, want %v", ids, want)
+	}
+
+	closedEntry := feed.Entries[3]
+	if closedEntry.Link == nil || closedEntry.Link.Href != "https://acme.github.io/widgets/#/issue/a%2F3" {
+		t.Errorf("Expected deep link to the issue, got %+v", closedEntry.Link)
+	}
+	if closedEntry.Title != "Closed a/3: Shipped <fast>" {
+		t.Errorf("Title = %q", closedEntry.Title)
+	}
+	if feed.Entries[0].Link != nil {
+		t.Error("Project-wide alert should have no issue link")
+	}
+}
+
+func TestGenerateAtomFeed_LimitAndDeterminism(t *testing.T) {
+	issues := atomTestIssues()
+	a, err := GenerateAtomFeed(issues, nil, AtomFeedConfig{Title: "Widgets", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := GenerateAtomFeed(issues, nil, AtomFeedConfig{Title: "Widgets", Limit: 2})
+	if string(a) != string(b) {
+		t.Error("Feed should be identical for unchanged data")
+	}
+
+	var feed atomFeed
+	if err := xml.Unmarshal(a, &feed); err != nil {
+		t.Fatal(err)
+	}
+	if len(feed.Entries) != 2 {
+		t.Errorf("Expected limit of 2 entries, got %d", len(feed.Entries))
+	}
+	if len(feed.Links) != 0 || feed.ID != "urn:bv:feed:widgets" {
+		t.Errorf("Without BaseURL expected URN id and no links, got id=%q links=%v", feed.ID, feed.Links)
+	}
+	if feed.Entries[0].Link == nil || feed.Entries[0].Link.Href != "#/issue/a-2" {
+		t.Errorf("Expected relative issue link, got %+v", feed.Entries[0].Link)
+	}
+}
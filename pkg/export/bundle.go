@@ -0,0 +1,190 @@
+// Package export provides data export functionality for bv.
+//
+// This file implements share bundles: a static site export (issues,
+// precomputed analysis, and the HTML viewer) packed into one .tar.gz that can
+// be handed to people who should not get access to the repository.
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// BundleReadmeName is the instructions file added at the top of every bundle
+const BundleReadmeName = "README.txt"
+
+// BundleInfo describes a bundle for its README
+type BundleInfo struct {
+	Title       string
+	IssueCount  int
+	Scope       string    // Human-readable description of what was included
+	GeneratedAt time.Time // Also used as the modification time of every entry
+}
+
+// ScopeBundleIssues returns copies of issues whose dependencies only point at
+// other issues in the set, so a scoped bundle does not reveal the IDs of
+// issues it leaves out
+func ScopeBundleIssues(issues []model.Issue) []model.Issue {
+	inScope := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		inScope[issue.ID] = true
+	}
+
+	scoped := make([]model.Issue, len(issues))
+	for i, issue := range issues {
+		var deps []*model.Dependency
+		for _, dep := range issue.Dependencies {
+			if dep != nil && inScope[dep.DependsOnID] {
+				deps = append(deps, dep)
+			}
+		}
+		issue.Dependencies = deps
+		scoped[i] = issue
+	}
+	return scoped
+}
+
+// BundleDirName derives the top-level folder name inside the archive from its
+// file name, e.g. "acme-roadmap.tar.gz" -> "acme-roadmap"
+func BundleDirName(dest string) string {
+	name := filepath.Base(dest)
+	for _, ext := range []string{".tar.gz", ".tgz", ".gz", ".tar"} {
+		if strings.HasSuffix(name, ext) {
+			name = strings.TrimSuffix(name, ext)
+			break
+		}
+	}
+	if name == "" || name == "." {
+		name = "bv-bundle"
+	}
+	return name
+}
+
+// BundleReadme renders the instructions shipped inside the bundle
+func BundleReadme(info BundleInfo) string {
+	title := info.Title
+	if title == "" {
+		title = "Project Issues"
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\n%s\n\n", title, strings.Repeat("=", len([]rune(title))))
+	noun := "issues"
+	if info.IssueCount == 1 {
+		noun = "issue"
+	}
+	fmt.Fprintf(&sb, "Read-only snapshot of %d %s", info.IssueCount, noun)
+	if info.Scope != "" {
+		fmt.Fprintf(&sb, " (%s)", info.Scope)
+	}
+	if !info.GeneratedAt.IsZero() {
+		fmt.Fprintf(&sb, ", generated %s", info.GeneratedAt.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	sb.WriteString(".\n\n")
+	sb.WriteString("Viewing\n-------\n")
+	sb.WriteString("The viewer loads its database over HTTP, so serve this folder instead of\n")
+	sb.WriteString("opening index.html directly:\n\n")
+	sb.WriteString("    python3 -m http.server 8000      # then open http://localhost:8000\n")
+	sb.WriteString("    bv --preview-pages .             # if you have bv installed\n\n")
+	sb.WriteString("Contents\n--------\n")
+	sb.WriteString("    index.html, *.js, *.css, vendor/   static viewer\n")
+	sb.WriteString("    beads.sqlite3                      issues and dependencies\n")
+	sb.WriteString("    data/                              precomputed triage and graph metrics (JSON)\n")
+	sb.WriteString("    " + AtomFeedFilename + "                           recent changes (Atom)\n")
+	return sb.String()
+}
+
+// WriteBundle packs the static site in siteDir, plus a README, into a gzipped
+// tarball at dest. Entries are written in lexical order with fixed owners and
+// the GeneratedAt modification time, so the same site always yields the same
+// archive.
+func WriteBundle(siteDir, dest string, info BundleInfo) error {
+	modTime := info.GeneratedAt
+	if modTime.IsZero() {
+		modTime = time.Now()
+	}
+	root := BundleDirName(dest)
+
+	f, err := readonly.Create(dest)
+	if err != nil {
+		return fmt.Errorf("creating bundle: %w", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	gz.ModTime = modTime
+	tw := tar.NewWriter(gz)
+
+	writeHeader := func(name string, mode int64, size int64, typeflag byte) error {
+		return tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     mode,
+			Size:     size,
+			Typeflag: typeflag,
+			ModTime:  modTime,
+			Format:   tar.FormatPAX,
+		})
+	}
+
+	if err := writeHeader(root+"/", 0755, 0, tar.TypeDir); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+	readme := BundleReadme(info)
+	if err := writeHeader(root+"/"+BundleReadmeName, 0644, int64(len(readme)), tar.TypeReg); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+	if _, err := io.WriteString(tw, readme); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+
+	err = filepath.WalkDir(siteDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(siteDir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		name := root + "/" + filepath.ToSlash(rel)
+		if d.IsDir() {
+			return writeHeader(name+"/", 0755, 0, tar.TypeDir)
+		}
+		if !d.Type().IsRegular() {
+			return nil // skip symlinks and other special files
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if err := writeHeader(name, 0644, fi.Size(), tar.TypeReg); err != nil {
+			return err
+		}
+		src, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(tw, src)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("writing bundle: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestScopeBundleIssues(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "b-1", Dependencies: []*model.Dependency{
+			{IssueID: "b-1", DependsOnID: "b-2", Type: model.DepBlocks},
+			{IssueID: "b-1", DependsOnID: "secret-9", Type: model.DepBlocks},
+			nil,
+		}},
+		{ID: "b-2"},
+	}
+	scoped := ScopeBundleIssues(issues)
+	if len(scoped[0].Dependencies) != 1 || scoped[0].Dependencies[0].DependsOnID != "b-2" {
+		t.Errorf("Expected only the in-scope dependency, got %+v", scoped[0].Dependencies)
+	}
+	if len(issues[0].Dependencies) != 3 {
+		t.Error("ScopeBundleIssues must not modify its input")
+	}
+}
+
+func TestBundleDirName(t *testing.T) {
+	for in, want := range map[string]string{
+		"out/acme-roadmap.tar.gz": "acme-roadmap",
+		"share.tgz":               "share",
+		"plain":                   "plain",
+		".tar.gz":                 "bv-bundle",
+	} {
+		if got := BundleDirName(in); got != want {
+			t.Errorf("BundleDirName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func readBundle(t *testing.T, path string) map[string]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		io.Copy(&buf, tr)
+		files[hdr.Name] = buf.String()
+	}
+	return files
+}
+
+func TestWriteBundle(t *testing.T) {
+	site := t.TempDir()
+	os.MkdirAll(filepath.Join(site, "data"), 0755)
+	os.WriteFile(filepath.Join(site, "index.html"), []byte("<html></html>"), 0644)
+	os.WriteFile(filepath.Join(site, "data", "triage.json"), []byte("{}"), 0644)
+
+	out := t.TempDir()
+	info := BundleInfo{Title: "Roadmap", IssueCount: 2, Scope: "label frontend", GeneratedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	dest := filepath.Join(out, "roadmap.tar.gz")
+	if err := WriteBundle(site, dest, info); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readBundle(t, dest)
+	if files["roadmap/index.html"] != "<html></html>" || files["roadmap/data/triage.json"] != "{}" {
+		t.Errorf("Site files missing from bundle: %v", files)
+	}
+	readme := files["roadmap/"+BundleReadmeName]
+	for _, want := range []string{"Roadmap", "2 issues (label frontend)", "generated 2025-03-01", "http.server"} {
+		if !strings.Contains(readme, want) {
+			t.Errorf("Expected %q in README:\n%s", want, readme)
+		}
+	}
+
+	// Same site and timestamp produce an identical archive
+	again := filepath.Join(out, "again", "roadmap.tar.gz")
+	os.MkdirAll(filepath.Dir(again), 0755)
+	if err := WriteBundle(site, again, info); err != nil {
+		t.Fatal(err)
+	}
+	a, _ := os.ReadFile(dest)
+	b, _ := os.ReadFile(again)
+	if !bytes.Equal(a, b) {
+		t.Error("Expected byte-identical bundles for identical input")
+	}
+}
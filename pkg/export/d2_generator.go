@@ -0,0 +1,67 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// d2StatusClass names the D2 class of a status, or "" for statuses the
+// diagram defines no class for
+func d2StatusClass(status model.Status) string {
+	for _, s := range diagramStatuses {
+		if s == status {
+			return string(status)
+		}
+	}
+	return ""
+}
+
+// generateD2 creates a D2 diagram of the graph: one shape per issue,
+// classed and colored by status, and a connection from each issue to what
+// it depends on (thick red for blocks, dashed for the rest).
+func generateD2(issues []model.Issue, issueIDs map[string]bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("direction: right\n\n")
+	sb.WriteString("classes: {\n")
+	for _, status := range diagramStatuses {
+		sb.WriteString(fmt.Sprintf("  %s: {style.fill: %q}\n", d2StatusClass(status), dotStatusColor(status)))
+	}
+	sb.WriteString("  blocks: {style: {stroke: \"#E53935\"; stroke-width: 3}}\n")
+	sb.WriteString("  related: {style: {stroke: \"#999999\"; stroke-dash: 3}}\n")
+	sb.WriteString("}\n\n")
+
+	sortedIssues := sortedGraphIssues(issues)
+
+	// Nodes. Keys are quoted, so IDs with dots don't nest and any character
+	// is allowed; strconv.Quote gives the escapes D2 understands.
+	for _, i := range sortedIssues {
+		label := fmt.Sprintf("%s\n%s\nP%d %s", i.ID, truncate(i.Title, 30), i.Priority, i.Status)
+		if class := d2StatusClass(i.Status); class != "" {
+			sb.WriteString(fmt.Sprintf("%s: %s {class: %s}\n", strconv.Quote(i.ID), strconv.Quote(label), class))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", strconv.Quote(i.ID), strconv.Quote(label)))
+		}
+	}
+
+	sb.WriteString("\n")
+
+	// Edges
+	for _, i := range sortedIssues {
+		for _, dep := range sortedDependencies(i) {
+			if !issueIDs[dep.DependsOnID] {
+				continue
+			}
+			class := "related"
+			if dep.Type == model.DepBlocks {
+				class = "blocks"
+			}
+			sb.WriteString(fmt.Sprintf("%s -> %s: {class: %s}\n", strconv.Quote(i.ID), strconv.Quote(dep.DependsOnID), class))
+		}
+	}
+
+	return sb.String()
+}
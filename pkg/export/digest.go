@@ -0,0 +1,326 @@
+// Package export provides data export functionality for bv.
+//
+// This file implements the email digest: a single HTML page summarizing
+// recent backlog activity (alerts, new and closed issues, labels needing
+// attention, top triage picks), optionally delivered over SMTP.
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"mime"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DigestConfigFilename is the digest configuration file under .bv/
+const DigestConfigFilename = "digest.yaml"
+
+// DigestConfig controls digest content and delivery (.bv/digest.yaml)
+type DigestConfig struct {
+	// Days is the lookback window for new/closed issues (default 7)
+	Days int `yaml:"days,omitempty"`
+
+	// MaxItems caps each section of the digest (default 5)
+	MaxItems int `yaml:"max_items,omitempty"`
+
+	// Subject is the email subject; "{project}" is replaced with the project name
+	Subject string `yaml:"subject,omitempty"`
+
+	SMTP SMTPConfig `yaml:"smtp,omitempty"`
+}
+
+// SMTPConfig describes the mail server used by --digest-send.
+// The password is never stored in the file; it is read from PasswordEnv.
+type SMTPConfig struct {
+	Host        string   `yaml:"host"`
+	Port        int      `yaml:"port,omitempty"`         // default 587
+	Username    string   `yaml:"username,omitempty"`     // empty = no authentication
+	PasswordEnv string   `yaml:"password_env,omitempty"` // default BV_SMTP_PASSWORD
+	From        string   `yaml:"from"`
+	To          []string `yaml:"to"`
+}
+
+// DefaultDigestConfig returns the default digest settings
+func DefaultDigestConfig() DigestConfig {
+	return DigestConfig{
+		Days:     7,
+		MaxItems: 5,
+		Subject:  "Backlog digest: {project}",
+		SMTP: SMTPConfig{
+			Port:        587,
+			PasswordEnv: "BV_SMTP_PASSWORD",
+		},
+	}
+}
+
+// DigestConfigPath returns the path to .bv/digest.yaml
+func DigestConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", DigestConfigFilename)
+}
+
+// LoadDigestConfig loads .bv/digest.yaml over the defaults.
+// Returns the defaults if the file doesn't exist.
+func LoadDigestConfig(projectDir string) (DigestConfig, error) {
+	cfg := DefaultDigestConfig()
+
+	data, err := os.ReadFile(DigestConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading digest config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing digest config: %w", err)
+	}
+	if cfg.Days <= 0 {
+		cfg.Days = 7
+	}
+	if cfg.MaxItems <= 0 {
+		cfg.MaxItems = 5
+	}
+	return cfg, nil
+}
+
+// Validate checks that the SMTP settings are complete enough to send mail
+func (c SMTPConfig) Validate() error {
+	var missing []string
+	if c.Host == "" {
+		missing = append(missing, "host")
+	}
+	if c.From == "" {
+		missing = append(missing, "from")
+	}
+	if len(c.To) == 0 {
+		missing = append(missing, "to")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("smtp %s not set in %s", strings.Join(missing, ", "), filepath.Join(".bv", DigestConfigFilename))
+	}
+	return nil
+}
+
+// Digest is the content of one email digest
+type Digest struct {
+	Project     string
+	GeneratedAt time.Time // zero omits the timestamp (stable output)
+	Since       time.Time
+
+	OpenCount       int
+	InProgressCount int
+	BlockedCount    int
+	ActionableCount int
+
+	Alerts          []drift.Alert
+	NewIssues       []model.Issue
+	NewCount        int // total new issues, before MaxItems truncation
+	ClosedIssues    []model.Issue
+	ClosedCount     int // total closed issues, before MaxItems truncation
+	AttentionLabels []analysis.LabelAttentionScore
+	TopPicks        []analysis.TopPick
+}
+
+// BuildDigest selects the digest content. Alerts are ordered by severity,
+// new and closed issues by recency; every section is capped at maxItems.
+func BuildDigest(project string, issues []model.Issue, alerts []drift.Alert, attention analysis.LabelAttentionResult, triage analysis.TriageResult, days, maxItems int, now time.Time) Digest {
+	d := Digest{
+		Project:         project,
+		GeneratedAt:     now,
+		Since:           now.AddDate(0, 0, -days),
+		OpenCount:       triage.QuickRef.OpenCount,
+		InProgressCount: triage.QuickRef.InProgressCount,
+		BlockedCount:    triage.QuickRef.BlockedCount,
+		ActionableCount: triage.QuickRef.ActionableCount,
+	}
+
+	for _, issue := range issues {
+		if !issue.CreatedAt.Before(d.Since) {
+			d.NewIssues = append(d.NewIssues, issue)
+		}
+		if issue.Status == model.StatusClosed && issue.ClosedAt != nil && !issue.ClosedAt.Before(d.Since) {
+			d.ClosedIssues = append(d.ClosedIssues, issue)
+		}
+	}
+	sort.SliceStable(d.NewIssues, func(i, j int) bool {
+		if !d.NewIssues[i].CreatedAt.Equal(d.NewIssues[j].CreatedAt) {
+			return d.NewIssues[i].CreatedAt.After(d.NewIssues[j].CreatedAt)
+		}
+		return d.NewIssues[i].ID < d.NewIssues[j].ID
+	})
+	sort.SliceStable(d.ClosedIssues, func(i, j int) bool {
+		if !d.ClosedIssues[i].ClosedAt.Equal(*d.ClosedIssues[j].ClosedAt) {
+			return d.ClosedIssues[i].ClosedAt.After(*d.ClosedIssues[j].ClosedAt)
+		}
+		return d.ClosedIssues[i].ID < d.ClosedIssues[j].ID
+	})
+	d.NewCount, d.ClosedCount = len(d.NewIssues), len(d.ClosedIssues)
+
+	d.Alerts = append([]drift.Alert(nil), alerts...)
+	sort.SliceStable(d.Alerts, func(i, j int) bool {
+		return severityRank(d.Alerts[i].Severity) < severityRank(d.Alerts[j].Severity)
+	})
+
+	for _, l := range attention.Labels {
+		if l.AttentionScore > 0 {
+			d.AttentionLabels = append(d.AttentionLabels, l)
+		}
+	}
+	d.TopPicks = append([]analysis.TopPick(nil), triage.QuickRef.TopPicks...)
+
+	d.Alerts = capSlice(d.Alerts, maxItems)
+	d.NewIssues = capSlice(d.NewIssues, maxItems)
+	d.ClosedIssues = capSlice(d.ClosedIssues, maxItems)
+	d.AttentionLabels = capSlice(d.AttentionLabels, maxItems)
+	d.TopPicks = capSlice(d.TopPicks, maxItems)
+	return d
+}
+
+func severityRank(s drift.Severity) int {
+	switch s {
+	case drift.SeverityCritical:
+		return 0
+	case drift.SeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func capSlice[T any](s []T, n int) []T {
+	if n > 0 && len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// digestTemplate uses inline styles only, since most mail clients strip <style> blocks
+var digestTemplate = template.Must(template.New("digest").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("2006-01-02") },
+	"severityColor": func(s drift.Severity) string {
+		switch s {
+		case drift.SeverityCritical:
+			return "#d73a49"
+		case drift.SeverityWarning:
+			return "#e36209"
+		default:
+			return "#0366d6"
+		}
+	},
+	"score":   func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) },
+	"more":    func(total, shown int) int { return total - shown },
+	"reasons": func(r []string) string { return strings.Join(r, "; ") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Backlog digest: {{.Project}}</title></head>
+<body style="font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;color:#24292e;max-width:680px;margin:0 auto;padding:16px">
+<h1 style="font-size:22px;margin:0 0 4px">Backlog digest: {{.Project}}</h1>
+<p style="color:#6a737d;margin:0 0 16px">Since {{date .Since}}{{if not .GeneratedAt.IsZero}} &middot; generated {{.GeneratedAt.Format "2006-01-02 15:04 MST"}}{{end}}</p>
+<table style="border-collapse:collapse;margin-bottom:20px"><tr>
+<td style="padding:4px 16px 4px 0"><b>{{.OpenCount}}</b> open</td>
+<td style="padding:4px 16px 4px 0"><b>{{.InProgressCount}}</b> in progress</td>
+<td style="padding:4px 16px 4px 0"><b>{{.BlockedCount}}</b> blocked</td>
+<td style="padding:4px 16px 4px 0"><b>{{.ActionableCount}}</b> actionable</td>
+<td style="padding:4px 16px 4px 0"><b>{{.NewCount}}</b> new</td>
+<td style="padding:4px 0"><b>{{.ClosedCount}}</b> closed</td>
+</tr></table>
+
+<h2 style="font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px">Alerts</h2>
+{{if .Alerts}}<ul style="padding-left:20px">
+{{range .Alerts}}<li><b style="color:{{severityColor .Severity}}">{{.Severity}}</b> {{.Message}}{{if .IssueID}} <code>{{.IssueID}}</code>{{end}}</li>
+{{end}}</ul>{{else}}<p style="color:#6a737d">No alerts.</p>{{end}}
+
+<h2 style="font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px">Top picks</h2>
+{{if .TopPicks}}<ol style="padding-left:20px">
+{{range .TopPicks}}<li><code>{{.ID}}</code> {{.Title}} <span style="color:#6a737d">(score {{score .Score}}{{if .Unblocks}}, unblocks {{.Unblocks}}{{end}}){{if .Reasons}} &ndash; {{reasons .Reasons}}{{end}}</span></li>
+{{end}}</ol>{{else}}<p style="color:#6a737d">Nothing actionable.</p>{{end}}
+
+<h2 style="font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px">New issues</h2>
+{{if .NewIssues}}<ul style="padding-left:20px">
+{{range .NewIssues}}<li><code>{{.ID}}</code> {{.Title}} <span style="color:#6a737d">P{{.Priority}} &middot; {{date .CreatedAt}}</span></li>
+{{end}}</ul>{{if gt .NewCount (len .NewIssues)}}<p style="color:#6a737d">and {{more .NewCount (len .NewIssues)}} more</p>{{end}}{{else}}<p style="color:#6a737d">No new issues.</p>{{end}}
+
+<h2 style="font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px">Closed issues</h2>
+{{if .ClosedIssues}}<ul style="padding-left:20px">
+{{range .ClosedIssues}}<li><code>{{.ID}}</code> {{.Title}}{{if .ClosedAt}} <span style="color:#6a737d">{{date .ClosedAt}}</span>{{end}}</li>
+{{end}}</ul>{{if gt .ClosedCount (len .ClosedIssues)}}<p style="color:#6a737d">and {{more .ClosedCount (len .ClosedIssues)}} more</p>{{end}}{{else}}<p style="color:#6a737d">No issues closed.</p>{{end}}
+
+<h2 style="font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px">Labels needing attention</h2>
+{{if .AttentionLabels}}<table style="border-collapse:collapse">
+<tr><th style="text-align:left;padding:4px 12px 4px 0">Label</th><th style="text-align:right;padding:4px 12px">Open</th><th style="text-align:right;padding:4px 12px">Blocked</th><th style="text-align:right;padding:4px 0">Attention</th></tr>
+{{range .AttentionLabels}}<tr><td style="padding:4px 12px 4px 0">{{.Label}}</td><td style="text-align:right;padding:4px 12px">{{.OpenCount}}</td><td style="text-align:right;padding:4px 12px">{{.BlockedCount}}</td><td style="text-align:right;padding:4px 0">{{score .NormalizedScore}}</td></tr>
+{{end}}</table>{{else}}<p style="color:#6a737d">No labels need attention.</p>{{end}}
+
+<p style="color:#6a737d;font-size:12px;margin-top:24px">Generated by bv (beads viewer).</p>
+</body>
+</html>
+`))
+
+// GenerateDigestHTML renders the digest as a self-contained HTML email body
+func GenerateDigestHTML(d Digest) (string, error) {
+	var buf bytes.Buffer
+	if err := digestTemplate.Execute(&buf, d); err != nil {
+		return "", fmt.Errorf("rendering digest: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// DigestSubject expands "{project}" in the configured subject
+func (c DigestConfig) DigestSubject(project string) string {
+	return strings.ReplaceAll(c.Subject, "{project}", project)
+}
+
+// smtpSendMail is swapped out in tests
+var smtpSendMail = smtp.SendMail
+
+// SendDigest delivers an HTML body to the configured recipients.
+// Authentication is used only when a username is configured.
+func SendDigest(cfg SMTPConfig, subject, body string) error {
+	if err := readonly.Check("sending digest"); err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		password := os.Getenv(cfg.PasswordEnv)
+		if password == "" {
+			return fmt.Errorf("smtp username set but $%s is empty", cfg.PasswordEnv)
+		}
+		auth = smtp.PlainAuth("", cfg.Username, password, cfg.Host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(cfg.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	addr := fmt.Sprintf("%s:%d", cfg.Host, port)
+	if err := smtpSendMail(addr, auth, cfg.From, cfg.To, msg.Bytes()); err != nil {
+		return fmt.Errorf("sending digest via %s: %w", addr, err)
+	}
+	return nil
+}
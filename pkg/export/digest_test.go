@@ -0,0 +1,162 @@
+package export
+
+import (
+	"errors"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+func digestTestIssues(now time.Time) []model.Issue {
+	recent := now.Add(-24 * time.Hour)
+	old := now.AddDate(0, -2, 0)
+	return []model.Issue{
+		{ID: "d-1", Title: "Old open", Status: model.StatusOpen, CreatedAt: old},
+		{ID: "d-2", Title: "Fresh <b>bug</b>", Status: model.StatusOpen, CreatedAt: recent},
+		{ID: "d-3", Title: "Fresher", Status: model.StatusOpen, CreatedAt: recent.Add(time.Hour)},
+		{ID: "d-4", Title: "Shipped", Status: model.StatusClosed, CreatedAt: old, ClosedAt: &recent},
+		{ID: "d-5", Title: "Long closed", Status: model.StatusClosed, CreatedAt: old, ClosedAt: &old},
+	}
+}
+
+func TestBuildDigest_SelectsRecentActivity(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	alerts := []drift.Alert{
+		{Severity: drift.SeverityInfo, Message: "info"},
+		{Severity: drift.SeverityCritical, Message: "critical"},
+		{Severity: drift.SeverityWarning, Message: "warning"},
+	}
+	attention := analysis.LabelAttentionResult{Labels: []analysis.LabelAttentionScore{
+		{Label: "api", AttentionScore: 2},
+		{Label: "docs", AttentionScore: 0},
+	}}
+	triage := analysis.TriageResult{QuickRef: analysis.QuickRef{OpenCount: 3, TopPicks: []analysis.TopPick{{ID: "d-1"}, {ID: "d-2"}}}}
+
+	d := BuildDigest("proj", digestTestIssues(now), alerts, attention, triage, 7, 2, now)
+
+	if !d.Since.Equal(now.AddDate(0, 0, -7)) {
+		t.Errorf("Since = %v", d.Since)
+	}
+	if d.NewCount != 2 || len(d.NewIssues) != 2 || d.NewIssues[0].ID != "d-3" {
+		t.Errorf("Expected new issues d-3, d-2 (newest first), got %+v", d.NewIssues)
+	}
+	if d.ClosedCount != 1 || d.ClosedIssues[0].ID != "d-4" {
+		t.Errorf("Expected only d-4 closed in window, got %+v", d.ClosedIssues)
+	}
+	if len(d.Alerts) != 2 || d.Alerts[0].Message != "critical" || d.Alerts[1].Message != "warning" {
+		t.Errorf("Expected alerts by severity capped at 2, got %+v", d.Alerts)
+	}
+	if len(d.AttentionLabels) != 1 || d.AttentionLabels[0].Label != "api" {
+		t.Errorf("Expected only labels with attention, got %+v", d.AttentionLabels)
+	}
+	if d.OpenCount != 3 || len(d.TopPicks) != 2 {
+		t.Errorf("Expected triage summary carried over, got open=%d picks=%d", d.OpenCount, len(d.TopPicks))
+	}
+}
+
+func TestGenerateDigestHTML(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	d := BuildDigest("proj", digestTestIssues(now), nil, analysis.LabelAttentionResult{}, analysis.TriageResult{}, 7, 1, now)
+
+	html, err := GenerateDigestHTML(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Backlog digest: proj", "Since 2025-06-08", "generated 2025-06-15", "d-3", "and 1 more", "No alerts.", "Shipped"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected %q in digest HTML", want)
+		}
+	}
+	if strings.Contains(html, "<b>bug</b>") {
+		t.Error("Issue titles must be HTML-escaped")
+	}
+
+	d.GeneratedAt = time.Time{}
+	html, _ = GenerateDigestHTML(d)
+	if strings.Contains(html, "generated") {
+		t.Error("Zero GeneratedAt should omit the timestamp")
+	}
+}
+
+func TestLoadDigestConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadDigestConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Days != 7 || cfg.MaxItems != 5 || cfg.SMTP.Port != 587 {
+		t.Errorf("Unexpected defaults: %+v", cfg)
+	}
+
+	os.MkdirAll(filepath.Join(dir, ".bv"), 0755)
+	yaml := "days: 14\nsubject: \"Weekly {project}\"\nsmtp:\n  host: mail.example.com\n  from: bv@example.com\n  to: [team@example.com]\n"
+	if err := os.WriteFile(DigestConfigPath(dir), []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadDigestConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Days != 14 || cfg.MaxItems != 5 || cfg.SMTP.Port != 587 || cfg.SMTP.PasswordEnv != "BV_SMTP_PASSWORD" {
+		t.Errorf("Expected file values over defaults, got %+v", cfg)
+	}
+	if got := cfg.DigestSubject("api"); got != "Weekly api" {
+		t.Errorf("DigestSubject = %q", got)
+	}
+	if err := cfg.SMTP.Validate(); err != nil {
+		t.Errorf("Expected valid SMTP config: %v", err)
+	}
+}
+
+func TestSendDigest(t *testing.T) {
+	orig := smtpSendMail
+	defer func() { smtpSendMail = orig }()
+
+	var gotAddr string
+	var gotAuth smtp.Auth
+	var gotMsg []byte
+	smtpSendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotAuth, gotMsg = addr, a, msg
+		return nil
+	}
+
+	cfg := SMTPConfig{Host: "mail.example.com", From: "bv@example.com", To: []string{"a@example.com", "b@example.com"}}
+	if err := SendDigest(cfg, "Digest", "<p>hi</p>\n"); err != nil {
+		t.Fatal(err)
+	}
+	if gotAddr != "mail.example.com:587" || gotAuth != nil {
+		t.Errorf("addr=%q auth=%v", gotAddr, gotAuth)
+	}
+	msg := string(gotMsg)
+	for _, want := range []string{"To: a@example.com, b@example.com\r\n", "Subject: Digest\r\n", "Content-Type: text/html; charset=UTF-8\r\n", "<p>hi</p>\r\n"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in message:\n%s", want, msg)
+		}
+	}
+
+	cfg.Username = "bv"
+	cfg.PasswordEnv = "BV_TEST_SMTP_PASSWORD_UNSET"
+	if err := SendDigest(cfg, "Digest", "x"); err == nil || !strings.Contains(err.Error(), "BV_TEST_SMTP_PASSWORD_UNSET") {
+		t.Errorf("Expected missing password error, got %v", err)
+	}
+
+	if err := SendDigest(SMTPConfig{}, "Digest", "x"); err == nil {
+		t.Error("Expected validation error for empty config")
+	}
+
+	readonly.Set(true)
+	defer readonly.Set(false)
+	gotMsg = nil
+	cfg.Username = ""
+	if err := SendDigest(cfg, "Digest", "x"); !errors.Is(err, readonly.ErrReadOnly) || gotMsg != nil {
+		t.Errorf("Expected no mail in read-only mode, got %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+// Package export renders a beads backlog and its analysis in other formats:
+// Markdown reports, Mermaid and DOT graphs, SQLite databases, Atom feeds,
+// share bundles, and GitHub Issues sync. It depends on pkg/model and
+// pkg/analysis but not on the TUI.
+//
+// # Entry points
+//
+//   - GenerateMarkdown / SaveMarkdownToFileWithOptions: the Markdown report
+//     behind bv --export-md.
+//   - ExportGraph and SaveGraphSnapshot: the dependency graph as JSON, DOT,
+//     or Mermaid text, or as an SVG/PNG image.
+//   - NewSQLiteExporter: the SQLite database used by the static site.
+//   - GenerateAtomFeed, BuildDigest, and GeneratePriorityBrief: feeds and
+//     summaries of backlog changes.
+//   - WriteBundle: a static site snapshot packed into one archive.
+//   - PlanGitHubPush / ApplyGitHubPush: two-way sync with GitHub Issues.
+//
+// # Stability
+//
+// The identifiers above, their option and config types, and the files they
+// write are the public API. From v0.11.0 on they only change incompatibly
+// with a major version bump. The interactive Pages deployment wizard is
+// part of the bv binary and lives in internal/pages.
+package export
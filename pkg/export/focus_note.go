@@ -0,0 +1,56 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// GenerateFocusNote renders a weekly focus rotation as a Markdown planning
+// note: one section per focus label with a checklist of issues to start with
+func GenerateFocusNote(project string, r analysis.FocusRotation) string {
+	var sb strings.Builder
+
+	title := "Weekly Focus"
+	if project != "" {
+		title += ": " + project
+	}
+	sb.WriteString(fmt.Sprintf("# 🎯 %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("**Week:** %s (from %s)\n\n", r.Week, r.WeekStart.Format("2006-01-02")))
+
+	if len(r.Picks) == 0 {
+		sb.WriteString("No label has open work needing attention this week.\n")
+		return sb.String()
+	}
+
+	for i, pick := range r.Picks {
+		sb.WriteString(fmt.Sprintf("## %d. `%s`\n\n", i+1, pick.Label))
+		sb.WriteString(fmt.Sprintf("Attention rank #%d — %s", pick.AttentionRank, pick.Reason))
+		if pick.LastFocused != "" {
+			sb.WriteString(fmt.Sprintf(" (last focus %s)", pick.LastFocused))
+		}
+		sb.WriteString("\n\n")
+
+		if len(pick.StartWith) == 0 {
+			sb.WriteString("*Everything open here is blocked; clear its blockers first.*\n\n")
+			continue
+		}
+		sb.WriteString("Start with:\n\n")
+		for _, issue := range pick.StartWith {
+			sb.WriteString(fmt.Sprintf("- [ ] **%s** %s — %s\n", issue.ID, issue.Title, issue.Reason))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(r.Skipped) > 0 {
+		var skipped []string
+		for _, s := range r.Skipped {
+			skipped = append(skipped, fmt.Sprintf("`%s` (%s)", s.Label, s.LastFocused))
+		}
+		sb.WriteString("---\n\n")
+		sb.WriteString(fmt.Sprintf("*Rotated out after recent focus: %s.*\n", strings.Join(skipped, ", ")))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,43 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestGenerateFocusNote(t *testing.T) {
+	r := analysis.FocusRotation{
+		Week:      "2025-W23",
+		WeekStart: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		Picks: []analysis.FocusPick{
+			{Label: "api", AttentionRank: 1, Reason: "2 blocked", LastFocused: "2025-W19",
+				StartWith: []analysis.FocusIssue{{ID: "api-1", Title: "Fix auth", Reason: "unblocks 2, P1"}}},
+			{Label: "ui", AttentionRank: 3, Reason: "low velocity"},
+		},
+		Skipped: []analysis.FocusSkip{{Label: "db", AttentionRank: 2, LastFocused: "2025-W22"}},
+	}
+
+	note := GenerateFocusNote("demo", r)
+	for _, want := range []string{
+		"# 🎯 Weekly Focus: demo",
+		"**Week:** 2025-W23 (from 2025-06-02)",
+		"## 1. `api`",
+		"(last focus 2025-W19)",
+		"- [ ] **api-1** Fix auth — unblocks 2, P1",
+		"## 2. `ui`",
+		"clear its blockers first",
+		"`db` (2025-W22)",
+	} {
+		if !strings.Contains(note, want) {
+			t.Errorf("note missing %q:\n%s", want, note)
+		}
+	}
+
+	empty := GenerateFocusNote("", analysis.FocusRotation{Week: "2025-W23"})
+	if !strings.Contains(empty, "# 🎯 Weekly Focus\n") || !strings.Contains(empty, "No label has open work") {
+		t.Errorf("unexpected empty note:\n%s", empty)
+	}
+}
@@ -0,0 +1,552 @@
+// Package export provides data export functionality for bv.
+//
+// This file implements a one-way push of beads to GitHub issues (and
+// optionally a GitHub Project) through the gh CLI. Every pushed issue carries
+// a hidden marker with its bead ID, so later pushes update the same issue
+// instead of creating duplicates. Nothing is ever read back into beads.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GitHubIssuesConfigFilename is the push configuration file under .bv/
+const GitHubIssuesConfigFilename = "github.yaml"
+
+// GitHubIssuesConfig maps beads onto GitHub issues (.bv/github.yaml)
+type GitHubIssuesConfig struct {
+	// Repo is the target repository as owner/name
+	Repo string `yaml:"repo"`
+
+	// Project is an optional GitHub Projects (v2) number; pushed issues are added to it
+	Project int `yaml:"project,omitempty"`
+
+	// ProjectOwner owns the project (user or org); defaults to the repo owner
+	ProjectOwner string `yaml:"project_owner,omitempty"`
+
+	// Labels renames bead labels on GitHub; map a label to "" to leave it out.
+	// Unmapped bead labels are pushed unchanged.
+	Labels map[string]string `yaml:"labels,omitempty"`
+
+	// PriorityLabels maps bead priority (0-4) to a GitHub label; "" for none
+	PriorityLabels map[int]string `yaml:"priority_labels,omitempty"`
+
+	// TypeLabels maps bead issue type to a GitHub label; "" for none
+	TypeLabels map[string]string `yaml:"type_labels,omitempty"`
+
+	// ExtraLabels are added to every pushed issue (e.g. "from-beads")
+	ExtraLabels []string `yaml:"extra_labels,omitempty"`
+
+	// IncludeClosed also creates issues for beads that are already closed.
+	// Closed beads that were pushed earlier are always closed on GitHub.
+	IncludeClosed bool `yaml:"include_closed,omitempty"`
+}
+
+// DefaultGitHubIssuesConfig returns the default label mapping
+func DefaultGitHubIssuesConfig() GitHubIssuesConfig {
+	return GitHubIssuesConfig{
+		PriorityLabels: map[int]string{0: "P0", 1: "P1", 2: "P2", 3: "P3", 4: "P4"},
+		TypeLabels:     map[string]string{"bug": "bug", "feature": "enhancement"},
+	}
+}
+
+// GitHubIssuesConfigPath returns the path to .bv/github.yaml
+func GitHubIssuesConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", GitHubIssuesConfigFilename)
+}
+
+// LoadGitHubIssuesConfig loads .bv/github.yaml over the defaults.
+// Returns the defaults if the file doesn't exist.
+func LoadGitHubIssuesConfig(projectDir string) (GitHubIssuesConfig, error) {
+	cfg := DefaultGitHubIssuesConfig()
+
+	data, err := os.ReadFile(GitHubIssuesConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading GitHub config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing GitHub config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that the config names a target repository
+func (c GitHubIssuesConfig) Validate() error {
+	parts := strings.Split(c.Repo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("repo must be owner/name (got %q); set it in %s or pass --github-repo", c.Repo, filepath.Join(".bv", GitHubIssuesConfigFilename))
+	}
+	return nil
+}
+
+// LabelsFor returns the sorted GitHub labels for a bead
+func (c GitHubIssuesConfig) LabelsFor(issue model.Issue) []string {
+	set := make(map[string]bool)
+	if l := c.TypeLabels[string(issue.IssueType)]; l != "" {
+		set[l] = true
+	}
+	if l := c.PriorityLabels[issue.Priority]; l != "" {
+		set[l] = true
+	}
+	for _, label := range issue.Labels {
+		if mapped, ok := c.Labels[label]; ok {
+			label = mapped
+		}
+		if label != "" {
+			set[label] = true
+		}
+	}
+	for _, l := range c.ExtraLabels {
+		if l != "" {
+			set[l] = true
+		}
+	}
+	return sortedKeys(set)
+}
+
+// managedLabels returns every label a push could set for these issues.
+// Only managed labels are removed from GitHub issues; labels added by hand stay.
+func (c GitHubIssuesConfig) managedLabels(issues []model.Issue) map[string]bool {
+	managed := make(map[string]bool)
+	for _, l := range c.PriorityLabels {
+		managed[l] = true
+	}
+	for _, l := range c.TypeLabels {
+		managed[l] = true
+	}
+	for _, l := range c.Labels {
+		managed[l] = true
+	}
+	for _, l := range c.ExtraLabels {
+		managed[l] = true
+	}
+	for _, issue := range issues {
+		for _, l := range c.LabelsFor(issue) {
+			managed[l] = true
+		}
+	}
+	delete(managed, "")
+	return managed
+}
+
+// beadMarkerPattern finds the hidden bead ID marker in an issue body
+var beadMarkerPattern = regexp.MustCompile(`<!-- bv:bead=(\S+) -->`)
+
+// beadMarker returns the hidden marker identifying a bead in a GitHub issue body
+func beadMarker(id string) string {
+	return fmt.Sprintf("<!-- bv:bead=%s -->", id)
+}
+
+// GitHubRemoteIssue is an existing GitHub issue as reported by gh
+type GitHubRemoteIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	State  string `json:"state"`
+	URL    string `json:"url"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+}
+
+// BeadID returns the bead this issue was pushed from, or "" if it has no marker
+func (r GitHubRemoteIssue) BeadID() string {
+	if m := beadMarkerPattern.FindStringSubmatch(r.Body); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// GitHubIssueBody renders the GitHub issue body for a bead. numbers maps bead
+// IDs to known GitHub issue numbers so dependencies become cross-references.
+func GitHubIssueBody(issue model.Issue, numbers map[string]int) string {
+	var sb strings.Builder
+	if desc := strings.TrimSpace(issue.Description); desc != "" {
+		sb.WriteString(desc + "\n\n")
+	}
+	if design := strings.TrimSpace(issue.Design); design != "" {
+		sb.WriteString("## Design\n\n" + design + "\n\n")
+	}
+	if ac := strings.TrimSpace(issue.AcceptanceCriteria); ac != "" {
+		sb.WriteString("## Acceptance Criteria\n\n" + ac + "\n\n")
+	}
+
+	var deps []string
+	for _, dep := range issue.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if n, ok := numbers[dep.DependsOnID]; ok {
+			deps = append(deps, fmt.Sprintf("#%d (`%s`)", n, dep.DependsOnID))
+		} else {
+			deps = append(deps, fmt.Sprintf("`%s`", dep.DependsOnID))
+		}
+	}
+	if len(deps) > 0 {
+		sb.WriteString("**Depends on:** " + strings.Join(deps, ", ") + "\n\n")
+	}
+
+	sb.WriteString("---\n")
+	sb.WriteString(fmt.Sprintf("_Pushed from bead `%s` by bv. Edits made on GitHub are overwritten by the next push._\n", issue.ID))
+	sb.WriteString(beadMarker(issue.ID) + "\n")
+	return sb.String()
+}
+
+// GitHub push actions
+const (
+	GitHubActionCreate    = "create"
+	GitHubActionUpdate    = "update"
+	GitHubActionUnchanged = "unchanged"
+)
+
+// GitHubPushItem is the planned (and, after apply, performed) change for one bead
+type GitHubPushItem struct {
+	BeadID       string   `json:"bead_id"`
+	Action       string   `json:"action"`
+	Number       int      `json:"number,omitempty"`
+	URL          string   `json:"url,omitempty"`
+	Title        string   `json:"title"`
+	Body         string   `json:"-"`
+	Labels       []string `json:"labels"`
+	AddLabels    []string `json:"add_labels,omitempty"`
+	RemoveLabels []string `json:"remove_labels,omitempty"`
+	State        string   `json:"state"`             // Desired state: "open" or "closed"
+	Changes      []string `json:"changes,omitempty"` // Human-readable summary for updates
+}
+
+// GitHubPushPlan lists what a push would do, in bead ID order
+type GitHubPushPlan struct {
+	Repo    string           `json:"repo"`
+	Items   []GitHubPushItem `json:"items"`
+	Skipped int              `json:"skipped_closed"` // Closed beads never pushed (include_closed: false)
+}
+
+// Count returns the number of items with the given action
+func (p GitHubPushPlan) Count(action string) int {
+	n := 0
+	for _, item := range p.Items {
+		if item.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// PlanGitHubPush diffs beads against existing GitHub issues (matched by bead marker)
+func PlanGitHubPush(issues []model.Issue, remote []GitHubRemoteIssue, cfg GitHubIssuesConfig) GitHubPushPlan {
+	plan := GitHubPushPlan{Repo: cfg.Repo, Items: []GitHubPushItem{}}
+
+	byBead := make(map[string]GitHubRemoteIssue)
+	numbers := make(map[string]int)
+	for _, r := range remote {
+		if id := r.BeadID(); id != "" {
+			if _, dup := byBead[id]; !dup || r.Number < byBead[id].Number {
+				byBead[id] = r
+				numbers[id] = r.Number
+			}
+		}
+	}
+	managed := cfg.managedLabels(issues)
+
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	for _, issue := range sorted {
+		item := GitHubPushItem{
+			BeadID: issue.ID,
+			Title:  issue.Title,
+			Body:   GitHubIssueBody(issue, numbers),
+			Labels: cfg.LabelsFor(issue),
+			State:  "open",
+		}
+		if issue.Status == model.StatusClosed {
+			item.State = "closed"
+		}
+
+		existing, ok := byBead[issue.ID]
+		if !ok {
+			if item.State == "closed" && !cfg.IncludeClosed {
+				plan.Skipped++
+				continue
+			}
+			item.Action = GitHubActionCreate
+			item.AddLabels = item.Labels
+			plan.Items = append(plan.Items, item)
+			continue
+		}
+
+		item.Number = existing.Number
+		item.URL = existing.URL
+		if existing.Title != item.Title {
+			item.Changes = append(item.Changes, "title")
+		}
+		if strings.TrimSpace(existing.Body) != strings.TrimSpace(item.Body) {
+			item.Changes = append(item.Changes, "body")
+		}
+
+		current := make(map[string]bool)
+		for _, l := range existing.Labels {
+			current[l.Name] = true
+		}
+		desired := make(map[string]bool)
+		for _, l := range item.Labels {
+			desired[l] = true
+			if !current[l] {
+				item.AddLabels = append(item.AddLabels, l)
+			}
+		}
+		for l := range current {
+			if managed[l] && !desired[l] {
+				item.RemoveLabels = append(item.RemoveLabels, l)
+			}
+		}
+		sort.Strings(item.RemoveLabels)
+		if len(item.AddLabels) > 0 || len(item.RemoveLabels) > 0 {
+			item.Changes = append(item.Changes, "labels")
+		}
+		if !strings.EqualFold(existing.State, item.State) {
+			item.Changes = append(item.Changes, "state → "+item.State)
+		}
+
+		item.Action = GitHubActionUnchanged
+		if len(item.Changes) > 0 {
+			item.Action = GitHubActionUpdate
+		}
+		plan.Items = append(plan.Items, item)
+	}
+	return plan
+}
+
+// FormatGitHubPushPlan renders a plan as a human-readable preview (used for dry runs)
+func FormatGitHubPushPlan(plan GitHubPushPlan) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("GitHub push to %s: %d to create, %d to update, %d unchanged",
+		plan.Repo, plan.Count(GitHubActionCreate), plan.Count(GitHubActionUpdate), plan.Count(GitHubActionUnchanged)))
+	if plan.Skipped > 0 {
+		sb.WriteString(fmt.Sprintf(", %d closed beads skipped", plan.Skipped))
+	}
+	sb.WriteString("\n")
+
+	for _, item := range plan.Items {
+		switch item.Action {
+		case GitHubActionCreate:
+			sb.WriteString(fmt.Sprintf("  + %-12s %s", item.BeadID, item.Title))
+			if len(item.Labels) > 0 {
+				sb.WriteString(fmt.Sprintf("  [%s]", strings.Join(item.Labels, ", ")))
+			}
+			if item.State == "closed" {
+				sb.WriteString("  (closed)")
+			}
+		case GitHubActionUpdate:
+			sb.WriteString(fmt.Sprintf("  ~ %-12s #%d %s  (%s)", item.BeadID, item.Number, item.Title, strings.Join(item.Changes, ", ")))
+			if len(item.AddLabels) > 0 {
+				sb.WriteString(fmt.Sprintf(" +[%s]", strings.Join(item.AddLabels, ", ")))
+			}
+			if len(item.RemoveLabels) > 0 {
+				sb.WriteString(fmt.Sprintf(" -[%s]", strings.Join(item.RemoveLabels, ", ")))
+			}
+		default:
+			continue
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// runGH executes gh with optional stdin. It is a variable so tests can stub it.
+var runGH = func(stdin string, args ...string) ([]byte, error) {
+	cmd := exec.Command("gh", args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		name := strings.Join(args[:min(len(args), 2)], " ")
+		if detail := strings.TrimSpace(string(out)); detail != "" {
+			return out, fmt.Errorf("gh %s: %w: %s", name, err, detail)
+		}
+		return out, fmt.Errorf("gh %s: %w", name, err)
+	}
+	return out, nil
+}
+
+// githubIssuesJQ turns each page of the REST issues listing into one issue
+// per line, dropping pull requests (the endpoint returns both)
+const githubIssuesJQ = `.[] | select(.pull_request == null) | {number, title, body: (.body // ""), state, url: .html_url, labels: [.labels[] | {name}]}`
+
+// FetchGitHubIssues lists all of the repository's issues (open and closed)
+// via gh, following every page so no pushed issue is missed and duplicated
+func FetchGitHubIssues(repo string) ([]GitHubRemoteIssue, error) {
+	out, err := runGH("", "api", "--paginate", "repos/"+repo+"/issues?state=all&per_page=100", "--jq", githubIssuesJQ)
+	if err != nil {
+		return nil, err
+	}
+	var remote []GitHubRemoteIssue
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var issue GitHubRemoteIssue
+		if err := dec.Decode(&issue); err != nil {
+			return nil, fmt.Errorf("parsing gh api issues output: %w", err)
+		}
+		remote = append(remote, issue)
+	}
+	return remote, nil
+}
+
+// ApplyGitHubPush performs the plan: creates missing labels, creates and edits
+// issues, syncs open/closed state, and adds issues to the configured project.
+// Items are updated in place with issue numbers and URLs. logf reports progress.
+func ApplyGitHubPush(plan *GitHubPushPlan, cfg GitHubIssuesConfig, logf func(format string, args ...interface{})) error {
+	if err := readonly.Check("pushing to GitHub"); err != nil {
+		return err
+	}
+	if logf == nil {
+		logf = func(string, ...interface{}) {}
+	}
+	if err := ensureGitHubLabels(plan, cfg.Repo, logf); err != nil {
+		return err
+	}
+
+	projectOwner := cfg.ProjectOwner
+	if projectOwner == "" {
+		projectOwner = strings.SplitN(cfg.Repo, "/", 2)[0]
+	}
+
+	for i := range plan.Items {
+		item := &plan.Items[i]
+		switch item.Action {
+		case GitHubActionCreate:
+			args := []string{"issue", "create", "--repo", cfg.Repo, "--title", item.Title, "--body-file", "-"}
+			for _, l := range item.Labels {
+				args = append(args, "--label", l)
+			}
+			out, err := runGH(item.Body, args...)
+			if err != nil {
+				return fmt.Errorf("creating issue for %s: %w", item.BeadID, err)
+			}
+			item.URL, item.Number = parseIssueURL(string(out))
+			logf("created #%d for %s", item.Number, item.BeadID)
+			if item.State == "closed" && item.Number > 0 {
+				if _, err := runGH("", "issue", "close", strconv.Itoa(item.Number), "--repo", cfg.Repo); err != nil {
+					return fmt.Errorf("closing #%d: %w", item.Number, err)
+				}
+			}
+
+		case GitHubActionUpdate:
+			num := strconv.Itoa(item.Number)
+			args := []string{"issue", "edit", num, "--repo", cfg.Repo, "--title", item.Title, "--body-file", "-"}
+			for _, l := range item.AddLabels {
+				args = append(args, "--add-label", l)
+			}
+			for _, l := range item.RemoveLabels {
+				args = append(args, "--remove-label", l)
+			}
+			if _, err := runGH(item.Body, args...); err != nil {
+				return fmt.Errorf("updating #%d for %s: %w", item.Number, item.BeadID, err)
+			}
+			for _, change := range item.Changes {
+				if !strings.HasPrefix(change, "state") {
+					continue
+				}
+				verb := "reopen"
+				if item.State == "closed" {
+					verb = "close"
+				}
+				if _, err := runGH("", "issue", verb, num, "--repo", cfg.Repo); err != nil {
+					return fmt.Errorf("%s #%d: %w", verb, item.Number, err)
+				}
+			}
+			logf("updated #%d for %s (%s)", item.Number, item.BeadID, strings.Join(item.Changes, ", "))
+
+		default:
+			continue
+		}
+
+		if cfg.Project > 0 && item.URL != "" {
+			if _, err := runGH("", "project", "item-add", strconv.Itoa(cfg.Project), "--owner", projectOwner, "--url", item.URL); err != nil {
+				return fmt.Errorf("adding #%d to project %d: %w", item.Number, cfg.Project, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ensureGitHubLabels creates any label the plan adds that the repo lacks
+func ensureGitHubLabels(plan *GitHubPushPlan, repo string, logf func(string, ...interface{})) error {
+	needed := make(map[string]bool)
+	for _, item := range plan.Items {
+		for _, l := range item.AddLabels {
+			needed[l] = true
+		}
+	}
+	if len(needed) == 0 {
+		return nil
+	}
+
+	out, err := runGH("", "label", "list", "--repo", repo, "--limit", "1000", "--json", "name")
+	if err != nil {
+		return fmt.Errorf("listing labels: %w", err)
+	}
+	var existing []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(out, &existing); err != nil {
+		return fmt.Errorf("parsing gh label list output: %w", err)
+	}
+	for _, l := range existing {
+		delete(needed, l.Name)
+		// GitHub label names are case-insensitive
+		for n := range needed {
+			if strings.EqualFold(n, l.Name) {
+				delete(needed, n)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(needed) {
+		if _, err := runGH("", "label", "create", name, "--repo", repo); err != nil {
+			return fmt.Errorf("creating label %q: %w", name, err)
+		}
+		logf("created label %q", name)
+	}
+	return nil
+}
+
+// parseIssueURL extracts the issue URL and number from gh issue create output
+func parseIssueURL(out string) (string, int) {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	url := strings.TrimSpace(lines[len(lines)-1])
+	idx := strings.LastIndex(url, "/")
+	if idx < 0 {
+		return url, 0
+	}
+	n, _ := strconv.Atoi(url[idx+1:])
+	return url, n
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,196 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func githubTestConfig() GitHubIssuesConfig {
+	cfg := DefaultGitHubIssuesConfig()
+	cfg.Repo = "acme/widgets"
+	cfg.Labels = map[string]string{"backend": "area: backend", "internal": ""}
+	cfg.ExtraLabels = []string{"from-beads"}
+	return cfg
+}
+
+func remoteIssue(number int, beadID, title, body, state string, labels ...string) GitHubRemoteIssue {
+	r := GitHubRemoteIssue{Number: number, Title: title, Body: body, State: state,
+		URL: fmt.Sprintf("https://github.com/acme/widgets/issues/%d", number)}
+	if beadID != "" && !strings.Contains(body, "bv:bead=") {
+		r.Body += "\n" + beadMarker(beadID)
+	}
+	for _, l := range labels {
+		r.Labels = append(r.Labels, struct {
+			Name string `json:"name"`
+		}{l})
+	}
+	return r
+}
+
+func TestGitHubIssuesConfig_LabelsFor(t *testing.T) {
+	cfg := githubTestConfig()
+	issue := model.Issue{ID: "w-1", Priority: 1, IssueType: model.TypeFeature, Labels: []string{"backend", "internal", "ux"}}
+	want := []string{"P1", "area: backend", "enhancement", "from-beads", "ux"}
+	if got := cfg.LabelsFor(issue); !reflect.DeepEqual(got, want) {
+		t.Errorf("LabelsFor = %v, want %v", got, want)
+	}
+}
+
+func TestLoadGitHubIssuesConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadGitHubIssuesConfig(dir)
+	if err != nil || cfg.PriorityLabels[0] != "P0" {
+		t.Fatalf("Expected defaults without a config file, got %+v (err %v)", cfg, err)
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected validation error without repo")
+	}
+
+	os.MkdirAll(filepath.Join(dir, ".bv"), 0755)
+	yaml := "repo: acme/widgets\nproject: 4\npriority_labels:\n  0: critical\n"
+	if err := os.WriteFile(GitHubIssuesConfigPath(dir), []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadGitHubIssuesConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadGitHubIssuesConfig: %v", err)
+	}
+	if cfg.Repo != "acme/widgets" || cfg.Project != 4 || cfg.PriorityLabels[0] != "critical" || cfg.PriorityLabels[1] != "P1" {
+		t.Errorf("Config should override defaults key by key: %+v", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+}
+
+func TestPlanGitHubPush(t *testing.T) {
+	cfg := githubTestConfig()
+	issues := []model.Issue{
+		{ID: "w-1", Title: "New thing", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{DependsOnID: "w-2", Type: model.DepBlocks}}},
+		{ID: "w-2", Title: "Renamed", Status: model.StatusClosed, Priority: 0, IssueType: model.TypeBug},
+		{ID: "w-3", Title: "Same", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask},
+		{ID: "w-4", Title: "Old and closed", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeTask},
+	}
+	same := issues[2]
+	remote := []GitHubRemoteIssue{
+		remoteIssue(7, "w-2", "Original", "old body", "OPEN", "P1", "bug", "from-beads", "needs-triage"),
+		remoteIssue(8, "w-3", "Same", GitHubIssueBody(same, nil), "OPEN", "P3", "from-beads"),
+		remoteIssue(9, "", "Unrelated", "no marker", "OPEN"),
+	}
+
+	plan := PlanGitHubPush(issues, remote, cfg)
+	if plan.Skipped != 1 || len(plan.Items) != 3 {
+		t.Fatalf("Expected 3 items and 1 skipped closed bead, got %d items, %d skipped", len(plan.Items), plan.Skipped)
+	}
+
+	create := plan.Items[0]
+	if create.BeadID != "w-1" || create.Action != GitHubActionCreate {
+		t.Fatalf("Expected create for w-1, got %+v", create)
+	}
+	if !strings.Contains(create.Body, "#7 (`w-2`)") || !strings.Contains(create.Body, beadMarker("w-1")) {
+		t.Errorf("Body should cross-reference known issues and carry the marker:\n%s", create.Body)
+	}
+
+	update := plan.Items[1]
+	if update.Action != GitHubActionUpdate || update.Number != 7 {
+		t.Fatalf("Expected update of #7, got %+v", update)
+	}
+	if !reflect.DeepEqual(update.AddLabels, []string{"P0"}) || !reflect.DeepEqual(update.RemoveLabels, []string{"P1"}) {
+		t.Errorf("Label diff should only touch managed labels: add %v remove %v", update.AddLabels, update.RemoveLabels)
+	}
+	if !reflect.DeepEqual(update.Changes, []string{"title", "body", "labels", "state → closed"}) {
+		t.Errorf("Unexpected changes: %v", update.Changes)
+	}
+
+	if plan.Items[2].Action != GitHubActionUnchanged {
+		t.Errorf("Expected w-3 unchanged, got %+v", plan.Items[2])
+	}
+
+	preview := FormatGitHubPushPlan(plan)
+	for _, want := range []string{"1 to create, 1 to update, 1 unchanged", "+ w-1", "~ w-2", "-[P1]"} {
+		if !strings.Contains(preview, want) {
+			t.Errorf("Preview missing %q:\n%s", want, preview)
+		}
+	}
+}
+
+func TestFetchGitHubIssues(t *testing.T) {
+	var gotArgs []string
+	orig := runGH
+	defer func() { runGH = orig }()
+	runGH = func(stdin string, args ...string) ([]byte, error) {
+		gotArgs = args
+		// gh api --paginate --jq prints the issues of every page, one per line
+		return []byte(`{"number":1,"title":"First","body":"` + beadMarker("w-1") + `","state":"open","url":"https://github.com/acme/widgets/issues/1","labels":[{"name":"P1"}]}
+{"number":1001,"title":"Past the first thousand","body":"","state":"closed","url":"https://github.com/acme/widgets/issues/1001","labels":[]}
+`), nil
+	}
+
+	remote, err := FetchGitHubIssues("acme/widgets")
+	if err != nil {
+		t.Fatalf("FetchGitHubIssues: %v", err)
+	}
+	if !strings.Contains(strings.Join(gotArgs, " "), "api --paginate repos/acme/widgets/issues?state=all") {
+		t.Errorf("Expected a paginated issues listing, got %q", gotArgs)
+	}
+	if len(remote) != 2 || remote[1].Number != 1001 || remote[0].BeadID() != "w-1" || remote[0].Labels[0].Name != "P1" {
+		t.Errorf("Unexpected issues: %+v", remote)
+	}
+}
+
+func TestApplyGitHubPush(t *testing.T) {
+	var calls []string
+	orig := runGH
+	defer func() { runGH = orig }()
+	runGH = func(stdin string, args ...string) ([]byte, error) {
+		calls = append(calls, strings.Join(args, " "))
+		switch {
+		case args[0] == "label" && args[1] == "list":
+			return []byte(`[{"name":"p2"},{"name":"from-beads"}]`), nil
+		case args[0] == "issue" && args[1] == "create":
+			if !strings.Contains(stdin, beadMarker("w-1")) {
+				t.Errorf("Create body should be passed on stdin with the marker")
+			}
+			return []byte("Creating issue\nhttps://github.com/acme/widgets/issues/12\n"), nil
+		}
+		return nil, nil
+	}
+
+	cfg := githubTestConfig()
+	cfg.Project = 3
+	plan := GitHubPushPlan{Repo: cfg.Repo, Items: []GitHubPushItem{
+		{BeadID: "w-1", Action: GitHubActionCreate, Title: "New", Body: GitHubIssueBody(model.Issue{ID: "w-1"}, nil),
+			Labels: []string{"P2", "from-beads", "task"}, AddLabels: []string{"P2", "from-beads", "task"}, State: "open"},
+		{BeadID: "w-2", Action: GitHubActionUpdate, Number: 7, URL: "https://github.com/acme/widgets/issues/7", Title: "Renamed",
+			AddLabels: []string{"P0"}, RemoveLabels: []string{"P1"}, State: "closed", Changes: []string{"labels", "state → closed"}},
+		{BeadID: "w-3", Action: GitHubActionUnchanged, Number: 8},
+	}}
+
+	if err := ApplyGitHubPush(&plan, cfg, nil); err != nil {
+		t.Fatalf("ApplyGitHubPush: %v", err)
+	}
+	if plan.Items[0].Number != 12 {
+		t.Errorf("Expected created issue number 12, got %d", plan.Items[0].Number)
+	}
+
+	want := []string{
+		"label list --repo acme/widgets --limit 1000 --json name",
+		"label create P0 --repo acme/widgets",
+		"label create task --repo acme/widgets",
+		"issue create --repo acme/widgets --title New --body-file - --label P2 --label from-beads --label task",
+		"project item-add 3 --owner acme --url https://github.com/acme/widgets/issues/12",
+		"issue edit 7 --repo acme/widgets --title Renamed --body-file - --add-label P0 --remove-label P1",
+		"issue close 7 --repo acme/widgets",
+		"project item-add 3 --owner acme --url https://github.com/acme/widgets/issues/7",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Unexpected gh calls:\n got %q\nwant %q", calls, want)
+	}
+}
@@ -15,15 +15,18 @@ import (
 type GraphExportFormat string
 
 const (
-	GraphFormatJSON    GraphExportFormat = "json"
-	GraphFormatDOT     GraphExportFormat = "dot"
-	GraphFormatMermaid GraphExportFormat = "mermaid"
+	GraphFormatJSON     GraphExportFormat = "json"
+	GraphFormatDOT      GraphExportFormat = "dot"
+	GraphFormatMermaid  GraphExportFormat = "mermaid"
+	GraphFormatPlantUML GraphExportFormat = "plantuml"
+	GraphFormatD2       GraphExportFormat = "d2"
 )
 
 // GraphExportConfig configures graph export behavior.
 type GraphExportConfig struct {
-	Format   GraphExportFormat // Output format (json, dot, mermaid)
+	Format   GraphExportFormat // Output format (json, dot, mermaid, plantuml, d2)
 	Label    string            // Filter to specific label
+	Epic     string            // Filter to an epic and its descendants
 	Root     string            // Subgraph from specific root
 	Depth    int               // Max depth for subgraph (0 = unlimited)
 	DataHash string            // Hash of input data for provenance
@@ -109,6 +112,9 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 	if config.Label != "" {
 		filtersApplied["label"] = config.Label
 	}
+	if config.Epic != "" {
+		filtersApplied["epic"] = config.Epic
+	}
 	if config.Root != "" {
 		filtersApplied["root"] = config.Root
 	}
@@ -143,6 +149,22 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 			WhenToUse:   "When you need an embeddable diagram for documentation or GitHub issues",
 		}
 
+	case GraphFormatPlantUML:
+		result.Graph = generatePlantUML(filteredIssues, issueIDs)
+		result.Explanation = GraphExplanation{
+			What:        "Dependency graph in PlantUML format",
+			HowToRender: "Save to file.puml, run: plantuml -tsvg file.puml",
+			WhenToUse:   "When your documentation toolchain renders PlantUML (Confluence, AsciiDoc, Sphinx)",
+		}
+
+	case GraphFormatD2:
+		result.Graph = generateD2(filteredIssues, issueIDs)
+		result.Explanation = GraphExplanation{
+			What:        "Dependency graph in D2 format",
+			HowToRender: "Save to file.d2, run: d2 file.d2 graph.svg",
+			WhenToUse:   "When your documentation toolchain renders D2 diagrams",
+		}
+
 	case GraphFormatJSON:
 		fallthrough
 	default:
@@ -158,13 +180,17 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 	return result, nil
 }
 
-// filterIssues applies label and root filters to the issue list.
+// filterIssues applies epic, label and root filters to the issue list.
 func filterIssues(issues []model.Issue, config GraphExportConfig) []model.Issue {
-	// Filter by label first
+	// Filter by epic first, over all issues so the hierarchy stays whole
 	filtered := issues
+	if config.Epic != "" {
+		filtered = extractEpic(issues, config.Epic)
+	}
+
 	if config.Label != "" {
 		var labeled []model.Issue
-		for _, i := range issues {
+		for _, i := range filtered {
 			for _, l := range i.Labels {
 				if strings.EqualFold(l, config.Label) {
 					labeled = append(labeled, i)
@@ -183,6 +209,45 @@ func filterIssues(issues []model.Issue, config GraphExportConfig) []model.Issue
 	return filtered
 }
 
+// extractEpic returns the epic and every issue below it through
+// parent-child dependencies, at any depth.
+func extractEpic(issues []model.Issue, epicID string) []model.Issue {
+	children := make(map[string][]string)
+	found := false
+	for _, i := range issues {
+		found = found || i.ID == epicID
+		for _, dep := range i.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], i.ID)
+			}
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	inEpic := map[string]bool{epicID: true}
+	queue := []string{epicID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, child := range children[id] {
+			if !inEpic[child] {
+				inEpic[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	var result []model.Issue
+	for _, i := range issues {
+		if inEpic[i.ID] {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
 // extractSubgraph extracts a subgraph starting from a root node.
 func extractSubgraph(issues []model.Issue, rootID string, maxDepth int) []model.Issue {
 	// Build issue map
@@ -164,6 +164,84 @@ func TestExportGraph_Mermaid(t *testing.T) {
 	}
 }
 
+func TestExportGraph_PlantUML(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: `Say "hi"`, Status: model.StatusOpen, Priority: 1},
+		{ID: "bv.2", Title: "Second Issue", Status: model.StatusBlocked, Priority: 2,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv.2", DependsOnID: "bv-1", Type: model.DepBlocks},
+			},
+		},
+		{ID: "bv_2", Title: "Third Issue", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv_2", DependsOnID: "bv-1", Type: model.DepRelated},
+			},
+		},
+	}
+
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	result, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatPlantUML})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+
+	if result.Format != "plantuml" {
+		t.Errorf("Expected format 'plantuml', got %s", result.Format)
+	}
+	for _, want := range []string{
+		"@startuml",
+		"BackgroundColor<<blocked>>",
+		`rectangle "bv-1\nSay 'hi'\nP1 open" <<open>> as bv_1`,
+		"bv_2 -[#E53935,bold]-> bv_1",
+		"-[#999999,dashed]-> bv_1",
+		"@enduml",
+	} {
+		if !strings.Contains(result.Graph, want) {
+			t.Errorf("PlantUML output missing %q:\n%s", want, result.Graph)
+		}
+	}
+
+	// bv.2 and bv_2 clean up to the same alias; the second gets a suffix
+	if strings.Count(result.Graph, " as bv_2\n") != 1 {
+		t.Errorf("Colliding IDs should get distinct aliases:\n%s", result.Graph)
+	}
+}
+
+func TestExportGraph_D2(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv.1", Title: "First Issue", Status: model.StatusOpen, Priority: 1},
+		{ID: "bv-2", Title: "Second Issue", Status: model.StatusInProgress, Priority: 2,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-2", DependsOnID: "bv.1", Type: model.DepBlocks},
+			},
+		},
+	}
+
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	result, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatD2})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+
+	if result.Format != "d2" {
+		t.Errorf("Expected format 'd2', got %s", result.Format)
+	}
+	for _, want := range []string{
+		"direction: right",
+		"in_progress: {style.fill:",
+		`"bv.1": "bv.1\nFirst Issue\nP1 open" {class: open}`,
+		`"bv-2" -> "bv.1": {class: blocks}`,
+	} {
+		if !strings.Contains(result.Graph, want) {
+			t.Errorf("D2 output missing %q:\n%s", want, result.Graph)
+		}
+	}
+}
+
 func TestExportGraph_LabelFilter(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "bv-1", Title: "API Issue", Status: model.StatusOpen, Labels: []string{"api"}},
@@ -232,6 +310,50 @@ func TestExportGraph_SubgraphRoot(t *testing.T) {
 	}
 }
 
+func TestExportGraph_EpicFilter(t *testing.T) {
+	child := func(id, parent string) model.Issue {
+		return model.Issue{ID: id, Title: id, Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{
+				{IssueID: id, DependsOnID: parent, Type: model.DepParentChild},
+			},
+		}
+	}
+	issues := []model.Issue{
+		{ID: "epic-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("bv-1", "epic-1"),
+		child("bv-2", "bv-1"),
+		{ID: "epic-2", Title: "Other Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("bv-3", "epic-2"),
+		{ID: "bv-4", Title: "Blocker outside the epic", Status: model.StatusOpen},
+	}
+	issues[2].Dependencies = append(issues[2].Dependencies,
+		&model.Dependency{IssueID: "bv-2", DependsOnID: "bv-4", Type: model.DepBlocks})
+
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	result, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatJSON, Epic: "epic-1"})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+
+	// The epic, its child and grandchild; edges leaving the epic are dropped
+	if result.Nodes != 3 || result.Edges != 2 {
+		t.Errorf("Expected 3 nodes and 2 edges in epic-1, got %d and %d", result.Nodes, result.Edges)
+	}
+	if result.FiltersApplied["epic"] != "epic-1" {
+		t.Errorf("Expected epic filter 'epic-1', got %s", result.FiltersApplied["epic"])
+	}
+
+	result, err = ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatJSON, Epic: "missing"})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+	if result.Nodes != 0 {
+		t.Errorf("Expected an unknown epic to match nothing, got %d nodes", result.Nodes)
+	}
+}
+
 func TestExportGraph_EmptyResult(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "bv-1", Title: "Issue", Status: model.StatusOpen, Labels: []string{"api"}},
@@ -5,13 +5,13 @@ import (
 	"image/color"
 	"io"
 	"math"
-	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 
 	"git.sr.ht/~sbinet/gg"
 	"github.com/ajstarks/svgo"
@@ -27,6 +27,11 @@ type GraphSnapshotOptions struct {
 	Issues   []model.Issue        // Issues to render (already filtered by recipe/workspace)
 	Stats    *analysis.GraphStats // Graph analysis used for layout/summary
 	DataHash string               // Hash of input issues for provenance
+
+	// StatusColors, when set, colors nodes and the legend like the TUI: a
+	// pale tint of each status's color outlined in the color itself.
+	// Statuses without a color keep the default palette.
+	StatusColors map[model.Status]color.RGBA
 }
 
 // SaveGraphSnapshot renders a static graph snapshot (SVG or PNG) with a minimal
@@ -61,7 +66,7 @@ func SaveGraphSnapshot(opts GraphSnapshotOptions) error {
 		return fmt.Errorf("output path is required")
 	}
 
-	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+	if err := readonly.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
 		return fmt.Errorf("create parent dir: %w", err)
 	}
 
@@ -77,6 +82,18 @@ func SaveGraphSnapshot(opts GraphSnapshotOptions) error {
 	}
 }
 
+// WriteGraphSVG renders the same graph as SaveGraphSnapshot as SVG to w,
+// for callers that serve it rather than write a file.
+func WriteGraphSVG(w io.Writer, opts GraphSnapshotOptions) error {
+	if len(opts.Issues) == 0 {
+		return fmt.Errorf("no issues to export")
+	}
+	if opts.Stats == nil {
+		return fmt.Errorf("graph stats are required for snapshot export")
+	}
+	return renderSVGToWriter(w, buildLayout(opts))
+}
+
 // --- layout computation ----------------------------------------------------
 
 type layoutNode struct {
@@ -103,6 +120,7 @@ type layoutResult struct {
 	Height  int
 	Header  float64
 	Summary summaryInfo
+	Colors  map[model.Status]color.RGBA
 }
 
 type summaryInfo struct {
@@ -244,6 +262,7 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 		Width:  width,
 		Height: height,
 		Header: headerHeight,
+		Colors: opts.StatusColors,
 		Summary: summaryInfo{
 			Title:         title,
 			DataHash:      opts.DataHash,
@@ -301,6 +320,22 @@ func statusColor(s model.Status) color.RGBA {
 	}
 }
 
+// nodeColors returns the fill and outline of a node with status s
+func (l layoutResult) nodeColors(s model.Status) (fill, stroke color.RGBA) {
+	if c, ok := l.Colors[s]; ok {
+		return tint(c, 0.8), c
+	}
+	return statusColor(s), colorStroke
+}
+
+// tint mixes c with white; amount 1 is white
+func tint(c color.RGBA, amount float64) color.RGBA {
+	mix := func(v uint8) uint8 {
+		return uint8(math.Round(float64(v) + (255-float64(v))*amount))
+	}
+	return color.RGBA{mix(c.R), mix(c.G), mix(c.B), 0xff}
+}
+
 func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
 	dc := gg.NewContext(layout.Width, layout.Height)
 	dc.SetColor(colorBackdrop)
@@ -337,14 +372,14 @@ func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
 
 	// nodes
 	for _, n := range layout.Nodes {
-		drawNode(dc, n)
+		drawNode(dc, layout, n)
 	}
 
 	return dc.SavePNG(opts.Path)
 }
 
 func renderSVG(opts GraphSnapshotOptions, layout layoutResult) error {
-	file, err := os.Create(opts.Path)
+	file, err := readonly.Create(opts.Path)
 	if err != nil {
 		return err
 	}
@@ -386,8 +421,9 @@ func renderSVGToWriter(w io.Writer, layout layoutResult) error {
 	for _, n := range layout.Nodes {
 		x := int(n.X)
 		y := int(n.Y)
+		fill, stroke := layout.nodeColors(n.Status)
 		canvas.Roundrect(x, y, int(n.NodeW), int(n.NodeH), 8, 8,
-			fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1.2", css(statusColor(n.Status)), css(colorStroke)))
+			fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1.2", css(fill), css(stroke)))
 		canvas.Text(x+10, y+22, n.ID, fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace;font-weight:bold", css(colorText)))
 		canvas.Text(x+10, y+42, truncate(n.Title, 40), fmt.Sprintf("fill:%s;font-size:12px;font-family:monospace", css(colorSubtle)))
 		canvas.Text(x+10, y+60, fmt.Sprintf("PR %.3f", n.PageRank),
@@ -398,11 +434,12 @@ func renderSVGToWriter(w io.Writer, layout layoutResult) error {
 	return nil
 }
 
-func drawNode(dc *gg.Context, n layoutNode) {
-	dc.SetColor(statusColor(n.Status))
+func drawNode(dc *gg.Context, layout layoutResult, n layoutNode) {
+	fill, stroke := layout.nodeColors(n.Status)
+	dc.SetColor(fill)
 	dc.DrawRoundedRectangle(n.X, n.Y, n.NodeW, n.NodeH, 8)
 	dc.Fill()
-	dc.SetColor(colorStroke)
+	dc.SetColor(stroke)
 	dc.SetLineWidth(1.2)
 	dc.DrawRoundedRectangle(n.X, n.Y, n.NodeW, n.NodeH, 8)
 	dc.Stroke()
@@ -447,17 +484,18 @@ func drawLegend(dc *gg.Context, layout layoutResult) {
 
 	dc.SetColor(colorText)
 	dc.DrawStringAnchored("Legend", x+12, y+18, 0, 0.5)
-	drawLegendRow(dc, x+12, y+36, colorOpen, "Open / Ready")
-	drawLegendRow(dc, x+12, y+52, colorInProg, "In Progress")
-	drawLegendRow(dc, x+12, y+68, colorBlocked, "Blocked (has blockers)")
-	drawLegendRow(dc, x+12, y+84, colorClosed, "Closed")
+	drawLegendRow(dc, layout, x+12, y+36, model.StatusOpen, "Open / Ready")
+	drawLegendRow(dc, layout, x+12, y+52, model.StatusInProgress, "In Progress")
+	drawLegendRow(dc, layout, x+12, y+68, model.StatusBlocked, "Blocked (has blockers)")
+	drawLegendRow(dc, layout, x+12, y+84, model.StatusClosed, "Closed")
 }
 
-func drawLegendRow(dc *gg.Context, x, y float64, c color.RGBA, label string) {
-	dc.SetColor(c)
+func drawLegendRow(dc *gg.Context, layout layoutResult, x, y float64, status model.Status, label string) {
+	fill, stroke := layout.nodeColors(status)
+	dc.SetColor(fill)
 	dc.DrawRoundedRectangle(x, y-8, 14, 14, 3)
 	dc.Fill()
-	dc.SetColor(colorStroke)
+	dc.SetColor(stroke)
 	dc.DrawRoundedRectangle(x, y-8, 14, 14, 3)
 	dc.Stroke()
 	dc.SetColor(colorSubtle)
@@ -478,14 +516,15 @@ func drawLegendSVG(canvas *svg.SVG, layout layoutResult) {
 	y := 24
 	canvas.Roundrect(x, y, boxW, boxH, 10, 10, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", css(colorLegendBG), css(colorStroke)))
 	canvas.Text(x+12, y+18, "Legend", fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace;font-weight:bold", css(colorText)))
-	drawLegendRowSVG(canvas, x+12, y+36, colorOpen, "Open / Ready")
-	drawLegendRowSVG(canvas, x+12, y+52, colorInProg, "In Progress")
-	drawLegendRowSVG(canvas, x+12, y+68, colorBlocked, "Blocked")
-	drawLegendRowSVG(canvas, x+12, y+84, colorClosed, "Closed")
+	drawLegendRowSVG(canvas, layout, x+12, y+36, model.StatusOpen, "Open / Ready")
+	drawLegendRowSVG(canvas, layout, x+12, y+52, model.StatusInProgress, "In Progress")
+	drawLegendRowSVG(canvas, layout, x+12, y+68, model.StatusBlocked, "Blocked")
+	drawLegendRowSVG(canvas, layout, x+12, y+84, model.StatusClosed, "Closed")
 }
 
-func drawLegendRowSVG(canvas *svg.SVG, x, y int, c color.RGBA, label string) {
-	canvas.Roundrect(x, y-8, 14, 14, 3, 3, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", css(c), css(colorStroke)))
+func drawLegendRowSVG(canvas *svg.SVG, layout layoutResult, x, y int, status model.Status, label string) {
+	fill, stroke := layout.nodeColors(status)
+	canvas.Roundrect(x, y-8, 14, 14, 3, 3, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", css(fill), css(stroke)))
 	canvas.Text(x+20, y, label, fmt.Sprintf("fill:%s;font-size:12px;font-family:monospace", css(colorSubtle)))
 }
 
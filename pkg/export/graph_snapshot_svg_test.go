@@ -2,6 +2,7 @@ package export
 
 import (
 	"encoding/xml"
+	"image/color"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -992,3 +993,31 @@ func TestSVG_LongTitleTruncation(t *testing.T) {
 		t.Error("Truncation ellipsis not found for long title")
 	}
 }
+
+// TestSVG_StatusColors verifies nodes take the caller's status colors: a
+// pale fill outlined in the color, with other statuses keeping the default
+func TestSVG_StatusColors(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Task A", Status: model.StatusOpen},
+		{ID: "B", Title: "Task B", Status: model.StatusClosed},
+	}
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	var buf strings.Builder
+	err := WriteGraphSVG(&buf, GraphSnapshotOptions{
+		Issues:       issues,
+		Stats:        &stats,
+		StatusColors: map[model.Status]color.RGBA{model.StatusOpen: {0x00, 0x77, 0x00, 0xff}},
+	})
+	if err != nil {
+		t.Fatalf("WriteGraphSVG error: %v", err)
+	}
+	svgText := buf.String()
+	if !strings.Contains(svgText, "fill:#cce4cc;stroke:#007700") {
+		t.Error("open nodes should be a tint of #007700 outlined in it")
+	}
+	if !strings.Contains(svgText, "fill:"+css(colorClosed)+";stroke:"+css(colorStroke)) {
+		t.Error("closed nodes should keep the default colors")
+	}
+}
@@ -3,14 +3,15 @@ package export
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
 	"sort"
 	"strings"
 	"time"
 	"unicode"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // Package-level compiled regex for slug creation (avoids recompilation per call)
@@ -72,40 +73,90 @@ func sanitizeMermaidText(text string) string {
 
 // GenerateMarkdown creates a comprehensive markdown report of all issues
 func GenerateMarkdown(issues []model.Issue, title string) (string, error) {
+	return GenerateMarkdownWithOptions(issues, title, MarkdownOptions{})
+}
+
+// MarkdownOptions controls Markdown report generation
+type MarkdownOptions struct {
+	// Stable omits the generation timestamp and anchors time-relative sections
+	// (the timeline) to the data instead of the wall clock, so re-exporting
+	// unchanged issues produces byte-identical output.
+	Stable bool
+	// Now overrides the clock; zero means time.Now() (or StableTime when Stable)
+	Now time.Time
+	// TemplateDir holds user templates (.bv/templates) overriding the
+	// built-in layout; see ReportTemplatesDir. Empty means built-in only.
+	TemplateDir string
+	// Title heads the report written by SaveMarkdownToFileWithOptions or
+	// SaveReportToFile; empty means "Beads Export"
+	Title string
+}
+
+// GenerateMarkdownWithOptions is GenerateMarkdown with explicit options.
+// A report.md.tmpl in opts.TemplateDir replaces the built-in layout.
+func GenerateMarkdownWithOptions(issues []model.Issue, title string, opts MarkdownOptions) (string, error) {
+	now := reportTime(issues, opts)
+	if opts.TemplateDir != "" {
+		if content, ok, err := renderMarkdownTemplate(opts.TemplateDir, newReportData(issues, title, now, opts)); ok || err != nil {
+			return content, err
+		}
+	}
+
 	var sb strings.Builder
 
 	// Header
 	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
-	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format(time.RFC1123)))
+	if !opts.Stable {
+		sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", now.Format(time.RFC1123)))
+	}
 
-	// Summary Statistics
-	sb.WriteString("## Summary\n\n")
+	sb.WriteString(markdownSummary(issues))
+
+	// Quick Actions Section
+	sb.WriteString(generateQuickActions(issues))
 
-	open, inProgress, blocked, closed := 0, 0, 0, 0
+	sb.WriteString(markdownTOC(issues))
+	sb.WriteString(markdownDependencyGraph(issues))
+	sb.WriteString(markdownTimeline(reportGantt(issues, now)))
+
+	// Individual Issues
 	for _, i := range issues {
-		switch i.Status {
-		case model.StatusOpen:
-			open++
-		case model.StatusInProgress:
-			inProgress++
-		case model.StatusBlocked:
-			blocked++
-		case model.StatusClosed:
-			closed++
-		}
+		sb.WriteString(markdownIssue(i))
 	}
 
-	sb.WriteString("| Metric | Count |\n|--------|-------|\n")
-	sb.WriteString(fmt.Sprintf("| **Total** | %d |\n", len(issues)))
-	sb.WriteString(fmt.Sprintf("| Open | %d |\n", open))
-	sb.WriteString(fmt.Sprintf("| In Progress | %d |\n", inProgress))
-	sb.WriteString(fmt.Sprintf("| Blocked | %d |\n", blocked))
-	sb.WriteString(fmt.Sprintf("| Closed | %d |\n\n", closed))
+	return sb.String(), nil
+}
 
-	// Quick Actions Section
-	sb.WriteString(generateQuickActions(issues))
+// reportTime is the clock a report is generated at: opts.Now, else
+// StableTime for stable exports, else the wall clock
+func reportTime(issues []model.Issue, opts MarkdownOptions) time.Time {
+	if !opts.Now.IsZero() {
+		return opts.Now
+	}
+	if opts.Stable {
+		return StableTime(issues)
+	}
+	return time.Now()
+}
+
+// markdownSummary renders the Summary section: issue counts by status
+func markdownSummary(issues []model.Issue) string {
+	var sb strings.Builder
+	sb.WriteString("## Summary\n\n")
 
-	// Table of Contents
+	c := countStatuses(issues)
+	sb.WriteString("| Metric | Count |\n|--------|-------|\n")
+	sb.WriteString(fmt.Sprintf("| **Total** | %d |\n", c.Total))
+	sb.WriteString(fmt.Sprintf("| Open | %d |\n", c.Open))
+	sb.WriteString(fmt.Sprintf("| In Progress | %d |\n", c.InProgress))
+	sb.WriteString(fmt.Sprintf("| Blocked | %d |\n", c.Blocked))
+	sb.WriteString(fmt.Sprintf("| Closed | %d |\n\n", c.Closed))
+	return sb.String()
+}
+
+// markdownTOC renders the Table of Contents, linking each issue's section
+func markdownTOC(issues []model.Issue) string {
+	var sb strings.Builder
 	sb.WriteString("## Table of Contents\n\n")
 	for _, i := range issues {
 		// Create a slug for the anchor (lowercase, hyphens for spaces)
@@ -114,110 +165,138 @@ func GenerateMarkdown(issues []model.Issue, title string) (string, error) {
 		sb.WriteString(fmt.Sprintf("- [%s %s %s](#%s)\n", statusIcon, i.ID, i.Title, slug))
 	}
 	sb.WriteString("\n---\n\n")
+	return sb.String()
+}
 
-	// Dependency Graph (Mermaid)
+// markdownDependencyGraph renders the Dependency Graph section (Mermaid)
+func markdownDependencyGraph(issues []model.Issue) string {
+	var sb strings.Builder
 	sb.WriteString("## Dependency Graph\n\n")
 	sb.WriteString("```mermaid\n")
+	sb.WriteString(reportMermaidGraph(issues))
+	sb.WriteString("```\n\n")
+	sb.WriteString("---\n\n")
+	return sb.String()
+}
 
+// reportMermaidGraph is the Mermaid source of the report's dependency graph
+func reportMermaidGraph(issues []model.Issue) string {
 	issueIDs := make(map[string]bool)
 	for _, i := range issues {
 		issueIDs[i.ID] = true
 	}
+	return GenerateMermaidGraph(issues, issueIDs, MermaidConfig{ShowNoDependenciesNode: true})
+}
 
-	graph := GenerateMermaidGraph(issues, issueIDs, MermaidConfig{ShowNoDependenciesNode: true})
-	sb.WriteString(graph)
+// reportGantt is the Mermaid gantt chart of the report's timeline, or ""
+// when nothing can be scheduled
+func reportGantt(issues []model.Issue, now time.Time) string {
+	return GenerateMermaidGantt(analysis.NewAnalyzer(issues).ComputeTimeline(now))
+}
 
+// markdownTimeline renders the Timeline section from a gantt chart,
+// highlighting the critical path, or "" when the chart is empty
+func markdownTimeline(gantt string) string {
+	if gantt == "" {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("## Timeline\n\n")
+	sb.WriteString("```mermaid\n")
+	sb.WriteString(gantt)
 	sb.WriteString("```\n\n")
 	sb.WriteString("---\n\n")
+	return sb.String()
+}
 
-	// Individual Issues
-	for _, i := range issues {
-		typeIcon := getTypeEmoji(string(i.IssueType))
-		sb.WriteString(fmt.Sprintf("## %s %s %s\n\n", typeIcon, i.ID, i.Title))
-
-		// Metadata Table
-		sb.WriteString("| Property | Value |\n|----------|-------|\n")
-		sb.WriteString(fmt.Sprintf("| **Type** | %s %s |\n", typeIcon, i.IssueType))
-		sb.WriteString(fmt.Sprintf("| **Priority** | %s |\n", getPriorityLabel(i.Priority)))
-		sb.WriteString(fmt.Sprintf("| **Status** | %s %s |\n", getStatusEmoji(string(i.Status)), i.Status))
-		if i.Assignee != "" {
-			// Sanitize assignee: replace newlines with spaces, escape pipes
-			cleanAssignee := strings.ReplaceAll(i.Assignee, "\n", " ")
-			cleanAssignee = strings.ReplaceAll(cleanAssignee, "\r", "")
-			escapedAssignee := strings.ReplaceAll(cleanAssignee, "|", "\\|")
-			sb.WriteString(fmt.Sprintf("| **Assignee** | @%s |\n", escapedAssignee))
-		}
-		sb.WriteString(fmt.Sprintf("| **Created** | %s |\n", i.CreatedAt.Format("2006-01-02 15:04")))
-		sb.WriteString(fmt.Sprintf("| **Updated** | %s |\n", i.UpdatedAt.Format("2006-01-02 15:04")))
-		if i.ClosedAt != nil {
-			sb.WriteString(fmt.Sprintf("| **Closed** | %s |\n", i.ClosedAt.Format("2006-01-02 15:04")))
-		}
-		if len(i.Labels) > 0 {
-			// Escape pipe characters and sanitize newlines in labels
-			escapedLabels := make([]string, len(i.Labels))
-			for idx, label := range i.Labels {
-				cleanLabel := strings.ReplaceAll(label, "\n", " ")
-				cleanLabel = strings.ReplaceAll(cleanLabel, "\r", "")
-				escapedLabels[idx] = strings.ReplaceAll(cleanLabel, "|", "\\|")
-			}
-			sb.WriteString(fmt.Sprintf("| **Labels** | %s |\n", strings.Join(escapedLabels, ", ")))
+// markdownIssue renders one issue's section: metadata table, text fields,
+// dependencies, comments and command snippets
+func markdownIssue(i model.Issue) string {
+	var sb strings.Builder
+	typeIcon := getTypeEmoji(string(i.IssueType))
+	sb.WriteString(fmt.Sprintf("## %s %s %s\n\n", typeIcon, i.ID, i.Title))
+
+	// Metadata Table
+	sb.WriteString("| Property | Value |\n|----------|-------|\n")
+	sb.WriteString(fmt.Sprintf("| **Type** | %s %s |\n", typeIcon, i.IssueType))
+	sb.WriteString(fmt.Sprintf("| **Priority** | %s |\n", getPriorityLabel(i.Priority)))
+	sb.WriteString(fmt.Sprintf("| **Status** | %s %s |\n", getStatusEmoji(string(i.Status)), i.Status))
+	if i.Assignee != "" {
+		// Sanitize assignee: replace newlines with spaces, escape pipes
+		cleanAssignee := strings.ReplaceAll(i.Assignee, "\n", " ")
+		cleanAssignee = strings.ReplaceAll(cleanAssignee, "\r", "")
+		escapedAssignee := strings.ReplaceAll(cleanAssignee, "|", "\\|")
+		sb.WriteString(fmt.Sprintf("| **Assignee** | @%s |\n", escapedAssignee))
+	}
+	sb.WriteString(fmt.Sprintf("| **Created** | %s |\n", i.CreatedAt.Format("2006-01-02 15:04")))
+	sb.WriteString(fmt.Sprintf("| **Updated** | %s |\n", i.UpdatedAt.Format("2006-01-02 15:04")))
+	if i.ClosedAt != nil {
+		sb.WriteString(fmt.Sprintf("| **Closed** | %s |\n", i.ClosedAt.Format("2006-01-02 15:04")))
+	}
+	if len(i.Labels) > 0 {
+		// Escape pipe characters and sanitize newlines in labels
+		escapedLabels := make([]string, len(i.Labels))
+		for idx, label := range i.Labels {
+			cleanLabel := strings.ReplaceAll(label, "\n", " ")
+			cleanLabel = strings.ReplaceAll(cleanLabel, "\r", "")
+			escapedLabels[idx] = strings.ReplaceAll(cleanLabel, "|", "\\|")
 		}
-		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("| **Labels** | %s |\n", strings.Join(escapedLabels, ", ")))
+	}
+	sb.WriteString("\n")
 
-		if i.Description != "" {
-			sb.WriteString("### Description\n\n")
-			sb.WriteString(i.Description + "\n\n")
-		}
+	if i.Description != "" {
+		sb.WriteString("### Description\n\n")
+		sb.WriteString(i.Description + "\n\n")
+	}
 
-		if i.AcceptanceCriteria != "" {
-			sb.WriteString("### Acceptance Criteria\n\n")
-			sb.WriteString(i.AcceptanceCriteria + "\n\n")
-		}
+	if i.AcceptanceCriteria != "" {
+		sb.WriteString("### Acceptance Criteria\n\n")
+		sb.WriteString(i.AcceptanceCriteria + "\n\n")
+	}
 
-		if i.Design != "" {
-			sb.WriteString("### Design\n\n")
-			sb.WriteString(i.Design + "\n\n")
-		}
+	if i.Design != "" {
+		sb.WriteString("### Design\n\n")
+		sb.WriteString(i.Design + "\n\n")
+	}
 
-		if i.Notes != "" {
-			sb.WriteString("### Notes\n\n")
-			sb.WriteString(i.Notes + "\n\n")
-		}
+	if i.Notes != "" {
+		sb.WriteString("### Notes\n\n")
+		sb.WriteString(i.Notes + "\n\n")
+	}
 
-		if len(i.Dependencies) > 0 {
-			sb.WriteString("### Dependencies\n\n")
-			for _, dep := range i.Dependencies {
-				if dep == nil {
-					continue
-				}
-				icon := "🔗"
-				if dep.Type == model.DepBlocks {
-					icon = "⛔"
-				}
-				sb.WriteString(fmt.Sprintf("- %s **%s**: `%s`\n", icon, dep.Type, dep.DependsOnID))
+	if len(i.Dependencies) > 0 {
+		sb.WriteString("### Dependencies\n\n")
+		for _, dep := range i.Dependencies {
+			if dep == nil {
+				continue
+			}
+			icon := "🔗"
+			if dep.Type == model.DepBlocks {
+				icon = "⛔"
 			}
-			sb.WriteString("\n")
+			sb.WriteString(fmt.Sprintf("- %s **%s**: `%s`\n", icon, dep.Type, dep.DependsOnID))
 		}
+		sb.WriteString("\n")
+	}
 
-		if len(i.Comments) > 0 {
-			sb.WriteString("### Comments\n\n")
-			for _, c := range i.Comments {
-				if c == nil {
-					continue
-				}
-				escapedText := strings.ReplaceAll(c.Text, "\n", "\n> ")
-				sb.WriteString(fmt.Sprintf("> **%s** (%s)\n>\n> %s\n\n",
-					c.Author, c.CreatedAt.Format("2006-01-02"), escapedText))
+	if len(i.Comments) > 0 {
+		sb.WriteString("### Comments\n\n")
+		for _, c := range i.Comments {
+			if c == nil {
+				continue
 			}
+			escapedText := strings.ReplaceAll(c.Text, "\n", "\n> ")
+			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n>\n> %s\n\n",
+				c.Author, c.CreatedAt.Format("2006-01-02"), escapedText))
 		}
-
-		// Per-issue command snippets
-		sb.WriteString(generateIssueCommands(i))
-
-		sb.WriteString("---\n\n")
 	}
 
-	return sb.String(), nil
+	// Per-issue command snippets
+	sb.WriteString(generateIssueCommands(i))
+
+	sb.WriteString("---\n\n")
+	return sb.String()
 }
 
 // createSlug creates a URL-friendly slug from an ID
@@ -280,6 +359,29 @@ func getPriorityLabel(priority int) string {
 
 // SaveMarkdownToFile writes the generated markdown to a file
 func SaveMarkdownToFile(issues []model.Issue, filename string) error {
+	return SaveMarkdownToFileWithOptions(issues, filename, MarkdownOptions{})
+}
+
+// SaveMarkdownToFileWithOptions is SaveMarkdownToFile with explicit options
+func SaveMarkdownToFileWithOptions(issues []model.Issue, filename string, opts MarkdownOptions) error {
+	content, err := RenderReport(issues, false, opts)
+	if err != nil {
+		return err
+	}
+	return readonly.WriteFile(filename, []byte(content), 0644)
+}
+
+// reportTitle is opts.Title, or "Beads Export" when it's empty
+func reportTitle(opts MarkdownOptions) string {
+	if opts.Title == "" {
+		return "Beads Export"
+	}
+	return opts.Title
+}
+
+// sortReportIssues returns a sorted copy of issues for a report: open
+// first, then priority, then newest
+func sortReportIssues(issues []model.Issue) []model.Issue {
 	// Make a copy to avoid mutating the caller's slice
 	issuesCopy := make([]model.Issue, len(issues))
 	copy(issuesCopy, issues)
@@ -294,14 +396,12 @@ func SaveMarkdownToFile(issues []model.Issue, filename string) error {
 		if issuesCopy[i].Priority != issuesCopy[j].Priority {
 			return issuesCopy[i].Priority < issuesCopy[j].Priority
 		}
-		return issuesCopy[i].CreatedAt.After(issuesCopy[j].CreatedAt)
+		if !issuesCopy[i].CreatedAt.Equal(issuesCopy[j].CreatedAt) {
+			return issuesCopy[i].CreatedAt.After(issuesCopy[j].CreatedAt)
+		}
+		return issuesCopy[i].ID < issuesCopy[j].ID
 	})
-
-	content, err := GenerateMarkdown(issuesCopy, "Beads Export")
-	if err != nil {
-		return err
-	}
-	return os.WriteFile(filename, []byte(content), 0644)
+	return issuesCopy
 }
 
 // generateQuickActions creates a Quick Actions section with bulk commands
@@ -454,6 +554,7 @@ type PriorityBriefConfig struct {
 	IncludeWhatIf      bool   // Include what-if deltas
 	IncludeLegend      bool   // Include metric legend
 	DataHash           string // Optional data hash for verification
+	Stable             bool   // Omit the generation timestamp for reproducible output
 }
 
 // DefaultPriorityBriefConfig returns sensible defaults for the priority brief
@@ -477,7 +578,9 @@ func GeneratePriorityBrief(triage interface{}, config PriorityBriefConfig) strin
 
 	// Header
 	sb.WriteString("# 📊 Priority Brief\n\n")
-	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format("2006-01-02 15:04")))
+	if !config.Stable {
+		sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format("2006-01-02 15:04")))
+	}
 
 	// Add data hash if provided
 	if config.DataHash != "" {
@@ -580,7 +683,9 @@ func GeneratePriorityBriefFromTriageJSON(triageJSON []byte, config PriorityBrief
 
 	// Header
 	sb.WriteString("# 📊 Priority Brief\n\n")
-	sb.WriteString(fmt.Sprintf("*Generated: %s*  \n", triage.Meta.GeneratedAt.Format("2006-01-02 15:04")))
+	if !config.Stable {
+		sb.WriteString(fmt.Sprintf("*Generated: %s*  \n", triage.Meta.GeneratedAt.Format("2006-01-02 15:04")))
+	}
 	sb.WriteString(fmt.Sprintf("*Version: %s | Issues: %d*\n\n", triage.Meta.Version, triage.Meta.IssueCount))
 
 	// Data hash
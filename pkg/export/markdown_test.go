@@ -1516,3 +1516,138 @@ func TestGenerateMarkdown_ClosedIssueNoCommands(t *testing.T) {
 		t.Error("Closed issue should not have command snippets")
 	}
 }
+
+// ============================================================================
+// GenerateMermaidGantt tests
+// ============================================================================
+
+func TestGenerateMermaidGantt(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	tl := analysis.Timeline{
+		CriticalPath: []string{"A", "B"},
+		Entries: []analysis.TimelineEntry{
+			{ID: "A", Title: "Design: API", Start: start, End: start.AddDate(0, 0, 4), Critical: true},
+			{ID: "B", Title: "Build", Start: start.AddDate(0, 0, 2), End: start.AddDate(0, 0, 10), Ongoing: true, Critical: true},
+			{ID: "C", Title: "Docs", Start: start.AddDate(0, 0, 3), End: start.AddDate(0, 0, 10), Ongoing: true},
+			{ID: "D", Title: "Old", Start: start, End: start.AddDate(0, 0, 1)},
+		},
+	}
+
+	got := GenerateMermaidGantt(tl)
+	for _, want := range []string{
+		"gantt",
+		"section Critical path",
+		"A Design  API :crit, done, 2025-03-01, 2025-03-05",
+		"B Build :crit, active, 2025-03-03, 2025-03-11",
+		"section Open",
+		"C Docs :active, 2025-03-04, 2025-03-11",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected gantt to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "D Old") {
+		t.Errorf("Closed non-critical issues should be omitted:\n%s", got)
+	}
+
+	if got := GenerateMermaidGantt(analysis.Timeline{}); got != "" {
+		t.Errorf("Expected empty output for empty timeline, got %q", got)
+	}
+}
+
+// ============================================================================
+// Time report tests
+// ============================================================================
+
+func TestGenerateTimeReportCSVAndMarkdown(t *testing.T) {
+	report := analysis.TimeReport{
+		GeneratedAt: time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+		GroupBy:     analysis.TimeGroupIssue,
+		Rows: []analysis.TimeReportRow{
+			{Group: "A", Title: "Auth, login | SSO", Assignee: "alice", Issues: 1, Sessions: 2, Minutes: 150},
+			{Group: "B", Title: "Billing", Issues: 1, Sessions: 1, Minutes: 45},
+		},
+		TotalMinutes: 195,
+	}
+
+	csvOut, err := GenerateTimeReportCSV(report)
+	if err != nil {
+		t.Fatalf("GenerateTimeReportCSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOut), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected header + 2 rows, got %q", csvOut)
+	}
+	if lines[0] != "issue,title,assignee,issues,sessions,minutes,hours" {
+		t.Errorf("Unexpected CSV header: %q", lines[0])
+	}
+	if lines[1] != `A,"Auth, login | SSO",alice,1,2,150,2.50` {
+		t.Errorf("Unexpected CSV row: %q", lines[1])
+	}
+
+	md := GenerateTimeReportMarkdown(report)
+	for _, want := range []string{"# Time Report by Issue", `Auth, login \| SSO`, "| 2h 30m | 2.50 |", "**3h 15m**", "**3.25**"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown missing %q:\n%s", want, md)
+		}
+	}
+
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "hours.CSV")
+	if err := SaveTimeReport(report, csvPath); err != nil {
+		t.Fatalf("SaveTimeReport: %v", err)
+	}
+	if data, _ := os.ReadFile(csvPath); !strings.HasPrefix(string(data), "issue,") {
+		t.Errorf("Expected CSV output for .csv extension, got %q", data)
+	}
+}
+
+// ============================================================================
+// Quality report tests
+// ============================================================================
+
+func TestGenerateQualityReportCSVAndMarkdown(t *testing.T) {
+	report := analysis.QualityReport{
+		Checked: 4,
+		Counts: map[analysis.QualityKind]int{
+			analysis.QualityEmptyDescription: 1,
+			analysis.QualityUnsplitEpic:      1,
+		},
+		Findings: []analysis.QualityFinding{
+			{IssueID: "A", Title: "Login | SSO", Kind: analysis.QualityEmptyDescription, Message: "A has no description"},
+			{IssueID: "E", Title: "Platform", Kind: analysis.QualityUnsplitEpic, Message: "Epic E runs 400 words with no child issues; consider splitting it"},
+		},
+	}
+
+	csvOut, err := GenerateQualityReportCSV(report)
+	if err != nil {
+		t.Fatalf("GenerateQualityReportCSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOut), "\n")
+	if len(lines) != 3 || lines[0] != "issue,title,kind,message" || lines[1] != "A,Login | SSO,empty_description,A has no description" {
+		t.Errorf("Unexpected CSV: %q", csvOut)
+	}
+
+	md := GenerateQualityReportMarkdown(report)
+	for _, want := range []string{"# Issue Quality Report", "Checked 4 open issues", "## Empty descriptions (1)", `Login \| SSO`, "## Long epics without child issues (1)"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown missing %q:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "Generated") || strings.Contains(md, "Unresolved markers") {
+		t.Errorf("Zero timestamp and empty sections should be left out:\n%s", md)
+	}
+
+	report.SpellChecked = true
+	report.Counts[analysis.QualitySpelling] = 1
+	report.Findings = append(report.Findings, analysis.QualityFinding{IssueID: "S", Title: "Typos", Kind: analysis.QualitySpelling, Message: "S spelling: recieve → receive"})
+	md = GenerateQualityReportMarkdown(report)
+	if !strings.Contains(md, "1 with spelling slips, 0 with vague wording.") || !strings.Contains(md, "## Spelling (1)") {
+		t.Errorf("Spell-checked report should summarize and list spelling findings:\n%s", md)
+	}
+
+	clean := GenerateQualityReportMarkdown(analysis.QualityReport{Checked: 3})
+	if !strings.Contains(clean, "No problems found in 3 open issues") {
+		t.Errorf("Unexpected clean report:\n%s", clean)
+	}
+}
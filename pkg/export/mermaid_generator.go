@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
@@ -130,4 +131,80 @@ func GenerateMermaidGraph(issues []model.Issue, issueIDs map[string]bool, config
 	return sb.String()
 }
 
+// MaxGanttOpenTasks caps the number of non-critical open issues in a Gantt chart
+// so large backlogs still render legibly.
+const MaxGanttOpenTasks = 30
+
+// GenerateMermaidGantt renders a timeline as a Mermaid gantt chart.
+// The critical path gets its own section (tagged crit); other open issues follow,
+// most recently created first, capped at MaxGanttOpenTasks. Closed issues that
+// are not on the critical path are omitted. Returns "" if nothing is plottable.
+func GenerateMermaidGantt(tl analysis.Timeline) string {
+	entryByID := make(map[string]analysis.TimelineEntry, len(tl.Entries))
+	for _, e := range tl.Entries {
+		entryByID[e.ID] = e
+	}
+
+	var critical []analysis.TimelineEntry
+	for _, id := range tl.CriticalPath {
+		if e, ok := entryByID[id]; ok {
+			critical = append(critical, e)
+		}
+	}
+
+	var open []analysis.TimelineEntry
+	for i := len(tl.Entries) - 1; i >= 0 && len(open) < MaxGanttOpenTasks; i-- {
+		e := tl.Entries[i]
+		if e.Critical || !e.Ongoing {
+			continue
+		}
+		open = append(open, e)
+	}
+
+	if len(critical) == 0 && len(open) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("gantt\n")
+	sb.WriteString("    dateFormat YYYY-MM-DD\n")
+	sb.WriteString("    axisFormat %b %d\n")
+
+	writeTask := func(e analysis.TimelineEntry, crit bool) {
+		var tags []string
+		if crit {
+			tags = append(tags, "crit")
+		}
+		if e.Ongoing {
+			tags = append(tags, "active")
+		} else {
+			tags = append(tags, "done")
+		}
+		name := strings.ReplaceAll(sanitizeMermaidText(e.ID+" "+e.Title), ":", " ")
+		name = strings.ReplaceAll(name, "#", "")
+		name = strings.ReplaceAll(name, ";", ",")
+		end := e.End
+		if !end.After(e.Start) {
+			end = e.Start.AddDate(0, 0, 1)
+		}
+		sb.WriteString(fmt.Sprintf("    %s :%s, %s, %s\n",
+			name, strings.Join(tags, ", "), e.Start.Format("2006-01-02"), end.Format("2006-01-02")))
+	}
+
+	if len(critical) > 0 {
+		sb.WriteString("    section Critical path\n")
+		for _, e := range critical {
+			writeTask(e, true)
+		}
+	}
+	if len(open) > 0 {
+		sb.WriteString("    section Open\n")
+		for _, e := range open {
+			writeTask(e, false)
+		}
+	}
+
+	return sb.String()
+}
+
 // Note: sanitizeMermaidID and sanitizeMermaidText are defined in markdown.go
@@ -0,0 +1,124 @@
+package export
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// diagramStatuses are the statuses PlantUML and D2 diagrams define a style
+// for, with the DOT export's fill colors
+var diagramStatuses = []model.Status{model.StatusOpen, model.StatusInProgress, model.StatusBlocked, model.StatusClosed}
+
+// generatePlantUML creates a PlantUML diagram of the graph: one rectangle
+// per issue, stereotyped and colored by status, and an arrow from each
+// issue to what it depends on (bold red for blocks, dashed for the rest).
+func generatePlantUML(issues []model.Issue, issueIDs map[string]bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("@startuml\n")
+	sb.WriteString("left to right direction\n")
+	sb.WriteString("skinparam defaultFontName Helvetica\n")
+	sb.WriteString("skinparam rectangle {\n")
+	for _, status := range diagramStatuses {
+		sb.WriteString(fmt.Sprintf("    BackgroundColor<<%s>> %s\n", status, dotStatusColor(status)))
+	}
+	sb.WriteString("}\n\n")
+
+	sortedIssues := sortedGraphIssues(issues)
+	aliases := plantUMLAliases(sortedIssues)
+
+	// Nodes
+	for _, i := range sortedIssues {
+		label := fmt.Sprintf("%s\\n%s\\nP%d %s", sanitizePlantUMLText(i.ID), sanitizePlantUMLText(truncate(i.Title, 30)), i.Priority, i.Status)
+		sb.WriteString(fmt.Sprintf("rectangle \"%s\" <<%s>> as %s\n", label, i.Status, aliases[i.ID]))
+	}
+
+	sb.WriteString("\n")
+
+	// Edges
+	for _, i := range sortedIssues {
+		for _, dep := range sortedDependencies(i) {
+			if !issueIDs[dep.DependsOnID] {
+				continue
+			}
+			style := "#999999,dashed"
+			if dep.Type == model.DepBlocks {
+				style = "#E53935,bold"
+			}
+			sb.WriteString(fmt.Sprintf("%s -[%s]-> %s\n", aliases[i.ID], style, aliases[dep.DependsOnID]))
+		}
+	}
+
+	sb.WriteString("@enduml\n")
+	return sb.String()
+}
+
+// plantUMLAliases maps issue IDs to PlantUML aliases, which must be plain
+// identifiers. IDs that clean up to the same alias get a stable hash suffix.
+func plantUMLAliases(sortedIssues []model.Issue) map[string]string {
+	aliases := make(map[string]string, len(sortedIssues))
+	used := make(map[string]bool, len(sortedIssues))
+	for _, i := range sortedIssues {
+		base := strings.Map(func(r rune) rune {
+			if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+				return r
+			}
+			return '_'
+		}, i.ID)
+		if base == "" || unicode.IsDigit(rune(base[0])) {
+			base = "n_" + base
+		}
+		alias := base
+		if used[alias] {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(i.ID))
+			alias = fmt.Sprintf("%s_%x", base, h.Sum32())
+		}
+		used[alias] = true
+		aliases[i.ID] = alias
+	}
+	return aliases
+}
+
+// sanitizePlantUMLText prepares text for a double-quoted PlantUML label.
+// PlantUML has no escape for a double quote, and a backslash would start
+// an escape such as \n, so both are replaced.
+func sanitizePlantUMLText(text string) string {
+	replacer := strings.NewReplacer("\"", "'", "\\", "/", "\n", " ", "\r", "")
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, replacer.Replace(text))
+}
+
+// sortedGraphIssues returns issues sorted by ID, for deterministic output
+func sortedGraphIssues(issues []model.Issue) []model.Issue {
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// sortedDependencies returns an issue's non-nil dependencies sorted by
+// target, for deterministic output
+func sortedDependencies(issue model.Issue) []*model.Dependency {
+	deps := make([]*model.Dependency, 0, len(issue.Dependencies))
+	for _, dep := range issue.Dependencies {
+		if dep != nil {
+			deps = append(deps, dep)
+		}
+	}
+	sort.SliceStable(deps, func(a, b int) bool {
+		return deps[a].DependsOnID < deps[b].DependsOnID
+	})
+	return deps
+}
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// qualitySections lists report sections in order, with their headings
+var qualitySections = []struct {
+	Kind    analysis.QualityKind
+	Heading string
+}{
+	{analysis.QualityEmptyDescription, "Empty descriptions"},
+	{analysis.QualityUnresolvedMarker, "Unresolved markers in acceptance criteria"},
+	{analysis.QualityUnsplitEpic, "Long epics without child issues"},
+	{analysis.QualitySpelling, "Spelling"},
+	{analysis.QualityVagueWording, "Vague wording"},
+}
+
+// GenerateQualityReportCSV renders a quality report as CSV, one finding per row
+func GenerateQualityReportCSV(report analysis.QualityReport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"issue", "title", "kind", "message"}); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, f := range report.Findings {
+		if err := w.Write([]string{f.IssueID, f.Title, string(f.Kind), f.Message}); err != nil {
+			return "", fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// GenerateQualityReportMarkdown renders a quality report with a summary line
+// and one table per kind of finding
+func GenerateQualityReportMarkdown(report analysis.QualityReport) string {
+	var sb strings.Builder
+	sb.WriteString("# Issue Quality Report\n\n")
+	// A zero GeneratedAt (stable exports) leaves the timestamp out
+	if !report.GeneratedAt.IsZero() {
+		sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", report.GeneratedAt.Format("2006-01-02 15:04 MST")))
+	}
+
+	if len(report.Findings) == 0 {
+		sb.WriteString(fmt.Sprintf("No problems found in %d open issues.\n", report.Checked))
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("Checked %d open issues: %d without a description, %d with unresolved markers, %d epics to split",
+		report.Checked,
+		report.Counts[analysis.QualityEmptyDescription],
+		report.Counts[analysis.QualityUnresolvedMarker],
+		report.Counts[analysis.QualityUnsplitEpic]))
+	if report.SpellChecked {
+		sb.WriteString(fmt.Sprintf(", %d with spelling slips, %d with vague wording",
+			report.Counts[analysis.QualitySpelling],
+			report.Counts[analysis.QualityVagueWording]))
+	}
+	sb.WriteString(".\n")
+
+	for _, section := range qualitySections {
+		if report.Counts[section.Kind] == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s (%d)\n\n", section.Heading, report.Counts[section.Kind]))
+		sb.WriteString("| Issue | Title | Problem |\n")
+		sb.WriteString("|-------|-------|---------|\n")
+		for _, f := range report.Findings {
+			if f.Kind != section.Kind {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", f.IssueID, escapeTableCell(f.Title), escapeTableCell(f.Message)))
+		}
+	}
+	return sb.String()
+}
+
+// SaveQualityReport writes the report to filename, choosing CSV for a .csv
+// extension and Markdown otherwise
+func SaveQualityReport(report analysis.QualityReport, filename string) error {
+	var content string
+	if strings.EqualFold(filepath.Ext(filename), ".csv") {
+		csvContent, err := GenerateQualityReportCSV(report)
+		if err != nil {
+			return err
+		}
+		content = csvContent
+	} else {
+		content = GenerateQualityReportMarkdown(report)
+	}
+	return readonly.WriteFile(filename, []byte(content), 0644)
+}
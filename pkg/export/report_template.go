@@ -0,0 +1,260 @@
+// Package export provides data export functionality for bv.
+//
+// This file implements report templates: user-supplied Go templates in
+// .bv/templates/ that replace the built-in Markdown and HTML report layouts,
+// so section order, fields and headers can follow in-house documentation
+// standards.
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// ReportTemplatesDirname is the template directory under .bv/
+const ReportTemplatesDirname = "templates"
+
+// Report template entry points. Other *.md.tmpl or *.html.tmpl files in the
+// directory are parsed alongside, so they can hold partials.
+const (
+	MarkdownReportTemplate = "report.md.tmpl"
+	HTMLReportTemplate     = "report.html.tmpl"
+)
+
+// ReportTemplatesDir returns the path to .bv/templates
+func ReportTemplatesDir(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ReportTemplatesDirname)
+}
+
+// ReportStatusCounts counts a report's issues by status
+type ReportStatusCounts struct {
+	Total      int
+	Open       int
+	InProgress int
+	Blocked    int
+	Closed     int
+}
+
+// countStatuses counts issues by status
+func countStatuses(issues []model.Issue) ReportStatusCounts {
+	c := ReportStatusCounts{Total: len(issues)}
+	for _, i := range issues {
+		switch i.Status {
+		case model.StatusOpen:
+			c.Open++
+		case model.StatusInProgress:
+			c.InProgress++
+		case model.StatusBlocked:
+			c.Blocked++
+		case model.StatusClosed:
+			c.Closed++
+		}
+	}
+	return c
+}
+
+// ReportSections holds the built-in report's sections as Markdown, so a
+// template can keep some of them, reorder them, or drop the rest
+type ReportSections struct {
+	Summary         string
+	QuickActions    string
+	TOC             string
+	DependencyGraph string
+	Timeline        string // "" when nothing can be scheduled
+}
+
+// ReportData is what a report template is executed with
+type ReportData struct {
+	Title     string
+	Generated time.Time // Zero for stable exports
+	Counts    ReportStatusCounts
+	Issues    []model.Issue
+	Mermaid   string // Dependency graph as Mermaid source
+	Gantt     string // Timeline as a Mermaid gantt chart, "" when empty
+	Sections  ReportSections
+}
+
+// newReportData gathers everything a report template can use
+func newReportData(issues []model.Issue, title string, now time.Time, opts MarkdownOptions) ReportData {
+	gantt := reportGantt(issues, now)
+	d := ReportData{
+		Title:   title,
+		Counts:  countStatuses(issues),
+		Issues:  issues,
+		Mermaid: reportMermaidGraph(issues),
+		Gantt:   gantt,
+		Sections: ReportSections{
+			Summary:         markdownSummary(issues),
+			QuickActions:    generateQuickActions(issues),
+			TOC:             markdownTOC(issues),
+			DependencyGraph: markdownDependencyGraph(issues),
+			Timeline:        markdownTimeline(gantt),
+		},
+	}
+	if !opts.Stable {
+		d.Generated = now
+	}
+	return d
+}
+
+// reportFuncs are the helpers report templates can call
+var reportFuncs = map[string]any{
+	"statusEmoji":  func(s model.Status) string { return getStatusEmoji(string(s)) },
+	"typeEmoji":    func(t model.IssueType) string { return getTypeEmoji(string(t)) },
+	"priority":     getPriorityLabel,
+	"slug":         createSlug,
+	"date":         func(t time.Time) string { return t.Format("2006-01-02") },
+	"datetime":     func(t time.Time) string { return t.Format("2006-01-02 15:04") },
+	"join":         strings.Join,
+	"cell":         markdownCell,
+	"issueSection": markdownIssue,
+	"commands":     generateIssueCommands,
+}
+
+// markdownCell makes text safe for a Markdown table cell
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "\r", "")
+	text = strings.ReplaceAll(text, "\n", " ")
+	return strings.ReplaceAll(text, "|", "\\|")
+}
+
+// renderMarkdownTemplate executes report.md.tmpl from dir. ok is false,
+// with no error, when dir has no such template.
+func renderMarkdownTemplate(dir string, data ReportData) (content string, ok bool, err error) {
+	if !hasReportTemplate(dir, MarkdownReportTemplate) {
+		return "", false, nil
+	}
+	tmpl, err := template.New(MarkdownReportTemplate).Funcs(reportFuncs).ParseGlob(filepath.Join(dir, "*.md.tmpl"))
+	if err != nil {
+		return "", true, fmt.Errorf("parsing report template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, MarkdownReportTemplate, data); err != nil {
+		return "", true, fmt.Errorf("rendering report template: %w", err)
+	}
+	return buf.String(), true, nil
+}
+
+// hasReportTemplate reports whether dir holds the named template file
+func hasReportTemplate(dir, name string) bool {
+	_, err := os.Stat(filepath.Join(dir, name))
+	return err == nil || !errors.Is(err, os.ErrNotExist)
+}
+
+var htmlReportTemplate = htmltemplate.Must(htmltemplate.New(HTMLReportTemplate).Funcs(reportFuncs).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title>
+<style>
+body{font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;color:#24292e;max-width:860px;margin:0 auto;padding:16px}
+h2{font-size:18px;border-bottom:1px solid #e1e4e8;padding-bottom:4px;margin-top:32px}
+h3{font-size:14px;margin-bottom:4px}
+table{border-collapse:collapse}
+th,td{text-align:left;padding:4px 16px 4px 0;vertical-align:top}
+.num{text-align:right}
+.muted{color:#6a737d}
+.text{white-space:pre-wrap}
+blockquote{margin:8px 0;padding-left:12px;border-left:3px solid #e1e4e8}
+</style></head>
+<body>
+<h1 style="font-size:24px;margin:0 0 4px">{{.Title}}</h1>
+{{if not .Generated.IsZero}}<p class="muted">Generated {{.Generated.Format "Mon, 02 Jan 2006 15:04:05 MST"}}</p>{{end}}
+
+<h2>Summary</h2>
+<table>
+<tr><td><strong>Total</strong></td><td class="num">{{.Counts.Total}}</td></tr>
+<tr><td>Open</td><td class="num">{{.Counts.Open}}</td></tr>
+<tr><td>In Progress</td><td class="num">{{.Counts.InProgress}}</td></tr>
+<tr><td>Blocked</td><td class="num">{{.Counts.Blocked}}</td></tr>
+<tr><td>Closed</td><td class="num">{{.Counts.Closed}}</td></tr>
+</table>
+
+<h2>Contents</h2>
+<ul>
+{{range .Issues}}<li><a href="#{{slug .ID}}">{{statusEmoji .Status}} {{.ID}} {{.Title}}</a></li>
+{{end}}</ul>
+{{range .Issues}}
+<h2 id="{{slug .ID}}">{{typeEmoji .IssueType}} {{.ID}} {{.Title}}</h2>
+<table>
+<tr><th>Type</th><td>{{.IssueType}}</td></tr>
+<tr><th>Priority</th><td>{{priority .Priority}}</td></tr>
+<tr><th>Status</th><td>{{statusEmoji .Status}} {{.Status}}</td></tr>
+{{if .Assignee}}<tr><th>Assignee</th><td>@{{.Assignee}}</td></tr>
+{{end}}<tr><th>Created</th><td>{{datetime .CreatedAt}}</td></tr>
+<tr><th>Updated</th><td>{{datetime .UpdatedAt}}</td></tr>
+{{if .ClosedAt}}<tr><th>Closed</th><td>{{datetime .ClosedAt}}</td></tr>
+{{end}}{{if .Labels}}<tr><th>Labels</th><td>{{join .Labels ", "}}</td></tr>
+{{end}}</table>
+{{if .Description}}<h3>Description</h3>
+<div class="text">{{.Description}}</div>
+{{end}}{{if .AcceptanceCriteria}}<h3>Acceptance Criteria</h3>
+<div class="text">{{.AcceptanceCriteria}}</div>
+{{end}}{{if .Design}}<h3>Design</h3>
+<div class="text">{{.Design}}</div>
+{{end}}{{if .Notes}}<h3>Notes</h3>
+<div class="text">{{.Notes}}</div>
+{{end}}{{if .Dependencies}}<h3>Dependencies</h3>
+<ul>
+{{range .Dependencies}}{{if .}}<li>{{.Type}}: <code>{{.DependsOnID}}</code></li>
+{{end}}{{end}}</ul>
+{{end}}{{if .Comments}}<h3>Comments</h3>
+{{range .Comments}}{{if .}}<blockquote><strong>{{.Author}}</strong> <span class="muted">({{date .CreatedAt}})</span><div class="text">{{.Text}}</div></blockquote>
+{{end}}{{end}}{{end}}{{end}}
+<h2>Dependency Graph</h2>
+<p class="muted">Mermaid source</p>
+<pre>{{.Mermaid}}</pre>
+
+<p class="muted" style="font-size:12px;margin-top:24px">Generated by bv (beads viewer).</p>
+</body>
+</html>
+`))
+
+// GenerateHTMLReport renders issues as a self-contained HTML page. A
+// report.html.tmpl in opts.TemplateDir replaces the built-in layout.
+func GenerateHTMLReport(issues []model.Issue, title string, opts MarkdownOptions) (string, error) {
+	data := newReportData(issues, title, reportTime(issues, opts), opts)
+
+	tmpl := htmlReportTemplate
+	if opts.TemplateDir != "" && hasReportTemplate(opts.TemplateDir, HTMLReportTemplate) {
+		var err error
+		tmpl, err = htmltemplate.New(HTMLReportTemplate).Funcs(reportFuncs).ParseGlob(filepath.Join(opts.TemplateDir, "*.html.tmpl"))
+		if err != nil {
+			return "", fmt.Errorf("parsing report template: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, HTMLReportTemplate, data); err != nil {
+		return "", fmt.Errorf("rendering report template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// RenderReport renders the issue report, sorted like the file exports, as
+// an HTML page or as Markdown
+func RenderReport(issues []model.Issue, html bool, opts MarkdownOptions) (string, error) {
+	if html {
+		return GenerateHTMLReport(sortReportIssues(issues), reportTitle(opts), opts)
+	}
+	return GenerateMarkdownWithOptions(sortReportIssues(issues), reportTitle(opts), opts)
+}
+
+// SaveReportToFile writes the issue report to filename, choosing HTML for an
+// .html or .htm extension and Markdown otherwise
+func SaveReportToFile(issues []model.Issue, filename string, opts MarkdownOptions) error {
+	ext := strings.ToLower(filepath.Ext(filename))
+	content, err := RenderReport(issues, ext == ".html" || ext == ".htm", opts)
+	if err != nil {
+		return err
+	}
+	return readonly.WriteFile(filename, []byte(content), 0644)
+}
@@ -0,0 +1,124 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func reportTemplateIssues() []model.Issue {
+	created := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	closed := created.Add(48 * time.Hour)
+	return []model.Issue{
+		{ID: "R-1", Title: "Ship <login>", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeFeature,
+			Assignee: "alice", Labels: []string{"auth", "web"}, Description: "Line one\nLine | two", CreatedAt: created, UpdatedAt: created},
+		{ID: "R-2", Title: "Old bug", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeBug,
+			CreatedAt: created, UpdatedAt: closed, ClosedAt: &closed,
+			Dependencies: []*model.Dependency{{IssueID: "R-2", DependsOnID: "R-1", Type: model.DepBlocks}}},
+	}
+}
+
+func writeReportTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMarkdownReport_Template(t *testing.T) {
+	dir := t.TempDir()
+	writeReportTemplate(t, dir, MarkdownReportTemplate, `# {{.Title}} (ACME-DOC-7)
+{{template "row" .Counts}}
+{{range .Issues}}- {{.ID}} | {{cell .Description}} | {{join .Labels "+"}}
+{{end}}{{.Sections.Summary}}`)
+	writeReportTemplate(t, dir, "partials.md.tmpl", `{{define "row"}}open={{.Open}} closed={{.Closed}}{{end}}`)
+
+	opts := MarkdownOptions{Stable: true, TemplateDir: dir}
+	got, err := GenerateMarkdownWithOptions(reportTemplateIssues()[:1], "Status", opts)
+	if err != nil {
+		t.Fatalf("GenerateMarkdownWithOptions: %v", err)
+	}
+	for _, want := range []string{"# Status (ACME-DOC-7)", "open=1 closed=0", `- R-1 | Line one Line \| two | auth+web`, "## Summary"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Table of Contents") {
+		t.Error("sections the template leaves out shouldn't appear")
+	}
+
+	// Without a report.md.tmpl the built-in layout is used
+	builtin, _ := GenerateMarkdownWithOptions(reportTemplateIssues(), "Status", MarkdownOptions{Stable: true})
+	other, err := GenerateMarkdownWithOptions(reportTemplateIssues(), "Status", MarkdownOptions{Stable: true, TemplateDir: t.TempDir()})
+	if err != nil || other != builtin {
+		t.Errorf("an empty template directory should fall back to the built-in report (err %v)", err)
+	}
+}
+
+func TestMarkdownReport_TemplateErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeReportTemplate(t, dir, MarkdownReportTemplate, `{{.Title`)
+	if _, err := GenerateMarkdownWithOptions(reportTemplateIssues(), "T", MarkdownOptions{TemplateDir: dir}); err == nil || !strings.Contains(err.Error(), "parsing report template") {
+		t.Errorf("a broken template should fail the export, got %v", err)
+	}
+
+	writeReportTemplate(t, dir, MarkdownReportTemplate, `{{.NoSuchField}}`)
+	if _, err := GenerateMarkdownWithOptions(reportTemplateIssues(), "T", MarkdownOptions{TemplateDir: dir}); err == nil || !strings.Contains(err.Error(), "rendering report template") {
+		t.Errorf("an unknown field should fail the export, got %v", err)
+	}
+}
+
+func TestHTMLReport(t *testing.T) {
+	html, err := GenerateHTMLReport(reportTemplateIssues(), "Team <Report>", MarkdownOptions{Stable: true})
+	if err != nil {
+		t.Fatalf("GenerateHTMLReport: %v", err)
+	}
+	for _, want := range []string{
+		"<title>Team &lt;Report&gt;</title>",
+		`<a href="#r-1">`,
+		"Ship &lt;login&gt;",
+		"<th>Closed</th><td>2025-03-03 09:00</td>",
+		"blocks: <code>R-1</code>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("missing %q in built-in HTML report", want)
+		}
+	}
+	if strings.Contains(html, `<p class="muted">Generated`) {
+		t.Error("stable HTML reports shouldn't carry a generation time")
+	}
+
+	dir := t.TempDir()
+	writeReportTemplate(t, dir, HTMLReportTemplate, `<h1>{{.Title}}</h1>{{range .Issues}}<p>{{.ID}}: {{.Title}}</p>{{end}}`)
+	html, err = GenerateHTMLReport(reportTemplateIssues(), "Mine", MarkdownOptions{TemplateDir: dir})
+	if err != nil {
+		t.Fatalf("GenerateHTMLReport with template: %v", err)
+	}
+	if html != "<h1>Mine</h1><p>R-1: Ship &lt;login&gt;</p><p>R-2: Old bug</p>" {
+		t.Errorf("custom HTML template output = %q", html)
+	}
+}
+
+func TestSaveReportToFile(t *testing.T) {
+	dir := t.TempDir()
+	for name, want := range map[string]string{
+		"report.md":   "# Weekly\n",
+		"report.html": "<h1 style=\"font-size:24px;margin:0 0 4px\">Weekly</h1>",
+	} {
+		path := filepath.Join(dir, name)
+		if err := SaveReportToFile(reportTemplateIssues(), path, MarkdownOptions{Title: "Weekly", Stable: true}); err != nil {
+			t.Fatalf("SaveReportToFile(%s): %v", name, err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s should contain %q", name, want)
+		}
+	}
+}
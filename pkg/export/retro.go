@@ -0,0 +1,452 @@
+package export
+
+// This file implements the sprint retrospective: planned vs delivered work,
+// scope added and removed mid-sprint, the carry-over list, and velocity
+// against the sprints before it, as Markdown or HTML.
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// retroVelocitySprints is how many sprints the velocity comparison shows,
+// the retrospective's own sprint included
+const retroVelocitySprints = 5
+
+// RetroScopeChange is one issue added to or removed from the sprint after
+// it started, as read from the git history of the sprints file
+type RetroScopeChange struct {
+	Date    time.Time
+	IssueID string
+	Action  string // "added" or "removed"
+}
+
+// RetroItem is one issue in a retrospective section
+type RetroItem struct {
+	ID        string
+	Title     string
+	Status    model.Status
+	Estimate  float64
+	Estimated bool
+	ClosedAt  *time.Time
+	ChangedAt time.Time // When it was added or removed, for scope changes
+}
+
+// SprintVelocity is what one sprint committed to and delivered
+type SprintVelocity struct {
+	SprintID  string
+	Name      string
+	EndDate   time.Time
+	Committed int
+	Delivered int
+	Points    float64 // Estimates delivered, in the retrospective's unit
+}
+
+// SprintRetro is the content of one sprint retrospective
+type SprintRetro struct {
+	Sprint      model.Sprint
+	GeneratedAt time.Time // zero omits the timestamp (stable output)
+	Unit        analysis.EstimateUnit
+
+	Planned   []RetroItem // In the sprint when it started
+	Added     []RetroItem // Added after it started
+	Removed   []RetroItem // Removed after it started
+	Delivered []RetroItem // Closed by the end date
+	CarryOver []RetroItem // Still open at the end date
+
+	PlannedDelivered int              // Planned issues that were delivered
+	Velocity         []SprintVelocity // Oldest first, ending with this sprint
+}
+
+// BuildSprintRetro assembles the retrospective of sprint. Its scope at the
+// start is its current issues with changes undone, newest first; sprints
+// are the sprints to compare velocity with.
+func BuildSprintRetro(sprint model.Sprint, sprints []model.Sprint, issues []model.Issue, changes []RetroScopeChange, now time.Time) SprintRetro {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	var sprintIssues []model.Issue
+	for _, id := range sprint.BeadIDs {
+		if issue, ok := issueMap[id]; ok {
+			sprintIssues = append(sprintIssues, *issue)
+		}
+	}
+
+	r := SprintRetro{Sprint: sprint, GeneratedAt: now, Unit: analysis.EstimateUnitFor(sprintIssues)}
+	item := func(id string) RetroItem {
+		it := RetroItem{ID: id}
+		if issue, ok := issueMap[id]; ok {
+			it.Title = issue.Title
+			it.Status = issue.Status
+			it.ClosedAt = issue.ClosedAt
+			it.Estimate, it.Estimated = analysis.IssueEstimate(issue, r.Unit)
+		}
+		return it
+	}
+
+	// Undo the changes to get the scope the sprint started with
+	final := make(map[string]bool, len(sprint.BeadIDs))
+	for _, id := range sprint.BeadIDs {
+		final[id] = true
+	}
+	start := make(map[string]bool, len(final))
+	for id := range final {
+		start[id] = true
+	}
+	changes = append([]RetroScopeChange(nil), changes...)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Date.Before(changes[j].Date) })
+	for i := len(changes) - 1; i >= 0; i-- {
+		switch changes[i].Action {
+		case "added":
+			delete(start, changes[i].IssueID)
+		case "removed":
+			start[changes[i].IssueID] = true
+		}
+	}
+	lastChange := make(map[string]time.Time, len(changes))
+	for _, c := range changes {
+		lastChange[c.IssueID] = c.Date
+	}
+
+	for id := range start {
+		r.Planned = append(r.Planned, item(id))
+		if !final[id] {
+			it := item(id)
+			it.ChangedAt = lastChange[id]
+			r.Removed = append(r.Removed, it)
+		}
+	}
+	for _, id := range sprint.BeadIDs {
+		if !start[id] {
+			it := item(id)
+			it.ChangedAt = lastChange[id]
+			r.Added = append(r.Added, it)
+		}
+		it := item(id)
+		if deliveredBy(issueMap[id], sprint.EndDate) {
+			r.Delivered = append(r.Delivered, it)
+			if start[id] {
+				r.PlannedDelivered++
+			}
+		} else {
+			r.CarryOver = append(r.CarryOver, it)
+		}
+	}
+	for _, section := range [][]RetroItem{r.Planned, r.Added, r.Removed, r.Delivered, r.CarryOver} {
+		sort.Slice(section, func(i, j int) bool { return section[i].ID < section[j].ID })
+	}
+
+	r.Velocity = sprintVelocities(sprint, sprints, issueMap, r.Unit)
+	return r
+}
+
+// deliveredBy reports whether issue was closed by end. A closed issue with
+// no close time counts as delivered.
+func deliveredBy(issue *model.Issue, end time.Time) bool {
+	if issue == nil || !issue.Status.IsClosed() {
+		return false
+	}
+	return issue.ClosedAt == nil || end.IsZero() || !issue.ClosedAt.After(end)
+}
+
+// sprintVelocities returns the velocity of sprint and of up to
+// retroVelocitySprints-1 sprints that ended before it, oldest first
+func sprintVelocities(sprint model.Sprint, sprints []model.Sprint, issueMap map[string]*model.Issue, unit analysis.EstimateUnit) []SprintVelocity {
+	var prior []model.Sprint
+	for _, s := range sprints {
+		if s.ID != sprint.ID && !s.EndDate.IsZero() && s.EndDate.Before(sprint.EndDate) {
+			prior = append(prior, s)
+		}
+	}
+	sort.SliceStable(prior, func(i, j int) bool { return prior[i].EndDate.Before(prior[j].EndDate) })
+	if len(prior) > retroVelocitySprints-1 {
+		prior = prior[len(prior)-(retroVelocitySprints-1):]
+	}
+
+	velocities := make([]SprintVelocity, 0, len(prior)+1)
+	for _, s := range append(prior, sprint) {
+		v := SprintVelocity{SprintID: s.ID, Name: s.Name, EndDate: s.EndDate, Committed: len(s.BeadIDs)}
+		for _, id := range s.BeadIDs {
+			if issue := issueMap[id]; deliveredBy(issue, s.EndDate) {
+				v.Delivered++
+				if estimate, ok := analysis.IssueEstimate(issue, unit); ok {
+					v.Points += estimate
+				}
+			}
+		}
+		velocities = append(velocities, v)
+	}
+	return velocities
+}
+
+// Completion is the share of the final scope that was delivered, 0-100
+func (r SprintRetro) Completion() int {
+	total := len(r.Delivered) + len(r.CarryOver)
+	if total == 0 {
+		return 0
+	}
+	return len(r.Delivered) * 100 / total
+}
+
+// PriorAverage is the average number of issues the earlier sprints in the
+// velocity comparison delivered, or false if there are none
+func (r SprintRetro) PriorAverage() (float64, bool) {
+	if len(r.Velocity) < 2 {
+		return 0, false
+	}
+	prior := r.Velocity[:len(r.Velocity)-1]
+	sum := 0
+	for _, v := range prior {
+		sum += v.Delivered
+	}
+	return float64(sum) / float64(len(prior)), true
+}
+
+// VelocitySummary compares the sprint's delivery with the earlier sprints',
+// e.g. "Delivered 7 issues vs an average of 5.5 over the previous 3 sprints (+27%)."
+func (r SprintRetro) VelocitySummary() string {
+	delivered := fmt.Sprintf("%d issues", len(r.Delivered))
+	if len(r.Delivered) == 1 {
+		delivered = "1 issue"
+	}
+	avg, ok := r.PriorAverage()
+	if !ok {
+		return fmt.Sprintf("Delivered %s. No earlier sprints to compare with.", delivered)
+	}
+	prior := len(r.Velocity) - 1
+	sprints := "sprints"
+	if prior == 1 {
+		sprints = "sprint"
+	}
+	change := ""
+	if avg > 0 {
+		change = fmt.Sprintf(" (%+.0f%%)", (float64(len(r.Delivered))-avg)/avg*100)
+	}
+	return fmt.Sprintf("Delivered %s vs an average of %s over the previous %d %s%s.",
+		delivered, formatRetroFloat(avg), prior, sprints, change)
+}
+
+// sum totals the estimates of items in the retrospective's unit
+func (r SprintRetro) sum(items []RetroItem) string {
+	total := 0.0
+	for _, it := range items {
+		total += it.Estimate
+	}
+	return analysis.FormatEstimate(total, r.Unit)
+}
+
+func formatRetroFloat(f float64) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", f), ".0")
+}
+
+func retroDate(t time.Time) string {
+	if t.IsZero() {
+		return "?"
+	}
+	return t.Format("2006-01-02")
+}
+
+// retroSummaryRows are the rows of the summary table: label and items
+func (r SprintRetro) retroSummaryRows() []struct {
+	Label string
+	Items []RetroItem
+} {
+	return []struct {
+		Label string
+		Items []RetroItem
+	}{
+		{"Planned", r.Planned},
+		{"Added mid-sprint", r.Added},
+		{"Removed mid-sprint", r.Removed},
+		{"Delivered", r.Delivered},
+		{"Carried over", r.CarryOver},
+	}
+}
+
+// GenerateSprintRetroMarkdown renders the retrospective as Markdown
+func GenerateSprintRetroMarkdown(r SprintRetro) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Sprint Retrospective: %s\n\n", r.Sprint.Name))
+	meta := []string{fmt.Sprintf("*%s – %s*", retroDate(r.Sprint.StartDate), retroDate(r.Sprint.EndDate))}
+	if !r.GeneratedAt.IsZero() {
+		meta = append(meta, fmt.Sprintf("*Generated: %s*", r.GeneratedAt.Format("2006-01-02 15:04 MST")))
+	}
+	sb.WriteString(strings.Join(meta, " · ") + "\n\n")
+
+	sb.WriteString("## Summary\n\n")
+	if r.Unit != "" {
+		sb.WriteString("| | Issues | Estimate |\n|---|---|---|\n")
+	} else {
+		sb.WriteString("| | Issues |\n|---|---|\n")
+	}
+	for _, row := range r.retroSummaryRows() {
+		if r.Unit != "" {
+			sb.WriteString(fmt.Sprintf("| %s | %d | %s |\n", row.Label, len(row.Items), r.sum(row.Items)))
+		} else {
+			sb.WriteString(fmt.Sprintf("| %s | %d |\n", row.Label, len(row.Items)))
+		}
+	}
+	sb.WriteString(fmt.Sprintf("\n%d%% of the final scope was delivered, including %d of %d planned issues.\n\n",
+		r.Completion(), r.PlannedDelivered, len(r.Planned)))
+
+	writeList := func(title, empty string, items []RetroItem, line func(RetroItem) string) {
+		sb.WriteString("## " + title + "\n\n")
+		if len(items) == 0 {
+			sb.WriteString(empty + "\n\n")
+			return
+		}
+		for _, it := range items {
+			sb.WriteString(line(it) + "\n")
+		}
+		sb.WriteString("\n")
+	}
+	label := func(it RetroItem) string {
+		s := fmt.Sprintf("`%s` %s", it.ID, escapeTableCell(it.Title))
+		if it.Estimated {
+			s += " (" + analysis.FormatEstimate(it.Estimate, r.Unit) + ")"
+		}
+		return s
+	}
+	writeList("Delivered", "Nothing was delivered.", r.Delivered, func(it RetroItem) string {
+		s := "- [x] " + label(it)
+		if it.ClosedAt != nil {
+			s += " — closed " + retroDate(*it.ClosedAt)
+		}
+		return s
+	})
+	writeList("Carried Over", "Nothing to carry over.", r.CarryOver, func(it RetroItem) string {
+		return fmt.Sprintf("- [ ] %s — %s", label(it), it.Status)
+	})
+	writeList("Scope Added Mid-Sprint", "No issues were added after the sprint started.", r.Added, func(it RetroItem) string {
+		return fmt.Sprintf("- %s — added %s", label(it), retroDate(it.ChangedAt))
+	})
+	writeList("Scope Removed Mid-Sprint", "No issues were removed after the sprint started.", r.Removed, func(it RetroItem) string {
+		return fmt.Sprintf("- %s — removed %s", label(it), retroDate(it.ChangedAt))
+	})
+
+	sb.WriteString("## Velocity\n\n")
+	if r.Unit != "" {
+		sb.WriteString("| Sprint | Ended | Committed | Delivered | Estimate |\n|---|---|---|---|---|\n")
+	} else {
+		sb.WriteString("| Sprint | Ended | Committed | Delivered |\n|---|---|---|---|\n")
+	}
+	for _, v := range r.Velocity {
+		name := escapeTableCell(v.Name)
+		if v.SprintID == r.Sprint.ID {
+			name = "**" + name + "**"
+		}
+		if r.Unit != "" {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d | %s |\n", name, retroDate(v.EndDate), v.Committed, v.Delivered, analysis.FormatEstimate(v.Points, r.Unit)))
+		} else {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d |\n", name, retroDate(v.EndDate), v.Committed, v.Delivered))
+		}
+	}
+	sb.WriteString("\n" + r.VelocitySummary() + "\n")
+	return sb.String()
+}
+
+var retroTemplate = template.Must(template.New("retro").Funcs(template.FuncMap{
+	"date":     retroDate,
+	"estimate": func(v float64, unit analysis.EstimateUnit) string { return analysis.FormatEstimate(v, unit) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Sprint retrospective: {{.Sprint.Name}}</title>
+<style>
+body{font-family:-apple-system,Segoe UI,Helvetica,Arial,sans-serif;color:#24292e;max-width:760px;margin:0 auto;padding:16px}
+h2{font-size:17px;border-bottom:1px solid #e1e4e8;padding-bottom:4px}
+table{border-collapse:collapse}
+th,td{text-align:left;padding:4px 16px 4px 0}
+.num{text-align:right}
+.muted{color:#6a737d}
+.current{font-weight:bold}
+</style></head>
+<body>
+<h1 style="font-size:22px;margin:0 0 4px">Sprint retrospective: {{.Sprint.Name}}</h1>
+<p class="muted">{{date .Sprint.StartDate}} &ndash; {{date .Sprint.EndDate}}{{if not .GeneratedAt.IsZero}} &middot; generated {{.GeneratedAt.Format "2006-01-02 15:04 MST"}}{{end}}</p>
+
+<h2>Summary</h2>
+<table>
+<tr><th></th><th class="num">Issues</th>{{if .Unit}}<th class="num">Estimate</th>{{end}}</tr>
+{{range .Rows}}<tr><td>{{.Label}}</td><td class="num">{{len .Items}}</td>{{if $.Unit}}<td class="num">{{.Sum}}</td>{{end}}</tr>
+{{end}}</table>
+<p>{{.Completion}}% of the final scope was delivered, including {{.PlannedDelivered}} of {{len .Planned}} planned issues.</p>
+
+<h2>Delivered</h2>
+{{if .Delivered}}<ul>
+{{range .Delivered}}<li><code>{{.ID}}</code> {{.Title}}{{if .Estimated}} ({{estimate .Estimate $.Unit}}){{end}}{{if .ClosedAt}} <span class="muted">closed {{date .ClosedAt}}</span>{{end}}</li>
+{{end}}</ul>{{else}}<p class="muted">Nothing was delivered.</p>{{end}}
+
+<h2>Carried over</h2>
+{{if .CarryOver}}<ul>
+{{range .CarryOver}}<li><code>{{.ID}}</code> {{.Title}}{{if .Estimated}} ({{estimate .Estimate $.Unit}}){{end}} <span class="muted">{{.Status}}</span></li>
+{{end}}</ul>{{else}}<p class="muted">Nothing to carry over.</p>{{end}}
+
+<h2>Scope added mid-sprint</h2>
+{{if .Added}}<ul>
+{{range .Added}}<li><code>{{.ID}}</code> {{.Title}}{{if .Estimated}} ({{estimate .Estimate $.Unit}}){{end}} <span class="muted">added {{date .ChangedAt}}</span></li>
+{{end}}</ul>{{else}}<p class="muted">No issues were added after the sprint started.</p>{{end}}
+
+<h2>Scope removed mid-sprint</h2>
+{{if .Removed}}<ul>
+{{range .Removed}}<li><code>{{.ID}}</code> {{.Title}}{{if .Estimated}} ({{estimate .Estimate $.Unit}}){{end}} <span class="muted">removed {{date .ChangedAt}}</span></li>
+{{end}}</ul>{{else}}<p class="muted">No issues were removed after the sprint started.</p>{{end}}
+
+<h2>Velocity</h2>
+<table>
+<tr><th>Sprint</th><th>Ended</th><th class="num">Committed</th><th class="num">Delivered</th>{{if .Unit}}<th class="num">Estimate</th>{{end}}</tr>
+{{range .Velocity}}<tr{{if eq .SprintID $.Sprint.ID}} class="current"{{end}}><td>{{.Name}}</td><td>{{date .EndDate}}</td><td class="num">{{.Committed}}</td><td class="num">{{.Delivered}}</td>{{if $.Unit}}<td class="num">{{estimate .Points $.Unit}}</td>{{end}}</tr>
+{{end}}</table>
+<p>{{.VelocitySummary}}</p>
+
+<p class="muted" style="font-size:12px;margin-top:24px">Generated by bv (beads viewer).</p>
+</body>
+</html>
+`))
+
+// GenerateSprintRetroHTML renders the retrospective as a self-contained HTML page
+func GenerateSprintRetroHTML(r SprintRetro) (string, error) {
+	type row struct {
+		Label string
+		Items []RetroItem
+		Sum   string
+	}
+	var rows []row
+	for _, sr := range r.retroSummaryRows() {
+		rows = append(rows, row{Label: sr.Label, Items: sr.Items, Sum: r.sum(sr.Items)})
+	}
+	data := struct {
+		SprintRetro
+		Rows []row
+	}{r, rows}
+
+	var buf bytes.Buffer
+	if err := retroTemplate.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering retrospective: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// SaveSprintRetro writes the retrospective to filename, choosing HTML for an
+// .html or .htm extension and Markdown otherwise
+func SaveSprintRetro(r SprintRetro, filename string) error {
+	content := GenerateSprintRetroMarkdown(r)
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".html", ".htm":
+		html, err := GenerateSprintRetroHTML(r)
+		if err != nil {
+			return err
+		}
+		content = html
+	}
+	return readonly.WriteFile(filename, []byte(content), 0644)
+}
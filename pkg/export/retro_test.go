@@ -0,0 +1,112 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func retroFixture() (model.Sprint, []model.Sprint, []model.Issue, []RetroScopeChange) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	closed := func(d int) *time.Time { t := day(d); return &t }
+	pts := func(p float64) *float64 { return &p }
+
+	issues := []model.Issue{
+		{ID: "r-1", Title: "Login page", Status: model.StatusClosed, ClosedAt: closed(5), Estimate: pts(3)},
+		{ID: "r-2", Title: "Password reset", Status: model.StatusInProgress, Estimate: pts(5)},
+		{ID: "r-3", Title: "Hotfix | urgent", Status: model.StatusClosed, ClosedAt: closed(8), Estimate: pts(1)},
+		{ID: "r-4", Title: "Closed too late", Status: model.StatusClosed, ClosedAt: closed(20), Estimate: pts(2)},
+		{ID: "r-5", Title: "Descoped", Status: model.StatusOpen},
+		{ID: "p-1", Title: "Earlier work", Status: model.StatusClosed, ClosedAt: closed(1)},
+		{ID: "p-2", Title: "Earlier work", Status: model.StatusClosed, ClosedAt: closed(1)},
+	}
+	sprint := model.Sprint{ID: "s2", Name: "Sprint 2", StartDate: day(2), EndDate: day(13), BeadIDs: []string{"r-1", "r-2", "r-3", "r-4"}}
+	sprints := []model.Sprint{
+		{ID: "s0", Name: "Sprint 0", StartDate: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC)},
+		{ID: "s1", Name: "Sprint 1", StartDate: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), EndDate: day(1), BeadIDs: []string{"p-1", "p-2"}},
+		sprint,
+		{ID: "s3", Name: "Sprint 3", StartDate: day(14), EndDate: day(27)},
+	}
+	changes := []RetroScopeChange{
+		{Date: day(7), IssueID: "r-3", Action: "added"},
+		{Date: day(6), IssueID: "r-5", Action: "removed"},
+	}
+	return sprint, sprints, issues, changes
+}
+
+func TestBuildSprintRetro(t *testing.T) {
+	sprint, sprints, issues, changes := retroFixture()
+	r := BuildSprintRetro(sprint, sprints, issues, changes, time.Time{})
+
+	ids := func(items []RetroItem) string {
+		var out []string
+		for _, it := range items {
+			out = append(out, it.ID)
+		}
+		return strings.Join(out, ",")
+	}
+	for name, tc := range map[string][2]string{
+		"planned":   {ids(r.Planned), "r-1,r-2,r-4,r-5"},
+		"added":     {ids(r.Added), "r-3"},
+		"removed":   {ids(r.Removed), "r-5"},
+		"delivered": {ids(r.Delivered), "r-1,r-3"},
+		"carryover": {ids(r.CarryOver), "r-2,r-4"},
+	} {
+		if tc[0] != tc[1] {
+			t.Errorf("%s = %s, want %s", name, tc[0], tc[1])
+		}
+	}
+	if r.PlannedDelivered != 1 || r.Completion() != 50 {
+		t.Errorf("planned delivered = %d, completion = %d%%; want 1 and 50%%", r.PlannedDelivered, r.Completion())
+	}
+
+	// Sprint 3 hasn't ended before sprint 2, so it isn't compared
+	if len(r.Velocity) != 3 || r.Velocity[0].SprintID != "s0" || r.Velocity[2].SprintID != "s2" {
+		t.Fatalf("velocity = %+v", r.Velocity)
+	}
+	if r.Velocity[2].Delivered != 2 || r.Velocity[2].Points != 4 {
+		t.Errorf("sprint 2 delivered %d (%v pts), want 2 (4 pts)", r.Velocity[2].Delivered, r.Velocity[2].Points)
+	}
+	if got := r.VelocitySummary(); got != "Delivered 2 issues vs an average of 1 over the previous 2 sprints (+100%)." {
+		t.Errorf("summary = %q", got)
+	}
+}
+
+func TestSprintRetroMarkdownAndHTML(t *testing.T) {
+	sprint, sprints, issues, changes := retroFixture()
+	r := BuildSprintRetro(sprint, sprints, issues, changes, time.Time{})
+
+	md := GenerateSprintRetroMarkdown(r)
+	for _, want := range []string{
+		"# Sprint Retrospective: Sprint 2",
+		"| Planned | 4 | 10 pts |",
+		"- [x] `r-3` Hotfix \\| urgent (1 pt) — closed 2026-03-08",
+		"- [ ] `r-4` Closed too late (2 pts) — closed",
+		"- `r-3` Hotfix \\| urgent (1 pt) — added 2026-03-07",
+		"- `r-5` Descoped — removed 2026-03-06",
+		"| **Sprint 2** | 2026-03-13 | 4 | 2 | 4 pts |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown missing %q:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "Generated:") {
+		t.Error("A zero GeneratedAt should leave the timestamp out")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "retro.html")
+	if err := SaveSprintRetro(r, path); err != nil {
+		t.Fatal(err)
+	}
+	html, _ := os.ReadFile(path)
+	for _, want := range []string{"<!DOCTYPE html>", "Hotfix | urgent", `<tr class="current"><td>Sprint 2</td>`, "average of 1 over the previous 2 sprints"} {
+		if !strings.Contains(string(html), want) {
+			t.Errorf("HTML missing %q", want)
+		}
+	}
+}
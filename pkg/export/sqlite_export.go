@@ -13,11 +13,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -66,21 +68,25 @@ func (e *SQLiteExporter) SetGitHash(hash string) {
 
 // Export writes the SQLite database and supporting files to the output directory.
 func (e *SQLiteExporter) Export(outputDir string) error {
+	if err := readonly.Check("exporting SQLite database"); err != nil {
+		return err
+	}
+
 	// Ensure output directory exists
-	if err := os.MkdirAll(outputDir, 0755); err != nil {
+	if err := readonly.MkdirAll(outputDir, 0755); err != nil {
 		return fmt.Errorf("create output dir: %w", err)
 	}
 
 	// Create data subdirectory for JSON outputs
 	dataDir := filepath.Join(outputDir, "data")
-	if err := os.MkdirAll(dataDir, 0755); err != nil {
+	if err := readonly.MkdirAll(dataDir, 0755); err != nil {
 		return fmt.Errorf("create data dir: %w", err)
 	}
 
 	dbPath := filepath.Join(outputDir, "beads.sqlite3")
 
 	// Remove existing database if present
-	_ = os.Remove(dbPath)
+	_ = readonly.Remove(dbPath)
 
 	// Open database
 	db, err := sql.Open("sqlite3", dbPath)
@@ -340,11 +346,19 @@ func (e *SQLiteExporter) insertTriageRecommendations(db *sql.DB) error {
 	return tx.Commit()
 }
 
+// generatedAt returns the export timestamp, honoring Config.GeneratedAt.
+func (e *SQLiteExporter) generatedAt() time.Time {
+	if !e.Config.GeneratedAt.IsZero() {
+		return e.Config.GeneratedAt.UTC()
+	}
+	return time.Now().UTC()
+}
+
 // insertMeta inserts export metadata.
 func (e *SQLiteExporter) insertMeta(db *sql.DB) error {
 	meta := map[string]string{
 		"version":          "1.0.0",
-		"generated_at":     time.Now().UTC().Format(time.RFC3339),
+		"generated_at":     e.generatedAt().Format(time.RFC3339),
 		"issue_count":      fmt.Sprintf("%d", len(e.Issues)),
 		"dependency_count": fmt.Sprintf("%d", len(e.Deps)),
 		"schema_version":   fmt.Sprintf("%d", SchemaVersion),
@@ -357,7 +371,14 @@ func (e *SQLiteExporter) insertMeta(db *sql.DB) error {
 		meta["title"] = e.Config.Title
 	}
 
-	for key, value := range meta {
+	// Insert in key order so repeated exports produce identical databases
+	keys := make([]string, 0, len(meta))
+	for key := range meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := meta[key]
 		if err := InsertMetaValue(db, key, value); err != nil {
 			return fmt.Errorf("insert meta %s: %w", key, err)
 		}
@@ -383,7 +404,7 @@ func (e *SQLiteExporter) writeRobotOutputs(dataDir string) error {
 	// Write export metadata
 	meta := ExportMeta{
 		Version:     "1.0.0",
-		GeneratedAt: time.Now().UTC(),
+		GeneratedAt: e.generatedAt(),
 		GitCommit:   e.gitHash,
 		IssueCount:  len(e.Issues),
 		DepCount:    len(e.Deps),
@@ -415,7 +436,7 @@ func (e *SQLiteExporter) chunkIfNeeded(outputDir, dbPath string) error {
 
 	// Chunk the database
 	chunksDir := filepath.Join(outputDir, "chunks")
-	if err := os.MkdirAll(chunksDir, 0755); err != nil {
+	if err := readonly.MkdirAll(chunksDir, 0755); err != nil {
 		return fmt.Errorf("create chunks dir: %w", err)
 	}
 
@@ -445,7 +466,7 @@ func (e *SQLiteExporter) chunkIfNeeded(outputDir, dbPath string) error {
 		n, err := f.Read(buf)
 		if n > 0 {
 			chunkPath := filepath.Join(chunksDir, fmt.Sprintf("%05d.bin", chunkNum))
-			if err := os.WriteFile(chunkPath, buf[:n], 0644); err != nil {
+			if err := readonly.WriteFile(chunkPath, buf[:n], 0644); err != nil {
 				return fmt.Errorf("write chunk %d: %w", chunkNum, err)
 			}
 			chunkNum++
@@ -486,7 +507,7 @@ func (e *SQLiteExporter) chunkIfNeeded(outputDir, dbPath string) error {
 
 // writeJSON writes data as JSON to a file.
 func writeJSON(path string, data interface{}) error {
-	f, err := os.Create(path)
+	f, err := readonly.Create(path)
 	if err != nil {
 		return err
 	}
@@ -594,7 +615,7 @@ func (e *SQLiteExporter) ExportToJSON(path string) error {
 	}{
 		Meta: ExportMeta{
 			Version:     "1.0.0",
-			GeneratedAt: time.Now().UTC(),
+			GeneratedAt: e.generatedAt(),
 			GitCommit:   e.gitHash,
 			IssueCount:  len(issues),
 			DepCount:    len(e.Deps),
@@ -87,6 +87,10 @@ type SQLiteExportConfig struct {
 
 	// PageSize is the SQLite page size (optimal: 1024 for httpvfs)
 	PageSize int
+
+	// GeneratedAt is the timestamp recorded in export metadata.
+	// Zero means the current time; stable exports pass StableTime(issues).
+	GeneratedAt time.Time
 }
 
 // DefaultSQLiteExportConfig returns sensible defaults for export configuration.
@@ -0,0 +1,30 @@
+package export
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// StableTime returns the most recent created/updated/closed time across issues, in UTC.
+// Stable exports record it instead of the wall clock, so regenerating a report
+// only changes its content when the underlying data changed.
+// Returns the Unix epoch if no issue carries a timestamp, so the result is
+// always non-zero and reproducible.
+func StableTime(issues []model.Issue) time.Time {
+	var latest time.Time
+	for i := range issues {
+		for _, t := range []time.Time{issues[i].CreatedAt, issues[i].UpdatedAt} {
+			if t.After(latest) {
+				latest = t
+			}
+		}
+		if issues[i].ClosedAt != nil && issues[i].ClosedAt.After(latest) {
+			latest = *issues[i].ClosedAt
+		}
+	}
+	if latest.IsZero() {
+		return time.Unix(0, 0).UTC()
+	}
+	return latest.UTC()
+}
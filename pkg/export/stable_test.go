@@ -0,0 +1,87 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestStableTime(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := time.Date(2025, 3, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	issues := []model.Issue{
+		{ID: "a", CreatedAt: created, UpdatedAt: created.AddDate(0, 1, 0)},
+		{ID: "b", CreatedAt: created, UpdatedAt: created, ClosedAt: &closed},
+	}
+	if got := StableTime(issues); !got.Equal(closed) || got.Location() != time.UTC {
+		t.Errorf("StableTime = %v, want %v in UTC", got, closed)
+	}
+	if got := StableTime(nil); !got.Equal(time.Unix(0, 0)) {
+		t.Errorf("StableTime(nil) = %v, want Unix epoch", got)
+	}
+}
+
+func TestGenerateMarkdownWithOptions_Stable(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "b-2", Title: "Second", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, CreatedAt: base, UpdatedAt: base},
+		{ID: "b-1", Title: "First", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, CreatedAt: base, UpdatedAt: base},
+	}
+
+	first, err := GenerateMarkdownWithOptions(issues, "Report", MarkdownOptions{Stable: true})
+	if err != nil {
+		t.Fatalf("GenerateMarkdownWithOptions: %v", err)
+	}
+	if strings.Contains(first, "*Generated:") {
+		t.Error("Stable markdown should not contain a generation timestamp")
+	}
+	time.Sleep(10 * time.Millisecond)
+	second, _ := GenerateMarkdownWithOptions(issues, "Report", MarkdownOptions{Stable: true})
+	if first != second {
+		t.Error("Stable markdown should be byte-identical across runs")
+	}
+
+	normal, _ := GenerateMarkdown(issues, "Report")
+	if !strings.Contains(normal, "*Generated:") {
+		t.Error("Default markdown should keep the generation timestamp")
+	}
+
+	// Equal priority and creation time fall back to ID order
+	path := filepath.Join(t.TempDir(), "report.md")
+	if err := SaveMarkdownToFileWithOptions(issues, path, MarkdownOptions{Stable: true}); err != nil {
+		t.Fatalf("SaveMarkdownToFileWithOptions: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	i1, i2 := strings.Index(string(data), "## 📋 b-1"), strings.Index(string(data), "## 📋 b-2")
+	if i1 < 0 || i2 < 0 || i1 > i2 {
+		t.Errorf("Expected tied issues ordered by ID (b-1 at %d, b-2 at %d)", i1, i2)
+	}
+}
+
+func TestSQLiteExport_ConfiguredGeneratedAt(t *testing.T) {
+	tmpDir := t.TempDir()
+	exp := NewSQLiteExporter([]*model.Issue{
+		makeTestIssue("gen-1", "Generated", model.StatusOpen, 2, model.TypeTask),
+	}, nil, nil, nil)
+	exp.Config.GeneratedAt = time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	if err := exp.Export(tmpDir); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, "data", "meta.json"))
+	if err != nil {
+		t.Fatalf("Failed to read meta.json: %v", err)
+	}
+	var meta ExportMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		t.Fatalf("Failed to parse meta.json: %v", err)
+	}
+	if !meta.GeneratedAt.Equal(exp.Config.GeneratedAt) {
+		t.Errorf("meta generated_at = %v, want %v", meta.GeneratedAt, exp.Config.GeneratedAt)
+	}
+}
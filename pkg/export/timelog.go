@@ -0,0 +1,151 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// timeReportGroupHeader returns the column title for the report's grouping
+func timeReportGroupHeader(groupBy string) string {
+	switch groupBy {
+	case analysis.TimeGroupAssignee:
+		return "Assignee"
+	case analysis.TimeGroupWeek:
+		return "Week"
+	default:
+		return "Issue"
+	}
+}
+
+// GenerateTimeReportCSV renders a time report as CSV with a header row.
+// Hours are decimal with two places so the file drops straight into a spreadsheet or invoice.
+func GenerateTimeReportCSV(report analysis.TimeReport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{strings.ToLower(timeReportGroupHeader(report.GroupBy))}
+	if report.GroupBy == analysis.TimeGroupIssue {
+		header = append(header, "title", "assignee")
+	}
+	header = append(header, "issues", "sessions", "minutes", "hours")
+	if err := w.Write(header); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, row := range report.Rows {
+		record := []string{row.Group}
+		if report.GroupBy == analysis.TimeGroupIssue {
+			record = append(record, row.Title, row.Assignee)
+		}
+		record = append(record,
+			strconv.Itoa(row.Issues),
+			strconv.Itoa(row.Sessions),
+			strconv.Itoa(row.Minutes),
+			formatHours(row.Minutes),
+		)
+		if err := w.Write(record); err != nil {
+			return "", fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// GenerateTimeReportMarkdown renders a time report as a Markdown table with a total row
+func GenerateTimeReportMarkdown(report analysis.TimeReport) string {
+	var sb strings.Builder
+	groupHeader := timeReportGroupHeader(report.GroupBy)
+
+	sb.WriteString(fmt.Sprintf("# Time Report by %s\n\n", groupHeader))
+	// A zero GeneratedAt (stable exports) leaves the timestamp out
+	var meta []string
+	if !report.GeneratedAt.IsZero() {
+		meta = append(meta, fmt.Sprintf("*Generated: %s*", report.GeneratedAt.Format("2006-01-02 15:04 MST")))
+	}
+	if report.Since != nil {
+		meta = append(meta, fmt.Sprintf("*Since: %s*", report.Since.Format("2006-01-02")))
+	}
+	if len(meta) > 0 {
+		sb.WriteString(strings.Join(meta, " · ") + "\n\n")
+	}
+
+	if len(report.Rows) == 0 {
+		sb.WriteString("No work sessions logged.\n")
+		return sb.String()
+	}
+
+	if report.GroupBy == analysis.TimeGroupIssue {
+		sb.WriteString("| Issue | Title | Assignee | Sessions | Time | Hours |\n")
+		sb.WriteString("|-------|-------|----------|----------|------|-------|\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("| %s | Issues | Sessions | Time | Hours |\n", groupHeader))
+		sb.WriteString("|" + strings.Repeat("-", len(groupHeader)+2) + "|--------|----------|------|-------|\n")
+	}
+
+	for _, row := range report.Rows {
+		if report.GroupBy == analysis.TimeGroupIssue {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %d | %s | %s |\n",
+				row.Group, escapeTableCell(row.Title), escapeTableCell(row.Assignee),
+				row.Sessions, formatDurationMinutes(row.Minutes), formatHours(row.Minutes)))
+		} else {
+			sb.WriteString(fmt.Sprintf("| %s | %d | %d | %s | %s |\n",
+				escapeTableCell(row.Group), row.Issues, row.Sessions,
+				formatDurationMinutes(row.Minutes), formatHours(row.Minutes)))
+		}
+	}
+
+	total := formatDurationMinutes(report.TotalMinutes)
+	hours := formatHours(report.TotalMinutes)
+	if report.GroupBy == analysis.TimeGroupIssue {
+		sb.WriteString(fmt.Sprintf("| **Total** | | | | **%s** | **%s** |\n", total, hours))
+	} else {
+		sb.WriteString(fmt.Sprintf("| **Total** | | | **%s** | **%s** |\n", total, hours))
+	}
+	return sb.String()
+}
+
+// SaveTimeReport writes the report to filename, choosing CSV for a .csv
+// extension and Markdown otherwise
+func SaveTimeReport(report analysis.TimeReport, filename string) error {
+	var content string
+	if strings.EqualFold(filepath.Ext(filename), ".csv") {
+		csvContent, err := GenerateTimeReportCSV(report)
+		if err != nil {
+			return err
+		}
+		content = csvContent
+	} else {
+		content = GenerateTimeReportMarkdown(report)
+	}
+	return readonly.WriteFile(filename, []byte(content), 0644)
+}
+
+// formatHours renders minutes as decimal hours, e.g. 90 -> "1.50"
+func formatHours(minutes int) string {
+	return strconv.FormatFloat(float64(minutes)/60, 'f', 2, 64)
+}
+
+// formatDurationMinutes renders minutes as "2h 05m" or "45m"
+func formatDurationMinutes(minutes int) string {
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
+
+// escapeTableCell keeps pipes and newlines from breaking a Markdown table row
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
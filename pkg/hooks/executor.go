@@ -10,6 +10,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // HookResult contains the result of a hook execution
@@ -106,6 +108,12 @@ func (e *Executor) runHook(hook Hook, phase HookPhase) HookResult {
 		Phase: phase,
 	}
 
+	// Hooks run arbitrary commands, so read-only mode skips them
+	if err := readonly.Check(fmt.Sprintf("running hook %q", hook.Name)); err != nil {
+		result.Error = err
+		return result
+	}
+
 	start := time.Now()
 
 	// Create context with timeout
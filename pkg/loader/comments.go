@@ -0,0 +1,153 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// AppendComment adds a comment to an issue's record in a beads JSONL file.
+// The comment gets the next free comment ID in the file and the issue's ID.
+// Only the issue's "comments" field is rewritten; every other line and field
+// is kept byte for byte. The write is atomic (temp file + rename).
+func AppendComment(path, issueID string, comment model.Comment) (model.Comment, error) {
+	if strings.TrimSpace(comment.Text) == "" {
+		return comment, fmt.Errorf("comment text is empty")
+	}
+
+	data, err := readEditable(path)
+	if err != nil {
+		return comment, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return comment, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	target := -1
+	var maxID int64
+	for i, line := range lines {
+		if i == 0 {
+			line = stripBOM(line)
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var rec struct {
+			ID       string `json:"id"`
+			Comments []struct {
+				ID int64 `json:"id"`
+			} `json:"comments"`
+		}
+		if err := json.Unmarshal(line, &rec); err != nil {
+			continue // Malformed lines are skipped by the loader too
+		}
+		for _, c := range rec.Comments {
+			if c.ID > maxID {
+				maxID = c.ID
+			}
+		}
+		if rec.ID == issueID && target < 0 {
+			target = i
+		}
+	}
+	if target < 0 {
+		return comment, fmt.Errorf("issue %s not found in %s", issueID, filepath.Base(path))
+	}
+
+	comment.ID = maxID + 1
+	comment.IssueID = issueID
+	line := lines[target]
+	body := stripBOM(line)
+	updated, err := spliceComment(body, comment)
+	if err != nil {
+		return comment, fmt.Errorf("failed to update issue %s: %w", issueID, err)
+	}
+	bom := line[:len(line)-len(body)]
+	lines[target] = bytes.Join([][]byte{bom, updated}, nil)
+
+	if err := writeFileAtomic(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm()); err != nil {
+		return comment, err
+	}
+	return comment, nil
+}
+
+// spliceComment returns the JSON object in line with comment appended to its
+// "comments" array, adding the field if it is missing
+func spliceComment(line []byte, comment model.Comment) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		if key, _ := keyTok.(string); key != "comments" {
+			continue
+		}
+
+		end := int(dec.InputOffset())
+		start := end - len(raw)
+		var comments []model.Comment
+		if err := json.Unmarshal(raw, &comments); err != nil {
+			return nil, fmt.Errorf("invalid comments: %w", err)
+		}
+		encoded, err := json.Marshal(append(comments, comment))
+		if err != nil {
+			return nil, err
+		}
+		return bytes.Join([][]byte{line[:start], encoded, line[end:]}, nil), nil
+	}
+
+	// No comments yet: add the field before the closing brace
+	closing := bytes.LastIndexByte(line, '}')
+	encoded, err := json.Marshal([]model.Comment{comment})
+	if err != nil {
+		return nil, err
+	}
+	field := append([]byte(`"comments":`), encoded...)
+	if len(bytes.TrimSpace(line[1:closing])) > 0 {
+		field = append([]byte(","), field...)
+	}
+	return bytes.Join([][]byte{line[:closing], field, line[closing:]}, nil), nil
+}
+
+// writeFileAtomic replaces path with data via a temp file in the same directory
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := readonly.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = readonly.Remove(tmpName)
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = readonly.Remove(tmpName)
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := readonly.Chmod(tmpName, perm); err != nil {
+		_ = readonly.Remove(tmpName)
+		return fmt.Errorf("failed to set file mode: %w", err)
+	}
+	if err := readonly.Rename(tmpName, path); err != nil {
+		_ = readonly.Remove(tmpName)
+		return fmt.Errorf("failed to rename temp file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestAppendComment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := `{"id":"bv-1","title":"One","status":"open","issue_type":"task","x_custom":{"keep":true}}
+{"id":"bv-2", "title":"Two", "status":"open", "issue_type":"task", "comments":[{"id":7,"issue_id":"bv-2","author":"amy","text":"first","created_at":"2025-01-01T00:00:00Z"}], "priority":1}
+not json
+`
+	if err := os.WriteFile(path, []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	c, err := AppendComment(path, "bv-1", model.Comment{Author: "bob", Text: "hello\nworld", CreatedAt: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ID != 8 || c.IssueID != "bv-1" {
+		t.Errorf("Expected ID 8 on bv-1, got %+v", c)
+	}
+	if _, err := AppendComment(path, "bv-2", model.Comment{Author: "bob", Text: "second", CreatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(data), "\n")
+	if !strings.HasPrefix(lines[0], `{"id":"bv-1","title":"One","status":"open","issue_type":"task","x_custom":{"keep":true},"comments":[{"id":8,`) {
+		t.Errorf("Unknown fields and order should be kept, got %s", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], `{"id":"bv-2", "title":"Two", "status":"open", "issue_type":"task", "comments":[{"id":7,`) ||
+		!strings.HasSuffix(lines[1], `"text":"second","created_at":"2025-02-01T12:00:00Z"}], "priority":1}`) {
+		t.Errorf("Comment should be appended in place, got %s", lines[1])
+	}
+	if lines[2] != "not json" || lines[3] != "" {
+		t.Errorf("Other lines should be untouched, got %q", lines[2:])
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0640 {
+		t.Errorf("File mode changed to %v", info.Mode().Perm())
+	}
+
+	issues, err := LoadIssuesFromFileWithOptions(path, ParseOptions{WarningHandler: func(string) {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues[0].Comments) != 1 || issues[0].Comments[0].Text != "hello\nworld" || len(issues[1].Comments) != 2 {
+		t.Errorf("Comments not loaded back: %+v / %+v", issues[0].Comments, issues[1].Comments)
+	}
+}
+
+func TestAppendComment_Errors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	if err := os.WriteFile(path, []byte(`{"id":"bv-1","title":"One","status":"open"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AppendComment(path, "bv-9", model.Comment{Text: "hi"}); err == nil || !strings.Contains(err.Error(), "bv-9 not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if _, err := AppendComment(path, "bv-1", model.Comment{Text: "  "}); err == nil {
+		t.Error("Expected an error for an empty comment")
+	}
+}
@@ -3,8 +3,11 @@ package loader
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +15,38 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
+// Errors returned by CheckGit
+var (
+	ErrGitNotInstalled = errors.New("git is not installed")
+	ErrNotGitRepo      = errors.New("not a git repository")
+)
+
+// CheckGit reports whether git history features (history, time-travel,
+// correlation) can work in dir: the git binary must be in PATH and dir must
+// be inside a work tree. It only stats the filesystem, so it is cheap and
+// can't hang on a slow network share.
+func CheckGit(dir string) error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return ErrGitNotInstalled
+	}
+	if os.Getenv("GIT_DIR") != "" {
+		return nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNotGitRepo, dir)
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		// .git is a directory, or a file in worktrees and submodules
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			return nil
+		}
+		if filepath.Dir(d) == d {
+			return fmt.Errorf("%w: %s", ErrNotGitRepo, abs)
+		}
+	}
+}
+
 // GitLoader loads beads from git history
 type GitLoader struct {
 	repoPath string
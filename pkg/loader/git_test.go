@@ -2,6 +2,7 @@ package loader
 
 import (
 	"bytes"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -425,3 +426,33 @@ func TestCacheExpiry(t *testing.T) {
 		t.Errorf("expected 0 valid entries after expiry, got %d", stats.ValidEntries)
 	}
 }
+
+func TestCheckGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_DIR", "")
+
+	plain := t.TempDir()
+	if err := CheckGit(plain); !errors.Is(err, ErrNotGitRepo) {
+		t.Errorf("CheckGit(plain dir) = %v, want ErrNotGitRepo", err)
+	}
+
+	// A worktree has a .git file; subdirectories count as inside the repo
+	repo := t.TempDir()
+	if err := os.WriteFile(filepath.Join(repo, ".git"), []byte("gitdir: /elsewhere\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sub := filepath.Join(repo, "a", "b")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckGit(sub); err != nil {
+		t.Errorf("CheckGit(subdir of repo) = %v, want nil", err)
+	}
+
+	t.Setenv("PATH", "")
+	if err := CheckGit(sub); !errors.Is(err, ErrGitNotInstalled) {
+		t.Errorf("CheckGit without git in PATH = %v, want ErrGitNotInstalled", err)
+	}
+}
@@ -0,0 +1,219 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GitHubAPIURL is the GitHub REST API root used by LoadFromGitHub
+const GitHubAPIURL = "https://api.github.com"
+
+// githubPageSize is the largest page the issues endpoint allows
+const githubPageSize = 100
+
+// githubIssue is the subset of the REST API's issue object bv reads
+type githubIssue struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	State     string     `json:"state"`
+	HTMLURL   string     `json:"html_url"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	Labels    []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Assignee *struct {
+		Login string `json:"login"`
+	} `json:"assignee"`
+	Assignees []struct {
+		Login string `json:"login"`
+	} `json:"assignees"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// LoadFromGitHub fetches every issue (open and closed) of owner/repo through
+// the GitHub REST API and maps them to beads issues, so bv can be pointed at
+// repos that don't use beads. Pull requests are skipped. token may be empty
+// for public repos, at the cost of a much lower rate limit.
+//
+// Issue IDs are "<repo>-<number>". Labels carry over as-is and also set the
+// type (bug, feature/enhancement, epic, chore) and priority (p0-p4).
+// References like #12 in an issue's body become "related" dependencies.
+func LoadFromGitHub(owner, repo, token string) ([]model.Issue, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	return loadFromGitHub(client, GitHubAPIURL, owner, repo, token)
+}
+
+func loadFromGitHub(client *http.Client, apiURL, owner, repo, token string) ([]model.Issue, error) {
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("github repository must be given as owner/repo")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/issues?state=all&per_page=%d", strings.TrimRight(apiURL, "/"), owner, repo, githubPageSize)
+	var raw []githubIssue
+	for url != "" {
+		page, next, err := fetchGitHubIssuePage(client, url, token, owner+"/"+repo)
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, page...)
+		url = next
+	}
+
+	prefix := strings.ToLower(repo)
+	numbers := make(map[int]bool, len(raw))
+	for _, gi := range raw {
+		if gi.PullRequest == nil {
+			numbers[gi.Number] = true
+		}
+	}
+
+	var issues []model.Issue
+	for _, gi := range raw {
+		if gi.PullRequest != nil {
+			continue
+		}
+		issues = append(issues, githubToIssue(gi, prefix, numbers))
+	}
+	return issues, nil
+}
+
+// fetchGitHubIssuePage fetches one page of issues and returns the next page's
+// URL from the Link header ("" on the last page)
+func fetchGitHubIssuePage(client *http.Client, url, token, fullName string) ([]githubIssue, string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	// GitHub recommends sending a UA; some endpoints 403 without it.
+	req.Header.Set("User-Agent", "beads-viewer-github-import")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch github issues: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, "", fmt.Errorf("github repository %s not found (private repos need a token)", fullName)
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, "", fmt.Errorf("github rejected the token for %s: %s", fullName, resp.Status)
+	case (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests) &&
+		resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return nil, "", fmt.Errorf("github api rate limit exceeded (set a token to raise it)")
+	case resp.StatusCode != http.StatusOK:
+		return nil, "", fmt.Errorf("github api returned status: %s", resp.Status)
+	}
+
+	var page []githubIssue
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, "", fmt.Errorf("failed to parse github issues: %w", err)
+	}
+	return page, nextPageURL(resp.Header.Get("Link")), nil
+}
+
+// nextPageURL extracts the rel="next" URL from a Link header
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(segments[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// githubRefPattern matches same-repo references like "#12", but not anchors
+// inside URLs or words ("page#12") or cross-repo references ("org/repo#12")
+var githubRefPattern = regexp.MustCompile(`(?:^|[^\w/#])#(\d+)\b`)
+
+// githubPriorityPattern matches priority labels such as "P1" or "priority: 2"
+var githubPriorityPattern = regexp.MustCompile(`^(?:p|priority[:/ -]*p?)([0-4])$`)
+
+// githubToIssue maps a GitHub issue to a beads issue. numbers holds the
+// issue numbers that exist, so references to PRs or missing issues are dropped.
+func githubToIssue(gi githubIssue, prefix string, numbers map[int]bool) model.Issue {
+	id := fmt.Sprintf("%s-%d", prefix, gi.Number)
+	issue := model.Issue{
+		ID:          id,
+		Title:       gi.Title,
+		Description: gi.Body,
+		Status:      model.StatusOpen,
+		Priority:    2,
+		IssueType:   model.TypeTask,
+		CreatedAt:   gi.CreatedAt,
+		UpdatedAt:   gi.UpdatedAt,
+	}
+	if issue.Title == "" {
+		issue.Title = fmt.Sprintf("Issue #%d", gi.Number)
+	}
+	if gi.State == "closed" {
+		issue.Status = model.StatusClosed
+		issue.ClosedAt = gi.ClosedAt
+	}
+	if gi.HTMLURL != "" {
+		ref := gi.HTMLURL
+		issue.ExternalRef = &ref
+	}
+
+	switch {
+	case len(gi.Assignees) > 0:
+		issue.Assignee = gi.Assignees[0].Login
+	case gi.Assignee != nil:
+		issue.Assignee = gi.Assignee.Login
+	}
+
+	for _, l := range gi.Labels {
+		issue.Labels = append(issue.Labels, l.Name)
+		name := strings.ToLower(strings.TrimSpace(l.Name))
+		switch name {
+		case "bug":
+			issue.IssueType = model.TypeBug
+		case "enhancement", "feature":
+			issue.IssueType = model.TypeFeature
+		case "epic":
+			issue.IssueType = model.TypeEpic
+		case "chore":
+			issue.IssueType = model.TypeChore
+		}
+		if m := githubPriorityPattern.FindStringSubmatch(name); m != nil {
+			issue.Priority, _ = strconv.Atoi(m[1])
+		}
+	}
+
+	seen := make(map[int]bool)
+	for _, m := range githubRefPattern.FindAllStringSubmatch(gi.Body, -1) {
+		n, err := strconv.Atoi(m[1])
+		if err != nil || n == gi.Number || seen[n] || !numbers[n] {
+			continue
+		}
+		seen[n] = true
+		issue.Dependencies = append(issue.Dependencies, &model.Dependency{
+			IssueID:     id,
+			DependsOnID: fmt.Sprintf("%s-%d", prefix, n),
+			Type:        model.DepRelated,
+			CreatedAt:   gi.CreatedAt,
+		})
+	}
+	return issue
+}
@@ -0,0 +1,120 @@
+package loader
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestLoadFromGitHub(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/Widgets/issues" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer s3cret" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if r.URL.Query().Get("state") != "all" {
+			t.Errorf("Expected state=all, got %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/acme/Widgets/issues?state=all&per_page=100&page=2>; rel="next", <%s/repos/acme/Widgets/issues?state=all&per_page=100&page=2>; rel="last"`, srv.URL, srv.URL))
+			fmt.Fprint(w, `[
+				{"number": 1, "title": "Crash on start", "body": "See #2 and #3, not #99 or https://x.io/page#2 or other/repo#2. Also #2.",
+				 "state": "open", "html_url": "https://github.com/acme/Widgets/issues/1",
+				 "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z",
+				 "labels": [{"name": "bug"}, {"name": "P0"}], "assignees": [{"login": "ada"}, {"login": "bob"}]},
+				{"number": 3, "title": "Fix crash", "state": "open", "pull_request": {"url": "x"},
+				 "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"number": 2, "title": "Roadmap", "body": "", "state": "closed",
+				 "created_at": "2024-12-01T00:00:00Z", "updated_at": "2025-01-03T00:00:00Z", "closed_at": "2025-01-03T00:00:00Z",
+				 "labels": [{"name": "Epic"}, {"name": "priority: 3"}], "assignee": {"login": "cy"}}
+			]`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	}))
+	defer srv.Close()
+
+	issues, err := loadFromGitHub(srv.Client(), srv.URL, "acme", "Widgets", "s3cret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues (PR skipped), got %d", len(issues))
+	}
+
+	crash, roadmap := issues[0], issues[1]
+	if crash.ID != "widgets-1" || crash.Status != model.StatusOpen || crash.IssueType != model.TypeBug ||
+		crash.Priority != 0 || crash.Assignee != "ada" {
+		t.Errorf("Unexpected mapping: %+v", crash)
+	}
+	if crash.ExternalRef == nil || *crash.ExternalRef != "https://github.com/acme/Widgets/issues/1" {
+		t.Errorf("ExternalRef = %v", crash.ExternalRef)
+	}
+	if strings.Join(crash.Labels, ",") != "bug,P0" {
+		t.Errorf("Labels = %v", crash.Labels)
+	}
+	if len(crash.Dependencies) != 1 || crash.Dependencies[0].DependsOnID != "widgets-2" || crash.Dependencies[0].Type != model.DepRelated {
+		t.Errorf("Expected one related dep on widgets-2, got %+v", crash.Dependencies)
+	}
+
+	if roadmap.Status != model.StatusClosed || roadmap.ClosedAt == nil || roadmap.IssueType != model.TypeEpic ||
+		roadmap.Priority != 3 || roadmap.Assignee != "cy" {
+		t.Errorf("Unexpected mapping: %+v", roadmap)
+	}
+	for _, issue := range issues {
+		if err := issue.Validate(); err != nil {
+			t.Errorf("%s: %v", issue.ID, err)
+		}
+	}
+}
+
+func TestLoadFromGitHub_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/limited/"):
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusForbidden)
+		case strings.Contains(r.URL.Path, "/broken/"):
+			fmt.Fprint(w, `{"message": "not a list"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	for repo, want := range map[string]string{
+		"missing": "not found",
+		"limited": "rate limit",
+		"broken":  "failed to parse",
+	} {
+		_, err := loadFromGitHub(srv.Client(), srv.URL, "acme", repo, "")
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected %q error, got %v", repo, want, err)
+		}
+	}
+	if _, err := LoadFromGitHub("", "repo", ""); err == nil {
+		t.Error("Expected an error without an owner")
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	link := `<https://api.github.com/x?page=1>; rel="prev", <https://api.github.com/x?page=3>; rel="next"`
+	if got := nextPageURL(link); got != "https://api.github.com/x?page=3" {
+		t.Errorf("next = %q", got)
+	}
+	if got := nextPageURL(`<https://api.github.com/x?page=1>; rel="first"`); got != "" {
+		t.Errorf("Expected no next page, got %q", got)
+	}
+}
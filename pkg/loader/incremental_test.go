@@ -0,0 +1,54 @@
+package loader
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestIncrementalLoader_Load(t *testing.T) {
+	jsonl := filepath.Join(t.TempDir(), "issues.jsonl")
+	writeStoreJSONL(t, jsonl, storeLine("a", "A"), storeLine("b", "B"), storeLine("c", "C"))
+
+	l := NewIncrementalLoader()
+	issues, res, err := l.Load(jsonl, ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storeIDs(issues) != "a=A b=B c=C" || len(res.Upserted) != 3 || res.Unchanged != 0 {
+		t.Fatalf("First load should read everything, got %s %+v", storeIDs(issues), res)
+	}
+
+	if issues, res, err := l.Load(jsonl, ParseOptions{}); err != nil || !res.Skipped || res.Changed() || len(issues) != 3 {
+		t.Errorf("Unmodified file should be skipped with its issues kept, got %d issues %+v (%v)", len(issues), res, err)
+	}
+
+	// Drop a, edit b, add d ahead of c, repeat c, and add a malformed line
+	var warnings []string
+	opts := ParseOptions{WarningHandler: func(msg string) { warnings = append(warnings, msg) }}
+	writeStoreJSONL(t, jsonl, storeLine("b", "B2"), storeLine("d", "D"), "{oops", storeLine("c", "C"), storeLine("c", "C"))
+	issues, res, err = l.Load(jsonl, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := storeIDs(issues); got != "b=B2 d=D c=C" {
+		t.Errorf("Loaded issues = %s", got)
+	}
+	if storeIDs(res.Upserted) != "b=B2 d=D" || strings.Join(res.Removed, ",") != "a" || res.Unchanged != 1 {
+		t.Errorf("Expected b and d upserted, a removed, c unchanged; got %+v", res)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "line 3") {
+		t.Errorf("Expected a warning for line 3, got %v", warnings)
+	}
+
+	// The diff agrees with what the previous issues plus the result give
+	if got := storeIDs(res.Apply([]model.Issue{{ID: "a", Title: "A"}, {ID: "b", Title: "B"}, {ID: "c", Title: "C"}})); got != "b=B2 c=C d=D" {
+		t.Errorf("Apply = %s", got)
+	}
+
+	if _, _, err := l.Load(filepath.Join(t.TempDir(), "missing.jsonl"), ParseOptions{}); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,607 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JiraMappingFilename is the default Jira mapping file under .bv/
+const JiraMappingFilename = "jira.yaml"
+
+// JiraMapping translates Jira vocabulary into beads fields (.bv/jira.yaml).
+// Keys are matched case-insensitively; entries in the file are merged over
+// the defaults.
+type JiraMapping struct {
+	// BaseURL is the Jira site, e.g. https://acme.atlassian.net. When set,
+	// each issue's external_ref points at its browse page.
+	BaseURL string `yaml:"base_url,omitempty"`
+
+	// Statuses maps a Jira status name to open, in_progress, blocked or closed.
+	// Unmapped statuses fall back to the status category (To Do, In Progress,
+	// Done), then to open.
+	Statuses map[string]string `yaml:"statuses,omitempty"`
+
+	// Priorities maps a Jira priority name to 0-4; unmapped priorities are 2
+	Priorities map[string]int `yaml:"priorities,omitempty"`
+
+	// Types maps a Jira issue type to bug, feature, task, epic or chore;
+	// unmapped types are task
+	Types map[string]string `yaml:"types,omitempty"`
+
+	// Links maps a Jira link type name to blocks, related, parent-child or
+	// discovered-from; map a link type to "" to drop it. Unmapped link types
+	// become related.
+	Links map[string]string `yaml:"links,omitempty"`
+}
+
+// DefaultJiraMapping returns the mapping for Jira's stock workflows
+func DefaultJiraMapping() JiraMapping {
+	return JiraMapping{
+		Statuses: map[string]string{
+			"to do":                    "open",
+			"open":                     "open",
+			"backlog":                  "open",
+			"selected for development": "open",
+			"reopened":                 "open",
+			"in progress":              "in_progress",
+			"in review":                "in_progress",
+			"blocked":                  "blocked",
+			"done":                     "closed",
+			"closed":                   "closed",
+			"resolved":                 "closed",
+			"won't do":                 "closed",
+		},
+		Priorities: map[string]int{
+			"highest":  0,
+			"blocker":  0,
+			"high":     1,
+			"critical": 1,
+			"medium":   2,
+			"major":    2,
+			"low":      3,
+			"minor":    3,
+			"lowest":   4,
+			"trivial":  4,
+		},
+		Types: map[string]string{
+			"bug":         "bug",
+			"story":       "feature",
+			"new feature": "feature",
+			"improvement": "feature",
+			"epic":        "epic",
+			"task":        "task",
+			"sub-task":    "task",
+			"subtask":     "task",
+			"chore":       "chore",
+		},
+		Links: map[string]string{
+			"blocks":    "blocks",
+			"relates":   "related",
+			"duplicate": "related",
+			"cloners":   "related",
+		},
+	}
+}
+
+// JiraMappingPath returns the path to .bv/jira.yaml
+func JiraMappingPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", JiraMappingFilename)
+}
+
+// LoadJiraMapping loads a mapping file over the defaults.
+// Returns the defaults if the file doesn't exist.
+func LoadJiraMapping(path string) (JiraMapping, error) {
+	mapping := DefaultJiraMapping()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return mapping, nil
+		}
+		return mapping, fmt.Errorf("reading Jira mapping: %w", err)
+	}
+	var file JiraMapping
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return mapping, fmt.Errorf("parsing Jira mapping: %w", err)
+	}
+	if file.BaseURL != "" {
+		mapping.BaseURL = file.BaseURL
+	}
+	for k, v := range file.Statuses {
+		mapping.Statuses[strings.ToLower(k)] = v
+	}
+	for k, v := range file.Priorities {
+		mapping.Priorities[strings.ToLower(k)] = v
+	}
+	for k, v := range file.Types {
+		mapping.Types[strings.ToLower(k)] = v
+	}
+	for k, v := range file.Links {
+		mapping.Links[strings.ToLower(k)] = v
+	}
+	if err := mapping.Validate(); err != nil {
+		return mapping, fmt.Errorf("%s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+// Validate checks that every mapping target is a valid beads value
+func (j JiraMapping) Validate() error {
+	for _, name := range sortedMapKeys(j.Statuses) {
+		if !model.Status(j.Statuses[name]).IsValid() {
+			return fmt.Errorf("status %q maps to invalid status %q", name, j.Statuses[name])
+		}
+	}
+	for _, name := range sortedMapKeys(j.Priorities) {
+		if p := j.Priorities[name]; p < 0 || p > 4 {
+			return fmt.Errorf("priority %q maps to %d (want 0-4)", name, p)
+		}
+	}
+	for _, name := range sortedMapKeys(j.Types) {
+		if !model.IssueType(j.Types[name]).IsValid() {
+			return fmt.Errorf("type %q maps to invalid type %q", name, j.Types[name])
+		}
+	}
+	for _, name := range sortedMapKeys(j.Links) {
+		switch model.DependencyType(j.Links[name]) {
+		case "", model.DepBlocks, model.DepRelated, model.DepParentChild, model.DepDiscoveredFrom:
+		default:
+			return fmt.Errorf("link %q maps to invalid dependency type %q", name, j.Links[name])
+		}
+	}
+	return nil
+}
+
+func sortedMapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jiraIssue is one issue from the REST API (search results or an export)
+type jiraIssue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary     string          `json:"summary"`
+		Description json.RawMessage `json:"description"`
+		Status      *struct {
+			Name           string `json:"name"`
+			StatusCategory *struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+		Priority *struct {
+			Name string `json:"name"`
+		} `json:"priority"`
+		IssueType *struct {
+			Name string `json:"name"`
+		} `json:"issuetype"`
+		Labels   []string  `json:"labels"`
+		Assignee *jiraUser `json:"assignee"`
+		Created  string    `json:"created"`
+		Updated  string    `json:"updated"`
+		Resolved string    `json:"resolutiondate"`
+		Parent   *struct {
+			Key string `json:"key"`
+		} `json:"parent"`
+		IssueLinks []struct {
+			Type struct {
+				Name string `json:"name"`
+			} `json:"type"`
+			InwardIssue *struct {
+				Key string `json:"key"`
+			} `json:"inwardIssue"`
+			OutwardIssue *struct {
+				Key string `json:"key"`
+			} `json:"outwardIssue"`
+		} `json:"issuelinks"`
+		Comment *struct {
+			Comments []struct {
+				Author  *jiraUser       `json:"author"`
+				Body    json.RawMessage `json:"body"`
+				Created string          `json:"created"`
+			} `json:"comments"`
+		} `json:"comment"`
+	} `json:"fields"`
+}
+
+type jiraUser struct {
+	DisplayName  string `json:"displayName"`
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+func (u *jiraUser) String() string {
+	switch {
+	case u == nil:
+		return ""
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name != "":
+		return u.Name
+	}
+	return u.EmailAddress
+}
+
+// jiraLink is a dependency found while converting: from depends on to
+type jiraLink struct {
+	from, to string
+	linkType string
+}
+
+// jiraBuilder accumulates converted issues and resolves links once every
+// issue is known, so links to issues outside the export are dropped
+type jiraBuilder struct {
+	mapping JiraMapping
+	issues  []model.Issue
+	links   []jiraLink
+}
+
+// LoadFromJira reads a Jira export and maps it to beads issues, so Jira
+// backlogs can be explored with bv's graph tooling. Files ending in .csv are
+// read as Jira's CSV export; anything else as JSON, either the REST search
+// response ({"issues": [...]}) or a bare array of issues.
+//
+// Issue keys (PROJ-12) become IDs. Blocking links become "blocks"
+// dependencies, sub-tasks and epic children depend on their parent, and
+// other link types become "related", all adjustable through mapping.
+func LoadFromJira(path string, mapping JiraMapping) ([]model.Issue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Jira export: %w", err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return parseJiraCSV(bytes.NewReader(data), mapping)
+	}
+	return parseJiraJSON(data, mapping)
+}
+
+func parseJiraJSON(data []byte, mapping JiraMapping) ([]model.Issue, error) {
+	var raw []jiraIssue
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse Jira export: %w", err)
+		}
+	} else {
+		var page struct {
+			Issues []jiraIssue `json:"issues"`
+		}
+		if err := json.Unmarshal(trimmed, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse Jira export: %w", err)
+		}
+		raw = page.Issues
+	}
+
+	b := &jiraBuilder{mapping: mapping}
+	for _, ji := range raw {
+		if ji.Key == "" {
+			continue
+		}
+		f := ji.Fields
+		issue := b.newIssue(ji.Key, f.Summary, jiraText(f.Description))
+		if f.Status != nil {
+			category := ""
+			if f.Status.StatusCategory != nil {
+				category = f.Status.StatusCategory.Key
+			}
+			issue.Status = b.status(f.Status.Name, category)
+		}
+		if f.Priority != nil {
+			issue.Priority = b.priority(f.Priority.Name)
+		}
+		if f.IssueType != nil {
+			issue.IssueType = b.issueType(f.IssueType.Name)
+		}
+		issue.Labels = f.Labels
+		issue.Assignee = f.Assignee.String()
+		b.setTimes(&issue, f.Created, f.Updated, f.Resolved)
+
+		if f.Parent != nil && f.Parent.Key != "" {
+			b.links = append(b.links, jiraLink{from: ji.Key, to: f.Parent.Key, linkType: string(model.DepParentChild)})
+		}
+		for _, l := range f.IssueLinks {
+			if l.InwardIssue != nil {
+				b.addLink(ji.Key, l.InwardIssue.Key, l.Type.Name, true)
+			}
+			if l.OutwardIssue != nil {
+				b.addLink(ji.Key, l.OutwardIssue.Key, l.Type.Name, false)
+			}
+		}
+		if f.Comment != nil {
+			for i, c := range f.Comment.Comments {
+				created, _ := parseJiraTime(c.Created)
+				issue.Comments = append(issue.Comments, &model.Comment{
+					ID:        int64(i + 1),
+					IssueID:   ji.Key,
+					Author:    c.Author.String(),
+					Text:      jiraText(c.Body),
+					CreatedAt: created,
+				})
+			}
+		}
+		b.issues = append(b.issues, issue)
+	}
+	return b.finish(), nil
+}
+
+// jiraCSVIssueLink matches CSV link columns like "Inward issue link (Blocks)"
+var jiraCSVIssueLink = regexp.MustCompile(`^(Inward|Outward) issue link \((.+)\)$`)
+
+// parseJiraCSV reads Jira's "Export CSV (all fields)". Multi-valued fields
+// such as Labels and issue links repeat the column header once per value.
+func parseJiraCSV(r io.Reader, mapping JiraMapping) ([]model.Issue, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Jira CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	cols := make(map[string][]int)
+	for i, name := range header {
+		cols[strings.TrimSpace(name)] = append(cols[strings.TrimSpace(name)], i)
+	}
+	if len(cols["Issue key"]) == 0 {
+		return nil, fmt.Errorf("failed to parse Jira CSV: no \"Issue key\" column")
+	}
+
+	var linkCols []string
+	for name := range cols {
+		if jiraCSVIssueLink.MatchString(name) {
+			linkCols = append(linkCols, name)
+		}
+	}
+	sort.Strings(linkCols)
+
+	get := func(row []string, name string) string {
+		for _, i := range cols[name] {
+			if i < len(row) && strings.TrimSpace(row[i]) != "" {
+				return strings.TrimSpace(row[i])
+			}
+		}
+		return ""
+	}
+	all := func(row []string, name string) []string {
+		var values []string
+		for _, i := range cols[name] {
+			if i < len(row) && strings.TrimSpace(row[i]) != "" {
+				values = append(values, strings.TrimSpace(row[i]))
+			}
+		}
+		return values
+	}
+
+	// Parents are referenced by numeric issue id in CSV exports
+	keysByID := make(map[string]string)
+	for _, row := range records[1:] {
+		if id, key := get(row, "Issue id"), get(row, "Issue key"); id != "" && key != "" {
+			keysByID[id] = key
+		}
+	}
+
+	b := &jiraBuilder{mapping: mapping}
+	for _, row := range records[1:] {
+		key := get(row, "Issue key")
+		if key == "" {
+			continue
+		}
+		issue := b.newIssue(key, get(row, "Summary"), get(row, "Description"))
+		if status := get(row, "Status"); status != "" {
+			issue.Status = b.status(status, get(row, "Status Category"))
+		}
+		if priority := get(row, "Priority"); priority != "" {
+			issue.Priority = b.priority(priority)
+		}
+		if issueType := get(row, "Issue Type"); issueType != "" {
+			issue.IssueType = b.issueType(issueType)
+		}
+		issue.Labels = all(row, "Labels")
+		issue.Assignee = get(row, "Assignee")
+		b.setTimes(&issue, get(row, "Created"), get(row, "Updated"), get(row, "Resolved"))
+
+		parent := keysByID[get(row, "Parent id")]
+		if parent == "" {
+			parent = get(row, "Parent key")
+		}
+		if parent != "" {
+			b.links = append(b.links, jiraLink{from: key, to: parent, linkType: string(model.DepParentChild)})
+		}
+		for _, name := range linkCols {
+			m := jiraCSVIssueLink.FindStringSubmatch(name)
+			for _, other := range all(row, name) {
+				b.addLink(key, other, m[2], m[1] == "Inward")
+			}
+		}
+		b.issues = append(b.issues, issue)
+	}
+	return b.finish(), nil
+}
+
+func (b *jiraBuilder) newIssue(key, summary, description string) model.Issue {
+	issue := model.Issue{
+		ID:          key,
+		Title:       summary,
+		Description: description,
+		Status:      model.StatusOpen,
+		Priority:    2,
+		IssueType:   model.TypeTask,
+	}
+	if issue.Title == "" {
+		issue.Title = key
+	}
+	if b.mapping.BaseURL != "" {
+		ref := strings.TrimRight(b.mapping.BaseURL, "/") + "/browse/" + key
+		issue.ExternalRef = &ref
+	}
+	return issue
+}
+
+// status maps a Jira status, falling back to its category
+// ("new", "indeterminate", "done" in JSON; "To Do" etc. in CSV)
+func (b *jiraBuilder) status(name, category string) model.Status {
+	if s, ok := b.mapping.Statuses[strings.ToLower(name)]; ok {
+		return model.Status(s)
+	}
+	switch strings.ToLower(category) {
+	case "indeterminate", "in progress":
+		return model.StatusInProgress
+	case "done":
+		return model.StatusClosed
+	}
+	return model.StatusOpen
+}
+
+func (b *jiraBuilder) priority(name string) int {
+	if p, ok := b.mapping.Priorities[strings.ToLower(name)]; ok {
+		return p
+	}
+	return 2
+}
+
+func (b *jiraBuilder) issueType(name string) model.IssueType {
+	if t, ok := b.mapping.Types[strings.ToLower(name)]; ok {
+		return model.IssueType(t)
+	}
+	return model.TypeTask
+}
+
+func (b *jiraBuilder) setTimes(issue *model.Issue, created, updated, resolved string) {
+	issue.CreatedAt, _ = parseJiraTime(created)
+	issue.UpdatedAt, _ = parseJiraTime(updated)
+	if issue.UpdatedAt.Before(issue.CreatedAt) {
+		issue.UpdatedAt = issue.CreatedAt
+	}
+	if t, ok := parseJiraTime(resolved); ok && issue.Status == model.StatusClosed {
+		issue.ClosedAt = &t
+	}
+}
+
+// addLink records a link seen from key's side. Jira lists every link on both
+// issues, as "is blocked by" (inward) on one and "blocks" (outward) on the
+// other; the inward side is the one that depends on the other issue.
+func (b *jiraBuilder) addLink(key, other, linkName string, inward bool) {
+	linkType, ok := b.mapping.Links[strings.ToLower(linkName)]
+	if !ok {
+		linkType = string(model.DepRelated)
+	}
+	if linkType == "" || other == "" {
+		return
+	}
+	if inward {
+		b.links = append(b.links, jiraLink{from: key, to: other, linkType: linkType})
+	} else {
+		b.links = append(b.links, jiraLink{from: other, to: key, linkType: linkType})
+	}
+}
+
+// finish attaches links between exported issues, once per pair and type
+func (b *jiraBuilder) finish() []model.Issue {
+	index := make(map[string]int, len(b.issues))
+	for i, issue := range b.issues {
+		index[issue.ID] = i
+	}
+	seen := make(map[jiraLink]bool)
+	for _, l := range b.links {
+		from, ok := index[l.from]
+		if _, exists := index[l.to]; !ok || !exists || l.from == l.to || seen[l] {
+			continue
+		}
+		seen[l] = true
+		issue := &b.issues[from]
+		issue.Dependencies = append(issue.Dependencies, &model.Dependency{
+			IssueID:     l.from,
+			DependsOnID: l.to,
+			Type:        model.DependencyType(l.linkType),
+			CreatedAt:   issue.CreatedAt,
+		})
+	}
+	return b.issues
+}
+
+// jiraTimeLayouts covers the REST API ("2024-01-15T10:30:00.000+0000") and
+// the default CSV export format ("15/Jan/24 10:30 AM")
+var jiraTimeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	time.RFC3339Nano,
+	"02/Jan/06 3:04 PM",
+	"02/Jan/06 15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func parseJiraTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range jiraTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// jiraText returns a description or comment body as plain text. API v2
+// sends a string; v3 sends an Atlassian Document Format tree.
+func jiraText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var doc adfNode
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	doc.write(&sb)
+	return strings.TrimSpace(sb.String())
+}
+
+// adfNode is a node of an Atlassian Document Format tree
+type adfNode struct {
+	Type    string    `json:"type"`
+	Text    string    `json:"text"`
+	Content []adfNode `json:"content"`
+}
+
+func (n adfNode) write(sb *strings.Builder) {
+	switch n.Type {
+	case "text":
+		sb.WriteString(n.Text)
+	case "hardBreak":
+		sb.WriteString("\n")
+	}
+	for _, c := range n.Content {
+		c.write(sb)
+	}
+	switch n.Type {
+	case "paragraph", "heading", "codeBlock", "listItem", "blockquote":
+		sb.WriteString("\n")
+	}
+}
@@ -0,0 +1,201 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+const jiraSearchFixture = `{"startAt": 0, "total": 4, "issues": [
+  {"key": "OPS-1", "fields": {
+    "summary": "Migrate database",
+    "description": {"type": "doc", "content": [
+      {"type": "paragraph", "content": [{"type": "text", "text": "Move to "}, {"type": "text", "text": "Postgres"}]},
+      {"type": "paragraph", "content": [{"type": "text", "text": "Then drop MySQL"}]}]},
+    "status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+    "priority": {"name": "Highest"},
+    "issuetype": {"name": "Epic"},
+    "labels": ["infra"],
+    "assignee": {"displayName": "Ada Lovelace"},
+    "created": "2025-01-10T09:00:00.000+0000",
+    "updated": "2025-01-12T09:00:00.000+0000",
+    "issuelinks": [
+      {"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "OPS-2"}},
+      {"type": {"name": "Relates"}, "outwardIssue": {"key": "OTHER-9"}}],
+    "comment": {"comments": [{"author": {"name": "bob"}, "body": "Kickoff on Monday", "created": "2025-01-11T10:00:00.000+0000"}]}}},
+  {"key": "OPS-2", "fields": {
+    "summary": "Cut over traffic",
+    "description": "Plain v2 description",
+    "status": {"name": "QA", "statusCategory": {"key": "indeterminate"}},
+    "priority": {"name": "Urgent"},
+    "issuetype": {"name": "Story"},
+    "created": "2025-01-11T09:00:00.000+0000",
+    "updated": "2025-01-11T09:00:00.000+0000",
+    "parent": {"key": "OPS-1"},
+    "issuelinks": [
+      {"type": {"name": "Blocks"}, "inwardIssue": {"key": "OPS-1"}},
+      {"type": {"name": "Duplicate"}, "inwardIssue": {"key": "OPS-3"}}]}},
+  {"key": "OPS-3", "fields": {
+    "summary": "Cut over",
+    "status": {"name": "Done", "statusCategory": {"key": "done"}},
+    "issuetype": {"name": "Bug"},
+    "created": "2025-01-05T09:00:00.000+0000",
+    "updated": "2025-01-06T09:00:00.000+0000",
+    "resolutiondate": "2025-01-06T09:00:00.000+0000"}},
+  {"fields": {"summary": "No key"}}
+]}`
+
+func writeJiraFixture(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func depsString(issue model.Issue) string {
+	var deps []string
+	for _, d := range issue.Dependencies {
+		deps = append(deps, string(d.Type)+":"+d.DependsOnID)
+	}
+	return strings.Join(deps, ",")
+}
+
+func TestLoadFromJira_JSON(t *testing.T) {
+	mapping := DefaultJiraMapping()
+	mapping.BaseURL = "https://acme.atlassian.net/"
+	issues, err := LoadFromJira(writeJiraFixture(t, "export.json", jiraSearchFixture), mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 issues (keyless skipped), got %d", len(issues))
+	}
+	epic, story, bug := issues[0], issues[1], issues[2]
+
+	if epic.ID != "OPS-1" || epic.Status != model.StatusInProgress || epic.Priority != 0 ||
+		epic.IssueType != model.TypeEpic || epic.Assignee != "Ada Lovelace" {
+		t.Errorf("Unexpected mapping: %+v", epic)
+	}
+	if epic.Description != "Move to Postgres\nThen drop MySQL" {
+		t.Errorf("ADF description = %q", epic.Description)
+	}
+	if epic.ExternalRef == nil || *epic.ExternalRef != "https://acme.atlassian.net/browse/OPS-1" {
+		t.Errorf("ExternalRef = %v", epic.ExternalRef)
+	}
+	if len(epic.Comments) != 1 || epic.Comments[0].Author != "bob" || epic.Comments[0].Text != "Kickoff on Monday" {
+		t.Errorf("Comments = %+v", epic.Comments)
+	}
+	if deps := depsString(epic); deps != "" {
+		t.Errorf("OPS-1 blocks OPS-2 and links outside the export are dropped, got %s", deps)
+	}
+
+	// Unmapped status uses its category; unmapped priority defaults to 2
+	if story.Status != model.StatusInProgress || story.Priority != 2 || story.IssueType != model.TypeFeature {
+		t.Errorf("Unexpected mapping: %+v", story)
+	}
+	// Both sides list the Blocks link, but it is recorded once
+	if deps := depsString(story); deps != "blocks:OPS-1,parent-child:OPS-1,related:OPS-3" {
+		t.Errorf("OPS-2 deps = %s", deps)
+	}
+
+	if bug.Status != model.StatusClosed || bug.ClosedAt == nil || bug.IssueType != model.TypeBug {
+		t.Errorf("Unexpected mapping: %+v", bug)
+	}
+	for _, issue := range issues {
+		if err := issue.Validate(); err != nil {
+			t.Errorf("%s: %v", issue.ID, err)
+		}
+	}
+}
+
+func TestLoadFromJira_BareArray(t *testing.T) {
+	path := writeJiraFixture(t, "issues.json", `[{"key": "A-1", "fields": {"summary": "One"}}]`)
+	issues, err := LoadFromJira(path, DefaultJiraMapping())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || issues[0].ID != "A-1" || issues[0].Status != model.StatusOpen {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+
+	if _, err := LoadFromJira(writeJiraFixture(t, "bad.json", `{"issues": 3}`), DefaultJiraMapping()); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
+
+func TestLoadFromJira_CSV(t *testing.T) {
+	csv := "\xef\xbb\xbfSummary,Issue key,Issue id,Issue Type,Status,Priority,Assignee,Created,Updated,Resolved,Labels,Labels,Parent id,Outward issue link (Blocks),Inward issue link (Relates)\n" +
+		"Login page,WEB-1,100,Story,To Do,High,Ada,15/Jan/25 10:30 AM,16/Jan/25 9:00 AM,,ui,auth,,WEB-2,\n" +
+		"Session store,WEB-2,101,Sub-task,Done,Low,,14/Jan/25 8:00 AM,15/Jan/25 8:00 AM,15/Jan/25 8:00 AM,,,100,,WEB-1\n"
+	issues, err := LoadFromJira(writeJiraFixture(t, "export.CSV", csv), DefaultJiraMapping())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(issues))
+	}
+	login, store := issues[0], issues[1]
+	if login.Title != "Login page" || login.IssueType != model.TypeFeature || login.Priority != 1 ||
+		login.Assignee != "Ada" || strings.Join(login.Labels, ",") != "ui,auth" || login.CreatedAt.Day() != 15 {
+		t.Errorf("Unexpected mapping: %+v", login)
+	}
+	if store.Status != model.StatusClosed || store.ClosedAt == nil {
+		t.Errorf("Unexpected mapping: %+v", store)
+	}
+	if deps := depsString(store); deps != "blocks:WEB-1,parent-child:WEB-1,related:WEB-1" {
+		t.Errorf("WEB-2 deps = %s", deps)
+	}
+
+	if _, err := LoadFromJira(writeJiraFixture(t, "x.csv", "Summary\nfoo\n"), DefaultJiraMapping()); err == nil {
+		t.Error("Expected an error without an Issue key column")
+	}
+}
+
+func TestLoadJiraMapping(t *testing.T) {
+	mapping, err := LoadJiraMapping(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil || mapping.Statuses["done"] != "closed" {
+		t.Fatalf("Missing file should give defaults, got %v", err)
+	}
+
+	path := writeJiraFixture(t, "jira.yaml", `
+base_url: https://acme.atlassian.net
+statuses:
+  QA: blocked
+priorities:
+  Urgent: 0
+links:
+  Relates: ""
+`)
+	mapping, err = LoadJiraMapping(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapping.Statuses["qa"] != "blocked" || mapping.Statuses["done"] != "closed" || mapping.Priorities["urgent"] != 0 {
+		t.Errorf("File entries should merge over the defaults: %+v", mapping)
+	}
+	issues, err := LoadFromJira(writeJiraFixture(t, "export.json", jiraSearchFixture), mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues[1].Status != model.StatusBlocked || issues[1].Priority != 0 {
+		t.Errorf("Custom mapping not applied: %+v", issues[1])
+	}
+
+	for content, want := range map[string]string{
+		"statuses: {QA: review}":   "invalid status",
+		"priorities: {Urgent: 7}":  "want 0-4",
+		"types: {Spike: research}": "invalid type",
+		"links: {Causes: causes}":  "invalid dependency type",
+		"statuses: [":              "parsing Jira mapping",
+	} {
+		_, err := LoadJiraMapping(writeJiraFixture(t, "bad.yaml", content))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected %q error, got %v", content, want, err)
+		}
+	}
+}
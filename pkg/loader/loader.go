@@ -3,14 +3,20 @@ package loader
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/klauspost/compress/zstd"
 )
 
 // BeadsDirEnvVar is the name of the environment variable for custom beads directory
@@ -62,17 +68,18 @@ func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (stri
 			continue
 		}
 		name := e.Name()
+		base := trimCompressedExt(name)
 
-		// Must be a .jsonl file
-		if !strings.HasSuffix(name, ".jsonl") {
+		// Must be a .jsonl file, possibly compressed (issues.jsonl.gz)
+		if !strings.HasSuffix(base, ".jsonl") {
 			continue
 		}
 
 		// Skip backups, merge artifacts, and deletion manifests
-		if strings.Contains(name, ".backup") ||
-			strings.Contains(name, ".orig") ||
-			strings.Contains(name, ".merge") ||
-			name == "deletions.jsonl" {
+		if strings.Contains(base, ".backup") ||
+			strings.Contains(base, ".orig") ||
+			strings.Contains(base, ".merge") ||
+			base == "deletions.jsonl" {
 			continue
 		}
 
@@ -101,11 +108,12 @@ func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (stri
 	// 2. beads.jsonl (backward compatibility)
 	// 3. beads.base.jsonl (fallback, may be present during merge resolution)
 	// 4. First candidate
+	// Candidates are sorted, so a plain file comes before its .gz and .zst copies.
 	preferredNames := PreferredJSONLNames
 
 	for _, preferred := range preferredNames {
 		for _, name := range candidates {
-			if name == preferred {
+			if trimCompressedExt(name) == preferred {
 				path := filepath.Join(beadsDir, name)
 				// Check if file has content (skip empty files)
 				if info, err := os.Stat(path); err == nil && info.Size() > 0 {
@@ -160,6 +168,7 @@ type ParseOptions struct {
 }
 
 // LoadIssuesFromFileWithOptions reads issues from a file with custom options.
+// Files ending in .gz or .zst (e.g. issues.jsonl.gz) are decompressed on the fly.
 func LoadIssuesFromFileWithOptions(path string, opts ParseOptions) ([]model.Issue, error) {
 	// Check if file exists
 	if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -172,7 +181,96 @@ func LoadIssuesFromFileWithOptions(path string, opts ParseOptions) ([]model.Issu
 	}
 	defer file.Close()
 
-	return ParseIssuesWithOptions(file, opts)
+	r, closeFn, err := decompressReader(path, file)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	return ParseIssuesWithOptions(r, opts)
+}
+
+// ErrCompressed is returned when asked to edit a compressed beads file.
+// bv reads .gz and .zst files but never rewrites them.
+var ErrCompressed = errors.New("compressed beads files are read-only")
+
+// Magic bytes that open gzip and zstd streams
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// IsCompressed reports whether path names a .gz or .zst compressed file
+func IsCompressed(path string) bool {
+	return trimCompressedExt(path) != path
+}
+
+// trimCompressedExt strips a .gz or .zst suffix from name
+func trimCompressedExt(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".gz", ".zst":
+		return name[:len(name)-len(filepath.Ext(name))]
+	}
+	return name
+}
+
+// readEditable reads a beads file about to be rewritten, refusing compressed
+// files so they are never written back as plain text under a .gz/.zst name
+func readEditable(path string) ([]byte, error) {
+	if IsCompressed(path) {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), ErrCompressed)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issues file: %w", err)
+	}
+	return data, nil
+}
+
+// decompressReader wraps r in a decompressor chosen by the file extension.
+// Uncompressed files are returned as-is. The returned close function releases
+// decompressor resources; it does not close r.
+func decompressReader(path string, r io.Reader) (io.Reader, func(), error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gz":
+		return gzipReader(r)
+	case ".zst":
+		return zstdReader(r)
+	default:
+		return r, func() {}, nil
+	}
+}
+
+// DecompressStream wraps r in a decompressor if it starts with gzip or zstd
+// magic bytes, for input without a file name such as stdin. Other input is
+// returned unchanged. The returned close function does not close r.
+func DecompressStream(r io.Reader) (io.Reader, func(), error) {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(head, gzipMagic):
+		return gzipReader(br)
+	case bytes.HasPrefix(head, zstdMagic):
+		return zstdReader(br)
+	default:
+		return br, func() {}, nil
+	}
+}
+
+func gzipReader(r io.Reader) (io.Reader, func(), error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read gzip issues file: %w", err)
+	}
+	return gz, func() { gz.Close() }, nil
+}
+
+func zstdReader(r io.Reader) (io.Reader, func(), error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read zstd issues file: %w", err)
+	}
+	return zr, zr.Close, nil
 }
 
 // LoadIssuesFromFile reads issues directly from a specific JSONL file path.
@@ -190,6 +288,86 @@ func ParseIssues(r io.Reader) ([]model.Issue, error) {
 func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, error) {
 	var issues []model.Issue
 
+	warn := opts.warnFunc()
+	err := scanJSONLLines(r, opts, func(lineNum int, line []byte) {
+		if issue, ok := parseIssueLine(lineNum, line, warn); ok {
+			issues = append(issues, issue)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// ParseIssueStream parses issues piped in from another tool, e.g. stdin for
+// "bv -". It accepts JSONL as well as a single JSON array of issues, which is
+// what `bd list --json` prints. Gzip and zstd compressed input is detected by
+// its magic bytes.
+func ParseIssueStream(r io.Reader, opts ParseOptions) ([]model.Issue, error) {
+	r, closeFn, err := DecompressStream(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+	br := bufio.NewReader(r)
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		br.Discard(3)
+	}
+	// Find the first non-space byte to tell the two formats apart
+	for {
+		b, err := br.Peek(1)
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading issues stream: %w", err)
+		}
+		if b[0] != ' ' && b[0] != '\t' && b[0] != '\r' && b[0] != '\n' {
+			break
+		}
+		br.Discard(1)
+	}
+	if b, _ := br.Peek(1); b[0] != '[' {
+		return ParseIssuesWithOptions(br, opts)
+	}
+
+	var raws []json.RawMessage
+	if err := json.NewDecoder(br).Decode(&raws); err != nil {
+		return nil, fmt.Errorf("failed to parse issues array: %w", err)
+	}
+	warn := opts.warnFunc()
+	issues := make([]model.Issue, 0, len(raws))
+	for i, raw := range raws {
+		var issue model.Issue
+		if err := json.Unmarshal(raw, &issue); err != nil {
+			warn(fmt.Sprintf("skipping malformed issue %d in array: %v", i+1, err))
+			continue
+		}
+		applyEstimateAliases(raw, &issue)
+		if err := issue.Validate(); err != nil {
+			warn(fmt.Sprintf("skipping invalid issue %d in array: %v", i+1, err))
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// warnFunc returns the warning handler, defaulting to stderr
+func (opts ParseOptions) warnFunc() func(string) {
+	if opts.WarningHandler != nil {
+		return opts.WarningHandler
+	}
+	return func(msg string) {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+	}
+}
+
+// scanJSONLLines calls fn with each non-empty line of r, with the BOM
+// stripped from the first line. Lines longer than the buffer are skipped
+// with a warning. line is only valid until fn returns.
+func scanJSONLLines(r io.Reader, opts ParseOptions, fn func(lineNum int, line []byte)) error {
 	// Determine buffer size
 	maxCapacity := opts.BufferSize
 	if maxCapacity <= 0 {
@@ -197,14 +375,7 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 	}
 
 	reader := bufio.NewReaderSize(r, maxCapacity)
-
-	// Default warning handler prints to stderr
-	warn := opts.WarningHandler
-	if warn == nil {
-		warn = func(msg string) {
-			fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
-		}
-	}
+	warn := opts.warnFunc()
 
 	lineNum := 0
 	for {
@@ -217,7 +388,7 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			if err == io.EOF {
 				break
 			}
-			return nil, fmt.Errorf("error reading issues stream at line %d: %w", lineNum, err)
+			return fmt.Errorf("error reading issues stream at line %d: %w", lineNum, err)
 		}
 
 		if isPrefix {
@@ -226,7 +397,7 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			for isPrefix {
 				_, isPrefix, err = reader.ReadLine()
 				if err != nil && err != io.EOF {
-					return nil, fmt.Errorf("error skipping long line at line %d: %w", lineNum, err)
+					return fmt.Errorf("error skipping long line at line %d: %w", lineNum, err)
 				}
 				if err == io.EOF {
 					break
@@ -244,24 +415,119 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			line = stripBOM(line)
 		}
 
-		var issue model.Issue
-		if err := json.Unmarshal(line, &issue); err != nil {
-			// Skip malformed lines but warn
-			warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
-			continue
-		}
+		fn(lineNum, line)
+	}
+	return nil
+}
 
-		// Validate issue
-		if err := issue.Validate(); err != nil {
-			// Skip invalid issues
-			warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
-			continue
+// parseIssueLine decodes and validates one JSONL line, warning on failure
+func parseIssueLine(lineNum int, line []byte, warn func(string)) (model.Issue, bool) {
+	var issue model.Issue
+	if err := json.Unmarshal(line, &issue); err != nil {
+		// Skip malformed lines but warn
+		warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
+		return issue, false
+	}
+	applyEstimateAliases(line, &issue)
+
+	// Validate issue
+	if err := issue.Validate(); err != nil {
+		// Skip invalid issues
+		warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
+		return issue, false
+	}
+	return issue, true
+}
+
+// estimateAliases are the other names exports give estimates. estimate is
+// points when it's a number and a duration when it's a string like "30m"
+// or "2w".
+type estimateAliases struct {
+	Points        *float64        `json:"points"`
+	Estimate      json.RawMessage `json:"estimate"`
+	EstimateHours *float64        `json:"estimate_hours"`
+}
+
+// estimateAliasFields lists the estimateAliases keys, for the validator
+var estimateAliasFields = []string{"points", "estimate", "estimate_hours"}
+
+// durationUnitMinutes converts estimate duration units to minutes, counting
+// working days and weeks
+var durationUnitMinutes = map[string]float64{
+	"m": 1, "min": 1, "mins": 1,
+	"h": 60, "hr": 60, "hrs": 60, "hour": 60, "hours": 60,
+	"d": 8 * 60, "day": 8 * 60, "days": 8 * 60,
+	"w": 40 * 60, "wk": 40 * 60, "week": 40 * 60, "weeks": 40 * 60,
+}
+
+// estimateRe splits an estimate string into an amount and a unit
+var estimateRe = regexp.MustCompile(`^\s*([0-9]*\.?[0-9]+)\s*([a-z]*)\s*$`)
+
+// estimateAliasKeys are the alias keys as they appear in a JSON object, with
+// the closing quote and colon so "estimated_minutes" doesn't match "estimate"
+var estimateAliasKeys = func() [][]byte {
+	keys := make([][]byte, len(estimateAliasFields))
+	for i, name := range estimateAliasFields {
+		keys[i] = []byte(`"` + name + `":`)
+	}
+	return keys
+}()
+
+// hasEstimateAlias reports whether a raw issue line sets an alias key
+func hasEstimateAlias(raw []byte) bool {
+	for _, key := range estimateAliasKeys {
+		if bytes.Contains(raw, key) {
+			return true
 		}
+	}
+	return false
+}
 
-		issues = append(issues, issue)
+// applyEstimateAliases fills Estimate and EstimatedMinutes from their alias
+// fields when the issue doesn't set them directly. Lines without an alias
+// key are only scanned, not decoded a second time.
+func applyEstimateAliases(raw []byte, issue *model.Issue) {
+	if !hasEstimateAlias(raw) {
+		return
+	}
+	var aliases estimateAliases
+	if err := json.Unmarshal(raw, &aliases); err != nil {
+		return
+	}
+	points, minutes := aliases.Points, (*float64)(nil)
+	if aliases.EstimateHours != nil {
+		m := *aliases.EstimateHours * 60
+		minutes = &m
+	}
+	var number float64
+	var text string
+	switch {
+	case json.Unmarshal(aliases.Estimate, &number) == nil:
+		if points == nil {
+			points = &number
+		}
+	case json.Unmarshal(aliases.Estimate, &text) == nil:
+		if match := estimateRe.FindStringSubmatch(strings.ToLower(text)); match != nil {
+			amount, _ := strconv.ParseFloat(match[1], 64)
+			switch unit := match[2]; {
+			case unit == "" || unit == "p" || unit == "pt" || unit == "pts" || unit == "points":
+				if points == nil {
+					points = &amount
+				}
+			case durationUnitMinutes[unit] > 0 && minutes == nil:
+				m := amount * durationUnitMinutes[unit]
+				minutes = &m
+			}
+		}
 	}
 
-	return issues, nil
+	if issue.Estimate == nil && points != nil && *points >= 0 {
+		issue.Estimate = points
+	}
+	if issue.EstimatedMinutes == nil && minutes != nil && *minutes >= 0 {
+		m := int(math.Round(*minutes))
+		issue.EstimatedMinutes = &m
+	}
 }
 
 // stripBOM removes the UTF-8 Byte Order Mark if present
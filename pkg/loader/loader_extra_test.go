@@ -51,3 +51,33 @@ func TestParseIssuesWithOptions_LineTooLong(t *testing.T) {
 		t.Errorf("Expected warning containing %q, got: %v", expectedWarning, warnings)
 	}
 }
+
+func TestParseIssues_EstimateAliases(t *testing.T) {
+	input := strings.Join([]string{
+		`{"id":"a","title":"Points","status":"open","issue_type":"task","story_points":5}`,
+		`{"id":"b","title":"Estimate number","status":"open","issue_type":"task","estimate":3}`,
+		`{"id":"c","title":"Hours","status":"open","issue_type":"task","estimate_hours":1.5}`,
+		`{"id":"d","title":"Duration","status":"open","issue_type":"task","estimate":"2w"}`,
+		`{"id":"e","title":"Pointed string","status":"open","issue_type":"task","estimate":"8 pts"}`,
+		`{"id":"f","title":"Minutes win","status":"open","issue_type":"task","estimated_minutes":30,"estimate":"2h"}`,
+		`{"id":"g","title":"Unreadable","status":"open","issue_type":"task","estimate":"large"}`,
+	}, "\n")
+	issues, err := loader.ParseIssues(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 7 {
+		t.Fatalf("Expected 7 issues, got %d", len(issues))
+	}
+
+	points := map[string]float64{"a": 5, "b": 3, "e": 8}
+	minutes := map[string]int{"c": 90, "d": 80 * 60, "f": 30}
+	for _, issue := range issues {
+		if want, ok := points[issue.ID]; ok != (issue.Estimate != nil) || ok && *issue.Estimate != want {
+			t.Errorf("%s: Estimate = %v, want %v (set %v)", issue.ID, issue.Estimate, want, ok)
+		}
+		if want, ok := minutes[issue.ID]; ok != (issue.EstimatedMinutes != nil) || ok && *issue.EstimatedMinutes != want {
+			t.Errorf("%s: EstimatedMinutes = %v, want %v (set %v)", issue.ID, issue.EstimatedMinutes, want, ok)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package loader_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +12,8 @@ import (
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/klauspost/compress/zstd"
 )
 
 // =============================================================================
@@ -835,3 +840,137 @@ func TestGetBeadsDir_EnvVarEmpty_FallsBack(t *testing.T) {
 		t.Errorf("Empty BEADS_DIR should fallback: got %s, want %s", result, expected)
 	}
 }
+
+// =============================================================================
+// ParseIssueStream Tests
+// =============================================================================
+
+func TestParseIssueStream(t *testing.T) {
+	jsonl := `{"id":"s-1","title":"One","status":"open","issue_type":"task"}` + "\n" +
+		`{"id":"s-2","title":"Two","status":"closed","issue_type":"bug"}` + "\n"
+	array := "\xef\xbb\xbf\n  [\n" +
+		`{"id":"s-1","title":"One","status":"open","issue_type":"task"},` +
+		`{"id":"","title":"No ID","status":"open","issue_type":"task"},` +
+		`{"id":"s-2","title":"Two","status":"closed","issue_type":"bug"}]`
+
+	for name, input := range map[string]string{"jsonl": jsonl, "array": array} {
+		var warnings []string
+		opts := loader.ParseOptions{WarningHandler: func(msg string) { warnings = append(warnings, msg) }}
+		issues, err := loader.ParseIssueStream(strings.NewReader(input), opts)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(issues) != 2 || issues[0].ID != "s-1" || issues[1].ID != "s-2" {
+			t.Errorf("%s: unexpected issues %+v", name, issues)
+		}
+		if name == "array" && (len(warnings) != 1 || !strings.Contains(warnings[0], "issue 2")) {
+			t.Errorf("array: expected a warning for entry 2, got %v", warnings)
+		}
+	}
+
+	if issues, err := loader.ParseIssueStream(strings.NewReader(" \n"), loader.ParseOptions{}); err != nil || len(issues) != 0 {
+		t.Errorf("Empty stream should give no issues, got %v (%v)", issues, err)
+	}
+	if _, err := loader.ParseIssueStream(strings.NewReader(`[{"id":`), loader.ParseOptions{}); err == nil {
+		t.Error("Expected an error for a truncated array")
+	}
+}
+
+func TestLoadIssuesFromFile_Compressed(t *testing.T) {
+	jsonl := []byte(`{"id":"c-1","title":"One","status":"open","issue_type":"task"}` + "\n" +
+		`{"id":"c-2","title":"Two","status":"closed","issue_type":"bug"}` + "\n")
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(jsonl)
+	gw.Close()
+
+	var zst bytes.Buffer
+	zw, err := zstd.NewWriter(&zst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw.Write(jsonl)
+	zw.Close()
+
+	dir := t.TempDir()
+	for name, data := range map[string][]byte{"issues.jsonl.gz": gz.Bytes(), "issues.jsonl.zst": zst.Bytes(), "issues.JSONL.GZ": gz.Bytes()} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		issues, err := loader.LoadIssuesFromFile(path)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(issues) != 2 || issues[0].ID != "c-1" || issues[1].ID != "c-2" {
+			t.Errorf("%s: unexpected issues %+v", name, issues)
+		}
+	}
+
+	// Plain JSONL misnamed as .gz is an error, not silently empty
+	bad := filepath.Join(dir, "bad.jsonl.gz")
+	os.WriteFile(bad, jsonl, 0644)
+	if _, err := loader.LoadIssuesFromFile(bad); err == nil || !strings.Contains(err.Error(), "gzip") {
+		t.Errorf("Expected a gzip error, got %v", err)
+	}
+}
+
+func TestCompressedSource_DiscoveryStdinAndWrites(t *testing.T) {
+	jsonl := []byte(`{"id":"c-1","title":"One","status":"open","issue_type":"task"}` + "\n")
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(jsonl)
+	gw.Close()
+	var zst bytes.Buffer
+	zw, err := zstd.NewWriter(&zst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw.Write(jsonl)
+	zw.Close()
+
+	for name, data := range map[string][]byte{"issues.jsonl.gz": gz.Bytes(), "beads.jsonl.zst": zst.Bytes()} {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.WriteFile(filepath.Join(dir, "deletions.jsonl.gz"), gz.Bytes(), 0644)
+
+		// Discovery finds the compressed file and the loader reads it
+		path, err := loader.FindJSONLPath(dir)
+		if err != nil || filepath.Base(path) != name {
+			t.Fatalf("%s: FindJSONLPath = %q, %v", name, path, err)
+		}
+		if issues, err := loader.LoadIssuesFromFile(path); err != nil || len(issues) != 1 {
+			t.Errorf("%s: loaded %v, %v", name, issues, err)
+		}
+
+		// Stdin has no name, so the magic bytes give the format away
+		if issues, err := loader.ParseIssueStream(bytes.NewReader(data), loader.ParseOptions{}); err != nil || len(issues) != 1 || issues[0].ID != "c-1" {
+			t.Errorf("%s on stdin: %v, %v", name, issues, err)
+		}
+
+		// Edits are refused rather than writing plain text under a compressed name
+		if _, err := loader.UpdateIssue(path, "c-1", func(i *model.Issue) { i.Priority = 0 }); !errors.Is(err, loader.ErrCompressed) {
+			t.Errorf("%s: UpdateIssue should refuse, got %v", name, err)
+		}
+		if _, err := loader.AppendComment(path, "c-1", model.Comment{Text: "hi"}); !errors.Is(err, loader.ErrCompressed) {
+			t.Errorf("%s: AppendComment should refuse, got %v", name, err)
+		}
+		if err := loader.DropDependency(path, "c-1", "c-2"); !errors.Is(err, loader.ErrCompressed) {
+			t.Errorf("%s: DropDependency should refuse, got %v", name, err)
+		}
+		if after, _ := os.ReadFile(path); !bytes.Equal(after, data) {
+			t.Errorf("%s: the compressed file changed", name)
+		}
+	}
+
+	// A plain file wins over its compressed copy
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "issues.jsonl.gz"), gz.Bytes(), 0644)
+	os.WriteFile(filepath.Join(dir, "issues.jsonl"), jsonl, 0644)
+	if path, _ := loader.FindJSONLPath(dir); filepath.Base(path) != "issues.jsonl" {
+		t.Errorf("expected issues.jsonl over its .gz copy, got %q", path)
+	}
+}
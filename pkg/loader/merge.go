@@ -0,0 +1,351 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// SupersededLabel is added to the issue that a merge closes
+const SupersededLabel = "superseded"
+
+// MergePlan describes folding one issue into another
+type MergePlan struct {
+	Keep       model.Issue // The surviving issue, after the merge
+	Superseded model.Issue // The merged-away issue, closed and linked to Keep
+	Fields     []string    // Fields of Keep that change, in file order
+	Retargeted []string    // Other issues whose dependencies move to Keep
+}
+
+// MergeIssues folds drop into keep. Descriptions, design, acceptance criteria
+// and notes are concatenated under a "Merged from" header, labels and
+// dependencies are unioned, comments move over, and the higher priority wins.
+// The returned superseded copy of drop is closed, labeled "superseded" and
+// gets a related dependency on keep.
+func MergeIssues(keep, drop model.Issue, now time.Time) (merged, superseded model.Issue) {
+	merged = keep.Clone()
+	superseded = drop.Clone()
+
+	header := fmt.Sprintf("Merged from %s (%s):", drop.ID, drop.Title)
+	merged.Description = mergeText(keep.Description, drop.Description, header)
+	merged.Design = mergeText(keep.Design, drop.Design, header)
+	merged.AcceptanceCriteria = mergeText(keep.AcceptanceCriteria, drop.AcceptanceCriteria, header)
+	merged.Notes = mergeText(keep.Notes, drop.Notes, header)
+	if drop.Priority < merged.Priority {
+		merged.Priority = drop.Priority
+	}
+	merged.Labels = unionStrings(keep.Labels, drop.Labels)
+
+	// Dependencies: keep's own (minus any on drop), then drop's that keep lacks
+	merged.Dependencies = nil
+	has := make(map[string]bool)
+	for _, dep := range keep.Clone().Dependencies {
+		if dep == nil || dep.DependsOnID == drop.ID {
+			continue
+		}
+		has[dep.DependsOnID] = true
+		merged.Dependencies = append(merged.Dependencies, dep)
+	}
+	for _, dep := range drop.Clone().Dependencies {
+		if dep == nil || dep.DependsOnID == keep.ID || dep.DependsOnID == drop.ID || has[dep.DependsOnID] {
+			continue
+		}
+		has[dep.DependsOnID] = true
+		dep.IssueID = keep.ID
+		merged.Dependencies = append(merged.Dependencies, dep)
+	}
+
+	// Comments move to the survivor, in time order
+	for _, c := range superseded.Comments {
+		if c != nil {
+			c.IssueID = keep.ID
+			merged.Comments = append(merged.Comments, c)
+		}
+	}
+	sort.SliceStable(merged.Comments, func(i, j int) bool {
+		return merged.Comments[i].CreatedAt.Before(merged.Comments[j].CreatedAt)
+	})
+	merged.UpdatedAt = now
+
+	superseded.Comments = nil
+	superseded.Status = model.StatusClosed
+	if superseded.ClosedAt == nil {
+		closed := now
+		superseded.ClosedAt = &closed
+	}
+	superseded.UpdatedAt = now
+	superseded.Labels = unionStrings(superseded.Labels, []string{SupersededLabel})
+	superseded.Notes = mergeText(superseded.Notes, fmt.Sprintf("Superseded by %s (%s).", keep.ID, keep.Title), "")
+	linked := false
+	for _, dep := range superseded.Dependencies {
+		if dep != nil && dep.DependsOnID == keep.ID {
+			linked = true
+		}
+	}
+	if !linked {
+		superseded.Dependencies = append(superseded.Dependencies, &model.Dependency{
+			IssueID:     drop.ID,
+			DependsOnID: keep.ID,
+			Type:        model.DepRelated,
+			CreatedAt:   now,
+			CreatedBy:   "bv",
+		})
+	}
+	return merged, superseded
+}
+
+// PlanMerge previews merging dropID into keepID in a beads JSONL file
+// without writing anything
+func PlanMerge(path, keepID, dropID string, now time.Time) (MergePlan, error) {
+	plan, _, err := planMerge(path, keepID, dropID, now)
+	return plan, err
+}
+
+// ApplyMerge merges dropID into keepID in a beads JSONL file (see
+// MergeIssues). Dependencies other issues have on dropID are moved to keepID.
+// Only the changed fields of the affected records are rewritten and the
+// write is atomic.
+func ApplyMerge(path, keepID, dropID string, now time.Time) (MergePlan, error) {
+	plan, lines, err := planMerge(path, keepID, dropID, now)
+	if err != nil {
+		return plan, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return plan, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+	if err := writeFileAtomic(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm()); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// planMerge computes the plan and the rewritten file lines
+func planMerge(path, keepID, dropID string, now time.Time) (MergePlan, [][]byte, error) {
+	var plan MergePlan
+	if keepID == "" || dropID == "" || keepID == dropID {
+		return plan, nil, fmt.Errorf("merge needs two different issue IDs")
+	}
+	data, err := readEditable(path)
+	if err != nil {
+		return plan, nil, err
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	keepLine, dropLine := findIssueLine(lines, keepID), findIssueLine(lines, dropID)
+	for _, missing := range []struct {
+		line int
+		id   string
+	}{{keepLine, keepID}, {dropLine, dropID}} {
+		if missing.line < 0 {
+			return plan, nil, fmt.Errorf("issue %s not found in %s", missing.id, filepath.Base(path))
+		}
+	}
+
+	var keep, drop model.Issue
+	if err := json.Unmarshal(stripBOM(lines[keepLine]), &keep); err != nil {
+		return plan, nil, fmt.Errorf("issue %s: %w", keepID, err)
+	}
+	if err := json.Unmarshal(stripBOM(lines[dropLine]), &drop); err != nil {
+		return plan, nil, fmt.Errorf("issue %s: %w", dropID, err)
+	}
+	plan.Keep, plan.Superseded = MergeIssues(keep, drop, now)
+
+	for _, edit := range []struct {
+		line          int
+		before, after model.Issue
+		fields        *[]string
+	}{{keepLine, keep, plan.Keep, &plan.Fields}, {dropLine, drop, plan.Superseded, nil}} {
+		line := lines[edit.line]
+		body := stripBOM(line)
+		updated, changed, err := setIssueFields(body, edit.before, edit.after)
+		if err != nil {
+			return plan, nil, fmt.Errorf("failed to update issue %s: %w", edit.before.ID, err)
+		}
+		if edit.fields != nil {
+			*edit.fields = changed
+		}
+		bom := line[:len(line)-len(body)]
+		lines[edit.line] = bytes.Join([][]byte{bom, updated}, nil)
+	}
+
+	// Point everyone else's dependencies on the merged-away issue at keep
+	for i, line := range lines {
+		if i == keepLine || i == dropLine {
+			continue
+		}
+		body := line
+		if i == 0 {
+			body = stripBOM(line)
+		}
+		var rec struct {
+			ID           string              `json:"id"`
+			Dependencies []*model.Dependency `json:"dependencies"`
+		}
+		if len(bytes.TrimSpace(body)) == 0 || json.Unmarshal(body, &rec) != nil {
+			continue
+		}
+		onDrop, onKeep := false, false
+		for _, dep := range rec.Dependencies {
+			if dep != nil {
+				onDrop = onDrop || dep.DependsOnID == dropID
+				onKeep = onKeep || dep.DependsOnID == keepID
+			}
+		}
+		if !onDrop {
+			continue
+		}
+		updated, _, err := spliceField(body, "dependencies", func(raw json.RawMessage) ([]byte, error) {
+			return retargetDependencyList(raw, dropID, keepID, onKeep)
+		})
+		if err != nil {
+			return plan, nil, fmt.Errorf("failed to update issue %s: %w", rec.ID, err)
+		}
+		bom := line[:len(line)-len(body)]
+		lines[i] = bytes.Join([][]byte{bom, updated}, nil)
+		plan.Retargeted = append(plan.Retargeted, rec.ID)
+	}
+	return plan, lines, nil
+}
+
+// setIssueFields rewrites the top-level fields that differ between before
+// and after, adding any that are missing, and returns their names
+func setIssueFields(obj []byte, before, after model.Issue) ([]byte, []string, error) {
+	fields := []struct {
+		key           string
+		before, after interface{}
+	}{
+		{"description", before.Description, after.Description},
+		{"design", before.Design, after.Design},
+		{"acceptance_criteria", before.AcceptanceCriteria, after.AcceptanceCriteria},
+		{"notes", before.Notes, after.Notes},
+		{"status", before.Status, after.Status},
+		{"priority", before.Priority, after.Priority},
+		{"assignee", before.Assignee, after.Assignee},
+		{"updated_at", before.UpdatedAt, after.UpdatedAt},
+		{"closed_at", before.ClosedAt, after.ClosedAt},
+		{"labels", before.Labels, after.Labels},
+		{"dependencies", before.Dependencies, after.Dependencies},
+		{"comments", before.Comments, after.Comments},
+	}
+	var changed []string
+	for _, f := range fields {
+		old, err := json.Marshal(f.before)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := json.Marshal(f.after)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Equal(old, value) {
+			continue
+		}
+		if obj, err = setField(obj, f.key, value); err != nil {
+			return nil, nil, err
+		}
+		changed = append(changed, f.key)
+	}
+	return obj, changed, nil
+}
+
+// retargetDependencyList moves dependencies on from to to. If the record
+// already depends on to, the edges on from are dropped instead.
+func retargetDependencyList(raw json.RawMessage, from, to string, hasTo bool) ([]byte, error) {
+	var deps []json.RawMessage
+	if err := json.Unmarshal(raw, &deps); err != nil {
+		return nil, fmt.Errorf("invalid dependencies: %w", err)
+	}
+	encoded, err := json.Marshal(to)
+	if err != nil {
+		return nil, err
+	}
+	kept := make([][]byte, 0, len(deps))
+	for _, dep := range deps {
+		var d struct {
+			DependsOnID string `json:"depends_on_id"`
+		}
+		if err := json.Unmarshal(dep, &d); err != nil || d.DependsOnID != from {
+			kept = append(kept, dep)
+			continue
+		}
+		if hasTo {
+			continue
+		}
+		updated, _, err := spliceField(dep, "depends_on_id", func(json.RawMessage) ([]byte, error) {
+			return encoded, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		kept = append(kept, updated)
+		hasTo = true // Several edges on from collapse into one
+	}
+	return bytes.Join([][]byte{[]byte("["), bytes.Join(kept, []byte(",")), []byte("]")}, nil), nil
+}
+
+// mergeText appends extra to base under header, unless extra is empty or
+// already part of base
+func mergeText(base, extra, header string) string {
+	extra = strings.TrimSpace(extra)
+	switch {
+	case extra == "" || strings.Contains(base, extra):
+		return base
+	case strings.TrimSpace(base) == "":
+		return extra
+	case header == "":
+		return strings.TrimRight(base, "\n") + "\n\n" + extra
+	default:
+		return strings.TrimRight(base, "\n") + "\n\n" + header + "\n" + extra
+	}
+}
+
+// unionStrings returns a followed by the values of b not already in a
+func unionStrings(a, b []string) []string {
+	out := append([]string(nil), a...)
+	for _, s := range b {
+		if !containsString(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// FormatMergePlan renders a merge preview for the terminal
+func FormatMergePlan(plan MergePlan) string {
+	var sb strings.Builder
+	keep, sup := plan.Keep, plan.Superseded
+	sb.WriteString(fmt.Sprintf("Merge %s into %s\n", sup.ID, keep.ID))
+	sb.WriteString(fmt.Sprintf("  keep:       %s  %s\n", keep.ID, keep.Title))
+	sb.WriteString(fmt.Sprintf("  supersede:  %s  %s (closed, labeled %q, related → %s)\n", sup.ID, sup.Title, SupersededLabel, keep.ID))
+	if len(plan.Fields) > 0 {
+		sb.WriteString(fmt.Sprintf("  %s fields:  %s\n", keep.ID, strings.Join(plan.Fields, ", ")))
+	}
+	sb.WriteString(fmt.Sprintf("  priority:   P%d\n", keep.Priority))
+	if len(keep.Labels) > 0 {
+		sb.WriteString(fmt.Sprintf("  labels:     %s\n", strings.Join(keep.Labels, ", ")))
+	}
+	var deps []string
+	for _, dep := range keep.Dependencies {
+		if dep != nil {
+			deps = append(deps, dep.DependsOnID)
+		}
+	}
+	if len(deps) > 0 {
+		sb.WriteString(fmt.Sprintf("  depends on: %s\n", strings.Join(deps, ", ")))
+	}
+	if len(keep.Comments) > 0 {
+		sb.WriteString(fmt.Sprintf("  comments:   %d\n", len(keep.Comments)))
+	}
+	if len(plan.Retargeted) > 0 {
+		sb.WriteString(fmt.Sprintf("  retarget:   %s (dependencies on %s move to %s)\n", strings.Join(plan.Retargeted, ", "), sup.ID, keep.ID))
+	}
+	return sb.String()
+}
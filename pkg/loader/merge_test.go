@@ -0,0 +1,135 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestMergeIssues(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-48 * time.Hour)
+	keep := model.Issue{
+		ID: "m-1", Title: "Login fails", Description: "Users can't log in.", Priority: 2,
+		Status: model.StatusOpen, IssueType: model.TypeBug, Labels: []string{"auth"},
+		Dependencies: []*model.Dependency{
+			{IssueID: "m-1", DependsOnID: "m-2", Type: model.DepRelated},
+			{IssueID: "m-1", DependsOnID: "m-5", Type: model.DepBlocks},
+		},
+		Comments: []*model.Comment{{ID: 2, IssueID: "m-1", Text: "later", CreatedAt: now}},
+	}
+	drop := model.Issue{
+		ID: "m-2", Title: "Can't sign in", Description: "Sign-in button spins forever.", Priority: 1,
+		Status: model.StatusOpen, IssueType: model.TypeBug, Labels: []string{"auth", "ui"},
+		Dependencies: []*model.Dependency{
+			{IssueID: "m-2", DependsOnID: "m-5", Type: model.DepBlocks},
+			{IssueID: "m-2", DependsOnID: "m-6", Type: model.DepBlocks},
+			{IssueID: "m-2", DependsOnID: "m-1", Type: model.DepRelated},
+		},
+		Comments: []*model.Comment{{ID: 1, IssueID: "m-2", Text: "earlier", CreatedAt: earlier}},
+	}
+
+	merged, superseded := MergeIssues(keep, drop, now)
+	if want := "Users can't log in.\n\nMerged from m-2 (Can't sign in):\nSign-in button spins forever."; merged.Description != want {
+		t.Errorf("Description = %q", merged.Description)
+	}
+	if merged.Priority != 1 || strings.Join(merged.Labels, ",") != "auth,ui" {
+		t.Errorf("Priority/labels = %d %v", merged.Priority, merged.Labels)
+	}
+	var deps []string
+	for _, d := range merged.Dependencies {
+		deps = append(deps, d.IssueID+"→"+d.DependsOnID)
+	}
+	if strings.Join(deps, " ") != "m-1→m-5 m-1→m-6" {
+		t.Errorf("Dependencies = %v", deps)
+	}
+	if len(merged.Comments) != 2 || merged.Comments[0].Text != "earlier" || merged.Comments[0].IssueID != "m-1" {
+		t.Errorf("Comments = %+v", merged.Comments)
+	}
+
+	if superseded.Status != model.StatusClosed || superseded.ClosedAt == nil || !superseded.ClosedAt.Equal(now) {
+		t.Errorf("Superseded should be closed now, got %s %v", superseded.Status, superseded.ClosedAt)
+	}
+	if !containsString(superseded.Labels, SupersededLabel) || len(superseded.Comments) != 0 {
+		t.Errorf("Superseded labels/comments = %v %d", superseded.Labels, len(superseded.Comments))
+	}
+	if len(superseded.Dependencies) != 3 || !strings.Contains(superseded.Notes, "Superseded by m-1") {
+		t.Errorf("Superseded already links m-1; got %d deps, notes %q", len(superseded.Dependencies), superseded.Notes)
+	}
+	if keep.Description != "Users can't log in." || len(drop.Comments) != 1 || drop.Comments[0].IssueID != "m-2" {
+		t.Error("MergeIssues must not modify its inputs")
+	}
+}
+
+func TestApplyMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := "\ufeff" + `{"id":"a","title":"Keep","description":"Alpha","status":"open","priority":2,"issue_type":"task","x_custom":1}
+{"id":"b","title":"Dup","description":"Beta","status":"open","priority":2,"issue_type":"task","labels":["dup"]}
+{"id":"c","title":"Downstream","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"c","depends_on_id":"b","type":"blocks"}]}
+{"id":"d","title":"Both","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"d","depends_on_id":"a","type":"blocks"},{"issue_id":"d","depends_on_id":"b","type":"blocks"}]}
+{"id":"e","title":"Unrelated","status":"open","priority":3,"issue_type":"task"}
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	plan, err := PlanMerge(path, "a", "b", now)
+	if err != nil {
+		t.Fatalf("PlanMerge: %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != content {
+		t.Fatal("PlanMerge must not write")
+	}
+	if strings.Join(plan.Retargeted, ",") != "c,d" || strings.Join(plan.Fields, ",") != "description,updated_at,labels" {
+		t.Errorf("Plan = %+v", plan)
+	}
+	if out := FormatMergePlan(plan); !strings.Contains(out, "Merge b into a") || !strings.Contains(out, "c, d (dependencies on b move to a)") {
+		t.Errorf("FormatMergePlan:\n%s", out)
+	}
+
+	if _, err := ApplyMerge(path, "a", "b", now); err != nil {
+		t.Fatalf("ApplyMerge: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(string(data), "\n")
+	if !strings.HasPrefix(lines[0], "\ufeff") || !strings.Contains(lines[0], `"x_custom":1`) {
+		t.Errorf("BOM and unknown fields should survive: %s", lines[0])
+	}
+	if lines[4] != `{"id":"e","title":"Unrelated","status":"open","priority":3,"issue_type":"task"}` {
+		t.Errorf("Unrelated line changed: %s", lines[4])
+	}
+
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil || len(issues) != 5 {
+		t.Fatalf("Reload: %d issues, %v", len(issues), err)
+	}
+	byID := make(map[string]model.Issue)
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	if byID["a"].Description != "Alpha\n\nMerged from b (Dup):\nBeta" || strings.Join(byID["a"].Labels, ",") != "dup" {
+		t.Errorf("Keep = %+v", byID["a"])
+	}
+	if b := byID["b"]; b.Status != model.StatusClosed || strings.Join(b.Labels, ",") != "dup,superseded" ||
+		len(b.Dependencies) != 1 || b.Dependencies[0].DependsOnID != "a" {
+		t.Errorf("Superseded = %+v", b)
+	}
+	if c := byID["c"]; len(c.Dependencies) != 1 || c.Dependencies[0].DependsOnID != "a" || c.Dependencies[0].Type != model.DepBlocks {
+		t.Errorf("c should now block on a: %+v", c.Dependencies)
+	}
+	if d := byID["d"]; len(d.Dependencies) != 1 || d.Dependencies[0].DependsOnID != "a" {
+		t.Errorf("d's edge on b should collapse into its edge on a: %+v", d.Dependencies)
+	}
+
+	if _, err := ApplyMerge(path, "a", "a", now); err == nil {
+		t.Error("Merging an issue into itself should fail")
+	}
+	if _, err := ApplyMerge(path, "a", "zzz", now); err == nil || !strings.Contains(err.Error(), "zzz not found") {
+		t.Errorf("Missing issue error = %v", err)
+	}
+}
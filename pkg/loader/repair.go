@@ -0,0 +1,215 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DropDependency removes every dependency of issueID on targetID from a
+// beads JSONL file. Like AppendComment, only the issue's "dependencies"
+// field is rewritten and the write is atomic.
+func DropDependency(path, issueID, targetID string) error {
+	return rewriteDependencies(path, issueID, targetID, func(dep []byte) ([]byte, error) {
+		return nil, nil
+	})
+}
+
+// DropBlockingDependency removes issueID's blocking dependencies on
+// targetID, keeping non-blocking links such as "related". It breaks a
+// dependency cycle without losing the connection between the issues.
+func DropBlockingDependency(path, issueID, targetID string) error {
+	return rewriteDependencies(path, issueID, targetID, func(dep []byte) ([]byte, error) {
+		var d struct {
+			Type model.DependencyType `json:"type"`
+		}
+		if err := json.Unmarshal(dep, &d); err == nil && !d.Type.IsBlocking() {
+			return dep, nil
+		}
+		return nil, nil
+	})
+}
+
+// RetargetDependency points issueID's dependencies on fromID at toID instead,
+// keeping the rest of each dependency record
+func RetargetDependency(path, issueID, fromID, toID string) error {
+	if toID == "" || toID == issueID {
+		return fmt.Errorf("invalid dependency target %q", toID)
+	}
+	encoded, err := json.Marshal(toID)
+	if err != nil {
+		return err
+	}
+	return rewriteDependencies(path, issueID, fromID, func(dep []byte) ([]byte, error) {
+		updated, found, err := spliceField(dep, "depends_on_id", func(json.RawMessage) ([]byte, error) {
+			return encoded, nil
+		})
+		if err != nil || !found {
+			return nil, fmt.Errorf("dependency has no depends_on_id")
+		}
+		return updated, nil
+	})
+}
+
+// AppendIssue adds issue as a new record at the end of a beads JSONL file.
+// It fails if the ID is already taken.
+func AppendIssue(path string, issue model.Issue) error {
+	if err := issue.Validate(); err != nil {
+		return err
+	}
+	data, err := readEditable(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat issues file: %w", err)
+	}
+	if findIssueLine(bytes.Split(data, []byte("\n")), issue.ID) >= 0 {
+		return fmt.Errorf("issue %s already exists in %s", issue.ID, filepath.Base(path))
+	}
+
+	encoded, err := json.Marshal(issue)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data, '\n')
+	}
+	data = append(data, encoded...)
+	data = append(data, '\n')
+	return writeFileAtomic(path, data, info.Mode().Perm())
+}
+
+// rewriteDependencies applies fn to each of issueID's dependency records on
+// targetID. fn returns the replacement record, or nil to drop it.
+func rewriteDependencies(path, issueID, targetID string, fn func(dep []byte) ([]byte, error)) error {
+	data, err := readEditable(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat issues file: %w", err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	target := findIssueLine(lines, issueID)
+	if target < 0 {
+		return fmt.Errorf("issue %s not found in %s", issueID, filepath.Base(path))
+	}
+
+	line := lines[target]
+	body := stripBOM(line)
+	changed := 0
+	updated, found, err := spliceField(body, "dependencies", func(raw json.RawMessage) ([]byte, error) {
+		var deps []json.RawMessage
+		if err := json.Unmarshal(raw, &deps); err != nil {
+			return nil, fmt.Errorf("invalid dependencies: %w", err)
+		}
+		kept := make([][]byte, 0, len(deps))
+		for _, dep := range deps {
+			var d struct {
+				DependsOnID string `json:"depends_on_id"`
+			}
+			if err := json.Unmarshal(dep, &d); err != nil || d.DependsOnID != targetID {
+				kept = append(kept, dep)
+				continue
+			}
+			changed++
+			replacement, err := fn(dep)
+			if err != nil {
+				return nil, err
+			}
+			if replacement != nil {
+				kept = append(kept, replacement)
+			}
+		}
+		return bytes.Join([][]byte{[]byte("["), bytes.Join(kept, []byte(",")), []byte("]")}, nil), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update issue %s: %w", issueID, err)
+	}
+	if !found || changed == 0 {
+		return fmt.Errorf("issue %s has no dependency on %s", issueID, targetID)
+	}
+
+	bom := line[:len(line)-len(body)]
+	lines[target] = bytes.Join([][]byte{bom, updated}, nil)
+	return writeFileAtomic(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm())
+}
+
+// findIssueLine returns the index of the first line holding issueID, or -1
+func findIssueLine(lines [][]byte, issueID string) int {
+	for i, line := range lines {
+		if i == 0 {
+			line = stripBOM(line)
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var rec struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(line, &rec); err == nil && rec.ID == issueID {
+			return i
+		}
+	}
+	return -1
+}
+
+// spliceField replaces the value of a top-level key in a JSON object with
+// fn's result, leaving every other byte of the object as it was. found is
+// false if the key isn't present.
+func spliceField(obj []byte, key string, fn func(raw json.RawMessage) ([]byte, error)) (updated []byte, found bool, err error) {
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false, fmt.Errorf("not a JSON object")
+	}
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, false, err
+		}
+		if k, _ := keyTok.(string); k != key {
+			continue
+		}
+		end := int(dec.InputOffset())
+		start := end - len(raw)
+		value, err := fn(raw)
+		if err != nil {
+			return nil, true, err
+		}
+		return bytes.Join([][]byte{obj[:start], value, obj[end:]}, nil), true, nil
+	}
+	return obj, false, nil
+}
+
+// setField sets a top-level key of a JSON object to value, adding the key
+// before the closing brace if it isn't there yet
+func setField(obj []byte, key string, value []byte) ([]byte, error) {
+	updated, found, err := spliceField(obj, key, func(json.RawMessage) ([]byte, error) {
+		return value, nil
+	})
+	if err != nil || found {
+		return updated, err
+	}
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	closing := bytes.LastIndexByte(obj, '}')
+	field := bytes.Join([][]byte{encodedKey, []byte(":"), value}, nil)
+	if len(bytes.TrimSpace(obj[1:closing])) > 0 {
+		field = append([]byte(","), field...)
+	}
+	return bytes.Join([][]byte{obj[:closing], field, obj[closing:]}, nil), nil
+}
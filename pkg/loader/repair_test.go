@@ -0,0 +1,89 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestRepairDanglingDependencies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := `{"id":"r-1","title":"One","status":"open","issue_type":"task","dependencies":[{"issue_id":"r-1","depends_on_id":"r-9","type":"blocks","created_by":"amy"}, {"issue_id":"r-1","depends_on_id":"r-2","type":"related"}],"priority":1}
+{"id":"r-2", "title":"Two", "status":"open", "issue_type":"task", "dependencies":[{"issue_id":"r-2","depends_on_id":"gone","type":"blocks"}]}`
+	if err := os.WriteFile(path, []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+	read := func() []string {
+		data, _ := os.ReadFile(path)
+		return strings.Split(string(data), "\n")
+	}
+
+	if err := RetargetDependency(path, "r-1", "r-9", "r-3"); err != nil {
+		t.Fatalf("RetargetDependency: %v", err)
+	}
+	lines := read()
+	if want := `{"id":"r-1","title":"One","status":"open","issue_type":"task","dependencies":[{"issue_id":"r-1","depends_on_id":"r-3","type":"blocks","created_by":"amy"},{"issue_id":"r-1","depends_on_id":"r-2","type":"related"}],"priority":1}`; lines[0] != want {
+		t.Errorf("Retargeted line = %s", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], `{"id":"r-2", "title":"Two"`) {
+		t.Errorf("Other lines should be untouched: %s", lines[1])
+	}
+
+	if err := DropDependency(path, "r-2", "gone"); err != nil {
+		t.Fatalf("DropDependency: %v", err)
+	}
+	if lines = read(); lines[1] != `{"id":"r-2", "title":"Two", "status":"open", "issue_type":"task", "dependencies":[]}` {
+		t.Errorf("Dropped line = %s", lines[1])
+	}
+	if err := DropDependency(path, "r-2", "gone"); err == nil || !strings.Contains(err.Error(), "no dependency on gone") {
+		t.Errorf("Dropping twice should fail, got %v", err)
+	}
+
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	stub := model.Issue{ID: "r-3", Title: "Stub", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: now, UpdatedAt: now}
+	if err := AppendIssue(path, stub); err != nil {
+		t.Fatalf("AppendIssue: %v", err)
+	}
+	if err := AppendIssue(path, stub); err == nil {
+		t.Error("Appending an existing ID should fail")
+	}
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil || len(issues) != 3 || issues[2].ID != "r-3" || issues[0].Dependencies[0].CreatedBy != "amy" {
+		t.Errorf("Reloaded: %+v (%v)", issues, err)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0640 {
+		t.Errorf("File mode changed to %v", info.Mode().Perm())
+	}
+
+	for name, err := range map[string]error{
+		"unknown issue":  DropDependency(path, "r-404", "x"),
+		"self target":    RetargetDependency(path, "r-1", "r-3", "r-1"),
+		"invalid append": AppendIssue(path, model.Issue{ID: "r-4"}),
+	} {
+		if err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestDropBlockingDependencyKeepsOtherLinks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := `{"id":"c-1","title":"One","status":"open","dependencies":[{"issue_id":"c-1","depends_on_id":"c-2","type":"blocks"},{"issue_id":"c-1","depends_on_id":"c-2","type":"related"},{"issue_id":"c-1","depends_on_id":"c-3"}]}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := DropBlockingDependency(path, "c-1", "c-2"); err != nil {
+		t.Fatalf("DropBlockingDependency: %v", err)
+	}
+	if err := DropBlockingDependency(path, "c-1", "c-3"); err != nil {
+		t.Fatalf("DropBlockingDependency (untyped): %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if want := `{"id":"c-1","title":"One","status":"open","dependencies":[{"issue_id":"c-1","depends_on_id":"c-2","type":"related"}]}`; string(data) != want {
+		t.Errorf("got %s", data)
+	}
+}
@@ -0,0 +1,279 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ReplaceFields lists the issue fields search-and-replace may touch, in the
+// order changes are reported
+var ReplaceFields = []string{"title", "description", "design", "acceptance_criteria", "notes", "labels"}
+
+// DefaultReplaceFields are the fields searched when none are given. Labels are
+// left out so a prose rename doesn't silently reshape label filters.
+var DefaultReplaceFields = []string{"title", "description", "design", "acceptance_criteria", "notes"}
+
+// ReplaceSpec describes a backlog-wide search-and-replace
+type ReplaceSpec struct {
+	Pattern     *regexp.Regexp
+	Replacement string   // Supports $1-style group references
+	Fields      []string // Subset of ReplaceFields; empty means DefaultReplaceFields
+}
+
+// Validate rejects specs that would touch unknown fields or rewrite every
+// position in a field (patterns matching the empty string)
+func (s ReplaceSpec) Validate() error {
+	if s.Pattern == nil {
+		return fmt.Errorf("replace pattern is empty")
+	}
+	if s.Pattern.MatchString("") {
+		return fmt.Errorf("pattern %q matches the empty string; it would rewrite every field", s.Pattern.String())
+	}
+	for _, f := range s.Fields {
+		if !containsString(ReplaceFields, f) {
+			return fmt.Errorf("unknown field %q (choose from %s)", f, strings.Join(ReplaceFields, ", "))
+		}
+	}
+	return nil
+}
+
+func (s ReplaceSpec) fields() []string {
+	if len(s.Fields) == 0 {
+		return DefaultReplaceFields
+	}
+	return s.Fields
+}
+
+// ReplaceChange is one field rewritten on one issue
+type ReplaceChange struct {
+	IssueID string
+	Line    int // 1-based line in the JSONL file
+	Field   string
+	Before  string // Labels are joined with ", "
+	After   string
+	Matches int
+}
+
+// ReplacePlan lists every change a ReplaceSpec makes to a beads file
+type ReplacePlan struct {
+	Changes []ReplaceChange
+	Issues  int // Distinct issues changed
+	Matches int
+}
+
+// PlanReplace previews a search-and-replace over the issues in a beads JSONL
+// file without writing anything
+func PlanReplace(path string, spec ReplaceSpec) (ReplacePlan, error) {
+	plan, _, err := planReplace(path, spec)
+	return plan, err
+}
+
+// ApplyReplace performs a search-and-replace on a beads JSONL file and
+// returns what changed. Only the matched fields are re-encoded; every other
+// line and field is kept byte for byte. The write is atomic (temp file +
+// rename), and nothing is written when there are no matches.
+func ApplyReplace(path string, spec ReplaceSpec) (ReplacePlan, error) {
+	plan, lines, err := planReplace(path, spec)
+	if err != nil || len(plan.Changes) == 0 {
+		return plan, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return plan, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+	if err := writeFileAtomic(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm()); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// planReplace computes the plan and the rewritten file lines
+func planReplace(path string, spec ReplaceSpec) (ReplacePlan, [][]byte, error) {
+	var plan ReplacePlan
+	if err := spec.Validate(); err != nil {
+		return plan, nil, err
+	}
+	data, err := readEditable(path)
+	if err != nil {
+		return plan, nil, err
+	}
+
+	fields := spec.fields()
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		body := line
+		if i == 0 {
+			body = stripBOM(line)
+		}
+		if len(bytes.TrimSpace(body)) == 0 {
+			continue
+		}
+		var rec struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(body, &rec); err != nil {
+			continue // Malformed lines are skipped by the loader too
+		}
+
+		updated, changes, err := replaceInRecord(body, spec, fields)
+		if err != nil {
+			return plan, nil, fmt.Errorf("line %d (%s): %w", i+1, rec.ID, err)
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		sort.SliceStable(changes, func(a, b int) bool {
+			return indexOfString(ReplaceFields, changes[a].Field) < indexOfString(ReplaceFields, changes[b].Field)
+		})
+		for _, c := range changes {
+			c.IssueID = rec.ID
+			c.Line = i + 1
+			plan.Changes = append(plan.Changes, c)
+			plan.Matches += c.Matches
+		}
+		plan.Issues++
+		bom := line[:len(line)-len(body)]
+		lines[i] = bytes.Join([][]byte{bom, updated}, nil)
+	}
+	return plan, lines, nil
+}
+
+// replaceInRecord rewrites the selected top-level fields of one JSON object
+func replaceInRecord(line []byte, spec ReplaceSpec, fields []string) ([]byte, []ReplaceChange, error) {
+	type splice struct {
+		start, end int
+		value      []byte
+	}
+	var splices []splice
+	var changes []ReplaceChange
+
+	dec := json.NewDecoder(bytes.NewReader(line))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, nil, fmt.Errorf("not a JSON object")
+	}
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		key, _ := keyTok.(string)
+		if !containsString(fields, key) {
+			continue
+		}
+		end := int(dec.InputOffset())
+		start := end - len(raw)
+
+		var encoded []byte
+		change := ReplaceChange{Field: key}
+		if key == "labels" {
+			var labels []string
+			if err := json.Unmarshal(raw, &labels); err != nil || len(labels) == 0 {
+				continue
+			}
+			after := make([]string, len(labels))
+			for i, l := range labels {
+				change.Matches += len(spec.Pattern.FindAllStringIndex(l, -1))
+				after[i] = spec.Pattern.ReplaceAllString(l, spec.Replacement)
+			}
+			change.Before, change.After = strings.Join(labels, ", "), strings.Join(after, ", ")
+			encoded, err = json.Marshal(after)
+		} else {
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				continue
+			}
+			change.Matches = len(spec.Pattern.FindAllStringIndex(text, -1))
+			change.Before, change.After = text, spec.Pattern.ReplaceAllString(text, spec.Replacement)
+			encoded, err = json.Marshal(change.After)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if change.Matches == 0 || change.Before == change.After {
+			continue
+		}
+		splices = append(splices, splice{start, end, encoded})
+		changes = append(changes, change)
+	}
+
+	var out []byte
+	prev := 0
+	for _, s := range splices {
+		out = append(out, line[prev:s.start]...)
+		out = append(out, s.value...)
+		prev = s.end
+	}
+	out = append(out, line[prev:]...)
+	return out, changes, nil
+}
+
+// Diff renders a line-level diff of the change: unchanged lines are omitted,
+// removed lines start with "-" and added lines with "+"
+func (c ReplaceChange) Diff() string {
+	before := strings.Split(c.Before, "\n")
+	after := strings.Split(c.After, "\n")
+	var sb strings.Builder
+	if len(before) != len(after) {
+		// The replacement added or removed line breaks: show the whole field
+		for _, l := range before {
+			sb.WriteString("- " + l + "\n")
+		}
+		for _, l := range after {
+			sb.WriteString("+ " + l + "\n")
+		}
+		return sb.String()
+	}
+	for i := range before {
+		if before[i] != after[i] {
+			sb.WriteString("- " + before[i] + "\n")
+			sb.WriteString("+ " + after[i] + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// FormatReplacePlan renders a plan as a per-issue diff preview
+func FormatReplacePlan(plan ReplacePlan) string {
+	var sb strings.Builder
+	lastIssue := ""
+	for _, c := range plan.Changes {
+		if c.IssueID != lastIssue {
+			if lastIssue != "" {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(fmt.Sprintf("%s (line %d)\n", c.IssueID, c.Line))
+			lastIssue = c.IssueID
+		}
+		sb.WriteString(fmt.Sprintf("  %s:\n", c.Field))
+		for _, l := range strings.Split(strings.TrimSuffix(c.Diff(), "\n"), "\n") {
+			sb.WriteString("    " + l + "\n")
+		}
+	}
+	if len(plan.Changes) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("%d matches in %d fields across %d issues\n", plan.Matches, len(plan.Changes), plan.Issues))
+	return sb.String()
+}
+
+func containsString(list []string, s string) bool {
+	return indexOfString(list, s) >= 0
+}
+
+func indexOfString(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
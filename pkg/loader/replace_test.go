@@ -0,0 +1,91 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestApplyReplace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := "\xef\xbb\xbf" + `{"id":"bv-1","title":"Ship Falcon login","description":"Falcon needs SSO.\nKeep falcon lowercase.","status":"open","issue_type":"task","labels":["falcon","auth"],"x_custom":{"keep":"Falcon"}}
+{"id":"bv-2", "title":"Unrelated", "status":"open", "issue_type":"task"}
+not json
+{"id":"bv-3","title":"Falcon docs","notes":"Falcon and Falcon","status":"closed","issue_type":"task"}
+`
+	if err := os.WriteFile(path, []byte(content), 0640); err != nil {
+		t.Fatal(err)
+	}
+	spec := ReplaceSpec{Pattern: regexp.MustCompile(`Falcon`), Replacement: "Osprey"}
+
+	plan, err := PlanReplace(path, spec)
+	if err != nil {
+		t.Fatalf("PlanReplace: %v", err)
+	}
+	if plan.Issues != 2 || plan.Matches != 5 || len(plan.Changes) != 4 {
+		t.Fatalf("Unexpected plan: %+v", plan)
+	}
+	if c := plan.Changes[1]; c.IssueID != "bv-1" || c.Line != 1 || c.Field != "description" || c.Diff() != "- Falcon needs SSO.\n+ Osprey needs SSO.\n" {
+		t.Errorf("Unexpected description change %+v, diff %q", c, c.Diff())
+	}
+	if data, _ := os.ReadFile(path); string(data) != content {
+		t.Fatal("PlanReplace must not write the file")
+	}
+	preview := FormatReplacePlan(plan)
+	if !strings.Contains(preview, "bv-3 (line 4)\n  title:\n    - Falcon docs\n    + Osprey docs") || !strings.HasSuffix(preview, "5 matches in 4 fields across 2 issues\n") {
+		t.Errorf("Unexpected preview:\n%s", preview)
+	}
+
+	if _, err := ApplyReplace(path, spec); err != nil {
+		t.Fatalf("ApplyReplace: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(string(data), "\n")
+	if want := "\xef\xbb\xbf" + `{"id":"bv-1","title":"Ship Osprey login","description":"Osprey needs SSO.\nKeep falcon lowercase.","status":"open","issue_type":"task","labels":["falcon","auth"],"x_custom":{"keep":"Falcon"}}`; lines[0] != want {
+		t.Errorf("Line 1 = %s", lines[0])
+	}
+	if lines[1] != `{"id":"bv-2", "title":"Unrelated", "status":"open", "issue_type":"task"}` || lines[2] != "not json" {
+		t.Error("Untouched lines should be kept byte for byte")
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0640 {
+		t.Errorf("File mode changed to %v", info.Mode().Perm())
+	}
+
+	// Labels only when asked for, with group references
+	spec = ReplaceSpec{Pattern: regexp.MustCompile(`(?i)^falcon$`), Replacement: "osprey", Fields: []string{"labels"}}
+	plan, err = ApplyReplace(path, spec)
+	if err != nil || len(plan.Changes) != 1 || plan.Changes[0].After != "osprey, auth" {
+		t.Fatalf("Label replace: %+v (%v)", plan, err)
+	}
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil || len(issues) != 3 || issues[0].Labels[0] != "osprey" || issues[2].Notes != "Osprey and Osprey" {
+		t.Errorf("Reloaded issues: %+v (%v)", issues, err)
+	}
+}
+
+func TestReplaceSpecValidate(t *testing.T) {
+	tests := []struct {
+		spec ReplaceSpec
+		want string
+	}{
+		{ReplaceSpec{}, "empty"},
+		{ReplaceSpec{Pattern: regexp.MustCompile(`x*`)}, "matches the empty string"},
+		{ReplaceSpec{Pattern: regexp.MustCompile(`x`), Fields: []string{"status"}}, `unknown field "status"`},
+	}
+	for _, tt := range tests {
+		if err := tt.spec.Validate(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Validate(%+v) = %v, want %q", tt.spec, err, tt.want)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	os.WriteFile(path, []byte(`{"id":"a","title":"nothing here"}`+"\n"), 0644)
+	before, _ := os.Stat(path)
+	plan, err := ApplyReplace(path, ReplaceSpec{Pattern: regexp.MustCompile(`zzz`)})
+	after, _ := os.Stat(path)
+	if err != nil || len(plan.Changes) != 0 || !after.ModTime().Equal(before.ModTime()) {
+		t.Errorf("No matches should leave the file alone: %+v (%v)", plan, err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // SprintsFileName is the canonical filename for sprint storage.
@@ -107,12 +108,12 @@ func SaveSprints(repoPath string, sprints []model.Sprint) error {
 // SaveSprintsToFile writes sprints to a specific file path.
 // The write is atomic (temp file + rename) to be safe with editors and watchers.
 func SaveSprintsToFile(path string, sprints []model.Sprint) error {
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+	if err := readonly.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
 	dir := filepath.Dir(path)
-	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	tmp, err := readonly.CreateTemp(dir, filepath.Base(path)+".tmp-*")
 	if err != nil {
 		return fmt.Errorf("failed to create temp file: %w", err)
 	}
@@ -125,7 +126,7 @@ func SaveSprintsToFile(path string, sprints []model.Sprint) error {
 			_ = tmp.Close()
 			closed = true
 		}
-		_ = os.Remove(tmpName)
+		_ = readonly.Remove(tmpName)
 	}
 
 	enc := json.NewEncoder(tmp)
@@ -141,9 +142,9 @@ func SaveSprintsToFile(path string, sprints []model.Sprint) error {
 	}
 	closed = true
 
-	if err := os.Rename(tmpName, path); err != nil {
+	if err := readonly.Rename(tmpName, path); err != nil {
 		// File is already closed, just remove it
-		_ = os.Remove(tmpName)
+		_ = readonly.Remove(tmpName)
 		return fmt.Errorf("failed to rename temp file: %w", err)
 	}
 
@@ -0,0 +1,420 @@
+package loader
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteStoreFilename is the issue store under .bv/
+const SQLiteStoreFilename = "issues.db"
+
+// sqliteStoreVersion is bumped whenever the schema changes; an older store
+// is dropped and rebuilt from the JSONL on the next sync
+const sqliteStoreVersion = "1"
+
+// DefaultStorePageSize is the iterator page size used by LoadIssues
+const DefaultStorePageSize = 1000
+
+// SQLiteStore mirrors a beads JSONL file into SQLite for large repos. Each
+// issue is stored with a hash of its JSONL line, so a sync after the file
+// changes only decodes the lines that differ instead of the whole file.
+type SQLiteStore struct {
+	db   *sql.DB
+	path string
+}
+
+// SyncResult describes what a Sync changed
+type SyncResult struct {
+	// Upserted holds issues added or changed since the last sync, in file order
+	Upserted []model.Issue
+	// Removed holds the IDs of issues no longer in the file
+	Removed []string
+	// Unchanged counts issues whose line was identical
+	Unchanged int
+	// Skipped is set when the file's size and mtime matched the last sync
+	Skipped bool
+}
+
+// Changed reports whether the sync added, changed or removed any issue
+func (r SyncResult) Changed() bool {
+	return len(r.Upserted) > 0 || len(r.Removed) > 0
+}
+
+// Apply returns issues with the sync's changes merged in: changed issues are
+// replaced in place, removed ones dropped and new ones appended. issues is
+// not modified.
+func (r SyncResult) Apply(issues []model.Issue) []model.Issue {
+	removed := make(map[string]bool, len(r.Removed))
+	for _, id := range r.Removed {
+		removed[id] = true
+	}
+	upserted := make(map[string]int, len(r.Upserted))
+	for i, issue := range r.Upserted {
+		upserted[issue.ID] = i
+	}
+
+	merged := make([]model.Issue, 0, len(issues)+len(r.Upserted))
+	used := make([]bool, len(r.Upserted))
+	for _, issue := range issues {
+		if removed[issue.ID] {
+			continue
+		}
+		if i, ok := upserted[issue.ID]; ok {
+			issue = r.Upserted[i]
+			used[i] = true
+		}
+		merged = append(merged, issue)
+	}
+	for i, issue := range r.Upserted {
+		if !used[i] {
+			merged = append(merged, issue)
+		}
+	}
+	return merged
+}
+
+// SQLiteStorePath returns the path to .bv/issues.db
+func SQLiteStorePath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", SQLiteStoreFilename)
+}
+
+// OpenSQLiteStore opens (or creates) the store at path
+func OpenSQLiteStore(path string) (*SQLiteStore, error) {
+	// Opening writes the database and its WAL files, so it's refused outright
+	if err := readonly.Check("opening issue store"); err != nil {
+		return nil, err
+	}
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("create store dir: %w", err)
+	}
+	db, err := sql.Open("sqlite3", path+"?_journal_mode=WAL&_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("open issue store: %w", err)
+	}
+	// A single connection keeps the iterator and syncs from racing on WAL state
+	db.SetMaxOpenConns(1)
+
+	s := &SQLiteStore{db: db, path: path}
+	if err := s.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Path returns the database file path
+func (s *SQLiteStore) Path() string {
+	return s.path
+}
+
+// Close closes the database
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) migrate() error {
+	var version string
+	_ = s.db.QueryRow(`SELECT value FROM meta WHERE key = 'version'`).Scan(&version)
+	if version == sqliteStoreVersion {
+		return nil
+	}
+
+	stmts := []string{
+		`DROP TABLE IF EXISTS issues`,
+		`DROP TABLE IF EXISTS meta`,
+		`CREATE TABLE issues (
+			id TEXT PRIMARY KEY,
+			position INTEGER NOT NULL,
+			hash TEXT NOT NULL,
+			data BLOB NOT NULL
+		)`,
+		`CREATE INDEX idx_issues_position ON issues(position)`,
+		`CREATE TABLE meta (key TEXT PRIMARY KEY, value TEXT NOT NULL)`,
+		`INSERT INTO meta (key, value) VALUES ('version', '` + sqliteStoreVersion + `')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := s.db.Exec(stmt); err != nil {
+			return fmt.Errorf("create issue store schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// storedLine is what Sync needs to know about an issue already in the store
+type storedLine struct {
+	id       string
+	position int
+}
+
+// Sync brings the store in line with the JSONL file at path. Lines whose hash
+// matches a stored issue are not decoded; new and changed lines are decoded,
+// validated (invalid lines are skipped with a warning, as in
+// ParseIssuesWithOptions) and upserted, and issues missing from the file are
+// deleted. If the file's size and mtime match the last sync, nothing is read.
+func (s *SQLiteStore) Sync(path string, opts ParseOptions) (SyncResult, error) {
+	var result SyncResult
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, fmt.Errorf("no beads issues found at %s", path)
+		}
+		return result, err
+	}
+	fingerprint := map[string]string{
+		"source": path,
+		"size":   strconv.FormatInt(info.Size(), 10),
+		"mtime":  strconv.FormatInt(info.ModTime().UnixNano(), 10),
+	}
+	if s.metaMatches(fingerprint) {
+		result.Skipped = true
+		return result, nil
+	}
+
+	byHash, err := s.storedHashes()
+	if err != nil {
+		return result, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return result, fmt.Errorf("failed to open issues file: %w", err)
+	}
+	defer file.Close()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return result, fmt.Errorf("begin sync: %w", err)
+	}
+	defer tx.Rollback()
+
+	upsert, err := tx.Prepare(`INSERT INTO issues (id, position, hash, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET position = excluded.position, hash = excluded.hash, data = excluded.data`)
+	if err != nil {
+		return result, fmt.Errorf("prepare upsert: %w", err)
+	}
+	defer upsert.Close()
+	move, err := tx.Prepare(`UPDATE issues SET position = ? WHERE id = ?`)
+	if err != nil {
+		return result, fmt.Errorf("prepare update: %w", err)
+	}
+	defer move.Close()
+
+	warn := opts.warnFunc()
+	seen := make(map[string]bool)
+	position := 0
+	var writeErr error
+	err = scanJSONLLines(file, opts, func(lineNum int, line []byte) {
+		if writeErr != nil {
+			return
+		}
+		sum := sha256.Sum256(line)
+		hash := hex.EncodeToString(sum[:])
+
+		if stored, ok := byHash[hash]; ok && !seen[stored.id] {
+			delete(byHash, hash)
+			seen[stored.id] = true
+			result.Unchanged++
+			position++
+			if stored.position != position {
+				_, writeErr = move.Exec(position, stored.id)
+			}
+			return
+		}
+
+		issue, ok := parseIssueLine(lineNum, line, warn)
+		if !ok || seen[issue.ID] {
+			return
+		}
+		seen[issue.ID] = true
+		position++
+		result.Upserted = append(result.Upserted, issue)
+		_, writeErr = upsert.Exec(issue.ID, position, hash, line)
+	})
+	if err == nil {
+		err = writeErr
+	}
+	if err != nil {
+		return SyncResult{}, err
+	}
+
+	// Whatever wasn't seen in the file is gone
+	rows, err := tx.Query(`SELECT id FROM issues`)
+	if err != nil {
+		return SyncResult{}, fmt.Errorf("list stored issues: %w", err)
+	}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return SyncResult{}, err
+		}
+		if !seen[id] {
+			result.Removed = append(result.Removed, id)
+		}
+	}
+	rows.Close()
+	for _, id := range result.Removed {
+		if _, err := tx.Exec(`DELETE FROM issues WHERE id = ?`, id); err != nil {
+			return SyncResult{}, fmt.Errorf("delete %s: %w", id, err)
+		}
+	}
+
+	for key, value := range fingerprint {
+		if _, err := tx.Exec(`INSERT OR REPLACE INTO meta (key, value) VALUES (?, ?)`, key, value); err != nil {
+			return SyncResult{}, fmt.Errorf("write store meta: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return SyncResult{}, fmt.Errorf("commit sync: %w", err)
+	}
+	return result, nil
+}
+
+// metaMatches reports whether every key in want has the stored value
+func (s *SQLiteStore) metaMatches(want map[string]string) bool {
+	for key, value := range want {
+		var stored string
+		if err := s.db.QueryRow(`SELECT value FROM meta WHERE key = ?`, key).Scan(&stored); err != nil || stored != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SQLiteStore) storedHashes() (map[string]storedLine, error) {
+	rows, err := s.db.Query(`SELECT id, position, hash FROM issues`)
+	if err != nil {
+		return nil, fmt.Errorf("read issue hashes: %w", err)
+	}
+	defer rows.Close()
+
+	byHash := make(map[string]storedLine)
+	for rows.Next() {
+		var line storedLine
+		var hash string
+		if err := rows.Scan(&line.id, &line.position, &hash); err != nil {
+			return nil, err
+		}
+		byHash[hash] = line
+	}
+	return byHash, rows.Err()
+}
+
+// Count returns the number of stored issues
+func (s *SQLiteStore) Count() (int, error) {
+	var n int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM issues`).Scan(&n)
+	return n, err
+}
+
+// Issues returns an iterator over the stored issues in file order, fetching
+// pageSize issues per query (DefaultStorePageSize if <= 0)
+func (s *SQLiteStore) Issues(pageSize int) *IssueIterator {
+	if pageSize <= 0 {
+		pageSize = DefaultStorePageSize
+	}
+	return &IssueIterator{store: s, pageSize: pageSize}
+}
+
+// LoadIssues reads every stored issue in file order
+func (s *SQLiteStore) LoadIssues() ([]model.Issue, error) {
+	var issues []model.Issue
+	it := s.Issues(DefaultStorePageSize)
+	for it.Next() {
+		issues = append(issues, it.Issue())
+	}
+	return issues, it.Err()
+}
+
+// IssueIterator pages through a store's issues, so consumers can stream a
+// large store without holding every issue in memory:
+//
+//	it := store.Issues(500)
+//	for it.Next() {
+//		issue := it.Issue()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type IssueIterator struct {
+	store    *SQLiteStore
+	pageSize int
+	page     []model.Issue
+	index    int
+	after    int // position of the last issue fetched
+	done     bool
+	err      error
+}
+
+// Next advances to the next issue, fetching a page when needed.
+// Returns false at the end or on error.
+func (it *IssueIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	if err := it.fetch(); err != nil {
+		it.err = err
+		return false
+	}
+	it.index = 0
+	return len(it.page) > 0
+}
+
+// Issue returns the current issue
+func (it *IssueIterator) Issue() model.Issue {
+	return it.page[it.index]
+}
+
+// Err returns the first error encountered while iterating
+func (it *IssueIterator) Err() error {
+	return it.err
+}
+
+// fetch reads the page after the last position seen (keyset paging, so each
+// query is an index range scan no matter how deep the iterator is)
+func (it *IssueIterator) fetch() error {
+	rows, err := it.store.db.Query(`SELECT id, position, data FROM issues WHERE position > ? ORDER BY position LIMIT ?`,
+		it.after, it.pageSize)
+	if err != nil {
+		return fmt.Errorf("read issues: %w", err)
+	}
+	defer rows.Close()
+
+	it.page = it.page[:0]
+	for rows.Next() {
+		var id string
+		var data []byte
+		if err := rows.Scan(&id, &it.after, &data); err != nil {
+			return err
+		}
+		var issue model.Issue
+		if err := json.Unmarshal(data, &issue); err != nil {
+			return fmt.Errorf("decode stored issue %s: %w", id, err)
+		}
+		it.page = append(it.page, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(it.page) < it.pageSize {
+		it.done = true
+	}
+	return nil
+}
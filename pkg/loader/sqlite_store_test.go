@@ -0,0 +1,175 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+func storeLine(id, title string) string {
+	return fmt.Sprintf(`{"id":%q,"title":%q,"status":"open","issue_type":"task","priority":2}`, id, title)
+}
+
+// writeStoreJSONL writes lines and bumps the mtime, so back-to-back writes
+// within the filesystem's timestamp granularity still look modified
+func writeStoreJSONL(t *testing.T, path string, lines ...string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Now().Add(time.Duration(len(lines)) * time.Second)
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func storeIDs(issues []model.Issue) string {
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.ID+"="+issue.Title)
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestSQLiteStore_Sync(t *testing.T) {
+	dir := t.TempDir()
+	jsonl := filepath.Join(dir, "issues.jsonl")
+	dbPath := SQLiteStorePath(dir)
+	writeStoreJSONL(t, jsonl, storeLine("a", "A"), storeLine("b", "B"), storeLine("c", "C"))
+
+	store, err := OpenSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := store.Sync(jsonl, ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Upserted) != 3 || res.Unchanged != 0 || res.Skipped {
+		t.Fatalf("First sync should insert everything, got %+v", res)
+	}
+	issues, err := store.LoadIssues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := storeIDs(issues); got != "a=A b=B c=C" {
+		t.Errorf("Stored issues = %s", got)
+	}
+
+	if res, err := store.Sync(jsonl, ParseOptions{}); err != nil || !res.Skipped || res.Changed() {
+		t.Errorf("Unmodified file should be skipped, got %+v (%v)", res, err)
+	}
+
+	// Drop a, edit b, add d ahead of c, and add a malformed line
+	var warnings []string
+	opts := ParseOptions{WarningHandler: func(msg string) { warnings = append(warnings, msg) }}
+	writeStoreJSONL(t, jsonl, storeLine("b", "B2"), storeLine("d", "D"), "{oops", storeLine("c", "C"))
+	res, err = store.Sync(jsonl, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storeIDs(res.Upserted) != "b=B2 d=D" || strings.Join(res.Removed, ",") != "a" || res.Unchanged != 1 {
+		t.Errorf("Expected b and d upserted, a removed, c unchanged; got %+v", res)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "line 3") {
+		t.Errorf("Expected a warning for line 3, got %v", warnings)
+	}
+
+	// The merged in-memory list and the store agree with a full parse
+	merged := res.Apply(issues)
+	if got := storeIDs(merged); got != "b=B2 c=C d=D" {
+		t.Errorf("Apply = %s", got)
+	}
+	if got := storeIDs(issues); got != "a=A b=B c=C" {
+		t.Errorf("Apply should not modify its input, got %s", got)
+	}
+	stored, err := store.LoadIssues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	full, err := LoadIssuesFromFileWithOptions(jsonl, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if storeIDs(stored) != storeIDs(full) {
+		t.Errorf("Store order %s, file order %s", storeIDs(stored), storeIDs(full))
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopening keeps the data and the fingerprint
+	store, err = OpenSQLiteStore(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if n, err := store.Count(); err != nil || n != 3 {
+		t.Errorf("Expected 3 stored issues after reopening, got %d (%v)", n, err)
+	}
+	if res, err := store.Sync(jsonl, opts); err != nil || !res.Skipped {
+		t.Errorf("Reopened store should still be in sync, got %+v (%v)", res, err)
+	}
+
+	if _, err := store.Sync(filepath.Join(dir, "missing.jsonl"), opts); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func TestSQLiteStore_IteratorPages(t *testing.T) {
+	dir := t.TempDir()
+	jsonl := filepath.Join(dir, "issues.jsonl")
+	var lines []string
+	var want []string
+	for i := 0; i < 7; i++ {
+		id := fmt.Sprintf("p-%d", i)
+		lines = append(lines, storeLine(id, id))
+		want = append(want, id+"="+id)
+	}
+	writeStoreJSONL(t, jsonl, lines...)
+
+	store, err := OpenSQLiteStore(filepath.Join(dir, "store.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if _, err := store.Sync(jsonl, ParseOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pageSize := range []int{1, 3, 7, 100} {
+		var got []model.Issue
+		it := store.Issues(pageSize)
+		for it.Next() {
+			got = append(got, it.Issue())
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if storeIDs(got) != strings.Join(want, " ") {
+			t.Errorf("page size %d: got %s", pageSize, storeIDs(got))
+		}
+		if it.Next() {
+			t.Errorf("page size %d: Next after the end should stay false", pageSize)
+		}
+	}
+}
+
+func TestSQLiteStore_ReadOnly(t *testing.T) {
+	readonly.Set(true)
+	defer readonly.Set(false)
+	path := SQLiteStorePath(t.TempDir())
+	if _, err := OpenSQLiteStore(path); !errors.Is(err, readonly.ErrReadOnly) {
+		t.Errorf("opening the store in read-only mode: %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+		t.Error("read-only mode should not create the store")
+	}
+}
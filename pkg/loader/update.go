@@ -0,0 +1,58 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// UpdateIssue applies change to an issue's record in a beads JSONL file and
+// returns the changed issue. Only the fields change altered are rewritten
+// (status, priority, assignee, labels, dates and the text fields; see
+// setIssueFields), every other line and field is kept byte for byte, and the
+// write is atomic.
+func UpdateIssue(path, issueID string, change func(*model.Issue)) (model.Issue, error) {
+	data, err := readEditable(path)
+	if err != nil {
+		return model.Issue{}, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return model.Issue{}, fmt.Errorf("failed to stat issues file: %w", err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	target := findIssueLine(lines, issueID)
+	if target < 0 {
+		return model.Issue{}, fmt.Errorf("issue %s not found in %s", issueID, filepath.Base(path))
+	}
+	line := lines[target]
+	body := stripBOM(line)
+	var before model.Issue
+	if err := json.Unmarshal(body, &before); err != nil {
+		return model.Issue{}, fmt.Errorf("issue %s: %w", issueID, err)
+	}
+
+	// change gets its own label slice, so before keeps the old labels
+	after := before
+	after.Labels = append([]string(nil), before.Labels...)
+	change(&after)
+
+	updated, changed, err := setIssueFields(body, before, after)
+	if err != nil {
+		return after, fmt.Errorf("failed to update issue %s: %w", issueID, err)
+	}
+	if len(changed) == 0 {
+		return after, nil
+	}
+	bom := line[:len(line)-len(body)]
+	lines[target] = bytes.Join([][]byte{bom, updated}, nil)
+	if err := writeFileAtomic(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm()); err != nil {
+		return after, err
+	}
+	return after, nil
+}
@@ -0,0 +1,50 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestUpdateIssue(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "issues.jsonl")
+	other := `{"id":"u-2","title":"Other","status":"open","x_custom":1}`
+	content := `{"id":"u-1","title":"Move me","status":"open","labels":["review","ui"],"x_custom":true}` + "\n" + other + "\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	updated, err := UpdateIssue(path, "u-1", func(issue *model.Issue) {
+		issue.Status = model.StatusClosed
+		issue.ClosedAt = &now
+		issue.Labels = issue.Labels[1:]
+		issue.Assignee = "ann"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status != model.StatusClosed || strings.Join(updated.Labels, ",") != "ui" {
+		t.Errorf("updated = %+v", updated)
+	}
+
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(string(data), "\n")
+	for _, want := range []string{`"status":"closed"`, `"labels":["ui"]`, `"closed_at":"2026-05-01T09:00:00Z"`, `"assignee":"ann"`, `"x_custom":true`} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("record missing %s: %s", want, lines[0])
+		}
+	}
+	if lines[1] != other {
+		t.Errorf("other record changed: %s", lines[1])
+	}
+
+	if _, err := UpdateIssue(path, "u-9", func(*model.Issue) {}); err == nil {
+		t.Error("expected an error for a missing issue")
+	}
+}
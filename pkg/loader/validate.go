@@ -0,0 +1,283 @@
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ValidationSeverity says whether a problem loses data or only looks suspicious
+type ValidationSeverity string
+
+const (
+	// ValidationError marks records the loader drops or misreads
+	ValidationError ValidationSeverity = "error"
+	// ValidationWarning marks records that load but are probably wrong
+	ValidationWarning ValidationSeverity = "warning"
+)
+
+// ValidationCode identifies the kind of schema problem
+type ValidationCode string
+
+const (
+	CodeMalformedJSON      ValidationCode = "malformed_json"
+	CodeLineTooLong        ValidationCode = "line_too_long"
+	CodeBadFieldType       ValidationCode = "bad_field_type"
+	CodeMissingField       ValidationCode = "missing_field"
+	CodeBadEnum            ValidationCode = "bad_enum"
+	CodeBadTimestamps      ValidationCode = "bad_timestamps"
+	CodeDuplicateID        ValidationCode = "duplicate_id"
+	CodeUnknownField       ValidationCode = "unknown_field"
+	CodeDanglingDependency ValidationCode = "dangling_dependency"
+)
+
+// ValidationProblem is one schema problem found in a beads JSONL file
+type ValidationProblem struct {
+	Line     int                `json:"line"`
+	IssueID  string             `json:"issue_id,omitempty"`
+	Severity ValidationSeverity `json:"severity"`
+	Code     ValidationCode     `json:"code"`
+	Field    string             `json:"field,omitempty"`
+	Message  string             `json:"message"`
+}
+
+// ValidationReport is the result of checking every record in a beads file
+type ValidationReport struct {
+	Path     string              `json:"path,omitempty"`
+	Records  int                 `json:"records"` // Non-empty lines examined
+	Errors   int                 `json:"errors"`
+	Warnings int                 `json:"warnings"`
+	Problems []ValidationProblem `json:"problems"`
+}
+
+// HasErrors reports whether any record would be dropped or misread on load
+func (r ValidationReport) HasErrors() bool {
+	return r.Errors > 0
+}
+
+// bdExportFields are top-level keys bd writes that model.Issue does not
+// carry, so they are expected in a healthy export
+var bdExportFields = []string{
+	"content_hash", "close_reason", "created_by",
+	"deleted_at", "deleted_by", "delete_reason", "original_type",
+}
+
+// knownIssueFields are the top-level JSON keys of model.Issue, plus the
+// estimate aliases the loader reads and the bd export fields it ignores
+var knownIssueFields = func() map[string]bool {
+	fields := make(map[string]bool)
+	for _, name := range estimateAliasFields {
+		fields[name] = true
+	}
+	for _, name := range bdExportFields {
+		fields[name] = true
+	}
+	t := reflect.TypeOf(model.Issue{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}()
+
+// ValidateIssueFile checks every record in a beads JSONL file (optionally
+// .gz or .zst compressed) against the issue schema
+func ValidateIssueFile(path string) (ValidationReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ValidationReport{Path: path}, fmt.Errorf("failed to open issues file: %w", err)
+	}
+	defer file.Close()
+
+	r, closeFn, err := decompressReader(path, file)
+	if err != nil {
+		return ValidationReport{Path: path}, err
+	}
+	defer closeFn()
+
+	report, err := ValidateIssues(r)
+	report.Path = path
+	return report, err
+}
+
+// ValidateIssues checks JSONL records from r against the issue schema. It
+// reports malformed lines, wrong field types, missing required fields, bad
+// enum values, unknown fields, duplicate IDs and dependencies on IDs that are
+// not in the stream. Problems are sorted by line.
+func ValidateIssues(r io.Reader) (ValidationReport, error) {
+	report := ValidationReport{Problems: []ValidationProblem{}}
+	add := func(p ValidationProblem) {
+		if p.Severity == ValidationError {
+			report.Errors++
+		} else {
+			report.Warnings++
+		}
+		report.Problems = append(report.Problems, p)
+	}
+
+	type depRef struct {
+		line    int
+		issueID string
+		target  string
+	}
+	firstLine := make(map[string]int)
+	var deps []depRef
+
+	opts := ParseOptions{WarningHandler: func(msg string) {
+		// scanJSONLLines only warns about overlong lines
+		var line int
+		fmt.Sscanf(msg, "skipping line %d", &line)
+		report.Records++
+		add(ValidationProblem{Line: line, Severity: ValidationError, Code: CodeLineTooLong, Message: msg})
+	}}
+	err := scanJSONLLines(r, opts, func(lineNum int, line []byte) {
+		report.Records++
+		issue, problems := validateRecord(lineNum, line)
+		for _, p := range problems {
+			add(p)
+		}
+		if issue.ID == "" {
+			return
+		}
+		if first, ok := firstLine[issue.ID]; ok {
+			add(ValidationProblem{
+				Line: lineNum, IssueID: issue.ID, Severity: ValidationError, Code: CodeDuplicateID, Field: "id",
+				Message: fmt.Sprintf("ID %s is already used on line %d", issue.ID, first),
+			})
+		} else {
+			firstLine[issue.ID] = lineNum
+		}
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.DependsOnID != "" {
+				deps = append(deps, depRef{lineNum, issue.ID, dep.DependsOnID})
+			}
+		}
+	})
+	if err != nil {
+		return report, err
+	}
+
+	for _, d := range deps {
+		if _, ok := firstLine[d.target]; !ok {
+			add(ValidationProblem{
+				Line: d.line, IssueID: d.issueID, Severity: ValidationWarning, Code: CodeDanglingDependency, Field: "dependencies",
+				Message: fmt.Sprintf("depends on %s, which is not in the file", d.target),
+			})
+		}
+	}
+
+	sort.SliceStable(report.Problems, func(i, j int) bool {
+		return report.Problems[i].Line < report.Problems[j].Line
+	})
+	return report, nil
+}
+
+// validateRecord checks a single JSONL line against the issue schema and
+// returns the (possibly partial) issue it decodes to
+func validateRecord(lineNum int, line []byte) (model.Issue, []ValidationProblem) {
+	var issue model.Issue
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(line, &raw); err != nil {
+		msg := err.Error()
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			msg = fmt.Sprintf("record is a JSON %s, not an object", typeErr.Value)
+		}
+		return issue, []ValidationProblem{{Line: lineNum, Severity: ValidationError, Code: CodeMalformedJSON, Message: msg}}
+	}
+
+	var problems []ValidationProblem
+	problem := func(sev ValidationSeverity, code ValidationCode, field, format string, args ...interface{}) {
+		problems = append(problems, ValidationProblem{
+			Line: lineNum, IssueID: issue.ID, Severity: sev, Code: code, Field: field,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	// json.Unmarshal keeps going after a type mismatch, so the rest of the
+	// record is still checked below
+	if err := json.Unmarshal(line, &issue); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			problem(ValidationError, CodeBadFieldType, typeErr.Field, "%s should be %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+		} else {
+			problem(ValidationError, CodeBadFieldType, "", "%v", err)
+		}
+	}
+
+	if issue.ID == "" {
+		problem(ValidationError, CodeMissingField, "id", "missing id")
+	}
+	if issue.Title == "" {
+		problem(ValidationError, CodeMissingField, "title", "missing title")
+	}
+	if _, ok := raw["status"]; !ok {
+		problem(ValidationError, CodeMissingField, "status", "missing status")
+	} else if !issue.Status.IsValid() {
+		problem(ValidationError, CodeBadEnum, "status", "status %q is not one of open, in_progress, blocked, closed", issue.Status)
+	}
+	if _, ok := raw["issue_type"]; !ok {
+		problem(ValidationError, CodeMissingField, "issue_type", "missing issue_type")
+	} else if !issue.IssueType.IsValid() {
+		problem(ValidationError, CodeBadEnum, "issue_type", "issue_type %q is not one of bug, feature, task, epic, chore", issue.IssueType)
+	}
+	if issue.Priority < 0 || issue.Priority > 4 {
+		problem(ValidationWarning, CodeBadEnum, "priority", "priority %d is outside 0-4", issue.Priority)
+	}
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type != "" && !dep.Type.IsValid() {
+			problem(ValidationWarning, CodeBadEnum, "dependencies", "dependency on %s has unknown type %q", dep.DependsOnID, dep.Type)
+		}
+	}
+	if !issue.UpdatedAt.IsZero() && !issue.CreatedAt.IsZero() && issue.UpdatedAt.Before(issue.CreatedAt) {
+		problem(ValidationError, CodeBadTimestamps, "updated_at", "updated_at is before created_at")
+	}
+
+	var unknown []string
+	for key := range raw {
+		if !knownIssueFields[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		problem(ValidationWarning, CodeUnknownField, key, "unknown field %q", key)
+	}
+	return issue, problems
+}
+
+// FormatValidationReport renders a report compiler-style, one problem per
+// line ("file:line: severity: message [code]"), followed by a summary
+func FormatValidationReport(report ValidationReport) string {
+	var sb strings.Builder
+	name := report.Path
+	if name == "" {
+		name = "<stdin>"
+	}
+	for _, p := range report.Problems {
+		where := name
+		if p.Line > 0 {
+			where = fmt.Sprintf("%s:%d", name, p.Line)
+		}
+		msg := p.Message
+		if p.IssueID != "" {
+			msg = p.IssueID + ": " + msg
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s: %s [%s]\n", where, p.Severity, msg, p.Code))
+	}
+	if len(report.Problems) == 0 {
+		sb.WriteString(fmt.Sprintf("%s: %d records, no problems\n", name, report.Records))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s: %d records, %d errors, %d warnings\n", name, report.Records, report.Errors, report.Warnings))
+	}
+	return sb.String()
+}
@@ -0,0 +1,121 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateIssues(t *testing.T) {
+	content := `{"id":"v-1","title":"Good","status":"open","issue_type":"task","dependencies":[{"issue_id":"v-1","depends_on_id":"v-9","type":"blocks"}]}
+{"id":"v-2","title":"Odd","status":"done","issue_type":"story","priority":7,"size":3}
+not json
+
+{"id":"v-1","title":"Again","status":"open","issue_type":"bug"}
+{"id":"v-3","title":"Typed","status":"open","issue_type":"task","priority":"high"}
+{"title":"No ID","issue_type":"task"}
+[1,2]
+{"id":"v-4","title":"Time","status":"open","issue_type":"task","created_at":"2025-02-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}
+`
+	report, err := ValidateIssues(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ValidateIssues: %v", err)
+	}
+
+	var got []string
+	for _, p := range report.Problems {
+		got = append(got, fmt.Sprintf("%d %s %s %s", p.Line, p.Severity, p.Code, p.Field))
+	}
+	want := []string{
+		"1 warning dangling_dependency dependencies",
+		"2 error bad_enum status",
+		"2 error bad_enum issue_type",
+		"2 warning bad_enum priority",
+		"2 warning unknown_field size",
+		"3 error malformed_json ",
+		"5 error duplicate_id id",
+		"6 error bad_field_type priority",
+		"7 error missing_field id",
+		"7 error missing_field status",
+		"8 error malformed_json ",
+		"9 error bad_timestamps updated_at",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Problems:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if report.Records != 8 || report.Errors != 9 || report.Warnings != 3 || !report.HasErrors() {
+		t.Errorf("Records=%d Errors=%d Warnings=%d", report.Records, report.Errors, report.Warnings)
+	}
+	if msg := report.Problems[6].Message; msg != "ID v-1 is already used on line 1" {
+		t.Errorf("Duplicate message = %q", msg)
+	}
+	if msg := report.Problems[10].Message; msg != "record is a JSON array, not an object" {
+		t.Errorf("Array message = %q", msg)
+	}
+}
+
+func TestValidateIssueFile(t *testing.T) {
+	dir := t.TempDir()
+	clean := filepath.Join(dir, "issues.jsonl")
+	os.WriteFile(clean, []byte("\xef\xbb\xbf"+`{"id":"a","title":"A","status":"open","issue_type":"task"}`+"\n"), 0644)
+
+	report, err := ValidateIssueFile(clean)
+	if err != nil || report.HasErrors() || len(report.Problems) != 0 || report.Records != 1 {
+		t.Fatalf("Clean file: %+v (%v)", report, err)
+	}
+	if out := FormatValidationReport(report); out != clean+": 1 records, no problems\n" {
+		t.Errorf("Clean output = %q", out)
+	}
+
+	bad := filepath.Join(dir, "bad.jsonl")
+	os.WriteFile(bad, []byte(`{"id":"b","title":"B","status":"open","issue_type":"task","x":1}`+"\n{oops\n"), 0644)
+	report, _ = ValidateIssueFile(bad)
+	out := FormatValidationReport(report)
+	for _, want := range []string{
+		bad + `:1: warning: b: unknown field "x" [unknown_field]`,
+		bad + ":2: error: ",
+		bad + ": 2 records, 1 errors, 1 warnings",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Output missing %q:\n%s", want, out)
+		}
+	}
+
+	if _, err := ValidateIssueFile(filepath.Join(dir, "missing.jsonl")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func TestValidateIssueFile_RealExportsHaveNoUnknownFields(t *testing.T) {
+	paths, _ := filepath.Glob("../../tests/testdata/real/*.jsonl")
+	if len(paths) == 0 {
+		t.Skip("no real fixtures found")
+	}
+	for _, path := range paths {
+		report, err := ValidateIssueFile(path)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		for _, p := range report.Problems {
+			if p.Code == CodeUnknownField {
+				t.Errorf("%s:%d: unexpected unknown field %q", path, p.Line, p.Field)
+			}
+		}
+	}
+}
+
+func TestHasEstimateAlias(t *testing.T) {
+	for raw, want := range map[string]bool{
+		`{"id":"a","estimate":3}`:                  true,
+		`{"id":"a","estimate_hours":1.5}`:          true,
+		`{"id":"a","points":2}`:                    true,
+		`{"id":"a","estimated_minutes":30}`:        false,
+		`{"id":"a","title":"estimate the points"}`: false,
+	} {
+		if got := hasEstimateAlias([]byte(raw)); got != want {
+			t.Errorf("hasEstimateAlias(%s) = %v, want %v", raw, got, want)
+		}
+	}
+}
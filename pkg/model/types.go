@@ -19,6 +19,7 @@ type Issue struct {
 	IssueType          IssueType     `json:"issue_type"`
 	Assignee           string        `json:"assignee,omitempty"`
 	EstimatedMinutes   *int          `json:"estimated_minutes,omitempty"`
+	Estimate           *float64      `json:"story_points,omitempty"` // Story points
 	CreatedAt          time.Time     `json:"created_at"`
 	UpdatedAt          time.Time     `json:"updated_at"`
 	DueDate            *time.Time    `json:"due_date,omitempty"`
@@ -42,6 +43,10 @@ func (i Issue) Clone() Issue {
 		v := *i.EstimatedMinutes
 		clone.EstimatedMinutes = &v
 	}
+	if i.Estimate != nil {
+		v := *i.Estimate
+		clone.Estimate = &v
+	}
 	if i.ClosedAt != nil {
 		v := *i.ClosedAt
 		clone.ClosedAt = &v
@@ -111,6 +116,34 @@ func (i *Issue) Validate() error {
 	return nil
 }
 
+// DueSoonWindow is how close its due date must be for an open issue to be
+// due soon
+const DueSoonWindow = 3 * 24 * time.Hour
+
+// DueState is where an open issue stands against its due date
+type DueState string
+
+const (
+	DueNone    DueState = ""        // No due date, or closed
+	DueLater   DueState = "later"   // Due after DueSoonWindow
+	DueSoon    DueState = "soon"    // Due within DueSoonWindow
+	DueOverdue DueState = "overdue" // Past its due date
+)
+
+// DueStateAt reports where the issue stands against its due date at now
+func (i *Issue) DueStateAt(now time.Time) DueState {
+	if i.DueDate == nil || i.DueDate.IsZero() || i.Status.IsClosed() {
+		return DueNone
+	}
+	switch left := i.DueDate.Sub(now); {
+	case left < 0:
+		return DueOverdue
+	case left <= DueSoonWindow:
+		return DueSoon
+	}
+	return DueLater
+}
+
 // Status represents the current state of an issue
 type Status string
 
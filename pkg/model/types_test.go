@@ -69,6 +69,31 @@ func TestStatus_IsOpen(t *testing.T) {
 	}
 }
 
+func TestIssue_DueStateAt(t *testing.T) {
+	now := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time { t := now.Add(d); return &t }
+	tests := []struct {
+		name   string
+		due    *time.Time
+		status Status
+		want   DueState
+	}{
+		{"NoDueDate", nil, StatusOpen, DueNone},
+		{"Closed", at(-48 * time.Hour), StatusClosed, DueNone},
+		{"Overdue", at(-time.Hour), StatusInProgress, DueOverdue},
+		{"Soon", at(2 * 24 * time.Hour), StatusOpen, DueSoon},
+		{"Later", at(10 * 24 * time.Hour), StatusBlocked, DueLater},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := Issue{Status: tt.status, DueDate: tt.due}
+			if got := issue.DueStateAt(now); got != tt.want {
+				t.Errorf("DueStateAt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIssueType_IsValid(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -554,6 +579,7 @@ func TestIssue_Clone(t *testing.T) {
 	now := time.Now()
 	closedAt := now.Add(-1 * time.Hour)
 	estimatedMinutes := 60
+	estimate := 3.0
 	externalRef := "JIRA-123"
 	compactedAt := now.Add(-2 * time.Hour)
 	compactedAtCommit := "abc123"
@@ -567,6 +593,7 @@ func TestIssue_Clone(t *testing.T) {
 		IssueType:         TypeBug,
 		Assignee:          "user",
 		EstimatedMinutes:  &estimatedMinutes,
+		Estimate:          &estimate,
 		CreatedAt:         now,
 		UpdatedAt:         now,
 		ClosedAt:          &closedAt,
@@ -599,6 +626,9 @@ func TestIssue_Clone(t *testing.T) {
 	if *clone.EstimatedMinutes != *original.EstimatedMinutes {
 		t.Errorf("EstimatedMinutes value mismatch")
 	}
+	if clone.Estimate == original.Estimate || *clone.Estimate != *original.Estimate {
+		t.Errorf("Estimate should be a deep copy")
+	}
 
 	if clone.ClosedAt == original.ClosedAt {
 		t.Errorf("ClosedAt should be a new pointer")
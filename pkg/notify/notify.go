@@ -0,0 +1,308 @@
+// Package notify posts new critical drift alerts to a chat webhook
+// (Slack or Discord). Alerts already posted are remembered in .bv so each
+// one is announced once, and posts are debounced so a burst of edits
+// produces a single message.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFilename is the notifier config, in .bv
+const ConfigFilename = "notify.yaml"
+
+// StateFilename records the alerts already posted, in .bv
+const StateFilename = "notified_alerts.json"
+
+// Message formats
+const (
+	FormatSlack   = "slack"
+	FormatDiscord = "discord"
+)
+
+// discordMaxContent is Discord's limit on a message's content
+const discordMaxContent = 2000
+
+// Config is the notifier configuration in .bv/notify.yaml
+type Config struct {
+	// WebhookURL is the incoming webhook to post to. Prefer WebhookURLEnv
+	// when the file is committed.
+	WebhookURL string `yaml:"webhook_url,omitempty"`
+
+	// WebhookURLEnv names an environment variable holding the webhook URL
+	// (default BV_WEBHOOK_URL); used when WebhookURL is empty
+	WebhookURLEnv string `yaml:"webhook_url_env,omitempty"`
+
+	// Format is "slack" or "discord"; empty guesses from the URL
+	Format string `yaml:"format,omitempty"`
+
+	// DebounceMinutes is the minimum gap between posts (default 10).
+	// Alerts raised in between wait for the next check after the gap.
+	DebounceMinutes int `yaml:"debounce_minutes,omitempty"`
+}
+
+// DefaultConfig returns the default notifier settings
+func DefaultConfig() Config {
+	return Config{
+		WebhookURLEnv:   "BV_WEBHOOK_URL",
+		DebounceMinutes: 10,
+	}
+}
+
+// ConfigPath returns the path to .bv/notify.yaml
+func ConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ConfigFilename)
+}
+
+// LoadConfig loads .bv/notify.yaml over the defaults.
+// Returns the defaults if the file doesn't exist.
+func LoadConfig(projectDir string) (Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(ConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading notify config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing notify config: %w", err)
+	}
+	if cfg.DebounceMinutes < 0 {
+		cfg.DebounceMinutes = 0
+	}
+	switch cfg.Format {
+	case "", FormatSlack, FormatDiscord:
+	default:
+		return cfg, fmt.Errorf("notify config: unknown format %q (want slack or discord)", cfg.Format)
+	}
+	return cfg, nil
+}
+
+// URL returns the webhook to post to, or "" when none is configured
+func (c Config) URL() string {
+	if c.WebhookURL != "" {
+		return c.WebhookURL
+	}
+	if c.WebhookURLEnv != "" {
+		return os.Getenv(c.WebhookURLEnv)
+	}
+	return ""
+}
+
+// Enabled returns true if a webhook is configured
+func (c Config) Enabled() bool {
+	return c.URL() != ""
+}
+
+// format resolves the message format, guessing from the URL if unset
+func (c Config) format() string {
+	if c.Format != "" {
+		return c.Format
+	}
+	url := c.URL()
+	if strings.Contains(url, "discord.com/") || strings.Contains(url, "discordapp.com/") {
+		return FormatDiscord
+	}
+	return FormatSlack
+}
+
+// State remembers which alerts have been posted
+type State struct {
+	// Notified maps alert keys (drift.AlertKey) to when they were posted
+	Notified map[string]time.Time `json:"notified"`
+	// LastSent is when the last message went out, for debouncing
+	LastSent time.Time `json:"last_sent,omitempty"`
+}
+
+// StatePath returns the path to .bv/notified_alerts.json
+func StatePath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", StateFilename)
+}
+
+// LoadState reads the notified alerts; a missing file is an empty state
+func LoadState(projectDir string) (State, error) {
+	state := State{Notified: make(map[string]time.Time)}
+	data, err := os.ReadFile(StatePath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return state, fmt.Errorf("reading notify state: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("parsing notify state: %w", err)
+	}
+	if state.Notified == nil {
+		state.Notified = make(map[string]time.Time)
+	}
+	return state, nil
+}
+
+// SaveState writes the notified alerts to .bv
+func SaveState(projectDir string, state State) error {
+	path := StatePath(projectDir)
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating .bv directory: %w", err)
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding notify state: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing notify state: %w", err)
+	}
+	return nil
+}
+
+// Pending returns the critical alerts not posted yet. Keys of alerts that
+// are no longer raised are forgotten, so an alert that clears and comes
+// back is announced again. Reports whether the state changed.
+func (s *State) Pending(alerts []drift.Alert) ([]drift.Alert, bool) {
+	active := make(map[string]bool, len(alerts))
+	var pending []drift.Alert
+	for _, a := range alerts {
+		if a.Severity != drift.SeverityCritical {
+			continue
+		}
+		key := drift.AlertKey(a)
+		if active[key] {
+			continue
+		}
+		active[key] = true
+		if _, done := s.Notified[key]; !done {
+			pending = append(pending, a)
+		}
+	}
+
+	changed := false
+	for key := range s.Notified {
+		if !active[key] {
+			delete(s.Notified, key)
+			changed = true
+		}
+	}
+	return pending, changed
+}
+
+// Notifier posts new critical alerts for one project
+type Notifier struct {
+	cfg        Config
+	projectDir string
+	project    string
+	client     *http.Client
+	now        func() time.Time
+}
+
+// New creates a notifier for the project in projectDir
+func New(projectDir string, cfg Config) *Notifier {
+	return &Notifier{
+		cfg:        cfg,
+		projectDir: projectDir,
+		project:    filepath.Base(projectDir),
+		client:     &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// Notify posts the critical alerts among alerts that haven't been posted
+// before, and returns them. It returns nothing when there are none, or
+// when a message went out less than the debounce interval ago; those
+// alerts are posted by a later call. Nothing is posted in read-only mode.
+func (n *Notifier) Notify(alerts []drift.Alert) ([]drift.Alert, error) {
+	if !n.cfg.Enabled() || readonly.Enabled() {
+		return nil, nil
+	}
+	state, err := LoadState(n.projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, changed := state.Pending(alerts)
+	now := n.now().UTC()
+	debounce := time.Duration(n.cfg.DebounceMinutes) * time.Minute
+	if len(pending) == 0 || (!state.LastSent.IsZero() && now.Sub(state.LastSent) < debounce) {
+		if changed {
+			return nil, SaveState(n.projectDir, state)
+		}
+		return nil, nil
+	}
+
+	if err := n.post(FormatMessage(n.project, pending)); err != nil {
+		return nil, err
+	}
+	for _, a := range pending {
+		state.Notified[drift.AlertKey(a)] = now
+	}
+	state.LastSent = now
+	return pending, SaveState(n.projectDir, state)
+}
+
+// post sends text to the webhook in its format
+func (n *Notifier) post(text string) error {
+	var payload any
+	if n.cfg.format() == FormatDiscord {
+		if r := []rune(text); len(r) > discordMaxContent {
+			text = string(r[:discordMaxContent-1]) + "…"
+		}
+		payload = map[string]string{"content": text}
+	} else {
+		payload = map[string]string{"text": text}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encoding webhook payload: %w", err)
+	}
+
+	resp, err := n.client.Post(n.cfg.URL(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// FormatMessage summarizes alerts as chat markdown, which Slack and
+// Discord both render
+func FormatMessage(project string, alerts []drift.Alert) string {
+	sorted := append([]drift.Alert(nil), alerts...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Type < sorted[j].Type })
+
+	noun := "alert"
+	if len(sorted) != 1 {
+		noun = "alerts"
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "🚨 *%d new critical %s in %s*\n", len(sorted), noun, project)
+	for _, a := range sorted {
+		sb.WriteString("• " + a.Message)
+		if a.IssueID != "" {
+			fmt.Fprintf(&sb, " (%s)", a.IssueID)
+		}
+		sb.WriteString("\n")
+		for i, d := range a.Details {
+			if i == 5 {
+				fmt.Fprintf(&sb, "    … +%d more\n", len(a.Details)-i)
+				break
+			}
+			sb.WriteString("    " + d + "\n")
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -0,0 +1,139 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+)
+
+var (
+	cycleAlert = drift.Alert{Type: drift.AlertNewCycle, Severity: drift.SeverityCritical, Message: "1 new cycle(s) detected", Details: []string{"A → B → A"}}
+	staleAlert = drift.Alert{Type: drift.AlertStaleIssue, Severity: drift.SeverityCritical, Message: "Issue inactive for 40 days", IssueID: "bv-7"}
+	infoAlert  = drift.Alert{Type: drift.AlertNodeCountChange, Severity: drift.SeverityInfo, Message: "Node count changed"}
+)
+
+// webhook records the payloads posted to it
+func webhook(t *testing.T) (*httptest.Server, *[]map[string]string) {
+	t.Helper()
+	var posts []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("bad payload: %v", err)
+		}
+		posts = append(posts, payload)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &posts
+}
+
+func TestNotify_PostsEachCriticalAlertOnce(t *testing.T) {
+	srv, posts := webhook(t)
+	dir := t.TempDir()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	n := New(dir, Config{WebhookURL: srv.URL, DebounceMinutes: 10})
+	n.now = func() time.Time { return now }
+
+	sent, err := n.Notify([]drift.Alert{cycleAlert, infoAlert})
+	if err != nil || len(sent) != 1 || len(*posts) != 1 {
+		t.Fatalf("expected the critical alert posted, got %v, %v (%d posts)", sent, err, len(*posts))
+	}
+	if text := (*posts)[0]["text"]; !strings.Contains(text, "1 new critical alert in "+filepath.Base(dir)) || !strings.Contains(text, "A → B → A") {
+		t.Errorf("unexpected slack text: %q", text)
+	}
+
+	// A new alert within the debounce window waits...
+	now = now.Add(5 * time.Minute)
+	if sent, _ := n.Notify([]drift.Alert{cycleAlert, staleAlert}); len(sent) != 0 {
+		t.Errorf("post inside the debounce window: %v", sent)
+	}
+	// ...and goes out once the window has passed
+	now = now.Add(6 * time.Minute)
+	sent, err = n.Notify([]drift.Alert{cycleAlert, staleAlert})
+	if err != nil || len(sent) != 1 || sent[0].IssueID != "bv-7" {
+		t.Errorf("expected the held alert posted, got %v, %v", sent, err)
+	}
+	if len(*posts) != 2 {
+		t.Errorf("expected 2 posts, got %d", len(*posts))
+	}
+
+	// Already posted: nothing new, even after the debounce
+	now = now.Add(time.Hour)
+	if sent, _ := n.Notify([]drift.Alert{cycleAlert, staleAlert}); len(sent) != 0 {
+		t.Errorf("already notified alerts were posted again: %v", sent)
+	}
+}
+
+func TestNotify_ClearedAlertNotifiesAgain(t *testing.T) {
+	srv, posts := webhook(t)
+	dir := t.TempDir()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	n := New(dir, Config{WebhookURL: srv.URL})
+	n.now = func() time.Time { return now }
+
+	n.Notify([]drift.Alert{cycleAlert})
+	n.Notify(nil) // The cycle was fixed
+	state, _ := LoadState(dir)
+	if len(state.Notified) != 0 {
+		t.Errorf("cleared alert still tracked: %v", state.Notified)
+	}
+	n.Notify([]drift.Alert{cycleAlert})
+	if len(*posts) != 2 {
+		t.Errorf("expected the returning alert posted again, got %d posts", len(*posts))
+	}
+}
+
+func TestNotify_Discord(t *testing.T) {
+	srv, posts := webhook(t)
+	n := New(t.TempDir(), Config{WebhookURL: srv.URL, Format: FormatDiscord})
+	if _, err := n.Notify([]drift.Alert{staleAlert}); err != nil {
+		t.Fatal(err)
+	}
+	if len(*posts) != 1 || !strings.Contains((*posts)[0]["content"], "(bv-7)") {
+		t.Errorf("expected discord content, got %v", *posts)
+	}
+}
+
+func TestNotify_WebhookError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusForbidden)
+	}))
+	defer srv.Close()
+	dir := t.TempDir()
+	n := New(dir, Config{WebhookURL: srv.URL})
+	if _, err := n.Notify([]drift.Alert{cycleAlert}); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected a 403 error, got %v", err)
+	}
+	// Not recorded, so the next check retries
+	if state, _ := LoadState(dir); len(state.Notified) != 0 {
+		t.Errorf("failed post was recorded: %v", state.Notified)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadConfig(dir)
+	if err != nil || cfg.DebounceMinutes != 10 || cfg.WebhookURLEnv != "BV_WEBHOOK_URL" {
+		t.Fatalf("defaults = %+v, %v", cfg, err)
+	}
+
+	os.MkdirAll(filepath.Join(dir, ".bv"), 0755)
+	os.WriteFile(ConfigPath(dir), []byte("webhook_url_env: TEAM_HOOK\ndebounce_minutes: 30\n"), 0644)
+	t.Setenv("TEAM_HOOK", "https://discord.com/api/webhooks/1/x")
+	cfg, err = LoadConfig(dir)
+	if err != nil || cfg.DebounceMinutes != 30 || !cfg.Enabled() || cfg.format() != FormatDiscord {
+		t.Errorf("config = %+v, %v", cfg, err)
+	}
+
+	os.WriteFile(ConfigPath(dir), []byte("format: teams\n"), 0644)
+	if _, err := LoadConfig(dir); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,102 @@
+package publish
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// bodyRe captures the content of an HTML page's body
+var bodyRe = regexp.MustCompile(`(?is)<body[^>]*>(.*)</body>`)
+
+// confluencePage is the subset of Confluence's content object bv uses
+type confluencePage struct {
+	ID      string `json:"id"`
+	Version struct {
+		Number int `json:"number"`
+	} `json:"version"`
+	Links struct {
+		Base  string `json:"base"`
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+}
+
+// PublishConfluence writes an HTML report to the page titled title in the
+// configured space, creating it or replacing its content. Only the page's
+// <body> is sent, in Confluence's storage format.
+func PublishConfluence(cfg ConfluenceConfig, title, html string) (Result, error) {
+	return publishConfluence(newClient(), cfg, title, html)
+}
+
+func publishConfluence(client *http.Client, cfg ConfluenceConfig, title, html string) (Result, error) {
+	if err := checkWritable("Confluence"); err != nil {
+		return Result{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return Result{}, err
+	}
+	api := strings.TrimRight(cfg.BaseURL, "/") + "/rest/api/content"
+	secret := token(cfg.Token, cfg.TokenEnv)
+	auth := func(req *http.Request) {
+		if cfg.Username != "" {
+			req.SetBasicAuth(cfg.Username, secret)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+secret)
+		}
+	}
+
+	query := url.Values{"spaceKey": {cfg.Space}, "title": {title}, "expand": {"version"}}
+	var found struct {
+		Results []confluencePage `json:"results"`
+	}
+	if err := doJSON(client, http.MethodGet, api+"?"+query.Encode(), auth, nil, &found); err != nil {
+		return Result{}, fmt.Errorf("looking up Confluence page: %w", err)
+	}
+
+	page := map[string]any{
+		"type":  "page",
+		"title": title,
+		"space": map[string]string{"key": cfg.Space},
+		"body": map[string]any{
+			"storage": map[string]string{"value": confluenceStorage(html), "representation": "storage"},
+		},
+	}
+	if cfg.ParentID != "" {
+		page["ancestors"] = []map[string]string{{"id": cfg.ParentID}}
+	}
+
+	var saved confluencePage
+	result := Result{Created: len(found.Results) == 0}
+	if result.Created {
+		if err := doJSON(client, http.MethodPost, api, auth, page, &saved); err != nil {
+			return Result{}, fmt.Errorf("creating Confluence page: %w", err)
+		}
+	} else {
+		existing := found.Results[0]
+		page["id"] = existing.ID
+		page["version"] = map[string]int{"number": existing.Version.Number + 1}
+		if err := doJSON(client, http.MethodPut, api+"/"+url.PathEscape(existing.ID), auth, page, &saved); err != nil {
+			return Result{}, fmt.Errorf("updating Confluence page: %w", err)
+		}
+	}
+
+	if saved.Links.WebUI != "" {
+		base := saved.Links.Base
+		if base == "" {
+			base = strings.TrimRight(cfg.BaseURL, "/")
+		}
+		result.URL = base + saved.Links.WebUI
+	}
+	return result, nil
+}
+
+// confluenceStorage returns the part of an HTML page Confluence stores: the
+// content of <body>, or all of it for a fragment
+func confluenceStorage(html string) string {
+	if m := bodyRe.FindStringSubmatch(html); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+	return strings.TrimSpace(html)
+}
@@ -0,0 +1,284 @@
+package publish
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// NotionAPIURL is the Notion API root used by PublishNotion
+const NotionAPIURL = "https://api.notion.com"
+
+// notionVersion is the API version requests are made against
+const notionVersion = "2022-06-28"
+
+// Notion API limits
+const (
+	notionMaxBlocks = 100  // Children per request
+	notionMaxText   = 2000 // Characters per rich text object
+)
+
+// notionLanguages are the code block languages bv passes through; others
+// become plain text, which Notion always accepts
+var notionLanguages = map[string]string{
+	"bash": "bash", "sh": "shell", "shell": "shell", "mermaid": "mermaid",
+	"json": "json", "yaml": "yaml", "go": "go", "python": "python",
+	"javascript": "javascript", "markdown": "markdown", "sql": "sql",
+}
+
+// notionBlock is one block of page content, as the API takes it
+type notionBlock map[string]any
+
+// PublishNotion adds a Markdown report to the configured database as a page
+// titled title. Pages already there with that title are archived, so the
+// database keeps one current report.
+func PublishNotion(cfg NotionConfig, title, markdown string) (Result, error) {
+	return publishNotion(newClient(), NotionAPIURL, cfg, title, markdown)
+}
+
+func publishNotion(client *http.Client, apiURL string, cfg NotionConfig, title, markdown string) (Result, error) {
+	if err := checkWritable("Notion"); err != nil {
+		return Result{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return Result{}, err
+	}
+	api := strings.TrimRight(apiURL, "/") + "/v1"
+	secret := token(cfg.Token, cfg.TokenEnv)
+	auth := func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+secret)
+		req.Header.Set("Notion-Version", notionVersion)
+	}
+
+	filter := map[string]any{
+		"filter": map[string]any{
+			"property": cfg.TitleProperty,
+			"title":    map[string]string{"equals": title},
+		},
+	}
+	var found struct {
+		Results []struct {
+			ID string `json:"id"`
+		} `json:"results"`
+	}
+	if err := doJSON(client, http.MethodPost, api+"/databases/"+url.PathEscape(cfg.DatabaseID)+"/query", auth, filter, &found); err != nil {
+		return Result{}, fmt.Errorf("looking up Notion page: %w", err)
+	}
+
+	blocks := markdownToNotionBlocks(markdown)
+	first := blocks[:min(len(blocks), notionMaxBlocks)]
+	page := map[string]any{
+		"parent": map[string]string{"database_id": cfg.DatabaseID},
+		"properties": map[string]any{
+			cfg.TitleProperty: map[string]any{"title": notionText(title)},
+		},
+		"children": first,
+	}
+	var created struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := doJSON(client, http.MethodPost, api+"/pages", auth, page, &created); err != nil {
+		return Result{}, fmt.Errorf("creating Notion page: %w", err)
+	}
+	for rest := blocks[len(first):]; len(rest) > 0; {
+		batch := rest[:min(len(rest), notionMaxBlocks)]
+		rest = rest[len(batch):]
+		children := map[string]any{"children": batch}
+		if err := doJSON(client, http.MethodPatch, api+"/blocks/"+url.PathEscape(created.ID)+"/children", auth, children, nil); err != nil {
+			return Result{}, fmt.Errorf("adding content to Notion page: %w", err)
+		}
+	}
+
+	// Archive the old pages only once the new one is complete
+	for _, old := range found.Results {
+		archive := map[string]bool{"archived": true}
+		if err := doJSON(client, http.MethodPatch, api+"/pages/"+url.PathEscape(old.ID), auth, archive, nil); err != nil {
+			return Result{}, fmt.Errorf("archiving previous Notion page: %w", err)
+		}
+	}
+	return Result{Created: len(found.Results) == 0, URL: created.URL}, nil
+}
+
+var (
+	notionInlineRe   = regexp.MustCompile("\\*\\*([^*]+)\\*\\*|`([^`]+)`|\\[([^\\]]+)\\]\\([^)]*\\)")
+	notionOrderedRe  = regexp.MustCompile(`^\d+\.\s+`)
+	notionTableSepRe = regexp.MustCompile(`^\|[\s:|-]+\|$`)
+)
+
+// markdownToNotionBlocks converts the Markdown bv generates (headings,
+// paragraphs, lists, quotes, rules, tables and fenced code) to Notion blocks.
+// Inline bold and code carry over; links keep only their text, since
+// Notion rejects the in-page anchors reports link to.
+func markdownToNotionBlocks(md string) []notionBlock {
+	blocks := []notionBlock{}
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	var para, quote []string
+	flush := func() {
+		if len(para) > 0 {
+			blocks = append(blocks, textBlock("paragraph", strings.Join(para, "\n")))
+			para = nil
+		}
+		if len(quote) > 0 {
+			blocks = append(blocks, textBlock("quote", strings.Join(quote, "\n")))
+			quote = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			lang := notionLanguages[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(trimmed, "```")))]
+			if lang == "" {
+				lang = "plain text"
+			}
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, notionBlock{"type": "code", "code": map[string]any{
+				"language":  lang,
+				"rich_text": plainText(strings.Join(code, "\n")),
+			}})
+		case trimmed == "":
+			flush()
+		case trimmed == "---" || trimmed == "***":
+			flush()
+			blocks = append(blocks, notionBlock{"type": "divider", "divider": map[string]any{}})
+		case strings.HasPrefix(trimmed, "#"):
+			flush()
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			kind := fmt.Sprintf("heading_%d", min(level, 3))
+			blocks = append(blocks, textBlock(kind, strings.TrimSpace(trimmed[level:])))
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			flush()
+			blocks = append(blocks, textBlock("bulleted_list_item", trimmed[2:]))
+		case notionOrderedRe.MatchString(trimmed):
+			flush()
+			blocks = append(blocks, textBlock("numbered_list_item", notionOrderedRe.ReplaceAllString(trimmed, "")))
+		case strings.HasPrefix(trimmed, ">"):
+			if len(para) > 0 {
+				flush()
+			}
+			quote = append(quote, strings.TrimSpace(strings.TrimPrefix(trimmed, ">")))
+		case strings.HasPrefix(trimmed, "|"):
+			flush()
+			var rows []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|"); i++ {
+				rows = append(rows, strings.TrimSpace(lines[i]))
+			}
+			i--
+			blocks = append(blocks, tableBlock(rows))
+		default:
+			if len(quote) > 0 {
+				flush()
+			}
+			para = append(para, trimmed)
+		}
+	}
+	flush()
+	return blocks
+}
+
+// textBlock is a block of the given kind holding Markdown inline text
+func textBlock(kind, text string) notionBlock {
+	return notionBlock{"type": kind, kind: map[string]any{"rich_text": notionText(text)}}
+}
+
+// tableBlock converts Markdown table rows; the first row is the header and
+// the |---| separator is dropped
+func tableBlock(lines []string) notionBlock {
+	var rows [][]string
+	width := 1
+	for _, line := range lines {
+		if notionTableSepRe.MatchString(line) {
+			continue
+		}
+		cells := splitTableRow(line)
+		width = max(width, len(cells))
+		rows = append(rows, cells)
+	}
+	children := make([]notionBlock, len(rows))
+	for i, cells := range rows {
+		richCells := make([]any, width)
+		for j := range richCells {
+			text := ""
+			if j < len(cells) {
+				text = cells[j]
+			}
+			richCells[j] = notionText(text)
+		}
+		children[i] = notionBlock{"type": "table_row", "table_row": map[string]any{"cells": richCells}}
+	}
+	return notionBlock{"type": "table", "table": map[string]any{
+		"table_width":       width,
+		"has_column_header": true,
+		"has_row_header":    false,
+		"children":          children,
+	}}
+}
+
+// splitTableRow splits "| a | b \| c |" into its cells, honoring \|
+func splitTableRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// notionText converts Markdown inline text to rich text: **bold** and
+// `code` are annotated, and links become their text
+func notionText(text string) []map[string]any {
+	out := []map[string]any{}
+	last := 0
+	for _, m := range notionInlineRe.FindAllStringSubmatchIndex(text, -1) {
+		out = append(out, plainText(text[last:m[0]])...)
+		switch {
+		case m[2] >= 0:
+			out = append(out, annotated(text[m[2]:m[3]], "bold")...)
+		case m[4] >= 0:
+			out = append(out, annotated(text[m[4]:m[5]], "code")...)
+		default:
+			out = append(out, plainText(text[m[6]:m[7]])...)
+		}
+		last = m[1]
+	}
+	return append(out, plainText(text[last:])...)
+}
+
+// plainText splits text into rich text objects under Notion's length limit
+func plainText(text string) []map[string]any {
+	out := []map[string]any{}
+	for runes := []rune(text); len(runes) > 0; {
+		n := min(len(runes), notionMaxText)
+		out = append(out, map[string]any{"type": "text", "text": map[string]string{"content": string(runes[:n])}})
+		runes = runes[n:]
+	}
+	return out
+}
+
+// annotated is plainText with one annotation (bold, code, ...) set
+func annotated(text, annotation string) []map[string]any {
+	out := plainText(text)
+	for _, t := range out {
+		t["annotations"] = map[string]bool{annotation: true}
+	}
+	return out
+}
@@ -0,0 +1,212 @@
+// Package publish pushes the generated issue report straight to a
+// Confluence page or a Notion database, so it doesn't have to be exported
+// and pasted by hand. Each publish replaces the page with the same title,
+// so re-running keeps one up-to-date page instead of piling up copies.
+package publish
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFilename is the publisher config, in .bv
+const ConfigFilename = "publish.yaml"
+
+// Targets
+const (
+	TargetConfluence = "confluence"
+	TargetNotion     = "notion"
+)
+
+// Config is the publisher configuration in .bv/publish.yaml
+type Config struct {
+	// Title is the page title; "{project}" is replaced with the project name
+	Title string `yaml:"title,omitempty"`
+
+	Confluence ConfluenceConfig `yaml:"confluence,omitempty"`
+	Notion     NotionConfig     `yaml:"notion,omitempty"`
+}
+
+// ConfluenceConfig names the space (and optional parent page) to publish to
+type ConfluenceConfig struct {
+	// BaseURL is the site root, e.g. https://acme.atlassian.net/wiki
+	BaseURL string `yaml:"base_url"`
+
+	// Space is the key of the space the page lives in
+	Space string `yaml:"space"`
+
+	// ParentID is an optional page ID to publish under
+	ParentID string `yaml:"parent_id,omitempty"`
+
+	// Username is the account email for Confluence Cloud, which takes the
+	// token as an API token over basic auth. Leave it empty for a Server or
+	// Data Center personal access token, sent as a bearer token.
+	Username string `yaml:"username,omitempty"`
+
+	// Token is the API token. Prefer TokenEnv when the file is committed.
+	Token string `yaml:"token,omitempty"`
+
+	// TokenEnv names an environment variable holding the token (default
+	// BV_CONFLUENCE_TOKEN); used when Token is empty
+	TokenEnv string `yaml:"token_env,omitempty"`
+}
+
+// NotionConfig names the database to publish to
+type NotionConfig struct {
+	// DatabaseID is the database the report page is added to; share the
+	// database with the integration first
+	DatabaseID string `yaml:"database_id"`
+
+	// TitleProperty is the database's title column (default "Name")
+	TitleProperty string `yaml:"title_property,omitempty"`
+
+	// Token is the integration secret. Prefer TokenEnv when the file is committed.
+	Token string `yaml:"token,omitempty"`
+
+	// TokenEnv names an environment variable holding the secret (default
+	// BV_NOTION_TOKEN); used when Token is empty
+	TokenEnv string `yaml:"token_env,omitempty"`
+}
+
+// DefaultConfig returns the default publisher settings
+func DefaultConfig() Config {
+	return Config{
+		Title:      "Backlog report: {project}",
+		Confluence: ConfluenceConfig{TokenEnv: "BV_CONFLUENCE_TOKEN"},
+		Notion:     NotionConfig{TitleProperty: "Name", TokenEnv: "BV_NOTION_TOKEN"},
+	}
+}
+
+// ConfigPath returns the path to .bv/publish.yaml
+func ConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ConfigFilename)
+}
+
+// LoadConfig loads .bv/publish.yaml over the defaults.
+// Returns the defaults if the file doesn't exist.
+func LoadConfig(projectDir string) (Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(ConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading publish config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing publish config: %w", err)
+	}
+	if cfg.Notion.TitleProperty == "" {
+		cfg.Notion.TitleProperty = "Name"
+	}
+	return cfg, nil
+}
+
+// PageTitle returns the page title for a project
+func (c Config) PageTitle(project string) string {
+	return strings.ReplaceAll(c.Title, "{project}", project)
+}
+
+// token returns value, or else the environment variable env names
+func token(value, env string) string {
+	if value != "" {
+		return value
+	}
+	if env != "" {
+		return os.Getenv(env)
+	}
+	return ""
+}
+
+// Validate checks that Confluence is configured
+func (c ConfluenceConfig) Validate() error {
+	where := filepath.Join(".bv", ConfigFilename)
+	switch {
+	case c.BaseURL == "":
+		return fmt.Errorf("confluence.base_url is not set in %s", where)
+	case c.Space == "":
+		return fmt.Errorf("confluence.space is not set in %s", where)
+	case token(c.Token, c.TokenEnv) == "":
+		return fmt.Errorf("no Confluence token: set confluence.token in %s or $%s", where, c.TokenEnv)
+	}
+	return nil
+}
+
+// Validate checks that Notion is configured
+func (c NotionConfig) Validate() error {
+	where := filepath.Join(".bv", ConfigFilename)
+	switch {
+	case c.DatabaseID == "":
+		return fmt.Errorf("notion.database_id is not set in %s", where)
+	case token(c.Token, c.TokenEnv) == "":
+		return fmt.Errorf("no Notion token: set notion.token in %s or $%s", where, c.TokenEnv)
+	}
+	return nil
+}
+
+// Result describes a published page
+type Result struct {
+	Created bool   // False when an existing page was replaced
+	URL     string // Where the page can be viewed, if the API said
+}
+
+// newClient is the HTTP client publishers use
+func newClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// doJSON sends a JSON request and decodes a JSON response into out (which
+// may be nil). auth sets the request's credentials.
+func doJSON(client *http.Client, method, url string, auth func(*http.Request), in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	auth(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s %s: %s: %s", method, url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+// checkWritable refuses to publish in read-only mode
+func checkWritable(target string) error {
+	return readonly.Check("publishing to " + target)
+}
@@ -0,0 +1,223 @@
+package publish
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+)
+
+// request is one call a fake API received
+type request struct {
+	Method, Path, Query, Auth string
+	Body                      map[string]any
+}
+
+// fakeAPI records requests and answers each with respond's JSON
+func fakeAPI(t *testing.T, respond func(r request) any) (*httptest.Server, *[]request) {
+	t.Helper()
+	var calls []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Auth: r.Header.Get("Authorization")}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&call.Body)
+		}
+		calls = append(calls, call)
+		_ = json.NewEncoder(w).Encode(respond(call))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadConfig(dir)
+	if err != nil || cfg.Notion.TitleProperty != "Name" || cfg.PageTitle("shop") != "Backlog report: shop" {
+		t.Fatalf("defaults: %+v, %v", cfg, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	yaml := "title: Status\nconfluence:\n  base_url: https://x.example/wiki\n  space: ENG\nnotion:\n  database_id: db1\n  token_env: MY_NOTION\n"
+	if err := os.WriteFile(ConfigPath(dir), []byte(yaml), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Confluence.TokenEnv != "BV_CONFLUENCE_TOKEN" || cfg.Notion.TokenEnv != "MY_NOTION" || cfg.Notion.TitleProperty != "Name" {
+		t.Errorf("file should override only what it sets: %+v", cfg)
+	}
+
+	t.Setenv("BV_CONFLUENCE_TOKEN", "")
+	if err := cfg.Confluence.Validate(); err == nil || !strings.Contains(err.Error(), "BV_CONFLUENCE_TOKEN") {
+		t.Errorf("a missing token should name the variable, got %v", err)
+	}
+	t.Setenv("MY_NOTION", "secret")
+	if err := cfg.Notion.Validate(); err != nil {
+		t.Errorf("token from the environment: %v", err)
+	}
+}
+
+func TestPublishConfluence(t *testing.T) {
+	pages := map[string]int{} // title -> version
+	srv, calls := fakeAPI(t, func(r request) any {
+		switch r.Method {
+		case http.MethodGet:
+			if v, ok := pages["Status"]; ok {
+				return map[string]any{"results": []any{map[string]any{"id": "42", "version": map[string]int{"number": v}}}}
+			}
+			return map[string]any{"results": []any{}}
+		default:
+			pages["Status"]++
+			return map[string]any{"id": "42", "_links": map[string]string{"webui": "/spaces/ENG/pages/42"}}
+		}
+	})
+	cfg := ConfluenceConfig{BaseURL: srv.URL + "/wiki/", Space: "ENG", ParentID: "7", Username: "me@example.com", Token: "tok"}
+	html := "<!DOCTYPE html><html><head><title>x</title></head><body class=\"r\">\n<h1>Status</h1>\n</body></html>"
+
+	res, err := publishConfluence(srv.Client(), cfg, "Status", html)
+	if err != nil {
+		t.Fatalf("publishConfluence: %v", err)
+	}
+	if !res.Created || res.URL != srv.URL+"/wiki/spaces/ENG/pages/42" {
+		t.Errorf("first publish: %+v", res)
+	}
+	create := (*calls)[1]
+	if create.Method != http.MethodPost || create.Path != "/wiki/rest/api/content" || !strings.HasPrefix(create.Auth, "Basic ") {
+		t.Errorf("create call: %+v", create)
+	}
+	storage := create.Body["body"].(map[string]any)["storage"].(map[string]any)
+	if storage["value"] != "<h1>Status</h1>" || storage["representation"] != "storage" {
+		t.Errorf("only the body should be stored: %v", storage)
+	}
+	if fmt.Sprint(create.Body["ancestors"]) != "[map[id:7]]" {
+		t.Errorf("parent page: %v", create.Body["ancestors"])
+	}
+	if !strings.Contains((*calls)[0].Query, "spaceKey=ENG") || !strings.Contains((*calls)[0].Query, "title=Status") {
+		t.Errorf("lookup query: %s", (*calls)[0].Query)
+	}
+
+	// Publishing again replaces the page with the next version
+	cfg.Username = ""
+	res, err = publishConfluence(srv.Client(), cfg, "Status", html)
+	if err != nil || res.Created {
+		t.Fatalf("second publish: %+v, %v", res, err)
+	}
+	update := (*calls)[3]
+	if update.Method != http.MethodPut || update.Path != "/wiki/rest/api/content/42" || update.Auth != "Bearer tok" {
+		t.Errorf("update call: %+v", update)
+	}
+	if v := update.Body["version"].(map[string]any)["number"]; v != float64(2) {
+		t.Errorf("update should bump the version to 2, got %v", v)
+	}
+}
+
+func TestPublishNotion(t *testing.T) {
+	srv, calls := fakeAPI(t, func(r request) any {
+		if strings.HasSuffix(r.Path, "/query") {
+			return map[string]any{"results": []any{map[string]string{"id": "old-1"}}}
+		}
+		return map[string]string{"id": "new-1", "url": "https://notion.so/new-1"}
+	})
+
+	var md strings.Builder
+	md.WriteString("# Report\n\n")
+	for i := 0; i < 150; i++ {
+		fmt.Fprintf(&md, "- item %d\n", i)
+	}
+	cfg := NotionConfig{DatabaseID: "db1", TitleProperty: "Name", Token: "secret"}
+	res, err := publishNotion(srv.Client(), srv.URL, cfg, "Status", md.String())
+	if err != nil {
+		t.Fatalf("publishNotion: %v", err)
+	}
+	if res.Created || res.URL != "https://notion.so/new-1" {
+		t.Errorf("result: %+v", res)
+	}
+
+	var paths []string
+	for _, c := range *calls {
+		paths = append(paths, c.Method+" "+c.Path)
+		if c.Auth != "Bearer secret" {
+			t.Errorf("%s %s: auth %q", c.Method, c.Path, c.Auth)
+		}
+	}
+	want := "[POST /v1/databases/db1/query POST /v1/pages PATCH /v1/blocks/new-1/children PATCH /v1/pages/old-1]"
+	if fmt.Sprint(paths) != want {
+		t.Errorf("calls = %v, want %s", paths, want)
+	}
+	if n := len((*calls)[1].Body["children"].([]any)); n != notionMaxBlocks {
+		t.Errorf("the page should be created with %d blocks, got %d", notionMaxBlocks, n)
+	}
+	if n := len((*calls)[2].Body["children"].([]any)); n != 51 {
+		t.Errorf("the other 51 blocks should be appended, got %d", n)
+	}
+	if (*calls)[3].Body["archived"] != true {
+		t.Error("the previous page should be archived")
+	}
+}
+
+func TestPublish_ReadOnly(t *testing.T) {
+	readonly.Set(true)
+	defer readonly.Set(false)
+	if _, err := PublishNotion(NotionConfig{DatabaseID: "db", Token: "t"}, "x", "y"); !errors.Is(err, readonly.ErrReadOnly) {
+		t.Errorf("Notion publish in read-only mode: %v", err)
+	}
+	if _, err := PublishConfluence(ConfluenceConfig{BaseURL: "http://x", Space: "S", Token: "t"}, "x", "y"); !errors.Is(err, readonly.ErrReadOnly) {
+		t.Errorf("Confluence publish in read-only mode: %v", err)
+	}
+}
+
+func TestMarkdownToNotionBlocks(t *testing.T) {
+	md := "# Title\n\nSome **bold** and `code` with a [link](#anchor).\nSecond line\n\n" +
+		"| Metric | Count |\n|--------|-------|\n| a \\| b | 3 |\n\n" +
+		"> **alice** (2025-01-01)\n>\n> hi\n\n---\n\n```mermaid\ngraph TD\n```\n\n1. first\n"
+	blocks := markdownToNotionBlocks(md)
+
+	var kinds []string
+	for _, b := range blocks {
+		kinds = append(kinds, b["type"].(string))
+	}
+	want := "[heading_1 paragraph table quote divider code numbered_list_item]"
+	if fmt.Sprint(kinds) != want {
+		t.Fatalf("blocks = %v, want %s", kinds, want)
+	}
+
+	para := blocks[1]["paragraph"].(map[string]any)["rich_text"].([]map[string]any)
+	var text strings.Builder
+	for _, rt := range para {
+		text.WriteString(rt["text"].(map[string]string)["content"])
+	}
+	if text.String() != "Some bold and code with a link.\nSecond line" {
+		t.Errorf("paragraph text = %q", text.String())
+	}
+	if para[1]["annotations"].(map[string]bool)["bold"] != true || para[3]["annotations"].(map[string]bool)["code"] != true {
+		t.Errorf("bold and code should be annotated: %v", para)
+	}
+
+	table := blocks[2]["table"].(map[string]any)
+	rows := table["children"].([]notionBlock)
+	if table["table_width"] != 2 || len(rows) != 2 {
+		t.Fatalf("table: %v", table)
+	}
+	cell := rows[1]["table_row"].(map[string]any)["cells"].([]any)[0].([]map[string]any)
+	if cell[0]["text"].(map[string]string)["content"] != "a | b" {
+		t.Errorf("escaped pipes should stay in the cell: %v", cell)
+	}
+
+	if lang := blocks[5]["code"].(map[string]any)["language"]; lang != "mermaid" {
+		t.Errorf("code language = %v", lang)
+	}
+	if n := len(plainText(strings.Repeat("x", notionMaxText*2+1))); n != 3 {
+		t.Errorf("long text should split into 3 rich text objects, got %d", n)
+	}
+}
@@ -0,0 +1,564 @@
+// Package query implements the boolean filter language used by `/` search and recipes.
+//
+// Grammar (keywords are uppercase only, so "fix or remove" stays plain text):
+//
+//	expr    := or
+//	or      := and { ("OR" | "||") and }
+//	and     := unary { ["AND" | "&&"] unary }   // adjacency is an implicit AND
+//	unary   := ("NOT" | "!" | "-") unary | primary
+//	primary := "(" expr ")" | term
+//	term    := field op value | value            // a bare value is a text match
+//	op      := ":" | "=" | "!=" | "<" | "<=" | ">" | ">="
+//
+// Example: status:open AND label:backend AND priority<=1 AND NOT assignee:alice
+//
+// design:, acceptance:, notes: and comment: match a substring of that field,
+// e.g. comment:regression.
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
+)
+
+// Fields lists the field names understood by the query language
+var Fields = []string{"id", "title", "status", "priority", "type", "assignee", "label", "created", "updated", "text", "design", "acceptance", "notes", "comment"}
+
+// fieldAliases maps accepted spellings to canonical field names
+var fieldAliases = map[string]string{
+	"id":          "id",
+	"title":       "title",
+	"status":      "status",
+	"is":          "status",
+	"priority":    "priority",
+	"prio":        "priority",
+	"p":           "priority",
+	"type":        "type",
+	"assignee":    "assignee",
+	"owner":       "assignee",
+	"label":       "label",
+	"labels":      "label",
+	"tag":         "label",
+	"created":     "created",
+	"created_at":  "created",
+	"updated":     "updated",
+	"updated_at":  "updated",
+	"text":        "text",
+	"description": "text",
+	"design":      "design",
+	"acceptance":  "acceptance",
+	"ac":          "acceptance",
+	"notes":       "notes",
+	"comment":     "comment",
+	"comments":    "comment",
+}
+
+// Query is a parsed filter expression
+type Query struct {
+	raw  string
+	root node
+}
+
+// String returns the original expression
+func (q *Query) String() string {
+	return q.raw
+}
+
+// Match reports whether the issue satisfies the query
+func (q *Query) Match(issue *model.Issue) bool {
+	if q == nil || q.root == nil {
+		return true
+	}
+	return q.root.match(issue)
+}
+
+// Filter returns the issues matching the query, preserving order
+func (q *Query) Filter(issues []model.Issue) []model.Issue {
+	var out []model.Issue
+	for i := range issues {
+		if q.Match(&issues[i]) {
+			out = append(out, issues[i])
+		}
+	}
+	return out
+}
+
+// TextTerms returns the lowercased values the query looks for inside issue
+// text (bare words and title:, text:, design:, acceptance:, notes: and
+// comment: terms), for highlighting matches. Negated terms are left out and
+// a trailing "*" wildcard is dropped.
+func (q *Query) TextTerms() []string {
+	if q == nil || q.root == nil {
+		return nil
+	}
+	var terms []string
+	var walk func(n node, negated bool)
+	walk = func(n node, negated bool) {
+		switch n := n.(type) {
+		case andNode:
+			walk(n.left, negated)
+			walk(n.right, negated)
+		case orNode:
+			walk(n.left, negated)
+			walk(n.right, negated)
+		case notNode:
+			walk(n.inner, !negated)
+		case textNode:
+			if !negated {
+				terms = append(terms, n.value)
+			}
+		case stringNode:
+			switch n.field {
+			case "title", "text", "design", "acceptance", "notes", "comment":
+				if v := strings.TrimSuffix(n.value, "*"); !negated && v != "" {
+					terms = append(terms, v)
+				}
+			}
+		}
+	}
+	walk(q.root, false)
+	return terms
+}
+
+// Parse parses a query expression. Relative dates ("created>14d") are
+// resolved against the current time.
+func Parse(input string) (*Query, error) {
+	return ParseAt(input, time.Now())
+}
+
+// ParseAt parses a query expression, resolving relative dates against now
+func ParseAt(input string, now time.Time) (*Query, error) {
+	toks, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return &Query{raw: input}, nil
+	}
+	p := &parser{toks: toks, now: now}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	return &Query{raw: input, root: root}, nil
+}
+
+// LooksLikeQuery reports whether the input uses query syntax (a known field
+// with an operator, or a boolean keyword) rather than being plain search text.
+func LooksLikeQuery(input string) bool {
+	toks, err := lex(input)
+	if err != nil {
+		return false
+	}
+	for i, t := range toks {
+		switch t.kind {
+		case tokAnd, tokOr, tokLParen:
+			return true
+		case tokNot:
+			if t.text != "-" {
+				return true
+			}
+		case tokOp:
+			if i > 0 && toks[i-1].kind == tokWord {
+				if _, ok := fieldAliases[strings.ToLower(toks[i-1].text)]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// Lexer
+// ============================================================================
+
+type tokKind int
+
+const (
+	tokWord tokKind = iota
+	tokString
+	tokOp
+	tokAnd
+	tokOr
+	tokNot
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+}
+
+func lex(input string) ([]token, error) {
+	var toks []token
+	rs := []rune(input)
+	i := 0
+	for i < len(rs) {
+		r := rs[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+		case r == '(':
+			toks = append(toks, token{tokLParen, "(", i})
+			i++
+		case r == ')':
+			toks = append(toks, token{tokRParen, ")", i})
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			i++
+			var sb strings.Builder
+			for i < len(rs) && rs[i] != r {
+				sb.WriteRune(rs[i])
+				i++
+			}
+			if i >= len(rs) {
+				return nil, fmt.Errorf("unterminated quote at position %d", start)
+			}
+			i++
+			toks = append(toks, token{tokString, sb.String(), start})
+		case r == '&' && i+1 < len(rs) && rs[i+1] == '&':
+			toks = append(toks, token{tokAnd, "&&", i})
+			i += 2
+		case r == '|' && i+1 < len(rs) && rs[i+1] == '|':
+			toks = append(toks, token{tokOr, "||", i})
+			i += 2
+		case r == '!' && i+1 < len(rs) && rs[i+1] == '=':
+			toks = append(toks, token{tokOp, "!=", i})
+			i += 2
+		case r == '!':
+			toks = append(toks, token{tokNot, "!", i})
+			i++
+		case r == '-' && (len(toks) == 0 || toks[len(toks)-1].kind != tokOp):
+			toks = append(toks, token{tokNot, "-", i})
+			i++
+		case r == ':' || r == '=':
+			toks = append(toks, token{tokOp, string(r), i})
+			i++
+		case r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(rs) && rs[i+1] == '=' {
+				op += "="
+			}
+			toks = append(toks, token{tokOp, op, i})
+			i += len(op)
+		default:
+			start := i
+			for i < len(rs) && !strings.ContainsRune(" \t\n()\"':=<>!", rs[i]) {
+				// Allow '-' inside words (e.g. bv-123) and '&'/'|' unless doubled
+				if (rs[i] == '&' || rs[i] == '|') && i+1 < len(rs) && rs[i+1] == rs[i] {
+					break
+				}
+				i++
+			}
+			word := string(rs[start:i])
+			switch word {
+			case "AND":
+				toks = append(toks, token{tokAnd, word, start})
+			case "OR":
+				toks = append(toks, token{tokOr, word, start})
+			case "NOT":
+				toks = append(toks, token{tokNot, word, start})
+			default:
+				toks = append(toks, token{tokWord, word, start})
+			}
+		}
+	}
+	return toks, nil
+}
+
+// ============================================================================
+// Parser
+// ============================================================================
+
+type parser struct {
+	toks []token
+	pos  int
+	now  time.Time
+}
+
+func (p *parser) done() bool  { return p.pos >= len(p.toks) }
+func (p *parser) peek() token { return p.toks[p.pos] }
+func (p *parser) next() token { t := p.toks[p.pos]; p.pos++; return t }
+func (p *parser) at(k tokKind) bool {
+	return !p.done() && p.peek().kind == k
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.at(tokOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() {
+		if p.at(tokAnd) {
+			p.next()
+		} else if p.at(tokOr) || p.at(tokRParen) {
+			break
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	if p.at(tokNot) {
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{inner}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.at(tokRParen) {
+			return nil, fmt.Errorf("missing ')' for '(' at position %d", t.pos)
+		}
+		p.next()
+		return inner, nil
+	case tokString:
+		return textNode{strings.ToLower(t.text)}, nil
+	case tokWord:
+		if p.at(tokOp) {
+			op := p.next()
+			if p.done() || (p.peek().kind != tokWord && p.peek().kind != tokString) {
+				return nil, fmt.Errorf("missing value after %s%s", t.text, op.text)
+			}
+			return p.newTerm(t, op, p.next())
+		}
+		return textNode{strings.ToLower(t.text)}, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+	}
+}
+
+func (p *parser) newTerm(field, op, value token) (node, error) {
+	name, ok := fieldAliases[strings.ToLower(field.text)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q (known: %s)", field.text, strings.Join(Fields, ", "))
+	}
+	opText := op.text
+	if opText == "=" {
+		opText = ":"
+	}
+	val := value.text
+
+	switch name {
+	case "priority":
+		n, err := parsePriority(val)
+		if err != nil {
+			return nil, err
+		}
+		return priorityNode{op: opText, value: n}, nil
+	case "created", "updated":
+		t, err := parseDate(val, p.now)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return dateNode{field: name, op: opText, value: t}, nil
+	}
+
+	if opText != ":" && opText != "!=" {
+		return nil, fmt.Errorf("operator %s not supported for %s", op.text, name)
+	}
+	n := stringNode{field: name, value: strings.ToLower(val)}
+	if opText == "!=" {
+		return notNode{n}, nil
+	}
+	return n, nil
+}
+
+// parsePriority accepts "1" or "P1"
+func parsePriority(s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(s), "p"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority %q", s)
+	}
+	return n, nil
+}
+
+// parseDate accepts ISO dates or relative times ("14d", "2w") which resolve
+// to that long before now, so created>14d means "created in the last 14 days".
+func parseDate(s string, now time.Time) (time.Time, error) {
+	return recipe.ParseRelativeTime(s, now)
+}
+
+// ============================================================================
+// AST
+// ============================================================================
+
+type node interface {
+	match(issue *model.Issue) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) match(issue *model.Issue) bool { return n.left.match(issue) && n.right.match(issue) }
+
+type orNode struct{ left, right node }
+
+func (n orNode) match(issue *model.Issue) bool { return n.left.match(issue) || n.right.match(issue) }
+
+type notNode struct{ inner node }
+
+func (n notNode) match(issue *model.Issue) bool { return !n.inner.match(issue) }
+
+// textNode matches a substring of the ID, title, or description
+type textNode struct{ value string }
+
+func (n textNode) match(issue *model.Issue) bool {
+	return strings.Contains(strings.ToLower(issue.ID), n.value) ||
+		strings.Contains(strings.ToLower(issue.Title), n.value) ||
+		strings.Contains(strings.ToLower(issue.Description), n.value)
+}
+
+// stringNode matches a string field; "*" in the value is a prefix wildcard
+type stringNode struct {
+	field string
+	value string
+}
+
+func (n stringNode) match(issue *model.Issue) bool {
+	switch n.field {
+	case "id":
+		return n.equal(issue.ID)
+	case "title":
+		return strings.Contains(strings.ToLower(issue.Title), n.value)
+	case "text":
+		return textNode{n.value}.match(issue)
+	case "design", "acceptance", "notes", "comment":
+		f, _ := search.ParseField(n.field)
+		return strings.Contains(strings.ToLower(search.FieldText(issue, f)), n.value)
+	case "status":
+		return n.equal(string(issue.Status))
+	case "type":
+		return n.equal(string(issue.IssueType))
+	case "assignee":
+		if n.value == "none" {
+			return issue.Assignee == ""
+		}
+		return n.equal(strings.TrimPrefix(issue.Assignee, "@"))
+	case "label":
+		if n.value == "none" {
+			return len(issue.Labels) == 0
+		}
+		for _, l := range issue.Labels {
+			if n.equal(l) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n stringNode) equal(s string) bool {
+	s = strings.ToLower(s)
+	if prefix, ok := strings.CutSuffix(n.value, "*"); ok {
+		return strings.HasPrefix(s, prefix)
+	}
+	return s == n.value
+}
+
+type priorityNode struct {
+	op    string
+	value int
+}
+
+func (n priorityNode) match(issue *model.Issue) bool {
+	return compareInts(issue.Priority, n.op, n.value)
+}
+
+type dateNode struct {
+	field string
+	op    string
+	value time.Time
+}
+
+func (n dateNode) match(issue *model.Issue) bool {
+	t := issue.CreatedAt
+	if n.field == "updated" {
+		t = issue.UpdatedAt
+	}
+	if t.IsZero() {
+		return false
+	}
+	switch n.op {
+	case ":":
+		y1, m1, d1 := t.Date()
+		y2, m2, d2 := n.value.Date()
+		return y1 == y2 && m1 == m2 && d1 == d2
+	case "!=":
+		return !dateNode{n.field, ":", n.value}.match(issue)
+	case "<":
+		return t.Before(n.value)
+	case "<=":
+		return !t.After(n.value)
+	case ">":
+		return t.After(n.value)
+	case ">=":
+		return !t.Before(n.value)
+	}
+	return false
+}
+
+func compareInts(a int, op string, b int) bool {
+	switch op {
+	case ":":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package query
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+var now = time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+func testIssues() []model.Issue {
+	return []model.Issue{
+		{ID: "bv-1", Title: "Login API", Status: model.StatusOpen, Priority: 0, IssueType: model.TypeBug,
+			Assignee: "bob", Labels: []string{"backend", "auth"}, CreatedAt: now.AddDate(0, 0, -3),
+			Comments: []*model.Comment{{Text: "Regression since the token change"}}},
+		{ID: "bv-2", Title: "Login page", Status: model.StatusInProgress, Priority: 1, IssueType: model.TypeFeature,
+			Assignee: "alice", Labels: []string{"frontend"}, CreatedAt: now.AddDate(0, 0, -30)},
+		{ID: "bv-3", Title: "Cache layer", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask,
+			Assignee: "alice", Labels: []string{"backend"}, CreatedAt: now.AddDate(0, -3, 0),
+			Notes: "Redis or in-process?", AcceptanceCriteria: "p99 under 5ms"},
+		{ID: "bv-4", Title: "Old cleanup", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeChore,
+			CreatedAt: now.AddDate(-1, 0, 0)},
+	}
+}
+
+func matchIDs(t *testing.T, expr string) []string {
+	t.Helper()
+	q, err := ParseAt(expr, now)
+	if err != nil {
+		t.Fatalf("ParseAt(%q): %v", expr, err)
+	}
+	var ids []string
+	for _, issue := range q.Filter(testIssues()) {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
+
+func TestQueryMatching(t *testing.T) {
+	cases := []struct {
+		expr string
+		want []string
+	}{
+		{"status:open AND label:backend AND priority<=1 AND NOT assignee:alice", []string{"bv-1"}},
+		{"status:open label:backend", []string{"bv-1", "bv-3"}},
+		{"label:frontend OR priority:p3", []string{"bv-2", "bv-4"}},
+		{"(status:open OR status:in_progress) AND -assignee:bob", []string{"bv-2", "bv-3"}},
+		{"status!=closed AND type:bug", []string{"bv-1"}},
+		{"priority>1", []string{"bv-3", "bv-4"}},
+		{"created>14d", []string{"bv-1"}},
+		{"created<2025-01-01", []string{"bv-4"}},
+		{"login NOT page", []string{"bv-1"}},
+		{`title:"login page"`, []string{"bv-2"}},
+		{"id:bv-* AND assignee:none", []string{"bv-4"}},
+		{"label:back*", []string{"bv-1", "bv-3"}},
+		{"STATUS:OPEN && !label:auth", []string{"bv-3"}},
+		{"comment:regression", []string{"bv-1"}},
+		{"notes:redis OR ac:p99", []string{"bv-3"}},
+		{"", []string{"bv-1", "bv-2", "bv-3", "bv-4"}},
+	}
+	for _, tc := range cases {
+		got := matchIDs(t, tc.expr)
+		if len(got) != len(tc.want) {
+			t.Errorf("%q: got %v, want %v", tc.expr, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%q: got %v, want %v", tc.expr, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"status:open AND",
+		"(status:open",
+		"bogus:1",
+		"priority:high",
+		"assignee<bob",
+		`title:"unterminated`,
+		"status:",
+		"created>yesterday",
+	} {
+		if _, err := ParseAt(expr, now); err == nil {
+			t.Errorf("Expected parse error for %q", expr)
+		}
+	}
+}
+
+func TestTextTerms(t *testing.T) {
+	q, err := ParseAt(`login status:open (title:"sign in" OR comment:regress*) NOT page -text:draft`, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(q.TextTerms(), "|")
+	if want := "login|sign in|regress"; got != want {
+		t.Errorf("TextTerms() = %q, want %q", got, want)
+	}
+}
+
+func TestLooksLikeQuery(t *testing.T) {
+	for expr, want := range map[string]bool{
+		"status:open":          true,
+		"priority<=1":          true,
+		"comment:regression":   true,
+		"login AND page":       true,
+		"NOT blocked":          true,
+		"(a)":                  true,
+		"login page":           false,
+		"bv-123":               false,
+		"http://example.com":   false,
+		"fix the -v flag":      false,
+		"note: something else": false,
+		"fix or remove":        false,
+		"not now and later":    false,
+	} {
+		if got := LooksLikeQuery(expr); got != want {
+			t.Errorf("LooksLikeQuery(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Package readonly is the process-wide guard behind --read-only, for shared
+// terminals and demos. bv writes files only through the helpers here and
+// asks Check before other side effects (editors, hooks, gh, the SQLite
+// store, digest mail), so enabling the guard covers every mutation path at
+// once. A test rejects direct os write calls elsewhere, and database opens
+// or SMTP sends in files that never ask Check, so new features can't
+// bypass it.
+package readonly
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+)
+
+// ErrReadOnly is returned for mutations refused in read-only mode
+var ErrReadOnly = errors.New("bv is in read-only mode")
+
+var enabled atomic.Bool
+
+// Set turns read-only mode on or off for the whole process. bv sets it once
+// at startup; tests turn it off again.
+func Set(on bool) {
+	enabled.Store(on)
+}
+
+// Enabled reports whether read-only mode is on
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Check returns an error wrapping ErrReadOnly if read-only mode is on.
+// action describes the refused mutation, e.g. "launching editor".
+func Check(action string) error {
+	if !Enabled() {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", action, ErrReadOnly)
+}
+
+// refuse returns a *os.PathError for op on path if read-only mode is on
+func refuse(op, path string) error {
+	if !Enabled() {
+		return nil
+	}
+	return &os.PathError{Op: op, Path: path, Err: ErrReadOnly}
+}
+
+// WriteFile is os.WriteFile, refused in read-only mode
+func WriteFile(name string, data []byte, perm os.FileMode) error {
+	if err := refuse("write", name); err != nil {
+		return err
+	}
+	return os.WriteFile(name, data, perm)
+}
+
+// Create is os.Create, refused in read-only mode
+func Create(name string) (*os.File, error) {
+	if err := refuse("create", name); err != nil {
+		return nil, err
+	}
+	return os.Create(name)
+}
+
+// CreateTemp is os.CreateTemp, refused in read-only mode
+func CreateTemp(dir, pattern string) (*os.File, error) {
+	if err := refuse("createtemp", dir); err != nil {
+		return nil, err
+	}
+	return os.CreateTemp(dir, pattern)
+}
+
+// OpenFile is os.OpenFile for writing, refused in read-only mode
+func OpenFile(name string, flag int, perm os.FileMode) (*os.File, error) {
+	if err := refuse("open", name); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(name, flag, perm)
+}
+
+// MkdirAll is os.MkdirAll, refused in read-only mode
+func MkdirAll(path string, perm os.FileMode) error {
+	if err := refuse("mkdir", path); err != nil {
+		return err
+	}
+	return os.MkdirAll(path, perm)
+}
+
+// Remove is os.Remove, refused in read-only mode
+func Remove(name string) error {
+	if err := refuse("remove", name); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+// RemoveAll is os.RemoveAll, refused in read-only mode
+func RemoveAll(path string) error {
+	if err := refuse("remove", path); err != nil {
+		return err
+	}
+	return os.RemoveAll(path)
+}
+
+// Rename is os.Rename, refused in read-only mode
+func Rename(oldpath, newpath string) error {
+	if err := refuse("rename", oldpath); err != nil {
+		return err
+	}
+	return os.Rename(oldpath, newpath)
+}
+
+// Chmod is os.Chmod, refused in read-only mode
+func Chmod(name string, mode os.FileMode) error {
+	if err := refuse("chmod", name); err != nil {
+		return err
+	}
+	return os.Chmod(name, mode)
+}
+
+// MkdirTemp is os.MkdirTemp, refused in read-only mode
+func MkdirTemp(dir, pattern string) (string, error) {
+	if err := refuse("mkdirtemp", dir); err != nil {
+		return "", err
+	}
+	return os.MkdirTemp(dir, pattern)
+}
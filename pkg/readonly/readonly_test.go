@@ -0,0 +1,111 @@
+package readonly
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGuard(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+
+	if err := Check("anything"); err != nil {
+		t.Fatalf("Check while off: %v", err)
+	}
+	if err := WriteFile(path, []byte("a"), 0644); err != nil {
+		t.Fatalf("WriteFile while off: %v", err)
+	}
+
+	Set(true)
+	defer Set(false)
+	if !Enabled() {
+		t.Fatal("Enabled() = false after Set(true)")
+	}
+	if err := Check("launching editor"); !errors.Is(err, ErrReadOnly) || !strings.HasPrefix(err.Error(), "launching editor: ") {
+		t.Errorf("Check = %v, want launching editor: %v", err, ErrReadOnly)
+	}
+
+	refused := map[string]error{
+		"WriteFile": WriteFile(path, []byte("b"), 0644),
+		"MkdirAll":  MkdirAll(filepath.Join(dir, "sub"), 0755),
+		"Remove":    Remove(path),
+		"RemoveAll": RemoveAll(dir),
+		"Rename":    Rename(path, path+".bak"),
+		"Chmod":     Chmod(path, 0600),
+	}
+	_, err := Create(path)
+	refused["Create"] = err
+	_, err = CreateTemp(dir, "x-*")
+	refused["CreateTemp"] = err
+	_, err = OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	refused["OpenFile"] = err
+	_, err = MkdirTemp(dir, "x-*")
+	refused["MkdirTemp"] = err
+	for name, err := range refused {
+		var pathErr *os.PathError
+		if !errors.Is(err, ErrReadOnly) || !errors.As(err, &pathErr) {
+			t.Errorf("%s = %v, want a *os.PathError wrapping ErrReadOnly", name, err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "a" {
+		t.Errorf("file changed in read-only mode: %q, %v", data, err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("read-only mode created files: %v", entries)
+	}
+}
+
+// directWrite matches os calls that change the filesystem; bv code must use
+// this package's guarded versions instead
+var directWrite = regexp.MustCompile(`\bos\.(WriteFile|Create|CreateTemp|OpenFile|Mkdir|MkdirAll|MkdirTemp|Remove|RemoveAll|Rename|Chmod|Symlink|Link|Truncate)\(`)
+
+// sideEffect matches calls that write or send outside the os package: opening
+// a database and sending mail. Files making them must ask Check first.
+var sideEffect = regexp.MustCompile(`\b(sql\.Open|smtp\.(SendMail|Dial|NewClient))\b`)
+
+func TestNoUnguardedWrites(t *testing.T) {
+	root := filepath.Join("..", "..")
+	for _, top := range []string{"cmd", "pkg", "internal"} {
+		err := filepath.WalkDir(filepath.Join(root, top), func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				switch d.Name() {
+				case "readonly", "testutil", "testdata":
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			guarded := strings.Contains(string(data), "readonly.Check(")
+			for i, line := range strings.Split(string(data), "\n") {
+				if strings.HasPrefix(strings.TrimSpace(line), "//") {
+					continue
+				}
+				if directWrite.MatchString(line) {
+					t.Errorf("%s:%d: use the pkg/readonly helper so --read-only covers it: %s", path, i+1, strings.TrimSpace(line))
+				}
+				if sideEffect.MatchString(line) && !guarded {
+					t.Errorf("%s:%d: call readonly.Check before this so --read-only covers it: %s", path, i+1, strings.TrimSpace(line))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 // Recipe defines a reusable view configuration for beads
@@ -32,11 +34,14 @@ type FilterConfig struct {
 	Actionable    *bool    `yaml:"actionable,omitempty" json:"actionable,omitempty"`         // true = no open blockers
 	TitleContains string   `yaml:"title_contains,omitempty" json:"title_contains,omitempty"` // Substring match
 	IDPrefix      string   `yaml:"id_prefix,omitempty" json:"id_prefix,omitempty"`           // e.g., "bv-" for project filtering
+	Query         string   `yaml:"query,omitempty" json:"query,omitempty"`                   // Filter expression, e.g. "label:api AND priority<=1"
+	Overdue       *bool    `yaml:"overdue,omitempty" json:"overdue,omitempty"`               // true = open and past due, false = not overdue
+	DueWithin     string   `yaml:"due_within,omitempty" json:"due_within,omitempty"`         // Open issues due by then, overdue included: "7d" ahead or ISO date
 }
 
 // SortConfig defines how to order issues
 type SortConfig struct {
-	Field     string      `yaml:"field" json:"field"`                             // priority, created, updated, title, id, pagerank, betweenness
+	Field     string      `yaml:"field" json:"field"`                             // priority, created, updated, due, title, id, pagerank, betweenness
 	Direction string      `yaml:"direction,omitempty" json:"direction,omitempty"` // asc, desc (default: asc for priority, desc for dates)
 	Secondary *SortConfig `yaml:"secondary,omitempty" json:"secondary,omitempty"` // Tie-breaker
 }
@@ -74,20 +79,8 @@ func ParseRelativeTime(s string, now time.Time) (time.Time, error) {
 	s = strings.TrimSpace(s)
 
 	// Try relative time first (case-insensitive)
-	if matches := relativeTimePattern.FindStringSubmatch(strings.ToLower(s)); matches != nil {
-		n, _ := strconv.Atoi(matches[1])
-		unit := matches[2]
-
-		switch unit {
-		case "d":
-			return now.AddDate(0, 0, -n), nil
-		case "w":
-			return now.AddDate(0, 0, -n*7), nil
-		case "m":
-			return now.AddDate(0, -n, 0), nil
-		case "y":
-			return now.AddDate(-n, 0, 0), nil
-		}
+	if t, ok := shiftRelative(s, now, -1); ok {
+		return t, nil
 	}
 
 	// Try ISO 8601 formats (preserve case for parsing)
@@ -109,6 +102,72 @@ func ParseRelativeTime(s string, now time.Time) (time.Time, error) {
 	return time.Time{}, &TimeParseError{Input: s}
 }
 
+// ParseDueWithin converts a due_within value to a deadline. Unlike
+// ParseRelativeTime, a relative time counts forward: "7d" is a week from now.
+func ParseDueWithin(s string, now time.Time) (time.Time, error) {
+	if t, ok := shiftRelative(strings.TrimSpace(s), now, 1); ok {
+		return t, nil
+	}
+	return ParseRelativeTime(s, now)
+}
+
+// shiftRelative moves now by a relative time like "14d" in the direction of
+// sign. It reports false if s is not a relative time.
+func shiftRelative(s string, now time.Time, sign int) (time.Time, bool) {
+	matches := relativeTimePattern.FindStringSubmatch(strings.ToLower(s))
+	if matches == nil {
+		return time.Time{}, false
+	}
+	n, _ := strconv.Atoi(matches[1])
+	n *= sign
+	switch matches[2] {
+	case "d":
+		return now.AddDate(0, 0, n), true
+	case "w":
+		return now.AddDate(0, 0, n*7), true
+	case "m":
+		return now.AddDate(0, n, 0), true
+	default: // "y"
+		return now.AddDate(n, 0, 0), true
+	}
+}
+
+// MatchesDue reports whether issue passes the overdue and due_within
+// filters. An unparseable due_within is ignored, like the date filters.
+func (f FilterConfig) MatchesDue(issue *model.Issue, now time.Time) bool {
+	state := issue.DueStateAt(now)
+	if f.Overdue != nil && *f.Overdue != (state == model.DueOverdue) {
+		return false
+	}
+	if f.DueWithin != "" {
+		deadline, err := ParseDueWithin(f.DueWithin, now)
+		if err == nil && (state == model.DueNone || issue.DueDate.After(deadline)) {
+			return false
+		}
+	}
+	return true
+}
+
+// DueLess orders issues by due date, soonest first, with undated issues last
+func DueLess(a, b *model.Issue) bool {
+	switch {
+	case b.DueDate == nil:
+		return a.DueDate != nil
+	case a.DueDate == nil:
+		return false
+	}
+	return a.DueDate.Before(*b.DueDate)
+}
+
+// DueLessDesc orders issues by due date, latest first, still with undated
+// issues last
+func DueLessDesc(a, b *model.Issue) bool {
+	if a.DueDate == nil || b.DueDate == nil {
+		return DueLess(a, b)
+	}
+	return b.DueDate.Before(*a.DueDate)
+}
+
 // TimeParseError indicates a time parsing failure
 type TimeParseError struct {
 	Input string
@@ -117,4 +176,3 @@ type TimeParseError struct {
 func (e *TimeParseError) Error() string {
 	return "invalid time format: " + e.Input + " (expected relative like '14d', '2w', '1m' or ISO date)"
 }
-
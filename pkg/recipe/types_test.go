@@ -1,9 +1,11 @@
 package recipe_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 )
 
@@ -153,3 +155,52 @@ func TestRecipeStructTags(t *testing.T) {
 		t.Error("Filters.Status should not be nil")
 	}
 }
+
+func TestDueFiltersAndSort(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	if got, _ := recipe.ParseDueWithin("1w", now); !got.Equal(now.AddDate(0, 0, 7)) {
+		t.Errorf("ParseDueWithin(1w) = %v, want a week ahead", got)
+	}
+	if got, _ := recipe.ParseDueWithin("2025-02-01", now); got.Format("2006-01-02") != "2025-02-01" {
+		t.Errorf("ParseDueWithin(ISO) = %v", got)
+	}
+
+	in := func(days int) *time.Time { t := now.AddDate(0, 0, days); return &t }
+	late := model.Issue{ID: "late", Status: model.StatusOpen, DueDate: in(-1)}
+	soon := model.Issue{ID: "soon", Status: model.StatusOpen, DueDate: in(5)}
+	far := model.Issue{ID: "far", Status: model.StatusOpen, DueDate: in(30)}
+	undated := model.Issue{ID: "undated", Status: model.StatusOpen}
+
+	yes, no := true, false
+	tests := []struct {
+		name   string
+		filter recipe.FilterConfig
+		want   string
+	}{
+		{"overdue", recipe.FilterConfig{Overdue: &yes}, "late"},
+		{"not overdue", recipe.FilterConfig{Overdue: &no}, "soon,far,undated"},
+		{"due within", recipe.FilterConfig{DueWithin: "7d"}, "late,soon"},
+		{"due within, not overdue", recipe.FilterConfig{DueWithin: "7d", Overdue: &no}, "soon"},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, issue := range []model.Issue{late, soon, far, undated} {
+			if tt.filter.MatchesDue(&issue, now) {
+				got = append(got, issue.ID)
+			}
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("%s: matched %v, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if !recipe.DueLess(&late, &soon) || recipe.DueLess(&soon, &late) {
+		t.Error("DueLess should put the earlier due date first")
+	}
+	if !recipe.DueLess(&far, &undated) || recipe.DueLess(&undated, &far) || recipe.DueLess(&undated, &undated) {
+		t.Error("DueLess should put undated issues last")
+	}
+	if !recipe.DueLessDesc(&soon, &late) || !recipe.DueLessDesc(&late, &undated) || recipe.DueLessDesc(&undated, &late) {
+		t.Error("DueLessDesc should put the later due date first and undated issues last")
+	}
+}
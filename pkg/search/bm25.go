@@ -0,0 +1,140 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// BM25 parameters: K1 caps how much repeating a term helps, B how much
+// long documents are penalized
+const (
+	BM25K1 = 1.2
+	BM25B  = 0.75
+)
+
+// BM25Index is a lexical index over issues, scored with Okapi BM25. Term
+// frequencies are weighted by field boost (a title hit counts as three
+// description hits by default), a simplified BM25F.
+type BM25Index struct {
+	postings  map[string]map[string]float64 // Term -> issue ID -> weighted frequency
+	lengths   map[string]float64            // Issue ID -> weighted length
+	avgLength float64
+}
+
+// NewBM25Index indexes every SearchFields field of the issues. Nil boosts
+// uses DefaultFieldBoosts.
+func NewBM25Index(issues []model.Issue, boosts map[Field]float64) *BM25Index {
+	if boosts == nil {
+		boosts = DefaultFieldBoosts
+	}
+	ix := &BM25Index{
+		postings: make(map[string]map[string]float64),
+		lengths:  make(map[string]float64, len(issues)),
+	}
+	var total float64
+	for i := range issues {
+		issue := &issues[i]
+		if issue.ID == "" {
+			continue
+		}
+		var length float64
+		for _, f := range SearchFields {
+			boost := boosts[f]
+			if boost <= 0 {
+				continue
+			}
+			for _, term := range Tokenize(FieldText(issue, f)) {
+				docs := ix.postings[term]
+				if docs == nil {
+					docs = make(map[string]float64)
+					ix.postings[term] = docs
+				}
+				docs[issue.ID] += boost
+				length += boost
+			}
+		}
+		ix.lengths[issue.ID] = length
+		total += length
+	}
+	if len(ix.lengths) > 0 {
+		ix.avgLength = total / float64(len(ix.lengths))
+	}
+	return ix
+}
+
+// Tokenize splits text into lowercased runs of letters and digits, dropping
+// single characters
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	out := fields[:0]
+	for _, f := range fields {
+		if utf8.RuneCountInString(f) >= 2 {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Len returns the number of indexed issues
+func (ix *BM25Index) Len() int {
+	return len(ix.lengths)
+}
+
+// Scores returns the BM25 score of every issue containing at least one
+// query term. A term repeated in the query counts once.
+func (ix *BM25Index) Scores(query string) map[string]float64 {
+	scores := make(map[string]float64)
+	n := float64(len(ix.lengths))
+	if n == 0 || ix.avgLength == 0 {
+		return scores
+	}
+	seen := make(map[string]bool)
+	for _, term := range Tokenize(query) {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		docs := ix.postings[term]
+		if len(docs) == 0 {
+			continue
+		}
+		df := float64(len(docs))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range docs {
+			norm := BM25K1 * (1 - BM25B + BM25B*ix.lengths[id]/ix.avgLength)
+			scores[id] += idf * tf * (BM25K1 + 1) / (tf + norm)
+		}
+	}
+	return scores
+}
+
+// Search returns the best matches for query, best first (ties by ID),
+// keeping at most limit results (<= 0 keeps all)
+func (ix *BM25Index) Search(query string, limit int) []SearchResult {
+	return rankScores(ix.Scores(query), limit)
+}
+
+// rankScores sorts scores into results, best first with ties by ID
+func rankScores(scores map[string]float64, limit int) []SearchResult {
+	results := make([]SearchResult, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, SearchResult{IssueID: id, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].IssueID < results[j].IssueID
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
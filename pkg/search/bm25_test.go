@@ -0,0 +1,61 @@
+package search
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("Fix OAuth2 login-flow, a v2 bug!")
+	want := []string{"fix", "oauth2", "login", "flow", "v2", "bug"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestBM25Index_Search(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Login fails with OAuth", Description: "Users cannot sign in"},
+		{ID: "B", Title: "Dashboard", Description: "The login page link on the dashboard is broken"},
+		{ID: "C", Title: "Export", Description: "CSV export is slow"},
+		{ID: "D", Title: "Login", Description: "login login login retry loop"},
+		{ID: ""},
+	}
+	ix := NewBM25Index(issues, nil)
+	if ix.Len() != 4 {
+		t.Fatalf("Expected 4 indexed issues, got %d", ix.Len())
+	}
+
+	results := ix.Search("login", 0)
+	var ids []string
+	for _, r := range results {
+		ids = append(ids, r.IssueID)
+	}
+	if len(ids) != 3 || slices.Contains(ids, "C") {
+		t.Fatalf("Expected A, B and D to match login, got %v", ids)
+	}
+	if ids[2] != "B" {
+		t.Errorf("Expected the description-only match B last, got %v", ids)
+	}
+
+	if got := ix.Search("oauth login", 1); len(got) != 1 || got[0].IssueID != "A" {
+		t.Errorf("Expected A to rank first for both terms, got %+v", got)
+	}
+	if got := ix.Search("nothing matches", 0); len(got) != 0 {
+		t.Errorf("Expected no results, got %+v", got)
+	}
+}
+
+func TestBM25Index_RareTermsWeighMore(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "cache bug"},
+		{ID: "B", Title: "parser bug"},
+		{ID: "C", Title: "render bug"},
+	}
+	scores := NewBM25Index(issues, nil).Scores("cache bug")
+	if scores["A"] <= scores["B"] || scores["B"] != scores["C"] {
+		t.Errorf("Expected the rare term to lift A only, got %v", scores)
+	}
+}
@@ -0,0 +1,129 @@
+package search
+
+import "sort"
+
+// DefaultClusterThreshold is the cosine similarity above which two issues are
+// considered near-duplicates for clustering.
+const DefaultClusterThreshold = 0.8
+
+// MaxClusterCandidates bounds the number of issues compared pairwise.
+// Clustering is quadratic, so callers should trim larger candidate sets.
+const MaxClusterCandidates = 2000
+
+// SimilarityCluster is a group of issues whose embeddings are linked by
+// pairwise similarity at or above the clustering threshold.
+type SimilarityCluster struct {
+	IDs            []string `json:"ids"`
+	MeanSimilarity float64  `json:"mean_similarity"`
+}
+
+// SimilarityClusters groups the given issues by single-linkage over pairs
+// whose similarity is at least threshold. IDs missing from the index are
+// ignored, and clusters smaller than minSize are dropped. Clusters are
+// returned largest first, then by mean similarity of their linking pairs.
+func SimilarityClusters(idx *VectorIndex, ids []string, threshold float64, minSize int) []SimilarityCluster {
+	if idx == nil || len(ids) < 2 {
+		return nil
+	}
+	if minSize < 2 {
+		minSize = 2
+	}
+
+	members, vectors := indexedVectors(idx, ids)
+
+	parent := make([]int, len(members))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	type linkStats struct {
+		sum   float64
+		count int
+	}
+	links := make(map[int]*linkStats)
+	type edge struct {
+		a, b  int
+		score float64
+	}
+	var edges []edge
+	for i := 0; i < len(members); i++ {
+		for j := i + 1; j < len(members); j++ {
+			score := dotFloat32(vectors[i], vectors[j])
+			if score < threshold {
+				continue
+			}
+			edges = append(edges, edge{i, j, score})
+			if ri, rj := find(i), find(j); ri != rj {
+				parent[rj] = ri
+			}
+		}
+	}
+	for _, e := range edges {
+		root := find(e.a)
+		s := links[root]
+		if s == nil {
+			s = &linkStats{}
+			links[root] = s
+		}
+		s.sum += e.score
+		s.count++
+	}
+
+	groups := make(map[int][]string)
+	for i, id := range members {
+		root := find(i)
+		groups[root] = append(groups[root], id)
+	}
+
+	var clusters []SimilarityCluster
+	for root, group := range groups {
+		if len(group) < minSize {
+			continue
+		}
+		sort.Strings(group)
+		s := links[root]
+		clusters = append(clusters, SimilarityCluster{
+			IDs:            group,
+			MeanSimilarity: s.sum / float64(s.count),
+		})
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		a, b := clusters[i], clusters[j]
+		if len(a.IDs) != len(b.IDs) {
+			return len(a.IDs) > len(b.IDs)
+		}
+		if a.MeanSimilarity != b.MeanSimilarity {
+			return a.MeanSimilarity > b.MeanSimilarity
+		}
+		return a.IDs[0] < b.IDs[0]
+	})
+	return clusters
+}
+
+// indexedVectors returns the distinct ids that have vectors in idx, with
+// their vectors
+func indexedVectors(idx *VectorIndex, ids []string) ([]string, [][]float32) {
+	seen := make(map[string]bool, len(ids))
+	members := make([]string, 0, len(ids))
+	vectors := make([][]float32, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		entry, ok := idx.Get(id)
+		if !ok || len(entry.Vector) == 0 {
+			continue
+		}
+		members = append(members, id)
+		vectors = append(vectors, entry.Vector)
+	}
+	return members, vectors
+}
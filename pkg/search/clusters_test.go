@@ -0,0 +1,48 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSimilarityClusters(t *testing.T) {
+	idx := NewVectorIndex(3)
+	vectors := map[string][]float32{
+		"A": {1, 0, 0},
+		"B": {0.9, 0.4359, 0},
+		"C": {0.6, 0.8, 0}, // linked to A only through B
+		"D": {0, 0, 1},
+		"E": {0, 0.6, 0.8},
+		"F": {-1, 0, 0},
+	}
+	for id, vec := range vectors {
+		if err := idx.Upsert(id, ComputeContentHash(id), vec); err != nil {
+			t.Fatalf("Upsert failed: %v", err)
+		}
+	}
+
+	ids := []string{"F", "E", "D", "C", "B", "A", "A", "Z"}
+	clusters := SimilarityClusters(idx, ids, DefaultClusterThreshold, 2)
+	if len(clusters) != 2 {
+		t.Fatalf("Expected 2 clusters, got %+v", clusters)
+	}
+	if got := strings.Join(clusters[0].IDs, ","); got != "A,B,C" {
+		t.Errorf("Largest cluster = %s", got)
+	}
+	if s := clusters[0].MeanSimilarity; s < 0.89 || s > 0.9 {
+		t.Errorf("Mean similarity = %f, want the mean of the A-B and B-C links", s)
+	}
+	if got := strings.Join(clusters[1].IDs, ","); got != "D,E" {
+		t.Errorf("Second cluster = %s", got)
+	}
+
+	if clusters := SimilarityClusters(idx, ids, DefaultClusterThreshold, 3); len(clusters) != 1 {
+		t.Errorf("minSize 3 should keep only A,B,C, got %+v", clusters)
+	}
+	if clusters := SimilarityClusters(idx, ids, 0.95, 2); len(clusters) != 0 {
+		t.Errorf("Nothing is that similar, got %+v", clusters)
+	}
+	if clusters := SimilarityClusters(nil, ids, DefaultClusterThreshold, 2); clusters != nil {
+		t.Errorf("Nil index should give no clusters, got %+v", clusters)
+	}
+}
@@ -6,18 +6,17 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
-// IssueDocument returns the default text representation used for semantic indexing.
-// For bv-9gf.3 we intentionally keep this minimal (title + description) for predictability.
+// IssueDocument returns the text representation used for semantic indexing:
+// the title and description, then design, acceptance criteria, notes, labels
+// and comments, one non-empty field per line.
 func IssueDocument(issue model.Issue) string {
-	title := strings.TrimSpace(issue.Title)
-	desc := strings.TrimSpace(issue.Description)
-	if title == "" {
-		return desc
-	}
-	if desc == "" {
-		return title
+	parts := make([]string, 0, len(SearchFields))
+	for _, f := range SearchFields {
+		if text := FieldText(&issue, f); text != "" {
+			parts = append(parts, text)
+		}
 	}
-	return title + "\n" + desc
+	return strings.Join(parts, "\n")
 }
 
 // DocumentsFromIssues builds an ID->document map suitable for indexing.
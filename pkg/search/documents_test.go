@@ -114,6 +114,19 @@ Line 3`,
 			},
 			expected: "Title\nwith\nnewlines\nDesc",
 		},
+		{
+			name: "all searchable fields",
+			issue: model.Issue{
+				Title:              "Login fails",
+				Description:        "Mobile only",
+				Design:             "Retry the token refresh",
+				AcceptanceCriteria: "User stays logged in",
+				Notes:              "  ",
+				Labels:             []string{"auth", "mobile"},
+				Comments:           []*model.Comment{{Text: "Seen again"}, nil, {Text: " "}},
+			},
+			expected: "Login fails\nMobile only\nRetry the token refresh\nUser stays logged in\nauth mobile\nSeen again",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,51 @@
+package search
+
+import "sort"
+
+// DuplicatePair is two issues whose embeddings are similar enough that one
+// is probably a duplicate of the other
+type DuplicatePair struct {
+	A          string  `json:"a"`
+	B          string  `json:"b"`
+	Similarity float64 `json:"similarity"`
+}
+
+// DuplicatePairs returns the pairs among ids whose similarity is at least
+// threshold, most similar first (ties by ID). A is always the smaller ID.
+// IDs missing from the index are ignored; limit <= 0 returns every pair.
+// Like SimilarityClusters this is quadratic, so trim ids to
+// MaxClusterCandidates.
+func DuplicatePairs(idx *VectorIndex, ids []string, threshold float64, limit int) []DuplicatePair {
+	if idx == nil || len(ids) < 2 {
+		return nil
+	}
+	members, vectors := indexedVectors(idx, ids)
+
+	var pairs []DuplicatePair
+	for i := 0; i < len(members); i++ {
+		for j := i + 1; j < len(members); j++ {
+			score := dotFloat32(vectors[i], vectors[j])
+			if score < threshold {
+				continue
+			}
+			a, b := members[i], members[j]
+			if b < a {
+				a, b = b, a
+			}
+			pairs = append(pairs, DuplicatePair{A: a, B: b, Similarity: score})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
+		}
+		return pairs[i].B < pairs[j].B
+	})
+	if limit > 0 && len(pairs) > limit {
+		pairs = pairs[:limit]
+	}
+	return pairs
+}
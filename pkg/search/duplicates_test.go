@@ -0,0 +1,40 @@
+package search
+
+import "testing"
+
+func TestDuplicatePairs(t *testing.T) {
+	idx := NewVectorIndex(3)
+	vectors := map[string][]float32{
+		"A": {1, 0, 0},
+		"B": {0.9, 0.4359, 0},
+		"C": {0.6, 0.8, 0},
+		"D": {0, 0, 1},
+		"E": {0, 0.6, 0.8},
+	}
+	for id, vec := range vectors {
+		if err := idx.Upsert(id, ComputeContentHash(id), vec); err != nil {
+			t.Fatalf("Upsert failed: %v", err)
+		}
+	}
+
+	pairs := DuplicatePairs(idx, []string{"E", "D", "C", "B", "A", "A", "Z"}, DefaultClusterThreshold, 0)
+	want := []string{"A-B", "B-C", "D-E"}
+	if len(pairs) != len(want) {
+		t.Fatalf("Expected %d pairs, got %+v", len(want), pairs)
+	}
+	for i, p := range pairs {
+		if got := p.A + "-" + p.B; got != want[i] {
+			t.Errorf("Pair %d = %s, want %s", i, got, want[i])
+		}
+		if i > 0 && p.Similarity > pairs[i-1].Similarity {
+			t.Errorf("Pairs should be sorted by similarity, got %+v", pairs)
+		}
+	}
+
+	if pairs := DuplicatePairs(idx, []string{"A", "B", "C", "D", "E"}, DefaultClusterThreshold, 1); len(pairs) != 1 || pairs[0].A != "A" {
+		t.Errorf("Limit 1 should keep only the closest pair, got %+v", pairs)
+	}
+	if pairs := DuplicatePairs(nil, []string{"A", "B"}, DefaultClusterThreshold, 0); pairs != nil {
+		t.Errorf("Nil index should give no pairs, got %+v", pairs)
+	}
+}
@@ -0,0 +1,220 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Field identifies a searchable part of an issue
+type Field string
+
+const (
+	FieldTitle       Field = "title"
+	FieldDescription Field = "description"
+	FieldDesign      Field = "design"
+	FieldAcceptance  Field = "acceptance"
+	FieldNotes       Field = "notes"
+	FieldLabels      Field = "labels"
+	FieldComments    Field = "comments"
+)
+
+// SearchFields lists the indexed fields in document order
+var SearchFields = []Field{FieldTitle, FieldDescription, FieldDesign, FieldAcceptance, FieldNotes, FieldLabels, FieldComments}
+
+// DefaultFieldBoosts weights a match by the field it is in: a title hit
+// counts three times a description hit, while chatty comments count less.
+var DefaultFieldBoosts = map[Field]float64{
+	FieldTitle:       3.0,
+	FieldLabels:      2.0,
+	FieldDescription: 1.0,
+	FieldAcceptance:  1.0,
+	FieldDesign:      0.8,
+	FieldNotes:       0.8,
+	FieldComments:    0.6,
+}
+
+// fieldAliases maps accepted spellings in "field:value" terms to fields
+var fieldAliases = map[string]Field{
+	"title":               FieldTitle,
+	"desc":                FieldDescription,
+	"description":         FieldDescription,
+	"design":              FieldDesign,
+	"acceptance":          FieldAcceptance,
+	"acceptance_criteria": FieldAcceptance,
+	"ac":                  FieldAcceptance,
+	"notes":               FieldNotes,
+	"note":                FieldNotes,
+	"label":               FieldLabels,
+	"labels":              FieldLabels,
+	"comment":             FieldComments,
+	"comments":            FieldComments,
+}
+
+// ParseField resolves a field name or alias such as "comment" or "ac"
+func ParseField(name string) (Field, bool) {
+	f, ok := fieldAliases[strings.ToLower(name)]
+	return f, ok
+}
+
+// FieldText returns one field of the issue as plain text. Labels are joined
+// with spaces and comments with newlines.
+func FieldText(issue *model.Issue, f Field) string {
+	switch f {
+	case FieldTitle:
+		return strings.TrimSpace(issue.Title)
+	case FieldDescription:
+		return strings.TrimSpace(issue.Description)
+	case FieldDesign:
+		return strings.TrimSpace(issue.Design)
+	case FieldAcceptance:
+		return strings.TrimSpace(issue.AcceptanceCriteria)
+	case FieldNotes:
+		return strings.TrimSpace(issue.Notes)
+	case FieldLabels:
+		return strings.Join(issue.Labels, " ")
+	case FieldComments:
+		texts := make([]string, 0, len(issue.Comments))
+		for _, c := range issue.Comments {
+			if c == nil {
+				continue
+			}
+			if text := strings.TrimSpace(c.Text); text != "" {
+				texts = append(texts, text)
+			}
+		}
+		return strings.Join(texts, "\n")
+	}
+	return ""
+}
+
+// TextTerm is one word or quoted phrase of a full-text query, optionally
+// limited to a field
+type TextTerm struct {
+	Field Field  `json:"field,omitempty"` // Empty matches any field
+	Text  string `json:"text"`            // Lowercased
+}
+
+// TextQuery is a parsed full-text query; every term must match
+type TextQuery []TextTerm
+
+// ParseTextQuery splits input into terms. "comment:regression" limits a
+// term to a field and quotes keep spaces ("notes:\"flaky test\""). A prefix
+// that isn't a known field stays part of the text, so "http://x" still
+// searches for itself.
+func ParseTextQuery(input string) TextQuery {
+	var q TextQuery
+	for _, word := range splitQuoted(input) {
+		term := TextTerm{Text: word}
+		if name, value, ok := strings.Cut(word, ":"); ok && value != "" {
+			if f, known := ParseField(name); known {
+				term = TextTerm{Field: f, Text: value}
+			}
+		}
+		term.Text = strings.ToLower(strings.Trim(term.Text, `"`))
+		if term.Text != "" {
+			q = append(q, term)
+		}
+	}
+	return q
+}
+
+// splitQuoted splits on whitespace, keeping double-quoted runs together
+func splitQuoted(s string) []string {
+	var words []string
+	var sb strings.Builder
+	inQuote := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			sb.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuote:
+			if sb.Len() > 0 {
+				words = append(words, sb.String())
+				sb.Reset()
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() > 0 {
+		words = append(words, sb.String())
+	}
+	return words
+}
+
+// Scoped reports whether any term is limited to a field
+func (q TextQuery) Scoped() bool {
+	for _, t := range q {
+		if t.Field != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Score returns the issue's relevance, or 0 unless every term appears (as a
+// case-insensitive substring) in its field, or in any field when unscoped.
+// Each field a term occurs in adds the field's boost, damped by the number
+// of occurrences. Nil boosts uses DefaultFieldBoosts.
+func (q TextQuery) Score(issue *model.Issue, boosts map[Field]float64) float64 {
+	if len(q) == 0 {
+		return 0
+	}
+	if boosts == nil {
+		boosts = DefaultFieldBoosts
+	}
+	texts := make(map[Field]string, len(SearchFields))
+	for _, f := range SearchFields {
+		texts[f] = strings.ToLower(FieldText(issue, f))
+	}
+
+	var total float64
+	for _, term := range q {
+		fields := SearchFields
+		if term.Field != "" {
+			fields = []Field{term.Field}
+		}
+		var termScore float64
+		for _, f := range fields {
+			if n := strings.Count(texts[f], term.Text); n > 0 {
+				termScore += boosts[f] * (1 + math.Log(float64(n)))
+			}
+		}
+		if termScore == 0 {
+			return 0
+		}
+		total += termScore
+	}
+	return total
+}
+
+// FullTextSearch ranks the issues matching every term of query, best first
+// (ties by ID), keeping at most limit results (<= 0 keeps all). Nil boosts
+// uses DefaultFieldBoosts.
+func FullTextSearch(issues []model.Issue, query string, boosts map[Field]float64, limit int) []SearchResult {
+	q := ParseTextQuery(query)
+	if len(q) == 0 {
+		return nil
+	}
+	var results []SearchResult
+	for i := range issues {
+		if score := q.Score(&issues[i], boosts); score > 0 {
+			results = append(results, SearchResult{IssueID: issues[i].ID, Score: score})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].IssueID < results[j].IssueID
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
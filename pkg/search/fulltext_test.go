@@ -0,0 +1,71 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestParseTextQuery(t *testing.T) {
+	q := ParseTextQuery(`Login comment:Regression notes:"flaky test" http://x bogus:y ac:`)
+	want := TextQuery{
+		{Text: "login"},
+		{Field: FieldComments, Text: "regression"},
+		{Field: FieldNotes, Text: "flaky test"},
+		{Text: "http://x"},
+		{Text: "bogus:y"},
+		{Text: "ac:"},
+	}
+	if len(q) != len(want) {
+		t.Fatalf("Expected %d terms, got %+v", len(want), q)
+	}
+	for i := range want {
+		if q[i] != want[i] {
+			t.Errorf("Term %d = %+v, want %+v", i, q[i], want[i])
+		}
+	}
+	if !q.Scoped() {
+		t.Error("Query with comment: should be scoped")
+	}
+	if ParseTextQuery("plain words").Scoped() {
+		t.Error("Plain words should not be scoped")
+	}
+}
+
+func TestFullTextSearch(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Crash on startup", Comments: []*model.Comment{{Text: "Regression from v2"}}},
+		{ID: "B", Title: "Regression in export", Description: "Export is slow"},
+		{ID: "C", Title: "Docs", Notes: "regression suite is flaky", Labels: []string{"ci"}},
+		{ID: "D", Title: "Unrelated"},
+	}
+
+	results := FullTextSearch(issues, "regression", nil, 0)
+	got := make([]string, len(results))
+	for i, r := range results {
+		got[i] = r.IssueID
+	}
+	// Title hits outrank notes, which outrank comments
+	if want := []string{"B", "C", "A"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if results := FullTextSearch(issues, "comment:regression", nil, 0); len(results) != 1 || results[0].IssueID != "A" {
+		t.Errorf("comment: should only match A, got %+v", results)
+	}
+	if results := FullTextSearch(issues, "regression label:ci", nil, 0); len(results) != 1 || results[0].IssueID != "C" {
+		t.Errorf("Every term must match, got %+v", results)
+	}
+	if results := FullTextSearch(issues, "regression", nil, 1); len(results) != 1 {
+		t.Errorf("Limit should cap results, got %+v", results)
+	}
+	if results := FullTextSearch(issues, "   ", nil, 0); results != nil {
+		t.Errorf("Empty query should give no results, got %+v", results)
+	}
+
+	// Custom boosts can reorder fields
+	boosts := map[Field]float64{FieldComments: 10, FieldTitle: 1, FieldNotes: 1}
+	if results := FullTextSearch(issues, "regression", boosts, 0); results[0].IssueID != "A" {
+		t.Errorf("Boosted comments should rank A first, got %+v", results)
+	}
+}
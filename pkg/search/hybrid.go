@@ -0,0 +1,43 @@
+package search
+
+import "fmt"
+
+// DefaultRRFK is the rank constant of reciprocal rank fusion. Larger values
+// flatten the difference between the top ranks; 60 is the usual choice.
+const DefaultRRFK = 60
+
+// hybridCandidates is how many results each ranking contributes to fusion,
+// at least, so an issue ranked just below the limit by both still counts
+const hybridCandidates = 50
+
+// ReciprocalRankFusion merges rankings by summing 1/(k+rank) over the lists
+// an issue appears in (rank starts at 1). Raw scores are ignored, so BM25
+// and cosine scores need no normalization; an issue near the top of either
+// list stays near the top of the result. Results are best first, ties by ID.
+func ReciprocalRankFusion(k float64, limit int, rankings ...[]SearchResult) []SearchResult {
+	if k <= 0 {
+		k = DefaultRRFK
+	}
+	scores := make(map[string]float64)
+	for _, ranking := range rankings {
+		for i, r := range ranking {
+			scores[r.IssueID] += 1 / (k + float64(i+1))
+		}
+	}
+	return rankScores(scores, limit)
+}
+
+// HybridSearch ranks issues by fusing the lexical BM25 ranking of query with
+// the semantic ranking of its embedding, so exact keyword matches aren't
+// buried by results that are merely similar in meaning
+func HybridSearch(lexical *BM25Index, idx *VectorIndex, queryVec []float32, query string, limit int) ([]SearchResult, error) {
+	if lexical == nil || idx == nil {
+		return nil, fmt.Errorf("hybrid search needs both a lexical and a vector index")
+	}
+	candidates := max(limit*4, hybridCandidates)
+	semantic, err := idx.SearchTopK(queryVec, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return ReciprocalRankFusion(DefaultRRFK, limit, lexical.Search(query, candidates), semantic), nil
+}
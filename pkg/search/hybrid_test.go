@@ -0,0 +1,58 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestReciprocalRankFusion(t *testing.T) {
+	lexical := []SearchResult{{IssueID: "A", Score: 9}, {IssueID: "B", Score: 5}}
+	semantic := []SearchResult{{IssueID: "C", Score: 0.9}, {IssueID: "B", Score: 0.8}, {IssueID: "A", Score: 0.1}}
+
+	got := ReciprocalRankFusion(DefaultRRFK, 0, lexical, semantic)
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 fused results, got %+v", got)
+	}
+	// A (ranks 1 and 3) edges out B (ranks 2 and 2); C appears once
+	if got[0].IssueID != "A" || got[1].IssueID != "B" || got[2].IssueID != "C" {
+		t.Errorf("Expected order A, B, C, got %+v", got)
+	}
+	if want := 2 / float64(DefaultRRFK+2); got[1].Score != want {
+		t.Errorf("Expected B to score %v, got %v", want, got[1].Score)
+	}
+
+	if got := ReciprocalRankFusion(0, 1, lexical, semantic); len(got) != 1 || got[0].IssueID != "A" {
+		t.Errorf("Expected k <= 0 to use the default and limit to keep 1, got %+v", got)
+	}
+}
+
+func TestHybridSearch_KeepsExactKeywordMatch(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Flaky integration suite"},
+		{ID: "B", Title: "ERR_CONN_RESET when syncing"},
+		{ID: "C", Title: "Intermittent test failures"},
+	}
+	idx := NewVectorIndex(2)
+	// The semantic side prefers A and C and puts B last
+	idx.Upsert("A", ContentHash{}, []float32{1, 0})
+	idx.Upsert("C", ContentHash{}, []float32{0.9, 0.1})
+	idx.Upsert("B", ContentHash{}, []float32{0, 1})
+
+	semantic, err := idx.SearchTopK([]float32{1, 0}, 3)
+	if err != nil || semantic[0].IssueID == "B" {
+		t.Fatalf("Expected B not to lead the semantic ranking, got %+v, %v", semantic, err)
+	}
+
+	got, err := HybridSearch(NewBM25Index(issues, nil), idx, []float32{1, 0}, "err_conn_reset", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) == 0 || got[0].IssueID != "B" {
+		t.Errorf("Expected the exact keyword match B first, got %+v", got)
+	}
+
+	if _, err := HybridSearch(nil, idx, []float32{1, 0}, "x", 3); err == nil {
+		t.Error("Expected an error without a lexical index")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 // DefaultIndexPath returns the default semantic index path under the given project directory.
@@ -29,6 +31,7 @@ type IndexSyncStats struct {
 	Removed  int `json:"removed"`
 	Skipped  int `json:"skipped"`
 	Embedded int `json:"embedded"`
+	Reused   int `json:"reused"` // Added or updated from another entry with the same content
 }
 
 func (s IndexSyncStats) Changed() bool {
@@ -50,7 +53,7 @@ func LoadOrNewVectorIndex(path string, dim int) (*VectorIndex, bool, error) {
 	// File exists but failed to load - likely corrupt
 	// Attempt to back it up
 	backupPath := path + ".corrupt-" + fmt.Sprintf("%d", time.Now().Unix())
-	if renameErr := os.Rename(path, backupPath); renameErr == nil {
+	if renameErr := readonly.Rename(path, backupPath); renameErr == nil {
 		// Successfully backed up, return new index
 		// Note: We return error as nil here because we recovered, but the caller might want to know?
 		// We'll return false for loaded, and nil for error, effectively resetting.
@@ -64,10 +67,19 @@ func LoadOrNewVectorIndex(path string, dim int) (*VectorIndex, bool, error) {
 }
 
 // SyncVectorIndex updates idx to match docs using embedder, incrementally embedding only changed items.
+// Entries are keyed by content hash, so a document whose text is already in the index (an issue
+// that was renamed or copied) reuses that vector instead of being embedded again.
 //
 // This is intended for offline, deterministic embedding providers. Callers should persist idx
 // with (*VectorIndex).Save when desired.
 func SyncVectorIndex(ctx context.Context, idx *VectorIndex, embedder Embedder, docs map[string]string, batchSize int) (IndexSyncStats, error) {
+	return SyncVectorIndexWithProgress(ctx, idx, embedder, docs, batchSize, nil)
+}
+
+// SyncVectorIndexWithProgress is SyncVectorIndex with a callback that reports
+// how many of the documents that need embedding are done, once before the
+// first batch and after each one. It stops between batches when ctx is done.
+func SyncVectorIndexWithProgress(ctx context.Context, idx *VectorIndex, embedder Embedder, docs map[string]string, batchSize int, progress func(done, total int)) (IndexSyncStats, error) {
 	var stats IndexSyncStats
 	if idx == nil {
 		return stats, fmt.Errorf("index cannot be nil")
@@ -90,9 +102,15 @@ func SyncVectorIndex(ctx context.Context, idx *VectorIndex, embedder Embedder, d
 		docIDs[id] = struct{}{}
 	}
 
-	// Use sortedIDs to safely iterate over keys without holding lock or racing
+	// Use sortedIDs to safely iterate over keys without holding lock or racing.
+	// Vectors of removed entries stay available for reuse below.
 	existingIDs := idx.sortedIDs()
+	byHash := make(map[ContentHash][]float32, len(existingIDs))
 	for _, issueID := range existingIDs {
+		entry, ok := idx.Get(issueID)
+		if ok {
+			byHash[entry.ContentHash] = entry.Vector
+		}
 		if _, ok := docIDs[issueID]; !ok {
 			idx.Remove(issueID)
 			stats.Removed++
@@ -123,12 +141,22 @@ func SyncVectorIndex(ctx context.Context, idx *VectorIndex, embedder Embedder, d
 		} else {
 			stats.Added++
 		}
+		if vec, seen := byHash[ch]; seen {
+			if err := idx.Upsert(id, ch, vec); err != nil {
+				return stats, err
+			}
+			stats.Reused++
+			continue
+		}
 		toEmbedIDs = append(toEmbedIDs, id)
 		toEmbedTexts = append(toEmbedTexts, text)
 		toEmbedHashes = append(toEmbedHashes, ch)
 	}
 
 	// Embed in batches.
+	if progress != nil {
+		progress(0, len(toEmbedTexts))
+	}
 	for start := 0; start < len(toEmbedTexts); start += batchSize {
 		if err := ctx.Err(); err != nil {
 			return stats, err
@@ -150,6 +178,9 @@ func SyncVectorIndex(ctx context.Context, idx *VectorIndex, embedder Embedder, d
 			}
 			stats.Embedded++
 		}
+		if progress != nil {
+			progress(end, len(toEmbedTexts))
+		}
 	}
 
 	return stats, nil
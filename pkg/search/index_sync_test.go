@@ -2,6 +2,8 @@ package search
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 )
@@ -61,6 +63,58 @@ func TestSyncVectorIndex_IncrementalUpdates(t *testing.T) {
 	}
 }
 
+func TestSyncVectorIndex_ReusesVectorsByContentHash(t *testing.T) {
+	embedder, err := NewEmbedderFromConfig(EmbeddingConfig{Provider: ProviderHash, Dim: 16})
+	if err != nil {
+		t.Fatalf("NewEmbedderFromConfig: %v", err)
+	}
+	idx := NewVectorIndex(embedder.Dim())
+	if _, err := SyncVectorIndex(context.Background(), idx, embedder, map[string]string{"A": "Fix login flow"}, 0); err != nil {
+		t.Fatalf("SyncVectorIndex: %v", err)
+	}
+	before, _ := idx.Get("A")
+
+	// A renamed to B and copied to C: same text, so nothing is embedded
+	stats, err := SyncVectorIndex(context.Background(), idx, embedder, map[string]string{"B": "Fix login flow", "C": "Fix login flow"}, 0)
+	if err != nil {
+		t.Fatalf("SyncVectorIndex: %v", err)
+	}
+	if stats.Added != 2 || stats.Removed != 1 || stats.Reused != 2 || stats.Embedded != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	after, ok := idx.Get("B")
+	if !ok || after.ContentHash != before.ContentHash || after.Vector[0] != before.Vector[0] {
+		t.Fatalf("B should carry A's vector, got %+v", after)
+	}
+}
+
+func TestSyncVectorIndexWithProgress_ReportsBatches(t *testing.T) {
+	embedder := NewHashEmbedder(8)
+	idx := NewVectorIndex(embedder.Dim())
+	docs := map[string]string{"A": "one", "B": "two", "C": "three"}
+
+	var reports [][2]int
+	progress := func(done, total int) { reports = append(reports, [2]int{done, total}) }
+	if _, err := SyncVectorIndexWithProgress(context.Background(), idx, embedder, docs, 2, progress); err != nil {
+		t.Fatalf("SyncVectorIndexWithProgress: %v", err)
+	}
+	want := [][2]int{{0, 3}, {2, 3}, {3, 3}}
+	if fmt.Sprint(reports) != fmt.Sprint(want) {
+		t.Fatalf("reports = %v, want %v", reports, want)
+	}
+
+	// A cancelled sync embeds nothing
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	docs["D"] = "four"
+	if _, err := SyncVectorIndexWithProgress(ctx, idx, embedder, docs, 2, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if _, ok := idx.Get("D"); ok {
+		t.Fatal("D should not be embedded after cancellation")
+	}
+}
+
 func TestLoadOrNewVectorIndex(t *testing.T) {
 	embedder := NewHashEmbedder(8)
 	path := filepath.Join(t.TempDir(), "semantic", "index.bvvi")
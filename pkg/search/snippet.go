@@ -0,0 +1,110 @@
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Snippet is a passage of one issue field, used to show why an issue matched
+type Snippet struct {
+	Field Field
+	Text  string
+}
+
+// Passages splits every field but the title into non-empty lines, in
+// SearchFields order
+func Passages(issue *model.Issue) []Snippet {
+	var out []Snippet
+	for _, f := range SearchFields {
+		if f == FieldTitle {
+			continue
+		}
+		for _, line := range strings.Split(FieldText(issue, f), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				out = append(out, Snippet{Field: f, Text: line})
+			}
+		}
+	}
+	return out
+}
+
+// BestSnippet returns the passage containing the most of terms (lowercased
+// substrings), cut to at most width runes around the first match. A term
+// of five or more runes also matches on its first four, so "regression"
+// finds "regressed".
+func BestSnippet(issue *model.Issue, terms []string, width int) (Snippet, bool) {
+	var best Snippet
+	bestScore := 0
+	for _, p := range Passages(issue) {
+		lower := strings.ToLower(p.Text)
+		score := 0
+		for _, term := range terms {
+			if matchTerm(lower, term) >= 0 {
+				score++
+			}
+		}
+		if score > bestScore {
+			best, bestScore = p, score
+		}
+	}
+	if bestScore == 0 {
+		return Snippet{}, false
+	}
+	lower := strings.ToLower(best.Text)
+	at := -1
+	for _, term := range terms {
+		if i := matchTerm(lower, term); i >= 0 && (at < 0 || i < at) {
+			at = i
+		}
+	}
+	best.Text = TrimAround(best.Text, at, width)
+	return best, true
+}
+
+// matchTerm returns the byte offset of term (or its stem) in lower, or -1
+func matchTerm(lower, term string) int {
+	if term == "" {
+		return -1
+	}
+	if i := strings.Index(lower, term); i >= 0 {
+		return i
+	}
+	if utf8.RuneCountInString(term) >= 5 {
+		return strings.Index(lower, string([]rune(term)[:4]))
+	}
+	return -1
+}
+
+// TrimAround shortens text to at most width runes, keeping the byte offset
+// at in view and marking cut ends with "…"
+func TrimAround(text string, at, width int) string {
+	runes := []rune(text)
+	if width <= 0 || len(runes) <= width {
+		return text
+	}
+	if width < 3 {
+		return string(runes[:width])
+	}
+	center := 0
+	if at > 0 && at <= len(text) {
+		center = utf8.RuneCountInString(text[:at])
+	}
+	// Keep a little context before the match
+	start := max(0, center-width/4)
+	if start+width > len(runes) {
+		start = len(runes) - width
+	}
+	end := start + width
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = "…"
+		start++
+	}
+	if end < len(runes) {
+		suffix = "…"
+		end--
+	}
+	return prefix + string(runes[start:end]) + suffix
+}
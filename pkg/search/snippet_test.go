@@ -0,0 +1,44 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBestSnippet(t *testing.T) {
+	issue := model.Issue{
+		Title:       "Crash on startup",
+		Description: "Happens on Android 14.\nStack trace attached.",
+		Notes:       "Possibly regressed by the splash screen change",
+		Comments:    []*model.Comment{{Text: "Same crash on the splash screen here"}},
+	}
+
+	s, ok := BestSnippet(&issue, []string{"splash", "crash"}, 0)
+	if !ok || s.Field != FieldComments {
+		t.Fatalf("Expected the comment matching both terms, got %+v", s)
+	}
+	s, ok = BestSnippet(&issue, []string{"regression"}, 0)
+	if !ok || s.Field != FieldNotes {
+		t.Errorf("Stem should match 'regressed' in notes, got %+v", s)
+	}
+	if _, ok := BestSnippet(&issue, []string{"startup"}, 0); ok {
+		t.Error("Title-only matches should not produce a snippet")
+	}
+}
+
+func TestTrimAround(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog"
+	if got := TrimAround(text, 0, 100); got != text {
+		t.Errorf("Short text should be unchanged, got %q", got)
+	}
+	if got := TrimAround(text, 0, 10); got != "The quick…" {
+		t.Errorf("TrimAround at start = %q", got)
+	}
+	if got := TrimAround(text, 20, 12); got != "…x jumps ov…" {
+		t.Errorf("TrimAround around 'jumps' = %q", got)
+	}
+	if got := TrimAround(text, 35, 12); got != "…he lazy dog" {
+		t.Errorf("TrimAround near the end = %q", got)
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"runtime"
 	"sort"
 	"sync"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 )
 
 const (
@@ -132,13 +134,10 @@ func LoadVectorIndex(path string) (*VectorIndex, error) {
 			return nil, fmt.Errorf("read content hash: %w", err)
 		}
 
+		// Whole-vector reads keep loading a 10k-issue index fast
 		vec := make([]float32, idx.Dim)
-		for j := 0; j < idx.Dim; j++ {
-			var bits uint32
-			if err := binary.Read(r, binary.LittleEndian, &bits); err != nil {
-				return nil, fmt.Errorf("read vector: %w", err)
-			}
-			vec[j] = math.Float32frombits(bits)
+		if err := binary.Read(r, binary.LittleEndian, vec); err != nil {
+			return nil, fmt.Errorf("read vector: %w", err)
 		}
 
 		if err := idx.Upsert(issueID, ch, vec); err != nil {
@@ -150,6 +149,11 @@ func LoadVectorIndex(path string) (*VectorIndex, error) {
 }
 
 func (idx *VectorIndex) Save(path string) error {
+	// The index is only a cache: in read-only mode it stays in memory
+	if readonly.Enabled() {
+		return nil
+	}
+
 	// Acquire sorted IDs before locking to avoid deadlock (sortedIDs needs Write lock if dirty)
 	ids := idx.sortedIDs()
 
@@ -157,18 +161,18 @@ func (idx *VectorIndex) Save(path string) error {
 	defer idx.mu.RUnlock()
 
 	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0o755); err != nil {
+	if err := readonly.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("mkdir %s: %w", dir, err)
 	}
 
-	tmp, err := os.CreateTemp(dir, "bvvi-*.tmp")
+	tmp, err := readonly.CreateTemp(dir, "bvvi-*.tmp")
 	if err != nil {
 		return fmt.Errorf("create temp: %w", err)
 	}
 	tmpPath := tmp.Name()
 	defer func() {
 		_ = tmp.Close()
-		_ = os.Remove(tmpPath)
+		_ = readonly.Remove(tmpPath)
 	}()
 
 	w := bufio.NewWriter(tmp)
@@ -211,10 +215,8 @@ func (idx *VectorIndex) Save(path string) error {
 		if len(entry.Vector) != idx.Dim {
 			return fmt.Errorf("vector dim mismatch for %s: %d != %d", issueID, len(entry.Vector), idx.Dim)
 		}
-		for _, v := range entry.Vector {
-			if err := binary.Write(w, binary.LittleEndian, math.Float32bits(v)); err != nil {
-				return fmt.Errorf("write vector: %w", err)
-			}
+		if err := binary.Write(w, binary.LittleEndian, entry.Vector); err != nil {
+			return fmt.Errorf("write vector: %w", err)
 		}
 	}
 
@@ -225,15 +227,15 @@ func (idx *VectorIndex) Save(path string) error {
 		return fmt.Errorf("close temp: %w", err)
 	}
 
-	if err := os.Rename(tmpPath, path); err != nil {
+	if err := readonly.Rename(tmpPath, path); err != nil {
 		// os.Rename doesn't replace existing files on Windows. Since the index is deterministic
 		// and can be rebuilt, fall back to removing the destination and retrying.
 		if runtime.GOOS == "windows" {
 			if _, statErr := os.Stat(path); statErr == nil {
-				if rmErr := os.Remove(path); rmErr != nil {
+				if rmErr := readonly.Remove(path); rmErr != nil {
 					return fmt.Errorf("remove existing index: %w", rmErr)
 				}
-				if err2 := os.Rename(tmpPath, path); err2 == nil {
+				if err2 := readonly.Rename(tmpPath, path); err2 == nil {
 					return nil
 				} else {
 					return fmt.Errorf("rename: %w", err2)
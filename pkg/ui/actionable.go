@@ -18,6 +18,13 @@ type ActionableModel struct {
 	width         int
 	height        int
 	theme         Theme
+
+	// Waves schedule, shown instead of the tracks when showWaves is set
+	waves        analysis.WavePlan
+	showWaves    bool
+	selectedWave int
+	selectedStep int // Index into the selected wave's items
+	waveScroll   int
 }
 
 // NewActionableModel creates a new actionable view from execution plan
@@ -67,6 +74,10 @@ func (m *ActionableModel) PageDown() {
 
 // MoveUp moves selection up
 func (m *ActionableModel) MoveUp() {
+	if m.showWaves {
+		m.moveWave(-1)
+		return
+	}
 	if len(m.plan.Tracks) == 0 {
 		return
 	}
@@ -82,6 +93,10 @@ func (m *ActionableModel) MoveUp() {
 
 // MoveDown moves selection down
 func (m *ActionableModel) MoveDown() {
+	if m.showWaves {
+		m.moveWave(1)
+		return
+	}
 	if len(m.plan.Tracks) == 0 {
 		return
 	}
@@ -98,6 +113,9 @@ func (m *ActionableModel) MoveDown() {
 
 // SelectedIssueID returns the ID of the currently selected issue
 func (m *ActionableModel) SelectedIssueID() string {
+	if m.showWaves {
+		return m.selectedWaveItemID()
+	}
 	if len(m.plan.Tracks) == 0 {
 		return ""
 	}
@@ -155,6 +173,9 @@ func (m *ActionableModel) Render() string {
 	if m.width == 0 || m.height == 0 {
 		return ""
 	}
+	if m.showWaves {
+		return m.renderWaves()
+	}
 
 	t := m.theme
 	var lines []string
@@ -174,7 +195,7 @@ func (m *ActionableModel) Render() string {
 		Padding(0, 2).
 		Width(m.width - 4)
 
-	header := fmt.Sprintf("⚡ ACTIONABLE ITEMS  │  %d items in %d tracks", totalItems, len(m.plan.Tracks))
+	header := fmt.Sprintf("⚡ ACTIONABLE ITEMS  │  %d items in %d tracks  │  s: waves", totalItems, len(m.plan.Tracks))
 	lines = append(lines, headerStyle.Render(header))
 	lines = append(lines, "")
 
@@ -75,3 +75,49 @@ func TestActionableRenderShowsSummary(t *testing.T) {
 		t.Fatalf("expected unblocks count badge, got:\n%s", out)
 	}
 }
+
+func TestActionableWaves(t *testing.T) {
+	m := NewActionableModel(analysis.ExecutionPlan{
+		Tracks: []analysis.ExecutionTrack{{TrackID: "track-A", Items: []analysis.PlanItem{{ID: "A1", Title: "First"}}}},
+	}, newTestTheme())
+	m.SetSize(100, 30)
+	m.SetWaves(analysis.WavePlan{
+		Waves: []analysis.ExecutionWave{
+			{Wave: 1, Items: []analysis.WaveItem{{ID: "A1", Title: "First"}, {ID: "C1", Title: "Third"}}, EstimatedDays: 4},
+			{Wave: 2, Items: []analysis.WaveItem{{ID: "B1", Title: "Second", WaitsOn: []string{"A1"}}}, StartDay: 4, EstimatedDays: 2},
+		},
+		ClosesPerDay:    0.5,
+		VelocitySamples: 15,
+		EstimatedDays:   6,
+		MaxParallel:     2,
+		Unschedulable:   []string{"Z1"},
+	})
+
+	m.ToggleWaves()
+	if !m.WavesShown() {
+		t.Fatal("expected the waves schedule after ToggleWaves")
+	}
+	out := m.Render()
+	for _, want := range []string{"WORK WAVES", "3 issues in 2 waves", "WAVE 2", "⇠ A1", "Z1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in waves schedule, got:\n%s", want, out)
+		}
+	}
+
+	// Navigation runs through each wave, then on to the next
+	for _, want := range []string{"C1", "B1", "B1"} {
+		m.MoveDown()
+		if got := m.SelectedIssueID(); got != want {
+			t.Fatalf("expected selection %s after MoveDown, got %s", want, got)
+		}
+	}
+	m.MoveUp()
+	if got := m.SelectedIssueID(); got != "C1" {
+		t.Fatalf("expected selection back to C1 after MoveUp, got %s", got)
+	}
+
+	m.ToggleWaves()
+	if got := m.SelectedIssueID(); got != "A1" {
+		t.Fatalf("expected the tracks selection A1 after toggling back, got %s", got)
+	}
+}
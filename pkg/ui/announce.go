@@ -0,0 +1,193 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// overlay is a modal drawn over the main views
+type overlay struct {
+	name string // Announced when the overlay has focus
+	keys string // How to use and close it, announced after the name
+	open func(m Model) bool
+}
+
+// overlays registers every modal overlay, in the order View draws them.
+// hasOverlay and the announce line both read it, so an overlay added here
+// blocks the mouse, is announced, and is covered by the keyboard audit test.
+var overlays = []overlay{
+	{"Quit confirmation", "y or esc quits, any other key goes back", func(m Model) bool { return m.showQuitConfirm }},
+	{"Label health details", "d drills down, esc closes", func(m Model) bool { return m.showLabelHealthDetail }},
+	{"Label graph analysis", "esc closes", func(m Model) bool { return m.showLabelGraphAnalysis }},
+	{"Label drilldown", "enter filters the list by the label, g shows its graph, esc closes", func(m Model) bool { return m.showLabelDrilldown }},
+	{"Alerts panel", "j and k move, d dismisses, C copies the list, tab switches between active alerts and history, esc closes", func(m Model) bool { return m.showAlertsPanel }},
+	{"Validation panel", "j and k move, esc closes", func(m Model) bool { return m.showValidationPanel }},
+	{"Dependency repair", "j and k move, esc closes", func(m Model) bool { return m.showRepair }},
+	{"Cycle breaker", "j and k move, enter applies the cut, esc closes", func(m Model) bool { return m.showCycleBreak }},
+	{"Bottlenecks", "j and k move, enter jumps to the issue, esc closes", func(m Model) bool { return m.showBottlenecks }},
+	{"Field history", "j and k scroll, esc closes", func(m Model) bool { return m.showFieldHistory }},
+	{"Lead and cycle time", "tab switches overall, labels and assignees, j and k move, esc closes", func(m Model) bool { return m.showLeadTime }},
+	{"Workspace repos", "j and k move, s changes the sort, enter shows the repo's issues, esc closes", func(m Model) bool { return m.showRepoDashboard }},
+	{"History scrubber", "left and right step a week, space plays, enter time-travels there, esc closes", func(m Model) bool { return m.showScrubber }},
+	{"Merge issues", "esc cancels", func(m Model) bool { return m.showMerge }},
+	{"Compare issues", "esc closes", func(m Model) bool { return m.showCompare }},
+	{"Baselines", "j and k move, esc closes", func(m Model) bool { return m.showBaselines }},
+	{"Changes in my watched issues", "j and k move, esc closes", func(m Model) bool { return m.showChanges }},
+	{"Session journal", "j and k move, enter opens the issue, esc closes", func(m Model) bool { return m.showJournal }},
+	{"Ask the backlog", "type a question, enter asks, esc closes", func(m Model) bool { return m.showAsk }},
+	{"Time-travel revision", "type a revision, enter travels, esc cancels", func(m Model) bool { return m.showTimeTravelPrompt }},
+	{"Triage session", "0 to 4 set the priority, l adds labels, a assigns, s snoozes, n and p move, enter opens the issue, esc ends", func(m Model) bool { return m.showTriageSession }},
+	{"Snooze prompt", "type a date or a time like 3d, enter snoozes, esc cancels", func(m Model) bool { return m.showSnoozePrompt }},
+	{"Comment editor", "ctrl+s saves, esc discards", func(m Model) bool { return m.showCommentEditor }},
+	{"Recipe picker", "j and k move, enter applies, esc closes", func(m Model) bool { return m.showRecipePicker }},
+	{"Theme picker", "j and k preview, enter keeps, esc restores", func(m Model) bool { return m.showThemePicker }},
+	{"Export scope", "j and k move, enter exports, esc cancels", func(m Model) bool { return m.showExportScopePicker }},
+	{"List columns", "space shows or hides, shift J and K move, plus and minus resize, enter saves, esc cancels", func(m Model) bool { return m.showColumnEditor }},
+	{"Jump list", "j and k move, enter jumps, esc closes", func(m Model) bool { return m.showJumpList }},
+	{"Jump to graph node", "type an ID, enter selects it in the graph, esc closes", func(m Model) bool { return m.showGoto && m.gotoGraph }},
+	{"Go to issue", "type an ID, enter jumps, esc closes", func(m Model) bool { return m.showGoto }},
+	{"Repo filter", "space toggles, enter applies, esc closes", func(m Model) bool { return m.showRepoPicker }},
+	{"Label picker", "type to filter, enter filters the list, esc closes", func(m Model) bool { return m.showLabelPicker }},
+	{"Help", "j and k scroll, esc closes", func(m Model) bool { return m.showHelp }},
+}
+
+// activeOverlay returns the overlay in front, if any
+func (m Model) activeOverlay() (overlay, bool) {
+	for _, o := range overlays {
+		if o.open(m) {
+			return o, true
+		}
+	}
+	return overlay{}, false
+}
+
+// describeFocus says in plain text, without symbols or color, where keyboard
+// focus is, what is selected, and how to get back. It is the announce line.
+func (m Model) describeFocus() string {
+	if o, ok := m.activeOverlay(); ok {
+		return fmt.Sprintf("%s: %s.", o.name, o.keys)
+	}
+
+	var parts []string
+	back := "esc returns to the list"
+	switch {
+	case m.focused == focusInsights && m.showAttentionView:
+		parts = append(parts, "Label attention scores", "1 to 9 filter by a label")
+	case m.focused == focusInsights:
+		parts = append(parts, "Insights dashboard", m.describeIssue(m.insightsPanel.SelectedIssueID()))
+	case m.isGraphView:
+		parts = append(parts, "Dependency graph")
+		if layout := m.graphView.Layout(); layout != LayoutEgo {
+			parts = append(parts, string(layout)+" layout")
+		}
+		if summary := m.graphView.FilterSummary(); summary != "" {
+			parts = append(parts, summary)
+		}
+		parts = append(parts, m.describeIssue(m.currentIssueID()))
+	case m.isBoardView && m.board.Moving():
+		id, _, to := m.board.MoveTarget()
+		parts = append(parts, "Kanban board", "moving "+m.describeIssue(id), "drop into "+m.board.Config().Columns[to].Title)
+	case m.isBoardView && m.board.LaneTitle() != "":
+		parts = append(parts, "Kanban board", "lane "+m.board.LaneTitle(), m.describeIssue(m.currentIssueID()))
+	case m.isBoardView:
+		parts = append(parts, "Kanban board", m.describeIssue(m.currentIssueID()))
+	case m.isActionableView && m.actionableView.WavesShown():
+		parts = append(parts, "Work waves", m.describeIssue(m.currentIssueID()))
+	case m.isActionableView:
+		parts = append(parts, "Actionable view", m.describeIssue(m.currentIssueID()))
+	case m.isHistoryView:
+		parts = append(parts, "History view", m.describeIssue(m.historyView.SelectedBeadID()))
+	case m.isSprintView:
+		name := ""
+		if m.selectedSprint != nil {
+			name = "sprint " + m.selectedSprint.Name
+		}
+		if m.showSprintVelocity {
+			target := m.velocityComparison.ForecastTarget()
+			name = "label " + target.Label
+			if target.Epic != "" {
+				name = "epic " + target.Epic
+			}
+			parts = append(parts, "Sprint velocity forecast", name)
+			break
+		}
+		parts = append(parts, "Sprint dashboard", name)
+	case m.isTimelineView:
+		parts = append(parts, "Timeline", m.describeIssue(m.currentIssueID()))
+	case m.isHeatmapView:
+		parts = append(parts, "Activity heatmap", m.describeIssue(m.currentIssueID()))
+	case m.focused == focusLabelDashboard:
+		label := ""
+		if c := m.labelDashboard.cursor; c >= 0 && c < len(m.labelDashboard.labels) {
+			label = "label " + m.labelDashboard.labels[c].Label
+		}
+		parts = append(parts, "Label dashboard", label)
+	case m.focused == focusDetail || m.showDetails && !m.isSplitView:
+		parts = append(parts, "Issue details", m.describeIssue(m.selectedListID()))
+	case m.list.FilterState() == list.Filtering:
+		parts = append(parts, fmt.Sprintf("Search, %d matches", len(m.list.VisibleItems())))
+		back = "enter keeps the results, esc clears the search"
+	default:
+		where := fmt.Sprintf("Issue list, %d issues", len(m.list.VisibleItems()))
+		if n := len(m.list.VisibleItems()); n > 0 {
+			where = fmt.Sprintf("Issue list, item %d of %d", m.list.Index()+1, n)
+		}
+		if m.currentFilter != "" && m.currentFilter != "all" {
+			where += ", filter " + m.currentFilter
+		}
+		parts = append(parts, where, m.describeIssue(m.selectedListID()))
+		back = "? lists the keys"
+	}
+	parts = append(parts, back)
+
+	var kept []string
+	for _, p := range parts {
+		if p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, ". ") + "."
+}
+
+// describeIssue names an issue with its status and priority, or "" if id is
+// empty or unknown
+func (m Model) describeIssue(id string) string {
+	issue, ok := m.issueMap[id]
+	if id == "" || !ok {
+		return ""
+	}
+	return fmt.Sprintf("Selected %s, %s, %s, priority %d",
+		issue.ID, issue.Title, strings.ReplaceAll(string(issue.Status), "_", " "), issue.Priority)
+}
+
+// renderAnnounceLine renders describeFocus as a single unadorned line
+func (m Model) renderAnnounceLine() string {
+	line := truncateRunesHelper(m.describeFocus(), max(1, m.width), "…")
+	return m.theme.Renderer.NewStyle().Foreground(m.theme.Secondary).Render(line)
+}
+
+// toggleAnnounce shows or hides the announce line, laying the screen out
+// again to make room for it
+func (m Model) toggleAnnounce() (tea.Model, tea.Cmd) {
+	m.showAnnounce = !m.showAnnounce
+	if !m.ready {
+		return m, nil
+	}
+	updated, cmd := m.Update(tea.WindowSizeMsg{Width: m.width, Height: m.screenHeight})
+	m = updated.(Model)
+	if m.showAnnounce {
+		m.statusMsg = "Announce line on: F3 hides it"
+	} else {
+		m.statusMsg = "Announce line off"
+	}
+	m.statusIsError = false
+	return m, cmd
+}
+
+// EnableAnnounce shows the announce line from the start (--announce)
+func (m *Model) EnableAnnounce() {
+	m.showAnnounce = true
+}
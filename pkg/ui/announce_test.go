@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// auditModel builds a model in which every view and overlay can be opened:
+// git is usable, there are sprints, repos, marks, subscribed changes, a
+// dependency cycle, a dangling dependency and a beads file on disk
+func auditModel(t *testing.T, width int) Model {
+	t.Helper()
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "a-1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"api"}, CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "a-1", DependsOnID: "a-2", Type: model.DepBlocks}}},
+		{ID: "a-2", Title: "Login times out", Status: model.StatusInProgress, Priority: 1, Labels: []string{"api"}, CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "a-2", DependsOnID: "a-1", Type: model.DepBlocks}}},
+		{ID: "a-3", Title: "Orphan", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "a-3", DependsOnID: "gone-9", Type: model.DepBlocks}, {IssueID: "a-3", DependsOnID: "a-2", Type: model.DepBlocks}}},
+	}
+	beadsPath := filepath.Join(t.TempDir(), ".beads", "issues.jsonl")
+	if err := os.MkdirAll(filepath.Dir(beadsPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, issue := range issues {
+		data, err := json.Marshal(issue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(data))
+	}
+	if err := os.WriteFile(beadsPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	m.gitErr = nil
+	m.sprints = []model.Sprint{{ID: "s-1", Name: "Sprint 1", StartDate: now.AddDate(0, 0, -3), EndDate: now.AddDate(0, 0, 4), BeadIDs: []string{"a-2"}}}
+	m.EnableWorkspaceMode(WorkspaceInfo{Enabled: true, RepoCount: 2, RepoPrefixes: []string{"a", "b"}})
+	m.marked = map[string]bool{"a-1": true, "a-2": true}
+	m.changes = []IssueChange{{IssueID: "a-2", Title: "Login times out", At: now, Old: issues[1], New: issues[1]}}
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: width, Height: 40})
+	return updated.(Model)
+}
+
+// press sends each key in turn
+func press(m Model, keys ...string) Model {
+	for _, key := range keys {
+		var msg tea.KeyMsg
+		switch key {
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		default:
+			msg = keyMsgFromString(key)
+		}
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	return m
+}
+
+// backToList presses esc until the list has focus with nothing in front of
+// it, failing if that takes more than three presses (one per nesting level)
+func backToList(t *testing.T, m Model, path string) {
+	t.Helper()
+	for i := 0; ; i++ {
+		_, open := m.activeOverlay()
+		if m.focused == focusList && !open && strings.HasPrefix(m.describeFocus(), "Issue list") {
+			return
+		}
+		if i == 3 {
+			break
+		}
+		if m.showQuitConfirm {
+			// esc confirms quitting here; any other key goes back
+			m = press(m, "n")
+			continue
+		}
+		m = press(m, "esc")
+	}
+	t.Errorf("%s: esc x3 left focus %d, announce %q", path, m.focused, m.describeFocus())
+}
+
+// TestKeyboardAudit_FocusStates reaches every focus state from the list by
+// keyboard alone and gets back with esc
+func TestKeyboardAudit_FocusStates(t *testing.T) {
+	paths := map[focus][]string{
+		focusList:            nil,
+		focusDetail:          {"tab"},
+		focusBoard:           {"b"},
+		focusGraph:           {"g"},
+		focusLabelDashboard:  {"L"},
+		focusInsights:        {"i"},
+		focusActionable:      {"a"},
+		focusRecipePicker:    {"R"},
+		focusRepoPicker:      {"w"},
+		focusHelp:            {"?"},
+		focusQuitConfirm:     {"esc", "esc"}, // The first esc drops the marks
+		focusTimeTravelInput: {"t"},
+		focusHistory:         {"H"},
+		focusLabelPicker:     {"l"},
+		focusSprint:          {"P"},
+		focusTimeline:        {"Y"},
+		focusHeatmap:         {"D"},
+		focusThemePicker:     {"V"},
+		focusCommentEditor:   {"m"},
+		focusJumpList:        {"ctrl+o"},
+		focusGoto:            {":"},
+		focusExportScope:     {"E"},
+		focusTriageSession:   {"z"},
+		focusSnoozeInput:     {"u"},
+	}
+	for f := focusList; f < focusStates; f++ {
+		keys, ok := paths[f]
+		if !ok {
+			t.Errorf("focus %d has no keyboard path in this test", f)
+			continue
+		}
+		path := strings.Join(keys, " ")
+		m := press(auditModel(t, 140), keys...)
+		if m.focused != f {
+			t.Errorf("%q: focus %d, want %d", path, m.focused, f)
+			continue
+		}
+		if desc := m.describeFocus(); desc == "" || strings.ContainsAny(desc, "🔒📌❌✓") {
+			t.Errorf("%q: announce %q should be plain text", path, desc)
+		}
+		backToList(t, m, path)
+	}
+}
+
+// TestKeyboardAudit_Overlays opens every registered overlay by keyboard,
+// checks it is announced, and closes it with esc
+func TestKeyboardAudit_Overlays(t *testing.T) {
+	paths := map[string][]string{
+		"Quit confirmation":            {"esc", "esc"},
+		"Label health details":         {"L", "h"},
+		"Label graph analysis":         {"L", "d", "g"},
+		"Label drilldown":              {"L", "d"},
+		"Alerts panel":                 {"!"},
+		"Validation panel":             {"X"},
+		"Dependency repair":            {"ctrl+r"},
+		"Cycle breaker":                {"ctrl+x"},
+		"Bottlenecks":                  {"I"},
+		"History scrubber":             {"Z"},
+		"Field history":                {"f"},
+		"Lead and cycle time":          {"ctrl+t"},
+		"Workspace repos":              {"ctrl+w"},
+		"Merge issues":                 {"U"},
+		"Compare issues":               {"="},
+		"Baselines":                    {"ctrl+b"},
+		"Changes in my watched issues": {"N"},
+		"Session journal":              {"f5"},
+		"Ask the backlog":              {"Q"},
+		"Time-travel revision":         {"t"},
+		"Triage session":               {"z"},
+		"Snooze prompt":                {"u"},
+		"Comment editor":               {"m"},
+		"Recipe picker":                {"R"},
+		"Theme picker":                 {"V"},
+		"Export scope":                 {"E"},
+		"List columns":                 {"f4"},
+		"Jump list":                    {"ctrl+o"},
+		"Jump to graph node":           {"g", "/"},
+		"Go to issue":                  {":"},
+		"Repo filter":                  {"w"},
+		"Label picker":                 {"l"},
+		"Help":                         {"?"},
+	}
+	for _, width := range []int{140, 80} {
+		for _, o := range overlays {
+			keys, ok := paths[o.name]
+			if !ok {
+				t.Errorf("overlay %q has no keyboard path in this test", o.name)
+				continue
+			}
+			path := strings.Join(keys, " ")
+			m := press(auditModel(t, width), keys...)
+			got, open := m.activeOverlay()
+			if !open || got.name != o.name {
+				t.Errorf("width %d, %q: overlay %q open=%v, want %q", width, path, got.name, open, o.name)
+				continue
+			}
+			if !strings.HasPrefix(m.describeFocus(), o.name+": ") {
+				t.Errorf("width %d, %q: announce %q", width, path, m.describeFocus())
+			}
+			backToList(t, m, path)
+		}
+	}
+}
+
+func TestAnnounceLine(t *testing.T) {
+	m := auditModel(t, 120)
+	if strings.Contains(m.View(), "Issue list, item") {
+		t.Error("announce line should be off by default")
+	}
+	height := m.height
+
+	m = press(m, "f3")
+	if !m.showAnnounce || m.height != height-1 {
+		t.Fatalf("F3: showAnnounce=%v height=%d, want true and %d", m.showAnnounce, m.height, height-1)
+	}
+	lines := strings.Split(m.View(), "\n")
+	last := lines[len(lines)-1]
+	if !strings.Contains(last, "Issue list, item 1 of 3") || !strings.Contains(last, "Selected a-") {
+		t.Errorf("announce line = %q", last)
+	}
+
+	before := m.describeFocus()
+	m = press(m, "j")
+	if m.describeFocus() == before || !strings.Contains(m.describeFocus(), "item 2 of 3") {
+		t.Errorf("moving the cursor should change the announcement: %q", m.describeFocus())
+	}
+	m = press(m, "b")
+	if !strings.HasPrefix(m.describeFocus(), "Kanban board") {
+		t.Errorf("board announcement = %q", m.describeFocus())
+	}
+
+	m = press(m, "f3")
+	if m.showAnnounce || m.height != height {
+		t.Errorf("second F3: showAnnounce=%v height=%d, want false and %d", m.showAnnounce, m.height, height)
+	}
+}
@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/internal/ask"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// AskAnswerMsg carries the answer to a question from the ask panel. Seq
+// matches the question it answers, so a stale answer is dropped.
+type AskAnswerMsg struct {
+	Seq    int
+	Answer string
+	Err    error
+}
+
+// askCmd grounds question in issues and runs the configured answer command
+func askCmd(seq int, command, question string, issues []model.Issue) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), ask.DefaultTimeout)
+		defer cancel()
+		answer, err := ask.RunCommand(ctx, command, ask.BuildPrompt(question, issues))
+		return AskAnswerMsg{Seq: seq, Answer: answer, Err: err}
+	}
+}
+
+// openAsk shows the ask panel, keeping the last question and answer
+func (m *Model) openAsk() {
+	if m.askInput.Placeholder == "" {
+		ti := textinput.New()
+		ti.Prompt = "? "
+		ti.Placeholder = "what's blocking the release label?"
+		ti.CharLimit = 500
+		m.askInput = ti
+	}
+	m.askInput.Width = max(20, min(100, m.width-4)-10)
+	m.askInput.Focus()
+	m.showAsk = true
+}
+
+// handleAskKeys handles keys while the ask panel is open. While the
+// question is being typed every key goes to the input; once an answer is
+// shown, keys move between and open the cited issues.
+func (m Model) handleAskKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.askInput.Focused() {
+		switch msg.String() {
+		case "esc":
+			m.askInput.Blur()
+			m.showAsk = false
+		case "enter":
+			question := strings.TrimSpace(m.askInput.Value())
+			if question == "" || m.askPending {
+				return m, nil
+			}
+			m.askAnswer, m.askErr, m.askCitations = "", nil, nil
+			m.askCursor, m.askScroll = 0, 0
+			command := ask.CommandFromEnv()
+			if command == "" {
+				// The panel already explains how to turn Q&A on
+				return m, nil
+			}
+			m.askInput.Blur()
+			m.askPending = true
+			m.askSeq++
+			return m, askCmd(m.askSeq, command, question, m.analysisIssues())
+		default:
+			m.askInput, _ = m.askInput.Update(msg)
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q", "Q":
+		m.showAsk = false
+	case "/", "i":
+		// Ask another question
+		m.askInput.Focus()
+	case "tab", "j", "down":
+		if m.askCursor < len(m.askCitations)-1 {
+			m.askCursor++
+		}
+	case "shift+tab", "k", "up":
+		if m.askCursor > 0 {
+			m.askCursor--
+		}
+	case "ctrl+d", "pgdown":
+		m.askScroll += 5
+	case "ctrl+u", "pgup":
+		m.askScroll = max(0, m.askScroll-5)
+	case "enter":
+		if m.askCursor < len(m.askCitations) {
+			m.showAsk = false
+			m.jumpToIssue(m.askCitations[m.askCursor])
+		}
+	}
+	return m, nil
+}
+
+// setAskAnswer records an answer and the issues it cites
+func (m *Model) setAskAnswer(msg AskAnswerMsg) {
+	if msg.Seq != m.askSeq {
+		return
+	}
+	m.askPending = false
+	m.askAnswer, m.askErr = msg.Answer, msg.Err
+	known := make(map[string]bool, len(m.issueMap))
+	for id := range m.issueMap {
+		known[id] = true
+	}
+	m.askCitations = ask.Citations(msg.Answer, known)
+	m.askCursor, m.askScroll = 0, 0
+	if !m.showAsk && msg.Err == nil {
+		m.statusMsg = "Answer ready (Q to view)"
+		m.statusIsError = false
+	}
+}
+
+// highlightCitations styles the issue IDs in line that the answer cites,
+// the selected one in reverse video
+func (m Model) highlightCitations(line string) string {
+	if len(m.askCitations) == 0 {
+		return line
+	}
+	cited := make(map[string]int, len(m.askCitations))
+	for i, id := range m.askCitations {
+		cited[id] = i
+	}
+	citeStyle := m.theme.Renderer.NewStyle().Foreground(m.theme.Primary).Bold(true)
+	selectedStyle := citeStyle.Reverse(true)
+
+	var sb strings.Builder
+	runes := []rune(line)
+	for i := 0; i < len(runes); {
+		if !isIDRune(runes[i]) {
+			sb.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isIDRune(runes[j]) {
+			j++
+		}
+		token := strings.TrimRight(string(runes[i:j]), ".-_")
+		rest := string(runes[i:j])[len(token):]
+		if idx, ok := cited[token]; ok {
+			if idx == m.askCursor {
+				token = selectedStyle.Render(token)
+			} else {
+				token = citeStyle.Render(token)
+			}
+		}
+		sb.WriteString(token + rest)
+		i = j
+	}
+	return sb.String()
+}
+
+func isIDRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.'
+}
+
+// renderAskOverlay renders the ask panel: the question input, the answer
+// with cited issue IDs highlighted, and the list of cited issues
+func (m Model) renderAskOverlay() string {
+	t := m.theme
+	boxWidth := min(100, m.width-4)
+	textWidth := boxWidth - 6
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	errStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+	inputStyle := t.Renderer.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(textWidth - 2)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("💬 Ask the Backlog"))
+	sb.WriteString("\n\n")
+	sb.WriteString(inputStyle.Render(m.askInput.View()))
+	sb.WriteString("\n\n")
+
+	switch {
+	case m.askPending:
+		sb.WriteString(mutedStyle.Render("Thinking… (answering from triage, critical path and label flow)"))
+	case m.askErr != nil:
+		sb.WriteString(errStyle.Render(wrapText(m.askErr.Error(), textWidth)))
+	case m.askAnswer != "":
+		var lines []string
+		for _, para := range strings.Split(m.askAnswer, "\n") {
+			if strings.TrimSpace(para) == "" {
+				lines = append(lines, "")
+				continue
+			}
+			lines = append(lines, strings.Split(wrapText(para, textWidth), "\n")...)
+		}
+		citeHeight := min(len(m.askCitations), 8)
+		if citeHeight > 0 {
+			citeHeight += 2
+		}
+		bodyHeight := max(3, m.height-14-citeHeight)
+		start := min(m.askScroll, max(0, len(lines)-bodyHeight))
+		end := min(start+bodyHeight, len(lines))
+		for i, l := range lines[start:end] {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(m.highlightCitations(l))
+		}
+		if end < len(lines) {
+			sb.WriteString("\n" + mutedStyle.Render(fmt.Sprintf("… %d more lines (ctrl+d)", len(lines)-end)))
+		}
+		if len(m.askCitations) > 0 {
+			sb.WriteString("\n\n" + mutedStyle.Render("Cited issues:"))
+			first := max(0, min(m.askCursor-3, len(m.askCitations)-8))
+			for i := first; i < len(m.askCitations) && i < first+8; i++ {
+				id := m.askCitations[i]
+				cursor := "  "
+				style := t.Renderer.NewStyle()
+				if i == m.askCursor {
+					cursor = "▸ "
+					style = style.Foreground(t.Primary).Bold(true)
+				}
+				line := cursor + id
+				if issue := m.issueMap[id]; issue != nil {
+					line = cursor + GetStatusIcon(string(issue.Status)) + " " + id + "  " + issue.Title
+				}
+				sb.WriteString("\n" + style.Render(truncateRunesHelper(line, textWidth, "…")))
+			}
+		}
+	case ask.CommandFromEnv() == "":
+		sb.WriteString(mutedStyle.Render(wrapText(fmt.Sprintf(
+			"Q&A is off. Set %s to a command that reads a prompt on stdin and prints the answer, e.g. %s=\"llm -m gpt-4o\".",
+			ask.EnvCommand, ask.EnvCommand), textWidth)))
+	default:
+		sb.WriteString(mutedStyle.Render("Ask about blockers, the critical path or a label; answers cite issue IDs you can jump to."))
+	}
+
+	sb.WriteString("\n\n")
+	hint := "enter: ask • esc: close"
+	if !m.askInput.Focused() {
+		hint = "j/k: select citation • enter: open issue • /: ask again • esc: close"
+	}
+	sb.WriteString(mutedStyle.Italic(true).Render(hint))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/internal/ask"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAskPanel_AnswerCitesJumpableIssues(t *testing.T) {
+	t.Setenv(ask.EnvCommand, "grep -q 'Question: what blocks' && echo 'Finish [TWO] first; it blocks ONE.'")
+	issues := []model.Issue{
+		{ID: "ONE", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1,
+			Dependencies: []*model.Dependency{{DependsOnID: "TWO", Type: model.DepBlocks}}},
+		{ID: "TWO", Title: "Two", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	updated, _ = m.Update(keyMsgFromString("Q"))
+	m = updated.(Model)
+	if !m.showAsk || !m.askInput.Focused() {
+		t.Fatal("Expected Q to open the ask panel with the input focused")
+	}
+	m.askInput.SetValue("what blocks ONE?")
+	updated, cmd := m.Update(keyMsgFromString("enter"))
+	m = updated.(Model)
+	if !m.askPending || cmd == nil {
+		t.Fatal("Expected enter to send the question")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.askErr != nil || m.askPending {
+		t.Fatalf("Expected an answer, got error %v", m.askErr)
+	}
+	if len(m.askCitations) != 2 || m.askCitations[0] != "TWO" || m.askCitations[1] != "ONE" {
+		t.Fatalf("Expected citations [TWO ONE], got %v", m.askCitations)
+	}
+	if view := m.View(); !strings.Contains(view, "Cited issues") || !strings.Contains(view, "Finish") {
+		t.Errorf("Expected the answer and its citations in the panel")
+	}
+
+	updated, _ = m.Update(keyMsgFromString("j"))
+	m = updated.(Model)
+	updated, _ = m.Update(keyMsgFromString("enter"))
+	m = updated.(Model)
+	if m.showAsk || selectedID(m) != "ONE" {
+		t.Errorf("Expected enter to open the selected citation ONE, got %q", selectedID(m))
+	}
+}
+
+func TestAskPanel_StaleAnswerDropped(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen}}, nil, "")
+	m.askSeq = 2
+	m.askPending = true
+	m.setAskAnswer(AskAnswerMsg{Seq: 1, Answer: "old A"})
+	if m.askAnswer != "" || !m.askPending {
+		t.Error("Expected an answer to an earlier question to be ignored")
+	}
+}
+
+func TestAskPanel_OffWithoutCommand(t *testing.T) {
+	t.Setenv(ask.EnvCommand, "")
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	updated, _ = m.Update(keyMsgFromString("Q"))
+	m = updated.(Model)
+	updated, _ = m.Update(keyMsgFromString("x"))
+	m = updated.(Model)
+	updated, cmd := m.Update(keyMsgFromString("enter"))
+	m = updated.(Model)
+	if cmd != nil || m.askPending {
+		t.Error("Expected no question to be sent without a command")
+	}
+	if !strings.Contains(m.View(), ask.EnvCommand) {
+		t.Error("Expected the panel to explain how to turn Q&A on")
+	}
+}
@@ -0,0 +1,268 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BaselineSnapshotMsg reports the automatic daily baseline snapshot
+type BaselineSnapshotMsg struct {
+	Path string
+	Err  error
+}
+
+// dailyBaselineCmd archives the current metrics as today's snapshot, unless
+// daily snapshots are off, one exists already or bv is read-only, then
+// prunes old ones.
+// It returns nil when there is nothing to do.
+func (m *Model) dailyBaselineCmd(now time.Time) tea.Cmd {
+	day := baseline.SnapshotName(now)
+	if m.beadsPath == "" || m.timeTravelMode || readonly.Enabled() || m.analysis == nil || m.baselineSnapshotDay == day {
+		return nil
+	}
+	m.baselineSnapshotDay = day
+
+	projectDir := projectDirFromBeadsPath(m.beadsPath)
+	cfg, err := drift.LoadConfig(projectDir)
+	if err != nil || !cfg.DailyBaselines || baseline.HasSnapshot(projectDir, now) {
+		return nil
+	}
+	snap := drift.Snapshot(m.issues, m.analysis, m.analyzer)
+	return func() tea.Msg {
+		bl := baseline.New(snap.Stats, snap.TopMetrics, snap.Cycles, "daily snapshot")
+		bl.CreatedAt = now
+		path, err := bl.Archive(projectDir)
+		if err == nil {
+			_, err = baseline.Rotate(projectDir, cfg.BaselineKeep)
+		}
+		return BaselineSnapshotMsg{Path: path, Err: err}
+	}
+}
+
+// openBaselines lists the stored baselines for comparison with the
+// current metrics
+func (m *Model) openBaselines() {
+	if m.analysis == nil {
+		m.statusMsg = "Baselines: analysis not ready yet"
+		m.statusIsError = false
+		return
+	}
+	entries, err := baseline.List(projectDirFromBeadsPath(m.beadsPath))
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Baselines: %v", err)
+		m.statusIsError = true
+		return
+	}
+	if len(entries) == 0 {
+		m.statusMsg = "No baselines yet: press s in the baselines panel or run bv --save-baseline"
+		m.statusIsError = false
+	}
+	m.baselines = entries
+	m.baselinesCursor = 0
+	m.baselineCurrent = drift.Snapshot(m.issues, m.analysis, m.analyzer)
+	m.baselineDriftConfig, err = drift.LoadConfig(projectDirFromBeadsPath(m.beadsPath))
+	if err != nil {
+		m.baselineDriftConfig = drift.DefaultConfig()
+	}
+	m.showBaselines = true
+}
+
+// handleBaselinesKeys handles keys while the baselines panel is open
+func (m Model) handleBaselinesKeys(msg tea.KeyMsg) Model {
+	projectDir := projectDirFromBeadsPath(m.beadsPath)
+	switch msg.String() {
+	case "j", "down":
+		if m.baselinesCursor < len(m.baselines)-1 {
+			m.baselinesCursor++
+		}
+	case "k", "up":
+		if m.baselinesCursor > 0 {
+			m.baselinesCursor--
+		}
+	case "g", "home":
+		m.baselinesCursor = 0
+	case "G", "end":
+		m.baselinesCursor = max(0, len(m.baselines)-1)
+	case "s":
+		// Snapshot the current metrics as today's baseline
+		snap := m.baselineCurrent
+		bl := baseline.New(snap.Stats, snap.TopMetrics, snap.Cycles, "saved from the TUI")
+		path, err := bl.Archive(projectDir)
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Snapshot failed: %v", err)
+			m.statusIsError = true
+			break
+		}
+		m.reloadBaselines(path)
+		m.statusMsg = "Saved baseline snapshot " + baseline.SnapshotName(bl.CreatedAt)
+		m.statusIsError = false
+	case "u":
+		// Make the selected snapshot the one drift checks compare against
+		if m.baselinesCursor >= len(m.baselines) || m.baselines[m.baselinesCursor].Active() {
+			break
+		}
+		e := m.baselines[m.baselinesCursor]
+		if err := baseline.Activate(projectDir, e.Path); err != nil {
+			m.statusMsg = fmt.Sprintf("Could not activate baseline: %v", err)
+			m.statusIsError = true
+			break
+		}
+		m.reloadBaselines(baseline.DefaultPath(projectDir))
+		m.statusMsg = fmt.Sprintf("Baseline %s is now active", e.Name)
+		m.statusIsError = false
+	case "esc", "q", "ctrl+b":
+		m.showBaselines = false
+	}
+	return m
+}
+
+// reloadBaselines re-reads the stored baselines and selects the one at path
+func (m *Model) reloadBaselines(path string) {
+	entries, err := baseline.List(projectDirFromBeadsPath(m.beadsPath))
+	if err != nil {
+		return
+	}
+	m.baselines = entries
+	m.baselinesCursor = 0
+	for i, e := range entries {
+		if e.Path == path {
+			m.baselinesCursor = i
+			break
+		}
+	}
+}
+
+// baselineDelta is one row of the comparison table. sense colors the
+// change: 1 when a rise is good, -1 when it is bad, 0 when neither.
+type baselineDelta struct {
+	name     string
+	was, now int
+	sense    int
+}
+
+// renderBaselinesPanel lists stored baselines with the selected one
+// compared to the current metrics: stats side by side, then drift alerts
+func (m Model) renderBaselinesPanel() string {
+	t := m.theme
+	boxWidth := min(90, m.width-4)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	worseStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+	betterStyle := t.Renderer.NewStyle().Foreground(t.Open)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("📏 Baselines (%d)", len(m.baselines))))
+	sb.WriteString("\n\n")
+
+	if len(m.baselines) == 0 {
+		sb.WriteString(mutedStyle.Render("No baselines yet. Press s to snapshot the current metrics."))
+		sb.WriteString("\n")
+	}
+
+	// Keep the cursor in view within a few rows
+	listHeight := min(len(m.baselines), max(3, m.height-24))
+	start := 0
+	if m.baselinesCursor >= listHeight {
+		start = m.baselinesCursor - listHeight + 1
+	}
+	for i := start; i < len(m.baselines) && i < start+listHeight; i++ {
+		e := m.baselines[i]
+		cursor := "  "
+		style := t.Renderer.NewStyle()
+		if i == m.baselinesCursor {
+			cursor = "▸ "
+			style = style.Bold(true).Foreground(t.Primary)
+		}
+		name := e.Name
+		if e.Active() {
+			name = "● active"
+		}
+		line := fmt.Sprintf("%s%-10s %s", cursor, name, e.Baseline.CreatedAt.Local().Format("2006-01-02 15:04"))
+		if e.Baseline.Description != "" {
+			line += "  " + e.Baseline.Description
+		}
+		sb.WriteString(style.Render(truncateRunesHelper(line, boxWidth-6, "…")))
+		sb.WriteString("\n")
+	}
+
+	if m.baselinesCursor < len(m.baselines) && m.baselineCurrent != nil {
+		bl := m.baselines[m.baselinesCursor].Baseline
+		cur := m.baselineCurrent
+		sb.WriteString("\n")
+		sb.WriteString(titleStyle.Render("Then → now"))
+		sb.WriteString("\n")
+
+		rows := []baselineDelta{
+			{"Issues", bl.Stats.NodeCount, cur.Stats.NodeCount, 0},
+			{"Dependencies", bl.Stats.EdgeCount, cur.Stats.EdgeCount, 0},
+			{"Open", bl.Stats.OpenCount, cur.Stats.OpenCount, -1},
+			{"Blocked", bl.Stats.BlockedCount, cur.Stats.BlockedCount, -1},
+			{"Closed", bl.Stats.ClosedCount, cur.Stats.ClosedCount, 1},
+			{"Actionable", bl.Stats.ActionableCount, cur.Stats.ActionableCount, 1},
+			{"Cycles", bl.Stats.CycleCount, cur.Stats.CycleCount, -1},
+		}
+		for _, r := range rows {
+			delta := r.now - r.was
+			deltaText := mutedStyle.Render("  =")
+			if delta != 0 {
+				deltaText = fmt.Sprintf("%+4d", delta)
+				switch {
+				case delta*r.sense > 0:
+					deltaText = betterStyle.Render(deltaText)
+				case delta*r.sense < 0:
+					deltaText = worseStyle.Render(deltaText)
+				}
+			}
+			sb.WriteString(fmt.Sprintf("  %-14s %6d → %-6d %s\n", r.name, r.was, r.now, deltaText))
+		}
+
+		result := drift.NewCalculator(bl, cur, m.baselineDriftConfig).Calculate()
+		sb.WriteString("\n")
+		if len(result.Alerts) == 0 {
+			sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓ No drift from this baseline"))
+			sb.WriteString("\n")
+		}
+		for i, a := range result.Alerts {
+			if i == 6 {
+				sb.WriteString(mutedStyle.Render(fmt.Sprintf("  … %d more", len(result.Alerts)-i)))
+				sb.WriteString("\n")
+				break
+			}
+			icon, style := "ℹ", mutedStyle
+			switch a.Severity {
+			case drift.SeverityCritical:
+				icon, style = "⚠", worseStyle.Bold(true)
+			case drift.SeverityWarning:
+				icon, style = "⚡", t.Renderer.NewStyle().Foreground(t.Feature)
+			}
+			sb.WriteString(style.Render(truncateRunesHelper(fmt.Sprintf("%s %s", icon, a.Message), boxWidth-6, "…")))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: select • s: snapshot now • u: make active • esc: close"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
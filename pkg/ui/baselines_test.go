@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func baselineTestModel(t *testing.T) (Model, string) {
+	t.Helper()
+	dir, beadsPath := stateTestBeadsPath(t)
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "B", Title: "B", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 50})
+	return updated.(Model), dir
+}
+
+func TestDailyBaselineCmd(t *testing.T) {
+	m, dir := baselineTestModel(t)
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.Local)
+
+	cmd := m.dailyBaselineCmd(now)
+	if cmd == nil {
+		t.Fatal("expected a snapshot command on the first analysis of the day")
+	}
+	msg := cmd().(BaselineSnapshotMsg)
+	if msg.Err != nil || msg.Path != baseline.SnapshotPath(dir, now) {
+		t.Fatalf("snapshot = %+v", msg)
+	}
+	b, err := baseline.Load(msg.Path)
+	if err != nil || b.Stats.NodeCount != 2 || b.Stats.ActionableCount != 1 {
+		t.Errorf("snapshot stats = %+v, %v", b, err)
+	}
+
+	if m.dailyBaselineCmd(now.Add(time.Hour)) != nil {
+		t.Error("expected one snapshot per day")
+	}
+	m.baselineSnapshotDay = "" // A new session the same day
+	if m.dailyBaselineCmd(now.Add(time.Hour)) != nil {
+		t.Error("expected an existing snapshot for today to be kept")
+	}
+}
+
+func TestBaselinesPanel(t *testing.T) {
+	m, dir := baselineTestModel(t)
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	m = updated.(Model)
+	if !m.showBaselines || len(m.baselines) != 0 {
+		t.Fatalf("expected an empty baselines panel, show=%v entries=%d", m.showBaselines, len(m.baselines))
+	}
+
+	// Snapshot now, then make it the active baseline
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	if len(m.baselines) != 1 || m.baselines[0].Active() {
+		t.Fatalf("expected one dated snapshot, got %+v (%s)", m.baselines, m.statusMsg)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	m = updated.(Model)
+	if len(m.baselines) != 2 || !m.baselines[m.baselinesCursor].Active() || !baseline.Exists(baseline.DefaultPath(dir)) {
+		t.Fatalf("expected the snapshot activated, got %+v (%s)", m.baselines, m.statusMsg)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Baselines (2)", "Then → now", "Actionable", "No drift from this baseline"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("panel missing %q", want)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.(Model).showBaselines {
+		t.Error("esc should close the panel")
+	}
+}
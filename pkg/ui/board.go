@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
@@ -12,14 +13,31 @@ import (
 
 // BoardModel represents the Kanban board view with adaptive columns
 type BoardModel struct {
-	columns      [4][]model.Issue
-	activeColIdx []int  // Indices of non-empty columns (for navigation)
-	focusedCol   int    // Index into activeColIdx
-	selectedRow  [4]int // Store selection for each column
+	config       BoardConfig
+	columns      [][]model.Issue // Every card in each column, across lanes
+	activeColIdx []int           // Indices of shown columns (for navigation)
+	focusedCol   int             // Index into activeColIdx
+	lanes        []boardLane     // Swimlanes; a single lane when the board isn't split
+	focusedLane  int
+	issues       []model.Issue // Issues last set, for rebuilding lanes
 	theme        Theme
+	starred      map[string]bool // Watchlist, marked on the cards
+
+	// Card being moved (space, then h/l and enter); "" when not moving
+	moveID   string
+	moveFrom int
+}
+
+// boardLane is one horizontal swimlane: its cards in each column, and the
+// selection (and so the scroll position) of each column within the lane
+type boardLane struct {
+	key         string // Assignee, priority band or epic ID; "" for the catch-all lane
+	title       string
+	columns     [][]model.Issue
+	selectedRow []int
 }
 
-// Column indices for the Kanban board
+// Column indices of the default board
 const (
 	ColOpen       = 0
 	ColInProgress = 1
@@ -27,6 +45,20 @@ const (
 	ColClosed     = 3
 )
 
+// Swimlane modes: how the board splits into horizontal lanes
+const (
+	LanesNone     = ""
+	LanesAssignee = "assignee"
+	LanesPriority = "priority"
+	LanesEpic     = "epic"
+)
+
+// laneModes is the order s cycles the swimlanes through
+var laneModes = []string{LanesNone, LanesAssignee, LanesPriority, LanesEpic}
+
+// priorityBands titles the priority lanes; P3 and below share the last
+var priorityBands = []string{"P0 Critical", "P1 High", "P2 Medium", "P3+ Low"}
+
 // sortIssuesByPriorityAndDate sorts issues by priority (ascending) then by creation date (descending)
 func sortIssuesByPriorityAndDate(issues []model.Issue) {
 	sort.Slice(issues, func(i, j int) bool {
@@ -37,17 +69,21 @@ func sortIssuesByPriorityAndDate(issues []model.Issue) {
 	})
 }
 
-// updateActiveColumns rebuilds the list of non-empty column indices
+// updateActiveColumns rebuilds the list of shown column indices: every
+// column, or only the non-empty ones if the board hides empty columns.
+// While a card is moving every column is shown, so any can take it.
 func (b *BoardModel) updateActiveColumns() {
 	b.activeColIdx = nil
-	for i := 0; i < 4; i++ {
-		if len(b.columns[i]) > 0 {
+	for i := range b.columns {
+		if len(b.columns[i]) > 0 || !b.config.HideEmpty || b.moveID != "" {
 			b.activeColIdx = append(b.activeColIdx, i)
 		}
 	}
 	// If all columns are empty, include all columns anyway
 	if len(b.activeColIdx) == 0 {
-		b.activeColIdx = []int{ColOpen, ColInProgress, ColBlocked, ColClosed}
+		for i := range b.columns {
+			b.activeColIdx = append(b.activeColIdx, i)
+		}
 	}
 	// Ensure focused column is within valid range
 	if b.focusedCol >= len(b.activeColIdx) {
@@ -58,100 +94,267 @@ func (b *BoardModel) updateActiveColumns() {
 	}
 }
 
-// NewBoardModel creates a new Kanban board from the given issues
+// NewBoardModel creates a new Kanban board from the given issues, with the
+// default columns
 func NewBoardModel(issues []model.Issue, theme Theme) BoardModel {
-	var cols [4][]model.Issue
-
-	// Distribute issues into columns by status
-	for _, issue := range issues {
-		switch issue.Status {
-		case model.StatusOpen:
-			cols[ColOpen] = append(cols[ColOpen], issue)
-		case model.StatusInProgress:
-			cols[ColInProgress] = append(cols[ColInProgress], issue)
-		case model.StatusBlocked:
-			cols[ColBlocked] = append(cols[ColBlocked], issue)
-		case model.StatusClosed:
-			cols[ColClosed] = append(cols[ColClosed], issue)
-		}
-	}
-
-	// Sort each column
-	for i := 0; i < 4; i++ {
-		sortIssuesByPriorityAndDate(cols[i])
-	}
+	return NewBoardModelWithConfig(issues, DefaultBoardConfig(), theme)
+}
 
+// NewBoardModelWithConfig creates a Kanban board with the given columns
+func NewBoardModelWithConfig(issues []model.Issue, config BoardConfig, theme Theme) BoardModel {
 	b := BoardModel{
-		columns:    cols,
+		config:     config,
 		focusedCol: 0,
 		theme:      theme,
 	}
-	b.updateActiveColumns()
+	b.SetIssues(issues)
 	return b
 }
 
 // SetIssues updates the board data, typically after filtering
 func (b *BoardModel) SetIssues(issues []model.Issue) {
-	var cols [4][]model.Issue
+	cols := make([][]model.Issue, len(b.config.Columns))
 
+	// Distribute issues into columns; issues no column collects are left out
 	for _, issue := range issues {
-		switch issue.Status {
-		case model.StatusOpen:
-			cols[ColOpen] = append(cols[ColOpen], issue)
-		case model.StatusInProgress:
-			cols[ColInProgress] = append(cols[ColInProgress], issue)
-		case model.StatusBlocked:
-			cols[ColBlocked] = append(cols[ColBlocked], issue)
-		case model.StatusClosed:
-			cols[ColClosed] = append(cols[ColClosed], issue)
+		if col := b.config.columnOf(&issue); col >= 0 {
+			cols[col] = append(cols[col], issue)
 		}
 	}
 
 	// Sort each column
-	for i := 0; i < 4; i++ {
+	for i := range cols {
 		sortIssuesByPriorityAndDate(cols[i])
 	}
 
 	b.columns = cols
+	b.issues = issues
+	b.buildLanes()
+	b.updateActiveColumns()
+}
 
-	// Sanitize selection to prevent out-of-bounds
-	for i := 0; i < 4; i++ {
-		if b.selectedRow[i] >= len(b.columns[i]) {
-			if len(b.columns[i]) > 0 {
-				b.selectedRow[i] = len(b.columns[i]) - 1
-			} else {
-				b.selectedRow[i] = 0
+// buildLanes splits the columns into swimlanes. Each lane keeps its
+// selection, and the focused lane stays focused, across rebuilds.
+func (b *BoardModel) buildLanes() {
+	previous := make(map[string][]int, len(b.lanes))
+	for _, lane := range b.lanes {
+		previous[lane.key] = lane.selectedRow
+	}
+	focusedKey, hadFocus := "", false
+	if lane := b.lane(); lane != nil {
+		focusedKey, hadFocus = lane.key, true
+	}
+
+	byID := make(map[string]*model.Issue, len(b.issues))
+	for i := range b.issues {
+		byID[b.issues[i].ID] = &b.issues[i]
+	}
+
+	// Columns are already sorted, so each lane's cards keep their order
+	var lanes []boardLane
+	index := make(map[string]int)
+	for col, cards := range b.columns {
+		for _, issue := range cards {
+			key, title := b.laneOf(&issue, byID)
+			i, ok := index[key]
+			if !ok {
+				i = len(lanes)
+				index[key] = i
+				lanes = append(lanes, boardLane{key: key, title: title, columns: make([][]model.Issue, len(b.columns))})
 			}
+			lanes[i].columns[col] = append(lanes[i].columns[col], issue)
 		}
 	}
+	if len(lanes) == 0 {
+		lanes = []boardLane{{columns: make([][]model.Issue, len(b.columns))}}
+	}
 
-	b.updateActiveColumns()
+	// Named lanes in order, the catch-all lane (unassigned, no epic) last
+	sort.SliceStable(lanes, func(i, j int) bool {
+		if (lanes[i].key == "") != (lanes[j].key == "") {
+			return lanes[j].key == ""
+		}
+		if b.config.Lanes == LanesPriority {
+			return lanes[i].key < lanes[j].key
+		}
+		return strings.ToLower(lanes[i].title) < strings.ToLower(lanes[j].title)
+	})
+
+	b.focusedLane = 0
+	for i := range lanes {
+		lane := &lanes[i]
+		lane.selectedRow = make([]int, len(b.columns))
+		if prev := previous[lane.key]; len(prev) == len(b.columns) {
+			copy(lane.selectedRow, prev)
+		}
+		// Sanitize selection to prevent out-of-bounds
+		for col := range lane.columns {
+			if lane.selectedRow[col] >= len(lane.columns[col]) {
+				lane.selectedRow[col] = max(len(lane.columns[col])-1, 0)
+			}
+		}
+		if hadFocus && lane.key == focusedKey {
+			b.focusedLane = i
+		}
+	}
+	b.lanes = lanes
+}
+
+// laneOf returns the swimlane key and title of an issue
+func (b *BoardModel) laneOf(issue *model.Issue, byID map[string]*model.Issue) (string, string) {
+	switch b.config.Lanes {
+	case LanesAssignee:
+		if issue.Assignee == "" {
+			return "", "Unassigned"
+		}
+		return issue.Assignee, "@" + issue.Assignee
+	case LanesPriority:
+		band := min(max(issue.Priority, 0), len(priorityBands)-1)
+		return fmt.Sprintf("p%d", band), priorityBands[band]
+	case LanesEpic:
+		return epicLaneOf(issue, byID)
+	}
+	return "", ""
+}
+
+// epicLaneOf returns the lane of an issue's nearest epic ancestor. An epic
+// leads its own lane; a parent that isn't on the board names the lane
+// itself, since its type is unknown.
+func epicLaneOf(issue *model.Issue, byID map[string]*model.Issue) (string, string) {
+	seen := make(map[string]bool)
+	for cur := issue; !seen[cur.ID]; {
+		seen[cur.ID] = true
+		if cur.IssueType == model.TypeEpic {
+			return cur.ID, cur.ID + " " + cur.Title
+		}
+		pid := parentID(cur)
+		if pid == "" {
+			break
+		}
+		parent, ok := byID[pid]
+		if !ok {
+			return pid, pid
+		}
+		cur = parent
+	}
+	return "", "No epic"
+}
+
+// lane returns the focused swimlane, or nil before issues are set
+func (b *BoardModel) lane() *boardLane {
+	if b.focusedLane < 0 || b.focusedLane >= len(b.lanes) {
+		return nil
+	}
+	return &b.lanes[b.focusedLane]
+}
+
+// Lanes returns the swimlane mode, LanesNone when the board isn't split
+func (b *BoardModel) Lanes() string {
+	return b.config.Lanes
 }
 
-// actualFocusedCol returns the actual column index (0-3) being focused
+// SetStarred shares the watchlist with the board, which stars its cards
+func (b *BoardModel) SetStarred(starred map[string]bool) {
+	b.starred = starred
+}
+
+// SetLanes splits the board by mode, keeping the selected card selected
+func (b *BoardModel) SetLanes(mode string) {
+	var selectedID string
+	if issue := b.SelectedIssue(); issue != nil {
+		selectedID = issue.ID
+	}
+	b.config.Lanes = mode
+	b.lanes = nil
+	b.buildLanes()
+	if selectedID != "" {
+		b.SelectIssueByID(selectedID)
+	}
+}
+
+// CycleLanes moves to the next swimlane mode and returns it
+func (b *BoardModel) CycleLanes() string {
+	next := LanesAssignee
+	for i, mode := range laneModes {
+		if mode == b.config.Lanes {
+			next = laneModes[(i+1)%len(laneModes)]
+			break
+		}
+	}
+	b.SetLanes(next)
+	return next
+}
+
+// LaneCount returns the number of swimlanes
+func (b *BoardModel) LaneCount() int {
+	return len(b.lanes)
+}
+
+// LaneTitle returns the title of the focused swimlane, "" when the board
+// isn't split
+func (b *BoardModel) LaneTitle() string {
+	if lane := b.lane(); lane != nil && b.config.Lanes != LanesNone {
+		return lane.title
+	}
+	return ""
+}
+
+// NextLane focuses the swimlane below
+func (b *BoardModel) NextLane() {
+	if b.focusedLane < len(b.lanes)-1 {
+		b.focusedLane++
+	}
+}
+
+// PrevLane focuses the swimlane above
+func (b *BoardModel) PrevLane() {
+	if b.focusedLane > 0 {
+		b.focusedLane--
+	}
+}
+
+// focusedCell returns the focused lane and column, or nil and -1 for a
+// board without columns
+func (b *BoardModel) focusedCell() (*boardLane, int) {
+	col := b.actualFocusedCol()
+	lane := b.lane()
+	if col < 0 || lane == nil {
+		return nil, -1
+	}
+	return lane, col
+}
+
+// actualFocusedCol returns the actual column index being focused, or -1 for
+// a board without columns
 func (b *BoardModel) actualFocusedCol() int {
 	if len(b.activeColIdx) == 0 {
-		return 0
+		return -1
 	}
 	return b.activeColIdx[b.focusedCol]
 }
 
 // Navigation methods
 func (b *BoardModel) MoveDown() {
-	col := b.actualFocusedCol()
-	count := len(b.columns[col])
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	count := len(lane.columns[col])
 	if count == 0 {
 		return
 	}
-	if b.selectedRow[col] < count-1 {
-		b.selectedRow[col]++
+	if lane.selectedRow[col] < count-1 {
+		lane.selectedRow[col]++
 	}
 }
 
 func (b *BoardModel) MoveUp() {
-	col := b.actualFocusedCol()
-	if b.selectedRow[col] > 0 {
-		b.selectedRow[col]--
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	if lane.selectedRow[col] > 0 {
+		lane.selectedRow[col]--
 	}
 }
 
@@ -168,54 +371,166 @@ func (b *BoardModel) MoveLeft() {
 }
 
 func (b *BoardModel) MoveToTop() {
-	col := b.actualFocusedCol()
-	b.selectedRow[col] = 0
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	lane.selectedRow[col] = 0
 }
 
 func (b *BoardModel) MoveToBottom() {
-	col := b.actualFocusedCol()
-	count := len(b.columns[col])
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	count := len(lane.columns[col])
 	if count > 0 {
-		b.selectedRow[col] = count - 1
+		lane.selectedRow[col] = count - 1
 	}
 }
 
 func (b *BoardModel) PageDown(visibleRows int) {
-	col := b.actualFocusedCol()
-	count := len(b.columns[col])
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	count := len(lane.columns[col])
 	if count == 0 {
 		return
 	}
-	newRow := b.selectedRow[col] + visibleRows/2
+	newRow := lane.selectedRow[col] + visibleRows/2
 	if newRow >= count {
 		newRow = count - 1
 	}
-	b.selectedRow[col] = newRow
+	lane.selectedRow[col] = newRow
 }
 
 func (b *BoardModel) PageUp(visibleRows int) {
-	col := b.actualFocusedCol()
-	newRow := b.selectedRow[col] - visibleRows/2
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return
+	}
+	newRow := lane.selectedRow[col] - visibleRows/2
 	if newRow < 0 {
 		newRow = 0
 	}
-	b.selectedRow[col] = newRow
+	lane.selectedRow[col] = newRow
 }
 
 // SelectedIssue returns the currently selected issue, or nil if none
 func (b *BoardModel) SelectedIssue() *model.Issue {
-	col := b.actualFocusedCol()
-	cols := b.columns[col]
-	row := b.selectedRow[col]
+	lane, col := b.focusedCell()
+	if lane == nil {
+		return nil
+	}
+	cols := lane.columns[col]
+	row := lane.selectedRow[col]
 	if len(cols) > 0 && row < len(cols) {
 		return &cols[row]
 	}
 	return nil
 }
 
+// SelectIssueByID focuses the lane and column holding id and selects it.
+// Returns false if the board doesn't show id.
+func (b *BoardModel) SelectIssueByID(id string) bool {
+	for l := range b.lanes {
+		lane := &b.lanes[l]
+		for pos, col := range b.activeColIdx {
+			for row, issue := range lane.columns[col] {
+				if issue.ID == id {
+					b.focusedLane = l
+					b.focusedCol = pos
+					lane.selectedRow[col] = row
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// focusColumnIndex focuses column col, if it is shown
+func (b *BoardModel) focusColumnIndex(col int) {
+	for pos, c := range b.activeColIdx {
+		if c == col {
+			b.focusedCol = pos
+			return
+		}
+	}
+}
+
+// StartMove picks up the selected card so h/l can choose a column for it.
+// Returns false if no card is selected.
+func (b *BoardModel) StartMove() bool {
+	issue := b.SelectedIssue()
+	if issue == nil {
+		return false
+	}
+	b.moveID, b.moveFrom = issue.ID, b.actualFocusedCol()
+	b.updateActiveColumns()
+	b.focusColumnIndex(b.moveFrom)
+	return true
+}
+
+// Moving reports whether a card is being moved
+func (b *BoardModel) Moving() bool {
+	return b.moveID != ""
+}
+
+// MoveTarget returns the card being moved, the column it came from, and
+// the focused column it would drop into
+func (b *BoardModel) MoveTarget() (id string, from, to int) {
+	return b.moveID, b.moveFrom, b.actualFocusedCol()
+}
+
+// EndMove puts the card down, selecting it again where it now is
+func (b *BoardModel) EndMove() {
+	id, from := b.moveID, b.moveFrom
+	b.moveID = ""
+	b.updateActiveColumns()
+	if !b.SelectIssueByID(id) {
+		b.focusColumnIndex(from)
+	}
+}
+
+// Config returns the board's column layout
+func (b *BoardModel) Config() BoardConfig {
+	return b.config
+}
+
+// OverWIP reports whether a column holds more cards than its WIP limit
+func (b *BoardModel) OverWIP(col int) bool {
+	if col < 0 || col >= len(b.columns) {
+		return false
+	}
+	limit := b.config.Columns[col].WIP
+	return limit > 0 && len(b.columns[col]) > limit
+}
+
+// columnLook returns the header emoji and color of a column: a label
+// column's, or those of its first status
+func (b BoardModel) columnLook(col int) (string, lipgloss.AdaptiveColor) {
+	t := b.theme
+	c := b.config.Columns[col]
+	if len(c.Label) > 0 {
+		return "🏷️", t.Primary
+	}
+	switch model.Status(c.Status[0]) {
+	case model.StatusInProgress:
+		return "🔄", t.InProgress
+	case model.StatusBlocked:
+		return "🚫", t.Blocked
+	case model.StatusClosed:
+		return "✅", t.Closed
+	default:
+		return "📋", t.Open
+	}
+}
+
 // ColumnCount returns the number of issues in a column
 func (b *BoardModel) ColumnCount(col int) int {
-	if col >= 0 && col < 4 {
+	if col >= 0 && col < len(b.columns) {
 		return len(b.columns[col])
 	}
 	return 0
@@ -224,25 +539,17 @@ func (b *BoardModel) ColumnCount(col int) int {
 // TotalCount returns the total number of issues across all columns
 func (b *BoardModel) TotalCount() int {
 	total := 0
-	for i := 0; i < 4; i++ {
+	for i := range b.columns {
 		total += len(b.columns[i])
 	}
 	return total
 }
 
-// View renders the Kanban board with adaptive columns
-func (b BoardModel) View(width, height int) string {
-	t := b.theme
-
-	// Calculate how many columns we're showing
+// columnWidth is the inner width of each column for a board rendered at width
+func (b BoardModel) columnWidth(width int) int {
 	numCols := len(b.activeColIdx)
 	if numCols == 0 {
-		return t.Renderer.NewStyle().
-			Width(width).
-			Height(height).
-			Align(lipgloss.Center, lipgloss.Center).
-			Foreground(t.Secondary).
-			Render("No issues to display")
+		return 0
 	}
 
 	// Calculate column widths - distribute space proportionally
@@ -262,42 +569,184 @@ func (b BoardModel) View(width, height int) string {
 	if baseWidth > maxColWidth {
 		baseWidth = maxColWidth
 	}
+	return baseWidth
+}
+
+// ColumnAt returns the position (as used by FocusColumn) of the column drawn
+// at screen column x for a board rendered at width, or -1 if there is none
+func (b BoardModel) ColumnAt(x, width int) int {
+	colWidth := b.columnWidth(width)
+	if colWidth == 0 || x < 0 {
+		return -1
+	}
+	// Each column is its inner width plus a border on either side
+	i := x / (colWidth + 2)
+	if i >= len(b.activeColIdx) {
+		return -1
+	}
+	return i
+}
+
+// FocusColumn moves focus to the i-th visible column
+func (b *BoardModel) FocusColumn(i int) {
+	if i >= 0 && i < len(b.activeColIdx) {
+		b.focusedCol = i
+	}
+}
+
+// View renders the Kanban board with adaptive columns, split into
+// swimlanes if lanes are on
+func (b BoardModel) View(width, height int) string {
+	t := b.theme
 
-	colHeight := height - 4 // Account for header
-	if colHeight < 8 {
-		colHeight = 8
+	// Calculate how many columns we're showing
+	numCols := len(b.activeColIdx)
+	if numCols == 0 || len(b.lanes) == 0 {
+		return t.Renderer.NewStyle().
+			Width(width).
+			Height(height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Foreground(t.Secondary).
+			Render("No issues to display")
 	}
 
-	columnTitles := []string{"OPEN", "IN PROGRESS", "BLOCKED", "CLOSED"}
-	columnColors := []lipgloss.AdaptiveColor{t.Open, t.InProgress, t.Blocked, t.Closed}
-	columnEmoji := []string{"📋", "🔄", "🚫", "✅"}
+	baseWidth := b.columnWidth(width)
+	headers := b.renderColumnHeaders(baseWidth)
 
-	var renderedCols []string
+	if b.config.Lanes == LanesNone {
+		colHeight := height - 4 // Account for header
+		if colHeight < 8 {
+			colHeight = 8
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, headers, b.renderLane(&b.lanes[0], true, baseWidth, colHeight))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{headers}, b.renderLanes(width, height-lipgloss.Height(headers), baseWidth)...)...)
+}
 
+// renderColumnHeaders renders the row of column headers, with the card
+// count of each column across all lanes
+func (b BoardModel) renderColumnHeaders(baseWidth int) string {
+	t := b.theme
+	var headers []string
 	for i, colIdx := range b.activeColIdx {
 		isFocused := b.focusedCol == i
-		issues := b.columns[colIdx]
-		issueCount := len(issues)
-
-		// Header with emoji, title, and count
-		headerText := fmt.Sprintf("%s %s (%d)", columnEmoji[colIdx], columnTitles[colIdx], issueCount)
+		emoji, color := b.columnLook(colIdx)
+		overWIP := b.OverWIP(colIdx)
+
+		// Header with emoji, title, and count (of the WIP limit, if any)
+		issueCount := len(b.columns[colIdx])
+		count := fmt.Sprintf("%d", issueCount)
+		if limit := b.config.Columns[colIdx].WIP; limit > 0 {
+			count = fmt.Sprintf("%d/%d", issueCount, limit)
+		}
+		headerText := fmt.Sprintf("%s %s (%s)", emoji, strings.ToUpper(b.config.Columns[colIdx].Title), count)
+		if overWIP {
+			headerText += " ⚠"
+		}
+		if b.Moving() && isFocused && colIdx != b.moveFrom {
+			headerText = "⇣ " + headerText
+		}
 		headerStyle := t.Renderer.NewStyle().
 			Width(baseWidth).
 			Align(lipgloss.Center).
 			Bold(true).
 			Padding(0, 1)
 
-		if isFocused {
+		switch {
+		case overWIP:
+			// Over the WIP limit: a red header whether focused or not
+			headerStyle = headerStyle.
+				Background(t.Blocked).
+				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
+		case isFocused:
 			headerStyle = headerStyle.
-				Background(columnColors[colIdx]).
+				Background(color).
 				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
-		} else {
+		default:
 			headerStyle = headerStyle.
 				Background(lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#2a2a2a"}).
-				Foreground(columnColors[colIdx])
+				Foreground(color)
 		}
 
-		header := headerStyle.Render(headerText)
+		// Centered over its column, which is two border cells wider
+		headers = append(headers, lipgloss.PlaceHorizontal(baseWidth+2, lipgloss.Center, headerStyle.Render(headerText)))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, headers...)
+}
+
+// renderLanes renders as many swimlanes as fit in height, keeping the
+// focused lane in view. Lanes share the height, but each shows at least
+// one card.
+func (b BoardModel) renderLanes(width, height, baseWidth int) []string {
+	t := b.theme
+
+	// A lane is its summary line plus its bordered columns
+	const minLaneColHeight = 6
+	colHeight := height/len(b.lanes) - 3
+	shown := len(b.lanes)
+	if colHeight < minLaneColHeight {
+		colHeight = minLaneColHeight
+		// Leave a line to say how many lanes are out of view
+		shown = max((height-1)/(colHeight+3), 1)
+	}
+	start := 0
+	if b.focusedLane >= shown {
+		start = b.focusedLane - shown + 1
+	}
+	end := min(start+shown, len(b.lanes))
+
+	var rows []string
+	for l := start; l < end; l++ {
+		lane := &b.lanes[l]
+		rows = append(rows,
+			b.renderLaneSummary(lane, l == b.focusedLane, width),
+			b.renderLane(lane, l == b.focusedLane, baseWidth, colHeight))
+	}
+	if end-start < len(b.lanes) {
+		rows = append(rows, t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).
+			Render(fmt.Sprintf("  lanes %d–%d of %d · J/K switch lane", start+1, end, len(b.lanes))))
+	}
+	return rows
+}
+
+// renderLaneSummary renders a lane's header line: its title and total,
+// then its count in each shown column
+func (b BoardModel) renderLaneSummary(lane *boardLane, focused bool, width int) string {
+	t := b.theme
+	total := 0
+	var counts []string
+	for _, col := range b.activeColIdx {
+		n := len(lane.columns[col])
+		total += n
+		counts = append(counts, fmt.Sprintf("%s %d", b.config.Columns[col].Title, n))
+	}
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	marker := "  "
+	if focused {
+		titleStyle = titleStyle.Foreground(t.Primary)
+		marker = "▸ "
+	}
+	countStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	line := titleStyle.Render(fmt.Sprintf("%s%s (%d)", marker, lane.title, total)) +
+		countStyle.Render("  "+strings.Join(counts, " · "))
+	return t.Renderer.NewStyle().MaxWidth(width).Render(line)
+}
+
+// renderLane renders a lane's columns of cards side by side. Only the
+// focused lane shows its selection; every lane scrolls its columns on its
+// own.
+func (b BoardModel) renderLane(lane *boardLane, focusedLane bool, baseWidth, colHeight int) string {
+	t := b.theme
+
+	var renderedCols []string
+
+	for i, colIdx := range b.activeColIdx {
+		isFocused := focusedLane && b.focusedCol == i
+		issues := lane.columns[colIdx]
+		issueCount := len(issues)
+		_, color := b.columnLook(colIdx)
+		overWIP := b.OverWIP(colIdx)
 
 		// Calculate visible rows
 		// Cards have 3 content lines + 1 margin, plus borders:
@@ -310,7 +759,7 @@ func (b BoardModel) View(width, height int) string {
 			visibleCards = 1
 		}
 
-		sel := b.selectedRow[colIdx]
+		sel := lane.selectedRow[colIdx]
 		if sel >= issueCount && issueCount > 0 {
 			sel = issueCount - 1
 		}
@@ -368,14 +817,18 @@ func (b BoardModel) View(width, height int) string {
 			Padding(0, 1).
 			Border(lipgloss.RoundedBorder())
 
-		if isFocused {
-			colStyle = colStyle.BorderForeground(columnColors[colIdx])
-		} else {
+		switch {
+		case b.Moving() && isFocused:
+			colStyle = colStyle.BorderForeground(t.Primary).BorderStyle(lipgloss.DoubleBorder())
+		case isFocused:
+			colStyle = colStyle.BorderForeground(color)
+		case overWIP:
+			colStyle = colStyle.BorderForeground(t.Blocked)
+		default:
 			colStyle = colStyle.BorderForeground(t.Secondary)
 		}
 
-		column := lipgloss.JoinVertical(lipgloss.Center, header, colStyle.Render(content))
-		renderedCols = append(renderedCols, column)
+		renderedCols = append(renderedCols, colStyle.Render(content))
 	}
 
 	// Join columns with gaps
@@ -425,6 +878,9 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 		prioIcon,
 		t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary).Render(displayID),
 	)
+	if b.starred[issue.ID] {
+		line1 += " " + t.Renderer.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("★")
+	}
 
 	// ══════════════════════════════════════════════════════════════════════════
 	// LINE 2: Title with selection highlighting
@@ -444,10 +900,15 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 	line2 := titleStyle.Render(truncatedTitle)
 
 	// ══════════════════════════════════════════════════════════════════════════
-	// LINE 3: Metadata chips (assignee, deps, labels, age)
+	// LINE 3: Metadata chips (due, assignee, deps, labels, age)
 	// ══════════════════════════════════════════════════════════════════════════
 	var meta []string
 
+	// Due chip: overdue or due soon
+	if due := RenderDueBadge(&issue, time.Now()); due != "" {
+		meta = append(meta, due)
+	}
+
 	// Assignee chip
 	if issue.Assignee != "" {
 		assignee := truncateRunesHelper(issue.Assignee, 8, "…")
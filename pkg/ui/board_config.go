@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// BoardFilename holds the project's Kanban columns, in .bv/
+const BoardFilename = "board.yaml"
+
+// BoardColumn is one Kanban column. It holds the issues with one of its
+// statuses, or carrying one of its labels. Label columns take an issue
+// before status columns do, so a "review" label column can pull cards out
+// of "in_progress".
+type BoardColumn struct {
+	Title  string  `yaml:"title"`
+	Status keyList `yaml:"status,omitempty"` // One status or a list
+	Label  keyList `yaml:"label,omitempty"`  // One label or a list
+	WIP    int     `yaml:"wip,omitempty"`    // Work-in-progress limit; 0 for none
+}
+
+// BoardConfig is the column layout of the Kanban board
+type BoardConfig struct {
+	Columns []BoardColumn `yaml:"columns"`
+	// HideEmpty collapses columns without cards
+	HideEmpty bool `yaml:"hide_empty,omitempty"`
+	// Lanes splits the board into swimlanes: assignee, priority or epic
+	Lanes string `yaml:"swimlanes,omitempty"`
+}
+
+// DefaultBoardConfig returns the built-in board: one column per status,
+// with empty columns collapsed
+func DefaultBoardConfig() BoardConfig {
+	return BoardConfig{
+		Columns: []BoardColumn{
+			{Title: "Open", Status: keyList{string(model.StatusOpen)}},
+			{Title: "In Progress", Status: keyList{string(model.StatusInProgress)}},
+			{Title: "Blocked", Status: keyList{string(model.StatusBlocked)}},
+			{Title: "Closed", Status: keyList{string(model.StatusClosed)}},
+		},
+		HideEmpty: true,
+	}
+}
+
+// Validate reports columns without a title or a status or label to collect,
+// unknown statuses, negative WIP limits, and unknown swimlanes
+func (c BoardConfig) Validate() error {
+	if len(c.Columns) == 0 {
+		return fmt.Errorf("no columns")
+	}
+	if !slices.Contains(laneModes, c.Lanes) {
+		return fmt.Errorf("unknown swimlanes %q (known: assignee, priority, epic)", c.Lanes)
+	}
+	for i, col := range c.Columns {
+		name := col.Title
+		if name == "" {
+			return fmt.Errorf("column %d has no title", i+1)
+		}
+		switch {
+		case len(col.Status) == 0 && len(col.Label) == 0:
+			return fmt.Errorf("column %q needs a status or a label", name)
+		case len(col.Status) > 0 && len(col.Label) > 0:
+			return fmt.Errorf("column %q has both a status and a label", name)
+		case col.WIP < 0:
+			return fmt.Errorf("column %q: wip must not be negative", name)
+		}
+		for _, s := range col.Status {
+			if !model.Status(s).IsValid() {
+				return fmt.Errorf("column %q: unknown status %q", name, s)
+			}
+		}
+	}
+	return nil
+}
+
+// BoardConfigPath returns the board layout path for a project
+func BoardConfigPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", BoardFilename)
+}
+
+// LoadBoardConfig reads .bv/board.yaml, returning the default board if there
+// is none. A broken file returns the default with an error.
+func LoadBoardConfig(projectDir string) (BoardConfig, error) {
+	data, err := os.ReadFile(BoardConfigPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultBoardConfig(), nil
+		}
+		return DefaultBoardConfig(), fmt.Errorf("reading board: %w", err)
+	}
+	var cfg BoardConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return DefaultBoardConfig(), fmt.Errorf("parsing board: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return DefaultBoardConfig(), fmt.Errorf("%s: %w", BoardFilename, err)
+	}
+	return cfg, nil
+}
+
+// columnOf returns the column an issue belongs in, or -1 if none collects it
+func (c BoardConfig) columnOf(issue *model.Issue) int {
+	for i, col := range c.Columns {
+		for _, label := range col.Label {
+			if slices.Contains(issue.Labels, label) {
+				return i
+			}
+		}
+	}
+	for i, col := range c.Columns {
+		if slices.Contains(col.Status, string(issue.Status)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// moveIssue changes issue so it lands in column to. Moving into a status
+// column sets the column's first status and drops the labels of every
+// label column; moving into a label column adds its first label. Closing
+// stamps closed_at and reopening clears it.
+func (c BoardConfig) moveIssue(issue *model.Issue, to int, now time.Time) {
+	dst := c.Columns[to]
+	if len(dst.Label) > 0 {
+		for i, col := range c.Columns {
+			if i != to && len(col.Label) > 0 {
+				issue.Labels = slices.DeleteFunc(issue.Labels, func(l string) bool { return slices.Contains(col.Label, l) })
+			}
+		}
+		if !slices.ContainsFunc(issue.Labels, func(l string) bool { return slices.Contains(dst.Label, l) }) {
+			issue.Labels = append(issue.Labels, dst.Label[0])
+		}
+	} else {
+		for _, col := range c.Columns {
+			issue.Labels = slices.DeleteFunc(issue.Labels, func(l string) bool { return slices.Contains(col.Label, l) })
+		}
+		status := model.Status(dst.Status[0])
+		switch {
+		case status.IsClosed() && !issue.Status.IsClosed():
+			issue.ClosedAt = &now
+		case !status.IsClosed():
+			issue.ClosedAt = nil
+		}
+		issue.Status = status
+	}
+	issue.UpdatedAt = now
+}
@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestBoardConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  BoardConfig
+		want string
+	}{
+		{"default", DefaultBoardConfig(), ""},
+		{"empty", BoardConfig{}, "no columns"},
+		{"no title", BoardConfig{Columns: []BoardColumn{{Status: keyList{"open"}}}}, "no title"},
+		{"nothing collected", BoardConfig{Columns: []BoardColumn{{Title: "Todo"}}}, "needs a status or a label"},
+		{"both", BoardConfig{Columns: []BoardColumn{{Title: "Todo", Status: keyList{"open"}, Label: keyList{"x"}}}}, "both"},
+		{"negative wip", BoardConfig{Columns: []BoardColumn{{Title: "Todo", Status: keyList{"open"}, WIP: -1}}}, "negative"},
+		{"unknown status", BoardConfig{Columns: []BoardColumn{{Title: "Todo", Status: keyList{"todo"}}}}, "unknown status"},
+	}
+	for _, tt := range tests {
+		err := tt.cfg.Validate()
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestLoadBoardConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadBoardConfig(dir)
+	if err != nil || len(cfg.Columns) != 4 || !cfg.HideEmpty {
+		t.Fatalf("missing board.yaml should give the default board, got %+v, %v", cfg, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := `columns:
+  - title: Todo
+    status: [open, blocked]
+  - title: Review
+    label: review
+    wip: 2
+  - title: Done
+    status: closed
+`
+	if err := os.WriteFile(BoardConfigPath(dir), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadBoardConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Columns) != 3 || cfg.HideEmpty {
+		t.Fatalf("got %+v", cfg)
+	}
+	if review := cfg.Columns[1]; review.WIP != 2 || !slices.Equal(review.Label, keyList{"review"}) {
+		t.Errorf("review column = %+v", review)
+	}
+	if !slices.Equal(cfg.Columns[0].Status, keyList{"open", "blocked"}) {
+		t.Errorf("todo statuses = %v", cfg.Columns[0].Status)
+	}
+
+	if err := os.WriteFile(BoardConfigPath(dir), []byte("columns:\n  - title: Todo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, err := LoadBoardConfig(dir); err == nil || len(cfg.Columns) != 4 {
+		t.Errorf("broken board.yaml should fall back to the default with an error, got %v", err)
+	}
+}
+
+func testBoardConfig() BoardConfig {
+	return BoardConfig{Columns: []BoardColumn{
+		{Title: "Todo", Status: keyList{"open", "blocked"}},
+		{Title: "Doing", Status: keyList{"in_progress"}},
+		{Title: "Review", Label: keyList{"review"}, WIP: 1},
+		{Title: "Done", Status: keyList{"closed"}},
+	}}
+}
+
+func TestBoardConfigColumnOfAndMove(t *testing.T) {
+	cfg := testBoardConfig()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	issue := model.Issue{ID: "b-1", Status: model.StatusInProgress, Labels: []string{"api"}}
+	if got := cfg.columnOf(&issue); got != 1 {
+		t.Errorf("in_progress issue in column %d, want 1", got)
+	}
+	if got := cfg.columnOf(&model.Issue{Status: model.Status("deferred")}); got != -1 {
+		t.Errorf("uncollected issue in column %d, want -1", got)
+	}
+
+	// Label columns take issues before status columns
+	cfg.moveIssue(&issue, 2, now)
+	if got := cfg.columnOf(&issue); got != 2 || issue.Status != model.StatusInProgress {
+		t.Errorf("after moving to Review: column %d, status %s", got, issue.Status)
+	}
+	if !slices.Equal(issue.Labels, []string{"api", "review"}) || !issue.UpdatedAt.Equal(now) {
+		t.Errorf("labels = %v, updated = %v", issue.Labels, issue.UpdatedAt)
+	}
+
+	// Moving to a status column drops the label and closing stamps closed_at
+	cfg.moveIssue(&issue, 3, now)
+	if issue.Status != model.StatusClosed || issue.ClosedAt == nil || !slices.Equal(issue.Labels, []string{"api"}) {
+		t.Errorf("after moving to Done: %+v", issue)
+	}
+	cfg.moveIssue(&issue, 0, now)
+	if issue.Status != model.StatusOpen || issue.ClosedAt != nil {
+		t.Errorf("reopening should clear closed_at: %+v", issue)
+	}
+}
+
+func TestBoardView_WIPLimit(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "b-1", Title: "One", Status: model.StatusOpen, Labels: []string{"review"}},
+		{ID: "b-2", Title: "Two", Status: model.StatusOpen, Labels: []string{"review"}},
+		{ID: "b-3", Title: "Three", Status: model.StatusOpen},
+	}
+	b := NewBoardModelWithConfig(issues, testBoardConfig(), DefaultTheme(lipgloss.NewRenderer(os.Stdout)))
+	if b.ColumnCount(2) != 2 || !b.OverWIP(2) || b.OverWIP(0) {
+		t.Fatalf("review column count %d, over WIP %v", b.ColumnCount(2), b.OverWIP(2))
+	}
+	view := b.View(160, 30)
+	for _, want := range []string{"REVIEW (2/1) ⚠", "TODO (1)", "DOING (0)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("board view missing %q", want)
+		}
+	}
+}
+
+func TestModel_MoveBoardCard(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"mv-1","title":"Card","status":"open","issue_type":"task"}` + "\n"
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	board := "columns:\n  - {title: Todo, status: open}\n  - {title: Review, label: review}\n  - {title: Done, status: closed}\n"
+	if err := os.WriteFile(BoardConfigPath(dir), []byte(board), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("b")
+	if !m.isBoardView || m.board.ColumnCount(0) != 1 {
+		t.Fatal("b should open the board with the card in Todo")
+	}
+
+	// Cancelling leaves the card where it was
+	press(" ")
+	press("l")
+	press("esc")
+	if m.board.Moving() || m.board.ColumnCount(0) != 1 {
+		t.Fatal("esc should cancel the move")
+	}
+
+	press(" ")
+	if !m.board.Moving() {
+		t.Fatal("space should pick up the card")
+	}
+	press("l")
+	press("enter")
+	if m.board.Moving() || m.board.ColumnCount(1) != 1 || !strings.Contains(m.statusMsg, "Moved mv-1 to Review") {
+		t.Fatalf("enter should drop the card into Review, status %q", m.statusMsg)
+	}
+	if got := m.board.SelectedIssue(); got == nil || got.ID != "mv-1" {
+		t.Error("the moved card should stay selected")
+	}
+
+	press(" ")
+	press("l")
+	press("enter")
+	reloaded, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := reloaded[0]
+	if got.Status != model.StatusClosed || got.ClosedAt == nil || len(got.Labels) != 0 {
+		t.Errorf("moving to Done should close the issue and drop the review label, got %+v", got)
+	}
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func laneTitles(b *BoardModel) []string {
+	var titles []string
+	for _, lane := range b.lanes {
+		titles = append(titles, lane.title)
+	}
+	return titles
+}
+
+func TestBoardSwimlanes(t *testing.T) {
+	child := func(id, parent string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}
+	}
+	issues := []model.Issue{
+		{ID: "e-1", Title: "Billing", Status: model.StatusOpen, IssueType: model.TypeEpic, Priority: 1},
+		{ID: "t-1", Title: "Invoice", Status: model.StatusOpen, Assignee: "bob", Priority: 0, Dependencies: child("t-1", "e-1")},
+		{ID: "t-2", Title: "Refund", Status: model.StatusInProgress, Assignee: "alice", Priority: 2, Dependencies: child("t-2", "t-1")},
+		{ID: "t-3", Title: "Docs", Status: model.StatusOpen, Assignee: "alice", Priority: 4},
+		{ID: "t-4", Title: "Orphan", Status: model.StatusOpen, Priority: 3, Dependencies: child("t-4", "gone")},
+	}
+	b := NewBoardModel(issues, DefaultTheme(lipgloss.NewRenderer(os.Stdout)))
+	if b.LaneCount() != 1 || b.LaneTitle() != "" {
+		t.Fatalf("an unsplit board has one untitled lane, got %v", laneTitles(&b))
+	}
+
+	b.SetLanes(LanesAssignee)
+	if got := strings.Join(laneTitles(&b), ","); got != "@alice,@bob,Unassigned" {
+		t.Errorf("assignee lanes = %s", got)
+	}
+	b.SetLanes(LanesPriority)
+	if got := strings.Join(laneTitles(&b), ","); got != "P0 Critical,P1 High,P2 Medium,P3+ Low" {
+		t.Errorf("priority lanes = %s", got)
+	}
+	// Grandchildren find their epic; a parent off the board names its lane
+	b.SetLanes(LanesEpic)
+	if got := strings.Join(laneTitles(&b), ","); got != "e-1 Billing,gone,No epic" {
+		t.Errorf("epic lanes = %s", got)
+	}
+	if n := len(b.lanes[0].columns[ColOpen]) + len(b.lanes[0].columns[ColInProgress]); n != 3 {
+		t.Errorf("Billing lane holds %d cards, want 3", n)
+	}
+
+	// Each lane keeps its own selection
+	b.SetLanes(LanesAssignee)
+	if !b.SelectIssueByID("t-3") || b.LaneTitle() != "@alice" {
+		t.Fatal("selecting t-3 should focus alice's lane")
+	}
+	b.NextLane()
+	if got := b.SelectedIssue(); got == nil || got.ID != "t-1" || b.LaneTitle() != "@bob" {
+		t.Errorf("J should move to bob's lane, got %v", got)
+	}
+	b.PrevLane()
+	if got := b.SelectedIssue(); got == nil || got.ID != "t-3" {
+		t.Errorf("alice's lane should keep t-3 selected, got %v", got)
+	}
+
+	// The selection survives a rebuild and switching lanes off
+	b.SetIssues(issues)
+	if got := b.SelectedIssue(); got == nil || got.ID != "t-3" {
+		t.Errorf("rebuild lost the selection, got %v", got)
+	}
+	b.SetLanes(LanesNone)
+	if got := b.SelectedIssue(); got == nil || got.ID != "t-3" {
+		t.Errorf("turning lanes off lost the selection, got %v", got)
+	}
+}
+
+func TestBoardView_LaneSummaries(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "t-1", Title: "One", Status: model.StatusOpen, Assignee: "alice"},
+		{ID: "t-2", Title: "Two", Status: model.StatusInProgress, Assignee: "alice"},
+		{ID: "t-3", Title: "Three", Status: model.StatusOpen},
+	}
+	cfg := DefaultBoardConfig()
+	cfg.Lanes = LanesAssignee
+	b := NewBoardModelWithConfig(issues, cfg, DefaultTheme(lipgloss.NewRenderer(os.Stdout)))
+	view := b.View(160, 40)
+	for _, want := range []string{"▸ @alice (2)", "Open 1 · In Progress 1", "Unassigned (1)", "OPEN (2)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("board view missing %q", want)
+		}
+	}
+
+	// Lanes that don't fit say so
+	many := make([]model.Issue, 0, 8)
+	for _, who := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		many = append(many, model.Issue{ID: "m-" + who, Title: who, Status: model.StatusOpen, Assignee: who})
+	}
+	b = NewBoardModelWithConfig(many, cfg, DefaultTheme(lipgloss.NewRenderer(os.Stdout)))
+	if view := b.View(160, 30); !strings.Contains(view, "lanes 1–3 of 8") {
+		t.Errorf("expected a lane window indicator, got:\n%s", view)
+	}
+}
+
+func TestBoardConfigValidate_Lanes(t *testing.T) {
+	cfg := DefaultBoardConfig()
+	cfg.Lanes = "team"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "unknown swimlanes") {
+		t.Errorf("error = %v", err)
+	}
+	cfg.Lanes = LanesEpic
+	if err := cfg.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestModel_BoardSwimlaneKeys(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "t-1", Title: "One", Status: model.StatusOpen, Assignee: "alice"},
+		{ID: "t-2", Title: "Two", Status: model.StatusOpen, Assignee: "bob"},
+	}
+	m := NewModel(issues, nil, "")
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("b")
+	press("s")
+	if m.board.Lanes() != LanesAssignee || !strings.Contains(m.statusMsg, "Swimlanes by assignee") {
+		t.Fatalf("s should split the board by assignee, status %q", m.statusMsg)
+	}
+	press("J")
+	if got := m.board.SelectedIssue(); got == nil || got.ID != "t-2" {
+		t.Errorf("J should move to bob's lane, got %v", got)
+	}
+	for range laneModes[1:] {
+		press("s")
+	}
+	if m.board.Lanes() != LanesNone || m.statusMsg != "Swimlanes off" {
+		t.Errorf("cycling past epic should turn lanes off, got %q", m.board.Lanes())
+	}
+}
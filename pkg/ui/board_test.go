@@ -368,3 +368,22 @@ func TestViewRendering(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardSelectIssueByID(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "o1", Status: model.StatusOpen, Priority: 1, CreatedAt: createTime(0)},
+		{ID: "o2", Status: model.StatusOpen, Priority: 2, CreatedAt: createTime(0)},
+		{ID: "c1", Status: model.StatusClosed, Priority: 1, CreatedAt: createTime(0)},
+	}
+	b := ui.NewBoardModel(issues, createTheme())
+
+	if !b.SelectIssueByID("c1") || b.SelectedIssue().ID != "c1" {
+		t.Errorf("Expected c1 selected, got %+v", b.SelectedIssue())
+	}
+	if !b.SelectIssueByID("o2") || b.SelectedIssue().ID != "o2" {
+		t.Errorf("Expected o2 selected, got %+v", b.SelectedIssue())
+	}
+	if b.SelectIssueByID("missing") || b.SelectedIssue().ID != "o2" {
+		t.Error("Unknown IDs should leave the selection alone")
+	}
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openBottlenecks ranks the issues whose removal most disconnects the graph
+func (m *Model) openBottlenecks() {
+	m.refreshBottlenecks()
+	if len(m.bottlenecks) == 0 {
+		m.showBottlenecks = false
+		m.statusMsg = "No bottlenecks: no issue holds the dependency graph together"
+		m.statusIsError = false
+		return
+	}
+	m.bottleneckCursor = 0
+	m.showBottlenecks = true
+}
+
+// refreshBottlenecks re-ranks after a reload or once betweenness is ready
+func (m *Model) refreshBottlenecks() {
+	if m.analyzer == nil {
+		m.bottlenecks = nil
+		return
+	}
+	var betweenness map[string]float64
+	if m.analysis != nil {
+		betweenness = m.analysis.Betweenness()
+	}
+	m.bottlenecks = m.analyzer.Bottlenecks(betweenness)
+	if m.bottleneckCursor >= len(m.bottlenecks) {
+		m.bottleneckCursor = max(0, len(m.bottlenecks)-1)
+	}
+}
+
+// handleBottlenecksKeys handles keys while the bottlenecks panel is open
+func (m Model) handleBottlenecksKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.bottleneckCursor < len(m.bottlenecks)-1 {
+			m.bottleneckCursor++
+		}
+	case "k", "up":
+		if m.bottleneckCursor > 0 {
+			m.bottleneckCursor--
+		}
+	case "enter":
+		if m.bottleneckCursor < len(m.bottlenecks) {
+			m.showBottlenecks = false
+			m.jumpToIssue(m.bottlenecks[m.bottleneckCursor].ID)
+		}
+	case "esc", "q", "I":
+		m.showBottlenecks = false
+	}
+	return m
+}
+
+// renderBottlenecksPanel renders the bottleneck ranking
+func (m Model) renderBottlenecksPanel() string {
+	t := m.theme
+
+	boxWidth := min(100, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("⛓ Bottlenecks"))
+	sb.WriteString("\n")
+	cuts := 0
+	for _, b := range m.bottlenecks {
+		if b.CutOff > 0 {
+			cuts++
+		}
+	}
+	summary := fmt.Sprintf("%d issues hold the graph together; removing one cuts off the issues shown", cuts)
+	if m.analysis != nil && !m.analysis.IsPhase2Ready() {
+		summary += " • betweenness still computing"
+	}
+	sb.WriteString(mutedStyle.Render(truncateRunesHelper(summary, boxWidth-6, "…")))
+	sb.WriteString("\n\n")
+
+	maxBW := 0.0
+	for _, b := range m.bottlenecks {
+		maxBW = max(maxBW, b.Betweenness)
+	}
+
+	visible := max(3, m.height-14)
+	start := 0
+	if m.bottleneckCursor >= visible {
+		start = m.bottleneckCursor - visible + 1
+	}
+	end := min(len(m.bottlenecks), start+visible)
+	if start > 0 {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↑ %d more", start)))
+		sb.WriteString("\n")
+	}
+
+	const barWidth = 8
+	for i := start; i < end; i++ {
+		b := m.bottlenecks[i]
+		selected := i == m.bottleneckCursor
+		cursor := "  "
+		style := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+		if selected {
+			cursor = "▸ "
+			style = style.Bold(true).Foreground(t.Primary)
+		}
+
+		cut := mutedStyle.Render(fmt.Sprintf("%-20s", "no cut"))
+		if b.CutOff > 0 {
+			cut = t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("%-20s", fmt.Sprintf("cuts off %d, %d parts", b.CutOff, b.Pieces)))
+		}
+		filled := 0
+		if maxBW > 0 {
+			filled = int(b.Betweenness / maxBW * barWidth)
+		}
+		bar := t.Renderer.NewStyle().Foreground(t.Secondary).Render(strings.Repeat("█", filled)) +
+			mutedStyle.Render(strings.Repeat("░", barWidth-filled))
+
+		prefix := fmt.Sprintf("%s%-12s ", cursor, truncateRunesHelper(b.ID, 12, "…"))
+		title := truncateRunesHelper(b.Title, max(10, boxWidth-lipgloss.Width(prefix)-20-barWidth-10), "…")
+		sb.WriteString(style.Render(prefix) + cut + " " + bar + " " + style.Render(title))
+		sb.WriteString("\n")
+	}
+	if end < len(m.bottlenecks) {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.bottlenecks)-end)))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("cuts off N, K parts: without it the graph splits in K, N issues apart from the largest • bar: betweenness"))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: move • Enter: jump to issue • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
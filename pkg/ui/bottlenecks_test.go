@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_Bottlenecks(t *testing.T) {
+	dep := func(id, on string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "hub", Title: "Shared schema", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "api", Title: "API", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: dep("api", "hub")},
+		{ID: "cli", Title: "CLI", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: dep("cli", "hub")},
+		{ID: "web", Title: "Web", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: dep("web", "api")},
+		{ID: "solo", Title: "Unlinked", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	m = press(m, "I")
+	if !m.showBottlenecks || len(m.bottlenecks) < 2 {
+		t.Fatalf("Expected the bottlenecks panel with hub and api, got %+v", m.bottlenecks)
+	}
+	// Both cut one issue off; api also lies between web and hub
+	if m.bottlenecks[0].ID != "api" || m.bottlenecks[0].CutOff != 1 || m.bottlenecks[1].ID != "hub" {
+		t.Fatalf("Expected api then hub, got %+v", m.bottlenecks)
+	}
+	view := m.View()
+	for _, want := range []string{"Bottlenecks", "cuts off 1, 2 parts", "Shared schema"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in the panel:\n%s", want, view)
+		}
+	}
+
+	m = press(m, "j", "enter")
+	if m.showBottlenecks {
+		t.Fatal("Expected enter to close the panel")
+	}
+	if got := m.selectedListID(); got != "hub" {
+		t.Errorf("Expected enter to jump to hub, got %q", got)
+	}
+}
@@ -0,0 +1,273 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Width limits for a column sized in the editor
+const (
+	minColumnWidth = 1
+	maxColumnWidth = 60
+)
+
+// columnEditorRow is one column in the editor, shown or not
+type columnEditorRow struct {
+	name  string
+	on    bool
+	width int // 0 uses the column's default
+}
+
+// ColumnEditorModel represents the list column editor overlay. Shown columns
+// come first in list order, followed by the hidden ones.
+type ColumnEditorModel struct {
+	rows          []columnEditorRow
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewColumnEditorModel creates a column editor for the given layout
+func NewColumnEditorModel(cols ListColumns, theme Theme) ColumnEditorModel {
+	m := ColumnEditorModel{theme: theme}
+	m.setColumns(cols)
+	return m
+}
+
+func (m *ColumnEditorModel) setColumns(cols ListColumns) {
+	m.rows = m.rows[:0]
+	for _, col := range cols {
+		m.rows = append(m.rows, columnEditorRow{name: col.Name, on: true, width: col.Width})
+	}
+	for _, name := range listColumnNames {
+		if !cols.Has(name) {
+			m.rows = append(m.rows, columnEditorRow{name: name})
+		}
+	}
+}
+
+// SetSize updates the editor dimensions
+func (m *ColumnEditorModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *ColumnEditorModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *ColumnEditorModel) MoveDown() {
+	if m.selectedIndex < len(m.rows)-1 {
+		m.selectedIndex++
+	}
+}
+
+// Toggle shows or hides the selected column. The title can't be hidden.
+func (m *ColumnEditorModel) Toggle() bool {
+	row := &m.rows[m.selectedIndex]
+	if row.name == ColumnTitle {
+		return false
+	}
+	row.on = !row.on
+	return true
+}
+
+// Shift moves the selected column delta places, carrying the selection
+func (m *ColumnEditorModel) Shift(delta int) {
+	to := m.selectedIndex + delta
+	if to < 0 || to >= len(m.rows) {
+		return
+	}
+	m.rows[m.selectedIndex], m.rows[to] = m.rows[to], m.rows[m.selectedIndex]
+	m.selectedIndex = to
+}
+
+// Resize widens or narrows the selected column. The title takes whatever
+// width is left, so it has no width of its own.
+func (m *ColumnEditorModel) Resize(delta int) bool {
+	row := &m.rows[m.selectedIndex]
+	if row.name == ColumnTitle {
+		return false
+	}
+	w := row.width
+	if w == 0 {
+		w = listColumnSpecs[row.name].width
+		if w == 0 {
+			w = 12 // An auto-sized ID column
+		}
+	}
+	row.width = min(max(w+delta, minColumnWidth), maxColumnWidth)
+	return true
+}
+
+// ResetWidth returns the selected column to its default width
+func (m *ColumnEditorModel) ResetWidth() {
+	m.rows[m.selectedIndex].width = 0
+}
+
+// Reset restores the built-in layout
+func (m *ColumnEditorModel) Reset() {
+	m.setColumns(DefaultListColumns())
+	m.selectedIndex = 0
+}
+
+// Columns returns the edited layout: the shown columns in order
+func (m *ColumnEditorModel) Columns() ListColumns {
+	var cols ListColumns
+	for _, row := range m.rows {
+		if row.on {
+			cols = append(cols, ListColumn{Name: row.name, Width: row.width})
+		}
+	}
+	return cols
+}
+
+// View renders the column editor overlay
+func (m *ColumnEditorModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 60
+	if m.width < 70 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("List Columns"))
+	lines = append(lines, "")
+
+	descStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+
+	for i, row := range m.rows {
+		spec := listColumnSpecs[row.name]
+		isSelected := i == m.selectedIndex
+
+		nameStyle := t.Renderer.NewStyle()
+		switch {
+		case isSelected:
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		case row.on:
+			nameStyle = nameStyle.Foreground(t.Base.GetForeground())
+		default:
+			nameStyle = nameStyle.Foreground(t.Muted)
+		}
+
+		prefix := "  "
+		if isSelected {
+			prefix = "▸ "
+		}
+		check := "[ ]"
+		if row.on {
+			check = "[x]"
+		}
+		width := "auto"
+		switch {
+		case row.name == ColumnTitle:
+			width = "rest"
+		case row.width > 0:
+			width = fmt.Sprintf("%d", row.width)
+		case spec.width > 0:
+			width = fmt.Sprintf("%d", spec.width)
+		}
+		name := fmt.Sprintf("%s%s %-9s %4s  ", prefix, check, spec.header, width)
+		desc := truncateRunesHelper(spec.desc, max(boxWidth-4-lipgloss.Width(name), 5), "…")
+		lines = append(lines, nameStyle.Render(name)+descStyle.Render(desc))
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("space: show/hide • J/K: move • +/-: width • 0: auto"))
+	lines = append(lines, footerStyle.Render("r: defaults • enter: save • esc: cancel"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
+
+// openColumnEditor opens the column editor on the current layout
+func (m Model) openColumnEditor() Model {
+	m.columnEditor = NewColumnEditorModel(m.listColumns, m.theme)
+	m.columnEditor.SetSize(m.width, m.height-1)
+	m.showColumnEditor = true
+	return m
+}
+
+// handleColumnEditorKeys handles keyboard input when the column editor is
+// open. Changes apply on enter, which also saves them for the user.
+func (m Model) handleColumnEditorKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.columnEditor.MoveDown()
+	case "k", "up":
+		m.columnEditor.MoveUp()
+	case "J", "shift+down":
+		m.columnEditor.Shift(1)
+	case "K", "shift+up":
+		m.columnEditor.Shift(-1)
+	case " ", "x":
+		if !m.columnEditor.Toggle() {
+			m.statusMsg = "The title column is always shown"
+			m.statusIsError = true
+		}
+	case "+", "=", "right", "l":
+		m.columnEditor.Resize(1)
+	case "-", "left", "h":
+		m.columnEditor.Resize(-1)
+	case "0":
+		m.columnEditor.ResetWidth()
+	case "r":
+		m.columnEditor.Reset()
+	case "esc", "q":
+		m.showColumnEditor = false
+	case "enter":
+		m.listColumns = m.columnEditor.Columns()
+		m.list.SetDelegate(m.issueDelegate())
+		if err := SaveListColumns(m.listColumns); err != nil {
+			m.statusMsg = fmt.Sprintf("Columns applied but not saved: %v", err)
+			m.statusIsError = true
+		} else {
+			m.statusMsg = "List columns saved to ~/.config/bv/" + ColumnsFilename
+			m.statusIsError = false
+		}
+		m.showColumnEditor = false
+	}
+	return m
+}
@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newCommentInput creates the multi-line editor used by the comment overlay
+func newCommentInput() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "Write a comment…"
+	ta.ShowLineNumbers = false
+	ta.CharLimit = 0
+	ta.SetWidth(60)
+	ta.SetHeight(6)
+	return ta
+}
+
+// commentAuthor returns the author recorded on new comments: git's user.name
+// (or user.email) for the project, falling back to $USER
+func commentAuthor(projectDir string) string {
+	for _, key := range []string{"user.name", "user.email"} {
+		out, err := exec.Command("git", "-C", projectDir, "config", key).Output()
+		if err != nil {
+			continue
+		}
+		if author := strings.TrimSpace(string(out)); author != "" {
+			return author
+		}
+	}
+	if user := os.Getenv("USER"); user != "" {
+		return user
+	}
+	return "unknown"
+}
+
+// openCommentEditor shows the comment overlay for the selected issue
+func (m *Model) openCommentEditor() {
+	if m.beadsPath == "" {
+		m.statusMsg = "Comments need a single beads file (not available in workspace mode)"
+		m.statusIsError = true
+		return
+	}
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+
+	width := m.width - 16
+	if width > 72 {
+		width = 72
+	}
+	if width < 30 {
+		width = 30
+	}
+	m.commentInput.Reset()
+	m.commentInput.SetWidth(width)
+	m.commentInput.Focus()
+	m.commentIssueID = issueItem.Issue.ID
+	m.commentAuthor = commentAuthor(projectDirFromBeadsPath(m.beadsPath))
+	m.showCommentEditor = true
+	m.focused = focusCommentEditor
+}
+
+// closeCommentEditor hides the comment overlay and returns focus to the issue
+func (m *Model) closeCommentEditor() {
+	m.showCommentEditor = false
+	m.commentInput.Blur()
+	if m.isSplitView || m.showDetails {
+		m.focused = focusDetail
+	} else {
+		m.focused = focusList
+	}
+}
+
+// handleCommentEditorKeys handles keyboard input for the comment overlay.
+// Enter inserts a newline; ctrl+s saves.
+func (m Model) handleCommentEditorKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc":
+		m.closeCommentEditor()
+	case "ctrl+s":
+		m.submitComment()
+	default:
+		m.commentInput, _ = m.commentInput.Update(msg)
+	}
+	return m
+}
+
+// submitComment appends the editor's text to the issue's JSONL record. On
+// failure the overlay stays open so the text isn't lost.
+func (m *Model) submitComment() {
+	text := strings.TrimSpace(m.commentInput.Value())
+	if text == "" {
+		m.statusMsg = "Comment is empty"
+		m.statusIsError = true
+		return
+	}
+
+	comment, err := loader.AppendComment(m.beadsPath, m.commentIssueID, model.Comment{
+		Author:    m.commentAuthor,
+		Text:      text,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Comment not saved: %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	// Show the comment now rather than waiting for the file watcher's reload
+	if issue, ok := m.issueMap[comment.IssueID]; ok {
+		issue.Comments = append(issue.Comments, &comment)
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == comment.IssueID {
+				issueItem.Issue.Comments = issue.Comments
+				m.list.SetItem(i, issueItem)
+				break
+			}
+		}
+	}
+
+	m.closeCommentEditor()
+	m.statusMsg = fmt.Sprintf("💬 Comment added to %s", comment.IssueID)
+	m.statusIsError = false
+	m.updateViewportContent()
+}
+
+// renderCommentEditor renders the comment overlay
+func (m Model) renderCommentEditor() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+
+	subtitleStyle := t.Renderer.NewStyle().
+		Foreground(t.Subtext).
+		Italic(true)
+
+	keyStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+
+	textStyle := t.Renderer.NewStyle().
+		Foreground(t.Base.GetForeground())
+
+	subtitle := m.commentIssueID
+	if issue, ok := m.issueMap[m.commentIssueID]; ok {
+		subtitle += " · " + truncateRunesHelper(issue.Title, m.commentInput.Width()-len(subtitle)-3, "…")
+	}
+
+	content := titleStyle.Render("💬 Add Comment") + "\n" +
+		subtitleStyle.Render(subtitle) + "\n\n" +
+		m.commentInput.View() + "\n\n" +
+		subtitleStyle.Render("as "+m.commentAuthor) + "\n" +
+		keyStyle.Render("Ctrl+S") + textStyle.Render(" save · ") +
+		keyStyle.Render("Enter") + textStyle.Render(" new line · ") +
+		keyStyle.Render("Esc") + textStyle.Render(" cancel")
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
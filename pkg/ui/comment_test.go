@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCommentAuthor_FromGitConfig(t *testing.T) {
+	gitConfig := filepath.Join(t.TempDir(), "gitconfig")
+	if err := os.WriteFile(gitConfig, []byte("[user]\n\tname = Ada Lovelace\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", gitConfig)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	if got := commentAuthor(t.TempDir()); got != "Ada Lovelace" {
+		t.Errorf("Author = %q", got)
+	}
+
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("USER", "ada")
+	if got := commentAuthor(t.TempDir()); got != "ada" {
+		t.Errorf("Expected $USER fallback, got %q", got)
+	}
+}
+
+func TestModel_AddComment(t *testing.T) {
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("USER", "tester")
+
+	_, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"cm-1","title":"Needs input","status":"open","issue_type":"task"}` + "\n"
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	typeText := func(text string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+		m = updated.(Model)
+	}
+
+	press("m")
+	if !m.showCommentEditor || m.focused != focusCommentEditor || m.commentIssueID != "cm-1" {
+		t.Fatal("m should open the comment editor for the selected issue")
+	}
+	if !strings.Contains(m.View(), "as tester") {
+		t.Error("Editor should show the comment author")
+	}
+
+	// Empty comments are refused, letters don't trigger shortcuts
+	press("ctrl+s")
+	if !m.showCommentEditor || !m.statusIsError {
+		t.Error("Empty comment should keep the editor open with an error")
+	}
+	typeText("Looks good")
+	press("enter")
+	typeText("b")
+	if m.isBoardView {
+		t.Fatal("Typing should not trigger view shortcuts")
+	}
+	press("ctrl+s")
+	if m.showCommentEditor || m.statusMsg != "💬 Comment added to cm-1" {
+		t.Fatalf("Expected comment saved, got status %q", m.statusMsg)
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || len(item.Issue.Comments) != 1 || len(m.issueMap["cm-1"].Comments) != 1 {
+		t.Error("New comment should show before the file watcher reloads")
+	}
+
+	saved, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := saved[0].Comments
+	if len(c) != 1 || c[0].Text != "Looks good\nb" || c[0].Author != "tester" || c[0].ID != 1 {
+		t.Errorf("Unexpected saved comments: %+v", c)
+	}
+
+	// esc discards the draft
+	press("m")
+	typeText("never mind")
+	press("esc")
+	if m.showCommentEditor {
+		t.Error("esc should close the editor")
+	}
+	if saved, _ := loader.LoadIssuesFromFile(beadsPath); len(saved[0].Comments) != 1 {
+		t.Error("Cancelled comment was saved")
+	}
+}
+
+func TestModel_AddCommentNeedsBeadsFile(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "cm-1", Title: "One", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(keyMsgFromString("m"))
+	m = updated.(Model)
+	if m.showCommentEditor || !m.statusIsError {
+		t.Error("Comments should be refused without a beads file")
+	}
+}
@@ -0,0 +1,280 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openCompareForMarked opens the side-by-side compare for the two marked
+// issues, the one marked issue and the one under the cursor, or the issue
+// under the cursor and its parent
+func (m *Model) openCompareForMarked() {
+	var ids []string
+	for _, issue := range m.markedIssues() {
+		ids = append(ids, issue.ID)
+	}
+	item, hasItem := m.list.SelectedItem().(IssueItem)
+	switch {
+	case len(ids) == 1 && hasItem && item.Issue.ID != ids[0]:
+		ids = append(ids, item.Issue.ID)
+	case len(ids) == 0 && hasItem:
+		if parent := parentID(&item.Issue); parent != "" && m.issueMap[parent] != nil {
+			ids = []string{parent, item.Issue.ID}
+		}
+	}
+	if len(ids) != 2 {
+		m.statusMsg = "Mark the two issues to compare (space), then press ="
+		m.statusIsError = true
+		return
+	}
+	m.openCompare(ids[0], ids[1])
+}
+
+// openCompare shows leftID and rightID side by side
+func (m *Model) openCompare(leftID, rightID string) {
+	if m.issueMap[leftID] == nil || m.issueMap[rightID] == nil {
+		m.statusMsg = fmt.Sprintf("Can't compare: %s or %s not found", leftID, rightID)
+		m.statusIsError = true
+		return
+	}
+	m.compareIDs = [2]string{leftID, rightID}
+	m.compareScroll = 0
+	m.showCompare = true
+	m.refreshCompare()
+}
+
+// parentID returns the issue's parent-child dependency target, if any
+func parentID(issue *model.Issue) string {
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type == model.DepParentChild {
+			return dep.DependsOnID
+		}
+	}
+	return ""
+}
+
+// compareColumnWidth is the text width of one side of the compare view
+func (m Model) compareColumnWidth() int {
+	return max(20, (m.width-7)/2)
+}
+
+// refreshCompare re-renders both sides at the current width
+func (m *Model) refreshCompare() {
+	width := m.compareColumnWidth()
+	renderer := NewMarkdownRendererWithTheme(width, m.theme)
+	for side, id := range m.compareIDs {
+		issue := m.issueMap[id]
+		if issue == nil {
+			m.compareLines[side] = []string{id + " not found"}
+			continue
+		}
+		rendered, err := renderer.Render(compareIssueMarkdown(issue))
+		if err != nil {
+			rendered = fmt.Sprintf("Error rendering markdown: %v", err)
+		}
+		m.compareLines[side] = strings.Split(strings.TrimRight(rendered, "\n"), "\n")
+	}
+}
+
+// compareIssueMarkdown is the detail shown for one side of the compare view.
+// Unlike the detail pane it leaves out graph metrics and history so the two
+// sides line up section by section.
+func compareIssueMarkdown(issue *model.Issue) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s %s\n", GetTypeIconMD(string(issue.IssueType)), issue.Title))
+	sb.WriteString(fmt.Sprintf("**%s** • **%s** • %s", issue.ID, strings.ToUpper(string(issue.Status)), GetPriorityIcon(issue.Priority)))
+	if issue.Assignee != "" {
+		sb.WriteString(" • @" + issue.Assignee)
+	}
+	sb.WriteString(fmt.Sprintf(" • %s\n\n", issue.CreatedAt.Format("2006-01-02")))
+	if len(issue.Labels) > 0 {
+		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(issue.Labels, ", ")))
+	}
+
+	for _, section := range []struct{ title, text string }{
+		{"Description", issue.Description},
+		{"Design", issue.Design},
+		{"Acceptance Criteria", issue.AcceptanceCriteria},
+		{"Notes", issue.Notes},
+	} {
+		if strings.TrimSpace(section.text) != "" {
+			sb.WriteString("### " + section.title + "\n")
+			sb.WriteString(section.text + "\n\n")
+		}
+	}
+
+	if len(issue.Dependencies) > 0 {
+		sb.WriteString("### Dependencies\n")
+		for _, dep := range issue.Dependencies {
+			if dep != nil {
+				sb.WriteString(fmt.Sprintf("- %s (%s)\n", dep.DependsOnID, dep.Type))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(issue.Comments) > 0 {
+		sb.WriteString(fmt.Sprintf("### Comments (%d)\n", len(issue.Comments)))
+		for _, comment := range issue.Comments {
+			if comment == nil {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n> \n> %s\n\n",
+				comment.Author,
+				FormatTimeRel(comment.CreatedAt),
+				strings.ReplaceAll(comment.Text, "\n", "\n> ")))
+		}
+	}
+	return sb.String()
+}
+
+// compareDifferences lists the fields that differ between a and b
+func compareDifferences(a, b *model.Issue) []string {
+	var diffs []string
+	add := func(name string, differ bool) {
+		if differ {
+			diffs = append(diffs, name)
+		}
+	}
+	add("status", a.Status != b.Status)
+	add("priority", a.Priority != b.Priority)
+	add("type", a.IssueType != b.IssueType)
+	add("assignee", a.Assignee != b.Assignee)
+	add("labels", !slices.Equal(sortedCopy(a.Labels), sortedCopy(b.Labels)))
+	add("description", strings.TrimSpace(a.Description) != strings.TrimSpace(b.Description))
+	add("design", strings.TrimSpace(a.Design) != strings.TrimSpace(b.Design))
+	add("acceptance", strings.TrimSpace(a.AcceptanceCriteria) != strings.TrimSpace(b.AcceptanceCriteria))
+	add("notes", strings.TrimSpace(a.Notes) != strings.TrimSpace(b.Notes))
+	return diffs
+}
+
+// sortedCopy returns a sorted copy of s
+func sortedCopy(s []string) []string {
+	out := slices.Clone(s)
+	slices.Sort(out)
+	return out
+}
+
+// compareRelation describes a direct dependency between a and b, if any
+func compareRelation(a, b *model.Issue) string {
+	for _, pair := range [][2]*model.Issue{{a, b}, {b, a}} {
+		from, to := pair[0], pair[1]
+		for _, dep := range from.Dependencies {
+			if dep == nil || dep.DependsOnID != to.ID {
+				continue
+			}
+			if dep.Type == model.DepParentChild {
+				return fmt.Sprintf("%s is the parent of %s", to.ID, from.ID)
+			}
+			return fmt.Sprintf("%s depends on %s (%s)", from.ID, to.ID, dep.Type)
+		}
+	}
+	return ""
+}
+
+// compareBodyHeight is the number of detail lines visible per side
+func (m Model) compareBodyHeight() int {
+	return max(3, m.height-8)
+}
+
+// compareMaxScroll is the furthest the shared scroll offset can go
+func (m Model) compareMaxScroll() int {
+	longest := max(len(m.compareLines[0]), len(m.compareLines[1]))
+	return max(0, longest-m.compareBodyHeight())
+}
+
+// handleCompareKeys handles keys while the compare view is open. Both sides
+// scroll together.
+func (m Model) handleCompareKeys(msg tea.KeyMsg) Model {
+	page := m.compareBodyHeight() / 2
+	switch msg.String() {
+	case "j", "down":
+		m.compareScroll++
+	case "k", "up":
+		m.compareScroll--
+	case "ctrl+d", "pgdown", " ":
+		m.compareScroll += page
+	case "ctrl+u", "pgup":
+		m.compareScroll -= page
+	case "g", "home":
+		m.compareScroll = 0
+	case "G", "end":
+		m.compareScroll = m.compareMaxScroll()
+	case "tab", "s":
+		m.compareIDs[0], m.compareIDs[1] = m.compareIDs[1], m.compareIDs[0]
+		m.compareLines[0], m.compareLines[1] = m.compareLines[1], m.compareLines[0]
+	case "U":
+		// Merge the right issue into the left one
+		m.showCompare = false
+		m.openMergeOverlay(m.compareIDs[0], m.compareIDs[1])
+	case "enter":
+		m.showCompare = false
+		m.jumpToIssue(m.compareIDs[0])
+	case "esc", "q", "=":
+		m.showCompare = false
+	}
+	m.compareScroll = max(0, min(m.compareScroll, m.compareMaxScroll()))
+	return m
+}
+
+// renderCompareView renders the two issues side by side
+func (m Model) renderCompareView() string {
+	t := m.theme
+	left, right := m.issueMap[m.compareIDs[0]], m.issueMap[m.compareIDs[1]]
+	if left == nil || right == nil {
+		return "Compared issue no longer exists (esc to close)"
+	}
+	colWidth := m.compareColumnWidth()
+	bodyHeight := m.compareBodyHeight()
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	diffStyle := t.Renderer.NewStyle().Foreground(t.Feature)
+
+	var header strings.Builder
+	header.WriteString(titleStyle.Render(fmt.Sprintf("⇔ Compare %s and %s", left.ID, right.ID)))
+	if rel := compareRelation(left, right); rel != "" {
+		header.WriteString(mutedStyle.Render("  • " + rel))
+	}
+	header.WriteString("\n")
+	if diffs := compareDifferences(left, right); len(diffs) > 0 {
+		header.WriteString(diffStyle.Render(truncateRunesHelper("Differs: "+strings.Join(diffs, ", "), m.width-2, "…")))
+	} else {
+		header.WriteString(diffStyle.Render("Same status, priority, type, assignee, labels and text"))
+	}
+
+	column := func(side int) string {
+		lines := m.compareLines[side]
+		start := min(m.compareScroll, len(lines))
+		end := min(start+bodyHeight, len(lines))
+		visible := make([]string, 0, bodyHeight)
+		visible = append(visible, lines[start:end]...)
+		for len(visible) < bodyHeight {
+			visible = append(visible, "")
+		}
+		return t.Renderer.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(t.Secondary).
+			Width(colWidth).
+			Height(bodyHeight).
+			MaxHeight(bodyHeight + 2).
+			Render(strings.Join(visible, "\n"))
+	}
+
+	position := ""
+	if maxScroll := m.compareMaxScroll(); maxScroll > 0 {
+		position = fmt.Sprintf(" • %d%%", m.compareScroll*100/maxScroll)
+	}
+	footer := mutedStyle.Italic(true).Render("j/k: scroll both • tab: swap sides • U: merge right into left • enter: open left • esc: close" + position)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header.String(),
+		lipgloss.JoinHorizontal(lipgloss.Top, column(0), " ", column(1)),
+		footer,
+	)
+}
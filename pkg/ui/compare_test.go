@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_CompareView(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	longDesc := strings.Repeat("line\n\n", 60)
+	issues := []model.Issue{
+		{ID: "cmp-1", Title: "Checkout epic", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeEpic, CreatedAt: created},
+		{ID: "cmp-2", Title: "Pay with card", Description: longDesc, Status: model.StatusInProgress, Priority: 1, IssueType: model.TypeTask,
+			Labels: []string{"payments"}, CreatedAt: created.Add(time.Hour),
+			Dependencies: []*model.Dependency{{IssueID: "cmp-2", DependsOnID: "cmp-1", Type: model.DepParentChild}}},
+		{ID: "cmp-3", Title: "Unrelated", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeBug, CreatedAt: created.Add(2 * time.Hour)},
+	}
+
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	selectInList := func(id string) {
+		m.jumpToIssue(id)
+		m.focused = focusList
+	}
+
+	// Nothing marked and no parent: only an error
+	selectInList("cmp-3")
+	press("=")
+	if m.showCompare || !m.statusIsError {
+		t.Fatal("= without two issues should only report an error")
+	}
+
+	// Nothing marked on a child: compare with its parent
+	selectInList("cmp-2")
+	press("=")
+	if !m.showCompare || m.compareIDs != [2]string{"cmp-1", "cmp-2"} {
+		t.Fatalf("Expected parent/child compare, got %v (status %q)", m.compareIDs, m.statusMsg)
+	}
+	view := m.View()
+	for _, want := range []string{"Compare cmp-1 and cmp-2", "cmp-1 is the parent of cmp-2", "Differs: status, type, labels, description", "Checkout", "card"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q", want)
+		}
+	}
+
+	// Scrolling moves both sides and is clamped
+	press("j")
+	press("j")
+	if m.compareScroll != 2 {
+		t.Errorf("Scroll = %d, want 2", m.compareScroll)
+	}
+	press("G")
+	if m.compareScroll != m.compareMaxScroll() || m.compareScroll == 0 {
+		t.Errorf("G should scroll to the end, got %d of %d", m.compareScroll, m.compareMaxScroll())
+	}
+	press("j")
+	if m.compareScroll != m.compareMaxScroll() {
+		t.Errorf("Scroll should clamp at %d, got %d", m.compareMaxScroll(), m.compareScroll)
+	}
+
+	press("tab")
+	if m.compareIDs != [2]string{"cmp-2", "cmp-1"} {
+		t.Errorf("Tab should swap sides, got %v", m.compareIDs)
+	}
+	press("enter")
+	if m.showCompare || selectedID(m) != "cmp-2" {
+		t.Errorf("Enter should close and open the left issue, got %q", selectedID(m))
+	}
+
+	// One marked issue compares with the cursor
+	m.marked["cmp-3"] = true
+	selectInList("cmp-1")
+	press("=")
+	if !m.showCompare || m.compareIDs != [2]string{"cmp-3", "cmp-1"} {
+		t.Fatalf("Expected marked + cursor compare, got %v", m.compareIDs)
+	}
+	press("esc")
+	if m.showCompare {
+		t.Error("Esc should close the compare view")
+	}
+}
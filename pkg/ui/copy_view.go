@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+
+	"github.com/atotto/clipboard"
+)
+
+// copyViewToClipboard copies a view rendered as Markdown, reporting rows
+// copied in the status bar
+func (m *Model) copyViewToClipboard(what, md string, rows int) {
+	if rows == 0 {
+		m.statusMsg = fmt.Sprintf("Nothing to copy: the %s is empty", what)
+		m.statusIsError = true
+		return
+	}
+	if err := clipboard.WriteAll(md); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard (%d rows)", what, rows)
+	m.statusIsError = false
+}
+
+// copyActionableView copies the execution plan, or the waves schedule when
+// it is shown
+func (m *Model) copyActionableView() {
+	if m.actionableView.showWaves {
+		md, rows := wavePlanMarkdown(m.actionableView.waves)
+		m.copyViewToClipboard("work waves", md, rows)
+		return
+	}
+	md, rows := executionPlanMarkdown(m.actionableView.plan)
+	m.copyViewToClipboard("execution plan", md, rows)
+}
+
+// copyAlertsView copies the alerts panel's current tab
+func (m *Model) copyAlertsView() {
+	if m.alertsHistoryTab {
+		md, rows := alertHistoryMarkdown(m.alertHistory.Recent())
+		m.copyViewToClipboard("alert history", md, rows)
+		return
+	}
+	md, rows := alertsMarkdown(m.activeAlerts())
+	m.copyViewToClipboard("alerts list", md, rows)
+}
+
+// markdownTable renders a GFM table, escaping pipes and line breaks in cells
+func markdownTable(headers []string, rows [][]string) string {
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, c := range cells {
+			c = strings.ReplaceAll(c, "|", "\\|")
+			c = strings.Join(strings.Fields(c), " ")
+			sb.WriteString(" " + c + " |")
+		}
+		sb.WriteString("\n")
+	}
+	writeRow(headers)
+	sb.WriteString("|")
+	for range headers {
+		sb.WriteString("---|")
+	}
+	sb.WriteString("\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return sb.String()
+}
+
+// executionPlanMarkdown renders the actionable tracks as one table
+func executionPlanMarkdown(plan analysis.ExecutionPlan) (string, int) {
+	var rows [][]string
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			rows = append(rows, []string{
+				strings.TrimPrefix(track.TrackID, "track-"),
+				item.ID,
+				item.Title,
+				fmt.Sprintf("P%d", item.Priority),
+				item.Status,
+				strings.Join(item.UnblocksIDs, ", "),
+			})
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Execution Plan\n\n")
+	if s := plan.Summary; s.HighestImpact != "" && s.UnblocksCount > 0 {
+		fmt.Fprintf(&sb, "Start with **%s**: %s (unblocks %d)\n\n", s.HighestImpact, s.ImpactReason, s.UnblocksCount)
+	}
+	sb.WriteString(markdownTable([]string{"Track", "ID", "Title", "Priority", "Status", "Unblocks"}, rows))
+	return sb.String(), len(rows)
+}
+
+// wavePlanMarkdown renders the waves schedule as one table
+func wavePlanMarkdown(plan analysis.WavePlan) (string, int) {
+	var rows [][]string
+	for _, wave := range plan.Waves {
+		for _, item := range wave.Items {
+			rows = append(rows, []string{
+				fmt.Sprintf("%d", wave.Wave),
+				fmt.Sprintf("%.1f", wave.StartDay),
+				item.ID,
+				item.Title,
+				fmt.Sprintf("P%d", item.Priority),
+				strings.Join(item.WaitsOn, ", "),
+			})
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Work Waves\n\n")
+	if len(plan.Waves) > 0 {
+		fmt.Fprintf(&sb, "%d waves, about %.1f days at %.2f closes/day\n\n", len(plan.Waves), plan.EstimatedDays, plan.ClosesPerDay)
+	}
+	sb.WriteString(markdownTable([]string{"Wave", "Starts (day)", "ID", "Title", "Priority", "Waits on"}, rows))
+	return sb.String(), len(rows)
+}
+
+// topPicksMarkdown renders the triage top picks
+func topPicksMarkdown(picks []analysis.TopPick) (string, int) {
+	rows := make([][]string, len(picks))
+	for i, p := range picks {
+		rows[i] = []string{
+			fmt.Sprintf("%d", i+1),
+			p.ID,
+			p.Title,
+			fmt.Sprintf("%.3f", p.Score),
+			fmt.Sprintf("%d", p.Unblocks),
+			strings.Join(p.Reasons, "; "),
+		}
+	}
+	md := "## Triage Top Picks\n\n" + markdownTable([]string{"#", "ID", "Title", "Score", "Unblocks", "Reasons"}, rows)
+	return md, len(rows)
+}
+
+// alertsMarkdown renders active drift alerts
+func alertsMarkdown(alerts []drift.Alert) (string, int) {
+	rows := make([][]string, len(alerts))
+	for i, a := range alerts {
+		rows[i] = []string{string(a.Severity), string(a.Type), a.Message, a.IssueID}
+	}
+	md := "## Alerts\n\n" + markdownTable([]string{"Severity", "Type", "Message", "Issue"}, rows)
+	return md, len(rows)
+}
+
+// alertHistoryMarkdown renders the alert log, newest first
+func alertHistoryMarkdown(records []drift.AlertRecord) (string, int) {
+	const stamp = "2006-01-02 15:04"
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		state := "active"
+		if !r.Active() {
+			state = "resolved " + r.ResolvedAt.Format(stamp)
+		} else if r.DismissedAt != nil {
+			state = "dismissed by " + r.DismissedBy
+		}
+		rows[i] = []string{string(r.Severity), r.Message, r.IssueID, r.FirstSeen.Format(stamp), state}
+	}
+	md := "## Alert History\n\n" + markdownTable([]string{"Severity", "Message", "Issue", "First seen", "State"}, rows)
+	return md, len(rows)
+}
+
+// labelHealthMarkdown renders the label health table in dashboard order
+func labelHealthMarkdown(labels []analysis.LabelHealth) (string, int) {
+	rows := make([][]string, len(labels))
+	for i, lh := range labels {
+		rows[i] = []string{
+			lh.Label,
+			fmt.Sprintf("%d", lh.Health),
+			lh.HealthLevel,
+			fmt.Sprintf("%d", lh.OpenCount),
+			fmt.Sprintf("%d", lh.Blocked),
+			fmt.Sprintf("%d/%d", lh.Velocity.ClosedLast7Days, lh.Velocity.ClosedLast30Days),
+			fmt.Sprintf("%d", lh.Freshness.StaleCount),
+		}
+	}
+	headers := []string{"Label", "Health", "Level", "Open", "Blocked", "Velocity 7d/30d", "Stale"}
+	return "## Label Health\n\n" + markdownTable(headers, rows), len(rows)
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+)
+
+func TestMarkdownTable_EscapesCells(t *testing.T) {
+	got := markdownTable([]string{"A", "B"}, [][]string{{"x | y", "two\nlines"}})
+	want := "| A | B |\n|---|---|\n| x \\| y | two lines |\n"
+	if got != want {
+		t.Errorf("markdownTable = %q, want %q", got, want)
+	}
+}
+
+func TestViewMarkdown(t *testing.T) {
+	plan := analysis.ExecutionPlan{
+		Tracks: []analysis.ExecutionTrack{
+			{TrackID: "track-A", Items: []analysis.PlanItem{{ID: "x-1", Title: "Login", Priority: 1, Status: "open", UnblocksIDs: []string{"x-2", "x-3"}}}},
+			{TrackID: "track-B", Items: []analysis.PlanItem{{ID: "x-4", Title: "Docs", Priority: 3, Status: "open"}}},
+		},
+		Summary: analysis.PlanSummary{HighestImpact: "x-1", ImpactReason: "unblocks login work", UnblocksCount: 2},
+	}
+	md, rows := executionPlanMarkdown(plan)
+	if rows != 2 || !strings.Contains(md, "| A | x-1 | Login | P1 | open | x-2, x-3 |") || !strings.Contains(md, "Start with **x-1**") {
+		t.Errorf("execution plan (%d rows):\n%s", rows, md)
+	}
+
+	waves := analysis.WavePlan{Waves: []analysis.ExecutionWave{
+		{Wave: 2, StartDay: 1.5, Items: []analysis.WaveItem{{ID: "x-2", Title: "Profile", Priority: 2, WaitsOn: []string{"x-1"}}}},
+	}}
+	if md, _ := wavePlanMarkdown(waves); !strings.Contains(md, "| 2 | 1.5 | x-2 | Profile | P2 | x-1 |") {
+		t.Errorf("waves:\n%s", md)
+	}
+
+	picks := []analysis.TopPick{{ID: "x-1", Title: "Login", Score: 0.8123, Unblocks: 2, Reasons: []string{"High PageRank", "Unblocks 2"}}}
+	if md, _ := topPicksMarkdown(picks); !strings.Contains(md, "| 1 | x-1 | Login | 0.812 | 2 | High PageRank; Unblocks 2 |") {
+		t.Errorf("top picks:\n%s", md)
+	}
+
+	alerts := []drift.Alert{{Type: drift.AlertNewCycle, Severity: drift.SeverityWarning, Message: "New cycle: x-9 → x-10", IssueID: "x-9"}}
+	if md, _ := alertsMarkdown(alerts); !strings.Contains(md, "| warning | "+string(drift.AlertNewCycle)+" | New cycle: x-9 → x-10 | x-9 |") {
+		t.Errorf("alerts:\n%s", md)
+	}
+
+	resolved := time.Date(2025, 5, 2, 10, 0, 0, 0, time.UTC)
+	records := []drift.AlertRecord{{Severity: drift.SeverityCritical, Message: "cycle", FirstSeen: resolved.Add(-24 * time.Hour), ResolvedAt: &resolved}}
+	if md, _ := alertHistoryMarkdown(records); !strings.Contains(md, "| critical | cycle |  | 2025-05-01 10:00 | resolved 2025-05-02 10:00 |") {
+		t.Errorf("alert history:\n%s", md)
+	}
+
+	labels := []analysis.LabelHealth{{Label: "api", Health: 42, HealthLevel: analysis.HealthLevelCritical, OpenCount: 5, Blocked: 2}}
+	if md, _ := labelHealthMarkdown(labels); !strings.Contains(md, "| api | 42 | critical | 5 | 2 | 0/0 | 0 |") {
+		t.Errorf("label health:\n%s", md)
+	}
+}
+
+func TestCopyView_EmptyLabelDashboard(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	m.focused = focusLabelDashboard
+	newM, _ := m.Update(keyMsgFromString("C"))
+	m = newM.(Model)
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "label health table is empty") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+}
@@ -826,6 +826,10 @@ func TestOverlaysAndWorkspaceHelpers(t *testing.T) {
 	if !strings.HasPrefix(name, "beads_report_") || !strings.HasSuffix(name, ".md") {
 		t.Fatalf("generateExportFilename unexpected: %s", name)
 	}
+	m.EnableStableExport()
+	if got, want := m.generateExportFilename(), name[:len(name)-len("_2006-01-02.md")]+".md"; got != want {
+		t.Fatalf("stable export filename = %s, want %s", got, want)
+	}
 }
 
 func TestGraphIconsAndTruncation(t *testing.T) {
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openCycleBreaker lists the dependencies proposed for removal to break
+// every dependency cycle
+func (m *Model) openCycleBreaker() {
+	m.cycleBreakPlan = analysis.PlanCycleCuts(m.issues)
+	if len(m.cycleBreakPlan.Cuts) == 0 {
+		m.statusMsg = "No dependency cycles"
+		m.statusIsError = false
+		return
+	}
+	m.cycleCutCursor = 0
+	m.showCycleBreak = true
+}
+
+// refreshCycleCuts re-plans the cuts after the issues change
+func (m *Model) refreshCycleCuts() {
+	m.cycleBreakPlan = analysis.PlanCycleCuts(m.issues)
+	if len(m.cycleBreakPlan.Cuts) == 0 {
+		m.showCycleBreak = false
+		return
+	}
+	if m.cycleCutCursor >= len(m.cycleBreakPlan.Cuts) {
+		m.cycleCutCursor = len(m.cycleBreakPlan.Cuts) - 1
+	}
+}
+
+// handleCycleBreakKeys handles keys while the cycle breaker is open
+func (m Model) handleCycleBreakKeys(msg tea.KeyMsg) Model {
+	cuts := m.cycleBreakPlan.Cuts
+	if len(cuts) == 0 {
+		m.showCycleBreak = false
+		return m
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		if m.cycleCutCursor < len(cuts)-1 {
+			m.cycleCutCursor++
+		}
+	case "k", "up":
+		if m.cycleCutCursor > 0 {
+			m.cycleCutCursor--
+		}
+	case "enter":
+		if m.beadsPath == "" {
+			m.statusMsg = "Applying a cut needs a beads file to write to (not available for stdin, workspace or imported issues)"
+			m.statusIsError = true
+			return m
+		}
+		cut := cuts[m.cycleCutCursor]
+		if err := loader.DropBlockingDependency(m.beadsPath, cut.IssueID, cut.DependsOnID); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Cut failed: %v", err)
+			m.statusIsError = true
+			return m
+		}
+		// The rest of the plan still holds; the watcher's reload re-plans
+		m.cycleBreakPlan.Cuts = append(cuts[:m.cycleCutCursor:m.cycleCutCursor], cuts[m.cycleCutCursor+1:]...)
+		if m.cycleCutCursor >= len(m.cycleBreakPlan.Cuts) {
+			m.cycleCutCursor = max(0, len(m.cycleBreakPlan.Cuts)-1)
+		}
+		if len(m.cycleBreakPlan.Cuts) == 0 {
+			m.showCycleBreak = false
+		}
+		m.statusMsg = fmt.Sprintf("✂ %s no longer waits on %s", cut.IssueID, cut.DependsOnID)
+		m.statusIsError = false
+	case "esc", "q", "ctrl+x":
+		m.showCycleBreak = false
+	}
+	return m
+}
+
+// renderCycleBreakOverlay renders the cycle breaker
+func (m Model) renderCycleBreakOverlay() string {
+	t := m.theme
+	cuts := m.cycleBreakPlan.Cuts
+
+	boxWidth := min(90, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("✂ Break Dependency Cycles"))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("Removing %d blocking dependencies breaks every cycle among %d issues",
+		len(cuts), m.cycleBreakPlan.Issues)))
+	sb.WriteString("\n\n")
+
+	visible := max(3, (m.height-14)/3)
+	start := 0
+	if m.cycleCutCursor >= visible {
+		start = m.cycleCutCursor - visible + 1
+	}
+	end := min(len(cuts), start+visible)
+	if start > 0 {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↑ %d more", start)))
+		sb.WriteString("\n")
+	}
+
+	for i := start; i < end; i++ {
+		cut := cuts[i]
+		selected := i == m.cycleCutCursor
+		cursor := "  "
+		style := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+		if selected {
+			cursor = "▸ "
+			style = style.Bold(true)
+		}
+		line := fmt.Sprintf("%s%s no longer waits on %s", cursor, cut.IssueID, cut.DependsOnID)
+		sb.WriteString(style.Render(truncateRunesHelper(line, boxWidth-8, "…")))
+		sb.WriteString("\n")
+		if !selected {
+			continue
+		}
+
+		for _, id := range []string{cut.IssueID, cut.DependsOnID} {
+			if issue, ok := m.issueMap[id]; ok {
+				sb.WriteString(mutedStyle.Render(truncateRunesHelper(fmt.Sprintf("     %s: %s", id, issue.Title), boxWidth-8, "…")))
+				sb.WriteString("\n")
+			}
+			if cut.IssueID == cut.DependsOnID {
+				break
+			}
+		}
+		sb.WriteString(mutedStyle.Italic(true).Render(truncateRunesHelper("     Cycle: "+strings.Join(cut.Cycle, " → "), boxWidth-8, "…")))
+		sb.WriteString("\n")
+	}
+	if end < len(cuts) {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(cuts)-end)))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: review • Enter: apply cut • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
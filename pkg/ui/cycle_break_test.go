@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_CycleBreaker(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"cb-1","title":"Schema","status":"open","issue_type":"task","dependencies":[{"issue_id":"cb-1","depends_on_id":"cb-2","type":"blocks"}]}
+{"id":"cb-2","title":"API","status":"open","issue_type":"task","dependencies":[{"issue_id":"cb-2","depends_on_id":"cb-1","type":"blocks"},{"issue_id":"cb-2","depends_on_id":"cb-3","type":"blocks"}]}
+{"id":"cb-3","title":"Client","status":"open","issue_type":"task","dependencies":[{"issue_id":"cb-3","depends_on_id":"cb-1","type":"blocks"},{"issue_id":"cb-3","depends_on_id":"cb-1","type":"related"}]}
+`
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("ctrl+x")
+	if !m.showCycleBreak || len(m.cycleBreakPlan.Cuts) != 1 {
+		t.Fatalf("Expected one cut for two cycles sharing an edge, got %+v", m.cycleBreakPlan)
+	}
+	view := m.View()
+	for _, want := range []string{"Break Dependency Cycles", "breaks every cycle among 3 issues", "cb-1: Schema", "Cycle: cb-1 → cb-2 → cb-1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q", want)
+		}
+	}
+
+	press("enter")
+	if m.showCycleBreak || m.statusIsError || !strings.Contains(m.statusMsg, "cb-1 no longer waits on cb-2") {
+		t.Fatalf("Expected the overlay to close after the last cut, got %q", m.statusMsg)
+	}
+	issues, err = loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan := analysis.PlanCycleCuts(issues); len(plan.Cuts) != 0 {
+		t.Errorf("Cycles left after applying the cut: %+v", plan)
+	}
+	data, _ := os.ReadFile(beadsPath)
+	if !strings.Contains(string(data), `"depends_on_id":"cb-1","type":"related"`) {
+		t.Errorf("Non-blocking link should be kept:\n%s", data)
+	}
+
+	m = NewModel(issues, nil, "")
+	press("ctrl+x")
+	if m.showCycleBreak || m.statusMsg != "No dependency cycles" {
+		t.Errorf("Expected no cycles after the reload, got %q", m.statusMsg)
+	}
+}
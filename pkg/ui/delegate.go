@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 
@@ -17,7 +19,11 @@ type IssueDelegate struct {
 	Theme             Theme
 	ShowPriorityHints bool
 	PriorityHints     map[string]*analysis.PriorityRecommendation
-	WorkspaceMode     bool // When true, shows repo prefix badges
+	WorkspaceMode     bool            // When true, shows repo prefix badges
+	Marked            map[string]bool // Multi-select marks, keyed by issue ID
+	Subscribed        map[string]bool // Issues watched for changes on reload
+	Starred           map[string]bool // Issues on the user's watchlist
+	Columns           ListColumns     // Column layout; nil uses the default
 }
 
 func (d IssueDelegate) Height() int {
@@ -50,205 +56,184 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	// ══════════════════════════════════════════════════════════════════════════
 	// POLISHED ROW LAYOUT - Stripe-level visual hierarchy
-	// Layout: [sel] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
+	// Layout: [sel] then the configured columns, e.g.
+	// [repo] [type] [prio-badge] [status-badge] [ID] [title...] [age] [comments] ...
 	// ══════════════════════════════════════════════════════════════════════════
+	lay := d.Columns.layout(width, d.WorkspaceMode, d.ShowPriorityHints, idColumnWidth(m.Items()))
+
+	var row strings.Builder
+
+	// Selection indicator with accent color; a check marks multi-selected rows
+	marked := d.Marked[i.Issue.ID]
+	switch {
+	case isSelected && marked:
+		row.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸✓"))
+	case isSelected:
+		row.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+	case marked:
+		row.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Render("✓ "))
+	default:
+		row.WriteString("  ")
+	}
 
-	// Get all the data
-	icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
-	idStr := i.Issue.ID
-	title := i.Issue.Title
-	ageStr := FormatTimeRel(i.Issue.CreatedAt)
-	commentCount := len(i.Issue.Comments)
-
-	// Measure actual icon display width (emojis vary: 1-2 cells)
-	iconDisplayWidth := lipgloss.Width(icon)
-
-	// Calculate widths for right-side columns (fixed)
-	rightWidth := 0
-	var rightParts []string
-
-	// Show Age and Comments only if we have reasonable width
-	if width > 60 {
-		// Age - with subtle styling
-		ageStyle := t.Renderer.NewStyle().Foreground(ColorMuted)
-		rightParts = append(rightParts, ageStyle.Render(fmt.Sprintf("%8s", ageStr)))
-		rightWidth += 9
-
-		// Comments with icon - use lipgloss.Width for accurate emoji measurement
-		if commentCount > 0 {
-			commentStyle := t.Renderer.NewStyle().Foreground(ColorInfo)
-			commentStr := fmt.Sprintf("💬%d", commentCount)
-			rightParts = append(rightParts, commentStyle.Render(commentStr))
-			rightWidth += lipgloss.Width(commentStr) + 1 // +1 for spacing
-		} else {
-			rightParts = append(rightParts, "   ")
-			rightWidth += 3
+	for k, name := range lay.names {
+		if k > 0 {
+			row.WriteString(" ")
 		}
+		cellWidth := lay.widths[k]
+		if name == ColumnTitle {
+			row.WriteString(d.renderTitleCell(i, m, index, cellWidth, isSelected))
+			continue
+		}
+		row.WriteString(padCell(d.renderCell(name, i, cellWidth, isSelected), cellWidth, name == ColumnAge))
 	}
 
-	// Sparkline (Graph Score) - visualization of importance
-	if width > 120 {
-		spark := RenderSparkline(i.GraphScore, 5)
-		sparkColor := GetHeatmapColor(i.GraphScore, t)
-		sparkStyle := t.Renderer.NewStyle().Foreground(sparkColor)
-		rightParts = append(rightParts, sparkStyle.Render(spark))
-		rightWidth += 6 // 5 + 1 spacing
+	// Apply row background for selection and clamp width
+	rowStyle := t.Renderer.NewStyle().Width(width).MaxWidth(width)
+	if isSelected {
+		fmt.Fprint(w, rowStyle.Background(t.Highlight).Render(row.String()))
+	} else {
+		fmt.Fprint(w, rowStyle.Render(row.String()))
 	}
+}
 
-	// Assignee (if present and we have room)
-	if width > 100 && i.Issue.Assignee != "" {
-		assignee := truncateRunesHelper(i.Issue.Assignee, 12, "…")
-		assigneeStyle := t.Renderer.NewStyle().Foreground(ColorSecondary)
-		rightParts = append(rightParts, assigneeStyle.Render(fmt.Sprintf("@%-12s", assignee)))
-		rightWidth += 14
-	}
+// renderCell renders one column other than the title, at most width cells
+func (d IssueDelegate) renderCell(name string, i IssueItem, width int, isSelected bool) string {
+	t := d.Theme
+	switch name {
+	case ColumnRepo:
+		// Compact repo badge like [API] or [WEB]
+		return RenderRepoBadge(i.RepoPrefix)
+
+	case ColumnType:
+		icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
+		return t.Renderer.NewStyle().Foreground(iconColor).Render(icon)
+
+	case ColumnPriority:
+		badge := RenderPriorityBadge(i.Issue.Priority)
+		// Priority hint indicator (↑/↓)
+		if d.ShowPriorityHints && d.PriorityHints != nil {
+			if hint, ok := d.PriorityHints[i.Issue.ID]; ok {
+				if hint.Direction == "increase" {
+					badge += " " + t.Renderer.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Bold(true).Render("↑")
+				} else if hint.Direction == "decrease" {
+					badge += " " + t.Renderer.NewStyle().Foreground(lipgloss.Color("#4ECDC4")).Bold(true).Render("↓")
+				}
+			}
+		}
+		return badge
 
-	// Labels (if present and we have room) - render as mini tags
-	if width > 140 && len(i.Issue.Labels) > 0 {
-		labelStr := truncateRunesHelper(strings.Join(i.Issue.Labels, ","), 20, "…")
-		labelStyle := t.Renderer.NewStyle().
-			Foreground(ColorPrimary).
-			Background(ColorBgSubtle).
-			Padding(0, 1)
-		rightParts = append(rightParts, labelStyle.Render(labelStr))
-		rightWidth += lipgloss.Width(labelStyle.Render(labelStr)) + 1
-	}
+	case ColumnStatus:
+		return RenderStatusBadge(string(i.Issue.Status))
 
-	// Left side fixed columns with polished badges
-	// [selector 2] [repo-badge 0-6] [icon 1-2] [prio-badge 3] [hint 1-2] [status-badge 6] [id dynamic] [space]
-	// Use measured iconDisplayWidth instead of hardcoded value for proper alignment
-	leftFixedWidth := 2 + iconDisplayWidth + 1 // selector(2) + icon(measured) + space(1)
-
-	// Repo badge width (workspace mode)
-	var repoBadge string
-	if d.WorkspaceMode && i.RepoPrefix != "" {
-		// Create a compact repo badge like [API] or [WEB]
-		repoBadge = RenderRepoBadge(i.RepoPrefix)
-		leftFixedWidth += lipgloss.Width(repoBadge) + 1
-	}
+	case ColumnID:
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		if isSelected {
+			idStyle = idStyle.Bold(true)
+		}
+		return idStyle.Render(truncateRunesHelper(i.Issue.ID, width, "…"))
 
-	// Priority badge (polished)
-	prioBadge := RenderPriorityBadge(i.Issue.Priority)
-	prioBadgeWidth := lipgloss.Width(prioBadge)
-	leftFixedWidth += prioBadgeWidth + 1
+	case ColumnTriage:
+		if i.TriageScore == 0 {
+			return t.Renderer.NewStyle().Foreground(ColorMuted).Render("-")
+		}
+		return t.Renderer.NewStyle().Foreground(GetHeatmapColor(i.TriageScore, t)).Render(fmt.Sprintf("%.2f", i.TriageScore))
 
-	// Priority hint indicator
-	if d.ShowPriorityHints {
-		leftFixedWidth += 2
-	}
+	case ColumnPageRank:
+		// Sparkline (Graph Score) - visualization of importance
+		spark := RenderSparkline(i.GraphScore, width)
+		return t.Renderer.NewStyle().Foreground(GetHeatmapColor(i.GraphScore, t)).Render(spark)
 
-	// Triage indicator width (bv-151) - use lipgloss.Width for accurate emoji measurement
-	if i.IsQuickWin {
-		leftFixedWidth += lipgloss.Width("⭐") + 1 // emoji + space
-	} else if i.IsBlocker && i.UnblocksCount > 0 {
-		leftFixedWidth += lipgloss.Width(fmt.Sprintf("🔓%d", i.UnblocksCount)) + 1 // emoji+count + space
-	} else if i.UnblocksCount > 0 {
-		leftFixedWidth += lipgloss.Width(fmt.Sprintf("↪%d", i.UnblocksCount)) + 1 // arrow+count + space
-	}
+	case ColumnAge:
+		return t.Renderer.NewStyle().Foreground(ColorMuted).Render(FormatTimeRel(i.Issue.CreatedAt))
 
-	// Status badge (polished)
-	statusBadge := RenderStatusBadge(string(i.Issue.Status))
-	statusBadgeWidth := lipgloss.Width(statusBadge)
-	leftFixedWidth += statusBadgeWidth + 1
+	case ColumnComments:
+		if n := len(i.Issue.Comments); n > 0 {
+			return t.Renderer.NewStyle().Foreground(ColorInfo).Render(fmt.Sprintf("💬%d", n))
+		}
+		return ""
 
-	// ID width - use actual visual width, but cap reasonably
-	idWidth := lipgloss.Width(idStr)
-	if idWidth > 35 {
-		idWidth = 35
-		idStr = truncateRunesHelper(idStr, 35, "…")
-	}
-	leftFixedWidth += idWidth + 1
+	case ColumnAssignee:
+		if i.Issue.Assignee == "" {
+			return ""
+		}
+		assignee := truncateRunesHelper(i.Issue.Assignee, width-1, "…")
+		return t.Renderer.NewStyle().Foreground(ColorSecondary).Render("@" + assignee)
 
-	// Diff badge width adjustment
-	if badge := i.DiffStatus.Badge(); badge != "" {
-		leftFixedWidth += lipgloss.Width(badge) + 1
+	case ColumnLabels:
+		// Labels rendered as a mini tag
+		if len(i.Issue.Labels) == 0 {
+			return ""
+		}
+		labelStr := truncateRunesHelper(strings.Join(i.Issue.Labels, ","), width-2, "…")
+		return t.Renderer.NewStyle().
+			Foreground(ColorPrimary).
+			Background(ColorBgSubtle).
+			Padding(0, 1).
+			Render(labelStr)
 	}
+	return ""
+}
 
-	// Title gets everything in between
-	titleWidth := width - leftFixedWidth - rightWidth - 2
-	if titleWidth < 5 {
-		titleWidth = 5
-	}
+// renderTitleCell renders the title, led by the triage, due, star,
+// subscription and diff badges, padded to exactly width cells
+func (d IssueDelegate) renderTitleCell(i IssueItem, m list.Model, index, width int, isSelected bool) string {
+	t := d.Theme
+	var badges []string
 
-	// Truncate title if needed
-	title = truncateRunesHelper(title, titleWidth, "…")
-	
-	// Pad title to fill space
-	currentWidth := lipgloss.Width(title)
-	if currentWidth < titleWidth {
-		title = title + strings.Repeat(" ", titleWidth-currentWidth)
+	// Triage indicators (bv-151): Quick win ⭐ and Unblocks count 🔓
+	if i.IsQuickWin {
+		badges = append(badges, t.Renderer.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("⭐"))
+	} else if i.IsBlocker && i.UnblocksCount > 0 {
+		badges = append(badges, t.Renderer.NewStyle().Foreground(lipgloss.Color("#50FA7B")).Render(fmt.Sprintf("🔓%d", i.UnblocksCount)))
+	} else if i.UnblocksCount > 0 {
+		badges = append(badges, t.Renderer.NewStyle().Foreground(lipgloss.Color("#6272A4")).Render(fmt.Sprintf("↪%d", i.UnblocksCount)))
 	}
 
-	// ══════════════════════════════════════════════════════════════════════════
-	// BUILD THE ROW
-	// ══════════════════════════════════════════════════════════════════════════
-	var leftSide strings.Builder
-
-	// Selection indicator with accent color
-	if isSelected {
-		leftSide.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
-	} else {
-		leftSide.WriteString("  ")
+	// Due badge: overdue or due soon
+	if due := RenderDueBadge(&i.Issue, time.Now()); due != "" {
+		badges = append(badges, due)
 	}
 
-	// Repo badge (workspace mode)
-	if repoBadge != "" {
-		leftSide.WriteString(repoBadge)
-		leftSide.WriteString(" ")
+	// Star for issues on the watchlist
+	if d.Starred[i.Issue.ID] {
+		badges = append(badges, t.Renderer.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("★"))
 	}
 
-	// Type icon with color
-	leftSide.WriteString(t.Renderer.NewStyle().Foreground(iconColor).Render(icon))
-	leftSide.WriteString(" ")
-
-	// Priority badge (polished)
-	leftSide.WriteString(prioBadge)
-	leftSide.WriteString(" ")
-
-	// Priority hint indicator (↑/↓)
-	if d.ShowPriorityHints && d.PriorityHints != nil {
-		if hint, ok := d.PriorityHints[i.Issue.ID]; ok {
-			if hint.Direction == "increase" {
-				leftSide.WriteString(t.Renderer.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Bold(true).Render("↑"))
-			} else if hint.Direction == "decrease" {
-				leftSide.WriteString(t.Renderer.NewStyle().Foreground(lipgloss.Color("#4ECDC4")).Bold(true).Render("↓"))
-			}
-		} else {
-			leftSide.WriteString(" ")
-		}
-		leftSide.WriteString(" ")
+	// Bell for issues subscribed to changes
+	if d.Subscribed[i.Issue.ID] {
+		badges = append(badges, "🔔")
 	}
 
-	// Triage indicators (bv-151): Quick win ⭐ and Unblocks count 🔓
-	triageIndicator := ""
-	if i.IsQuickWin {
-		triageIndicator = t.Renderer.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("⭐")
-	} else if i.IsBlocker && i.UnblocksCount > 0 {
-		triageIndicator = t.Renderer.NewStyle().Foreground(lipgloss.Color("#50FA7B")).Render(fmt.Sprintf("🔓%d", i.UnblocksCount))
-	} else if i.UnblocksCount > 0 {
-		triageIndicator = t.Renderer.NewStyle().Foreground(lipgloss.Color("#6272A4")).Render(fmt.Sprintf("↪%d", i.UnblocksCount))
+	// Diff badge (time-travel mode)
+	if badge := i.DiffStatus.Badge(); badge != "" {
+		badges = append(badges, badge)
 	}
-	if triageIndicator != "" {
-		leftSide.WriteString(triageIndicator)
-		leftSide.WriteString(" ")
+
+	var sb strings.Builder
+	for _, badge := range badges {
+		sb.WriteString(badge)
+		sb.WriteString(" ")
 	}
+	titleWidth := max(width-lipgloss.Width(sb.String()), 5)
 
-	// Status badge (polished)
-	leftSide.WriteString(statusBadge)
-	leftSide.WriteString(" ")
+	// While filtering, highlight what matched; a title without a visible
+	// match is followed by the passage that did match
+	title := i.Issue.Title
+	titleMarks, snippet, terms := rowHighlight(&i.Issue, m, index, titleWidth)
 
-	// ID with secondary styling
-	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
-	if isSelected {
-		idStyle = idStyle.Bold(true)
+	// Truncate title if needed (the ellipsis is never highlighted)
+	truncated := truncateRunesHelper(title, titleWidth, "…")
+	if truncated != title {
+		titleMarks = titleMarks[:min(len(titleMarks), utf8.RuneCountInString(truncated)-1)]
 	}
-	leftSide.WriteString(idStyle.Render(idStr))
-	leftSide.WriteString(" ")
-
-	// Diff badge (time-travel mode)
-	if badge := i.DiffStatus.Badge(); badge != "" {
-		leftSide.WriteString(badge)
-		leftSide.WriteString(" ")
+	title = truncated
+	if snippet != "" {
+		if room := titleWidth - lipgloss.Width(title) - 3; room >= 10 {
+			snippet = truncateRunesHelper(snippet, room, "…")
+		} else {
+			snippet = ""
+		}
 	}
 
 	// Title with emphasis when selected
@@ -258,29 +243,16 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	} else {
 		titleStyle = titleStyle.Foreground(lipgloss.AdaptiveColor{Light: "#333333", Dark: "#E8E8E8"})
 	}
-	leftSide.WriteString(titleStyle.Render(title))
-
-	// Right side
-	rightSide := strings.Join(rightParts, " ")
-
-	// Combine: left + padding + right
-	leftLen := lipgloss.Width(leftSide.String())
-	rightLen := lipgloss.Width(rightSide)
-	padding := width - leftLen - rightLen
-	if padding < 0 {
-		padding = 0
+	sb.WriteString(renderHighlighted(title, titleMarks, titleStyle, titleStyle.Reverse(true)))
+	if snippet != "" {
+		snippetStyle := t.Renderer.NewStyle().Foreground(t.Muted).Italic(true)
+		sb.WriteString(snippetStyle.Render(" · "))
+		sb.WriteString(renderHighlighted(snippet, matchedRunes(snippet, terms), snippetStyle, snippetStyle.Reverse(true)))
 	}
 
-	// Construct the row string
-	row := leftSide.String() + strings.Repeat(" ", padding) + rightSide
-
-	// Apply row background for selection and clamp width
-	rowStyle := t.Renderer.NewStyle().Width(width).MaxWidth(width)
-	if isSelected {
-		row = rowStyle.Background(t.Highlight).Render(row)
-	} else {
-		row = rowStyle.Render(row)
+	// Pad title to fill space
+	if pad := width - lipgloss.Width(sb.String()); pad > 0 {
+		sb.WriteString(strings.Repeat(" ", pad))
 	}
-
-	fmt.Fprint(w, row)
+	return sb.String()
 }
@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openRepairOverlay lists dependencies on missing issues so they can be fixed
+func (m *Model) openRepairOverlay() {
+	if m.beadsPath == "" {
+		m.statusMsg = "Repair needs a beads file to write to (not available for stdin, workspace or imported issues)"
+		m.statusIsError = true
+		return
+	}
+	m.repairItems = analysis.FindDanglingDependencies(m.issues)
+	if len(m.repairItems) == 0 {
+		m.statusMsg = "No dangling dependencies"
+		m.statusIsError = false
+		return
+	}
+	m.repairCursor = 0
+	m.repairChoice = 0
+	m.showRepair = true
+}
+
+// refreshRepairItems recomputes the overlay after the issues change
+func (m *Model) refreshRepairItems() {
+	m.repairItems = analysis.FindDanglingDependencies(m.issues)
+	if len(m.repairItems) == 0 {
+		m.showRepair = false
+		return
+	}
+	if m.repairCursor >= len(m.repairItems) {
+		m.repairCursor = len(m.repairItems) - 1
+	}
+	m.repairChoice = 0
+}
+
+// handleRepairKeys handles keys while the repair overlay is open
+func (m Model) handleRepairKeys(msg tea.KeyMsg) Model {
+	if len(m.repairItems) == 0 {
+		m.showRepair = false
+		return m
+	}
+	item := m.repairItems[m.repairCursor]
+
+	switch msg.String() {
+	case "j", "down":
+		if m.repairCursor < len(m.repairItems)-1 {
+			m.repairCursor++
+			m.repairChoice = 0
+		}
+	case "k", "up":
+		if m.repairCursor > 0 {
+			m.repairCursor--
+			m.repairChoice = 0
+		}
+	case "tab":
+		if len(item.Suggestions) > 0 {
+			m.repairChoice = (m.repairChoice + 1) % len(item.Suggestions)
+		}
+	case "enter":
+		if len(item.Suggestions) == 0 {
+			m.statusMsg = fmt.Sprintf("No existing issue looks like %s; drop the edge (d) or create a stub (s)", item.MissingID)
+			m.statusIsError = true
+			return m
+		}
+		to := item.Suggestions[m.repairChoice]
+		err := loader.RetargetDependency(m.beadsPath, item.IssueID, item.MissingID, to)
+		m.finishRepair(item, err, fmt.Sprintf("%s now depends on %s instead of %s", item.IssueID, to, item.MissingID), false)
+	case "d":
+		err := loader.DropDependency(m.beadsPath, item.IssueID, item.MissingID)
+		m.finishRepair(item, err, fmt.Sprintf("Dropped %s → %s", item.IssueID, item.MissingID), false)
+	case "s":
+		now := time.Now().UTC()
+		stub := model.Issue{
+			ID:          item.MissingID,
+			Title:       fmt.Sprintf("Stub for %s (referenced by %s)", item.MissingID, item.IssueID),
+			Description: "Created by bv to repair a dangling dependency. Fill in the details or close it.",
+			Status:      model.StatusOpen,
+			Priority:    2,
+			IssueType:   model.TypeTask,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		err := loader.AppendIssue(m.beadsPath, stub)
+		m.finishRepair(item, err, fmt.Sprintf("Created stub issue %s", stub.ID), true)
+	case "esc", "q", "ctrl+r":
+		m.showRepair = false
+	}
+	return m
+}
+
+// finishRepair reports a write and, on success, takes the fixed edges off
+// the overlay. The file watcher's reload brings the graph up to date; a stub
+// fixes every edge to its ID at once.
+func (m *Model) finishRepair(item analysis.DanglingDependency, err error, done string, stubCreated bool) {
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Repair failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	var remaining []analysis.DanglingDependency
+	for _, it := range m.repairItems {
+		if it.MissingID == item.MissingID && (it.IssueID == item.IssueID || stubCreated) {
+			continue
+		}
+		remaining = append(remaining, it)
+	}
+	m.repairItems = remaining
+	if m.repairCursor >= len(m.repairItems) {
+		m.repairCursor = max(0, len(m.repairItems)-1)
+	}
+	m.repairChoice = 0
+	if len(m.repairItems) == 0 {
+		m.showRepair = false
+	}
+	m.statusMsg = "🔧 " + done
+	m.statusIsError = false
+}
+
+// renderRepairOverlay renders the dangling-dependency repair overlay
+func (m Model) renderRepairOverlay() string {
+	t := m.theme
+
+	boxWidth := min(90, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	choiceStyle := t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Underline(true)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🔧 Repair Dangling Dependencies"))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d dependencies point at issues that don't exist", len(m.repairItems))))
+	sb.WriteString("\n\n")
+
+	visible := max(3, (m.height-14)/2)
+	start := 0
+	if m.repairCursor >= visible {
+		start = m.repairCursor - visible + 1
+	}
+	end := min(len(m.repairItems), start+visible)
+	if start > 0 {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↑ %d more", start)))
+		sb.WriteString("\n")
+	}
+
+	for i := start; i < end; i++ {
+		item := m.repairItems[i]
+		selected := i == m.repairCursor
+		cursor := "  "
+		style := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+		if selected {
+			cursor = "▸ "
+			style = style.Bold(true)
+		}
+		depType := string(item.Type)
+		if depType == "" {
+			depType = string(model.DepBlocks)
+		}
+		line := fmt.Sprintf("%s%s → %s (%s)", cursor, item.IssueID, item.MissingID, depType)
+		sb.WriteString(style.Render(truncateRunesHelper(line, boxWidth-8, "…")))
+		sb.WriteString("\n")
+		if !selected {
+			continue
+		}
+
+		if len(item.Suggestions) == 0 {
+			sb.WriteString(mutedStyle.Italic(true).Render("     No similar issue IDs"))
+		} else {
+			parts := make([]string, len(item.Suggestions))
+			for j, s := range item.Suggestions {
+				if j == m.repairChoice {
+					parts[j] = choiceStyle.Render(s)
+				} else {
+					parts[j] = mutedStyle.Render(s)
+				}
+			}
+			sb.WriteString(mutedStyle.Render("     Did you mean: ") + strings.Join(parts, mutedStyle.Render(" · ")))
+		}
+		sb.WriteString("\n")
+	}
+	if end < len(m.repairItems) {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.repairItems)-end)))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render(
+		"Enter: use suggestion • Tab: next suggestion • d: drop edge • s: create stub • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
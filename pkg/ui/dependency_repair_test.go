@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_RepairOverlay(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"rp-1","title":"Target","status":"open","issue_type":"task"}
+{"id":"rp-2","title":"Typo","status":"open","issue_type":"task","dependencies":[{"issue_id":"rp-2","depends_on_id":"rp-l","type":"blocks"}]}
+{"id":"rp-3","title":"Stale","status":"open","issue_type":"task","dependencies":[{"issue_id":"rp-3","depends_on_id":"zzz-gone","type":"blocks"}]}
+{"id":"rp-4","title":"Stub me","status":"open","issue_type":"task","dependencies":[{"issue_id":"rp-4","depends_on_id":"ext-99","type":"related"}]}
+`
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("ctrl+r")
+	if !m.showRepair || len(m.repairItems) != 3 {
+		t.Fatalf("Expected 3 dangling dependencies, got %+v", m.repairItems)
+	}
+	view := m.View()
+	for _, want := range []string{"Repair Dangling Dependencies", "rp-2 → rp-l", "Did you mean", "rp-1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q", want)
+		}
+	}
+
+	// Retarget the typo to the suggested ID
+	press("enter")
+	if m.statusIsError || !strings.Contains(m.statusMsg, "rp-2 now depends on rp-1") {
+		t.Fatalf("Unexpected status %q", m.statusMsg)
+	}
+	// Drop the stale edge; no suggestion exists for it
+	if m.repairItems[m.repairCursor].MissingID != "zzz-gone" {
+		t.Fatalf("Expected zzz-gone next, got %+v", m.repairItems[m.repairCursor])
+	}
+	press("enter")
+	if !m.statusIsError {
+		t.Error("Enter without suggestions should report an error")
+	}
+	press("d")
+	// Create a stub for the last one, which closes the overlay
+	press("s")
+	if m.showRepair || !strings.Contains(m.statusMsg, "Created stub issue ext-99") {
+		t.Fatalf("Expected the overlay to close after the last fix, got %q", m.statusMsg)
+	}
+
+	report, err := loader.ValidateIssueFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Records != 5 || len(report.Problems) != 0 {
+		t.Errorf("Expected 5 clean records after repair, got %+v", report)
+	}
+	data, _ := os.ReadFile(beadsPath)
+	if !strings.Contains(string(data), `"depends_on_id":"rp-1"`) || strings.Contains(string(data), "zzz-gone") {
+		t.Errorf("File not repaired as expected:\n%s", data)
+	}
+
+	m = NewModel(issues, nil, "")
+	press("ctrl+r")
+	if m.showRepair || !m.statusIsError {
+		t.Error("Without a beads file the overlay should not open")
+	}
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+)
+
+// minEffortConfidence skips weak correlations (e.g. same author, same day)
+// when counting the work behind an issue
+const minEffortConfidence = 0.5
+
+// effortFromHistory sums the commits and lines changed correlated with
+// each issue
+func effortFromHistory(report *correlation.HistoryReport) map[string]analysis.IssueEffort {
+	effort := make(map[string]analysis.IssueEffort, len(report.Histories))
+	for id, history := range report.Histories {
+		var e analysis.IssueEffort
+		seen := make(map[string]bool, len(history.Commits))
+		for _, c := range history.Commits {
+			if c.Confidence < minEffortConfidence || seen[c.SHA] {
+				continue
+			}
+			seen[c.SHA] = true
+			e.Commits++
+			for _, f := range c.Files {
+				e.LinesChanged += f.Insertions + f.Deletions
+			}
+		}
+		if !e.IsZero() {
+			effort[id] = e
+		}
+	}
+	return effort
+}
+
+// restoreChainEffort hands chain effort estimates for the current issues
+// to a freshly built insights panel, once history has loaded
+func (m *Model) restoreChainEffort() {
+	if m.observedEffort == nil {
+		return
+	}
+	ce := analysis.ComputeChainEffort(m.issues, m.observedEffort)
+	m.insightsPanel.SetChainEffort(&ce)
+}
+
+// formatEffort renders an effort as e.g. "~1.2k lines · 9 commits"
+func formatEffort(e analysis.IssueEffort) string {
+	lines := fmt.Sprintf("%d", e.LinesChanged)
+	if e.LinesChanged >= 1000 {
+		lines = fmt.Sprintf("%.1fk", float64(e.LinesChanged)/1000)
+	}
+	commits := "commits"
+	if e.Commits == 1 {
+		commits = "commit"
+	}
+	return fmt.Sprintf("~%s lines · %d %s", lines, e.Commits, commits)
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestEffortFromHistory(t *testing.T) {
+	files := []correlation.FileChange{{Path: "a.go", Insertions: 30, Deletions: 10}, {Path: "b.go", Insertions: 5}}
+	report := &correlation.HistoryReport{Histories: map[string]correlation.BeadHistory{
+		"bv-1": {Commits: []correlation.CorrelatedCommit{
+			{SHA: "aaa", Confidence: 0.95, Files: files},
+			{SHA: "aaa", Confidence: 0.95, Files: files}, // same commit found twice
+			{SHA: "bbb", Confidence: 0.2, Files: files},  // weak temporal guess
+			{SHA: "ccc", Confidence: 0.7, Files: []correlation.FileChange{{Insertions: 1}}},
+		}},
+		"bv-2": {Commits: []correlation.CorrelatedCommit{{SHA: "ddd", Confidence: 0.3}}},
+	}}
+
+	effort := effortFromHistory(report)
+	if got := effort["bv-1"]; got != (analysis.IssueEffort{Commits: 2, LinesChanged: 46}) {
+		t.Errorf("bv-1 effort = %+v", got)
+	}
+	if _, ok := effort["bv-2"]; ok {
+		t.Error("issues with only weak correlations should have no effort")
+	}
+}
+
+func TestKeystoneDetailShowsRemainingHeaviness(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "old", Title: "Shipped", Status: model.StatusClosed, IssueType: model.TypeTask},
+		{ID: "base", Title: "Base", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "top", Title: "Top", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "top", DependsOnID: "base", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 140, 40
+	report := &correlation.HistoryReport{Histories: map[string]correlation.BeadHistory{
+		"old": {Commits: []correlation.CorrelatedCommit{{SHA: "a", Confidence: 1, Files: []correlation.FileChange{{Insertions: 1500}}}}},
+	}}
+	updated, _ := m.Update(HistoryLoadedMsg{Report: report})
+	m = updated.(Model)
+
+	ce := m.insightsPanel.chainEffort
+	if ce == nil {
+		t.Fatal("expected history to give the insights panel chain effort")
+	}
+	if got := formatEffort(ce.Chain["base"]); got != "~3.0k lines · 2 commits" {
+		t.Errorf("base heaviness = %s", got)
+	}
+
+	m.insightsPanel.insights.Keystones = []analysis.InsightItem{{ID: "base", Value: 2}}
+	m.insightsPanel.focusedPanel = PanelKeystones
+	out := m.insightsPanel.renderDetailPanel(80, 30, m.theme)
+	if !strings.Contains(out, "Remaining heaviness") || !strings.Contains(out, "~3.0k lines") {
+		t.Errorf("keystone detail missing heaviness:\n%s", out)
+	}
+}
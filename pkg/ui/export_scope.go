@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// exportScope is one set of issues the Markdown export can be limited to
+type exportScope struct {
+	name   string // Shown in the picker, e.g. "Label api"
+	title  string // Report heading
+	slug   string // Added to the filename; "" for all issues
+	issues []model.Issue
+}
+
+// ExportScopePickerModel represents the overlay that chooses which issues
+// E exports
+type ExportScopePickerModel struct {
+	scopes        []exportScope
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewExportScopePickerModel creates a scope picker with the given scope
+// selected
+func NewExportScopePickerModel(scopes []exportScope, selected int, theme Theme) ExportScopePickerModel {
+	return ExportScopePickerModel{
+		scopes:        scopes,
+		selectedIndex: max(0, min(selected, len(scopes)-1)),
+		theme:         theme,
+	}
+}
+
+// SetSize updates the overlay dimensions
+func (m *ExportScopePickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *ExportScopePickerModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *ExportScopePickerModel) MoveDown() {
+	if m.selectedIndex < len(m.scopes)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedScope returns the selected scope, or nil if there are none
+func (m *ExportScopePickerModel) SelectedScope() *exportScope {
+	if m.selectedIndex >= len(m.scopes) {
+		return nil
+	}
+	return &m.scopes[m.selectedIndex]
+}
+
+// ScopeAt returns the scope shown with number n (1-based), or nil
+func (m *ExportScopePickerModel) ScopeAt(n int) *exportScope {
+	if n < 1 || n > len(m.scopes) {
+		return nil
+	}
+	return &m.scopes[n-1]
+}
+
+// View renders the scope picker overlay
+func (m *ExportScopePickerModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 56
+	if m.width < 66 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 36 {
+		boxWidth = 36
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("Export to Markdown"))
+	lines = append(lines, "")
+
+	metaStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary)
+
+	for i, s := range m.scopes {
+		isSelected := i == m.selectedIndex
+
+		nameStyle := t.Renderer.NewStyle()
+		if isSelected {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		} else {
+			nameStyle = nameStyle.Foreground(t.Base.GetForeground())
+		}
+
+		prefix := "  "
+		if isSelected {
+			prefix = "▸ "
+		}
+		count := "1 issue"
+		if len(s.issues) != 1 {
+			count = fmt.Sprintf("%d issues", len(s.issues))
+		}
+		left := fmt.Sprintf("%s%d %s", prefix, i+1, s.name)
+		nameWidth := boxWidth - 6 - lipgloss.Width(count) - 2
+		left = truncateRunesHelper(left, nameWidth, "…")
+		pad := max(0, nameWidth-lipgloss.Width(left))
+		lines = append(lines, nameStyle.Render(left)+strings.Repeat(" ", pad+2)+metaStyle.Render(count))
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render(fmt.Sprintf("j/k: move • 1-%d/enter: export • esc: cancel", len(m.scopes))))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
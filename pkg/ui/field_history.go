@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FieldHistoryEntry is one commit's changes to an issue's fields
+type FieldHistoryEntry struct {
+	SHA     string
+	At      time.Time
+	Author  string
+	Message string
+	Created bool
+	Removed bool
+	Changes []analysis.FieldChange
+}
+
+// FieldHistoryLoadedMsg is sent when an issue's field history is rebuilt
+type FieldHistoryLoadedMsg struct {
+	IssueID string
+	Entries []FieldHistoryEntry
+	Error   error
+}
+
+// LoadFieldHistoryCmd rebuilds the field-by-field history of one issue
+// from the git history of the beads file
+func LoadFieldHistoryCmd(beadsPath, issueID string) tea.Cmd {
+	return func() tea.Msg {
+		repoPath := projectDirFromBeadsPath(beadsPath)
+		if repoPath == "" {
+			return FieldHistoryLoadedMsg{IssueID: issueID, Error: fmt.Errorf("unable to determine repository root")}
+		}
+		changes, err := correlation.NewExtractor(repoPath, beadsPath).ExtractChanges(context.Background(), issueID, correlation.ExtractOptions{})
+		if err != nil {
+			return FieldHistoryLoadedMsg{IssueID: issueID, Error: err}
+		}
+		return FieldHistoryLoadedMsg{IssueID: issueID, Entries: fieldHistoryEntries(changes)}
+	}
+}
+
+// fieldHistoryEntries turns raw line changes into field changes, dropping
+// commits that touched nothing DetectChanges compares (such as updated_at)
+func fieldHistoryEntries(changes []correlation.BeadChange) []FieldHistoryEntry {
+	var entries []FieldHistoryEntry
+	for _, c := range changes {
+		entry := FieldHistoryEntry{
+			SHA:     c.CommitSHA,
+			At:      c.Timestamp,
+			Author:  c.Author,
+			Message: c.CommitMsg,
+			Created: c.Before == "",
+			Removed: c.After == "",
+		}
+		if !entry.Created && !entry.Removed {
+			var before, after model.Issue
+			if json.Unmarshal([]byte(c.Before), &before) != nil || json.Unmarshal([]byte(c.After), &after) != nil {
+				continue
+			}
+			entry.Changes = analysis.DetectChanges(before, after)
+			if len(entry.Changes) == 0 {
+				continue
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// openFieldHistory starts loading the field history of the selected issue
+func (m *Model) openFieldHistory() tea.Cmd {
+	id := m.selectedListID()
+	if id == "" || m.blockNoGit("Field history") {
+		return nil
+	}
+	m.showFieldHistory = true
+	m.fieldHistoryID = id
+	m.fieldHistory = nil
+	m.fieldHistoryLoading = true
+	m.fieldHistoryScroll = 0
+	return LoadFieldHistoryCmd(m.beadsPath, id)
+}
+
+// handleFieldHistoryLoaded shows the loaded history, newest at the bottom
+func (m *Model) handleFieldHistoryLoaded(msg FieldHistoryLoadedMsg) {
+	if msg.IssueID != m.fieldHistoryID {
+		return // The overlay has moved on to another issue
+	}
+	m.fieldHistoryLoading = false
+	if msg.Error != nil {
+		m.showFieldHistory = false
+		m.statusMsg = fmt.Sprintf("❌ Field history failed: %v", msg.Error)
+		m.statusIsError = true
+		return
+	}
+	m.fieldHistory = msg.Entries
+}
+
+// handleFieldHistoryKeys handles keys while the field history is open
+func (m Model) handleFieldHistoryKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.fieldHistoryScroll < len(m.fieldHistory)-1 {
+			m.fieldHistoryScroll++
+		}
+	case "k", "up":
+		if m.fieldHistoryScroll > 0 {
+			m.fieldHistoryScroll--
+		}
+	case "home", "g":
+		m.fieldHistoryScroll = 0
+	case "end", "G":
+		m.fieldHistoryScroll = max(0, len(m.fieldHistory)-1)
+	case "esc", "q", "f":
+		m.showFieldHistory = false
+	}
+	return m
+}
+
+// renderFieldHistory renders the issue's field changes commit by commit
+func (m Model) renderFieldHistory() string {
+	t := m.theme
+
+	boxWidth := min(100, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	headStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	fieldStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).Width(22)
+	oldStyle := t.Renderer.NewStyle().Foreground(t.Muted).Strikethrough(true)
+	newStyle := t.Renderer.NewStyle().Foreground(t.Open)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("📜 Field History: " + m.fieldHistoryID))
+	sb.WriteString("\n")
+
+	switch {
+	case m.fieldHistoryLoading:
+		sb.WriteString(mutedStyle.Render("Reading the beads file's git history…"))
+	case len(m.fieldHistory) == 0:
+		sb.WriteString(mutedStyle.Render("No recorded changes: the issue was never committed with the beads file"))
+	default:
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d commits changed this issue, oldest first", len(m.fieldHistory))))
+		sb.WriteString("\n")
+
+		// Entries are scrolled one at a time; each takes two or more lines
+		lines := 0
+		budget := max(6, m.height-12)
+		shown := 0
+		for i := m.fieldHistoryScroll; i < len(m.fieldHistory) && lines < budget; i++ {
+			e := m.fieldHistory[i]
+			sb.WriteString("\n")
+			head := fmt.Sprintf("%s  %s  %s", e.At.Format("2006-01-02 15:04"), e.SHA[:min(7, len(e.SHA))], e.Author)
+			sb.WriteString(headStyle.Render(head))
+			sb.WriteString(mutedStyle.Render("  " + truncateRunesHelper(e.Message, max(10, boxWidth-lipgloss.Width(head)-8), "…")))
+			sb.WriteString("\n")
+			lines += 2
+			switch {
+			case e.Created:
+				sb.WriteString("  " + newStyle.Render("created"))
+				sb.WriteString("\n")
+				lines++
+			case e.Removed:
+				sb.WriteString("  " + oldStyle.Render("removed from the beads file"))
+				sb.WriteString("\n")
+				lines++
+			}
+			for _, c := range e.Changes {
+				width := max(10, (boxWidth-32)/2)
+				sb.WriteString("  " + fieldStyle.Render(c.Field) +
+					oldStyle.Render(truncateRunesHelper(c.OldValue, width, "…")) +
+					mutedStyle.Render(" → ") +
+					newStyle.Render(truncateRunesHelper(c.NewValue, width, "…")))
+				sb.WriteString("\n")
+				lines++
+			}
+			shown++
+		}
+		if rest := len(m.fieldHistory) - m.fieldHistoryScroll - shown; rest > 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("\n  ↓ %d more", rest)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: scroll • g/G: first/last • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
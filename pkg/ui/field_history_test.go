@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_FieldHistory(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(msg string, lines ...string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", ".")
+		git("commit", "-q", "-m", msg)
+	}
+	git("init", "-q")
+	git("config", "user.email", "test@test.com")
+	git("config", "user.name", "Test User")
+	commit("Add A",
+		`{"id":"A","title":"Login","status":"open","priority":2,"issue_type":"task"}`)
+	commit("Triage",
+		`{"id":"A","title":"Login","status":"open","priority":0,"issue_type":"task","labels":["auth"]}`,
+		`{"id":"B","title":"Other","status":"open","issue_type":"task"}`)
+	commit("Touch B only",
+		`{"id":"A","title":"Login","status":"open","priority":0,"issue_type":"task","labels":["auth"]}`,
+		`{"id":"B","title":"Other","status":"closed","issue_type":"task"}`)
+	commit("Bump timestamp",
+		`{"id":"A","title":"Login","status":"open","priority":0,"issue_type":"task","labels":["auth"],"updated_at":"2025-03-01T00:00:00Z"}`,
+		`{"id":"B","title":"Other","status":"closed","issue_type":"task"}`)
+	commit("Close A",
+		`{"id":"A","title":"Login","status":"closed","priority":0,"issue_type":"task","labels":["auth"],"updated_at":"2025-03-02T00:00:00Z"}`,
+		`{"id":"B","title":"Other","status":"closed","issue_type":"task"}`)
+
+	m := NewModel([]model.Issue{
+		{ID: "A", Title: "Login", Status: model.StatusClosed, IssueType: model.TypeTask},
+		{ID: "B", Title: "Other", Status: model.StatusClosed, IssueType: model.TypeTask},
+	}, nil, beadsPath)
+	m.gitErr = nil
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.jumpToIssue("A")
+
+	updated, cmd := m.Update(keyMsgFromString("f"))
+	m = updated.(Model)
+	if !m.showFieldHistory || m.fieldHistoryID != "A" || cmd == nil {
+		t.Fatal("Expected f to open the field history of A")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+
+	// B's commit and the updated_at-only commit are left out
+	if len(m.fieldHistory) != 3 {
+		t.Fatalf("Expected created, triage and close entries, got %+v", m.fieldHistory)
+	}
+	if !m.fieldHistory[0].Created {
+		t.Error("Expected the first entry to be the creation")
+	}
+	fields := func(i int) string {
+		var names []string
+		for _, c := range m.fieldHistory[i].Changes {
+			names = append(names, c.Field+":"+c.OldValue+">"+c.NewValue)
+		}
+		return strings.Join(names, ",")
+	}
+	if got := fields(1); got != "priority:P2>P0,labels:(none)>auth" {
+		t.Errorf("Expected the triage commit to change priority and labels, got %q", got)
+	}
+	if got := fields(2); got != "status:open>closed" {
+		t.Errorf("Expected the last commit to close A, got %q", got)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Field History: A", "Triage", "Close A", "closed"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the overlay to show %q", want)
+		}
+	}
+	m = press(m, "esc")
+	if m.showFieldHistory {
+		t.Error("Expected esc to close the field history")
+	}
+}
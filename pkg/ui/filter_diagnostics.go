@@ -0,0 +1,299 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// filterStage is one constraint of the active filter. applyFilter and
+// applyRecipe keep issues that match every stage; the zero-result panel
+// counts what survives each stage in turn.
+type filterStage struct {
+	Label string
+	Match func(issue *model.Issue) bool
+}
+
+// funnelStep is the number of issues left after a stage
+type funnelStep struct {
+	Label string
+	Count int
+}
+
+func matchesStages(stages []filterStage, issue *model.Issue) bool {
+	for _, s := range stages {
+		if !s.Match(issue) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasOpenBlocker reports whether a blocking dependency is still open
+func (m *Model) hasOpenBlocker(issue *model.Issue) bool {
+	for _, dep := range issue.Dependencies {
+		if dep.Type == model.DepBlocks {
+			if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// baseFilterStages are the workspace repo filter, the marked-only view and
+// the hiding of snoozed issues, which apply under both plain filters and
+// recipes
+func (m *Model) baseFilterStages() []filterStage {
+	var stages []filterStage
+	if stage, ok := m.snoozeStage(); ok {
+		stages = append(stages, stage)
+	}
+	// Workspace repo filter (nil = all repos)
+	if m.workspaceMode && m.activeRepos != nil {
+		stages = append(stages, filterStage{m.filterCrumbLabel(filterKindRepo), func(issue *model.Issue) bool {
+			repoKey := strings.ToLower(ExtractRepoPrefix(issue.ID))
+			return repoKey == "" || m.activeRepos[repoKey]
+		}})
+	}
+	// Multi-select restriction (M); other filters narrow the marked set
+	if m.markedOnly {
+		stages = append(stages, filterStage{m.filterCrumbLabel(filterKindMarked), func(issue *model.Issue) bool {
+			return m.marked[issue.ID]
+		}})
+	}
+	return stages
+}
+
+// listFilterStages returns the stages of currentFilter; q is its parsed
+// query expression, if any
+func (m *Model) listFilterStages(q *query.Query) []filterStage {
+	stages := m.baseFilterStages()
+	var match func(issue *model.Issue) bool
+	switch m.currentFilter {
+	case "all":
+		return stages
+	case "open":
+		match = func(issue *model.Issue) bool { return issue.Status != model.StatusClosed }
+	case "closed":
+		match = func(issue *model.Issue) bool { return issue.Status == model.StatusClosed }
+	case "ready":
+		// Ready = Open/InProgress AND NO Open Blockers
+		match = func(issue *model.Issue) bool {
+			return issue.Status != model.StatusClosed && issue.Status != model.StatusBlocked && !m.hasOpenBlocker(issue)
+		}
+	case "snoozed":
+		match = func(issue *model.Issue) bool { return m.isSnoozed(issue.ID) }
+	case "starred":
+		match = func(issue *model.Issue) bool { return m.starred[issue.ID] }
+	default:
+		if label, ok := strings.CutPrefix(m.currentFilter, "label:"); ok && q == nil {
+			match = func(issue *model.Issue) bool {
+				for _, l := range issue.Labels {
+					if l == label {
+						return true
+					}
+				}
+				return false
+			}
+		} else if q != nil {
+			match = func(issue *model.Issue) bool { return q.Match(issue) }
+		} else {
+			// Unknown filters match nothing
+			match = func(*model.Issue) bool { return false }
+		}
+	}
+	return append(stages, filterStage{m.filterCrumbLabel(filterKindStatus), match})
+}
+
+// recipeFilterStages returns the stages of a recipe's filters; q is its
+// parsed query expression, if any
+func (m *Model) recipeFilterStages(r *recipe.Recipe, q *query.Query) []filterStage {
+	stages := m.baseFilterStages()
+	f := r.Filters
+
+	if len(f.Status) > 0 {
+		stages = append(stages, filterStage{"recipe status: " + strings.Join(f.Status, ", "), func(issue *model.Issue) bool {
+			for _, s := range f.Status {
+				if string(issue.Status) == s {
+					return true
+				}
+			}
+			return false
+		}})
+	}
+	if len(f.Priority) > 0 {
+		prios := make([]string, len(f.Priority))
+		for i, p := range f.Priority {
+			prios[i] = "P" + strconv.Itoa(p)
+		}
+		stages = append(stages, filterStage{"recipe priority: " + strings.Join(prios, ", "), func(issue *model.Issue) bool {
+			for _, p := range f.Priority {
+				if issue.Priority == p {
+					return true
+				}
+			}
+			return false
+		}})
+	}
+	// Tags: must have ALL specified tags
+	if len(f.Tags) > 0 {
+		stages = append(stages, filterStage{"recipe tags: " + strings.Join(f.Tags, ", "), func(issue *model.Issue) bool {
+			labelSet := make(map[string]bool, len(issue.Labels))
+			for _, l := range issue.Labels {
+				labelSet[l] = true
+			}
+			for _, required := range f.Tags {
+				if !labelSet[required] {
+					return false
+				}
+			}
+			return true
+		}})
+	}
+	if f.Actionable != nil && *f.Actionable {
+		stages = append(stages, filterStage{"actionable (no open blockers)", func(issue *model.Issue) bool {
+			return !m.hasOpenBlocker(issue)
+		}})
+	}
+	if f.Overdue != nil || f.DueWithin != "" {
+		name := "recipe due within " + f.DueWithin
+		switch {
+		case f.Overdue != nil && *f.Overdue:
+			name = "recipe overdue"
+		case f.Overdue != nil && f.DueWithin == "":
+			name = "recipe not overdue"
+		}
+		now := time.Now()
+		stages = append(stages, filterStage{name, func(issue *model.Issue) bool {
+			return f.MatchesDue(issue, now)
+		}})
+	}
+	if q != nil {
+		stages = append(stages, filterStage{"recipe query: " + truncateRunesHelper(f.Query, 30, "…"), func(issue *model.Issue) bool {
+			return q.Match(issue)
+		}})
+	}
+	return stages
+}
+
+// filterFunnel counts the issues left after each active stage, starting from
+// every loaded issue and ending with the fuzzy search, if one is applied
+func (m Model) filterFunnel() []funnelStep {
+	var stages []filterStage
+	if m.recipeFilterActive() {
+		var q *query.Query
+		if expr := m.activeRecipe.Filters.Query; expr != "" {
+			q, _ = query.Parse(expr)
+		}
+		stages = m.recipeFilterStages(m.activeRecipe, q)
+	} else {
+		var q *query.Query
+		if expr, ok := strings.CutPrefix(m.currentFilter, "query:"); ok {
+			q, _ = query.Parse(expr)
+		}
+		stages = m.listFilterStages(q)
+	}
+
+	steps := []funnelStep{{"all issues", len(m.issues)}}
+	remaining := make([]*model.Issue, 0, len(m.issues))
+	for i := range m.issues {
+		remaining = append(remaining, &m.issues[i])
+	}
+	for _, s := range stages {
+		kept := remaining[:0]
+		for _, issue := range remaining {
+			if s.Match(issue) {
+				kept = append(kept, issue)
+			}
+		}
+		remaining = kept
+		steps = append(steps, funnelStep{s.Label, len(remaining)})
+	}
+	if m.list.FilterState() == list.FilterApplied {
+		steps = append(steps, funnelStep{m.filterCrumbLabel(filterKindSearch), len(m.list.VisibleItems())})
+	}
+	return steps
+}
+
+// showZeroResultDiagnostics reports whether the list is empty because of
+// the active filters (rather than because there are no issues)
+func (m Model) showZeroResultDiagnostics() bool {
+	return len(m.issues) > 0 && len(m.list.VisibleItems()) == 0 && m.list.FilterState() != list.Filtering
+}
+
+// renderZeroResultDiagnostics explains an empty list: how many issues each
+// stage left, and which one eliminated the last of them
+func (m Model) renderZeroResultDiagnostics(width, height int) string {
+	t := m.theme
+	steps := m.filterFunnel()
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	hitStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+
+	lines := []string{
+		titleStyle.Render("No issues match the active filters"),
+		"",
+	}
+	labelWidth := 0
+	for _, s := range steps {
+		if w := lipgloss.Width(s.Label); w > labelWidth {
+			labelWidth = w
+		}
+	}
+	if maxLabel := width - 24; labelWidth > maxLabel && maxLabel > 10 {
+		labelWidth = maxLabel
+	}
+
+	culprit := -1
+	for i := 1; i < len(steps); i++ {
+		if steps[i].Count == 0 && steps[i-1].Count > 0 {
+			culprit = i
+			break
+		}
+	}
+	for i, s := range steps {
+		label := truncateRunesHelper(s.Label, labelWidth, "…")
+		line := fmt.Sprintf("  %s%s %6d", label, strings.Repeat(" ", labelWidth-lipgloss.Width(label)), s.Count)
+		switch {
+		case i == culprit:
+			lines = append(lines, hitStyle.Render(line+"  ← eliminated the rest"))
+		case i == 0:
+			lines = append(lines, dimStyle.Render(line))
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	lines = append(lines, "")
+	if len(m.filterStack) > 0 {
+		lines = append(lines, dimStyle.Render("⌫ remove the last filter • a show all"))
+	} else {
+		lines = append(lines, dimStyle.Render("a show all"))
+	}
+
+	return t.Renderer.NewStyle().
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Padding(1, 2).
+		Render(strings.Join(lines, "\n"))
+}
+
+// listBody renders the issue list, or the zero-result panel when the active
+// filters leave nothing to show
+func (m Model) listBody(width int) string {
+	if m.showZeroResultDiagnostics() {
+		return m.renderZeroResultDiagnostics(width, m.list.Height())
+	}
+	return m.list.View()
+}
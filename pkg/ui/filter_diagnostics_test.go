@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func funnelString(steps []funnelStep) string {
+	var parts []string
+	for _, s := range steps {
+		parts = append(parts, fmt.Sprintf("%s=%d", s.Label, s.Count))
+	}
+	return strings.Join(parts, " > ")
+}
+
+func TestModel_ZeroResultDiagnostics(t *testing.T) {
+	m := filterStackTestModel(t)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	if m.showZeroResultDiagnostics() {
+		t.Fatal("Unfiltered list should not show diagnostics")
+	}
+
+	// Mark an open issue, show marked only, then ask for closed issues
+	m.list.Select(m.listIndexOf("fs-1"))
+	press(" ")
+	press("M")
+	press("c")
+	if len(m.list.Items()) != 0 || !m.showZeroResultDiagnostics() {
+		t.Fatalf("Setup: expected an empty list, got %d items", len(m.list.Items()))
+	}
+	if got := funnelString(m.filterFunnel()); got != "all issues=3 > marked only=1 > status: closed=0" {
+		t.Errorf("Funnel = %s", got)
+	}
+	view := m.View()
+	if !strings.Contains(view, "No issues match the active filters") || !strings.Contains(view, "eliminated the rest") {
+		t.Error("Empty list should explain which filter removed everything")
+	}
+
+	press("backspace")
+	if m.showZeroResultDiagnostics() || strings.Contains(m.View(), "No issues match") {
+		t.Error("Diagnostics should go away once issues are visible")
+	}
+}
+
+func TestModel_ZeroResultDiagnosticsRecipe(t *testing.T) {
+	m := filterStackTestModel(t)
+	r := &recipe.Recipe{Name: "urgent"}
+	r.Filters.Status = []string{"open"}
+	r.Filters.Priority = []int{0}
+	m.activeRecipe = r
+	m.applyRecipe(r)
+
+	if got := funnelString(m.filterFunnel()); got != "all issues=3 > recipe status: open=2 > recipe priority: P0=0" {
+		t.Errorf("Funnel = %s", got)
+	}
+	if !m.showZeroResultDiagnostics() {
+		t.Error("Empty recipe result should show diagnostics")
+	}
+}
+
+func TestModel_ZeroResultDiagnosticsNoIssues(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	m.SetFilter("closed")
+	if m.showZeroResultDiagnostics() {
+		t.Error("An empty project has nothing to diagnose")
+	}
+
+	// Unchanged filter semantics: an unknown filter still matches nothing
+	m = filterStackTestModel(t)
+	m.SetFilter("bogus")
+	if len(m.list.Items()) != 0 {
+		t.Errorf("Unknown filter should match nothing, got %d", len(m.list.Items()))
+	}
+}
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// Kinds of list constraint that can be active at once. Each kind holds at
+// most one constraint; a status filter and a recipe share currentFilter.
+const (
+	filterKindRepo   = "repo"
+	filterKindRecipe = "recipe"
+	filterKindStatus = "filter"
+	filterKindMarked = "marked"
+	filterKindSearch = "search"
+)
+
+// filterCrumb is one active constraint in the footer breadcrumb
+type filterCrumb struct {
+	Kind  string
+	Label string
+}
+
+// recipeFilterActive reports whether the list is showing the active recipe
+func (m Model) recipeFilterActive() bool {
+	return m.activeRecipe != nil && m.currentFilter == "recipe:"+m.activeRecipe.Name
+}
+
+// activeFilterKinds returns the constraints narrowing the list, in a fixed order
+func (m Model) activeFilterKinds() []string {
+	var kinds []string
+	if m.workspaceMode && len(m.activeRepos) > 0 {
+		kinds = append(kinds, filterKindRepo)
+	}
+	if m.recipeFilterActive() {
+		kinds = append(kinds, filterKindRecipe)
+	} else if m.currentFilter != "all" && m.currentFilter != "" && !strings.HasPrefix(m.currentFilter, "recipe:") {
+		kinds = append(kinds, filterKindStatus)
+	}
+	if m.markedOnly {
+		kinds = append(kinds, filterKindMarked)
+	}
+	if m.list.FilterState() == list.FilterApplied {
+		kinds = append(kinds, filterKindSearch)
+	}
+	return kinds
+}
+
+// filterCrumbs returns the active constraints in the order they were applied.
+// Kinds that became active since the stack was last synced go last.
+func (m Model) filterCrumbs() []filterCrumb {
+	active := m.activeFilterKinds()
+	isActive := make(map[string]bool, len(active))
+	for _, kind := range active {
+		isActive[kind] = true
+	}
+
+	var crumbs []filterCrumb
+	seen := make(map[string]bool, len(active))
+	for _, kind := range append(append([]string{}, m.filterStack...), active...) {
+		if !isActive[kind] || seen[kind] {
+			continue
+		}
+		seen[kind] = true
+		crumbs = append(crumbs, filterCrumb{Kind: kind, Label: m.filterCrumbLabel(kind)})
+	}
+	return crumbs
+}
+
+// filterCrumbLabel describes the constraint of the given kind
+func (m Model) filterCrumbLabel(kind string) string {
+	switch kind {
+	case filterKindRepo:
+		return "repos: " + formatRepoList(sortedRepoKeys(m.activeRepos), 2)
+	case filterKindRecipe:
+		return "recipe: " + m.activeRecipe.Name
+	case filterKindMarked:
+		return "marked only"
+	case filterKindSearch:
+		return "search: " + truncateRunesHelper(m.list.FilterValue(), 20, "…")
+	}
+	switch m.currentFilter {
+	case "open", "closed", "ready":
+		return "status: " + m.currentFilter
+	}
+	if label, ok := strings.CutPrefix(m.currentFilter, "label:"); ok {
+		return "label: " + label
+	}
+	if expr, ok := strings.CutPrefix(m.currentFilter, "query:"); ok {
+		return "query: " + truncateRunesHelper(expr, 24, "…")
+	}
+	return m.currentFilter
+}
+
+// syncFilterStack records newly applied constraints on top of the stack and
+// drops the ones that were cleared
+func (m *Model) syncFilterStack() {
+	crumbs := m.filterCrumbs()
+	m.filterStack = m.filterStack[:0]
+	for _, c := range crumbs {
+		m.filterStack = append(m.filterStack, c.Kind)
+	}
+}
+
+// popFilter removes the most recently applied constraint and keeps the rest.
+// Returns false if the list is unfiltered.
+func (m *Model) popFilter() bool {
+	crumbs := m.filterCrumbs()
+	if len(crumbs) == 0 {
+		m.statusMsg = "No filters to remove"
+		m.statusIsError = false
+		return false
+	}
+	last := crumbs[len(crumbs)-1]
+
+	switch last.Kind {
+	case filterKindRepo:
+		m.activeRepos = nil
+	case filterKindRecipe:
+		m.activeRecipe = nil
+		m.currentFilter = "all"
+	case filterKindStatus:
+		m.currentFilter = "all"
+	case filterKindMarked:
+		m.markedOnly = false
+	case filterKindSearch:
+		m.list.ResetFilter()
+	}
+	if last.Kind != filterKindSearch {
+		m.applyFilter()
+	}
+	m.syncFilterStack()
+
+	m.statusMsg = fmt.Sprintf("Removed %s", last.Label)
+	if len(crumbs) == 1 {
+		m.statusMsg += " (showing all)"
+	}
+	m.statusIsError = false
+	return true
+}
+
+// filterBreadcrumb renders the active constraints, oldest first
+func (m Model) filterBreadcrumb() string {
+	crumbs := m.filterCrumbs()
+	labels := make([]string, len(crumbs))
+	for i, c := range crumbs {
+		labels[i] = c.Label
+	}
+	return strings.Join(labels, " › ")
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func filterStackTestModel(t *testing.T) Model {
+	t.Helper()
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "fs-1", Title: "Alpha", Status: model.StatusOpen, Priority: 1, CreatedAt: now},
+		{ID: "fs-2", Title: "Beta", Status: model.StatusOpen, Priority: 2, CreatedAt: now},
+		{ID: "fs-3", Title: "Gamma", Status: model.StatusClosed, Priority: 3, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	return m
+}
+
+func TestModel_PopFilters(t *testing.T) {
+	m := filterStackTestModel(t)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("o")
+	m.list.Select(m.listIndexOf("fs-2"))
+	press(" ")
+	press("M")
+	press("/")
+	for _, r := range "be" {
+		press(string(r))
+	}
+	press("enter")
+	if m.list.FilterState() != list.FilterApplied {
+		t.Fatalf("Setup: search should be applied, got %v", m.list.FilterState())
+	}
+	if got := m.filterBreadcrumb(); got != "status: open › marked only › search: be" {
+		t.Fatalf("Breadcrumb = %q", got)
+	}
+	if !strings.Contains(m.View(), "status: open › marked only") {
+		t.Error("Compound filters should be shown in the footer")
+	}
+
+	// Each backspace removes only the newest constraint
+	press("backspace")
+	if m.list.FilterState() != list.Unfiltered || m.currentFilter != "open" || !m.markedOnly {
+		t.Fatalf("First pop should clear only the search (filter=%q marked=%v)", m.currentFilter, m.markedOnly)
+	}
+	press("backspace")
+	if m.markedOnly || m.currentFilter != "open" || !strings.Contains(m.statusMsg, "marked only") {
+		t.Fatalf("Second pop should clear marked-only, got %q", m.statusMsg)
+	}
+	if n := len(m.FilteredIssues()); n != 2 {
+		t.Errorf("Expected 2 open issues, got %d", n)
+	}
+	press("backspace")
+	if m.currentFilter != "all" || !strings.Contains(m.statusMsg, "showing all") {
+		t.Fatalf("Third pop should show everything, got %q / %q", m.currentFilter, m.statusMsg)
+	}
+	press("backspace")
+	if m.statusMsg != "No filters to remove" || len(m.FilteredIssues()) != 3 {
+		t.Errorf("Popping an unfiltered list should be a no-op, got %q", m.statusMsg)
+	}
+}
+
+func TestModel_PopFiltersKeepsRecipe(t *testing.T) {
+	m := filterStackTestModel(t)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("S")
+	if m.activeRecipe == nil {
+		t.Fatal("S should apply the triage recipe")
+	}
+	m.list.Select(m.listIndexOf("fs-1"))
+	press(" ")
+	press("M")
+	if !m.recipeFilterActive() || len(m.FilteredIssues()) != 1 {
+		t.Fatalf("Marked-only should narrow the recipe, got %d issues (filter %q)", len(m.FilteredIssues()), m.currentFilter)
+	}
+	if got := m.filterBreadcrumb(); got != "recipe: triage › marked only" {
+		t.Errorf("Breadcrumb = %q", got)
+	}
+
+	press("backspace")
+	if !m.recipeFilterActive() || m.markedOnly {
+		t.Fatal("Popping marked-only should keep the recipe")
+	}
+	press("backspace")
+	if m.activeRecipe != nil || m.currentFilter != "all" {
+		t.Errorf("Popping the recipe should clear it, got %q", m.currentFilter)
+	}
+}
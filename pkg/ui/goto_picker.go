@@ -0,0 +1,221 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// gotoMaxMatches caps how many completions the goto prompt lists
+const gotoMaxMatches = 8
+
+// GotoPickerModel is the ":"/"#" prompt that opens an issue by ID, with fuzzy
+// completion over every loaded issue regardless of the active filter
+type GotoPickerModel struct {
+	issues        []model.Issue
+	matches       []model.Issue
+	input         textinput.Model
+	selectedIndex int
+	title         string
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewGotoPickerModel creates a goto prompt over issues
+func NewGotoPickerModel(issues []model.Issue, theme Theme) GotoPickerModel {
+	ti := textinput.New()
+	ti.Prompt = "# "
+	ti.Placeholder = "issue ID, e.g. bv-12 or 12"
+	ti.CharLimit = 100
+	ti.Width = 40
+	ti.Focus()
+
+	m := GotoPickerModel{
+		issues: issues,
+		input:  ti,
+		title:  "Go to Issue",
+		theme:  theme,
+	}
+	m.filterMatches()
+	return m
+}
+
+// SetTitle replaces the prompt's "Go to Issue" title
+func (m *GotoPickerModel) SetTitle(title string) {
+	m.title = title
+}
+
+// SetSize updates the prompt dimensions
+func (m *GotoPickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *GotoPickerModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *GotoPickerModel) MoveDown() {
+	if m.selectedIndex < len(m.matches)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedID returns the highlighted completion, or "" if nothing matches
+func (m *GotoPickerModel) SelectedID() string {
+	if m.selectedIndex >= len(m.matches) {
+		return ""
+	}
+	return m.matches[m.selectedIndex].ID
+}
+
+// InputValue returns the typed text
+func (m *GotoPickerModel) InputValue() string {
+	return strings.TrimSpace(m.input.Value())
+}
+
+// UpdateInput processes a key message for the text input
+func (m *GotoPickerModel) UpdateInput(msg interface{}) {
+	m.input, _ = m.input.Update(msg)
+	m.filterMatches()
+}
+
+// filterMatches ranks issue IDs against the input
+func (m *GotoPickerModel) filterMatches() {
+	m.selectedIndex = 0
+	m.matches = nil
+	query := strings.ToLower(strings.TrimPrefix(m.InputValue(), "#"))
+	if query == "" {
+		return
+	}
+
+	type scored struct {
+		issue model.Issue
+		score int
+	}
+	var ranked []scored
+	for _, issue := range m.issues {
+		if score := gotoScore(issue.ID, query); score > 0 {
+			ranked = append(ranked, scored{issue, score})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		if len(ranked[i].issue.ID) != len(ranked[j].issue.ID) {
+			return len(ranked[i].issue.ID) < len(ranked[j].issue.ID)
+		}
+		return ranked[i].issue.ID < ranked[j].issue.ID
+	})
+	for i := 0; i < len(ranked) && i < gotoMaxMatches; i++ {
+		m.matches = append(m.matches, ranked[i].issue)
+	}
+}
+
+// gotoScore scores an issue ID against the query (0 = no match). A bare
+// number matches the ID's numeric suffix, so "12" finds bv-12 before bv-120.
+func gotoScore(id, query string) int {
+	lower := strings.ToLower(id)
+	if lower == query {
+		return 2000
+	}
+	if strings.HasSuffix(lower, "-"+query) {
+		return 1500
+	}
+	return fuzzyScore(lower, query)
+}
+
+// View renders the goto prompt
+func (m *GotoPickerModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 64
+	if m.width < 74 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render(m.title))
+	lines = append(lines, "")
+
+	inputStyle := t.Renderer.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(boxWidth - 6)
+	lines = append(lines, inputStyle.Render(m.input.View()))
+	lines = append(lines, "")
+
+	dimStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	if m.InputValue() != "" && len(m.matches) == 0 {
+		lines = append(lines, dimStyle.Render("  No matching issues"))
+	}
+
+	for i, issue := range m.matches {
+		isSelected := i == m.selectedIndex
+
+		itemStyle := t.Renderer.NewStyle()
+		if isSelected {
+			itemStyle = itemStyle.Foreground(t.Primary).Bold(true)
+		} else {
+			itemStyle = itemStyle.Foreground(t.Base.GetForeground())
+		}
+		prefix := "  "
+		if isSelected {
+			prefix = "> "
+		}
+
+		idPart := prefix + GetStatusIcon(string(issue.Status)) + " " + issue.ID + "  "
+		title := truncateRunesHelper(issue.Title, boxWidth-8-lipgloss.Width(idPart), "…")
+		lines = append(lines, itemStyle.Render(idPart)+dimStyle.Render(title))
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("tab/↑↓: choose | enter: open | esc: cancel"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func gotoTestIssues() []model.Issue {
+	now := time.Now()
+	return []model.Issue{
+		{ID: "bv-120", Title: "Hundred twenty", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "bv-12", Title: "Twelve", Status: model.StatusClosed, CreatedAt: now},
+		{ID: "bv-2", Title: "Two", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "api-7", Title: "Seven", Status: model.StatusOpen, CreatedAt: now},
+	}
+}
+
+func gotoMatchIDs(m GotoPickerModel) string {
+	var ids []string
+	for _, issue := range m.matches {
+		ids = append(ids, issue.ID)
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestGotoPicker_Matches(t *testing.T) {
+	m := NewGotoPickerModel(gotoTestIssues(), DefaultTheme(nil))
+	for query, want := range map[string]string{
+		"":       "",
+		"12":     "bv-12 bv-120",
+		"#12":    "bv-12 bv-120",
+		"BV-2":   "bv-2 bv-12 bv-120",
+		"api":    "api-7",
+		"a7":     "api-7",
+		"zzz":    "",
+		"bv-120": "bv-120",
+	} {
+		m.input.SetValue(query)
+		m.filterMatches()
+		if got := gotoMatchIDs(m); got != want {
+			t.Errorf("%q: got %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestModel_Goto(t *testing.T) {
+	m := NewModel(gotoTestIssues(), nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	typeText := func(text string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+		m = updated.(Model)
+	}
+
+	// Hide bv-12 behind the open filter and switch to the board
+	press("o")
+	press("b")
+	if m.listIndexOf("bv-12") >= 0 || !m.isBoardView {
+		t.Fatal("Setup: bv-12 should be filtered out on the board")
+	}
+
+	press(":")
+	if !m.showGoto || m.focused != focusGoto {
+		t.Fatal(": should open the goto prompt")
+	}
+	typeText("12")
+	if !strings.Contains(m.View(), "Twelve") {
+		t.Error("Completions should list matching titles")
+	}
+	press("enter")
+	if m.showGoto || m.isBoardView || m.focused != focusDetail || selectedID(m) != "bv-12" {
+		t.Fatalf("enter should open bv-12, got %q (board=%v)", selectedID(m), m.isBoardView)
+	}
+	if m.currentFilter != "all" {
+		t.Errorf("Filter hiding the issue should be cleared, got %q", m.currentFilter)
+	}
+
+	// Tab picks the next completion; typed letters never trigger shortcuts
+	press("esc")
+	press("#")
+	typeText("bv-")
+	press("tab")
+	if m.gotoPicker.SelectedID() != "bv-12" {
+		t.Errorf("tab should move to the second match, got %s", m.gotoPicker.SelectedID())
+	}
+	press("esc")
+	if m.showGoto || m.focused != focusList {
+		t.Error("esc should close the prompt and restore focus")
+	}
+
+	// Unknown IDs report an error
+	press("#")
+	typeText("nope")
+	press("enter")
+	if m.showGoto || !m.statusIsError || !strings.Contains(m.statusMsg, "No issue nope") {
+		t.Errorf("Expected an error for an unknown ID, got %q", m.statusMsg)
+	}
+}
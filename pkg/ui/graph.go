@@ -25,6 +25,7 @@ type GraphModel struct {
 	// Precomputed graph relationships
 	blockers   map[string][]string // What each issue depends on (blocks this issue)
 	dependents map[string][]string // What depends on each issue (this issue blocks)
+	links      map[string][]string // Non-blocking relations (related, parent-child, ...), both ways
 
 	// Flat list for navigation
 	sortedIDs []string
@@ -38,14 +39,47 @@ type GraphModel struct {
 	rankCriticalPath map[string]int
 	rankInDegree     map[string]int
 	rankOutDegree    map[string]int
+
+	// Cluster mode: nodes grouped and colored by workstream
+	showClusters bool
+	clusters     analysis.ClusterResult
+
+	// Workspace mode: edges between repos are drawn dashed
+	workspaceMode bool
+
+	// Filters for large graphs: focus mode keeps only the nodes within
+	// focusDepth hops of focusRoot; closed issues and non-blocking links
+	// can be hidden
+	focusRoot  string
+	focusDepth int
+	hideClosed bool
+	hideLinks  bool
+
+	// Layout: the ego view, or a map of the whole graph drawn by a layout
+	// engine, scrolled to mapX/mapY. Layouts are cached by graph hash.
+	layout      GraphLayout
+	layoutCache map[string]*graphCanvas
+	mapX, mapY  int
+	mapCentered string // Node the map last scrolled to
+	mapRoot     string // Radial layout's center when focus mode is off
 }
 
+// Focus mode neighborhood sizes, in hops
+const (
+	defaultFocusDepth = 2
+	maxFocusDepth     = 6
+)
+
+// mapScrollStep is how many columns H/L pan a map layout
+const mapScrollStep = 20
+
 // NewGraphModel creates a new graph view from issues
 func NewGraphModel(issues []model.Issue, insights *analysis.Insights, theme Theme) GraphModel {
 	g := GraphModel{
-		issues:   issues,
-		insights: insights,
-		theme:    theme,
+		issues:     issues,
+		insights:   insights,
+		theme:      theme,
+		focusDepth: defaultFocusDepth,
 	}
 	g.rebuildGraph()
 	return g
@@ -89,21 +123,50 @@ func (g *GraphModel) rebuildGraph() {
 	g.issueMap = make(map[string]*model.Issue)
 	g.blockers = make(map[string][]string)
 	g.dependents = make(map[string][]string)
+	g.links = make(map[string][]string)
 	g.sortedIDs = nil
 
 	for i := range g.issues {
 		issue := &g.issues[i]
 		g.issueMap[issue.ID] = issue
-		g.sortedIDs = append(g.sortedIDs, issue.ID)
+		if !g.hidden(issue.ID) {
+			g.sortedIDs = append(g.sortedIDs, issue.ID)
+		}
 	}
 
-	// Build relationships
+	// Build relationships, leaving out edges to hidden nodes
 	for _, issue := range g.issues {
+		if g.hidden(issue.ID) {
+			continue
+		}
 		for _, dep := range issue.Dependencies {
-			if dep != nil && dep.Type.IsBlocking() {
+			if dep == nil || g.hidden(dep.DependsOnID) {
+				continue
+			}
+			switch {
+			case dep.Type.IsBlocking():
 				g.blockers[issue.ID] = append(g.blockers[issue.ID], dep.DependsOnID)
 				g.dependents[dep.DependsOnID] = append(g.dependents[dep.DependsOnID], issue.ID)
+			case !g.hideLinks:
+				g.links[issue.ID] = append(g.links[issue.ID], dep.DependsOnID)
+				g.links[dep.DependsOnID] = append(g.links[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+
+	// Focus mode: keep the root's neighborhood; a hidden root ends it
+	if g.focusRoot != "" {
+		if g.issueMap[g.focusRoot] == nil || g.hidden(g.focusRoot) {
+			g.focusRoot = ""
+		} else {
+			near := g.neighborhood(g.focusRoot, g.focusDepth)
+			kept := g.sortedIDs[:0]
+			for _, id := range g.sortedIDs {
+				if _, ok := near[id]; ok {
+					kept = append(kept, id)
+				}
 			}
+			g.sortedIDs = kept
 		}
 	}
 
@@ -124,11 +187,50 @@ func (g *GraphModel) rebuildGraph() {
 		sort.Strings(g.sortedIDs)
 	}
 
+	if g.showClusters {
+		g.clusters = analysis.DetectClusters(g.issues)
+		sort.SliceStable(g.sortedIDs, func(i, j int) bool {
+			return g.clusters.Membership[g.sortedIDs[i]] < g.clusters.Membership[g.sortedIDs[j]]
+		})
+	}
+
 	if g.selectedIdx >= len(g.sortedIDs) {
 		g.selectedIdx = 0
 	}
 }
 
+// hidden reports whether a node is filtered out: a closed issue while
+// closed issues are hidden
+func (g *GraphModel) hidden(id string) bool {
+	if !g.hideClosed {
+		return false
+	}
+	issue := g.issueMap[id]
+	return issue != nil && issue.Status.IsClosed()
+}
+
+// neighborhood returns the nodes within depth hops of root, following
+// edges either way, with their distance from root
+func (g *GraphModel) neighborhood(root string, depth int) map[string]int {
+	dist := map[string]int{root: 0}
+	frontier := []string{root}
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		var next []string
+		for _, id := range frontier {
+			for _, edges := range [][]string{g.blockers[id], g.dependents[id], g.links[id]} {
+				for _, other := range edges {
+					if _, seen := dist[other]; !seen {
+						dist[other] = hop
+						next = append(next, other)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	return dist
+}
+
 // computeRankings precomputes rankings for all metrics
 func (g *GraphModel) computeRankings() {
 	g.rankPageRank = make(map[string]int)
@@ -239,8 +341,18 @@ func (g *GraphModel) PageDown() {
 	g.ensureVisible()
 }
 
-func (g *GraphModel) ScrollLeft()  {}
-func (g *GraphModel) ScrollRight() {}
+// ScrollLeft pans the map layouts left; the ego view doesn't scroll
+func (g *GraphModel) ScrollLeft() {
+	g.mapX = max(0, g.mapX-mapScrollStep)
+}
+
+// ScrollRight pans the map layouts right, up to the canvas edge on the
+// next render
+func (g *GraphModel) ScrollRight() {
+	if g.Layout() != LayoutEgo {
+		g.mapX += mapScrollStep
+	}
+}
 
 func (g *GraphModel) ensureVisible() {}
 
@@ -252,10 +364,158 @@ func (g *GraphModel) SelectedIssue() *model.Issue {
 	return g.issueMap[id]
 }
 
+// SelectIssueByID selects id, returning false if the graph doesn't show it
+func (g *GraphModel) SelectIssueByID(id string) bool {
+	for i, sortedID := range g.sortedIDs {
+		if sortedID == id {
+			g.selectedIdx = i
+			g.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// ShownIssues returns the issues the graph shows, in node list order
+func (g *GraphModel) ShownIssues() []model.Issue {
+	issues := make([]model.Issue, 0, len(g.sortedIDs))
+	for _, id := range g.sortedIDs {
+		issues = append(issues, *g.issueMap[id])
+	}
+	return issues
+}
+
 func (g *GraphModel) TotalCount() int {
 	return len(g.sortedIDs)
 }
 
+// ToggleClusters groups and colors the nodes by cluster, keeping the selection
+func (g *GraphModel) ToggleClusters() {
+	selected := g.SelectedIssue()
+	g.showClusters = !g.showClusters
+	g.rebuildGraph()
+	if selected != nil {
+		g.SelectIssueByID(selected.ID)
+	}
+}
+
+// ClustersShown reports whether cluster mode is on
+func (g *GraphModel) ClustersShown() bool {
+	return g.showClusters
+}
+
+// rebuildKeepingSelection rebuilds the graph after a filter changes,
+// selecting the same issue if it is still shown
+func (g *GraphModel) rebuildKeepingSelection() {
+	selected := g.SelectedIssue()
+	g.rebuildGraph()
+	if selected == nil || !g.SelectIssueByID(selected.ID) {
+		g.selectedIdx = 0
+	}
+}
+
+// ToggleFocus shows only the selected node's neighborhood. On another node
+// it moves the focus there; on the focused node it shows the whole graph.
+func (g *GraphModel) ToggleFocus() {
+	selected := g.SelectedIssue()
+	switch {
+	case selected == nil:
+		return
+	case g.focusRoot == selected.ID:
+		g.focusRoot = ""
+	default:
+		g.focusRoot = selected.ID
+	}
+	g.rebuildKeepingSelection()
+}
+
+// FocusRoot returns the node focus mode is centered on, "" when it is off
+func (g *GraphModel) FocusRoot() string {
+	return g.focusRoot
+}
+
+// FocusDepth returns how many hops focus mode reaches
+func (g *GraphModel) FocusDepth() int {
+	return g.focusDepth
+}
+
+// ChangeFocusDepth widens or narrows focus mode by delta hops, within 1 to
+// maxFocusDepth
+func (g *GraphModel) ChangeFocusDepth(delta int) {
+	g.focusDepth = min(max(g.focusDepth+delta, 1), maxFocusDepth)
+	if g.focusRoot != "" {
+		g.rebuildKeepingSelection()
+	}
+}
+
+// ToggleHideClosed hides or shows closed issues, returning whether they
+// are now hidden
+func (g *GraphModel) ToggleHideClosed() bool {
+	g.hideClosed = !g.hideClosed
+	g.rebuildKeepingSelection()
+	return g.hideClosed
+}
+
+// ToggleHideLinks hides or shows non-blocking edges (related,
+// parent-child, discovered-from), returning whether they are now hidden
+func (g *GraphModel) ToggleHideLinks() bool {
+	g.hideLinks = !g.hideLinks
+	g.rebuildKeepingSelection()
+	return g.hideLinks
+}
+
+// FilterSummary describes the active filters, or "" when the whole graph
+// is shown
+func (g *GraphModel) FilterSummary() string {
+	var parts []string
+	if g.focusRoot != "" {
+		parts = append(parts, fmt.Sprintf("focus %s ±%d", g.focusRoot, g.focusDepth))
+	}
+	if g.hideClosed {
+		parts = append(parts, "closed hidden")
+	}
+	if g.hideLinks {
+		parts = append(parts, "blocking edges only")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d of %d nodes · %s", len(g.sortedIDs), len(g.issues), strings.Join(parts, " · "))
+}
+
+// SetWorkspaceMode marks the graph as spanning repos, so edges between
+// issues from different repos are drawn distinctly
+func (g *GraphModel) SetWorkspaceMode(enabled bool) {
+	g.workspaceMode = enabled
+}
+
+// crossesRepo reports whether an edge between a and b spans two repos
+func (g *GraphModel) crossesRepo(a, b string) bool {
+	if !g.workspaceMode {
+		return false
+	}
+	pa, pb := ExtractRepoPrefix(a), ExtractRepoPrefix(b)
+	return pa != "" && pb != "" && !strings.EqualFold(pa, pb)
+}
+
+// crossRepoCount counts the ids whose edge to id spans repos
+func (g *GraphModel) crossRepoCount(id string, ids []string) int {
+	n := 0
+	for _, other := range ids {
+		if g.crossesRepo(id, other) {
+			n++
+		}
+	}
+	return n
+}
+
+// crossRepoBorder is the dashed border of nodes in another repo than the
+// selected issue
+var crossRepoBorder = lipgloss.Border{
+	Top: "╌", Bottom: "╌", Left: "╎", Right: "╎",
+	TopLeft: "╭", TopRight: "╮", BottomLeft: "╰", BottomRight: "╯",
+}
+
 // View renders the visual graph view
 func (g *GraphModel) View(width, height int) string {
 	g.width = width
@@ -276,6 +536,9 @@ func (g *GraphModel) View(width, height int) string {
 	if selectedIssue == nil {
 		return "Error: selected issue not found"
 	}
+	if g.Layout() != LayoutEgo {
+		return g.renderMapView(width, height, t)
+	}
 
 	// Layout: Left panel (node list) | Right panel (visual graph + metrics)
 	listWidth := 28
@@ -315,7 +578,11 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 		Bold(true).
 		Foreground(t.Primary).
 		Width(width)
-	lines = append(lines, headerStyle.Render(fmt.Sprintf("📊 Nodes (%d)", len(g.sortedIDs))))
+	header := fmt.Sprintf("📊 Nodes (%d)", len(g.sortedIDs))
+	if len(g.sortedIDs) < len(g.issues) {
+		header = fmt.Sprintf("📊 Nodes (%d/%d)", len(g.sortedIDs), len(g.issues))
+	}
+	lines = append(lines, headerStyle.Render(header))
 	lines = append(lines, strings.Repeat("─", width))
 
 	visibleItems := height - 4
@@ -346,6 +613,11 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 		isSelected := i == g.selectedIdx
 		statusIcon := getStatusIcon(issue.Status)
 		maxIDLen := width - 4
+		marker := ""
+		if g.showClusters {
+			maxIDLen -= 2
+			marker = t.Renderer.NewStyle().Foreground(g.clusterColor(id, t)).Render("●") + " "
+		}
 		displayID := smartTruncateID(id, maxIDLen)
 		line := fmt.Sprintf("%s %s", statusIcon, displayID)
 
@@ -361,7 +633,7 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 				Foreground(getStatusColor(issue.Status, t)).
 				Width(width)
 		}
-		lines = append(lines, style.Render(line))
+		lines = append(lines, marker+style.Width(width-lipgloss.Width(marker)).Render(line))
 	}
 
 	if len(g.sortedIDs) > visibleItems {
@@ -407,19 +679,45 @@ func (g *GraphModel) renderVisualGraph(id string, issue *model.Issue, width, hei
 		sections = append(sections, g.renderDependentsVisual(dependentIDs, width, t))
 	}
 
+	// ═══════════════════════════════════════════════════════════════════════
+	// LINKED SECTION (related, parent-child and other non-blocking edges)
+	// ═══════════════════════════════════════════════════════════════════════
+	if linkIDs := g.links[id]; len(linkIDs) > 0 {
+		linkHeader := t.Renderer.NewStyle().
+			Foreground(t.Secondary).
+			Width(width).
+			Align(lipgloss.Center).
+			Render("┄ LINKED (related, parent/child; not blocking) ┄")
+		sections = append(sections, "", linkHeader, g.renderNodeRow(linkIDs, width, t))
+	}
+
 	sections = append(sections, "")
 
 	// ═══════════════════════════════════════════════════════════════════════
 	// COMPREHENSIVE METRICS PANEL - ALL 8 metrics with values AND ranks
 	// ═══════════════════════════════════════════════════════════════════════
-	sections = append(sections, g.renderMetricsPanel(id, width, t))
+	hint := "j/k: navigate • enter: view details • g: back to list"
+	if g.crossRepoCount(id, blockerIDs)+g.crossRepoCount(id, dependentIDs) > 0 {
+		hint = "╎ dashed: another repo • " + hint
+	}
+	if g.showClusters {
+		sections = append(sections, g.renderClusterPanel(id, width, t))
+		hint = strings.Replace(hint, "g: back to list", "c: hide clusters • g: back to list", 1)
+	} else {
+		sections = append(sections, g.renderMetricsPanel(id, width, t))
+	}
 
 	// Navigation hint
 	navStyle := t.Renderer.NewStyle().
 		Foreground(t.Secondary).
 		Italic(true)
 	sections = append(sections, "")
-	sections = append(sections, navStyle.Render("j/k: navigate • enter: view details • g: back to list"))
+	if summary := g.FilterSummary(); summary != "" {
+		filterStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+		sections = append(sections, filterStyle.Render("🎯 "+summary))
+		hint = "f: focus here/off • +/-: hops • x: closed • e: links • " + hint
+	}
+	sections = append(sections, navStyle.Render(hint))
 
 	return strings.Join(sections, "\n")
 }
@@ -434,53 +732,30 @@ func (g *GraphModel) renderBlockersVisual(blockerIDs []string, width int, t Them
 
 	header := headerStyle.Render("▲ BLOCKED BY (must complete first) ▲")
 
-	// Calculate box width based on available space and number of blockers
-	maxBoxes := 5
-	if len(blockerIDs) < maxBoxes {
-		maxBoxes = len(blockerIDs)
-	}
-	if maxBoxes < 1 {
-		maxBoxes = 1
-	}
-	boxWidth := (width - 4) / maxBoxes
-	if boxWidth > 20 {
-		boxWidth = 20
-	}
-	if boxWidth < 12 {
-		boxWidth = 12
-	}
-	// Ensure boxWidth doesn't exceed available space (narrow terminals)
-	if boxWidth > width-2 {
-		boxWidth = width - 2
-	}
-	if boxWidth < 8 {
-		boxWidth = 8
-	}
+	return header + "\n" + g.renderNodeRow(blockerIDs, width, t)
+}
 
-	var boxes []string
-	for i, bid := range blockerIDs {
-		if i >= 5 {
-			remaining := len(blockerIDs) - 5
-			boxes = append(boxes, t.Renderer.NewStyle().
-				Foreground(t.Secondary).
-				Italic(true).
-				Render(fmt.Sprintf("+%d more", remaining)))
-			break
-		}
-		boxes = append(boxes, g.renderNodeBox(bid, boxWidth, t, false))
-	}
+// renderDependentsVisual renders dependent nodes as boxes
+func (g *GraphModel) renderDependentsVisual(dependentIDs []string, width int, t Theme) string {
+	centered := g.renderNodeRow(dependentIDs, width, t)
 
-	boxRow := lipgloss.JoinHorizontal(lipgloss.Center, boxes...)
-	centered := t.Renderer.NewStyle().Width(width).Align(lipgloss.Center).Render(boxRow)
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Feature).
+		Width(width).
+		Align(lipgloss.Center)
 
-	return header + "\n" + centered
+	header := headerStyle.Render("▼ BLOCKS (waiting on this) ▼")
+
+	return centered + "\n" + header
 }
 
-// renderDependentsVisual renders dependent nodes as boxes
-func (g *GraphModel) renderDependentsVisual(dependentIDs []string, width int, t Theme) string {
+// renderNodeRow renders up to five nodes as a centered row of boxes
+func (g *GraphModel) renderNodeRow(ids []string, width int, t Theme) string {
+	// Calculate box width based on available space and number of nodes
 	maxBoxes := 5
-	if len(dependentIDs) < maxBoxes {
-		maxBoxes = len(dependentIDs)
+	if len(ids) < maxBoxes {
+		maxBoxes = len(ids)
 	}
 	if maxBoxes < 1 {
 		maxBoxes = 1
@@ -501,30 +776,20 @@ func (g *GraphModel) renderDependentsVisual(dependentIDs []string, width int, t
 	}
 
 	var boxes []string
-	for i, did := range dependentIDs {
+	for i, id := range ids {
 		if i >= 5 {
-			remaining := len(dependentIDs) - 5
+			remaining := len(ids) - 5
 			boxes = append(boxes, t.Renderer.NewStyle().
 				Foreground(t.Secondary).
 				Italic(true).
 				Render(fmt.Sprintf("+%d more", remaining)))
 			break
 		}
-		boxes = append(boxes, g.renderNodeBox(did, boxWidth, t, false))
+		boxes = append(boxes, g.renderNodeBox(id, boxWidth, t, false))
 	}
 
 	boxRow := lipgloss.JoinHorizontal(lipgloss.Center, boxes...)
-	centered := t.Renderer.NewStyle().Width(width).Align(lipgloss.Center).Render(boxRow)
-
-	headerStyle := t.Renderer.NewStyle().
-		Bold(true).
-		Foreground(t.Feature).
-		Width(width).
-		Align(lipgloss.Center)
-
-	header := headerStyle.Render("▼ BLOCKS (waiting on this) ▼")
-
-	return centered + "\n" + header
+	return t.Renderer.NewStyle().Width(width).Align(lipgloss.Center).Render(boxRow)
 }
 
 // renderNodeBox renders a single node as an ASCII box
@@ -563,9 +828,17 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 			Align(lipgloss.Center).
 			Padding(0, 1)
 	} else {
+		borderColor := statusColor
+		if g.showClusters && issue != nil {
+			borderColor = g.clusterColor(id, t)
+		}
+		border := lipgloss.RoundedBorder()
+		if len(g.sortedIDs) > 0 && g.crossesRepo(g.sortedIDs[g.selectedIdx], id) {
+			border = crossRepoBorder
+		}
 		boxStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(statusColor).
+			Border(border).
+			BorderForeground(borderColor).
 			Foreground(statusColor).
 			Width(boxWidth).
 			Align(lipgloss.Center).
@@ -617,6 +890,12 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	blockerCount := len(g.blockers[id])
 	dependentCount := len(g.dependents[id])
 	content += fmt.Sprintf("\n⬆%d  ⬇%d", blockerCount, dependentCount)
+	if n := len(g.links[id]); n > 0 {
+		content += fmt.Sprintf("  ┄%d linked", n)
+	}
+	if n := g.crossRepoCount(id, g.blockers[id]) + g.crossRepoCount(id, g.dependents[id]); n > 0 {
+		content += fmt.Sprintf("  ⇄%d cross-repo", n)
+	}
 
 	egoStyle := t.Renderer.NewStyle().
 		Border(lipgloss.DoubleBorder()).
@@ -844,6 +1123,89 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 	return strings.Join(rows, "\n")
 }
 
+// renderClusterPanel summarizes the clusters, marking the selected issue's
+func (g *GraphModel) renderClusterPanel(id string, width int, t Theme) string {
+	panelHeaderStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(ColorText).
+		Background(ColorPrimary).
+		Padding(0, 2).
+		Width(width - 4)
+	mutedStyle := t.Renderer.NewStyle().Foreground(ColorMuted).Italic(true)
+
+	var linked []analysis.Cluster
+	unlinked := 0
+	for _, c := range g.clusters.Clusters {
+		if c.Size() > 1 {
+			linked = append(linked, c)
+		} else {
+			unlinked++
+		}
+	}
+
+	rows := []string{
+		panelHeaderStyle.Render(fmt.Sprintf("🧩 CLUSTERS (%d)", len(linked))),
+		RenderDivider(width - 4),
+	}
+	if len(linked) == 0 {
+		rows = append(rows, mutedStyle.Padding(0, 2).Render("No linked issues to group"))
+		return strings.Join(rows, "\n")
+	}
+
+	current := g.clusters.Membership[id]
+	c := g.clusters.Clusters[current]
+	if c.Size() > 1 {
+		rows = append(rows, t.Renderer.NewStyle().Foreground(ColorSecondary).Padding(0, 1).Render(
+			fmt.Sprintf("This issue: cluster #%d · %d links inside, %d to other clusters", current+1, c.InternalEdges, c.ExternalEdges)))
+	} else {
+		rows = append(rows, t.Renderer.NewStyle().Foreground(ColorSecondary).Padding(0, 1).Render("This issue has no blocking links"))
+	}
+	rows = append(rows, "")
+
+	shown := min(len(linked), 10)
+	for _, c := range linked[:shown] {
+		cursor := "  "
+		style := t.Renderer.NewStyle().Foreground(ColorText)
+		if c.ID == current {
+			cursor = "▸ "
+			style = style.Bold(true)
+		}
+		label := c.DominantLabel
+		if label == "" {
+			label = "(no labels)"
+		}
+		dot := t.Renderer.NewStyle().Foreground(clusterPalette(c.ID, t)).Render("●")
+		line := fmt.Sprintf("#%-3d %4d issues  %3.0f%% blocked  %s", c.ID+1, c.Size(), c.BlockedPct, label)
+		rows = append(rows, cursor+dot+" "+style.Render(truncateRunesHelper(line, width-10, "…")))
+	}
+	if more := len(linked) - shown; more > 0 {
+		rows = append(rows, mutedStyle.Render(fmt.Sprintf("    + %d smaller clusters", more)))
+	}
+	if unlinked > 0 {
+		rows = append(rows, mutedStyle.Render(fmt.Sprintf("    + %d unlinked issues", unlinked)))
+	}
+	rows = append(rows, "")
+	rows = append(rows, mutedStyle.Width(width-4).Render(
+		fmt.Sprintf("Modularity %.2f │ blocked = open issues waiting on an open blocker", g.clusters.Modularity)))
+
+	return strings.Join(rows, "\n")
+}
+
+// clusterColor returns the color of an issue's cluster; unlinked issues are muted
+func (g *GraphModel) clusterColor(id string, t Theme) lipgloss.AdaptiveColor {
+	c, ok := g.clusters.Membership[id]
+	if !ok || g.clusters.Clusters[c].Size() < 2 {
+		return t.Muted
+	}
+	return clusterPalette(c, t)
+}
+
+// clusterPalette cycles through the theme's accent colors
+func clusterPalette(cluster int, t Theme) lipgloss.AdaptiveColor {
+	colors := []lipgloss.AdaptiveColor{t.Primary, t.Open, t.InProgress, t.Feature, t.Epic, t.Bug, t.Task, t.Chore}
+	return colors[cluster%len(colors)]
+}
+
 // Helper functions
 
 func getStatusIcon(status model.Status) string {
@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// filterTestIssues is a chain a <- b <- c <- d <- e of blocking deps, plus
+// a closed blocker of c and an issue related to a
+func filterTestIssues() []model.Issue {
+	blocks := func(id, on string) *model.Dependency {
+		return &model.Dependency{IssueID: id, DependsOnID: on, Type: model.DepBlocks}
+	}
+	return []model.Issue{
+		{ID: "a", Title: "A", Status: model.StatusOpen},
+		{ID: "b", Title: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("b", "a")}},
+		{ID: "c", Title: "C", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("c", "b"), blocks("c", "done")}},
+		{ID: "d", Title: "D", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("d", "c")}},
+		{ID: "e", Title: "E", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("e", "d")}},
+		{ID: "done", Title: "Done", Status: model.StatusClosed},
+		{ID: "rel", Title: "Related", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "rel", DependsOnID: "a", Type: model.DepRelated},
+		}},
+	}
+}
+
+func TestGraphFocusMode(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	if !g.SelectIssueByID("c") {
+		t.Fatal("c should be in the graph")
+	}
+
+	g.ToggleFocus()
+	if g.FocusRoot() != "c" || g.TotalCount() != 6 {
+		t.Fatalf("two hops from c should keep a, b, c, d, e and done, got %v", g.sortedIDs)
+	}
+	for _, id := range []string{"a", "b", "d", "e", "done"} {
+		if !g.SelectIssueByID(id) {
+			t.Errorf("%s is within 2 hops of c", id)
+		}
+	}
+	if g.SelectIssueByID("rel") {
+		t.Error("rel is 3 hops from c and should be hidden")
+	}
+
+	g.ChangeFocusDepth(-1)
+	if g.SelectIssueByID("a") || !g.SelectIssueByID("b") {
+		t.Error("one hop from c keeps b but not a")
+	}
+	g.ChangeFocusDepth(2)
+	if !g.SelectIssueByID("rel") {
+		t.Error("three hops from c reach rel through its related link")
+	}
+
+	// Focusing on another node moves the focus; on the root it ends
+	g.ToggleFocus()
+	if g.FocusRoot() != "rel" {
+		t.Errorf("focus root = %q, want rel", g.FocusRoot())
+	}
+	g.ToggleFocus()
+	if g.FocusRoot() != "" || g.TotalCount() != 7 {
+		t.Errorf("focus should be off with all 7 nodes, got %q and %d", g.FocusRoot(), g.TotalCount())
+	}
+	if got := g.SelectedIssue(); got == nil || got.ID != "rel" {
+		t.Errorf("leaving focus mode should keep the selection, got %v", got)
+	}
+}
+
+func TestGraphHideClosedAndLinks(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+
+	if len(g.blockers["c"]) != 2 || len(g.links["a"]) != 1 {
+		t.Fatalf("c should have 2 blockers and a one link, got %v and %v", g.blockers["c"], g.links["a"])
+	}
+	if !g.ToggleHideClosed() || g.TotalCount() != 6 || len(g.blockers["c"]) != 1 {
+		t.Errorf("hiding closed should drop done and its edge: %d nodes, c blockers %v", g.TotalCount(), g.blockers["c"])
+	}
+	if !g.ToggleHideLinks() || len(g.links["a"]) != 0 {
+		t.Errorf("hiding links should drop the related edge, got %v", g.links["a"])
+	}
+	if got := g.FilterSummary(); got != "6 of 7 nodes · closed hidden · blocking edges only" {
+		t.Errorf("summary = %q", got)
+	}
+
+	g.ToggleHideClosed()
+	g.ToggleHideLinks()
+	if g.FilterSummary() != "" || g.TotalCount() != 7 {
+		t.Errorf("filters off should show everything, got %q", g.FilterSummary())
+	}
+}
+
+func TestGraphView_LinkedSectionAndFilterLine(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SelectIssueByID("a")
+	out := g.View(78, 60)
+	if !strings.Contains(out, "LINKED") || !strings.Contains(out, "┄1 linked") {
+		t.Errorf("a's related link should be drawn:\n%s", out)
+	}
+
+	g.ToggleFocus()
+	out = g.View(120, 60)
+	if !strings.Contains(out, "focus a ±2") || !strings.Contains(out, "Nodes (4/7)") {
+		t.Errorf("focus mode should show its summary and the node count:\n%s", out)
+	}
+}
+
+func TestModel_GraphFilterKeys(t *testing.T) {
+	m := NewModel(filterTestIssues(), nil, "")
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("g")
+	if !m.isGraphView {
+		t.Fatal("g should open the graph")
+	}
+	press("x")
+	if !strings.Contains(m.statusMsg, "closed hidden") {
+		t.Errorf("x should hide closed issues, status %q", m.statusMsg)
+	}
+	press("f")
+	if m.graphView.FocusRoot() == "" || !strings.Contains(m.statusMsg, "focus") {
+		t.Errorf("f should focus on the selected node, status %q", m.statusMsg)
+	}
+	press("+")
+	if m.graphView.FocusDepth() != 3 {
+		t.Errorf("+ should widen focus to 3 hops, got %d", m.graphView.FocusDepth())
+	}
+	press("e")
+	if !strings.Contains(m.statusMsg, "blocking edges only") {
+		t.Errorf("e should hide non-blocking edges, status %q", m.statusMsg)
+	}
+}
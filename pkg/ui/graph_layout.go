@@ -0,0 +1,818 @@
+package ui
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// GraphLayout selects how the graph view arranges its nodes
+type GraphLayout string
+
+const (
+	LayoutEgo     GraphLayout = "ego"     // Selected node between its blockers and dependents
+	LayoutLayered GraphLayout = "layered" // Sugiyama-style layers, blockers above dependents
+	LayoutRadial  GraphLayout = "radial"  // Rings of hops around a root
+	LayoutForce   GraphLayout = "force"   // Force-directed
+)
+
+// graphLayouts is the order o cycles through
+var graphLayouts = []GraphLayout{LayoutEgo, LayoutLayered, LayoutRadial, LayoutForce}
+
+const (
+	// layerWrap is the most nodes a layer puts side by side before it
+	// continues on another row, so wide layers don't make wide canvases
+	layerWrap = 8
+	// maxLayoutCache bounds the layouts kept; the cache is dropped when full
+	maxLayoutCache = 16
+	// forceIterations is the most force-directed steps, fewer on big graphs
+	forceIterations = 120
+	// layoutIDLen is the longest ID a map node shows
+	layoutIDLen = 14
+)
+
+// canvasPoint is a position on a layout's slot grid or character canvas
+type canvasPoint struct {
+	x, y int
+}
+
+// Line directions of a canvas cell, combined into box-drawing glyphs
+const (
+	lineUp = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+var solidGlyphs = map[int]rune{
+	lineUp: '│', lineDown: '│', lineUp | lineDown: '│',
+	lineLeft: '─', lineRight: '─', lineLeft | lineRight: '─',
+	lineDown | lineRight: '╭', lineDown | lineLeft: '╮',
+	lineUp | lineRight: '╰', lineUp | lineLeft: '╯',
+	lineUp | lineDown | lineRight: '├', lineUp | lineDown | lineLeft: '┤',
+	lineDown | lineLeft | lineRight: '┬', lineUp | lineLeft | lineRight: '┴',
+	lineUp | lineDown | lineLeft | lineRight: '┼',
+}
+
+// graphCanvas is a rasterized layout: the nodes' positions and the
+// characters of their labels and edges
+type graphCanvas struct {
+	layout GraphLayout
+	root   string // Radial layout's center
+	ids    []string
+	pos    map[string]canvasPoint // Left end of each node's label
+	labels map[string]string
+	bands  int // Layers (layered) or rings (radial)
+
+	width, height int
+	cells         []rune
+	owner         []int32 // Index into ids of the node drawn in a cell, -1 for none
+	dotted        []bool  // Cell belongs to a non-blocking link
+}
+
+func (c *graphCanvas) at(x, y int) int { return y*c.width + x }
+
+// layoutEdge is a blocking edge from a blocker to its dependent, or a
+// non-blocking link
+type graphLayoutEdge struct {
+	from, to string
+	link     bool
+}
+
+// CycleLayout switches to the next layout, returning it
+func (g *GraphModel) CycleLayout() GraphLayout {
+	next := LayoutEgo
+	for i, l := range graphLayouts {
+		if l == g.Layout() {
+			next = graphLayouts[(i+1)%len(graphLayouts)]
+		}
+	}
+	g.SetLayout(next)
+	return next
+}
+
+// SetLayout switches to layout, "" meaning the ego view
+func (g *GraphModel) SetLayout(layout GraphLayout) {
+	if layout == "" {
+		layout = LayoutEgo
+	}
+	g.layout = layout
+	g.mapX, g.mapY = 0, 0
+	g.mapCentered = ""
+	g.mapRoot = ""
+	if issue := g.SelectedIssue(); issue != nil && layout == LayoutRadial {
+		g.mapRoot = issue.ID
+	}
+}
+
+// Layout returns the current layout
+func (g *GraphModel) Layout() GraphLayout {
+	if g.layout == "" {
+		return LayoutEgo
+	}
+	return g.layout
+}
+
+// layoutEdges returns the edges between shown nodes, sorted
+func (g *GraphModel) layoutEdges(shown map[string]bool) []graphLayoutEdge {
+	var edges []graphLayoutEdge
+	for _, id := range g.sortedIDs {
+		for _, blocker := range g.blockers[id] {
+			if shown[blocker] {
+				edges = append(edges, graphLayoutEdge{from: blocker, to: id})
+			}
+		}
+		for _, other := range g.links[id] {
+			// Links are stored both ways; keep one
+			if shown[other] && id < other {
+				edges = append(edges, graphLayoutEdge{from: id, to: other, link: true})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+		if edges[i].to != edges[j].to {
+			return edges[i].to < edges[j].to
+		}
+		return !edges[i].link && edges[j].link
+	})
+	return edges
+}
+
+// graphHash fingerprints the shown nodes, their statuses and edges, so an
+// unchanged graph reuses its layout
+func graphHash(ids []string, issues map[string]*model.Issue, edges []graphLayoutEdge) uint64 {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	h := fnv.New64a()
+	for _, id := range sorted {
+		status := ""
+		if issue := issues[id]; issue != nil {
+			status = string(issue.Status)
+		}
+		fmt.Fprintf(h, "n%s\x00%s\x00", id, status)
+	}
+	for _, e := range edges {
+		fmt.Fprintf(h, "e%s\x00%s\x00%v\x00", e.from, e.to, e.link)
+	}
+	return h.Sum64()
+}
+
+// radialRoot is the center of the radial layout: the focus root, else the
+// node selected when the layout was chosen while it is still shown, else
+// the selected node
+func (g *GraphModel) radialRoot() string {
+	if g.focusRoot != "" {
+		return g.focusRoot
+	}
+	if g.mapRoot != "" && g.issueMap[g.mapRoot] != nil && slices.Contains(g.sortedIDs, g.mapRoot) {
+		return g.mapRoot
+	}
+	if issue := g.SelectedIssue(); issue != nil {
+		return issue.ID
+	}
+	return ""
+}
+
+// canvas returns the current layout of the shown nodes, from the cache
+// when the graph hasn't changed
+func (g *GraphModel) canvas() *graphCanvas {
+	shown := make(map[string]bool, len(g.sortedIDs))
+	for _, id := range g.sortedIDs {
+		shown[id] = true
+	}
+	edges := g.layoutEdges(shown)
+	root := ""
+	if g.Layout() == LayoutRadial {
+		root = g.radialRoot()
+	}
+	key := fmt.Sprintf("%s:%s:%016x", g.Layout(), root, graphHash(g.sortedIDs, g.issueMap, edges))
+	if c, ok := g.layoutCache[key]; ok {
+		return c
+	}
+
+	ids := append([]string(nil), g.sortedIDs...)
+	sort.Strings(ids)
+	var slots map[string]canvasPoint
+	bands := 0
+	switch g.Layout() {
+	case LayoutRadial:
+		slots, bands = layoutRadial(ids, edges, root)
+	case LayoutForce:
+		slots = layoutForce(ids, edges)
+	default:
+		slots, bands = layoutLayered(ids, edges)
+	}
+	c := rasterize(ids, g.issueMap, edges, slots)
+	c.layout, c.root, c.bands = g.Layout(), root, bands
+
+	if g.layoutCache == nil || len(g.layoutCache) >= maxLayoutCache {
+		g.layoutCache = make(map[string]*graphCanvas)
+	}
+	g.layoutCache[key] = c
+	return c
+}
+
+// layoutLayered places nodes Sugiyama-style: each node one layer below its
+// deepest blocker, layers ordered by the barycenter of their neighbours to
+// cut crossings, and long layers wrapped onto extra rows. Returns the
+// slots and the layer count.
+func layoutLayered(ids []string, edges []graphLayoutEdge) (map[string]canvasPoint, int) {
+	preds := make(map[string][]string)
+	succs := make(map[string][]string)
+	indegree := make(map[string]int, len(ids))
+	for _, e := range edges {
+		if e.link {
+			continue
+		}
+		preds[e.to] = append(preds[e.to], e.from)
+		succs[e.from] = append(succs[e.from], e.to)
+		indegree[e.to]++
+	}
+
+	// Longest-path layering; a cycle is broken at its smallest remaining ID
+	layer := make(map[string]int, len(ids))
+	done := make(map[string]bool, len(ids))
+	var ready []string
+	for _, id := range ids {
+		if indegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	for len(done) < len(ids) {
+		if len(ready) == 0 {
+			for _, id := range ids {
+				if !done[id] {
+					ready = append(ready, id)
+					break
+				}
+			}
+		}
+		id := ready[0]
+		ready = ready[1:]
+		if done[id] {
+			continue
+		}
+		done[id] = true
+		for _, p := range preds[id] {
+			if done[p] && layer[p]+1 > layer[id] {
+				layer[id] = layer[p] + 1
+			}
+		}
+		for _, s := range succs[id] {
+			indegree[s]--
+			if indegree[s] == 0 && !done[s] {
+				ready = append(ready, s)
+			}
+		}
+	}
+
+	layers := 0
+	for _, id := range ids {
+		layers = max(layers, layer[id]+1)
+	}
+	rows := make([][]string, layers)
+	for _, id := range ids {
+		rows[layer[id]] = append(rows[layer[id]], id)
+	}
+
+	// Barycenter ordering: sweep down by blockers, up by dependents
+	order := make(map[string]float64, len(ids))
+	renumber := func(row []string) {
+		for i, id := range row {
+			order[id] = float64(i)
+		}
+	}
+	for _, row := range rows {
+		renumber(row)
+	}
+	sweep := func(row []string, neighbours map[string][]string) {
+		bary := make(map[string]float64, len(row))
+		for _, id := range row {
+			bary[id] = order[id]
+			if n := neighbours[id]; len(n) > 0 {
+				sum := 0.0
+				for _, other := range n {
+					sum += order[other]
+				}
+				bary[id] = sum / float64(len(n))
+			}
+		}
+		sort.SliceStable(row, func(i, j int) bool { return bary[row[i]] < bary[row[j]] })
+		renumber(row)
+	}
+	for pass := 0; pass < 4; pass++ {
+		for l := 1; l < layers; l++ {
+			sweep(rows[l], preds)
+		}
+		for l := layers - 2; l >= 0; l-- {
+			sweep(rows[l], succs)
+		}
+	}
+
+	slots := make(map[string]canvasPoint, len(ids))
+	y := 0
+	for _, row := range rows {
+		for i, id := range row {
+			slots[id] = canvasPoint{x: i % layerWrap, y: y + i/layerWrap}
+		}
+		y += (len(row) + layerWrap - 1) / layerWrap
+	}
+	return slots, layers
+}
+
+// layoutRadial places root in the middle and every other node on the
+// ring of its distance in hops, ordered around the ring by its neighbours
+// on the inner ring. Nodes not connected to root go on an outer ring.
+// Returns the slots and the ring count.
+func layoutRadial(ids []string, edges []graphLayoutEdge, root string) (map[string]canvasPoint, int) {
+	adj := make(map[string][]string)
+	for _, e := range edges {
+		adj[e.from] = append(adj[e.from], e.to)
+		adj[e.to] = append(adj[e.to], e.from)
+	}
+	if root == "" && len(ids) > 0 {
+		root = ids[0]
+	}
+
+	dist := map[string]int{root: 0}
+	frontier := []string{root}
+	maxDist := 0
+	for len(frontier) > 0 {
+		var next []string
+		for _, id := range frontier {
+			for _, other := range adj[id] {
+				if _, seen := dist[other]; !seen {
+					dist[other] = dist[id] + 1
+					maxDist = dist[other]
+					next = append(next, other)
+				}
+			}
+		}
+		sort.Strings(next)
+		frontier = next
+	}
+	rings := make([][]string, maxDist+2)
+	for _, id := range ids {
+		d, ok := dist[id]
+		if !ok {
+			d = maxDist + 1
+		}
+		rings[d] = append(rings[d], id)
+	}
+	if len(rings[maxDist+1]) == 0 {
+		rings = rings[:maxDist+1]
+	}
+
+	// Each ring is at least as wide as the one inside it and wide enough
+	// for its nodes. Slots are several times wider than tall, so the rings
+	// are drawn with a larger vertical radius to look round.
+	angle := map[string]float64{root: 0}
+	var placed []struct {
+		id   string
+		x, y float64
+	}
+	radius := 0.0
+	for r, ring := range rings {
+		if r == 0 {
+			placed = append(placed, struct {
+				id   string
+				x, y float64
+			}{root, 0, 0})
+			continue
+		}
+		inner := make(map[string]float64, len(ring))
+		for _, id := range ring {
+			sum, n := 0.0, 0
+			for _, other := range adj[id] {
+				if a, ok := angle[other]; ok && dist[other] < r {
+					sum += a
+					n++
+				}
+			}
+			inner[id] = math.Inf(1)
+			if n > 0 {
+				inner[id] = sum / float64(n)
+			}
+		}
+		sort.SliceStable(ring, func(i, j int) bool { return inner[ring[i]] < inner[ring[j]] })
+		radius = math.Max(radius+1, float64(len(ring))/(2*math.Pi)*1.2)
+		for i, id := range ring {
+			a := 2 * math.Pi * float64(i) / float64(len(ring))
+			angle[id] = a
+			placed = append(placed, struct {
+				id   string
+				x, y float64
+			}{id, radius * math.Cos(a), radius * 2 * math.Sin(a)})
+		}
+	}
+
+	points := make(map[string][2]float64, len(placed))
+	order := make([]string, 0, len(placed))
+	for _, p := range placed {
+		points[p.id] = [2]float64{p.x, p.y}
+		order = append(order, p.id)
+	}
+	return snapToSlots(order, points, 1), len(rings)
+}
+
+// layoutForce places nodes with Fruchterman-Reingold: edges pull their
+// ends together, every pair of nodes pushes apart, and the steps shrink
+// until the layout settles. It starts from a circle in ID order, so the
+// same graph always gets the same layout.
+func layoutForce(ids []string, edges []graphLayoutEdge) map[string]canvasPoint {
+	n := len(ids)
+	index := make(map[string]int, n)
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i, id := range ids {
+		index[id] = i
+		a := 2 * math.Pi * float64(i) / float64(max(n, 1))
+		x[i] = math.Cos(a) * math.Sqrt(float64(n))
+		y[i] = math.Sin(a) * math.Sqrt(float64(n))
+	}
+
+	// Pairwise repulsion is quadratic, so big graphs take fewer steps
+	iterations := forceIterations
+	if n > 0 {
+		iterations = min(forceIterations, max(10, 2_000_000/(n*n)))
+	}
+	const k = 1.0
+	temp := math.Sqrt(float64(n)) / 2
+	dx := make([]float64, n)
+	dy := make([]float64, n)
+	for it := 0; it < iterations; it++ {
+		for i := range dx {
+			dx[i], dy[i] = 0, 0
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				ex, ey := x[i]-x[j], y[i]-y[j]
+				d2 := ex*ex + ey*ey
+				if d2 < 1e-6 {
+					ex, ey, d2 = 0.01*float64(i-j), 0.01, 1e-4
+				}
+				f := k * k / d2
+				dx[i] += ex * f
+				dy[i] += ey * f
+				dx[j] -= ex * f
+				dy[j] -= ey * f
+			}
+		}
+		for _, e := range edges {
+			i, j := index[e.from], index[e.to]
+			ex, ey := x[i]-x[j], y[i]-y[j]
+			d := math.Sqrt(ex*ex + ey*ey)
+			f := d / k
+			dx[i] -= ex * f
+			dy[i] -= ey * f
+			dx[j] += ex * f
+			dy[j] += ey * f
+		}
+		for i := 0; i < n; i++ {
+			d := math.Sqrt(dx[i]*dx[i] + dy[i]*dy[i])
+			if d > 0 {
+				step := math.Min(d, temp)
+				x[i] += dx[i] / d * step
+				y[i] += dy[i] / d * step
+			}
+		}
+		temp *= 0.95
+	}
+
+	// Fit the layout's longer side to a grid about twice the square root
+	// of the node count, so long chains curl up instead of sprawling.
+	// Slots are wider than tall, so y is stretched to keep the shape.
+	points := make(map[string][2]float64, n)
+	minX, maxX, minY, maxY := math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)
+	for i, id := range ids {
+		points[id] = [2]float64{x[i], y[i] * 2}
+		minX, maxX = math.Min(minX, x[i]), math.Max(maxX, x[i])
+		minY, maxY = math.Min(minY, y[i]*2), math.Max(maxY, y[i]*2)
+	}
+	side := math.Ceil(2 * math.Sqrt(float64(n)))
+	return snapToSlots(ids, points, side/math.Max(1, math.Max(maxX-minX, maxY-minY)))
+}
+
+// snapToSlots rounds positions onto the slot grid, scaled by spread,
+// moving each node that lands on a taken slot to the nearest free one.
+// Nodes are placed in order, so earlier ones keep their spots.
+func snapToSlots(order []string, points map[string][2]float64, spread float64) map[string]canvasPoint {
+	slots := make(map[string]canvasPoint, len(order))
+	if len(order) == 0 {
+		return slots
+	}
+	taken := make(map[canvasPoint]bool, len(order))
+	minX, minY := math.Inf(1), math.Inf(1)
+	for _, id := range order {
+		minX = math.Min(minX, points[id][0])
+		minY = math.Min(minY, points[id][1])
+	}
+	for _, id := range order {
+		want := canvasPoint{
+			x: int(math.Round((points[id][0] - minX) * spread)),
+			y: int(math.Round((points[id][1] - minY) * spread)),
+		}
+		slot := want
+		for r := 1; taken[slot]; r++ {
+			slot = nearestFree(want, r, taken)
+		}
+		taken[slot] = true
+		slots[id] = slot
+	}
+	return slots
+}
+
+// nearestFree returns a free slot r steps from want, or want when every
+// slot at that distance is taken
+func nearestFree(want canvasPoint, r int, taken map[canvasPoint]bool) canvasPoint {
+	for dy := -r; dy <= r; dy++ {
+		for dx := -r; dx <= r; dx++ {
+			if max(abs(dx), abs(dy)) != r {
+				continue
+			}
+			p := canvasPoint{x: want.x + dx, y: want.y + dy}
+			if p.x >= 0 && p.y >= 0 && !taken[p] {
+				return p
+			}
+		}
+	}
+	return want
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// mapNodeLabel is a node's label on the canvas: a one-cell status glyph
+// and its ID
+func mapNodeLabel(id string, issue *model.Issue) string {
+	glyph := "●"
+	if issue != nil && issue.Status.IsClosed() {
+		glyph = "✓"
+	}
+	return glyph + " " + smartTruncateID(id, layoutIDLen)
+}
+
+// rasterize draws the nodes at their slots with the edges routed between
+// them. An edge leaves its blocker's label on the row below (or above),
+// runs along that row and enters its dependent at an arrow; links are
+// dotted and have no arrow.
+func rasterize(ids []string, issues map[string]*model.Issue, edges []graphLayoutEdge, slots map[string]canvasPoint) *graphCanvas {
+	c := &graphCanvas{
+		ids:    ids,
+		pos:    make(map[string]canvasPoint, len(ids)),
+		labels: make(map[string]string, len(ids)),
+	}
+	labelWidth := 0
+	for _, id := range ids {
+		c.labels[id] = mapNodeLabel(id, issues[id])
+		labelWidth = max(labelWidth, lipgloss.Width(c.labels[id]))
+	}
+	colWidth, rowHeight := labelWidth+4, 3
+	for _, id := range ids {
+		s := slots[id]
+		c.pos[id] = canvasPoint{x: 1 + s.x*colWidth, y: 1 + s.y*rowHeight}
+		c.width = max(c.width, c.pos[id].x+colWidth)
+		c.height = max(c.height, c.pos[id].y+2)
+	}
+	c.cells = make([]rune, c.width*c.height)
+	c.owner = make([]int32, c.width*c.height)
+	c.dotted = make([]bool, c.width*c.height)
+	for i := range c.cells {
+		c.cells[i] = ' '
+		c.owner[i] = -1
+	}
+
+	center := func(id string) canvasPoint {
+		p := c.pos[id]
+		return canvasPoint{x: p.x + lipgloss.Width(c.labels[id])/2, y: p.y}
+	}
+	solid := make([]int, len(c.cells))
+	dotted := make([]int, len(c.cells))
+	arrows := make(map[int]rune)
+	for _, e := range edges {
+		bits := solid
+		if e.link {
+			bits = dotted
+		}
+		from, to := center(e.from), center(e.to)
+		// The bus row is beside the source, on the target's side
+		bus, toward := from.y+1, lineUp
+		if to.y < from.y {
+			bus, toward = from.y-1, lineDown
+		}
+		end, arrow := to.y-1, '▼'
+		if to.y <= from.y {
+			end, arrow = to.y+1, '▲'
+		}
+		bits[c.at(from.x, bus)] |= toward
+		for x := min(from.x, to.x); x <= max(from.x, to.x); x++ {
+			if x > min(from.x, to.x) {
+				bits[c.at(x, bus)] |= lineLeft
+			}
+			if x < max(from.x, to.x) {
+				bits[c.at(x, bus)] |= lineRight
+			}
+		}
+		for y := min(bus, end); y <= max(bus, end); y++ {
+			if y > min(bus, end) {
+				bits[c.at(to.x, y)] |= lineUp
+			}
+			if y < max(bus, end) {
+				bits[c.at(to.x, y)] |= lineDown
+			}
+		}
+		if end < to.y {
+			bits[c.at(to.x, end)] |= lineDown
+		} else {
+			bits[c.at(to.x, end)] |= lineUp
+		}
+		if !e.link {
+			arrows[c.at(to.x, end)] = arrow
+		}
+	}
+	for i := range c.cells {
+		switch {
+		case arrows[i] != 0:
+			c.cells[i] = arrows[i]
+		case solid[i] != 0:
+			c.cells[i] = solidGlyphs[solid[i]]
+		case dotted[i] != 0:
+			c.dotted[i] = true
+			switch dotted[i] {
+			case lineUp, lineDown, lineUp | lineDown:
+				c.cells[i] = '┆'
+			case lineLeft, lineRight, lineLeft | lineRight:
+				c.cells[i] = '┄'
+			default:
+				c.cells[i] = '·'
+			}
+		}
+	}
+
+	for idx, id := range ids {
+		p := c.pos[id]
+		x := p.x
+		for _, r := range c.labels[id] {
+			if x >= c.width {
+				break
+			}
+			c.cells[c.at(x, p.y)] = r
+			c.owner[c.at(x, p.y)] = int32(idx)
+			x++
+		}
+	}
+	return c
+}
+
+// ensureMapVisible scrolls the map so the selected node is on screen,
+// only when the selection has changed so H/L panning sticks
+func (g *GraphModel) ensureMapVisible(c *graphCanvas, width, height int) {
+	selected := g.SelectedIssue()
+	if selected == nil || selected.ID == g.mapCentered {
+		return
+	}
+	g.mapCentered = selected.ID
+	p := c.pos[selected.ID]
+	labelWidth := lipgloss.Width(c.labels[selected.ID])
+	if p.x < g.mapX || p.x+labelWidth > g.mapX+width {
+		g.mapX = p.x + labelWidth/2 - width/2
+	}
+	if p.y < g.mapY || p.y >= g.mapY+height {
+		g.mapY = p.y - height/2
+	}
+}
+
+// clampMap keeps the map's scroll offsets on the canvas
+func (g *GraphModel) clampMap(c *graphCanvas, width, height int) {
+	g.mapX = max(0, min(g.mapX, c.width-width))
+	g.mapY = max(0, min(g.mapY, c.height-height))
+}
+
+// renderMap draws the visible part of the current layout's canvas with a
+// header, the filter line and key hints
+func (g *GraphModel) renderMap(width, height int, t Theme) string {
+	c := g.canvas()
+
+	var header string
+	switch c.layout {
+	case LayoutLayered:
+		header = fmt.Sprintf("🗺  Layered · %d layers · blockers above dependents", c.bands)
+	case LayoutRadial:
+		header = fmt.Sprintf("🗺  Radial · %d rings around %s", c.bands, c.root)
+	default:
+		header = "🗺  Force-directed"
+	}
+	footer := []string{}
+	if summary := g.FilterSummary(); summary != "" {
+		footer = append(footer, t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("🎯 "+summary))
+	}
+	hint := fmt.Sprintf("o: layout (%s) • H/L: scroll • /: jump • j/k: navigate • enter: view details • g: back to list", c.layout)
+	footer = append(footer, t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render(hint))
+
+	viewHeight := max(1, height-len(footer)-2)
+	g.ensureMapVisible(c, width, viewHeight)
+	g.clampMap(c, width, viewHeight)
+	if position := g.mapPosition(c, width, viewHeight); position != "" {
+		header += " · " + position
+	}
+	headerStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	lines := []string{headerStyle.Render(header)}
+	minimap := g.renderMinimap(c, width, viewHeight, t)
+
+	selectedIdx := int32(-1)
+	if selected := g.SelectedIssue(); selected != nil {
+		selectedIdx = int32(sort.SearchStrings(c.ids, selected.ID))
+	}
+	edgeStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	linkStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Faint(true)
+	selectedStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary).Background(t.Highlight)
+	styleOf := func(i int) (string, lipgloss.Style) {
+		switch owner := c.owner[i]; {
+		case owner == selectedIdx:
+			return "selected", selectedStyle
+		case owner >= 0:
+			id := c.ids[owner]
+			color := t.Secondary
+			if issue := g.issueMap[id]; issue != nil {
+				color = getStatusColor(issue.Status, t)
+			}
+			if g.showClusters {
+				color = g.clusterColor(id, t)
+			}
+			return "node:" + id, t.Renderer.NewStyle().Foreground(color)
+		case c.dotted[i]:
+			return "link", linkStyle
+		default:
+			return "edge", edgeStyle
+		}
+	}
+
+	for row := 0; row < viewHeight; row++ {
+		y := g.mapY + row
+		// The minimap covers the top right corner
+		rowWidth := width
+		if row < len(minimap) {
+			rowWidth = width - lipgloss.Width(minimap[row]) - 1
+		}
+		var sb strings.Builder
+		var run []rune
+		runKey := ""
+		var runStyle lipgloss.Style
+		flush := func() {
+			if len(run) > 0 {
+				sb.WriteString(runStyle.Render(string(run)))
+				run = run[:0]
+			}
+		}
+		for x := g.mapX; y < c.height && x < min(c.width, g.mapX+rowWidth); x++ {
+			i := c.at(x, y)
+			key, style := styleOf(i)
+			if key != runKey {
+				flush()
+				runKey, runStyle = key, style
+			}
+			run = append(run, c.cells[i])
+		}
+		flush()
+		line := sb.String()
+		if row < len(minimap) {
+			line += strings.Repeat(" ", max(0, rowWidth-lipgloss.Width(line))) + " " + minimap[row]
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "")
+	lines = append(lines, footer...)
+	return strings.Join(lines, "\n")
+}
+
+// renderMapView lays out the node list beside the map, or the map alone
+// on narrow terminals
+func (g *GraphModel) renderMapView(width, height int, t Theme) string {
+	if width < 80 {
+		return g.renderMap(width, height, t)
+	}
+	listWidth := 28
+	if width < 120 {
+		listWidth = 24
+	}
+	listView := g.renderNodeList(listWidth, height-2, t)
+	mapView := g.renderMap(width-listWidth-3, height-2, t)
+	separator := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Render(strings.Repeat("│\n", max(1, height-2)))
+	return lipgloss.JoinHorizontal(lipgloss.Top, listView, separator, " ", mapView)
+}
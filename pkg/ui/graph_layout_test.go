@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// chainIssues is a chain of n issues, each blocked by the one before
+func chainIssues(n int) []model.Issue {
+	issues := make([]model.Issue, n)
+	for i := range issues {
+		issues[i] = model.Issue{ID: fmt.Sprintf("c-%02d", i), Title: "Step", Status: model.StatusOpen}
+		if i > 0 {
+			issues[i].Dependencies = []*model.Dependency{{IssueID: issues[i].ID, DependsOnID: issues[i-1].ID, Type: model.DepBlocks}}
+		}
+	}
+	return issues
+}
+
+func TestLayoutLayered(t *testing.T) {
+	g := NewGraphModel(chainIssues(12), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SetLayout(LayoutLayered)
+	c := g.canvas()
+	if c.bands != 12 {
+		t.Fatalf("a chain of 12 should have 12 layers, got %d", c.bands)
+	}
+	for i := 1; i < 12; i++ {
+		above, below := c.pos[fmt.Sprintf("c-%02d", i-1)], c.pos[fmt.Sprintf("c-%02d", i)]
+		if below.y <= above.y || below.x != above.x {
+			t.Errorf("c-%02d at %v should sit straight below its blocker at %v", i, below, above)
+		}
+	}
+	if c.width > 30 {
+		t.Errorf("a deep chain should make a narrow canvas, got %d columns", c.width)
+	}
+
+	// A wide layer wraps, and a cycle doesn't stop the layering
+	var issues []model.Issue
+	for i := 0; i < 20; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("w-%02d", i), Status: model.StatusOpen})
+	}
+	issues = append(issues,
+		model.Issue{ID: "x", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "x", DependsOnID: "y", Type: model.DepBlocks}}},
+		model.Issue{ID: "y", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "y", DependsOnID: "x", Type: model.DepBlocks}}},
+	)
+	ids := make([]string, len(issues))
+	shown := map[string]bool{}
+	for i := range issues {
+		ids[i] = issues[i].ID
+		shown[ids[i]] = true
+	}
+	g = NewGraphModel(issues, nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	slots, layers := layoutLayered(ids, g.layoutEdges(shown))
+	if layers != 2 || len(slots) != len(ids) {
+		t.Fatalf("got %d layers and %d slots", layers, len(slots))
+	}
+	widest := 0
+	for _, s := range slots {
+		widest = max(widest, s.x)
+	}
+	if widest >= layerWrap {
+		t.Errorf("layers should wrap at %d nodes, got slot x %d", layerWrap, widest)
+	}
+}
+
+func TestLayoutRadial(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SelectIssueByID("c")
+	g.SetLayout(LayoutRadial)
+	c := g.canvas()
+	// c; b, d, done; a, e; rel
+	if c.root != "c" || c.bands != 4 {
+		t.Fatalf("radial around c should have 4 rings, got root %q and %d", c.root, c.bands)
+	}
+
+	// The center stays put while the selection moves
+	g.SelectIssueByID("a")
+	if g.canvas() != c {
+		t.Error("moving the selection should keep the radial layout")
+	}
+	g.ToggleFocus()
+	if got := g.canvas(); got.root != "a" {
+		t.Errorf("focus mode should center the rings on its root, got %q", got.root)
+	}
+}
+
+func TestLayoutForce(t *testing.T) {
+	issues := filterTestIssues()
+	ids := []string{"a", "b", "c", "d", "done", "e", "rel"}
+	g := NewGraphModel(issues, nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	shown := map[string]bool{}
+	for _, id := range ids {
+		shown[id] = true
+	}
+	edges := g.layoutEdges(shown)
+
+	first := layoutForce(ids, edges)
+	if second := layoutForce(ids, edges); !maps.Equal(first, second) {
+		t.Error("the force-directed layout should be deterministic")
+	}
+	taken := map[canvasPoint]string{}
+	for id, slot := range first {
+		if other, ok := taken[slot]; ok {
+			t.Errorf("%s and %s share slot %v", id, other, slot)
+		}
+		taken[slot] = id
+	}
+}
+
+func TestGraphLayoutCache(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SetLayout(LayoutLayered)
+	c := g.canvas()
+	if g.canvas() != c {
+		t.Error("an unchanged graph should reuse its cached layout")
+	}
+	g.ToggleHideClosed()
+	if hidden := g.canvas(); hidden == c || len(hidden.ids) != 6 {
+		t.Error("hiding closed issues changes the graph hash")
+	}
+	g.ToggleHideClosed()
+	if g.canvas() != c {
+		t.Error("showing them again should find the first layout in the cache")
+	}
+
+	// Reloading the same issues keeps the hash; a status change doesn't
+	issues := filterTestIssues()
+	g.SetIssues(issues, nil)
+	if g.canvas() != c {
+		t.Error("reloading unchanged issues should reuse the layout")
+	}
+	issues[0].Status = model.StatusClosed
+	g.SetIssues(issues, nil)
+	if g.canvas() == c {
+		t.Error("a status change should lay the graph out again")
+	}
+}
+
+func TestGraphView_MapLayout(t *testing.T) {
+	g := NewGraphModel(filterTestIssues(), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SelectIssueByID("c")
+	g.SetLayout(LayoutLayered)
+	out := g.View(120, 40)
+	for _, want := range []string{"Layered · 5 layers", "● c", "✓ done", "▼", "┄", "o: layout (layered)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("layered map missing %q:\n%s", want, out)
+		}
+	}
+
+	// Panning sticks until the selection moves
+	g.ScrollRight()
+	if g.mapX != mapScrollStep {
+		t.Fatalf("L should pan the map, got x %d", g.mapX)
+	}
+	g.View(40, 40)
+	g.ScrollLeft()
+	if g.mapX != 0 {
+		t.Errorf("H should pan back, got x %d", g.mapX)
+	}
+}
+
+func TestModel_GraphLayoutKey(t *testing.T) {
+	m := NewModel(filterTestIssues(), nil, "")
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("g")
+	for _, want := range graphLayouts[1:] {
+		press("o")
+		if m.graphView.Layout() != want || !strings.Contains(m.statusMsg, "Graph layout") {
+			t.Errorf("o should switch to %s, got %s (%q)", want, m.graphView.Layout(), m.statusMsg)
+		}
+	}
+	if !strings.Contains(m.describeFocus(), "force layout") {
+		t.Errorf("announce should name the layout, got %q", m.describeFocus())
+	}
+	press("o")
+	if m.graphView.Layout() != LayoutEgo {
+		t.Errorf("o should cycle back to the ego view, got %s", m.graphView.Layout())
+	}
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Minimap bounds, in cells inside its border
+const (
+	minimapMaxWidth  = 24
+	minimapMaxHeight = 8
+)
+
+// mapPosition describes which part of the canvas the map shows, or ""
+// when all of it fits
+func (g *GraphModel) mapPosition(c *graphCanvas, width, height int) string {
+	if c.width <= width && c.height <= height {
+		return ""
+	}
+	return fmt.Sprintf("cols %d–%d of %d, rows %d–%d of %d",
+		g.mapX+1, min(c.width, g.mapX+width), c.width,
+		g.mapY+1, min(c.height, g.mapY+height), c.height)
+}
+
+// renderMinimap draws the whole canvas shrunk into a small bordered box,
+// with the visible part shaded and the selected node marked ◆. It returns
+// the box's lines, or nil when the canvas fits on screen or the screen is
+// too small to spare the room.
+func (g *GraphModel) renderMinimap(c *graphCanvas, width, height int, t Theme) []string {
+	if c.width <= width && c.height <= height {
+		return nil
+	}
+	mw := min(minimapMaxWidth, width/3, c.width)
+	mh := min(minimapMaxHeight, height/2-2, c.height)
+	if width < 24 || mh < 3 {
+		return nil
+	}
+
+	// Each minimap cell covers a block of the canvas
+	scaleX, scaleY := (c.width+mw-1)/mw, (c.height+mh-1)/mh
+	cell := func(p canvasPoint) int {
+		return min(p.y/scaleY, mh-1)*mw + min(p.x/scaleX, mw-1)
+	}
+	nodes := make([]bool, mw*mh)
+	for _, p := range c.pos {
+		nodes[cell(p)] = true
+	}
+	selected := -1
+	if issue := g.SelectedIssue(); issue != nil {
+		if p, ok := c.pos[issue.ID]; ok {
+			selected = cell(p)
+		}
+	}
+	x0, x1 := g.mapX/scaleX, (g.mapX+width-1)/scaleX
+	y0, y1 := g.mapY/scaleY, (g.mapY+height-1)/scaleY
+
+	nodeStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	viewStyle := t.Renderer.NewStyle().Foreground(t.Primary).Background(t.Highlight)
+	selectedStyle := t.Renderer.NewStyle().Foreground(t.Primary).Background(t.Highlight).Bold(true)
+	rows := make([]string, mh)
+	for y := 0; y < mh; y++ {
+		var sb strings.Builder
+		for x := 0; x < mw; x++ {
+			i := y*mw + x
+			inView := x >= x0 && x <= x1 && y >= y0 && y <= y1
+			glyph := " "
+			if nodes[i] {
+				glyph = "•"
+			}
+			switch {
+			case i == selected:
+				sb.WriteString(selectedStyle.Render("◆"))
+			case inView:
+				sb.WriteString(viewStyle.Render(glyph))
+			default:
+				sb.WriteString(nodeStyle.Render(glyph))
+			}
+		}
+		rows[y] = sb.String()
+	}
+	box := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Secondary).
+		Render(strings.Join(rows, "\n"))
+	return strings.Split(box, "\n")
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestGraphMinimap(t *testing.T) {
+	g := NewGraphModel(chainIssues(40), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SetLayout(LayoutLayered)
+
+	// A chain of 40 is 120 rows tall, so the map scrolls and shows a minimap
+	out := g.View(120, 40)
+	if !strings.Contains(out, "rows 1–") || !strings.Contains(out, "of 120") {
+		t.Errorf("header should give the viewport position:\n%s", out)
+	}
+	if !strings.Contains(out, "╭") || !strings.Contains(out, "◆") {
+		t.Errorf("a tall map should draw a minimap marking the selection:\n%s", out)
+	}
+
+	// The minimap follows the selection down the chain
+	c := g.canvas()
+	g.SelectIssueByID("c-39")
+	g.View(120, 40)
+	if g.mapY == 0 {
+		t.Fatal("selecting the last node should scroll the map down")
+	}
+	mini := g.renderMinimap(c, 80, 30, g.theme)
+	last := mini[len(mini)-2]
+	if !strings.Contains(last, "◆") {
+		t.Errorf("the selection should be marked on the minimap's last row, got:\n%s", strings.Join(mini, "\n"))
+	}
+
+	// A graph that fits needs neither
+	g = NewGraphModel(chainIssues(3), nil, DefaultTheme(lipgloss.NewRenderer(io.Discard)))
+	g.SetLayout(LayoutLayered)
+	if out := g.View(120, 40); strings.Contains(out, "◆") || strings.Contains(out, "rows 1–") {
+		t.Errorf("a small graph shouldn't get a minimap:\n%s", out)
+	}
+}
+
+func TestModel_GraphJumpPrompt(t *testing.T) {
+	m := NewModel(filterTestIssues(), nil, "")
+	press := func(keys ...string) {
+		for _, key := range keys {
+			updated, _ := m.Update(keyMsgFromString(key))
+			m = updated.(Model)
+		}
+	}
+	press("g", "/")
+	if !m.showGoto || !m.gotoGraph || !strings.Contains(m.gotoPicker.View(), "Jump to Node") {
+		t.Fatal("/ in the graph should open the jump prompt")
+	}
+	press("r", "e", "l", "enter")
+	if !m.isGraphView || m.focused != focusGraph || m.graphView.SelectedIssue().ID != "rel" {
+		t.Fatalf("enter should select rel in the graph, status %q", m.statusMsg)
+	}
+
+	// Nodes hidden by a filter can't be jumped to
+	press("x", "/", "d", "o", "n", "e", "enter")
+	if !m.isGraphView || !m.statusIsError || !strings.Contains(m.statusMsg, "isn't in the graph") {
+		t.Errorf("done is hidden, status %q", m.statusMsg)
+	}
+}
@@ -2,6 +2,7 @@ package ui_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
@@ -308,6 +309,36 @@ func TestGraphModelViewRendering(t *testing.T) {
 	}
 }
 
+// TestGraphModelCrossRepoEdges verifies edges between workspace repos are drawn dashed
+func TestGraphModelCrossRepoEdges(t *testing.T) {
+	theme := createTheme()
+	issues := []model.Issue{
+		{ID: "web-bd-9", Title: "Login page", Dependencies: []*model.Dependency{
+			{DependsOnID: "api-bd-1", Type: model.DepBlocks},
+			{DependsOnID: "web-bd-8", Type: model.DepBlocks},
+		}},
+		{ID: "api-bd-1", Title: "Auth API"},
+		{ID: "web-bd-8", Title: "Form kit"},
+	}
+
+	g := ui.NewGraphModel(issues, nil, theme)
+	if !g.SelectIssueByID("web-bd-9") {
+		t.Fatal("Expected web-bd-9 in the graph")
+	}
+	if view := g.View(120, 40); strings.Contains(view, "╎") || strings.Contains(view, "cross-repo") {
+		t.Error("Expected no cross-repo styling outside workspace mode")
+	}
+
+	g.SetWorkspaceMode(true)
+	view := g.View(120, 40)
+	if !strings.Contains(view, "⇄1 cross-repo") {
+		t.Error("Expected the selected issue to count one cross-repo edge")
+	}
+	if !strings.Contains(view, "╎") {
+		t.Error("Expected the api blocker to be drawn with a dashed border")
+	}
+}
+
 // TestGraphModelIgnoresNonBlockingDeps verifies only blocking deps create edges
 func TestGraphModelIgnoresNonBlockingDeps(t *testing.T) {
 	theme := createTheme()
@@ -879,3 +910,52 @@ func TestGraphModelReferenceToSelf(t *testing.T) {
 		t.Error("Expected non-empty view")
 	}
 }
+
+// TestGraphModelClusters verifies cluster mode groups the nodes and shows the summary
+func TestGraphModelClusters(t *testing.T) {
+	theme := createTheme()
+	blocks := func(id string) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: model.DepBlocks}
+	}
+	issues := []model.Issue{
+		{ID: "a1", Title: "API one", Status: model.StatusOpen, Labels: []string{"api"}},
+		{ID: "b1", Title: "UI one", Status: model.StatusOpen, Labels: []string{"ui"}},
+		{ID: "a2", Title: "API two", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: []*model.Dependency{blocks("a1")}},
+		{ID: "b2", Title: "UI two", Status: model.StatusOpen, Labels: []string{"ui"}, Dependencies: []*model.Dependency{blocks("b1")}},
+		{ID: "a3", Title: "API three", Status: model.StatusOpen, Labels: []string{"api"}, Dependencies: []*model.Dependency{blocks("a1"), blocks("a2")}},
+		{ID: "z9", Title: "Loner", Status: model.StatusOpen},
+	}
+
+	g := ui.NewGraphModel(issues, nil, theme)
+	g.SelectIssueByID("b2")
+	g.ToggleClusters()
+	if !g.ClustersShown() {
+		t.Fatal("Expected cluster mode on")
+	}
+	if sel := g.SelectedIssue(); sel == nil || sel.ID != "b2" {
+		t.Errorf("Expected b2 to stay selected, got %v", sel)
+	}
+
+	// Nodes are grouped: the api cluster (largest) first, then ui, then the loner
+	var order []string
+	g.SelectIssueByID("a1")
+	for i := 0; i < g.TotalCount(); i++ {
+		order = append(order, g.SelectedIssue().ID)
+		g.MoveDown()
+	}
+	if got := fmt.Sprint(order); got != "[a1 a2 a3 b1 b2 z9]" {
+		t.Errorf("Expected nodes grouped by cluster, got %s", got)
+	}
+
+	view := g.View(140, 50)
+	for _, want := range []string{"CLUSTERS (2)", "3 issues", "67% blocked  api", "+ 1 unlinked issues", "c: hide clusters"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Cluster panel missing %q:\n%s", want, view)
+		}
+	}
+
+	g.ToggleClusters()
+	if view := g.View(140, 50); strings.Contains(view, "CLUSTERS") || !strings.Contains(view, "GRAPH METRICS") {
+		t.Error("Expected the metrics panel back with clusters off")
+	}
+}
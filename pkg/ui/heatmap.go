@@ -0,0 +1,301 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// heatmapMode selects which activity the calendar cells count
+type heatmapMode int
+
+const (
+	heatmapModeBoth heatmapMode = iota
+	heatmapModeCreated
+	heatmapModeClosed
+)
+
+func (m heatmapMode) String() string {
+	switch m {
+	case heatmapModeCreated:
+		return "created"
+	case heatmapModeClosed:
+		return "closed"
+	default:
+		return "created + closed"
+	}
+}
+
+// heatmapWeeks is how much history is computed; narrow terminals show the most recent part
+const heatmapWeeks = 52
+
+// heatmapLabelWidth is the width of the weekday label column
+const heatmapLabelWidth = 4
+
+// heatmapHeaderLines counts the lines above the stale list: title, month axis,
+// 7 weekday rows, legend, blank line, stale header
+const heatmapHeaderLines = 12
+
+// HeatmapModel shows a weeks × weekdays calendar of issue activity above a
+// list of open issues that have gone stale
+type HeatmapModel struct {
+	heatmap      analysis.ActivityHeatmap
+	stale        []analysis.StaleIssue
+	freshness    analysis.FreshnessMetrics
+	staleDays    int
+	mode         heatmapMode
+	selectedIdx  int
+	scrollOffset int
+	now          time.Time
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewHeatmapModel creates the activity view; staleDays comes from .bv/analysis.yaml
+func NewHeatmapModel(issues []model.Issue, staleDays int, now time.Time, theme Theme) HeatmapModel {
+	h := HeatmapModel{staleDays: staleDays, theme: theme}
+	h.SetIssues(issues, now)
+	return h
+}
+
+// SetIssues recomputes the view while keeping the selected stale issue if possible
+func (h *HeatmapModel) SetIssues(issues []model.Issue, now time.Time) {
+	selectedID := h.SelectedIssueID()
+	h.now = now
+	h.heatmap = analysis.ComputeActivityHeatmap(issues, heatmapWeeks, now)
+	h.stale = analysis.ComputeStaleIssues(issues, h.staleDays, now)
+
+	var open []model.Issue
+	for _, issue := range issues {
+		if issue.Status != model.StatusClosed {
+			open = append(open, issue)
+		}
+	}
+	h.freshness = analysis.ComputeFreshnessMetrics(open, now, h.staleDays)
+
+	h.selectedIdx = 0
+	for i, s := range h.stale {
+		if s.ID == selectedID {
+			h.selectedIdx = i
+			break
+		}
+	}
+	h.ensureVisible()
+}
+
+// SetSize updates the render dimensions
+func (h *HeatmapModel) SetSize(width, height int) {
+	h.width = width
+	h.height = height
+	h.ensureVisible()
+}
+
+// CycleMode switches the calendar between created+closed, created, and closed
+func (h *HeatmapModel) CycleMode() {
+	h.mode = (h.mode + 1) % 3
+}
+
+// MoveUp selects the previous stale issue
+func (h *HeatmapModel) MoveUp() {
+	if h.selectedIdx > 0 {
+		h.selectedIdx--
+		h.ensureVisible()
+	}
+}
+
+// MoveDown selects the next stale issue
+func (h *HeatmapModel) MoveDown() {
+	if h.selectedIdx < len(h.stale)-1 {
+		h.selectedIdx++
+		h.ensureVisible()
+	}
+}
+
+// PageUp moves the selection up by a page
+func (h *HeatmapModel) PageUp() {
+	h.selectedIdx -= h.visibleRows()
+	if h.selectedIdx < 0 {
+		h.selectedIdx = 0
+	}
+	h.ensureVisible()
+}
+
+// PageDown moves the selection down by a page
+func (h *HeatmapModel) PageDown() {
+	if len(h.stale) == 0 {
+		return
+	}
+	h.selectedIdx += h.visibleRows()
+	if h.selectedIdx >= len(h.stale) {
+		h.selectedIdx = len(h.stale) - 1
+	}
+	h.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the selected stale issue, or "" if none
+func (h *HeatmapModel) SelectedIssueID() string {
+	if h.selectedIdx < 0 || h.selectedIdx >= len(h.stale) {
+		return ""
+	}
+	return h.stale[h.selectedIdx].ID
+}
+
+func (h *HeatmapModel) visibleRows() int {
+	// header block above the list + key legend below it
+	rows := h.height - heatmapHeaderLines - 1
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+func (h *HeatmapModel) ensureVisible() {
+	visible := h.visibleRows()
+	if h.selectedIdx < h.scrollOffset {
+		h.scrollOffset = h.selectedIdx
+	}
+	if h.selectedIdx >= h.scrollOffset+visible {
+		h.scrollOffset = h.selectedIdx - visible + 1
+	}
+	// Scroll back up when the view grows
+	if maxOffset := len(h.stale) - visible; h.scrollOffset > maxOffset {
+		h.scrollOffset = maxOffset
+	}
+	if h.scrollOffset < 0 {
+		h.scrollOffset = 0
+	}
+}
+
+// count returns the value a cell shows in the current mode, and the maximum
+// across the calendar for scaling
+func (h *HeatmapModel) count(d analysis.ActivityDay) (int, int) {
+	switch h.mode {
+	case heatmapModeCreated:
+		return d.Created, h.heatmap.MaxCreated
+	case heatmapModeClosed:
+		return d.Closed, h.heatmap.MaxClosed
+	default:
+		return d.Created + d.Closed, h.heatmap.MaxTotal
+	}
+}
+
+// View renders the calendar and the stale issue list
+func (h *HeatmapModel) View(width, height int) string {
+	h.SetSize(width, height)
+	th := h.theme
+	titleStyle := th.Renderer.NewStyle().Bold(true).Foreground(th.Primary)
+	mutedStyle := th.Renderer.NewStyle().Foreground(th.Muted)
+
+	// Two columns per week; show the most recent weeks that fit
+	weeks := (width - heatmapLabelWidth) / 2
+	if weeks > h.heatmap.Weeks {
+		weeks = h.heatmap.Weeks
+	}
+	if weeks < 1 {
+		weeks = 1
+	}
+	firstWeek := h.heatmap.Weeks - weeks
+
+	var lines []string
+	header := titleStyle.Render(fmt.Sprintf("🔥 Activity (%s, last %d weeks)", h.mode, weeks))
+	header += mutedStyle.Render(fmt.Sprintf("  •  freshness %d/100  •  avg %.1fd since update",
+		h.freshness.FreshnessScore, h.freshness.AvgDaysSinceUpdate))
+	lines = append(lines, header)
+	lines = append(lines, mutedStyle.Render(strings.Repeat(" ", heatmapLabelWidth)+h.renderMonthAxis(firstWeek, weeks)))
+
+	dayNames := []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	for wd := 0; wd < 7; wd++ {
+		var sb strings.Builder
+		sb.WriteString(mutedStyle.Render(padRight(dayNames[wd], heatmapLabelWidth)))
+		for w := firstWeek; w < h.heatmap.Weeks; w++ {
+			sb.WriteString(h.renderCell(h.heatmap.Day(w, wd)))
+		}
+		lines = append(lines, sb.String())
+	}
+
+	legend := strings.Repeat(" ", heatmapLabelWidth) + "less " +
+		h.renderLevel(0) + h.renderLevel(0.1) + h.renderLevel(0.4) + h.renderLevel(0.7) + h.renderLevel(1) + " more"
+	lines = append(lines, mutedStyle.Render(legend), "")
+
+	staleHeader := titleStyle.Render(fmt.Sprintf("⏳ Stale: %d open issues untouched for %d+ days", len(h.stale), h.staleDays))
+	lines = append(lines, staleHeader)
+
+	if len(h.stale) == 0 {
+		lines = append(lines, mutedStyle.Render("  Nothing stale 🎉"))
+	} else {
+		end := h.scrollOffset + h.visibleRows()
+		if end > len(h.stale) {
+			end = len(h.stale)
+		}
+		for i := h.scrollOffset; i < end; i++ {
+			lines = append(lines, h.renderStaleRow(h.stale[i], i == h.selectedIdx, width))
+		}
+	}
+
+	keys := "j/k select  •  c created/closed/both  •  enter open  •  D/esc close  •  threshold: stale_days in .bv/analysis.yaml"
+	for len(lines) < height-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, mutedStyle.Render(truncateRunesHelper(keys, width, "…")))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderMonthAxis labels the first week of each month
+func (h *HeatmapModel) renderMonthAxis(firstWeek, weeks int) string {
+	axis := []rune(strings.Repeat(" ", weeks*2))
+	lastMonth := time.Month(0)
+	for w := 0; w < weeks; w++ {
+		month := h.heatmap.Day(firstWeek+w, 0).Date.Month()
+		if month == lastMonth {
+			continue
+		}
+		lastMonth = month
+		label := []rune(month.String()[:3])
+		for k := 0; k < len(label) && w*2+k < len(axis); k++ {
+			axis[w*2+k] = label[k]
+		}
+	}
+	return string(axis)
+}
+
+func (h *HeatmapModel) renderCell(d analysis.ActivityDay) string {
+	if d.Date.After(h.now) {
+		return "  "
+	}
+	n, maxN := h.count(d)
+	if n == 0 || maxN == 0 {
+		return h.renderLevel(0) + " "
+	}
+	return h.renderLevel(float64(n)/float64(maxN)) + " "
+}
+
+// renderLevel draws one cell; zero is a muted dot, otherwise a block colored by intensity
+func (h *HeatmapModel) renderLevel(score float64) string {
+	if score <= 0 {
+		return h.theme.Renderer.NewStyle().Foreground(h.theme.Muted).Render("·")
+	}
+	return h.theme.Renderer.NewStyle().Foreground(GetHeatmapColor(score, h.theme)).Render("■")
+}
+
+func (h *HeatmapModel) renderStaleRow(s analysis.StaleIssue, selected bool, width int) string {
+	th := h.theme
+	row := fmt.Sprintf("  %4dd  P%d  %-12s %s", s.DaysIdle, s.Priority, smartTruncateID(s.ID, 12), s.Title)
+	if s.Assignee != "" {
+		row += "  @" + s.Assignee
+	}
+	row = padRight(truncateRunesHelper(row, width, "…"), width)
+
+	style := th.Renderer.NewStyle().Foreground(th.Base.GetForeground())
+	if s.DaysIdle >= 2*h.staleDays {
+		style = th.Renderer.NewStyle().Foreground(th.Blocked)
+	}
+	if selected {
+		style = th.Renderer.NewStyle().Background(th.Highlight).Foreground(th.Primary).Bold(true)
+	}
+	return style.Render(row)
+}
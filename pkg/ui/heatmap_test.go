@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func heatmapTestIssues(now time.Time) []model.Issue {
+	return []model.Issue{
+		{ID: "h-1", Title: "Recent", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now},
+		{ID: "h-2", Title: "Forgotten", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -90), UpdatedAt: now.AddDate(0, 0, -60)},
+		{ID: "h-3", Title: "Dusty", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -90), UpdatedAt: now.AddDate(0, 0, -20)},
+	}
+}
+
+func TestHeatmapModel_StaleListAndView(t *testing.T) {
+	now := time.Now()
+	hm := NewHeatmapModel(heatmapTestIssues(now), 14, now, createTheme())
+
+	if got := hm.SelectedIssueID(); got != "h-2" {
+		t.Fatalf("Expected stalest issue h-2 selected first, got %q", got)
+	}
+	hm.MoveDown()
+	if got := hm.SelectedIssueID(); got != "h-3" {
+		t.Errorf("Expected h-3 after MoveDown, got %q", got)
+	}
+	hm.MoveDown()
+	if got := hm.SelectedIssueID(); got != "h-3" {
+		t.Errorf("Expected selection to stop at the last stale issue, got %q", got)
+	}
+
+	view := hm.View(120, 30)
+	for _, want := range []string{"Activity (created + closed", "Mon", "Stale: 2 open issues untouched for 14+ days", "Forgotten", "Dusty"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in view:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Recent") {
+		t.Error("Fresh issues should not be listed as stale")
+	}
+
+	hm.CycleMode()
+	if !strings.Contains(hm.View(120, 30), "Activity (created,") {
+		t.Error("Expected created mode after cycling")
+	}
+}
+
+func TestHeatmapView_ToggleAndJump(t *testing.T) {
+	now := time.Now()
+	m := NewModel(heatmapTestIssues(now), nil, "")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	m = updated.(Model)
+	if !m.isHeatmapView || m.focused != focusHeatmap {
+		t.Fatalf("Expected D to open the activity view")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.isHeatmapView {
+		t.Error("Expected enter to leave the activity view")
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "h-2" {
+		t.Errorf("Expected list selection on h-2, got %+v", m.list.SelectedItem())
+	}
+
+	m = NewModel(heatmapTestIssues(now), nil, "")
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	updated, _ = updated.(Model).Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if updated.(Model).isHeatmapView {
+		t.Error("Expected esc to close the activity view")
+	}
+}
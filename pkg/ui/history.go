@@ -583,6 +583,12 @@ func (h *HistoryModel) renderDetailPanel(width, height int) string {
 	}
 	lines = append(lines, strings.Repeat("─", detailSepWidth))
 
+	// Delivery chain: branch → PR → merge
+	if !hist.Delivery.IsEmpty() {
+		lines = append(lines, h.renderDeliveryChain(hist.Delivery, width-4)...)
+		lines = append(lines, strings.Repeat("─", detailSepWidth))
+	}
+
 	// Render commits
 	for i, commit := range hist.Commits {
 		isSelected := i == h.selectedCommit && h.focused == historyFocusDetail
@@ -650,6 +656,21 @@ func (h *HistoryModel) renderCommitDetail(commit correlation.CorrelatedCommit, w
 	)
 	lines = append(lines, confLine)
 
+	// What the confidence is made of, for the selected commit
+	if selected {
+		for _, f := range commit.Breakdown {
+			style := t.Renderer.NewStyle().Foreground(t.Open)
+			if f.Delta < 0 {
+				style = t.Renderer.NewStyle().Foreground(t.Blocked)
+			}
+			label := confidenceSignalLabel(f.Signal)
+			lines = append(lines, fmt.Sprintf("      %s %-9s %s",
+				style.Render(fmt.Sprintf("%+4.0f%%", f.Delta*100)),
+				label,
+				truncate(f.Detail, width-24)))
+		}
+	}
+
 	// Files (abbreviated)
 	if len(commit.Files) > 0 {
 		fileCount := fmt.Sprintf("    %d files changed", len(commit.Files))
@@ -672,10 +693,70 @@ func (h *HistoryModel) renderCommitDetail(commit correlation.CorrelatedCommit, w
 	return lines
 }
 
+// renderDeliveryChain renders the branches, pull requests and merges that
+// carried a bead's work
+func (h *HistoryModel) renderDeliveryChain(d *correlation.DeliveryChain, width int) []string {
+	t := h.theme
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(8)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var lines []string
+	if len(d.Branches) > 0 {
+		var names []string
+		for _, b := range d.Branches {
+			names = append(names, b.Name)
+		}
+		lines = append(lines, labelStyle.Render("Branch")+truncate(strings.Join(names, ", "), width-8))
+	}
+	for _, pr := range d.PullRequests {
+		text := fmt.Sprintf("#%d", pr.Number)
+		if pr.Branch != "" {
+			text += " from " + pr.Branch
+		}
+		if pr.MergeSHA != "" {
+			text += " → " + pr.MergeSHA[:min(7, len(pr.MergeSHA))]
+		}
+		if !pr.MergedAt.IsZero() {
+			text += " " + pr.MergedAt.Format("2006-01-02")
+		}
+		lines = append(lines, labelStyle.Render("PR")+truncate(text, width-8))
+		if pr.URL != "" {
+			lines = append(lines, mutedStyle.Render("        "+truncate(pr.URL, width-8)))
+		}
+	}
+	for _, mr := range d.Merges {
+		text := mr.Branch
+		if mr.Into != "" {
+			text += " into " + mr.Into
+		}
+		text += fmt.Sprintf(" → %s %s", mr.SHA[:min(7, len(mr.SHA))], mr.At.Format("2006-01-02"))
+		lines = append(lines, labelStyle.Render("Merged")+truncate(text, width-8))
+	}
+	return lines
+}
+
 // Helper functions
 
 
 
+// confidenceSignalLabel names the heuristic a confidence factor came from
+func confidenceSignalLabel(signal string) string {
+	switch signal {
+	case correlation.SignalCoCommit:
+		return "co-commit"
+	case correlation.SignalExplicitID:
+		return "ID match"
+	case correlation.SignalFilePaths:
+		return "paths"
+	case correlation.SignalTemporal:
+		return "timing"
+	case correlation.SignalCap:
+		return "cap"
+	default:
+		return signal
+	}
+}
+
 func methodLabel(method correlation.CorrelationMethod) string {
 	switch method {
 	case correlation.MethodCoCommitted:
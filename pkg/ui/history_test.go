@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -349,6 +350,52 @@ func TestHistoryModel_View(t *testing.T) {
 	}
 }
 
+func TestHistoryModel_ViewDeliveryChain(t *testing.T) {
+	report := createTestHistoryReport()
+	h := NewHistoryModel(report, testTheme())
+	h.SetSize(140, 40)
+	id := h.SelectedBeadID()
+	hist := report.Histories[id]
+	hist.Delivery = &correlation.DeliveryChain{
+		Branches:     []correlation.BranchRef{{Name: id + "-auth"}},
+		PullRequests: []correlation.PullRequestRef{{Number: 42, Branch: id + "-auth", MergeSHA: "feedface1234", Source: "merge-commit"}},
+	}
+	report.Histories[id] = hist
+	h.SetReport(report)
+
+	view := h.View()
+	for _, want := range []string{id + "-auth", "#42 from " + id + "-auth", "feedfac"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the detail panel to show %q", want)
+		}
+	}
+}
+
+func TestHistoryModel_ViewConfidenceBreakdown(t *testing.T) {
+	report := createTestHistoryReport()
+	h := NewHistoryModel(report, testTheme())
+	h.SetSize(140, 40)
+	id := h.SelectedBeadID()
+	hist := report.Histories[id]
+	hist.Commits[0].Breakdown = []correlation.ConfidenceFactor{
+		{Signal: correlation.SignalCoCommit, Delta: 0.95, Detail: "same commit as the status change to closed"},
+		{Signal: correlation.SignalFilePaths, Delta: -0.05, Detail: "only test files"},
+	}
+	report.Histories[id] = hist
+	h.SetReport(report)
+
+	if strings.Contains(h.View(), "only test files") {
+		t.Error("Expected the breakdown to stay hidden until a commit is selected")
+	}
+	h.ToggleFocus()
+	view := h.View()
+	for _, want := range []string{"+95%", "co-commit", "-5%", "only test files"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the selected commit's breakdown to show %q", want)
+		}
+	}
+}
+
 func TestHistoryModel_ViewEmpty(t *testing.T) {
 	theme := testTheme()
 
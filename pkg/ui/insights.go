@@ -7,6 +7,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -25,6 +26,7 @@ const (
 	PanelSlack
 	PanelCycles
 	PanelPriority // Agent-first priority recommendations
+	PanelClusters // Near-duplicate open issues from the semantic index
 	PanelCount    // Sentinel for wrapping
 )
 
@@ -130,6 +132,15 @@ var metricDescriptions = map[MetricPanel]MetricInfo{
 		HowToUse:    "Work items top to bottom. High scores = high impact. Check unblocks count.",
 		FormulaHint: "Score = Σ(PageRank + Betweenness + BlockerRatio + Staleness + Priority + TimeToImpact + Urgency + Risk)",
 	},
+	PanelClusters: {
+		Icon:        "🧩",
+		Title:       "Similar",
+		ShortDesc:   "Semantic Clusters",
+		WhatIs:      "Groups of open beads whose text embeddings are nearly identical.",
+		WhyUseful:   "Clusters are likely duplicates or fragments of one piece of work.",
+		HowToUse:    "Press enter to drill into a cluster, or d to list the closest pairs, then merge or link them.",
+		FormulaHint: "Single-linkage over pairs with cosine similarity ≥ 0.80",
+	},
 }
 
 // InsightsModel is an interactive insights dashboard
@@ -141,6 +152,9 @@ type InsightsModel struct {
 	labelAttention []analysis.LabelAttentionScore
 	labelFlow      *analysis.CrossLabelFlow
 
+	// Remaining effort along dependency chains, from history (nil until loaded)
+	chainEffort *analysis.ChainEffort
+
 	// Priority triage data (bv-91)
 	topPicks []analysis.TopPick
 
@@ -148,6 +162,17 @@ type InsightsModel struct {
 	recommendations    []analysis.Recommendation
 	recommendationMap  map[string]*analysis.Recommendation // ID -> Recommendation for quick lookup
 	triageDataHash     string                              // Hash of data used for triage
+	triageProfile      string                              // Active .bv/triage.yaml profile
+
+	// Similar-issue clusters from the semantic index
+	clusters      []search.SimilarityCluster
+	clustersReady bool
+	clustersNote  string // Shown until clusters are ready
+	clusterOpen   bool   // Drilled into the selected cluster
+	clusterMember int    // Selected member while drilled in
+	duplicates    []search.DuplicatePair
+	showPairs     bool // List the closest pairs instead of clusters
+	pairIndex     int  // Selected pair
 
 	// Navigation state
 	focusedPanel  MetricPanel
@@ -188,6 +213,11 @@ func (m *InsightsModel) SetInsights(ins analysis.Insights) {
 	m.insights = ins
 }
 
+// SetChainEffort sets the remaining effort estimates shown for keystones
+func (m *InsightsModel) SetChainEffort(ce *analysis.ChainEffort) {
+	m.chainEffort = ce
+}
+
 // SetTopPicks sets the priority triage recommendations (bv-91)
 func (m *InsightsModel) SetTopPicks(picks []analysis.TopPick) {
 	m.topPicks = picks
@@ -204,6 +234,82 @@ func (m *InsightsModel) SetRecommendations(recs []analysis.Recommendation, dataH
 	}
 }
 
+// SetTriageProfile names the triage profile the picks were scored with
+func (m *InsightsModel) SetTriageProfile(name string) {
+	m.triageProfile = name
+}
+
+// SetClusters sets the similar-issue clusters and leaves any drilldown
+func (m *InsightsModel) SetClusters(clusters []search.SimilarityCluster) {
+	m.clusters = clusters
+	m.clustersReady = true
+	m.clustersNote = ""
+	m.clusterOpen = false
+	m.clusterMember = 0
+	if m.selectedIndex[PanelClusters] >= len(clusters) {
+		m.selectedIndex[PanelClusters] = 0
+	}
+}
+
+// SetClustersNote sets the message shown while clusters are unavailable
+func (m *InsightsModel) SetClustersNote(note string) {
+	m.clustersNote = note
+}
+
+// SetDuplicates sets the possible-duplicate pairs shown by the similar card
+func (m *InsightsModel) SetDuplicates(pairs []search.DuplicatePair) {
+	m.duplicates = pairs
+	if m.pairIndex >= len(pairs) {
+		m.pairIndex = 0
+	}
+}
+
+// ToggleDuplicatePairs switches the similar card between clusters and the
+// closest pairs, focusing it
+func (m *InsightsModel) ToggleDuplicatePairs() {
+	m.focusedPanel = PanelClusters
+	m.clusterOpen = false
+	m.showPairs = !m.showPairs
+}
+
+// SelectedDuplicatePair returns the pair under the cursor while the similar
+// card lists pairs, or nil
+func (m *InsightsModel) SelectedDuplicatePair() *search.DuplicatePair {
+	if m.focusedPanel != PanelClusters || !m.showPairs || m.pairIndex >= len(m.duplicates) {
+		return nil
+	}
+	return &m.duplicates[m.pairIndex]
+}
+
+// selectedCluster returns the cluster under the cursor, if any
+func (m *InsightsModel) selectedCluster() *search.SimilarityCluster {
+	idx := m.selectedIndex[PanelClusters]
+	if idx >= 0 && idx < len(m.clusters) {
+		return &m.clusters[idx]
+	}
+	return nil
+}
+
+// OpenCluster drills into the selected cluster so its members can be
+// browsed. It reports whether the key was consumed.
+func (m *InsightsModel) OpenCluster() bool {
+	if m.focusedPanel != PanelClusters || m.showPairs || m.clusterOpen || m.selectedCluster() == nil {
+		return false
+	}
+	m.clusterOpen = true
+	m.clusterMember = 0
+	return true
+}
+
+// CloseCluster leaves the cluster drilldown, reporting whether one was open
+func (m *InsightsModel) CloseCluster() bool {
+	if !m.clusterOpen {
+		return false
+	}
+	m.clusterOpen = false
+	return true
+}
+
 // isPanelSkipped returns true and a reason if the metric for this panel was skipped
 func (m *InsightsModel) isPanelSkipped(panel MetricPanel) (bool, string) {
 	if m.insights.Stats == nil {
@@ -255,14 +361,26 @@ func (m *InsightsModel) isPanelSkipped(panel MetricPanel) (bool, string) {
 	return false, ""
 }
 
+// cursor returns the selection being moved: the focused panel's, or the
+// member index while drilled into a cluster
+func (m *InsightsModel) cursor() *int {
+	if m.focusedPanel == PanelClusters && m.showPairs {
+		return &m.pairIndex
+	}
+	if m.focusedPanel == PanelClusters && m.clusterOpen {
+		return &m.clusterMember
+	}
+	return &m.selectedIndex[m.focusedPanel]
+}
+
 // Navigation methods
 func (m *InsightsModel) MoveUp() {
 	count := m.currentPanelItemCount()
 	if count == 0 {
 		return
 	}
-	if m.selectedIndex[m.focusedPanel] > 0 {
-		m.selectedIndex[m.focusedPanel]--
+	if cur := m.cursor(); *cur > 0 {
+		*cur--
 	}
 }
 
@@ -271,16 +389,18 @@ func (m *InsightsModel) MoveDown() {
 	if count == 0 {
 		return
 	}
-	if m.selectedIndex[m.focusedPanel] < count-1 {
-		m.selectedIndex[m.focusedPanel]++
+	if cur := m.cursor(); *cur < count-1 {
+		*cur++
 	}
 }
 
 func (m *InsightsModel) NextPanel() {
+	m.clusterOpen = false
 	m.focusedPanel = (m.focusedPanel + 1) % PanelCount
 }
 
 func (m *InsightsModel) PrevPanel() {
+	m.clusterOpen = false
 	if m.focusedPanel == 0 {
 		m.focusedPanel = PanelCount - 1
 	} else {
@@ -324,6 +444,16 @@ func (m *InsightsModel) currentPanelItemCount() int {
 		return len(m.insights.Cycles)
 	case PanelPriority:
 		return len(m.topPicks)
+	case PanelClusters:
+		if m.showPairs {
+			return len(m.duplicates)
+		}
+		if m.clusterOpen {
+			if c := m.selectedCluster(); c != nil {
+				return len(c.IDs)
+			}
+		}
+		return len(m.clusters)
 	default:
 		return 0
 	}
@@ -377,6 +507,24 @@ func (m *InsightsModel) SelectedIssueID() string {
 		return ""
 	}
 
+	// For clusters, return the drilled-in member or the cluster's first issue
+	if pair := m.SelectedDuplicatePair(); pair != nil {
+		return pair.A
+	}
+	if m.focusedPanel == PanelClusters {
+		if m.showPairs {
+			return ""
+		}
+		c := m.selectedCluster()
+		if c == nil {
+			return ""
+		}
+		if m.clusterOpen && m.clusterMember < len(c.IDs) {
+			return c.IDs[m.clusterMember]
+		}
+		return c.IDs[0]
+	}
+
 	// For other panels, return selected item's ID
 	items := m.getPanelItems(m.focusedPanel)
 	idx := m.selectedIndex[m.focusedPanel]
@@ -386,6 +534,46 @@ func (m *InsightsModel) SelectedIssueID() string {
 	return ""
 }
 
+// SelectIssueByID moves the cursor to id, preferring the focused panel and
+// otherwise focusing the first panel that lists it. Cycles and clusters are
+// groups rather than issues, so they are skipped. Returns false if no panel
+// lists id.
+func (m *InsightsModel) SelectIssueByID(id string) bool {
+	panels := []MetricPanel{m.focusedPanel}
+	for p := MetricPanel(0); p < PanelCount; p++ {
+		if p != m.focusedPanel {
+			panels = append(panels, p)
+		}
+	}
+	for _, p := range panels {
+		idx := -1
+		switch p {
+		case PanelCycles, PanelClusters:
+			continue
+		case PanelPriority:
+			for i, pick := range m.topPicks {
+				if pick.ID == id {
+					idx = i
+					break
+				}
+			}
+		default:
+			for i, item := range m.getPanelItems(p) {
+				if item.ID == id {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx >= 0 {
+			m.focusedPanel = p
+			m.selectedIndex[p] = idx
+			return true
+		}
+	}
+	return false
+}
+
 // View renders the insights dashboard (pointer receiver to persist scroll state)
 func (m *InsightsModel) View() string {
 	if !m.ready {
@@ -454,14 +642,17 @@ func (m *InsightsModel) View() string {
 	row1 := lipgloss.JoinHorizontal(lipgloss.Top, panels[0], panels[1], panels[2])
 	row2 := lipgloss.JoinHorizontal(lipgloss.Top, panels[3], panels[4], panels[5])
 	row3 := lipgloss.JoinHorizontal(lipgloss.Top, panels[6], panels[7], panels[8])
-	// Priority panel spans full width for prominence (bv-91)
+	// Priority panel spans two columns for prominence (bv-91), next to
+	// the similar-issue clusters.
 	// Toggle between priority list and heatmap view (bv-95)
-	var row4 string
+	priorityWidth := mainWidth - colWidth - 4
+	var priority string
 	if m.showHeatmap {
-		row4 = m.renderHeatmapPanel(mainWidth-2, rowHeight, t)
+		priority = m.renderHeatmapPanel(priorityWidth, rowHeight, t)
 	} else {
-		row4 = m.renderPriorityPanel(mainWidth-2, rowHeight, t)
+		priority = m.renderPriorityPanel(priorityWidth, rowHeight, t)
 	}
+	row4 := lipgloss.JoinHorizontal(lipgloss.Top, priority, m.renderClustersPanel(colWidth, rowHeight, t))
 
 	mainContent := lipgloss.JoinVertical(lipgloss.Left, row1, row2, row3, row4)
 
@@ -852,6 +1043,234 @@ func (m *InsightsModel) renderCyclesPanel(width, height int, t Theme) string {
 	return panelStyle.Render(sb.String())
 }
 
+// renderClustersPanel renders clusters of similar open issues, or the members
+// of one cluster when drilled in
+func (m *InsightsModel) renderClustersPanel(width, height int, t Theme) string {
+	if m.showPairs {
+		return m.renderDuplicatePairsPanel(width, height, t)
+	}
+	info := metricDescriptions[PanelClusters]
+	isFocused := m.focusedPanel == PanelClusters
+
+	borderColor := t.Secondary
+	if isFocused {
+		borderColor = t.Primary
+	}
+
+	panelStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(width).
+		Height(height).
+		Padding(0, 1)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true)
+	if isFocused {
+		titleStyle = titleStyle.Foreground(t.Primary)
+	} else {
+		titleStyle = titleStyle.Foreground(t.Secondary)
+	}
+	subtitleStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
+
+	var sb strings.Builder
+
+	cluster := m.selectedCluster()
+	drilled := m.clusterOpen && cluster != nil
+	var headerLine string
+	switch {
+	case !m.clustersReady:
+		headerLine = fmt.Sprintf("%s %s", info.Icon, info.Title)
+	case drilled:
+		headerLine = fmt.Sprintf("%s Cluster %d/%d (%d issues)", info.Icon, m.selectedIndex[PanelClusters]+1, len(m.clusters), len(cluster.IDs))
+	default:
+		headerLine = fmt.Sprintf("%s %s (%d)", info.Icon, info.Title, len(m.clusters))
+	}
+	sb.WriteString(titleStyle.Render(truncateRunesHelper(headerLine, width-2, "…")))
+	sb.WriteString("\n")
+	switch {
+	case drilled:
+		sb.WriteString(subtitleStyle.Render(fmt.Sprintf("%.0f%% similar • esc back", cluster.MeanSimilarity*100)))
+	case m.clustersReady:
+		issues := 0
+		for _, c := range m.clusters {
+			issues += len(c.IDs)
+		}
+		sb.WriteString(subtitleStyle.Render(fmt.Sprintf("%s • %d issues", info.ShortDesc, issues)))
+	default:
+		sb.WriteString(subtitleStyle.Render(info.ShortDesc))
+	}
+	sb.WriteString("\n")
+
+	if m.showExplanations && !drilled {
+		explainStyle := t.Renderer.NewStyle().
+			Foreground(t.Secondary).
+			Width(width - 4)
+		sb.WriteString(explainStyle.Render(info.WhatIs))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	noteStyle := t.Renderer.NewStyle().
+		Foreground(t.Subtext).
+		Italic(true).
+		Width(width - 4)
+	if !m.clustersReady {
+		note := m.clustersNote
+		if note == "" {
+			note = "Semantic index not loaded"
+		}
+		sb.WriteString(noteStyle.Render(note))
+		return panelStyle.Render(sb.String())
+	}
+	if len(m.clusters) == 0 {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Render("✓ No near-duplicates"))
+		return panelStyle.Render(sb.String())
+	}
+
+	rows := len(m.clusters)
+	selectedIdx := m.selectedIndex[PanelClusters]
+	scrollKey := PanelClusters
+	if drilled {
+		rows = len(cluster.IDs)
+		selectedIdx = m.clusterMember
+	}
+	visibleRows := height - 6
+	if m.showExplanations && !drilled {
+		visibleRows -= 2
+	}
+	if visibleRows < 3 {
+		visibleRows = 3
+	}
+
+	startIdx := 0
+	if !drilled {
+		startIdx = m.scrollOffset[scrollKey]
+	}
+	if selectedIdx >= startIdx+visibleRows {
+		startIdx = selectedIdx - visibleRows + 1
+	}
+	if selectedIdx < startIdx {
+		startIdx = selectedIdx
+	}
+	if !drilled {
+		m.scrollOffset[scrollKey] = startIdx
+	}
+	endIdx := min(startIdx+visibleRows, rows)
+
+	for i := startIdx; i < endIdx; i++ {
+		isSelected := isFocused && i == selectedIdx
+		prefix := "  "
+		if isSelected {
+			prefix = t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ ")
+		}
+		var line string
+		if drilled {
+			id := cluster.IDs[i]
+			line = id + " " + m.getBeadTitle(id, width)
+		} else {
+			c := m.clusters[i]
+			line = fmt.Sprintf("%d× %s", len(c.IDs), m.getBeadTitle(c.IDs[0], width))
+		}
+		rowStyle := t.Renderer.NewStyle()
+		if isSelected {
+			rowStyle = rowStyle.Bold(true)
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(rowStyle.Render(truncateRunesHelper(line, width-6, "…")))
+		sb.WriteString("\n")
+	}
+
+	if rows > visibleRows {
+		scrollStyle := t.Renderer.NewStyle().
+			Foreground(t.Subtext).
+			Align(lipgloss.Center).
+			Width(width - 4)
+		sb.WriteString(scrollStyle.Render(fmt.Sprintf("↕ %d/%d", selectedIdx+1, rows)))
+	}
+
+	return panelStyle.Render(sb.String())
+}
+
+// renderDuplicatePairsPanel renders the similar card's list of the closest
+// issue pairs
+func (m *InsightsModel) renderDuplicatePairsPanel(width, height int, t Theme) string {
+	info := metricDescriptions[PanelClusters]
+	isFocused := m.focusedPanel == PanelClusters
+
+	borderColor := t.Secondary
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	if isFocused {
+		borderColor = t.Primary
+		titleStyle = titleStyle.Foreground(t.Primary)
+	}
+	panelStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Width(width).
+		Height(height).
+		Padding(0, 1)
+	subtitleStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
+
+	var sb strings.Builder
+	header := fmt.Sprintf("%s Possible Duplicates", info.Icon)
+	if m.clustersReady {
+		header = fmt.Sprintf("%s (%d)", header, len(m.duplicates))
+	}
+	sb.WriteString(titleStyle.Render(truncateRunesHelper(header, width-2, "…")))
+	sb.WriteString("\n")
+	sb.WriteString(subtitleStyle.Render("Closest pairs • = compare • d clusters"))
+	sb.WriteString("\n\n")
+
+	if !m.clustersReady {
+		note := m.clustersNote
+		if note == "" {
+			note = "Semantic index not loaded"
+		}
+		sb.WriteString(subtitleStyle.Width(width - 4).Render(note))
+		return panelStyle.Render(sb.String())
+	}
+	if len(m.duplicates) == 0 {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Render("✓ No near-duplicates"))
+		return panelStyle.Render(sb.String())
+	}
+
+	// Each pair takes two lines: score + first title, then the second title
+	visiblePairs := max(1, (height-5)/2)
+	startIdx := m.scrollOffset[PanelClusters]
+	if m.pairIndex >= startIdx+visiblePairs {
+		startIdx = m.pairIndex - visiblePairs + 1
+	}
+	if m.pairIndex < startIdx {
+		startIdx = m.pairIndex
+	}
+	m.scrollOffset[PanelClusters] = startIdx
+	endIdx := min(startIdx+visiblePairs, len(m.duplicates))
+
+	for i := startIdx; i < endIdx; i++ {
+		pair := m.duplicates[i]
+		isSelected := isFocused && i == m.pairIndex
+		prefix := "  "
+		rowStyle := t.Renderer.NewStyle()
+		if isSelected {
+			prefix = t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ ")
+			rowStyle = rowStyle.Bold(true)
+		}
+		first := fmt.Sprintf("%3.0f%% %s", pair.Similarity*100, m.getBeadTitle(pair.A, width))
+		sb.WriteString(prefix + rowStyle.Render(truncateRunesHelper(first, width-6, "…")) + "\n")
+		second := "   ↔ " + m.getBeadTitle(pair.B, width)
+		sb.WriteString("  " + subtitleStyle.Render(truncateRunesHelper(second, width-6, "…")) + "\n")
+	}
+	if len(m.duplicates) > visiblePairs {
+		scrollStyle := t.Renderer.NewStyle().
+			Foreground(t.Subtext).
+			Align(lipgloss.Center).
+			Width(width - 4)
+		sb.WriteString(scrollStyle.Render(fmt.Sprintf("↕ %d/%d", m.pairIndex+1, len(m.duplicates))))
+	}
+
+	return panelStyle.Render(sb.String())
+}
+
 // renderPriorityPanel renders the priority recommendations panel (bv-91)
 func (m *InsightsModel) renderPriorityPanel(width, height int, t Theme) string {
 	info := metricDescriptions[PanelPriority]
@@ -886,7 +1305,11 @@ func (m *InsightsModel) renderPriorityPanel(width, height int, t Theme) string {
 
 	// Inline subtitle for horizontal layout
 	subtitleStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
-	sb.WriteString(subtitleStyle.Render(info.ShortDesc))
+	subtitle := info.ShortDesc
+	if m.triageProfile != "" {
+		subtitle += " · profile: " + m.triageProfile + " (r re-scores)"
+	}
+	sb.WriteString(subtitleStyle.Render(subtitle))
 	sb.WriteString("\n")
 
 	if len(picks) == 0 {
@@ -1572,6 +1995,21 @@ func (m *InsightsModel) renderCalculationProof(selectedID string, width int, t T
 		sb.WriteString(labelStyle.Render(" levels deep"))
 		sb.WriteString("\n\n")
 
+		// Estimated remaining heaviness from history, not just chain length
+		if m.chainEffort != nil {
+			if e, ok := m.chainEffort.Chain[selectedID]; ok && m.chainEffort.Samples > 0 {
+				sb.WriteString(labelStyle.Render("Remaining heaviness: "))
+				sb.WriteString(valueStyle.Render(formatEffort(e)))
+				sb.WriteString("\n")
+				heaviest := m.chainEffort.HeaviestChain(selectedID)
+				sb.WriteString(subStyle.Render(wrapText(fmt.Sprintf("Heaviest chain of %d open issues, estimated from %d closed issues with commits.", len(heaviest), m.chainEffort.Samples), width)))
+				sb.WriteString("\n\n")
+			} else if m.chainEffort.Samples == 0 {
+				sb.WriteString(subStyle.Render(wrapText("No closed issues with correlated commits yet, so remaining heaviness can't be estimated.", width)))
+				sb.WriteString("\n\n")
+			}
+		}
+
 		// Show the chain of dependents
 		chain := m.buildImpactChain(selectedID, int(impact))
 		if len(chain) > 0 {
@@ -1698,6 +2136,33 @@ func (m *InsightsModel) renderCalculationProof(selectedID string, width int, t T
 			sb.WriteString("\n")
 			sb.WriteString(subStyle.Render(wrapText("These beads form a circular dependency. Break the cycle by removing or reversing one edge.", width)))
 		}
+
+	case PanelClusters:
+		// Pairs: show both sides; clusters: every member, marking the selected one
+		if pair := m.SelectedDuplicatePair(); pair != nil {
+			sb.WriteString(labelStyle.Render("Possible duplicates, "))
+			sb.WriteString(valueStyle.Render(fmt.Sprintf("%.0f%%", pair.Similarity*100)))
+			sb.WriteString(labelStyle.Render(" similar:"))
+			sb.WriteString("\n")
+			for _, id := range []string{pair.A, pair.B} {
+				sb.WriteString(itemStyle.Render(fmt.Sprintf("  • %s\n", m.getBeadTitle(id, width-6))))
+			}
+			sb.WriteString("\n")
+			sb.WriteString(subStyle.Render(wrapText("Enter marks both and opens the first; U then merges them. = compares them side by side.", width)))
+			sb.WriteString("\n")
+		} else if c := m.selectedCluster(); c != nil && !m.showPairs {
+			sb.WriteString(labelStyle.Render(fmt.Sprintf("Cluster of %d beads, ", len(c.IDs))))
+			sb.WriteString(valueStyle.Render(fmt.Sprintf("%.0f%%", c.MeanSimilarity*100)))
+			sb.WriteString(labelStyle.Render(" mean similarity:"))
+			sb.WriteString("\n")
+			for _, id := range c.IDs {
+				marker := "•"
+				if id == selectedID {
+					marker = "▸"
+				}
+				sb.WriteString(itemStyle.Render(fmt.Sprintf("  %s %s\n", marker, m.getBeadTitle(id, width-6))))
+			}
+		}
 	}
 
 	sb.WriteString("\n")
@@ -1,10 +1,12 @@
 package ui_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
 )
 
@@ -581,3 +583,76 @@ func TestInsightsModelScrolling(t *testing.T) {
 		_ = m.View()
 	}
 }
+
+// TestInsightsModelClustersPanel verifies the similar-issue clusters card and its drilldown
+func TestInsightsModelClustersPanel(t *testing.T) {
+	theme := createTheme()
+	m := ui.NewInsightsModel(createTestInsights(), createTestIssueMap(), theme)
+	m.SetSize(180, 60)
+
+	m.SetClustersNote("Building semantic index…")
+	if view := m.View(); !strings.Contains(view, "Building semantic index") {
+		t.Error("Expected the clusters note before clusters are ready")
+	}
+
+	m.SetClusters([]search.SimilarityCluster{
+		{IDs: []string{"core-1", "core-2", "art-1"}, MeanSimilarity: 0.9},
+		{IDs: []string{"slack-1", "slack-2"}, MeanSimilarity: 0.85},
+	})
+	// Clusters sit after the priority panel (10 NextPanels from start)
+	for i := 0; i < 10; i++ {
+		m.NextPanel()
+	}
+	if id := m.SelectedIssueID(); id != "core-1" {
+		t.Errorf("Expected core-1 (first of largest cluster), got %s", id)
+	}
+	m.MoveDown()
+	if id := m.SelectedIssueID(); id != "slack-1" {
+		t.Errorf("Expected slack-1 (first of second cluster), got %s", id)
+	}
+	if !m.OpenCluster() {
+		t.Fatal("OpenCluster should drill into the selected cluster")
+	}
+	m.MoveDown()
+	m.MoveDown() // Stays on the last member
+	if id := m.SelectedIssueID(); id != "slack-2" {
+		t.Errorf("Expected slack-2, got %s", id)
+	}
+	if view := m.View(); !strings.Contains(view, "Cluster 2/2") {
+		t.Error("Drilldown should show which cluster is open")
+	}
+
+	// Switching panels leaves the drilldown
+	m.PrevPanel()
+	m.NextPanel()
+	if id := m.SelectedIssueID(); id != "slack-1" {
+		t.Errorf("Expected the cluster's first issue after leaving the drilldown, got %s", id)
+	}
+	if m.CloseCluster() {
+		t.Error("No drilldown should be open")
+	}
+}
+
+// TestInsightsModelSelectIssueByID verifies selection prefers the focused panel
+func TestInsightsModelSelectIssueByID(t *testing.T) {
+	ins := createTestInsights()
+	ins.Hubs = append(ins.Hubs, analysis.InsightItem{ID: "bottleneck-2", Value: 1})
+	m := ui.NewInsightsModel(ins, createTestIssueMap(), createTheme())
+	m.SetSize(120, 40)
+
+	if !m.SelectIssueByID("bottleneck-2") || m.SelectedIssueID() != "bottleneck-2" {
+		t.Errorf("Expected bottleneck-2, got %s", m.SelectedIssueID())
+	}
+	if !m.SelectIssueByID("hub-2") || m.SelectedIssueID() != "hub-2" {
+		t.Errorf("Expected focus to move to the hubs panel, got %s", m.SelectedIssueID())
+	}
+	// Now on hubs, which also lists bottleneck-2: stay on this panel
+	m.SelectIssueByID("bottleneck-2")
+	m.NextPanel()
+	if m.SelectedIssueID() != "auth-1" {
+		t.Errorf("Expected to still be on hubs (next panel authorities), got %s", m.SelectedIssueID())
+	}
+	if m.SelectIssueByID("nowhere") {
+		t.Error("Unknown IDs should not be found")
+	}
+}
@@ -0,0 +1,251 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Kinds of session journal entries, in the order the panel lists them
+const (
+	journalNew      = "new"
+	journalClosed   = "closed"
+	journalModified = "modified"
+	journalRemoved  = "removed"
+)
+
+var journalKinds = []string{journalNew, journalClosed, journalModified, journalRemoved}
+
+// journalEntry is how one issue differs from when the session started
+type journalEntry struct {
+	IssueID string
+	Title   string
+	Kind    string
+	Fields  []analysis.FieldChange // Modified and closed issues only
+	At      time.Time              // Last reload that changed the issue
+}
+
+// snapshotIssues copies the issues by ID, so write-backs to the live issues
+// don't reach the session baseline
+func snapshotIssues(issues []model.Issue) map[string]model.Issue {
+	snapshot := make(map[string]model.Issue, len(issues))
+	for _, issue := range issues {
+		snapshot[issue.ID] = issue.Clone()
+	}
+	return snapshot
+}
+
+// recordJournal notes which issues a reload changed, comparing the issues
+// from before the reload with the new ones
+func (m *Model) recordJournal(before map[string]*model.Issue, now time.Time) {
+	if m.journalChangedAt == nil {
+		m.journalChangedAt = make(map[string]time.Time)
+	}
+	m.journalReloads++
+	for id, issue := range m.issueMap {
+		old := before[id]
+		if old == nil || len(analysis.DetectChanges(*old, *issue)) > 0 {
+			m.journalChangedAt[id] = now
+		}
+	}
+	for id := range before {
+		if m.issueMap[id] == nil {
+			m.journalChangedAt[id] = now
+		}
+	}
+}
+
+// sessionJournal compares the issues at launch with the current ones: new,
+// closed, modified and removed issues, each kind most recent first
+func sessionJournal(baseline map[string]model.Issue, current map[string]*model.Issue, changedAt map[string]time.Time) []journalEntry {
+	var entries []journalEntry
+	for id, issue := range current {
+		old, existed := baseline[id]
+		if !existed {
+			entries = append(entries, journalEntry{IssueID: id, Title: issue.Title, Kind: journalNew, At: changedAt[id]})
+			continue
+		}
+		fields := analysis.DetectChanges(old, *issue)
+		if len(fields) == 0 {
+			continue
+		}
+		kind := journalModified
+		if issue.Status == model.StatusClosed && old.Status != model.StatusClosed {
+			kind = journalClosed
+		}
+		entries = append(entries, journalEntry{IssueID: id, Title: issue.Title, Kind: kind, Fields: fields, At: changedAt[id]})
+	}
+	for id, old := range baseline {
+		if current[id] == nil {
+			entries = append(entries, journalEntry{IssueID: id, Title: old.Title, Kind: journalRemoved, At: changedAt[id]})
+		}
+	}
+
+	rank := make(map[string]int, len(journalKinds))
+	for i, kind := range journalKinds {
+		rank[kind] = i
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Kind != b.Kind {
+			return rank[a.Kind] < rank[b.Kind]
+		}
+		if !a.At.Equal(b.At) {
+			return a.At.After(b.At)
+		}
+		return a.IssueID < b.IssueID
+	})
+	return entries
+}
+
+// openJournal shows what changed since the session started
+func (m *Model) openJournal() {
+	m.journalEntries = sessionJournal(m.journalBaseline, m.issueMap, m.journalChangedAt)
+	m.journalCursor = 0
+	m.showJournal = true
+}
+
+// handleJournalKeys handles keys while the session journal is open
+func (m Model) handleJournalKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.journalCursor < len(m.journalEntries)-1 {
+			m.journalCursor++
+		}
+	case "k", "up":
+		if m.journalCursor > 0 {
+			m.journalCursor--
+		}
+	case "g", "home":
+		m.journalCursor = 0
+	case "G", "end":
+		m.journalCursor = max(0, len(m.journalEntries)-1)
+	case "enter":
+		if m.journalCursor < len(m.journalEntries) {
+			e := m.journalEntries[m.journalCursor]
+			if e.Kind != journalRemoved {
+				m.showJournal = false
+				m.jumpToIssue(e.IssueID)
+			}
+		}
+	case "esc", "q", "f5":
+		m.showJournal = false
+	}
+	return m
+}
+
+// journalSummary counts the entries of each kind, e.g. "2 new, 1 closed"
+func journalSummary(entries []journalEntry) string {
+	counts := make(map[string]int)
+	for _, e := range entries {
+		counts[e.Kind]++
+	}
+	var parts []string
+	for _, kind := range journalKinds {
+		if counts[kind] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[kind], kind))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// renderJournal renders the session journal, grouped by kind, keeping the
+// selected entry in view
+func (m Model) renderJournal() string {
+	t := m.theme
+	boxWidth := min(100, m.width-4)
+	textWidth := boxWidth - 6
+	bodyHeight := max(5, m.height-11)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	sectionStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	selectedStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	kindStyles := map[string]lipgloss.Style{
+		journalNew:      t.Renderer.NewStyle().Foreground(t.Open),
+		journalClosed:   t.Renderer.NewStyle().Foreground(t.Closed),
+		journalModified: t.Renderer.NewStyle().Foreground(t.InProgress),
+		journalRemoved:  t.Renderer.NewStyle().Foreground(t.Blocked),
+	}
+
+	var lines []string
+	selectedLine := 0
+	kind := ""
+	for i, e := range m.journalEntries {
+		if e.Kind != kind {
+			kind = e.Kind
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, kindStyles[kind].Bold(true).Render(strings.ToUpper(kind)))
+		}
+		cursor := "  "
+		style := t.Renderer.NewStyle()
+		if i == m.journalCursor {
+			cursor = "▸ "
+			style = selectedStyle
+			selectedLine = len(lines)
+		}
+		when := ""
+		if !e.At.IsZero() {
+			when = "  " + e.At.Format("15:04")
+		}
+		detail := ""
+		if e.Kind == journalModified || e.Kind == journalClosed {
+			names := make([]string, len(e.Fields))
+			for j, f := range e.Fields {
+				names[j] = f.Field
+			}
+			detail = " · " + strings.Join(names, ", ")
+		}
+		text := truncateRunesHelper(fmt.Sprintf("%s  %s", e.IssueID, e.Title), textWidth-lipgloss.Width(when)-lipgloss.Width(detail)-2, "…")
+		lines = append(lines, style.Render(cursor+text)+mutedStyle.Render(detail+when))
+	}
+
+	start := 0
+	if selectedLine >= bodyHeight {
+		start = selectedLine - bodyHeight + 1
+	}
+	end := min(start+bodyHeight, len(lines))
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("📓 Session Journal"))
+	sb.WriteString("\n")
+	reloads := "1 reload"
+	if m.journalReloads != 1 {
+		reloads = fmt.Sprintf("%d reloads", m.journalReloads)
+	}
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("Since %s · %s", m.journalStart.Format("Mon 15:04"), reloads)))
+	sb.WriteString("\n\n")
+	if len(m.journalEntries) == 0 {
+		sb.WriteString(mutedStyle.Italic(true).Render("Nothing has changed since the session started."))
+	} else {
+		sb.WriteString(sectionStyle.Render(journalSummary(m.journalEntries)))
+		sb.WriteString("\n\n")
+		sb.WriteString(strings.Join(lines[start:end], "\n"))
+	}
+	sb.WriteString("\n\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: select • enter: open issue • esc: close"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSessionJournal(t *testing.T) {
+	early, late := time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC), time.Date(2026, 5, 10, 11, 0, 0, 0, time.UTC)
+	baseline := snapshotIssues([]model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 2},
+		{ID: "D", Title: "Delta", Status: model.StatusOpen},
+		{ID: "E", Title: "Echo", Status: model.StatusOpen},
+	})
+	current := map[string]*model.Issue{
+		"A": {ID: "A", Title: "Alpha", Status: model.StatusOpen},
+		"B": {ID: "B", Title: "Beta", Status: model.StatusClosed},
+		"C": {ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 0},
+		"E": {ID: "E", Title: "Echo", Status: model.StatusInProgress},
+		"F": {ID: "F", Title: "Foxtrot", Status: model.StatusOpen},
+	}
+	changedAt := map[string]time.Time{"A": late, "C": early, "E": late}
+	var got []string
+	for _, e := range sessionJournal(baseline, current, changedAt) {
+		got = append(got, e.Kind+":"+e.IssueID)
+	}
+	// A changed and changed back, so it isn't listed
+	want := "new:F,closed:B,modified:E,modified:C,removed:D"
+	if strings.Join(got, ",") != want {
+		t.Errorf("journal = %s, want %s", strings.Join(got, ","), want)
+	}
+}
+
+func TestSessionJournal_AcrossReloads(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"id":"ONE","title":"One","status":"open","issue_type":"task","priority":2}
+{"id":"TWO","title":"Two","status":"open","issue_type":"task","priority":2}
+`)
+	m := NewModel([]model.Issue{
+		{ID: "ONE", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2},
+		{ID: "TWO", Title: "Two", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2},
+	}, nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	reload := func(data string) {
+		t.Helper()
+		write(data)
+		updated, _ := m.Update(FileChangedMsg{})
+		m = updated.(Model)
+	}
+	reload(`{"id":"ONE","title":"One","status":"closed","issue_type":"task","priority":2}
+{"id":"TWO","title":"Two","status":"open","issue_type":"task","priority":2}
+{"id":"NEW","title":"Fresh","status":"open","issue_type":"task","priority":2}
+`)
+	reload(`{"id":"ONE","title":"One","status":"closed","issue_type":"task","priority":2}
+{"id":"TWO","title":"Two, renamed","status":"open","issue_type":"task","priority":2}
+{"id":"NEW","title":"Fresh","status":"open","issue_type":"task","priority":2}
+`)
+
+	m = press(m, "f5")
+	if !m.showJournal || journalSummary(m.journalEntries) != "1 new, 1 closed, 1 modified" {
+		t.Fatalf("journal = %+v", m.journalEntries)
+	}
+	view := m.View()
+	for _, want := range []string{"Session Journal", "2 reloads", "NEW  Fresh", "TWO  Two, renamed · title"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the journal to show %q", want)
+		}
+	}
+
+	m = press(m, "j", "enter")
+	if m.showJournal || selectedID(m) != "ONE" {
+		t.Errorf("Expected enter to open ONE, got %q", selectedID(m))
+	}
+}
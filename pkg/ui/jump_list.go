@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// jumpListEntry is one recently viewed issue in the jump list
+type jumpListEntry struct {
+	visit RecentVisit
+	issue *model.Issue
+}
+
+// JumpListModel represents the recently-viewed overlay, ranked by frecency
+type JumpListModel struct {
+	entries       []jumpListEntry
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewJumpListModel creates a jump list from ranked visits. Issues that no
+// longer exist are skipped, and the selection starts on the first issue other
+// than current so enter jumps back.
+func NewJumpListModel(ranked []RecentVisit, issueMap map[string]*model.Issue, current string, theme Theme) JumpListModel {
+	m := JumpListModel{theme: theme}
+	for _, v := range ranked {
+		if issue, ok := issueMap[v.ID]; ok {
+			m.entries = append(m.entries, jumpListEntry{visit: v, issue: issue})
+		}
+	}
+	if len(m.entries) > 1 && m.entries[0].visit.ID == current {
+		m.selectedIndex = 1
+	}
+	return m
+}
+
+// SetSize updates the overlay dimensions
+func (m *JumpListModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *JumpListModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *JumpListModel) MoveDown() {
+	if m.selectedIndex < len(m.entries)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedID returns the ID of the selected issue, or "" if the list is empty
+func (m *JumpListModel) SelectedID() string {
+	if m.selectedIndex >= len(m.entries) {
+		return ""
+	}
+	return m.entries[m.selectedIndex].visit.ID
+}
+
+// IDAt returns the ID shown with number n (1-based), or ""
+func (m *JumpListModel) IDAt(n int) string {
+	if n < 1 || n > len(m.entries) {
+		return ""
+	}
+	return m.entries[n-1].visit.ID
+}
+
+// View renders the jump list overlay
+func (m *JumpListModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 72
+	if m.width < 82 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 40 {
+		boxWidth = 40
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("Recently Viewed"))
+	lines = append(lines, "")
+
+	metaStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary)
+
+	if len(m.entries) == 0 {
+		lines = append(lines, metaStyle.Italic(true).Render("No recently viewed issues yet. Open an issue to start the list."))
+	}
+
+	// Keep the selection on screen; title, footer, border, and padding take 10 rows
+	maxRows := m.height - 10
+	if maxRows < 3 {
+		maxRows = 3
+	}
+	start := 0
+	if m.selectedIndex >= maxRows {
+		start = m.selectedIndex - maxRows + 1
+	}
+	end := start + maxRows
+	if end > len(m.entries) {
+		end = len(m.entries)
+	}
+
+	for i := start; i < end; i++ {
+		e := m.entries[i]
+		isSelected := i == m.selectedIndex
+
+		prefix := "  "
+		if isSelected {
+			prefix = "▸ "
+		}
+		number := "  "
+		if i < 9 {
+			number = fmt.Sprintf("%d ", i+1)
+		}
+
+		visits := "1 visit"
+		if e.visit.Visits != 1 {
+			visits = fmt.Sprintf("%d visits", e.visit.Visits)
+		}
+		meta := fmt.Sprintf("%s · %s", visits, FormatTimeRel(e.visit.LastViewed))
+
+		nameStyle := t.Renderer.NewStyle()
+		if isSelected {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		} else {
+			nameStyle = nameStyle.Foreground(t.Base.GetForeground())
+		}
+		left := prefix + number + e.visit.ID + "  "
+		titleWidth := boxWidth - 6 - lipgloss.Width(left) - lipgloss.Width(meta) - 2
+		if titleWidth < 8 {
+			titleWidth = 8
+		}
+		title := truncateRunesHelper(e.issue.Title, titleWidth, "…")
+		pad := titleWidth - lipgloss.Width(title)
+		if pad < 0 {
+			pad = 0
+		}
+		lines = append(lines, nameStyle.Render(left+title)+strings.Repeat(" ", pad+2)+metaStyle.Render(meta))
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("j/k: move • 1-9/enter: jump • esc: close"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
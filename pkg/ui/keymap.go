@@ -0,0 +1,715 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+)
+
+// KeymapFilename is the per-project file under .bv/ that remaps shortcuts
+const KeymapFilename = "keys.yaml"
+
+// KeyContext scopes an action to the views where its keys are read
+type KeyContext string
+
+const (
+	KeyContextGlobal     KeyContext = "global"     // Read everywhere, before view keys
+	KeyContextNav        KeyContext = "nav"        // Movement shared by all list-like views
+	KeyContextList       KeyContext = "list"       // Main issue list
+	KeyContextGraph      KeyContext = "graph"      // Dependency graph
+	KeyContextTimeline   KeyContext = "timeline"   // Gantt timeline
+	KeyContextActivity   KeyContext = "activity"   // Activity heatmap
+	KeyContextInsights   KeyContext = "insights"   // Insights dashboard
+	KeyContextHistory    KeyContext = "history"    // Bead history
+	KeyContextActionable KeyContext = "actionable" // Actionable tracks and work waves
+	KeyContextBoard      KeyContext = "board"      // Kanban board
+)
+
+// keyContextOrder lists contexts in the order they appear in keys.yaml docs
+var keyContextOrder = []KeyContext{
+	KeyContextGlobal, KeyContextNav, KeyContextList, KeyContextGraph,
+	KeyContextTimeline, KeyContextActivity, KeyContextInsights, KeyContextHistory,
+	KeyContextActionable, KeyContextBoard,
+}
+
+// KeyAction is one remappable shortcut. Handlers still match on the default
+// keys; the keymap translates remapped keys to a default before dispatch.
+type KeyAction struct {
+	Context  KeyContext
+	Name     string   // Name used in keys.yaml
+	Defaults []string // Built-in keys, as reported by tea.KeyMsg.String()
+	Section  string   // Help overlay section
+	Desc     string
+}
+
+// ID returns the qualified action name, e.g. "global.board"
+func (a KeyAction) ID() string {
+	return string(a.Context) + "." + a.Name
+}
+
+// defaultKeyActions is the registry of remappable shortcuts, in help overlay order
+var defaultKeyActions = []KeyAction{
+	// Navigation
+	{KeyContextNav, "move_down", []string{"j", "down"}, "Navigation", "Move down"},
+	{KeyContextNav, "move_up", []string{"k", "up"}, "Navigation", "Move up"},
+	{KeyContextNav, "move_left", []string{"h", "left"}, "Navigation", "Left (column, node, panel, earlier)"},
+	{KeyContextNav, "move_right", []string{"l", "right"}, "Navigation", "Right (column, node, panel, later)"},
+	{KeyContextNav, "first", []string{"home"}, "Navigation", "Go to first item"},
+	{KeyContextNav, "last", []string{"G", "end"}, "Navigation", "Go to last item"},
+	{KeyContextNav, "page_down", []string{"ctrl+d", "pgdown"}, "Navigation", "Page down"},
+	{KeyContextNav, "page_up", []string{"ctrl+u", "pgup"}, "Navigation", "Page up"},
+	{KeyContextGlobal, "switch_focus", []string{"tab"}, "Navigation", "Switch focus (split view)"},
+	{KeyContextGlobal, "shrink_list", []string{"<"}, "Navigation", "Shrink list pane (split view)"},
+	{KeyContextGlobal, "grow_list", []string{">"}, "Navigation", "Grow list pane (split view)"},
+	{KeyContextGlobal, "goto", []string{":", "#"}, "Navigation", "Go to issue by ID (ignores filters)"},
+	{KeyContextNav, "open", []string{"enter"}, "Navigation", "View details / jump to issue"},
+	{KeyContextGlobal, "back", []string{"esc"}, "Navigation", "Back / close"},
+
+	// Views
+	{KeyContextGlobal, "actionable", []string{"a"}, "Views", "Toggle Actionable view"},
+	{KeyContextGlobal, "board", []string{"b"}, "Views", "Toggle Kanban board"},
+	{KeyContextGlobal, "graph", []string{"g"}, "Views", "Toggle Graph view"},
+	{KeyContextGlobal, "history", []string{"H"}, "Views", "Toggle History view"},
+	{KeyContextGlobal, "insights", []string{"i"}, "Views", "Toggle Insights dashboard"},
+	{KeyContextGlobal, "sprint", []string{"P"}, "Views", "Toggle Sprint dashboard"},
+	{KeyContextGlobal, "timeline", []string{"Y"}, "Views", "Toggle Timeline (Gantt) view"},
+	{KeyContextGlobal, "activity", []string{"D"}, "Views", "Toggle Activity heatmap + stale issues"},
+	{KeyContextGlobal, "labels", []string{"L"}, "Views", "Label health dashboard"},
+	{KeyContextGlobal, "label_picker", []string{"l"}, "Views", "Filter by label (picker)"},
+	{KeyContextGlobal, "attention", []string{"A"}, "Views", "Label attention scores"},
+	{KeyContextGlobal, "flow", []string{"F"}, "Views", "Cross-label flow matrix"},
+	{KeyContextGlobal, "alerts", []string{"!"}, "Views", "Alerts panel"},
+	{KeyContextGlobal, "validation", []string{"X"}, "Views", "Validation panel (schema problems in the beads file)"},
+	{KeyContextGlobal, "baselines", []string{"ctrl+b"}, "Views", "Baselines: compare current metrics with stored snapshots"},
+	{KeyContextGlobal, "repair_deps", []string{"ctrl+r"}, "Views", "Repair dependencies on missing issues"},
+	{KeyContextGlobal, "cycle_break", []string{"ctrl+x"}, "Views", "Break dependency cycles (review suggested cuts)"},
+	{KeyContextGlobal, "bottlenecks", []string{"I"}, "Views", "Bottlenecks (issues holding the graph together)"},
+	{KeyContextGlobal, "scrubber", []string{"Z"}, "Views", "History scrubber (backlog health week by week)"},
+	{KeyContextGlobal, "lead_time", []string{"ctrl+t"}, "Views", "Lead and cycle time analytics"},
+	{KeyContextGlobal, "triage_session", []string{"z"}, "Views", "Triage session: walk untriaged issues one by one (inbox zero)"},
+	{KeyContextGlobal, "recipes", []string{"R"}, "Views", "Open Recipe picker"},
+	{KeyContextGlobal, "repo_filter", []string{"w"}, "Views", "Repo filter (workspace mode)"},
+	{KeyContextGlobal, "repo_dashboard", []string{"ctrl+w"}, "Views", "Repo health comparison (workspace mode)"},
+	{KeyContextGlobal, "theme_picker", []string{"V"}, "Views", "Theme picker (live preview)"},
+	{KeyContextGlobal, "jump_list", []string{"ctrl+o"}, "Views", "Recently viewed issues (jump list)"},
+	{KeyContextGlobal, "paste_jump", []string{"ctrl+v"}, "Views", "Jump to the issue ID or tracker URL on the clipboard"},
+	{KeyContextGlobal, "help", []string{"?", "f1"}, "Views", "Toggle this help"},
+	{KeyContextGlobal, "sidebar", []string{"f2"}, "Views", "Toggle shortcuts sidebar"},
+	{KeyContextGlobal, "announce", []string{"f3"}, "Views", "Toggle announce line (plain-text focus and selection, for screen readers)"},
+	{KeyContextGlobal, "columns", []string{"f4"}, "Views", "List columns: choose, order and size"},
+
+	// Graph
+	{KeyContextGraph, "scroll_left", []string{"H"}, "Graph View", "Scroll canvas left"},
+	{KeyContextGraph, "scroll_right", []string{"L"}, "Graph View", "Scroll canvas right"},
+	{KeyContextGraph, "clusters", []string{"c"}, "Graph View", "Group nodes by cluster (workstream) and show the cluster summary"},
+	{KeyContextGraph, "focus", []string{"f"}, "Graph View", "Focus mode: show only the selected node's neighborhood (again to exit)"},
+	{KeyContextGraph, "focus_wider", []string{"+", "="}, "Graph View", "Focus mode: one more hop"},
+	{KeyContextGraph, "focus_narrower", []string{"-"}, "Graph View", "Focus mode: one hop fewer"},
+	{KeyContextGraph, "hide_closed", []string{"x"}, "Graph View", "Hide/show closed issues"},
+	{KeyContextGraph, "hide_links", []string{"e"}, "Graph View", "Hide/show non-blocking edges (related, parent-child)"},
+	{KeyContextGraph, "export_svg", []string{"s"}, "Graph View", "Export the shown graph as SVG"},
+	{KeyContextGraph, "export_png", []string{"S"}, "Graph View", "Export the shown graph as PNG"},
+	{KeyContextGraph, "jump", []string{"/"}, "Graph View", "Jump to a node by ID"},
+	{KeyContextGraph, "layout", []string{"o"}, "Graph View", "Cycle layout: ego, layered, radial, force-directed"},
+
+	// Timeline
+	{KeyContextTimeline, "zoom_in", []string{"+", "="}, "Timeline View", "Zoom in (month → week → day)"},
+	{KeyContextTimeline, "zoom_out", []string{"-", "_"}, "Timeline View", "Zoom out"},
+	{KeyContextTimeline, "critical_only", []string{"c"}, "Timeline View", "Show critical path only"},
+
+	// Activity
+	{KeyContextActivity, "cycle_mode", []string{"c"}, "Activity View", "Count created / closed / both"},
+
+	// Insights
+	{KeyContextInsights, "explanations", []string{"e"}, "Insights Panel", "Toggle explanations"},
+	{KeyContextInsights, "calculation", []string{"x"}, "Insights Panel", "Toggle calculation details"},
+	{KeyContextInsights, "rescore", []string{"r"}, "Insights Panel", "Re-score triage with .bv/triage.yaml (reloads the weights)"},
+	{KeyContextInsights, "copy_picks", []string{"C"}, "Insights Panel", "Copy triage top picks as a Markdown table"},
+
+	// History
+	{KeyContextHistory, "next_commit", []string{"J"}, "History View", "Next commit in bead"},
+	{KeyContextHistory, "prev_commit", []string{"K"}, "History View", "Previous commit in bead"},
+	{KeyContextHistory, "copy_sha", []string{"y"}, "History View", "Copy commit SHA"},
+	{KeyContextHistory, "confidence", []string{"c"}, "History View", "Cycle confidence filter"},
+
+	// Actionable
+	{KeyContextActionable, "waves", []string{"s"}, "Actionable View", "Toggle tracks / work waves schedule"},
+	{KeyContextActionable, "copy_plan", []string{"C"}, "Actionable View", "Copy the plan (or waves) as a Markdown table"},
+
+	// Board
+	{KeyContextBoard, "move_card", []string{" "}, "Kanban Board", "Move card: h/l pick a column, enter drops, esc cancels"},
+	{KeyContextBoard, "swimlanes", []string{"s"}, "Kanban Board", "Cycle swimlanes: none, assignee, priority, epic"},
+	{KeyContextBoard, "next_lane", []string{"J"}, "Kanban Board", "Next swimlane"},
+	{KeyContextBoard, "prev_lane", []string{"K"}, "Kanban Board", "Previous swimlane"},
+
+	// Filters
+	{KeyContextList, "filter_open", []string{"o"}, "Filters", "Show Open issues"},
+	{KeyContextList, "filter_closed", []string{"c"}, "Filters", "Show Closed issues"},
+	{KeyContextList, "filter_ready", []string{"r"}, "Filters", "Show Ready (unblocked)"},
+	{KeyContextList, "filter_all", []string{"a"}, "Filters", "Show All issues"},
+	{KeyContextList, "filter_snoozed", []string{"x"}, "Filters", "Show snoozed issues (hidden elsewhere until their date)"},
+	{KeyContextList, "filter_starred", []string{"*"}, "Filters", "Show starred issues (your watchlist)"},
+	{KeyContextList, "pop_filter", []string{"backspace"}, "Filters", "Remove the most recent filter (repo, recipe, label, search)"},
+	{KeyContextList, "search", []string{"/"}, "Filters", "Fuzzy search (or query: status:open AND p<=1)"},
+	{KeyContextList, "semantic_search", []string{"ctrl+s"}, "Filters", "Toggle semantic search mode"},
+
+	// General
+	{KeyContextList, "time_travel", []string{"t"}, "General", "Time-travel (custom revision)"},
+	{KeyContextList, "time_travel_quick", []string{"T"}, "General", "Time-travel (HEAD~5)"},
+	{KeyContextGlobal, "export", []string{"E"}, "General", "Export to Markdown (all, view, label, epic or marked)"},
+	{KeyContextList, "copy", []string{"C"}, "General", "Copy issue (or marked issues) to clipboard; in plan, insights, alerts and labels, the view"},
+	{KeyContextList, "mark", []string{" "}, "General", "Mark/unmark issue for bulk actions"},
+	{KeyContextList, "mark_all", []string{"ctrl+a"}, "General", "Mark/unmark all visible issues"},
+	{KeyContextList, "marked_only", []string{"M"}, "General", "Show only marked issues"},
+	{KeyContextList, "merge", []string{"U"}, "General", "Merge two marked issues (supersede one)"},
+	{KeyContextList, "compare", []string{"="}, "General", "Compare two marked issues (or issue and parent) side by side"},
+	{KeyContextList, "open_editor", []string{"O"}, "General", "Open in editor"},
+	{KeyContextList, "cycle_sort", []string{"s"}, "General", "Cycle sort: priority, created, updated, impact, pagerank, triage"},
+	{KeyContextList, "reverse_sort", []string{"~"}, "General", "Reverse sort direction"},
+	{KeyContextList, "triage_sort", []string{"S"}, "General", "Sort by triage score"},
+	{KeyContextList, "pin", []string{"^"}, "General", "Pin epic (list) / label (labels) to footer"},
+	{KeyContextList, "star", []string{"+"}, "General", "Star/unstar the issue (watchlist, leads the top picks)"},
+	{KeyContextList, "snooze", []string{"u"}, "General", "Snooze the selected issue until a date (again to wake it)"},
+	{KeyContextList, "subscribe", []string{"B"}, "General", "Subscribe to changes of the issue (reported on reload)"},
+	{KeyContextList, "field_history", []string{"f"}, "General", "Field history of the issue (from git)"},
+	{KeyContextGlobal, "changes", []string{"N"}, "General", "Changes to subscribed and starred issues since launch"},
+	{KeyContextGlobal, "journal", []string{"f5"}, "General", "Session journal: new, closed and modified issues since launch"},
+	{KeyContextGlobal, "ask", []string{"Q"}, "General", "Ask the backlog a question (needs BV_ASK_COMMAND)"},
+	{KeyContextGlobal, "priority_hints", []string{"p"}, "General", "Toggle priority hints"},
+	{KeyContextGlobal, "work_session", []string{"W"}, "General", "Start/stop work session on issue"},
+	{KeyContextGlobal, "comment", []string{"m"}, "General", "Add a comment to the selected issue"},
+	{KeyContextGlobal, "quit", []string{"q"}, "General", "Back / Quit"},
+}
+
+// mutatingActions write to the project or launch an editor. In read-only
+// mode they are refused before their handlers open prompts; anything that
+// gets past this still fails at the write itself (see pkg/readonly).
+var mutatingActions = map[string]bool{
+	"global.repair_deps":  true,
+	"global.export":       true,
+	"global.work_session": true,
+	"global.comment":      true,
+	"list.merge":          true,
+	"list.open_editor":    true,
+	"list.pin":            true,
+	"list.subscribe":      true,
+	"list.snooze":         true,
+	"list.star":           true,
+	"board.move_card":     true,
+}
+
+// reservedKeys can't be remapped, so there is always a way out
+var reservedKeys = map[string]bool{"ctrl+c": true}
+
+// keyTypesByName maps bubbletea key names ("enter", "ctrl+d", ...) back to key types
+var keyTypesByName = func() map[string]tea.KeyType {
+	names := make(map[string]tea.KeyType)
+	for kt := tea.KeyType(-128); kt < 256; kt++ {
+		if kt == tea.KeyRunes {
+			continue
+		}
+		if s := kt.String(); s != "" {
+			if _, seen := names[s]; !seen {
+				names[s] = kt
+			}
+		}
+	}
+	return names
+}()
+
+// keyAliases are friendlier spellings accepted in keys.yaml
+var keyAliases = map[string]string{
+	"space":    " ",
+	"escape":   "esc",
+	"return":   "enter",
+	"pagedown": "pgdown",
+	"pageup":   "pgup",
+}
+
+// normalizeKey converts a keys.yaml key to the form tea.KeyMsg.String() reports
+func normalizeKey(key string) (string, error) {
+	if key == " " {
+		return key, nil
+	}
+	k := strings.TrimSpace(key)
+	if k == "" {
+		return "", fmt.Errorf("empty key")
+	}
+	if len([]rune(k)) == 1 {
+		return k, nil
+	}
+	lower := strings.ToLower(k)
+	if alias, ok := keyAliases[lower]; ok {
+		return alias, nil
+	}
+	base := strings.TrimPrefix(lower, "alt+")
+	if len([]rune(base)) == 1 {
+		// Keep the rune's case: alt+J and alt+j differ
+		return "alt+" + k[len(k)-len(base):], nil
+	}
+	if _, ok := keyTypesByName[base]; ok {
+		return lower, nil
+	}
+	return "", fmt.Errorf("unknown key %q", key)
+}
+
+// keyMsgFromString builds the tea.KeyMsg that would report the given key string
+func keyMsgFromString(key string) tea.KeyMsg {
+	alt := false
+	if len(key) > len("alt+") && strings.HasPrefix(key, "alt+") {
+		alt = true
+		key = strings.TrimPrefix(key, "alt+")
+	}
+	if key == " " {
+		return tea.KeyMsg{Type: tea.KeySpace, Alt: alt}
+	}
+	if len([]rune(key)) > 1 {
+		if kt, ok := keyTypesByName[key]; ok {
+			return tea.KeyMsg{Type: kt, Alt: alt}
+		}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key), Alt: alt}
+}
+
+// keyLabel renders a key for the help overlay
+func keyLabel(key string) string {
+	switch key {
+	case " ":
+		return "Space"
+	case "down":
+		return "↓"
+	case "up":
+		return "↑"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case "pgdown":
+		return "PgDn"
+	case "pgup":
+		return "PgUp"
+	}
+	if len([]rune(key)) == 1 {
+		return key
+	}
+	if strings.HasPrefix(key, "ctrl+") {
+		return "Ctrl+" + strings.TrimPrefix(key, "ctrl+")
+	}
+	if len(key) <= 3 && key[0] == 'f' && key[1] >= '0' && key[1] <= '9' {
+		return strings.ToUpper(key)
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// KeyConflict is a key bound to several actions that can be active at once
+type KeyConflict struct {
+	Key     string
+	Actions []string // Qualified action IDs
+}
+
+func (c KeyConflict) String() string {
+	return fmt.Sprintf("%q is bound to %s", c.Key, strings.Join(c.Actions, " and "))
+}
+
+// Keymap holds the active key bindings for every action
+type Keymap struct {
+	actions  []KeyAction
+	bindings map[string][]string // action ID -> bound keys
+	custom   map[string]bool     // action IDs remapped in keys.yaml
+}
+
+// DefaultKeymap returns the built-in bindings
+func DefaultKeymap() *Keymap {
+	k := &Keymap{
+		actions:  defaultKeyActions,
+		bindings: make(map[string][]string, len(defaultKeyActions)),
+		custom:   make(map[string]bool),
+	}
+	for _, a := range k.actions {
+		k.bindings[a.ID()] = a.Defaults
+	}
+	return k
+}
+
+// keyList accepts either a single key or a list of keys in YAML (board.yaml
+// uses it for statuses and labels too)
+type keyList []string
+
+func (l *keyList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*l = keyList{node.Value}
+		return nil
+	}
+	var keys []string
+	if err := node.Decode(&keys); err != nil {
+		return err
+	}
+	*l = keys
+	return nil
+}
+
+// KeymapPath returns the keymap file path for a project
+func KeymapPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", KeymapFilename)
+}
+
+// LoadKeymap reads .bv/keys.yaml on top of the defaults. The file maps
+// context → action → keys; an action listed there loses its default keys.
+// Returns the defaults if the file doesn't exist.
+func LoadKeymap(projectDir string) (*Keymap, error) {
+	k := DefaultKeymap()
+	data, err := os.ReadFile(KeymapPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return k, nil
+		}
+		return k, fmt.Errorf("reading keymap: %w", err)
+	}
+
+	var raw map[string]map[string]keyList
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return DefaultKeymap(), fmt.Errorf("parsing keymap: %w", err)
+	}
+	if err := k.apply(raw); err != nil {
+		return DefaultKeymap(), fmt.Errorf("keymap %s: %w", KeymapFilename, err)
+	}
+	return k, nil
+}
+
+func (k *Keymap) apply(raw map[string]map[string]keyList) error {
+	known := make(map[string]bool, len(k.actions))
+	for _, a := range k.actions {
+		known[a.ID()] = true
+	}
+
+	// Sorted for deterministic error messages
+	contexts := make([]string, 0, len(raw))
+	for ctx := range raw {
+		contexts = append(contexts, ctx)
+	}
+	sort.Strings(contexts)
+	for _, ctx := range contexts {
+		names := make([]string, 0, len(raw[ctx]))
+		for name := range raw[ctx] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			id := ctx + "." + name
+			if !known[id] {
+				return fmt.Errorf("unknown action %q", id)
+			}
+			var keys []string
+			for _, key := range raw[ctx][name] {
+				norm, err := normalizeKey(key)
+				if err != nil {
+					return fmt.Errorf("%s: %w", id, err)
+				}
+				if reservedKeys[norm] {
+					return fmt.Errorf("%s: %q is reserved", id, norm)
+				}
+				keys = append(keys, norm)
+			}
+			k.bindings[id] = keys
+			k.custom[id] = true
+		}
+	}
+	return nil
+}
+
+// Actions returns the registry of remappable actions
+func (k *Keymap) Actions() []KeyAction {
+	return k.actions
+}
+
+// Keys returns the keys bound to an action, e.g. Keys(KeyContextGlobal, "board")
+func (k *Keymap) Keys(ctx KeyContext, name string) []string {
+	return k.bindings[string(ctx)+"."+name]
+}
+
+// Label renders an action's bound keys for display, e.g. "j / ↓"
+func (k *Keymap) Label(ctx KeyContext, name string) string {
+	keys := k.Keys(ctx, name)
+	if len(keys) == 0 {
+		return "—"
+	}
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		labels[i] = keyLabel(key)
+	}
+	return strings.Join(labels, " / ")
+}
+
+// globalDefaults returns the keys the global shortcut switch matches on
+func (k *Keymap) globalDefaults() map[string]bool {
+	keys := make(map[string]bool)
+	for _, a := range k.actions {
+		if a.Context == KeyContextGlobal {
+			for _, key := range a.Defaults {
+				keys[key] = true
+			}
+		}
+	}
+	return keys
+}
+
+// dispatchKey is the default key that triggers an action's handler. View
+// actions avoid keys the global switch would claim first.
+func (k *Keymap) dispatchKey(a KeyAction, globals map[string]bool) string {
+	if a.Context != KeyContextGlobal {
+		for _, key := range a.Defaults {
+			if !globals[key] {
+				return key
+			}
+		}
+	}
+	return a.Defaults[0]
+}
+
+// Translate maps a pressed key to the key the handlers expect, for the
+// contexts that are active (checked in order). It returns false when the key
+// should be ignored because its default action was remapped elsewhere.
+// Keys no action claims pass through unchanged.
+func (k *Keymap) Translate(key string, contexts []KeyContext) (string, bool) {
+	if len(k.custom) == 0 || len(contexts) == 0 || reservedKeys[key] {
+		return key, true
+	}
+	globals := k.globalDefaults()
+
+	for _, ctx := range contexts {
+		var match *KeyAction
+		for i := range k.actions {
+			a := &k.actions[i]
+			if a.Context != ctx || !containsKey(k.bindings[a.ID()], key) {
+				continue
+			}
+			// An explicit keys.yaml binding beats a default in the same context
+			if match == nil || k.custom[a.ID()] && !k.custom[match.ID()] {
+				match = a
+			}
+		}
+		if match == nil {
+			continue
+		}
+		if containsKey(match.Defaults, key) && (match.Context == KeyContextGlobal || !globals[key]) {
+			return key, true
+		}
+		return k.dispatchKey(*match, globals), true
+	}
+
+	// A default key whose action now lives elsewhere does nothing
+	for _, a := range k.actions {
+		if containsKey(a.Defaults, key) && containsContext(contexts, a.Context) {
+			return key, false
+		}
+	}
+	return key, true
+}
+
+// actionFor returns the action a default key triggers in the given contexts,
+// checked in order
+func actionFor(actions []KeyAction, key string, contexts []KeyContext) (KeyAction, bool) {
+	for _, ctx := range contexts {
+		for _, a := range actions {
+			if a.Context == ctx && containsKey(a.Defaults, key) {
+				return a, true
+			}
+		}
+	}
+	return KeyAction{}, false
+}
+
+// Conflicts reports keys bound to more than one action in contexts that can
+// be active together. Overlaps between built-in defaults (view keys that the
+// global keys shadow) are not reported; only those a keys.yaml entry causes.
+func (k *Keymap) Conflicts() []KeyConflict {
+	byKey := make(map[string][]KeyAction)
+	for _, a := range k.actions {
+		for _, key := range k.bindings[a.ID()] {
+			byKey[key] = append(byKey[key], a)
+		}
+	}
+
+	var conflicts []KeyConflict
+	for key, actions := range byKey {
+		seen := make(map[string]bool)
+		var ids []string
+		for i := 0; i < len(actions); i++ {
+			for j := i + 1; j < len(actions); j++ {
+				a, b := actions[i], actions[j]
+				if !contextsOverlap(a.Context, b.Context) {
+					continue
+				}
+				if !k.isCustomBinding(a, key) && !k.isCustomBinding(b, key) {
+					continue
+				}
+				for _, id := range []string{a.ID(), b.ID()} {
+					if !seen[id] {
+						seen[id] = true
+						ids = append(ids, id)
+					}
+				}
+			}
+		}
+		if len(ids) > 0 {
+			conflicts = append(conflicts, KeyConflict{Key: key, Actions: ids})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Key < conflicts[j].Key })
+	return conflicts
+}
+
+// isCustomBinding reports whether key was bound to the action by keys.yaml
+func (k *Keymap) isCustomBinding(a KeyAction, key string) bool {
+	return k.custom[a.ID()] && !containsKey(a.Defaults, key)
+}
+
+// contextsOverlap reports whether two contexts can be active at the same time
+func contextsOverlap(a, b KeyContext) bool {
+	return a == b || a == KeyContextGlobal || b == KeyContextGlobal || a == KeyContextNav || b == KeyContextNav
+}
+
+// KeyHelpEntry is one line of the generated help overlay
+type KeyHelpEntry struct {
+	Keys string
+	Desc string
+}
+
+// KeyHelpSection groups help entries under a heading
+type KeyHelpSection struct {
+	Title   string
+	Entries []KeyHelpEntry
+}
+
+// HelpSections lists the active bindings grouped for the help overlay,
+// in registry order
+func (k *Keymap) HelpSections() []KeyHelpSection {
+	var sections []KeyHelpSection
+	for _, a := range k.actions {
+		if a.Section == "" {
+			continue
+		}
+		if len(sections) == 0 || sections[len(sections)-1].Title != a.Section {
+			sections = append(sections, KeyHelpSection{Title: a.Section})
+		}
+		s := &sections[len(sections)-1]
+		s.Entries = append(s.Entries, KeyHelpEntry{Keys: k.Label(a.Context, a.Name), Desc: a.Desc})
+	}
+	return sections
+}
+
+// FormatBindings renders every action and its keys as keys.yaml, marking remapped ones
+func (k *Keymap) FormatBindings() string {
+	var sb strings.Builder
+	for _, ctx := range keyContextOrder {
+		sb.WriteString(string(ctx) + ":\n")
+		for _, a := range k.actions {
+			if a.Context != ctx {
+				continue
+			}
+			quoted := make([]string, len(k.bindings[a.ID()]))
+			for i, key := range k.bindings[a.ID()] {
+				quoted[i] = fmt.Sprintf("%q", key)
+			}
+			line := fmt.Sprintf("  %s: [%s]", a.Name, strings.Join(quoted, ", "))
+			comment := a.Desc
+			if k.custom[a.ID()] {
+				comment += " (remapped)"
+			}
+			sb.WriteString(fmt.Sprintf("%-36s # %s\n", line, comment))
+		}
+	}
+	return sb.String()
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func containsContext(contexts []KeyContext, ctx KeyContext) bool {
+	for _, c := range contexts {
+		if c == ctx {
+			return true
+		}
+	}
+	return false
+}
+
+// keyContexts lists the keymap contexts active for the current focus, in the
+// order the key handlers see them. Text inputs get none, so typing is never remapped.
+func (m Model) keyContexts() []KeyContext {
+	if m.list.FilterState() == list.Filtering {
+		return nil
+	}
+	switch m.focused {
+	case focusTimeTravelInput, focusCommentEditor, focusLabelPicker, focusRecipePicker, focusRepoPicker, focusThemePicker, focusJumpList, focusGoto, focusExportScope, focusTriageSession, focusSnoozeInput, focusQuitConfirm:
+		return nil
+	case focusList:
+		return []KeyContext{KeyContextGlobal, KeyContextList, KeyContextNav}
+	case focusGraph:
+		return []KeyContext{KeyContextGlobal, KeyContextGraph, KeyContextNav}
+	case focusTimeline:
+		return []KeyContext{KeyContextGlobal, KeyContextTimeline, KeyContextNav}
+	case focusHeatmap:
+		return []KeyContext{KeyContextGlobal, KeyContextActivity, KeyContextNav}
+	case focusInsights:
+		return []KeyContext{KeyContextGlobal, KeyContextInsights, KeyContextNav}
+	case focusHistory:
+		return []KeyContext{KeyContextGlobal, KeyContextHistory, KeyContextNav}
+	case focusActionable:
+		return []KeyContext{KeyContextGlobal, KeyContextActionable, KeyContextNav}
+	case focusBoard:
+		return []KeyContext{KeyContextGlobal, KeyContextBoard, KeyContextNav}
+	default:
+		return []KeyContext{KeyContextGlobal, KeyContextNav}
+	}
+}
+
+// remapKey translates a key press through the keymap. It returns false when
+// the key should be ignored.
+func (m Model) remapKey(msg tea.KeyMsg) (tea.KeyMsg, bool) {
+	if m.keymap == nil {
+		return msg, true
+	}
+	key := msg.String()
+	translated, ok := m.keymap.Translate(key, m.keyContexts())
+	if !ok {
+		return msg, false
+	}
+	if translated == key {
+		return msg, true
+	}
+	return keyMsgFromString(translated), true
+}
+
+// blockMutation refuses a (remapped) key press whose action writes while bv
+// is read-only, setting a status message, and reports whether it did
+func (m *Model) blockMutation(msg tea.KeyMsg) bool {
+	if !readonly.Enabled() {
+		return false
+	}
+	actions := defaultKeyActions
+	if m.keymap != nil {
+		actions = m.keymap.actions
+	}
+	a, ok := actionFor(actions, msg.String(), m.keyContexts())
+	if !ok || !mutatingActions[a.ID()] {
+		return false
+	}
+	m.statusMsg = "🔒 Disabled in read-only mode: " + a.Desc
+	m.statusIsError = true
+	return true
+}
@@ -0,0 +1,216 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func writeKeymap(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(KeymapPath(dir), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestDefaultKeymap_IsIdentity(t *testing.T) {
+	k, err := LoadKeymap(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	listCtx := []KeyContext{KeyContextGlobal, KeyContextList, KeyContextNav}
+	for _, a := range k.Actions() {
+		for _, key := range a.Defaults {
+			if got, ok := k.Translate(key, listCtx); !ok || got != key {
+				t.Errorf("Default key %q translated to %q (ok=%v)", key, got, ok)
+			}
+		}
+	}
+	if c := k.Conflicts(); len(c) != 0 {
+		t.Errorf("Built-in overlaps should not be reported, got %v", c)
+	}
+}
+
+func TestLoadKeymap_Translate(t *testing.T) {
+	dir := writeKeymap(t, "global:\n  board: v\n  graph: [b]\nnav:\n  move_down: [t, down]\n  move_right: n\n")
+	k, err := LoadKeymap(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := []KeyContext{KeyContextGlobal, KeyContextList, KeyContextNav}
+	graph := []KeyContext{KeyContextGlobal, KeyContextGraph, KeyContextNav}
+
+	tests := []struct {
+		name     string
+		key      string
+		contexts []KeyContext
+		want     string
+		wantOK   bool
+	}{
+		{"new key for global action", "v", list, "b", true},
+		{"key moved to another action", "b", list, "g", true},
+		{"old default is dropped", "g", list, "g", false},
+		{"view key wins over nav in its context", "t", list, "t", true},
+		{"nav remap applies in other views", "t", graph, "j", true},
+		{"remapped-away nav default is dropped", "j", graph, "j", false},
+		{"dispatch avoids keys the global switch claims", "n", graph, "right", true},
+		{"unclaimed keys pass through", "z", list, "z", true},
+		{"no contexts means no remapping", "v", nil, "v", true},
+		{"reserved keys pass through", "ctrl+c", list, "ctrl+c", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := k.Translate(tt.key, tt.contexts)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Translate(%q) = %q, %v; want %q, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	conflicts := k.Conflicts()
+	if len(conflicts) != 1 || conflicts[0].Key != "t" ||
+		!strings.Contains(conflicts[0].String(), "nav.move_down") || !strings.Contains(conflicts[0].String(), "list.time_travel") {
+		t.Errorf("Expected one conflict on t, got %v", conflicts)
+	}
+}
+
+func TestLoadKeymap_Errors(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown action": "global:\n  teleport: x\n",
+		"unknown key":    "global:\n  board: hyper+x\n",
+		"reserved key":   "global:\n  quit: ctrl+c\n",
+		"invalid yaml":   "global: [\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			k, err := LoadKeymap(writeKeymap(t, content))
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if got := k.Keys(KeyContextGlobal, "board"); len(got) != 1 || got[0] != "b" {
+				t.Errorf("Expected defaults on error, got board=%v", got)
+			}
+		})
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	for in, want := range map[string]string{
+		"x":      "x",
+		"X":      "X",
+		"Space":  " ",
+		"Ctrl+D": "ctrl+d",
+		"Escape": "esc",
+		"alt+J":  "alt+J",
+		"PgDown": "pgdown",
+		"f5":     "f5",
+	} {
+		got, err := normalizeKey(in)
+		if err != nil || got != want {
+			t.Errorf("normalizeKey(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+}
+
+func TestKeyMsgFromString_RoundTrips(t *testing.T) {
+	for _, key := range []string{"j", "G", "?", " ", "enter", "esc", "tab", "ctrl+a", "pgdown", "f1", "alt+x"} {
+		if got := keyMsgFromString(key).String(); got != key {
+			t.Errorf("keyMsgFromString(%q).String() = %q", key, got)
+		}
+	}
+}
+
+func TestKeymap_HelpReflectsBindings(t *testing.T) {
+	k, err := LoadKeymap(writeKeymap(t, "global:\n  board: [v, ctrl+b]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, s := range k.HelpSections() {
+		for _, e := range s.Entries {
+			if e.Desc == "Toggle Kanban board" {
+				found = true
+				if e.Keys != "v / Ctrl+b" {
+					t.Errorf("Board help keys = %q", e.Keys)
+				}
+			}
+		}
+	}
+	if !found {
+		t.Error("Board action missing from help sections")
+	}
+	if !strings.Contains(k.FormatBindings(), `board: ["v", "ctrl+b"]`) {
+		t.Errorf("FormatBindings missing remap:\n%s", k.FormatBindings())
+	}
+}
+
+func TestModel_RemappedKeys(t *testing.T) {
+	issues := []model.Issue{{ID: "k-1", Title: "One", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	k, err := LoadKeymap(writeKeymap(t, "global:\n  board: v\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.keymap = k
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	m = updated.(Model)
+	if m.isBoardView {
+		t.Fatal("Old board key should do nothing once remapped")
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	m = updated.(Model)
+	if !m.isBoardView {
+		t.Fatal("Remapped key should open the board")
+	}
+
+	m.showHelp = true
+	m.focused = focusHelp
+	m.height = 200
+	if out := m.renderHelpOverlay(); !strings.Contains(out, "Custom bindings from .bv/keys.yaml") {
+		t.Error("Help overlay should note custom bindings")
+	}
+}
+
+func TestModel_ReadOnlyBlocksMutations(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, ".beads", "issues.jsonl")
+	issues := []model.Issue{{ID: "k-1", Title: "One", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, beadsPath)
+	k, err := LoadKeymap(writeKeymap(t, "global:\n  comment: x\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.keymap = k
+
+	readonly.Set(true)
+	defer readonly.Set(false)
+
+	// Remapped or not, writing actions are refused before they start
+	for _, key := range []string{"x", "E", "W"} {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+		if m.showCommentEditor || !strings.Contains(m.statusMsg, "read-only") {
+			t.Errorf("%q: comment editor open=%v, status %q", key, m.showCommentEditor, m.statusMsg)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Read-only mode wrote files: %v", entries)
+	}
+
+	// Views still work
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	m = updated.(Model)
+	if !m.isBoardView {
+		t.Error("Board should open in read-only mode")
+	}
+}
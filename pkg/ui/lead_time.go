@@ -0,0 +1,254 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Tabs of the lead time panel
+const (
+	leadTimeTabOverall = iota
+	leadTimeTabLabels
+	leadTimeTabAssignees
+	leadTimeTabs
+)
+
+// lifecycleFromHistory takes each issue's created, claimed and closed
+// milestones from the correlation event stream
+func lifecycleFromHistory(report *correlation.HistoryReport) map[string]analysis.LifecycleTimes {
+	lifecycle := make(map[string]analysis.LifecycleTimes, len(report.Histories))
+	for id, history := range report.Histories {
+		var lc analysis.LifecycleTimes
+		if e := history.Milestones.Created; e != nil {
+			lc.Created = e.Timestamp
+		}
+		if e := history.Milestones.Claimed; e != nil {
+			lc.Started = e.Timestamp
+		}
+		if e := history.Milestones.Closed; e != nil {
+			lc.Closed = e.Timestamp
+		}
+		lifecycle[id] = lc
+	}
+	return lifecycle
+}
+
+// openLeadTime opens the lead and cycle time panel
+func (m *Model) openLeadTime() {
+	m.refreshLeadTime()
+	m.leadTimeTab = leadTimeTabOverall
+	m.leadTimeCursor = 0
+	m.showLeadTime = true
+}
+
+// refreshLeadTime recomputes the report after a reload or once history,
+// and with it cycle time, has loaded
+func (m *Model) refreshLeadTime() {
+	m.leadTimeReport = analysis.ComputeLeadCycleTimes(m.issues, m.lifecycle, time.Now())
+	if m.leadTimeCursor >= len(m.leadTimeGroups()) {
+		m.leadTimeCursor = max(0, len(m.leadTimeGroups())-1)
+	}
+}
+
+// leadTimeGroups returns the groups listed on the current tab
+func (m Model) leadTimeGroups() []analysis.LeadCycleGroup {
+	switch m.leadTimeTab {
+	case leadTimeTabLabels:
+		return m.leadTimeReport.ByLabel
+	case leadTimeTabAssignees:
+		return m.leadTimeReport.ByAssignee
+	}
+	return nil
+}
+
+// handleLeadTimeKeys handles keys while the lead time panel is open
+func (m Model) handleLeadTimeKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "tab":
+		m.leadTimeTab = (m.leadTimeTab + 1) % leadTimeTabs
+		m.leadTimeCursor = 0
+	case "shift+tab":
+		m.leadTimeTab = (m.leadTimeTab + leadTimeTabs - 1) % leadTimeTabs
+		m.leadTimeCursor = 0
+	case "j", "down":
+		if m.leadTimeCursor < len(m.leadTimeGroups())-1 {
+			m.leadTimeCursor++
+		}
+	case "k", "up":
+		if m.leadTimeCursor > 0 {
+			m.leadTimeCursor--
+		}
+	case "esc", "q", "ctrl+t":
+		m.showLeadTime = false
+	}
+	return m
+}
+
+// formatLeadDays renders a lead or cycle time given in days
+func formatLeadDays(days float64) string {
+	switch {
+	case days < 1:
+		return fmt.Sprintf("%.0fh", days*24)
+	case days < 14:
+		return fmt.Sprintf("%.1fd", days)
+	default:
+		return fmt.Sprintf("%.1fw", days/7)
+	}
+}
+
+// renderLeadTimePanel renders lead and cycle time distributions, trends and
+// breakdowns per label and assignee
+func (m Model) renderLeadTimePanel() string {
+	t := m.theme
+	r := m.leadTimeReport
+
+	boxWidth := min(100, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	headStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	barStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("⏲ Lead & Cycle Time"))
+	sb.WriteString("\n")
+
+	// Tab bar
+	tabs := []string{"Overall", "Labels", "Assignees"}
+	for i, name := range tabs {
+		if i == m.leadTimeTab {
+			sb.WriteString(t.Renderer.NewStyle().Bold(true).Underline(true).Foreground(t.Primary).Render(name))
+		} else {
+			sb.WriteString(mutedStyle.Render(name))
+		}
+		sb.WriteString("  ")
+	}
+	sb.WriteString("\n")
+	note := "Lead: created → closed • Cycle: in progress → closed"
+	switch {
+	case m.historyLoading:
+		note += " • cycle time waits for git history"
+	case m.lifecycle == nil:
+		note += " • no git history, so no cycle time"
+	}
+	sb.WriteString(mutedStyle.Render(truncateRunesHelper(note, boxWidth-6, "…")))
+	sb.WriteString("\n\n")
+
+	if r.Overall.Lead.Count == 0 {
+		sb.WriteString(mutedStyle.Render("No closed issues with a creation and close time yet"))
+	} else if m.leadTimeTab == leadTimeTabOverall {
+		statsRow := func(name string, s analysis.DurationStats) {
+			if s.Count == 0 {
+				sb.WriteString(textStyle.Render(fmt.Sprintf("%-6s", name)) + mutedStyle.Render("  no samples"))
+				sb.WriteString("\n")
+				return
+			}
+			sb.WriteString(textStyle.Render(fmt.Sprintf("%-6s  n=%-4d p50 %-6s p85 %-6s p95 %-6s mean %-6s max %s",
+				name, s.Count, formatLeadDays(s.P50), formatLeadDays(s.P85), formatLeadDays(s.P95),
+				formatLeadDays(s.Mean), formatLeadDays(s.Max))))
+			sb.WriteString("\n")
+		}
+		statsRow("Lead", r.Overall.Lead)
+		statsRow("Cycle", r.Overall.Cycle)
+		sb.WriteString("\n")
+
+		// Lead time histogram
+		sb.WriteString(headStyle.Render("Lead time distribution"))
+		sb.WriteString("\n")
+		labels := make([]string, len(analysis.DurationBuckets)+1)
+		lower := 0.0
+		for i, upper := range analysis.DurationBuckets {
+			labels[i] = fmt.Sprintf("%s–%s", formatLeadDays(lower), formatLeadDays(upper))
+			lower = upper
+		}
+		labels[len(labels)-1] = ">" + formatLeadDays(lower)
+		maxCount := 0
+		for _, c := range r.Overall.Lead.Buckets {
+			maxCount = max(maxCount, c)
+		}
+		const barWidth = 30
+		for i, c := range r.Overall.Lead.Buckets {
+			filled := 0
+			if maxCount > 0 {
+				filled = c * barWidth / maxCount
+			}
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  %-10s ", labels[i])))
+			sb.WriteString(barStyle.Render(strings.Repeat("█", filled)))
+			sb.WriteString(textStyle.Render(fmt.Sprintf(" %d", c)))
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+
+		// Weekly medians
+		sb.WriteString(headStyle.Render(fmt.Sprintf("Median lead time by week closed (last %d weeks)", len(r.Trend))))
+		sb.WriteString("\n")
+		values := make([]int, len(r.Trend))
+		maxVal := 0
+		for i, w := range r.Trend {
+			values[i] = int(w.LeadP50*10 + 0.5) // Tenths of a day keep short weeks visible
+			maxVal = max(maxVal, values[i])
+		}
+		sb.WriteString("  " + barStyle.Render(buildSparkline(values, maxVal)))
+		if n := len(r.Trend); n > 0 {
+			last := r.Trend[n-1]
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  this week: %d closed, lead p50 %s, cycle p50 %s",
+				last.Closed, formatLeadDays(last.LeadP50), formatLeadDays(last.CycleP50))))
+		}
+		sb.WriteString("\n")
+	} else {
+		groups := m.leadTimeGroups()
+		sb.WriteString(headStyle.Render(fmt.Sprintf("  %-20s %5s  %-15s %-15s", "", "n", "lead p50/p85", "cycle p50/p85")))
+		sb.WriteString("\n")
+		visible := max(3, m.height-14)
+		start := 0
+		if m.leadTimeCursor >= visible {
+			start = m.leadTimeCursor - visible + 1
+		}
+		end := min(len(groups), start+visible)
+		pair := func(s analysis.DurationStats) string {
+			if s.Count == 0 {
+				return "—"
+			}
+			return formatLeadDays(s.P50) + " / " + formatLeadDays(s.P85)
+		}
+		for i := start; i < end; i++ {
+			g := groups[i]
+			cursor, style := "  ", textStyle
+			if i == m.leadTimeCursor {
+				cursor, style = "▸ ", style.Bold(true).Foreground(t.Primary)
+			}
+			sb.WriteString(style.Render(fmt.Sprintf("%s%-20s %5d  %-15s %-15s",
+				cursor, truncateRunesHelper(g.Key, 20, "…"), g.Lead.Count, pair(g.Lead), pair(g.Cycle))))
+			sb.WriteString("\n")
+		}
+		if end < len(groups) {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(groups)-end)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("Tab: overall / labels / assignees • j/k: move • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_LeadTime(t *testing.T) {
+	now := time.Now()
+	closed := now.Add(-24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "a", Title: "A", Status: model.StatusClosed, CreatedAt: now.Add(-72 * time.Hour), ClosedAt: &closed, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "b", Title: "B", Status: model.StatusOpen, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+
+	m = press(m, "ctrl+t")
+	if !m.showLeadTime || m.leadTimeReport.Overall.Lead.Count != 1 || m.leadTimeReport.Overall.Cycle.Count != 0 {
+		t.Fatalf("Expected ctrl+t to measure a's lead time only, got %+v", m.leadTimeReport.Overall)
+	}
+	if view := m.View(); !strings.Contains(view, "Lead & Cycle Time") || !strings.Contains(view, "p50 2.0d") {
+		t.Error("Expected the overall tab to show a's two-day lead time")
+	}
+
+	// Once history loads, the claim gives a cycle time
+	claimed := &correlation.BeadEvent{BeadID: "a", EventType: correlation.EventClaimed, Timestamp: closed.Add(-12 * time.Hour)}
+	updated, _ = m.Update(HistoryLoadedMsg{Report: &correlation.HistoryReport{Histories: map[string]correlation.BeadHistory{
+		"a": {BeadID: "a", Milestones: correlation.BeadMilestones{Claimed: claimed}},
+	}}})
+	m = updated.(Model)
+	if m.leadTimeReport.Overall.Cycle.Count != 1 || m.leadTimeReport.Overall.Cycle.P50 != 0.5 {
+		t.Errorf("Expected a half-day cycle time after history loads, got %+v", m.leadTimeReport.Overall.Cycle)
+	}
+
+	m = press(m, "tab", "tab")
+	if m.leadTimeTab != leadTimeTabAssignees || !strings.Contains(m.View(), "ann") {
+		t.Errorf("Expected the assignees tab to list ann, got tab %d", m.leadTimeTab)
+	}
+	m = press(m, "esc")
+	if m.showLeadTime {
+		t.Error("Expected esc to close the panel")
+	}
+}
@@ -0,0 +1,308 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// ColumnsFilename holds the user's list column layout, in ~/.config/bv
+const ColumnsFilename = "columns.yaml"
+
+// List column names
+const (
+	ColumnRepo     = "repo"
+	ColumnType     = "type"
+	ColumnPriority = "priority"
+	ColumnStatus   = "status"
+	ColumnID       = "id"
+	ColumnTitle    = "title"
+	ColumnTriage   = "triage"
+	ColumnPageRank = "pagerank"
+	ColumnAge      = "age"
+	ColumnComments = "comments"
+	ColumnAssignee = "assignee"
+	ColumnLabels   = "labels"
+)
+
+// maxIDColumnWidth caps the width of an auto-sized ID column
+const maxIDColumnWidth = 35
+
+// listColumnSpec describes a column the list can show
+type listColumnSpec struct {
+	header  string
+	desc    string
+	width   int // Default width in cells; 0 sizes the ID column to the longest ID
+	minList int // Hidden when the list is narrower than this
+}
+
+var listColumnSpecs = map[string]listColumnSpec{
+	ColumnRepo:     {"REPO", "Repo badge (workspace mode only)", 6, 0},
+	ColumnType:     {"TYPE", "Issue type icon", 4, 0},
+	ColumnPriority: {"PRI", "Priority badge and hint arrow", 3, 0},
+	ColumnStatus:   {"STATUS", "Status badge", 6, 0},
+	ColumnID:       {"ID", "Issue ID", 0, 0},
+	ColumnTitle:    {"TITLE", "Title with triage, due and diff badges", 0, 0},
+	ColumnTriage:   {"TRIAGE", "Triage score", 6, 81},
+	ColumnPageRank: {"RANK", "PageRank sparkline", 5, 121},
+	ColumnAge:      {"AGE", "Time since created", 8, 61},
+	ColumnComments: {"CMTS", "Comment count", 4, 61},
+	ColumnAssignee: {"ASSIGNEE", "Assignee", 13, 101},
+	ColumnLabels:   {"LABELS", "Labels", 20, 141},
+}
+
+// listColumnNames lists every column in the order the editor offers them
+var listColumnNames = []string{
+	ColumnRepo, ColumnType, ColumnPriority, ColumnStatus, ColumnID, ColumnTitle,
+	ColumnTriage, ColumnPageRank, ColumnAge, ColumnComments, ColumnAssignee, ColumnLabels,
+}
+
+// ListColumn is one column of the issue list
+type ListColumn struct {
+	Name  string `yaml:"name"`
+	Width int    `yaml:"width,omitempty"` // Cells; 0 uses the column's default
+}
+
+// ListColumns is the ordered column layout of the issue list. The title
+// column is always shown and takes the width the others leave; columns
+// other than the core ones hide when the list is too narrow for them.
+type ListColumns []ListColumn
+
+// listColumnsFile is the columns.yaml format
+type listColumnsFile struct {
+	Columns ListColumns `yaml:"columns"`
+}
+
+// DefaultListColumns returns the built-in layout
+func DefaultListColumns() ListColumns {
+	return ListColumns{
+		{Name: ColumnRepo}, {Name: ColumnType}, {Name: ColumnPriority}, {Name: ColumnStatus},
+		{Name: ColumnID}, {Name: ColumnTitle}, {Name: ColumnAge}, {Name: ColumnComments},
+		{Name: ColumnPageRank}, {Name: ColumnAssignee}, {Name: ColumnLabels},
+	}
+}
+
+// Validate reports unknown or repeated columns, negative widths, and a
+// missing title column
+func (c ListColumns) Validate() error {
+	seen := make(map[string]bool, len(c))
+	for _, col := range c {
+		if _, ok := listColumnSpecs[col.Name]; !ok {
+			return fmt.Errorf("unknown column %q (known: %s)", col.Name, strings.Join(listColumnNames, ", "))
+		}
+		if seen[col.Name] {
+			return fmt.Errorf("column %q listed twice", col.Name)
+		}
+		if col.Width < 0 {
+			return fmt.Errorf("column %q: width must not be negative", col.Name)
+		}
+		seen[col.Name] = true
+	}
+	if !seen[ColumnTitle] {
+		return fmt.Errorf("the %q column is required", ColumnTitle)
+	}
+	return nil
+}
+
+// Has reports whether the layout includes the named column
+func (c ListColumns) Has(name string) bool {
+	for _, col := range c {
+		if col.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ColumnsPath returns the user's column layout path, or "" without a home
+// directory
+func ColumnsPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "bv", ColumnsFilename)
+}
+
+// LoadListColumns reads the user's column layout, returning the default
+// layout if there is none. A broken file returns the default with an error.
+func LoadListColumns() (ListColumns, error) {
+	path := ColumnsPath()
+	if path == "" {
+		return DefaultListColumns(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultListColumns(), nil
+		}
+		return DefaultListColumns(), fmt.Errorf("reading columns: %w", err)
+	}
+	var file listColumnsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return DefaultListColumns(), fmt.Errorf("parsing columns: %w", err)
+	}
+	if err := file.Columns.Validate(); err != nil {
+		return DefaultListColumns(), fmt.Errorf("%s: %w", ColumnsFilename, err)
+	}
+	return file.Columns, nil
+}
+
+// SaveListColumns writes the user's column layout, removing the file when
+// the layout is the default
+func SaveListColumns(c ListColumns) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	path := ColumnsPath()
+	if path == "" {
+		return fmt.Errorf("no home directory for %s", ColumnsFilename)
+	}
+	if c.equal(DefaultListColumns()) {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing columns: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating columns directory: %w", err)
+	}
+	data, err := yaml.Marshal(listColumnsFile{Columns: c})
+	if err != nil {
+		return fmt.Errorf("encoding columns: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing columns: %w", err)
+	}
+	return nil
+}
+
+func (c ListColumns) equal(other ListColumns) bool {
+	if len(c) != len(other) {
+		return false
+	}
+	for i := range c {
+		if c[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// columnLayout is the columns that fit one list width, with resolved widths
+type columnLayout struct {
+	names  []string
+	widths []int
+}
+
+// layout fits the columns to a row of width cells after the 2-cell
+// selector. Hints widen the priority column for the hint arrow; idWidth
+// sizes an auto-width ID column.
+func (c ListColumns) layout(width int, workspace, hints bool, idWidth int) columnLayout {
+	if len(c) == 0 {
+		c = DefaultListColumns()
+	}
+	var lay columnLayout
+	used, title := 2, -1
+	for _, col := range c {
+		spec, ok := listColumnSpecs[col.Name]
+		if !ok || width < spec.minList || (col.Name == ColumnRepo && !workspace) {
+			continue
+		}
+		w := col.Width
+		switch {
+		case col.Name == ColumnTitle:
+			title = len(lay.names)
+		case w > 0:
+		case col.Name == ColumnID:
+			w = idWidth
+		case col.Name == ColumnPriority && hints:
+			w = spec.width + 2
+		default:
+			w = spec.width
+		}
+		if len(lay.names) > 0 {
+			used++ // Separating space
+		}
+		used += w
+		lay.names = append(lay.names, col.Name)
+		lay.widths = append(lay.widths, w)
+	}
+	if title >= 0 {
+		lay.widths[title] = max(width-used, 5)
+	}
+	return lay
+}
+
+// idColumnWidth sizes an auto-width ID column to the longest listed ID
+func idColumnWidth(items []list.Item) int {
+	w := len(listColumnSpecs[ColumnID].header)
+	for _, item := range items {
+		if it, ok := item.(IssueItem); ok {
+			w = max(w, utf8.RuneCountInString(it.Issue.ID))
+		}
+	}
+	return min(w, maxIDColumnWidth)
+}
+
+// Header renders the column titles for rows of the given width
+func (c ListColumns) Header(width int, items []list.Item, workspace, hints bool) string {
+	lay := c.layout(width, workspace, hints, idColumnWidth(items))
+	var sb strings.Builder
+	sb.WriteString("  ")
+	for k, name := range lay.names {
+		if k > 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(padCell(listColumnSpecs[name].header, lay.widths[k], name == ColumnAge))
+	}
+	return sb.String()
+}
+
+// padCell fits a rendered cell to exactly w cells, right-aligning if asked
+func padCell(cell string, w int, right bool) string {
+	if lipgloss.Width(cell) > w {
+		cell = lipgloss.NewStyle().MaxWidth(w).Render(cell)
+	}
+	pad := strings.Repeat(" ", max(w-lipgloss.Width(cell), 0))
+	if right {
+		return pad + cell
+	}
+	return cell + pad
+}
+
+// issueDelegate returns the list delegate for the model's current settings
+func (m Model) issueDelegate() IssueDelegate {
+	return IssueDelegate{
+		Theme:             m.theme,
+		ShowPriorityHints: m.showPriorityHints,
+		PriorityHints:     m.priorityHints,
+		WorkspaceMode:     m.workspaceMode,
+		Marked:            m.marked,
+		Subscribed:        m.subscribed,
+		Starred:           m.starred,
+		Columns:           m.listColumns,
+	}
+}
+
+// listHeader renders the column titles for the list at its current width,
+// cut to fit a header bar of maxWidth cells
+func (m Model) listHeader(maxWidth int) string {
+	width := m.list.Width()
+	if width <= 0 {
+		width = 80
+	}
+	header := m.listColumns.Header(width-1, m.list.Items(), m.workspaceMode, m.showPriorityHints)
+	if maxWidth > 0 && lipgloss.Width(header) > maxWidth {
+		header = lipgloss.NewStyle().MaxWidth(maxWidth).Render(header)
+	}
+	return header
+}
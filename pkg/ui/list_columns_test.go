@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestListColumnsLayout(t *testing.T) {
+	cols := DefaultListColumns()
+
+	// Repo only shows in workspace mode; narrow lists drop the extra columns
+	lay := cols.layout(60, false, false, 8)
+	if got := strings.Join(lay.names, ","); got != "type,priority,status,id,title" {
+		t.Errorf("narrow layout = %s", got)
+	}
+	// 2 selector + 4 + 3 + 6 + 8 and four spaces leave the title 33
+	if lay.widths[4] != 33 {
+		t.Errorf("title width = %d, want 33", lay.widths[4])
+	}
+
+	lay = cols.layout(160, true, true, 8)
+	if got := strings.Join(lay.names, ","); got != "repo,type,priority,status,id,title,age,comments,pagerank,assignee,labels" {
+		t.Errorf("wide layout = %s", got)
+	}
+	if lay.widths[2] != 5 {
+		t.Errorf("priority with hints = %d, want 5", lay.widths[2])
+	}
+
+	custom := ListColumns{{Name: ColumnTitle}, {Name: ColumnTriage, Width: 4}, {Name: ColumnID, Width: 10}}
+	lay = custom.layout(100, false, false, 8)
+	if got := strings.Join(lay.names, ","); got != "title,triage,id" || lay.widths[1] != 4 || lay.widths[2] != 10 {
+		t.Errorf("custom layout = %v %v", lay.names, lay.widths)
+	}
+}
+
+func TestListColumnsValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cols ListColumns
+		want string
+	}{
+		"default":  {DefaultListColumns(), ""},
+		"unknown":  {ListColumns{{Name: ColumnTitle}, {Name: "owner"}}, "unknown column"},
+		"twice":    {ListColumns{{Name: ColumnTitle}, {Name: ColumnID}, {Name: ColumnID}}, "listed twice"},
+		"negative": {ListColumns{{Name: ColumnTitle}, {Name: ColumnAge, Width: -1}}, "negative"},
+		"no title": {ListColumns{{Name: ColumnID}}, "required"},
+	} {
+		err := tc.cols.Validate()
+		if tc.want == "" {
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want %q", name, err, tc.want)
+		}
+	}
+}
+
+func TestSaveAndLoadListColumns(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(home, ".config", "bv", ColumnsFilename)
+
+	cols := ListColumns{{Name: ColumnID}, {Name: ColumnTitle}, {Name: ColumnAssignee, Width: 20}}
+	if err := SaveListColumns(cols); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadListColumns()
+	if err != nil || !got.equal(cols) {
+		t.Fatalf("loaded %v, %v; want %v", got, err, cols)
+	}
+
+	// Saving the default layout removes the file
+	if err := SaveListColumns(DefaultListColumns()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("default layout should remove %s", path)
+	}
+
+	// A broken file falls back to the default with an error
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("columns:\n  - name: id\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err = LoadListColumns()
+	if err == nil || !got.equal(DefaultListColumns()) {
+		t.Errorf("missing title: got %v, %v", got, err)
+	}
+}
+
+func TestIssueDelegate_RenderCustomColumns(t *testing.T) {
+	item := newTestIssueItem("cc-1")
+	item.TriageScore = 0.75
+	delegate := IssueDelegate{
+		Theme:   DefaultTheme(lipgloss.NewRenderer(os.Stdout)),
+		Columns: ListColumns{{Name: ColumnTriage}, {Name: ColumnTitle}, {Name: ColumnAssignee}},
+	}
+	items := []list.Item{item}
+	l := list.New(items, delegate, 0, 0)
+	l.SetWidth(120)
+
+	var buf bytes.Buffer
+	delegate.Render(&buf, l, 0, item)
+	out := buf.String()
+	for _, want := range []string{"0.75", "Short title", "@alice"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("row missing %q: %q", want, out)
+		}
+	}
+	if strings.Contains(out, "cc-1") || strings.Contains(out, "one,two") {
+		t.Errorf("row shows hidden columns: %q", out)
+	}
+	if strings.Index(out, "0.75") > strings.Index(out, "Short title") {
+		t.Error("triage should come before the title")
+	}
+
+	header := delegate.Columns.Header(119, items, false, false)
+	if !strings.HasPrefix(header, "  TRIAGE TITLE") || !strings.HasSuffix(strings.TrimRight(header, " "), "ASSIGNEE") {
+		t.Errorf("header = %q", header)
+	}
+}
+
+func TestColumnEditorKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	issues := []model.Issue{{ID: "ce-1", Title: "One", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+
+	press := func(keys ...string) {
+		for _, key := range keys {
+			updated, _ := m.Update(keyMsgFromString(key))
+			m = updated.(Model)
+		}
+	}
+
+	press("f4")
+	if !m.showColumnEditor {
+		t.Fatal("f4 should open the column editor")
+	}
+	// Rows start with the default layout: repo, type, priority, ...
+	press("j", "j", " ") // Hide priority
+	press("k", "J")      // Move type down
+	press("esc")
+	if m.showColumnEditor || !m.listColumns.equal(DefaultListColumns()) {
+		t.Fatal("esc should close without changing the columns")
+	}
+
+	press("f4", "j", "J", "J") // Type after status
+	press("k", "k", " ")       // Hide priority
+	press("j", "j", "+", "+", "enter")
+	want := ListColumns{{Name: ColumnRepo}, {Name: ColumnStatus}, {Name: ColumnType, Width: 6}, {Name: ColumnID}, {Name: ColumnTitle}}
+	if !m.listColumns[:5].equal(want) || m.listColumns.Has(ColumnPriority) {
+		t.Fatalf("columns = %v", m.listColumns)
+	}
+	saved, err := LoadListColumns()
+	if err != nil || !saved.equal(m.listColumns) {
+		t.Errorf("saved %v, %v", saved, err)
+	}
+
+	// The title can't be hidden
+	press("f4")
+	for m.columnEditor.rows[m.columnEditor.selectedIndex].name != ColumnTitle {
+		press("j")
+	}
+	press(" ", "enter")
+	if !m.listColumns.Has(ColumnTitle) {
+		t.Error("title column was hidden")
+	}
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// listSortFields is the order s cycles the list sort through. "" is the
+// default order: open first, then priority, then newest.
+var listSortFields = []string{"", "priority", "created", "updated", "impact", "pagerank", "triage"}
+
+// sortDescendingByDefault reports whether a field sorts largest first when
+// picked: dates newest first and scores highest first
+func sortDescendingByDefault(field string) bool {
+	switch field {
+	case "created", "created_at", "updated", "updated_at", "impact", "pagerank", "triage":
+		return true
+	}
+	return false
+}
+
+// activeSort returns the list's sort field and direction: the one picked
+// with s, else the active recipe's. An empty field keeps the default order.
+func (m Model) activeSort() (field string, descending bool) {
+	if m.listSort != "" {
+		return m.listSort, m.listSortDesc
+	}
+	if m.recipeFilterActive() {
+		return m.activeRecipe.Sort.Field, m.activeRecipe.Sort.Direction == "desc"
+	}
+	return "", false
+}
+
+// sortLess returns the ascending order of a sort field. Unknown fields sort
+// by priority.
+func (m *Model) sortLess(field string) func(a, b *model.Issue) bool {
+	switch field {
+	case "created", "created_at":
+		return func(a, b *model.Issue) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	case "updated", "updated_at":
+		return func(a, b *model.Issue) bool { return a.UpdatedAt.Before(b.UpdatedAt) }
+	case "due", "due_date":
+		return recipe.DueLess
+	case "impact":
+		return func(a, b *model.Issue) bool {
+			return m.analysis.GetCriticalPathScore(a.ID) < m.analysis.GetCriticalPathScore(b.ID)
+		}
+	case "pagerank":
+		return func(a, b *model.Issue) bool {
+			return m.analysis.GetPageRankScore(a.ID) < m.analysis.GetPageRankScore(b.ID)
+		}
+	case "triage":
+		return func(a, b *model.Issue) bool { return m.triageScores[a.ID] < m.triageScores[b.ID] }
+	default:
+		return func(a, b *model.Issue) bool { return a.Priority < b.Priority }
+	}
+}
+
+// sortListed orders the listed items and their issues by field. Ties keep
+// the order of m.issues.
+func (m *Model) sortListed(items []list.Item, issues []model.Issue, field string, descending bool) {
+	if field == "" {
+		return
+	}
+	if field == "triage" {
+		m.ensureStartup(startupTriage)
+	}
+	less := m.sortLess(field)
+	switch {
+	case descending && (field == "due" || field == "due_date"):
+		// Undated issues stay last when the due order is reversed
+		less = recipe.DueLessDesc
+	case descending:
+		asc := less
+		less = func(a, b *model.Issue) bool { return asc(b, a) }
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].(IssueItem), items[j].(IssueItem)
+		return less(&a.Issue, &b.Issue)
+	})
+	sort.SliceStable(issues, func(i, j int) bool { return less(&issues[i], &issues[j]) })
+}
+
+// cycleListSort moves the list to the next sort field, in its natural
+// direction, keeping the selected issue selected
+func (m *Model) cycleListSort() {
+	next := listSortFields[1]
+	for i, f := range listSortFields {
+		if f == m.listSort {
+			// Past the last field the list returns to its default (or the
+			// recipe's) order
+			next = listSortFields[(i+1)%len(listSortFields)]
+			break
+		}
+	}
+	m.setListSort(next, sortDescendingByDefault(next))
+}
+
+// reverseListSort flips the direction of the active sort
+func (m *Model) reverseListSort() {
+	field, descending := m.activeSort()
+	if field == "" {
+		m.statusMsg = "Default order: press s to pick a sort first"
+		m.statusIsError = false
+		return
+	}
+	m.setListSort(field, !descending)
+}
+
+func (m *Model) setListSort(field string, descending bool) {
+	var selectedID string
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		selectedID = item.Issue.ID
+	}
+	m.listSort, m.listSortDesc = field, descending
+	m.applyFilter()
+	if i := m.listIndexOf(selectedID); i >= 0 {
+		m.list.Select(i)
+		m.updateViewportContent()
+	}
+	if label := m.sortLabel(); label != "" {
+		m.statusMsg = "Sort: " + label
+	} else {
+		m.statusMsg = "Sort: default order"
+	}
+	m.statusIsError = false
+}
+
+// sortLabel describes the active sort for the footer, e.g. "created ↓", or
+// "" for the default order
+func (m Model) sortLabel() string {
+	field, descending := m.activeSort()
+	if field == "" {
+		return ""
+	}
+	arrow := "↑"
+	if descending {
+		arrow = "↓"
+	}
+	return fmt.Sprintf("%s %s", field, arrow)
+}
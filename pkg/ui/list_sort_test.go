@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func listIDs(m Model) string {
+	var ids []string
+	for _, issue := range m.FilteredIssues() {
+		ids = append(ids, issue.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestListSortCycling(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "so-1", Title: "One", Status: model.StatusOpen, Priority: 2, CreatedAt: base, UpdatedAt: base.Add(48 * time.Hour)},
+		{ID: "so-2", Title: "Two", Status: model.StatusOpen, Priority: 0, CreatedAt: base.Add(24 * time.Hour), UpdatedAt: base},
+		{ID: "so-3", Title: "Three", Status: model.StatusOpen, Priority: 1, CreatedAt: base.Add(48 * time.Hour), UpdatedAt: base.Add(24 * time.Hour)},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	sized, _ := m.Update(tea.WindowSizeMsg{Width: 200, Height: 30})
+	m = sized.(Model)
+	press := func(keys ...string) {
+		for _, key := range keys {
+			updated, _ := m.Update(keyMsgFromString(key))
+			m = updated.(Model)
+		}
+	}
+
+	if got := listIDs(m); got != "so-2,so-3,so-1" || m.sortLabel() != "" {
+		t.Fatalf("default order = %s (sort %q)", got, m.sortLabel())
+	}
+	m.list.Select(m.listIndexOf("so-1"))
+
+	press("s")
+	if got := listIDs(m); got != "so-2,so-3,so-1" || m.sortLabel() != "priority ↑" {
+		t.Errorf("priority sort = %s (%q)", got, m.sortLabel())
+	}
+	press("s")
+	if got := listIDs(m); got != "so-3,so-2,so-1" || m.sortLabel() != "created ↓" {
+		t.Errorf("created sort = %s (%q)", got, m.sortLabel())
+	}
+	if selectedID(m) != "so-1" {
+		t.Errorf("sorting should keep so-1 selected, got %s", selectedID(m))
+	}
+	press("~")
+	if got := listIDs(m); got != "so-1,so-2,so-3" || m.sortLabel() != "created ↑" {
+		t.Errorf("reversed created sort = %s (%q)", got, m.sortLabel())
+	}
+	m.statusMsg = "" // The badge shows once the "Sort: ..." message clears
+	if !strings.Contains(m.renderFooter(), "⇅ created ↑") {
+		t.Error("footer should show the active sort")
+	}
+	press("s")
+	if got := listIDs(m); got != "so-1,so-3,so-2" || m.sortLabel() != "updated ↓" {
+		t.Errorf("updated sort = %s (%q)", got, m.sortLabel())
+	}
+
+	// impact, pagerank, triage, then back to the default order
+	press("s", "s", "s")
+	if m.sortLabel() != "triage ↓" {
+		t.Errorf("sort = %q, want triage ↓", m.sortLabel())
+	}
+	press("s")
+	if got := listIDs(m); got != "so-2,so-3,so-1" || m.sortLabel() != "" {
+		t.Errorf("back to default = %s (%q)", got, m.sortLabel())
+	}
+	press("~")
+	if m.statusMsg != "Default order: press s to pick a sort first" {
+		t.Errorf("reversing the default order: status %q", m.statusMsg)
+	}
+}
+
+func TestTriageRecipeSortsByTriageScore(t *testing.T) {
+	m := filterStackTestModel(t)
+	updated, _ := m.Update(keyMsgFromString("S"))
+	m = updated.(Model)
+	if m.sortLabel() != "triage ↓" {
+		t.Fatalf("sort = %q, want the recipe's triage ↓", m.sortLabel())
+	}
+	shown := m.FilteredIssues()
+	for i := 1; i < len(shown); i++ {
+		if m.triageScores[shown[i-1].ID] < m.triageScores[shown[i].ID] {
+			t.Errorf("%s (%.3f) listed before %s (%.3f)", shown[i-1].ID, m.triageScores[shown[i-1].ID], shown[i].ID, m.triageScores[shown[i].ID])
+		}
+	}
+}
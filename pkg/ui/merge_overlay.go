@@ -0,0 +1,165 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openMergeForMarked opens the merge overlay for the two marked issues, or
+// for the one marked issue and the one under the cursor
+func (m *Model) openMergeForMarked() {
+	var ids []string
+	for _, issue := range m.markedIssues() {
+		ids = append(ids, issue.ID)
+	}
+	if len(ids) == 1 {
+		if item, ok := m.list.SelectedItem().(IssueItem); ok && item.Issue.ID != ids[0] {
+			ids = append(ids, item.Issue.ID)
+		}
+	}
+	if len(ids) != 2 {
+		m.statusMsg = "Mark the two issues to merge (space), then press U"
+		m.statusIsError = true
+		return
+	}
+
+	// Keep the older issue by default; it usually has the history
+	keepID, dropID := ids[0], ids[1]
+	if a, b := m.issueMap[keepID], m.issueMap[dropID]; a != nil && b != nil && b.CreatedAt.Before(a.CreatedAt) {
+		keepID, dropID = dropID, keepID
+	}
+	m.openMergeOverlay(keepID, dropID)
+}
+
+// openMergeOverlay previews merging dropID into keepID
+func (m *Model) openMergeOverlay(keepID, dropID string) {
+	if m.beadsPath == "" {
+		m.statusMsg = "Merging needs a beads file to write to (not available for stdin, workspace or imported issues)"
+		m.statusIsError = true
+		return
+	}
+	plan, err := loader.PlanMerge(m.beadsPath, keepID, dropID, time.Now().UTC())
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Can't merge: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.mergePlan = plan
+	m.showMerge = true
+}
+
+// handleMergeKeys handles keys while the merge overlay is open
+func (m Model) handleMergeKeys(msg tea.KeyMsg) Model {
+	keepID, dropID := m.mergePlan.Keep.ID, m.mergePlan.Superseded.ID
+	switch msg.String() {
+	case "tab", "s":
+		// Swap which issue survives
+		m.openMergeOverlay(dropID, keepID)
+	case "enter", "y":
+		m.showMerge = false
+		if _, err := loader.ApplyMerge(m.beadsPath, keepID, dropID, time.Now().UTC()); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Merge failed: %v", err)
+			m.statusIsError = true
+			return m
+		}
+		clear(m.marked)
+		if m.markedOnly {
+			m.markedOnly = false
+			m.applyFilter()
+		}
+		m.jumpToIssue(keepID)
+		m.statusMsg = fmt.Sprintf("🔀 Merged %s into %s (%s is now closed as superseded)", dropID, keepID, dropID)
+		m.statusIsError = false
+	case "esc", "q", "n":
+		m.showMerge = false
+	}
+	return m
+}
+
+// renderMergeOverlay renders the merge preview
+func (m Model) renderMergeOverlay() string {
+	t := m.theme
+	plan := m.mergePlan
+	keep, sup := plan.Keep, plan.Superseded
+
+	boxWidth := min(90, m.width-4)
+	textWidth := boxWidth - 8
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(12)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	keepStyle := t.Renderer.NewStyle().Foreground(t.Open).Bold(true)
+	dropStyle := t.Renderer.NewStyle().Foreground(t.Closed).Strikethrough(true)
+
+	row := func(label, value string) string {
+		return labelStyle.Render(label) + truncateRunesHelper(value, textWidth-12, "…") + "\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🔀 Merge Issues"))
+	sb.WriteString("\n\n")
+	sb.WriteString(labelStyle.Render("Keep") + keepStyle.Render(truncateRunesHelper(keep.ID+"  "+keep.Title, textWidth-12, "…")) + "\n")
+	sb.WriteString(labelStyle.Render("Supersede") + dropStyle.Render(truncateRunesHelper(sup.ID+"  "+sup.Title, textWidth-12, "…")) + "\n\n")
+
+	sb.WriteString(row("Priority", fmt.Sprintf("P%d", keep.Priority)))
+	if len(keep.Labels) > 0 {
+		sb.WriteString(row("Labels", strings.Join(keep.Labels, ", ")))
+	}
+	var deps []string
+	for _, dep := range keep.Dependencies {
+		if dep != nil {
+			deps = append(deps, dep.DependsOnID)
+		}
+	}
+	if len(deps) > 0 {
+		sb.WriteString(row("Depends on", strings.Join(deps, ", ")))
+	}
+	if len(keep.Comments) > 0 {
+		sb.WriteString(row("Comments", fmt.Sprintf("%d", len(keep.Comments))))
+	}
+	if len(plan.Fields) > 0 {
+		sb.WriteString(row("Changes", strings.Join(plan.Fields, ", ")))
+	}
+	if len(plan.Retargeted) > 0 {
+		sb.WriteString(row("Retarget", strings.Join(plan.Retargeted, ", ")))
+	}
+
+	if desc := strings.TrimSpace(keep.Description); desc != "" {
+		sb.WriteString("\n")
+		sb.WriteString(mutedStyle.Render("Description"))
+		sb.WriteString("\n")
+		lines := strings.Split(desc, "\n")
+		maxLines := max(3, m.height-24)
+		if len(lines) > maxLines {
+			lines = append(lines[:maxLines], "…")
+		}
+		for _, line := range lines {
+			sb.WriteString(truncateRunesHelper(line, textWidth, "…"))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("%s will be closed, labeled %q and linked to %s.", sup.ID, loader.SupersededLabel, keep.ID)))
+	sb.WriteString("\n\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("Enter: merge • Tab: swap which issue is kept • Esc: cancel"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
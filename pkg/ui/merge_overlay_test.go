@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_MergeOverlay(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"mg-1","title":"Login fails","description":"Users can't log in","status":"open","priority":2,"issue_type":"bug","created_at":"2025-01-02T00:00:00Z","updated_at":"2025-01-02T00:00:00Z"}
+{"id":"mg-2","title":"Cannot sign in","description":"Spinner forever","status":"open","priority":1,"issue_type":"bug","labels":["ui"],"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}
+{"id":"mg-3","title":"Release","status":"open","priority":2,"issue_type":"task","created_at":"2025-01-03T00:00:00Z","updated_at":"2025-01-03T00:00:00Z","dependencies":[{"issue_id":"mg-3","depends_on_id":"mg-1","type":"blocks"}]}
+`
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("U")
+	if m.showMerge || !m.statusIsError {
+		t.Fatal("U without two issues should only report an error")
+	}
+
+	m.marked["mg-1"] = true
+	m.marked["mg-2"] = true
+	press("U")
+	if !m.showMerge {
+		t.Fatalf("Expected the merge overlay, status %q", m.statusMsg)
+	}
+	// The older issue is kept by default
+	if m.mergePlan.Keep.ID != "mg-2" || m.mergePlan.Superseded.ID != "mg-1" {
+		t.Errorf("Default keep = %s, want mg-2", m.mergePlan.Keep.ID)
+	}
+	press("tab")
+	if m.mergePlan.Keep.ID != "mg-1" {
+		t.Errorf("Tab should swap the survivor, keep = %s", m.mergePlan.Keep.ID)
+	}
+	view := m.View()
+	for _, want := range []string{"Merge Issues", "Login fails", "Merged from mg-2", "P1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q", want)
+		}
+	}
+
+	press("enter")
+	if m.showMerge || m.statusIsError || len(m.marked) != 0 {
+		t.Fatalf("Merge should close the overlay and clear marks, status %q", m.statusMsg)
+	}
+	if selectedID(m) != "mg-1" {
+		t.Errorf("Selection should move to the kept issue, got %q", selectedID(m))
+	}
+	reloaded, err := loader.LoadIssuesFromFile(beadsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range reloaded {
+		if issue.ID == "mg-2" && issue.Status != model.StatusClosed {
+			t.Errorf("mg-2 should be closed, got %s", issue.Status)
+		}
+		if issue.ID == "mg-1" && (issue.Priority != 1 || strings.Join(issue.Labels, ",") != "ui") {
+			t.Errorf("mg-1 = P%d %v", issue.Priority, issue.Labels)
+		}
+	}
+}
@@ -2,22 +2,28 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/updater"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/watcher"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -48,9 +54,19 @@ const (
 	focusQuitConfirm
 	focusTimeTravelInput
 	focusHistory
-	focusAttention
 	focusLabelPicker
 	focusSprint // Sprint dashboard view (bv-161)
+	focusTimeline
+	focusHeatmap
+	focusThemePicker
+	focusCommentEditor
+	focusJumpList
+	focusGoto
+	focusExportScope
+	focusTriageSession
+	focusSnoozeInput
+
+	focusStates // Number of focus states, for tests that walk them all
 )
 
 // LabelGraphAnalysisResult holds label-specific graph analysis results (bv-109)
@@ -108,33 +124,13 @@ type HistoryLoadedMsg struct {
 	Error  error
 }
 
-// LoadHistoryCmd returns a command that loads history data in the background
-func LoadHistoryCmd(issues []model.Issue, beadsPath string) tea.Cmd {
+// LoadHistoryCmd returns a command that loads history data in the background.
+// It stops when ctx is done and reports progress as commits are correlated.
+func LoadHistoryCmd(ctx context.Context, issues []model.Issue, beadsPath string, progress func(done, total int)) tea.Cmd {
 	return func() tea.Msg {
-		var repoPath string
-		var err error
-
-		if beadsPath != "" {
-			// If beadsPath is provided (single-repo mode), derive repo root from it.
-			// Try to resolve absolute path first.
-			if absPath, e := filepath.Abs(beadsPath); e == nil {
-				dir := filepath.Dir(absPath)
-				// Standard layout: <repo_root>/.beads/<file.jsonl>
-				if filepath.Base(dir) == ".beads" {
-					repoPath = filepath.Dir(dir)
-				} else {
-					// Legacy/Flat layout: <repo_root>/<file.jsonl>
-					repoPath = dir
-				}
-			}
-		}
-
-		// Fallback to CWD if beadsPath is empty (workspace mode) or Abs failed
+		repoPath := projectDirFromBeadsPath(beadsPath)
 		if repoPath == "" {
-			repoPath, err = os.Getwd()
-			if err != nil {
-				return HistoryLoadedMsg{Error: err}
-			}
+			return HistoryLoadedMsg{Error: fmt.Errorf("unable to determine repository root")}
 		}
 
 		// Convert model.Issue to correlation.BeadInfo
@@ -149,14 +145,42 @@ func LoadHistoryCmd(issues []model.Issue, beadsPath string) tea.Cmd {
 
 		correlator := correlation.NewCorrelator(repoPath, beadsPath)
 		opts := correlation.CorrelatorOptions{
-			Limit: 500, // Reasonable limit for TUI performance
+			Limit:    500, // Reasonable limit for TUI performance
+			Progress: progress,
 		}
 
-		report, err := correlator.GenerateReport(beads, opts)
+		report, err := correlator.GenerateReportContext(ctx, beads, opts)
 		return HistoryLoadedMsg{Report: report, Error: err}
 	}
 }
 
+// projectDirFromBeadsPath derives the project root from a beads.jsonl path.
+// Falls back to CWD if beadsPath is empty (workspace mode) or can't be resolved.
+func projectDirFromBeadsPath(beadsPath string) string {
+	if beadsPath != "" {
+		if absPath, err := filepath.Abs(beadsPath); err == nil {
+			dir := filepath.Dir(absPath)
+			// Standard layout: <repo_root>/.beads/<file.jsonl>
+			if filepath.Base(dir) == ".beads" {
+				return filepath.Dir(dir)
+			}
+			// Legacy/Flat layout: <repo_root>/<file.jsonl>
+			return dir
+		}
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return cwd
+}
+
+// analysisIssues returns the issues that feed graph metrics and triage,
+// i.e. all loaded issues minus any types excluded via .bv/analysis.yaml.
+func (m *Model) analysisIssues() []model.Issue {
+	return m.typeToggles.Apply(m.issues)
+}
+
 // Model is the main Bubble Tea model for the beads viewer
 type Model struct {
 	// Data
@@ -166,6 +190,17 @@ type Model struct {
 	analysis  *analysis.GraphStats
 	beadsPath string           // Path to beads.jsonl for reloading
 	watcher   *watcher.Watcher // File watcher for live reload
+	// Workspace mode: watches every repo's beads file; workspaceReload
+	// re-reads just the repos that changed
+	workspaceWatcher *watcher.MultiWatcher
+	workspaceReload  func(repos []string) ([]model.Issue, error)
+
+	// Issue types excluded from graph metrics and triage (.bv/analysis.yaml)
+	typeToggles analysis.TypeToggles
+
+	// Triage weights (.bv/triage.yaml); r in the insights view reloads them
+	triageProfile     analysis.TriageProfile
+	triageProfileName string // Profile chosen with --triage-profile; "" uses the file's
 
 	// UI Components
 	list               list.Model
@@ -197,7 +232,9 @@ type Model struct {
 	showQuitConfirm          bool
 	ready                    bool
 	width                    int
-	height                   int
+	height                   int // Rows for body and footer (less the announce line)
+	screenHeight             int // Terminal rows
+	showAnnounce             bool
 	showLabelHealthDetail    bool
 	showLabelDrilldown       bool
 	labelHealthDetail        *analysis.LabelHealth
@@ -221,13 +258,35 @@ type Model struct {
 	// History view
 	historyView       HistoryModel
 	historyLoading    bool // True while history is being loaded in background
+	// Background operations in flight (graph metrics, semantic index,
+	// history), shown with progress in the footer; esc cancels them
+	ops *opTracker
+	// Startup timings and the subsystems deferred until first use or idle
+	startup *startupScheduler
 	historyLoadFailed bool // True if history loading failed
+	gitErr            error // Why history and time-travel are off (nil when git is usable)
+	// Commits and lines changed per issue from history, for chain effort
+	// estimates in the Keystones panel (nil until history loads)
+	observedEffort map[string]analysis.IssueEffort
+	// When git history saw each issue created, started and closed, for
+	// cycle time (nil until history loads)
+	lifecycle map[string]analysis.LifecycleTimes
 
 	// Filter state
 	currentFilter         string
 	semanticSearchEnabled bool
 	semanticIndexBuilding bool
 	semanticSearch        *SemanticSearch
+	semanticIndexPath     string // Where the loaded index is saved
+	queryFilter           *QueryFilter
+	// Sort picked with s, overriding the default and recipe order ("" for none)
+	listSort     string
+	listSortDesc bool
+
+	// Similar-issue clusters for the insights card
+	similarityClusters      []search.SimilarityCluster
+	similarityClustersReady bool
+	similarityDuplicates    []search.DuplicatePair
 
 	// Stats (cached)
 	countOpen    int
@@ -256,10 +315,21 @@ type Model struct {
 	showLabelPicker bool
 	labelPicker     LabelPickerModel
 
+	// Mouse: split view ratio (drag the divider) and double-click tracking
+	splitRatio     float64
+	draggingSplit  bool
+	lastClickAt    time.Time
+	lastClickIndex int
+
 	// Repo picker (workspace mode)
 	showRepoPicker bool
 	repoPicker     RepoPickerModel
 
+	// Theme picker (builtin and .bv/themes/ color schemes)
+	showThemePicker bool
+	themePicker     ThemePickerModel
+	themes          []NamedTheme
+
 	// Time-travel mode
 	timeTravelMode   bool
 	timeTravelDiff   *analysis.SnapshotDiff
@@ -272,6 +342,46 @@ type Model struct {
 	timeTravelInput      textinput.Model
 	showTimeTravelPrompt bool
 
+	// Comment editor overlay
+	commentInput      textarea.Model
+	showCommentEditor bool
+	commentIssueID    string
+	commentAuthor     string
+
+	// Triage session (z): walks untriaged issues one by one for quick
+	// priority, label and assignee edits, or snoozes them
+	showTriageSession   bool
+	triageSessionQueue  []string
+	triageSessionPos    int
+	triageSessionDone   map[string]string // Outcome per issue visited: triaged, snoozed or skipped
+	triageSessionInput  textinput.Model
+	triageSessionField  string // "label" or "assignee" while one is typed
+	triageSessionReturn focus
+
+	// Snoozed issues (.bv/snoozed.yaml) and the u prompt asking until when
+	snoozed          map[string]time.Time
+	showSnoozePrompt bool
+	snoozeInput      textinput.Model
+	snoozeIssueID    string
+	snoozeReturn     focus
+
+	// Recently viewed issues and the ctrl+o jump list
+	recent         *RecentIssues
+	jumpList       JumpListModel
+	showJumpList   bool
+	jumpListReturn focus // Focus to restore when the jump list closes
+
+	// Markdown export scope picker (E)
+	exportScopePicker     ExportScopePickerModel
+	showExportScopePicker bool
+	exportScopeReturn     focus // Focus to restore when the picker closes
+
+	// Go-to-issue prompt (: or #)
+	gotoPicker GotoPickerModel
+	showGoto   bool
+	gotoReturn focus
+	gotoGraph  bool // Prompt jumps to a graph node instead of opening the issue
+
 	// Status message (for temporary feedback)
 	statusMsg     string
 	statusIsError bool
@@ -280,6 +390,7 @@ type Model struct {
 	workspaceMode    bool            // True when viewing multiple repos
 	availableRepos   []string        // List of repo prefixes available
 	activeRepos      map[string]bool // Which repos are currently shown (nil = all)
+	readOnlyRepos    map[string]bool // Repos marked read_only in the workspace config
 	workspaceSummary string          // Summary text for footer (e.g., "3 repos")
 
 	// Alerts panel (bv-168)
@@ -289,21 +400,205 @@ type Model struct {
 	alertsInfo      int
 	showAlertsPanel bool
 	alertsCursor    int
-	dismissedAlerts map[string]bool
+	dismissedAlerts map[string]bool // Keys of active alerts dismissed in alertHistory
+
+	// Alert log (.bv/alerts.json): when each alert appeared, resolved and
+	// was dismissed. The alerts panel's history tab (tab) lists it.
+	alertHistory     *drift.History
+	alertsHistoryTab bool
+
+	// Baselines panel (ctrl+b): stored baselines compared with the current
+	// metrics. baselineSnapshotDay is the day a daily snapshot was last
+	// considered, so each session checks once a day.
+	showBaselines       bool
+	baselines           []baseline.Entry
+	baselinesCursor     int
+	baselineCurrent     *baseline.Baseline
+	baselineDriftConfig *drift.Config
+	baselineSnapshotDay string
+
+	// Validation panel: schema problems in the beads file
+	showValidationPanel bool
+	validationReport    loader.ValidationReport
+	validationCursor    int
+
+	// Dangling-dependency repair overlay
+	showRepair   bool
+	repairItems  []analysis.DanglingDependency
+	repairCursor int
+	repairChoice int // Index into the selected item's suggestions
+
+	// Cycle breaker: proposed dependency cuts to review and apply
+	showCycleBreak bool
+	cycleBreakPlan analysis.CycleBreakPlan
+	cycleCutCursor int
+
+	// Bottlenecks panel: issues whose removal disconnects the graph
+	showBottlenecks  bool
+	bottlenecks      []analysis.Bottleneck
+	bottleneckCursor int
+
+	// History scrubber: weekly samples of the backlog's health from git
+	showScrubber bool
+	scrubLoading bool
+	scrubPlaying bool
+	scrubFrames  []ScrubFrame
+	scrubIndex   int
+
+	// Field history: the selected issue's field changes, commit by commit
+	showFieldHistory    bool
+	fieldHistoryLoading bool
+	fieldHistoryID      string
+	fieldHistory        []FieldHistoryEntry
+	fieldHistoryScroll  int
+
+	// Lead and cycle time analytics
+	showLeadTime   bool
+	leadTimeReport analysis.LeadCycleReport
+	leadTimeTab    int
+	leadTimeCursor int
+
+	// Per-repo health comparison (workspace mode)
+	showRepoDashboard bool
+	repoHealth        []RepoHealth
+	repoDashCursor    int
+	repoDashSort      int
+
+	// Issue merge overlay
+	showMerge bool
+	mergePlan loader.MergePlan
+
+	// Side-by-side compare of two issues
+	showCompare   bool
+	compareIDs    [2]string   // Left, right
+	compareLines  [2][]string // Rendered detail per side
+	compareScroll int         // Shared by both sides
 
 	// Sprint view (bv-161)
 	sprints        []model.Sprint
 	selectedSprint *model.Sprint
-	isSprintView   bool
-	sprintViewText string
+	isSprintView       bool
+	sprintViewText     string
+	showSprintVelocity bool // Velocity forecast over the dashboard
+
+	// Timeline (Gantt-style) view
+	isTimelineView bool
+	timelineView   TimelineModel
+
+	// Activity heatmap + staleness view (D)
+	isHeatmapView bool
+	heatmapView   HeatmapModel
+
+	// Pinned epic/label shown in the footer (.bv/pin.yaml)
+	pin         Pin
+	pinProgress PinProgress
+
+	// Tracker URL template for paste-to-jump (.bv/tracker.yaml)
+	tracker TrackerConfig
+
+	// Work sessions (sidecar time log in the beads directory; nil if unavailable)
+	timeLog *analysis.TimeLog
+
+	// Deterministic exports (bv --stable)
+	stableExport bool
+
+	// Optional SQLite mirror of the beads file (bv --sqlite-store); reloads
+	// decode only the changed lines and merge them into issues
+	issueStore *loader.SQLiteStore
+	// Without a store, reloads go through an in-memory line-hash diff
+	issueLoader *loader.IncrementalLoader
+	// Analyses persisted across sessions (.bv/cache); nil without a beads file
+	analysisDiskCache *analysis.DiskCache
+	// The analyzer behind analysis, which persists it once Phase 2 is done
+	cachedAnalyzer *analysis.CachedAnalyzer
+
+	// Multi-select marks in the list, keyed by issue ID. Shared with the list
+	// delegate, so it is cleared in place rather than replaced.
+	marked     map[string]bool
+	markedOnly bool // List shows only marked issues (M)
+
+	// Issues subscribed to with B (.bv/subscriptions.yaml), shared with the
+	// list delegate like marked. Reloads that change them queue entries in
+	// changes, newest first, shown by the changes overlay (N).
+	subscribed    map[string]bool
+	changes       []IssueChange
+	unseenChanges int
+	showChanges   bool
+	changesCursor int
+
+	// Session journal (f5): the issues at launch, and when a reload last
+	// changed each issue, for reviewing what changed since then
+	journalBaseline  map[string]model.Issue
+	journalStart     time.Time
+	journalChangedAt map[string]time.Time
+	journalReloads   int
+	journalEntries   []journalEntry
+	journalCursor    int
+	showJournal      bool
+
+	// Starred issues (.bv/starred.yaml), the user's watchlist, shared with
+	// the list delegate and the board like marked. Reloads queue their status
+	// changes and new blockers in changes too.
+	starred map[string]bool
+
+	// Ask-the-backlog panel (Q): questions go to BV_ASK_COMMAND, and the
+	// issue IDs the answer cites can be selected and opened
+	showAsk      bool
+	askInput     textinput.Model
+	askAnswer    string
+	askErr       error
+	askCitations []string
+	askCursor    int
+	askScroll    int
+	askPending   bool
+	askSeq       int // Bumped per question so stale answers are dropped
+
+	// Active filter kinds in the order they were applied; backspace pops the last
+	filterStack []string
+
+	// Active key bindings (.bv/keys.yaml on top of the defaults)
+	keymap *Keymap
+
+	// List column layout (~/.config/bv/columns.yaml) and its editor
+	listColumns      ListColumns
+	showColumnEditor bool
+	columnEditor     ColumnEditorModel
 }
 
 // NewModel creates a new Model from the given issues
 // beadsPath is the path to the beads.jsonl file for live reload support
 func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath string) Model {
+	// Each part of startup is timed for bv --profile-startup; subsystems the
+	// first frame doesn't need are deferred until first use or idle
+	startup := newStartupScheduler()
+
+	// Per-type analysis toggles: excluded types stay visible but don't feed
+	// metrics. A missing analysis.yaml means no toggles; a broken one falls
+	// back to none and says so.
+	typeToggles, togglesErr := analysis.LoadTypeToggles(projectDirFromBeadsPath(beadsPath))
+	if togglesErr != nil {
+		typeToggles = analysis.TypeToggles{}
+	}
+	triageProfile, _ := analysis.LoadTriageProfile(projectDirFromBeadsPath(beadsPath), "")
+
 	// Graph Analysis - Phase 1 is instant, Phase 2 runs in background
-	analyzer := analysis.NewAnalyzer(issues)
-	graphStats := analyzer.AnalyzeAsync(context.Background())
+	ops := newOpTracker()
+	// History, time-travel and correlation need git; everything else works
+	// on a plain directory
+	gitErr := loader.CheckGit(projectDirFromBeadsPath(beadsPath))
+	// Analyses of a beads file are kept in .bv/cache, so reopening an
+	// unchanged repo skips Phase 2
+	analysisCtx, analysisProgress := ops.start(opAnalysis)
+	var diskCache *analysis.DiskCache
+	if beadsPath != "" {
+		diskCache = analysis.NewDiskCache(analysis.DiskCacheDir(projectDirFromBeadsPath(beadsPath)))
+	}
+	cachedAnalyzer := analysis.NewCachedAnalyzer(typeToggles.Apply(issues), nil)
+	cachedAnalyzer.SetDiskCache(diskCache)
+	analyzer := cachedAnalyzer.Analyzer
+	analyzer.SetProgress(analysisProgress.report)
+	graphStats := cachedAnalyzer.AnalyzeAsync(analysisCtx)
+	startup.lap("analysis")
 
 	// Sort issues
 	if activeRecipe != nil && activeRecipe.Sort.Field != "" {
@@ -319,6 +614,12 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 				less = issues[i].CreatedAt.Before(issues[j].CreatedAt)
 			case "updated", "updated_at":
 				less = issues[i].UpdatedAt.Before(issues[j].UpdatedAt)
+			case "due", "due_date":
+				// Undated issues go last in either direction, so no flip
+				if descending {
+					return recipe.DueLessDesc(&issues[i], &issues[j])
+				}
+				return recipe.DueLess(&issues[i], &issues[j])
 			case "impact":
 				less = graphStats.GetCriticalPathScore(issues[i].ID) < graphStats.GetCriticalPathScore(issues[j].ID)
 			case "pagerank":
@@ -393,11 +694,34 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		}
 	}
 
-	// Theme
-	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	startup.lap("list")
+
+	// Theme: builtin or .bv/themes/*.yaml, as last picked in the theme picker
+	themes, themesErr := LoadThemes(projectDirFromBeadsPath(beadsPath))
+	themeName, _ := LoadThemeSelection(projectDirFromBeadsPath(beadsPath))
+	namedTheme, ok := FindTheme(themes, themeName)
+	if !ok {
+		namedTheme, _ = FindTheme(themes, DefaultThemeName)
+	}
+	theme := namedTheme.Build(lipgloss.NewRenderer(os.Stdout))
+	startup.lap("theme")
 
 	// List setup
-	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false}
+	marked := make(map[string]bool)
+	subscribed := make(map[string]bool)
+	subscribedIDs, _ := LoadSubscriptions(projectDirFromBeadsPath(beadsPath))
+	for _, id := range subscribedIDs {
+		subscribed[id] = true
+	}
+	starred := make(map[string]bool)
+	starredIDs, _ := LoadStarred(projectDirFromBeadsPath(beadsPath))
+	for _, id := range starredIDs {
+		starred[id] = true
+	}
+	snoozed, _ := LoadSnoozed(projectDirFromBeadsPath(beadsPath))
+	// The user's list columns; a broken columns.yaml falls back to the default
+	listColumns, columnsErr := LoadListColumns()
+	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false, Marked: marked, Subscribed: subscribed, Starred: starred, Columns: listColumns}
 	l := list.New(items, delegate, 0, 0)
 	l.Title = ""
 	l.SetShowTitle(false)
@@ -423,7 +747,10 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	renderer := NewMarkdownRendererWithTheme(80, theme)
 
 	// Initialize sub-components
-	board := NewBoardModel(issues, theme)
+	// The project's board columns; a broken board.yaml falls back to the default
+	boardConfig, boardErr := LoadBoardConfig(projectDirFromBeadsPath(beadsPath))
+	board := NewBoardModelWithConfig(issues, boardConfig, theme)
+	board.SetStarred(starred)
 	labelDashboard := NewLabelDashboardModel(theme)
 	velocityComparison := NewVelocityComparisonModel(theme) // bv-125
 	shortcutsSidebar := NewShortcutsSidebar(theme)          // bv-3qi5
@@ -434,56 +761,22 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	// Priority hints are generated asynchronously when Phase 2 completes
 	// This avoids blocking startup on expensive graph analysis
 	priorityHints := make(map[string]*analysis.PriorityRecommendation)
+	startup.lap("views")
 
-	// Compute triage insights (bv-151)
-	triageResult := analysis.ComputeTriage(issues)
-	triageScores := make(map[string]float64, len(triageResult.Recommendations))
-	triageReasons := make(map[string]analysis.TriageReasons, len(triageResult.Recommendations))
-	quickWinSet := make(map[string]bool, len(triageResult.QuickWins))
-	blockerSet := make(map[string]bool, len(triageResult.BlockersToClear))
-	unblocksMap := make(map[string][]string, len(triageResult.Recommendations))
-
-	for _, rec := range triageResult.Recommendations {
-		triageScores[rec.ID] = rec.Score
-		if len(rec.Reasons) > 0 {
-			triageReasons[rec.ID] = analysis.TriageReasons{
-				Primary:    rec.Reasons[0],
-				All:        rec.Reasons,
-				ActionHint: rec.Action,
-			}
-		}
-		unblocksMap[rec.ID] = rec.UnblocksIDs
-	}
-	for _, qw := range triageResult.QuickWins {
-		quickWinSet[qw.ID] = true
-	}
-	for _, bl := range triageResult.BlockersToClear {
-		blockerSet[bl.ID] = true
-	}
-
-	// Update items with triage data
-	for i := range items {
-		if issueItem, ok := items[i].(IssueItem); ok {
-			issueItem.TriageScore = triageScores[issueItem.Issue.ID]
-			if reasons, exists := triageReasons[issueItem.Issue.ID]; exists {
-				issueItem.TriageReason = reasons.Primary
-				issueItem.TriageReasons = reasons.All
-			}
-			issueItem.IsQuickWin = quickWinSet[issueItem.Issue.ID]
-			issueItem.IsBlocker = blockerSet[issueItem.Issue.ID]
-			issueItem.UnblocksCount = len(unblocksMap[issueItem.Issue.ID])
-			items[i] = issueItem
-		}
-	}
+	// Triage badges and scores fill in once computed (bv-151)
+	startup.later(startupTriage, (*Model).computeTriage)
 
-	// Initialize recipe loader
-	recipeLoader := recipe.NewLoader()
-	_ = recipeLoader.Load() // Load recipes (errors are non-fatal, will just show empty)
-	recipePicker := NewRecipePickerModel(recipeLoader.List(), theme)
+	// Recipes are read from disk when the picker or a recipe key needs them
+	startup.later(startupRecipes, func(m *Model) {
+		_ = m.recipeLoader.Load() // Errors are non-fatal, will just show empty
+		m.recipePicker = NewRecipePickerModel(m.recipeLoader.List(), m.theme)
+	})
 
-	// Initialize label picker (bv-126)
-	labelExtraction := analysis.ExtractLabels(issues)
-	labelPicker := NewLabelPickerModel(labelExtraction.Labels, theme)
+	// The label picker extracts labels each time it opens (bv-126); this
+	// only warms it up
+	startup.later(startupLabels, func(m *Model) {
+		m.labelPicker.SetLabels(analysis.ExtractLabels(m.issues).Labels)
+	})
 
 	// Initialize time-travel input
 	ti := textinput.New()
@@ -509,6 +802,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 			fileWatcher = w
 		}
 	}
+	startup.lap("watcher")
 
 	// Semantic search (bv-9gf.3): initialized lazily on first toggle.
 	semanticSearch := NewSemanticSearch()
@@ -520,6 +814,12 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	}
 	semanticSearch.SetIDs(semanticIDs)
 
+	// Query language for `/` search (e.g. "status:open AND priority<=1")
+	queryFilter := NewQueryFilter()
+	queryFilter.SetItems(items)
+	l.Filter = queryFilter.Wrap(list.DefaultFilter)
+	startup.lap("search")
+
 	// Build initial status message if watcher failed
 	var initialStatus string
 	var initialStatusErr bool
@@ -528,25 +828,74 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		initialStatusErr = true
 	}
 
-	// Precompute drift/health alerts (bv-168)
-	alerts, alertsCritical, alertsWarning, alertsInfo := computeAlerts(issues, graphStats, analyzer)
+	// Drift/health alerts (bv-168) are computed once idle, and again with the
+	// full metrics when Phase 2 completes
+	startup.later(startupAlerts, func(m *Model) {
+		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+	})
+	alertHistory, _ := drift.LoadHistory(projectDirFromBeadsPath(beadsPath))
+
+	// Restore the pinned epic/label so its progress stays in the footer
+	pin, _ := LoadPin(projectDirFromBeadsPath(beadsPath))
+
+	// Tracker URLs pasted with ctrl+v resolve to issues through this template
+	tracker, _ := LoadTrackerConfig(projectDirFromBeadsPath(beadsPath))
+
+	// Remapped shortcuts; a broken keys.yaml falls back to the defaults
+	keymap, keymapErr := LoadKeymap(projectDirFromBeadsPath(beadsPath))
+	if initialStatus == "" && themesErr != nil {
+		initialStatus = fmt.Sprintf("Skipped broken theme: %v", themesErr)
+		initialStatusErr = true
+	}
+	if initialStatus == "" {
+		if keymapErr != nil {
+			initialStatus = fmt.Sprintf("Using default keys: %v", keymapErr)
+			initialStatusErr = true
+		} else if columnsErr != nil {
+			initialStatus = fmt.Sprintf("Using default columns: %v", columnsErr)
+			initialStatusErr = true
+		} else if boardErr != nil {
+			initialStatus = fmt.Sprintf("Using default board: %v", boardErr)
+			initialStatusErr = true
+		} else if togglesErr != nil {
+			initialStatus = fmt.Sprintf("Analyzing all issue types: %v", togglesErr)
+			initialStatusErr = true
+		} else if conflicts := keymap.Conflicts(); len(conflicts) > 0 {
+			initialStatus = fmt.Sprintf("Key conflict in %s: %s", KeymapFilename, conflicts[0])
+			if len(conflicts) > 1 {
+				initialStatus += fmt.Sprintf(" (+%d more; see bv --check-keys)", len(conflicts)-1)
+			}
+			initialStatusErr = true
+		}
+	}
+	startup.lap("settings")
 
 	// Load sprints from the same directory as beadsPath (bv-161)
 	var sprints []model.Sprint
+	var timeLog *analysis.TimeLog
 	if beadsPath != "" {
 		beadsDir := filepath.Dir(beadsPath)
 		if loaded, err := loader.LoadSprintsFromFile(filepath.Join(beadsDir, loader.SprintsFileName)); err == nil {
 			sprints = loaded
 		}
+		// Work session time log lives alongside beads.jsonl
+		if loaded, err := analysis.LoadTimeLog(beadsDir); err == nil {
+			timeLog = loaded
+		}
 	}
+	startup.lap("sprints")
 
-	return Model{
+	m := Model{
 		issues:              issues,
 		issueMap:            issueMap,
 		analyzer:            analyzer,
 		analysis:            graphStats,
+		analysisDiskCache:   diskCache,
+		cachedAnalyzer:      cachedAnalyzer,
 		beadsPath:           beadsPath,
 		watcher:             fileWatcher,
+		typeToggles:         typeToggles,
+		triageProfile:       triageProfile,
 		list:                l,
 		renderer:            renderer,
 		board:               board,
@@ -556,8 +905,17 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		graphView:           graphView,
 		insightsPanel:       insightsPanel,
 		theme:               theme,
+		themes:              themes,
+		splitRatio:          defaultSplitRatio,
 		currentFilter:       "all",
+		marked:              marked,
+		subscribed:          subscribed,
+		starred:             starred,
+		journalBaseline:     snapshotIssues(issues),
+		journalStart:        time.Now(),
+		snoozed:             snoozed,
 		semanticSearch:      semanticSearch,
+		queryFilter:         queryFilter,
 		focused:             focusList,
 		countOpen:           cOpen,
 		countReady:          cReady,
@@ -565,29 +923,48 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		countClosed:         cClosed,
 		priorityHints:       priorityHints,
 		showPriorityHints:   false, // Off by default, toggle with 'p'
-		triageScores:        triageScores,
-		triageReasons:       triageReasons,
-		unblocksMap:         unblocksMap,
-		quickWinSet:         quickWinSet,
-		blockerSet:          blockerSet,
-		recipeLoader:        recipeLoader,
-		recipePicker:        recipePicker,
+		recipeLoader:        recipe.NewLoader(),
+		recipePicker:        NewRecipePickerModel(nil, theme),
 		activeRecipe:        activeRecipe,
-		labelPicker:         labelPicker,
+		labelPicker:         NewLabelPickerModel(nil, theme),
 		labelDrilldownCache: make(map[string][]model.Issue),
 		timeTravelInput:     ti,
+		commentInput:        newCommentInput(),
+		recent:              NewRecentIssues(nil),
 		statusMsg:           initialStatus,
 		statusIsError:       initialStatusErr,
-		historyLoading:      len(issues) > 0, // Will be loaded in Init()
+		historyLoading:      len(issues) > 0 && gitErr == nil, // Will be loaded in Init()
+		gitErr:              gitErr,
+		ops:                 ops,
+		startup:             startup,
 		// Alerts panel (bv-168)
-		alerts:          alerts,
-		alertsCritical:  alertsCritical,
-		alertsWarning:   alertsWarning,
-		alertsInfo:      alertsInfo,
-		dismissedAlerts: make(map[string]bool),
+		dismissedAlerts: alertHistory.Dismissed(),
+		alertHistory:    alertHistory,
 		// Sprint view (bv-161)
 		sprints: sprints,
+		// Pinned epic/label
+		pin:         pin,
+		pinProgress: ComputePinProgress(pin, issues),
+		tracker:     tracker,
+		// Work sessions
+		timeLog: timeLog,
+		// Key bindings
+		keymap: keymap,
+		// List columns
+		listColumns: listColumns,
+	}
+
+	// Hide snoozed issues from the first frame
+	if m.activeSnoozes(time.Now()) > 0 {
+		m.applyFilter()
+	}
+
+	// Restore the split ratio, view, and filter from the last run
+	if state, err := LoadUIState(projectDirFromBeadsPath(beadsPath)); err == nil {
+		m.restoreUIState(state)
 	}
+	startup.lap("restore")
+	return m
 }
 
 func (m Model) Init() tea.Cmd {
@@ -595,44 +972,103 @@ func (m Model) Init() tea.Cmd {
 	if m.watcher != nil {
 		cmds = append(cmds, WatchFileCmd(m.watcher))
 	}
+	if m.workspaceWatcher != nil {
+		cmds = append(cmds, WatchWorkspaceCmd(m.workspaceWatcher))
+	}
 	// Start loading history in background
-	if len(m.issues) > 0 {
-		cmds = append(cmds, LoadHistoryCmd(m.issues, m.beadsPath))
+	if len(m.issues) > 0 && m.gitErr == nil {
+		ctx, progress := m.ops.start(opHistory)
+		cmds = append(cmds, LoadHistoryCmd(ctx, m.issues, m.beadsPath, progress.report))
+	}
+	cmds = append(cmds, m.ops.tickCmd(), startupIdleCmd())
+	// Resume the footer timer for a session left running by a previous run
+	if m.activeWorkSession() != nil {
+		cmds = append(cmds, WorkSessionTickCmd())
 	}
 	return tea.Batch(cmds...)
 }
 
+// Update handles a message. When it switches views, the new view selects the
+// issue that was selected in the old one, unless the handler picked an issue
+// itself (goto, jump list, enter on a board card).
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	view, id, listID := m.selectionView(), m.currentIssueID(), m.selectedListID()
+	updated, cmd := m.update(msg)
+	if next, ok := updated.(Model); ok && id != "" && next.selectionView() != view && next.selectedListID() == listID {
+		next.syncSelection(id)
+		return next, cmd
+	}
+	return updated, cmd
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case ScrubberLoadedMsg:
+		m.handleScrubberLoaded(msg)
+		return m, nil
+
+	case scrubTickMsg:
+		return m, m.handleScrubTick()
+
+	case FieldHistoryLoadedMsg:
+		m.handleFieldHistoryLoaded(msg)
+		return m, nil
+
+	case WorkSessionTickMsg:
+		// Keep ticking only while a session is running
+		if m.activeWorkSession() != nil {
+			return m, WorkSessionTickCmd()
+		}
+		return m, nil
+
 	case UpdateMsg:
 		m.updateAvailable = true
 		m.updateTag = msg.TagName
 		m.updateURL = msg.URL
 
 	case SemanticIndexReadyMsg:
+		if errors.Is(msg.Error, context.Canceled) {
+			// Cancelled with esc; a build started since then is still running
+			if !m.ops.running(opSemantic) {
+				m.semanticIndexBuilding = false
+				m.semanticSearchEnabled = false
+				m.list.Filter = m.queryFilter.Wrap(list.DefaultFilter)
+			}
+			break
+		}
 		m.semanticIndexBuilding = false
+		m.ops.finish(opSemantic)
 		if msg.Error != nil {
 			// If indexing fails, revert to fuzzy mode for predictable behavior.
 			m.semanticSearchEnabled = false
-			m.list.Filter = list.DefaultFilter
+			m.list.Filter = m.queryFilter.Wrap(list.DefaultFilter)
 			m.statusMsg = fmt.Sprintf("Semantic search unavailable: %v", msg.Error)
 			m.statusIsError = true
+			m.insightsPanel.SetClustersNote("Semantic index unavailable")
 			break
 		}
 		if m.semanticSearch != nil {
 			m.semanticSearch.SetIndex(msg.Index, msg.Embedder)
+			m.semanticSearch.SetLexical(msg.Lexical)
 		}
-		if !msg.Loaded {
-			m.statusMsg = fmt.Sprintf("Semantic index built (%d embedded)", msg.Stats.Embedded)
-		} else if msg.Stats.Changed() {
-			m.statusMsg = fmt.Sprintf("Semantic index updated (+%d ~%d -%d)", msg.Stats.Added, msg.Stats.Updated, msg.Stats.Removed)
-		} else {
-			m.statusMsg = "Semantic index up to date"
+		m.semanticIndexPath = msg.IndexPath
+		if m.focused == focusInsights {
+			cmds = append(cmds, m.requestSimilarityClusters())
+		}
+		// A reload that didn't touch any indexed text keeps the reload's status
+		if !msg.Incremental || msg.Stats.Changed() {
+			if !msg.Loaded {
+				m.statusMsg = fmt.Sprintf("Semantic index built (%d embedded)", msg.Stats.Embedded)
+			} else if msg.Stats.Changed() {
+				m.statusMsg = fmt.Sprintf("Semantic index updated (+%d ~%d -%d)", msg.Stats.Added, msg.Stats.Updated, msg.Stats.Removed)
+			} else {
+				m.statusMsg = "Semantic index up to date"
+			}
+			m.statusIsError = false
 		}
-		m.statusIsError = false
 
 		// Refresh current filter view if the user is actively searching.
 		if m.semanticSearchEnabled && m.list.FilterState() != list.Unfiltered {
@@ -644,11 +1080,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case AskAnswerMsg:
+		m.setAskAnswer(msg)
+
+	case OpProgressTickMsg:
+		return m, m.ops.handleTick()
+
+	case startupIdleMsg:
+		m.runIdleStartup()
+		return m, nil
+
+	case SimilarityClustersMsg:
+		m.similarityClusters = msg.Clusters
+		m.similarityDuplicates = msg.Duplicates
+		m.similarityClustersReady = true
+		m.insightsPanel.SetClusters(msg.Clusters)
+		m.insightsPanel.SetDuplicates(msg.Duplicates)
+		return m, nil
+
 	case Phase2ReadyMsg:
 		// Ignore stale Phase2 completions (from before a file reload)
 		if msg.Stats != m.analysis {
 			return m, nil
 		}
+		if !m.ops.running(opAnalysis) && !m.analysis.IsPhase2Ready() {
+			// Cancelled with esc: keep the Phase 1 metrics until the next reload
+			return m, nil
+		}
+		m.ops.finish(opAnalysis)
 		// Phase 2 analysis complete - regenerate insights with full data
 		ins := m.analysis.GenerateInsights(len(m.issues))
 		m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
@@ -660,12 +1119,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.graphView.SetIssues(m.issues, &ins)
 
 		// Generate triage for priority panel (bv-91)
-		triage := analysis.ComputeTriage(m.issues)
-		m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
-
-		// Set full recommendations with breakdown for priority radar (bv-93)
-		dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
-		m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
+		m.setInsightsTriage(m.triageResult())
+		m.restoreSimilarityClusters()
+		m.restoreChainEffort()
+		if m.showBottlenecks {
+			m.refreshBottlenecks() // Betweenness is ready now
+		}
 
 		// Generate priority recommendations now that Phase 2 is ready
 		recommendations := m.analyzer.GenerateRecommendations()
@@ -675,7 +1134,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Refresh alerts now that full Phase 2 metrics (cycles, etc.) are available
+		m.startup.drop(startupAlerts)
 		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+		m.recordAlertHistory(time.Now())
+		if m.showRepoDashboard {
+			m.refreshRepoDashboard()
+		}
+		cmds = append(cmds, notifyAlertsCmd(m.alerts), m.persistAnalysisCmd())
+		if cmd := m.dailyBaselineCmd(time.Now()); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 
 		// Invalidate label health cache since we have new graph metrics (criticality)
 		m.labelHealthCached = false
@@ -719,9 +1187,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.applyFilter()
 		}
 
+	case BaselineSnapshotMsg:
+		if msg.Err != nil {
+			m.statusMsg = fmt.Sprintf("Daily baseline snapshot failed: %v", msg.Err)
+			m.statusIsError = true
+		}
+
+	case AlertsNotifiedMsg:
+		if msg.Err != nil {
+			m.statusMsg = fmt.Sprintf("Alert notification failed: %v", msg.Err)
+			m.statusIsError = true
+		} else if msg.Count > 0 {
+			m.statusMsg = fmt.Sprintf("Posted %d new critical alert(s) to the team webhook", msg.Count)
+			m.statusIsError = false
+		}
+
 	case HistoryLoadedMsg:
 		// Background history loading completed
 		m.historyLoading = false
+		m.ops.finish(opHistory)
+		if errors.Is(msg.Error, context.Canceled) {
+			// The status bar already reported the cancel
+			break
+		}
 		if msg.Error != nil {
 			m.historyLoadFailed = true
 			m.statusMsg = fmt.Sprintf("History load failed: %v", msg.Error)
@@ -729,19 +1217,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else if msg.Report != nil {
 			m.historyView = NewHistoryModel(msg.Report, m.theme)
 			m.historyView.SetSize(m.width, m.height-1)
+			m.observedEffort = effortFromHistory(msg.Report)
+			m.lifecycle = lifecycleFromHistory(msg.Report)
+			if m.showLeadTime {
+				m.refreshLeadTime()
+			}
+			m.restoreChainEffort()
 			// Refresh detail pane if visible
 			if m.isSplitView || m.showDetails {
 				m.updateViewportContent()
 			}
 		}
 
-	case FileChangedMsg:
-		// File changed on disk - reload issues and recompute analysis
-		if m.beadsPath == "" {
+	case FileChangedMsg, WorkspaceChangedMsg:
+		// File changed on disk - reload issues and recompute analysis.
+		// In a workspace, only the repos whose files changed are re-read.
+		changed, inWorkspace := msg.(WorkspaceChangedMsg)
+		if m.beadsPath == "" && (!inWorkspace || m.workspaceReload == nil) {
 			// Re-start watch for next change
-			if m.watcher != nil {
-				cmds = append(cmds, WatchFileCmd(m.watcher))
-			}
+			cmds = append(cmds, m.rewatchCmd(inWorkspace))
 			return m, tea.Batch(cmds...)
 		}
 
@@ -761,18 +1255,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Reload issues from disk
 		// Use custom warning handler to prevent stderr pollution during TUI render (bv-fix)
 		var reloadWarnings []string
-		newIssues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, loader.ParseOptions{
-			WarningHandler: func(msg string) {
-				reloadWarnings = append(reloadWarnings, msg)
-			},
-		})
+		var newIssues []model.Issue
+		var err error
+		if inWorkspace {
+			newIssues, err = m.workspaceReload(changed.Repos)
+			if err != nil && len(newIssues) > 0 {
+				// Repos that failed keep their last issues
+				reloadWarnings = append(reloadWarnings, err.Error())
+				err = nil
+			}
+		} else {
+			newIssues, err = m.reloadIssues(loader.ParseOptions{
+				WarningHandler: func(msg string) {
+					reloadWarnings = append(reloadWarnings, msg)
+				},
+			})
+		}
 		if err != nil {
 			m.statusMsg = fmt.Sprintf("Reload error: %v", err)
 			m.statusIsError = true
 			// Re-start watch for next change
-			if m.watcher != nil {
-				cmds = append(cmds, WatchFileCmd(m.watcher))
-			}
+			cmds = append(cmds, m.rewatchCmd(inWorkspace))
 			return m, tea.Batch(cmds...)
 		}
 
@@ -797,11 +1300,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
 		})
 
-		// Recompute analysis (async Phase 1/Phase 2) with caching
+		// Recompute analysis (async Phase 1/Phase 2) with caching, reusing
+		// the previous analysis wherever the dependency graph didn't change
+		oldIssueMap := m.issueMap
 		m.issues = newIssues
-		cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
+		prevAnalyzer, prevStats := m.analyzer, m.analysis
+		cachedAnalyzer := analysis.NewCachedAnalyzer(m.typeToggles.Apply(newIssues), nil)
+		cachedAnalyzer.SetDiskCache(m.analysisDiskCache)
+		m.cachedAnalyzer = cachedAnalyzer
 		m.analyzer = cachedAnalyzer.Analyzer
-		m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
+		analysisCtx, analysisProgress := m.ops.start(opAnalysis)
+		cachedAnalyzer.SetProgress(analysisProgress.report)
+		m.analysis = cachedAnalyzer.ReanalyzeAsync(analysisCtx, prevAnalyzer, prevStats)
 		cacheHit := cachedAnalyzer.WasCacheHit()
 		m.labelHealthCached = false
 		m.attentionCached = false
@@ -813,6 +1323,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.issueMap[m.issues[i].ID] = &m.issues[i]
 		}
 
+		m.pinProgress = ComputePinProgress(m.pin, m.issues)
+		changesToast := m.queueSubscribedChanges(oldIssueMap, time.Now())
+		m.recordJournal(oldIssueMap, time.Now())
+
 		// Clear stale priority hints (will be repopulated after Phase 2)
 		m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
 
@@ -845,9 +1359,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Recompute alerts for refreshed dataset
+		m.startup.drop(startupAlerts)
 		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
-		m.dismissedAlerts = make(map[string]bool)
+		m.dismissedAlerts = m.alertHistory.Dismissed()
 		m.showAlertsPanel = false
+		if m.showValidationPanel {
+			m.openValidationPanel() // Re-check the changed file
+		}
+		if m.showRepair {
+			m.refreshRepairItems()
+		}
+		if m.showCycleBreak {
+			m.refreshCycleCuts()
+		}
+		if m.showBottlenecks {
+			m.refreshBottlenecks()
+		}
+		if m.showLeadTime {
+			m.refreshLeadTime()
+		}
+		if m.showRepoDashboard {
+			m.refreshRepoDashboard()
+		}
+		m.scrubFrames = nil // The beads file has new history
+		m.showScrubber = false
+		m.scrubPlaying = false
 
 		// Rebuild list items
 		items := make([]list.Item, len(m.issues))
@@ -875,6 +1411,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
 		ins := m.analysis.GenerateInsights(len(m.issues))
 		m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
+		m.restoreChainEffort()
 		bodyHeight := m.height - 1
 		if bodyHeight < 5 {
 			bodyHeight = 5
@@ -883,11 +1420,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.graphView.SetIssues(m.issues, &ins)
 
 		// Generate priority recommendations now that Phase 2 is ready
-		m.board = NewBoardModel(m.issues, m.theme)
+		m.board = NewBoardModelWithConfig(m.issues, m.board.Config(), m.theme)
+		m.board.SetStarred(m.starred)
+		if m.isTimelineView {
+			m.timelineView.SetTimeline(m.analyzer.ComputeTimeline(time.Now()), time.Now())
+		}
+		if m.isHeatmapView {
+			m.heatmapView.SetIssues(m.issues, time.Now())
+		}
 
 		// Re-apply recipe filter if active
 		if m.activeRecipe != nil {
 			m.applyRecipe(m.activeRecipe)
+		} else if m.activeRepos != nil {
+			m.applyFilter() // Keep the workspace repo filter
 		}
 
 		// Reload sprints (bv-161)
@@ -914,20 +1460,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// Keep semantic index current when enabled.
-		if m.semanticSearchEnabled && !m.semanticIndexBuilding {
-			m.semanticIndexBuilding = true
-			cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+		// Keep the semantic index current, re-embedding only changed issues
+		if cmd := m.refreshSemanticIndex(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+		// Clusters refer to the old issues; recompute if they're on screen
+		m.similarityClustersReady = false
+		if m.focused == focusInsights {
+			cmds = append(cmds, m.requestSimilarityClusters())
 		}
 
 		if cacheHit {
 			m.statusMsg = fmt.Sprintf("Reloaded %d issues (cached)", len(newIssues))
+		} else if cachedAnalyzer.Reused() == analysis.ReuseAll {
+			m.statusMsg = fmt.Sprintf("Reloaded %d issues (graph unchanged)", len(newIssues))
+		} else if cachedAnalyzer.Reused() == analysis.ReusePartial {
+			m.statusMsg = fmt.Sprintf("Reloaded %d issues (incremental)", len(newIssues))
 		} else {
 			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(newIssues))
 		}
+		if inWorkspace {
+			m.statusMsg = fmt.Sprintf("Reloaded %s (%d issues)", formatRepoList(changed.Repos, 3), len(newIssues))
+		}
 		if len(reloadWarnings) > 0 {
 			m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
 		}
+		if changesToast != "" {
+			m.statusMsg = changesToast
+		}
 		m.statusIsError = false
 		// Invalidate label-derived caches
 		m.labelHealthCached = false
@@ -935,10 +1496,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateViewportContent()
 
 		// Re-start watching for next change + wait for Phase 2
-		if m.watcher != nil {
-			cmds = append(cmds, WatchFileCmd(m.watcher))
-		}
-		cmds = append(cmds, WaitForPhase2Cmd(m.analysis))
+		cmds = append(cmds, m.rewatchCmd(inWorkspace))
+		cmds = append(cmds, WaitForPhase2Cmd(m.analysis), m.ops.tickCmd())
 		return m, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
@@ -1039,17 +1598,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle alerts panel modal if open (bv-168)
 		if m.showAlertsPanel {
-			// Build list of active (non-dismissed) alerts
-			var activeAlerts []drift.Alert
-			for _, a := range m.alerts {
-				if !m.dismissedAlerts[alertKey(a)] {
-					activeAlerts = append(activeAlerts, a)
-				}
+			activeAlerts := m.activeAlerts()
+			rows := len(activeAlerts)
+			if m.alertsHistoryTab {
+				rows = len(m.alertHistory.Alerts)
 			}
 			s := msg.String()
 			switch s {
 			case "j", "down":
-				if m.alertsCursor < len(activeAlerts)-1 {
+				if m.alertsCursor < rows-1 {
 					m.alertsCursor++
 				}
 				return m, nil
@@ -1058,17 +1615,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.alertsCursor--
 				}
 				return m, nil
+			case "tab":
+				// Switch between active alerts and the alert history
+				m.alertsHistoryTab = !m.alertsHistoryTab
+				m.alertsCursor = 0
+				return m, nil
 			case "enter":
 				// Jump to the issue referenced by the selected alert
-				if m.alertsCursor < len(activeAlerts) {
-					issueID := activeAlerts[m.alertsCursor].IssueID
-					if issueID != "" {
-						// Find the issue in the list and select it
-						for i, item := range m.list.Items() {
-							if it, ok := item.(IssueItem); ok && it.Issue.ID == issueID {
-								m.list.Select(i)
-								break
-							}
+				issueID := ""
+				if m.alertsHistoryTab {
+					if recent := m.alertHistory.Recent(); m.alertsCursor < len(recent) {
+						issueID = recent[m.alertsCursor].IssueID
+					}
+				} else if m.alertsCursor < len(activeAlerts) {
+					issueID = activeAlerts[m.alertsCursor].IssueID
+				}
+				if issueID != "" {
+					// Find the issue in the list and select it
+					for i, item := range m.list.Items() {
+						if it, ok := item.(IssueItem); ok && it.Issue.ID == issueID {
+							m.list.Select(i)
+							break
 						}
 					}
 				}
@@ -1076,16 +1643,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case "d":
 				// Dismiss the selected alert
-				if m.alertsCursor < len(activeAlerts) {
-					key := alertKey(activeAlerts[m.alertsCursor])
-					m.dismissedAlerts[key] = true
+				if !m.alertsHistoryTab && m.alertsCursor < len(activeAlerts) {
+					m.dismissAlert(activeAlerts[m.alertsCursor])
+					remaining := len(m.activeAlerts())
 					// Adjust cursor if needed
-					remaining := 0
-					for _, a := range m.alerts {
-						if !m.dismissedAlerts[alertKey(a)] {
-							remaining++
-						}
-					}
 					if m.alertsCursor >= remaining {
 						m.alertsCursor = remaining - 1
 					}
@@ -1098,6 +1659,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 				return m, nil
+			case "C":
+				m.copyAlertsView()
+				return m, nil
 			case "esc", "q", "!":
 				m.showAlertsPanel = false
 				return m, nil
@@ -1105,6 +1669,134 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle validation panel if open
+		if m.showValidationPanel {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleValidationKeys(msg)
+			return m, nil
+		}
+
+		// Handle dangling-dependency repair overlay if open
+		if m.showRepair {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleRepairKeys(msg)
+			return m, nil
+		}
+
+		// Handle cycle breaker if open
+		if m.showCycleBreak {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleCycleBreakKeys(msg)
+			return m, nil
+		}
+
+		// Handle bottlenecks panel if open
+		if m.showBottlenecks {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleBottlenecksKeys(msg)
+			return m, nil
+		}
+
+		// Handle history scrubber if open
+		if m.showScrubber {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			var cmd tea.Cmd
+			m, cmd = m.handleScrubberKeys(msg)
+			return m, cmd
+		}
+
+		// Handle field history if open
+		if m.showFieldHistory {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleFieldHistoryKeys(msg)
+			return m, nil
+		}
+
+		// Handle lead time panel if open
+		if m.showLeadTime {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleLeadTimeKeys(msg)
+			return m, nil
+		}
+
+		// Handle repo dashboard if open
+		if m.showRepoDashboard {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleRepoDashboardKeys(msg)
+			return m, nil
+		}
+
+		// Handle merge overlay if open
+		if m.showMerge {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleMergeKeys(msg)
+			return m, nil
+		}
+
+		// Handle compare view if open
+		if m.showCompare {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleCompareKeys(msg)
+			return m, nil
+		}
+
+		// Handle the baselines panel if open
+		if m.showBaselines {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleBaselinesKeys(msg)
+			return m, nil
+		}
+
+		// Handle the subscription changes overlay if open
+		if m.showChanges {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleChangesKeys(msg)
+			return m, nil
+		}
+
+		// Handle the session journal if open
+		if m.showJournal {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleJournalKeys(msg)
+			return m, nil
+		}
+
+		// Handle the ask panel before global keys intercept letters
+		if m.showAsk {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			var cmd tea.Cmd
+			m, cmd = m.handleAskKeys(msg)
+			return m, cmd
+		}
+
 		// Handle repo picker overlay (workspace mode) before global keys (esc/q/etc.)
 		if m.showRepoPicker {
 			if msg.String() == "ctrl+c" {
@@ -1114,6 +1806,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle label picker overlay before global keys, which would
+		// otherwise take esc and the letters typed into its filter
+		if m.showLabelPicker {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleLabelPickerKeys(msg)
+			return m, nil
+		}
+
 		// Handle recipe picker overlay before global keys (esc/q/etc.)
 		if m.showRecipePicker {
 			if msg.String() == "ctrl+c" {
@@ -1123,6 +1825,42 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle theme picker overlay before global keys (esc/q/etc.)
+		if m.showThemePicker {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleThemePickerKeys(msg)
+			return m, nil
+		}
+
+		// Handle export scope picker overlay before global keys (esc/q/etc.)
+		if m.showExportScopePicker {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleExportScopePickerKeys(msg)
+			return m, nil
+		}
+
+		// Handle column editor overlay before global keys (esc/q/etc.)
+		if m.showColumnEditor {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleColumnEditorKeys(msg)
+			return m, nil
+		}
+
+		// Handle jump list overlay before global keys (esc/q/digits)
+		if m.showJumpList {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleJumpListKeys(msg)
+			return m, nil
+		}
+
 		// Handle quit confirmation first
 		if m.showQuitConfirm {
 			switch msg.String() {
@@ -1135,6 +1873,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Apply .bv/keys.yaml remapping before any shortcut matches
+		if remapped, ok := m.remapKey(msg); ok {
+			msg = remapped
+		} else {
+			return m, nil
+		}
+
+		// --read-only: refuse shortcuts that write before they open prompts
+		if m.blockMutation(msg) {
+			return m, nil
+		}
+
+		// A board card being moved takes h/l/enter/esc until it's dropped
+		if m.board.Moving() {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleBoardMoveKeys(msg)
+			return m, nil
+		}
+
 		// Handle help overlay toggle (? or F1)
 		if (msg.String() == "?" || msg.String() == "f1") && m.list.FilterState() != list.Filtering {
 			m.showHelp = !m.showHelp
@@ -1160,6 +1919,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Toggle the plain-text announce line (F3)
+		if msg.String() == "f3" && m.list.FilterState() != list.Filtering {
+			return m.toggleAnnounce()
+		}
+
+		// Open the list column editor (F4)
+		if msg.String() == "f4" && m.list.FilterState() != list.Filtering {
+			return m.openColumnEditor(), nil
+		}
+
 		// Handle shortcuts sidebar scrolling (Ctrl+j/k when sidebar visible) - bv-3qi5
 		if m.showShortcutsSidebar && m.list.FilterState() != list.Filtering {
 			switch msg.String() {
@@ -1178,11 +1947,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.semanticSearchEnabled = !m.semanticSearchEnabled
 			if m.semanticSearchEnabled {
 				if m.semanticSearch != nil {
-					m.list.Filter = m.semanticSearch.Filter
+					m.list.Filter = m.queryFilter.Wrap(m.semanticSearch.Filter)
 					if !m.semanticSearch.Snapshot().Ready && !m.semanticIndexBuilding {
 						m.semanticIndexBuilding = true
 						m.statusMsg = "Semantic search: building index…"
-						cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+						cmds = append(cmds, m.buildSemanticIndexCmd())
 					} else if !m.semanticSearch.Snapshot().Ready && m.semanticIndexBuilding {
 						m.statusMsg = "Semantic search: indexing…"
 					} else {
@@ -1190,12 +1959,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				} else {
 					m.semanticSearchEnabled = false
-					m.list.Filter = list.DefaultFilter
+					m.list.Filter = m.queryFilter.Wrap(list.DefaultFilter)
 					m.statusMsg = "Semantic search unavailable"
 					m.statusIsError = true
 				}
 			} else {
-				m.list.Filter = list.DefaultFilter
+				m.list.Filter = m.queryFilter.Wrap(list.DefaultFilter)
 				m.statusMsg = "Fuzzy search enabled"
 			}
 
@@ -1218,6 +1987,42 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle the goto prompt before global keys intercept letters
+		if m.focused == focusGoto {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleGotoKeys(msg)
+			return m, nil
+		}
+
+		// Handle the triage session before global keys intercept letters
+		if m.focused == focusTriageSession {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleTriageSessionKeys(msg)
+			return m, nil
+		}
+
+		// Handle the snooze prompt before global keys intercept letters
+		if m.focused == focusSnoozeInput {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleSnoozePromptKeys(msg)
+			return m, nil
+		}
+
+		// Handle the comment editor before global keys intercept letters
+		if m.focused == focusCommentEditor {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleCommentEditorKeys(msg)
+			return m, nil
+		}
+
 		// Handle time-travel input first (before global keys intercept letters)
 		// But allow ctrl+c to always quit
 		if m.focused == focusTimeTravelInput {
@@ -1228,67 +2033,55 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Enter on a query expression commits it as the active filter, so the
+		// board and graph views see the same subset as the list
+		if m.list.FilterState() == list.Filtering && m.focused == focusList && msg.String() == "enter" {
+			if expr := strings.TrimSpace(m.list.FilterInput.Value()); query.LooksLikeQuery(expr) {
+				if _, err := query.Parse(expr); err == nil {
+					m.list.ResetFilter()
+					m.currentFilter = "query:" + expr
+					m.applyFilter()
+					return m, nil
+				}
+			}
+		}
+
 		// Handle keys when not filtering
 		if m.list.FilterState() != list.Filtering {
+			if msg.Paste {
+				// Text pasted into the terminal (bracketed paste) names an issue
+				m.pasteJump(string(msg.Runes))
+				return m, nil
+			}
 			switch msg.String() {
 			case "ctrl+c":
 				return m, tea.Quit
 
 			case "q":
 				// q closes current view or quits if at top level
-				if m.showDetails && !m.isSplitView {
-					m.showDetails = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.focused == focusInsights {
-					m.focused = focusList
-					return m, nil
-				}
-				if m.isGraphView {
-					m.isGraphView = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.isBoardView {
-					m.isBoardView = false
-					m.focused = focusList
+				if m.closeView() {
 					return m, nil
 				}
 				return m, tea.Quit
 
 			case "esc":
-				// Escape closes modals and goes back
-				if m.showDetails && !m.isSplitView {
-					m.showDetails = false
-					m.focused = focusList
-					return m, nil
-				}
-				if m.focused == focusInsights {
-					m.focused = focusList
-					return m, nil
-				}
-				if m.isGraphView {
-					m.isGraphView = false
-					m.focused = focusList
+				// Escape closes modals and goes back. Leave a cluster
+				// drilldown before leaving insights.
+				if m.focused == focusInsights && m.insightsPanel.CloseCluster() {
 					return m, nil
 				}
-				if m.isBoardView {
-					m.isBoardView = false
-					m.focused = focusList
+				if m.closeView() {
 					return m, nil
 				}
-				if m.isActionableView {
-					m.isActionableView = false
-					m.focused = focusList
+				// At main list - cancel background work first, then drop
+				// multi-select marks
+				if m.cancelOperations() {
 					return m, nil
 				}
-				if m.isHistoryView {
-					m.isHistoryView = false
-					m.focused = focusList
+				if m.clearMarks() {
 					return m, nil
 				}
-				// At main list - show quit confirmation
+				// Then show quit confirmation
 				m.showQuitConfirm = true
 				m.focused = focusQuitConfirm
 				return m, nil
@@ -1306,6 +2099,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "b":
 				m.clearAttentionOverlay()
+				m.isTimelineView = false
+				m.isHeatmapView = false
 				m.isBoardView = !m.isBoardView
 				m.isGraphView = false
 				m.isActionableView = false
@@ -1318,6 +2113,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "g":
 				// Toggle graph view
 				m.clearAttentionOverlay()
+				m.isTimelineView = false
+				m.isHeatmapView = false
 				m.isGraphView = !m.isGraphView
 				m.isBoardView = false
 				m.isActionableView = false
@@ -1331,14 +2128,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "a":
 				// Toggle actionable view
 				m.clearAttentionOverlay()
+				m.isTimelineView = false
+				m.isHeatmapView = false
 				m.isActionableView = !m.isActionableView
 				m.isGraphView = false
 				m.isBoardView = false
 				if m.isActionableView {
 					// Build execution plan
-					analyzer := analysis.NewAnalyzer(m.issues)
+					analyzer := analysis.NewAnalyzer(m.analysisIssues())
 					plan := analyzer.GetExecutionPlan()
+					showWaves := m.actionableView.WavesShown()
 					m.actionableView = NewActionableModel(plan, m.theme)
+					m.actionableView.SetWaves(analyzer.GetWavePlan(time.Now()))
+					if showWaves {
+						m.actionableView.ToggleWaves() // Reopen the schedule the user left
+					}
 					m.actionableView.SetSize(m.width, m.height-2)
 					m.focused = focusActionable
 				} else {
@@ -1348,6 +2152,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "i":
 				m.clearAttentionOverlay()
+				m.isTimelineView = false
+				m.isHeatmapView = false
 				if m.focused == focusInsights {
 					m.focused = focusList
 				} else {
@@ -1361,16 +2167,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						ins := m.analysis.GenerateInsights(len(m.issues))
 						m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
 						// Include priority triage (bv-91)
-						triage := analysis.ComputeTriage(m.issues)
-						m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
-						// Set full recommendations with breakdown for priority radar (bv-93)
-						dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
-						m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
+						m.setInsightsTriage(m.triageResult())
+						m.restoreSimilarityClusters()
+						m.restoreChainEffort()
 						panelHeight := m.height - 2
 						if panelHeight < 3 {
 							panelHeight = 3
 						}
 						m.insightsPanel.SetSize(m.width, panelHeight)
+						return m, m.requestSimilarityClusters()
 					}
 				}
 				return m, nil
@@ -1379,17 +2184,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Toggle priority hints
 				m.showPriorityHints = !m.showPriorityHints
 				// Update delegate with new state
-				m.list.SetDelegate(IssueDelegate{
-					Theme:             m.theme,
-					ShowPriorityHints: m.showPriorityHints,
-					PriorityHints:     m.priorityHints,
-					WorkspaceMode:     m.workspaceMode,
-				})
+				m.list.SetDelegate(m.issueDelegate())
 				return m, nil
 
 			case "H":
 				// Toggle history view
+				if !m.isHistoryView && m.blockNoGit("History") {
+					return m, nil
+				}
 				m.clearAttentionOverlay()
+				m.isTimelineView = false
+				m.isHeatmapView = false
 				m.isHistoryView = !m.isHistoryView
 				m.isGraphView = false
 				m.isBoardView = false
@@ -1470,16 +2275,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "!":
-				// Toggle alerts panel (bv-168)
-				// Only show if there are active alerts
-				activeCount := 0
-				for _, a := range m.alerts {
-					if !m.dismissedAlerts[alertKey(a)] {
-						activeCount++
-					}
-				}
-				if activeCount > 0 {
+				// Toggle alerts panel (bv-168). With no active alerts it
+				// opens on the history tab, if there is any history.
+				m.ensureStartup(startupAlerts)
+				activeCount := len(m.activeAlerts())
+				if activeCount > 0 || len(m.alertHistory.Alerts) > 0 {
 					m.showAlertsPanel = !m.showAlertsPanel
+					m.alertsHistoryTab = activeCount == 0
 					m.alertsCursor = 0 // Reset cursor when opening
 				} else {
 					m.statusMsg = "No active alerts"
@@ -1489,6 +2291,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case "R":
 				// Toggle recipe picker overlay
+				m.ensureStartup(startupRecipes)
 				m.showRecipePicker = !m.showRecipePicker
 				if m.showRecipePicker {
 					m.recipePicker.SetSize(m.width, m.height-1)
@@ -1498,6 +2301,114 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 
+			case "<", ">":
+				// Shrink or grow the list pane of the split view
+				if m.isSplitView && (m.focused == focusList || m.focused == focusDetail) {
+					if msg.String() == "<" {
+						m.resizeSplit(-splitRatioStep)
+					} else {
+						m.resizeSplit(splitRatioStep)
+					}
+					return m, nil
+				}
+				if !m.isSplitView && m.focused == focusList {
+					m.resizeSplit(0)
+					return m, nil
+				}
+
+			case ":", "#":
+				// Open the go-to-issue prompt
+				m.gotoPicker = NewGotoPickerModel(m.issues, m.theme)
+				m.gotoPicker.SetSize(m.width, m.height-1)
+				m.gotoReturn = m.focused
+				m.gotoGraph = false
+				m.showGoto = true
+				m.focused = focusGoto
+				return m, nil
+
+			case "ctrl+o":
+				// Open the recently-viewed jump list
+				m.openJumpList()
+				return m, nil
+
+			case "ctrl+v":
+				// Jump to the issue ID or tracker URL on the clipboard
+				m.pasteJumpFromClipboard()
+				return m, nil
+
+			case "ctrl+b":
+				// Compare the current metrics with stored baselines
+				m.openBaselines()
+				return m, nil
+
+			case "N":
+				// Review what reloads changed in subscribed and starred issues
+				m.openChanges()
+				return m, nil
+
+			case "f5":
+				// Review what changed since the session started
+				m.openJournal()
+				return m, nil
+
+			case "Q":
+				// Ask the backlog a question
+				m.openAsk()
+				return m, textinput.Blink
+
+			case "X":
+				// Check the beads file against the issue schema
+				m.openValidationPanel()
+				return m, nil
+
+			case "ctrl+r":
+				// Fix dependencies on missing issues
+				m.openRepairOverlay()
+				return m, nil
+
+			case "ctrl+x":
+				// Review suggested cuts that break dependency cycles
+				m.openCycleBreaker()
+				return m, nil
+
+			case "I":
+				// Rank the issues that hold the dependency graph together
+				m.openBottlenecks()
+				return m, nil
+
+			case "Z":
+				// Step through the backlog's history week by week
+				return m, m.openScrubber()
+
+			case "ctrl+w":
+				// Compare the health of workspace repos
+				m.openRepoDashboard()
+				return m, nil
+
+			case "ctrl+t":
+				// Lead and cycle time analytics
+				m.openLeadTime()
+				return m, nil
+
+			case "z":
+				// Walk the untriaged issues one by one (inbox zero)
+				m.openTriageSession()
+				return m, nil
+
+			case "f":
+				// Show how the selected issue's fields changed over time
+				if m.focused == focusList || m.focused == focusDetail {
+					return m, m.openFieldHistory()
+				}
+
+			case "V":
+				// Open theme picker overlay
+				m.themePicker = NewThemePickerModel(m.themes, m.theme.Name, m.theme)
+				m.themePicker.SetSize(m.width, m.height-1)
+				m.showThemePicker = true
+				m.focused = focusThemePicker
+				return m, nil
+
 			case "w":
 				// Toggle repo picker overlay (workspace mode)
 				if !m.workspaceMode || len(m.availableRepos) == 0 {
@@ -1517,8 +2428,91 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "E":
-				// Export to Markdown file
-				m.exportToMarkdown()
+				// Export to Markdown file, asking which issues when a filter,
+				// label, epic or marks narrow the choice
+				m.openExportScopePicker()
+				return m, nil
+
+			case "m":
+				// Add a comment to the selected issue
+				if m.focused != focusList && m.focused != focusDetail {
+					break
+				}
+				if !m.blockReadOnly("Comments disabled") {
+					m.openCommentEditor()
+				}
+				return m, nil
+
+			case "W":
+				// Start/stop a work session on the selected issue
+				if m.focused != focusList && m.focused != focusDetail {
+					break
+				}
+				if m.blockReadOnly("Work sessions disabled") {
+					return m, nil
+				}
+				return m, m.toggleWorkSession()
+
+			case "Y":
+				// Toggle timeline (Gantt-style) view
+				m.clearAttentionOverlay()
+				m.isTimelineView = !m.isTimelineView
+				m.isHeatmapView = false
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				if m.isTimelineView {
+					m.timelineView = NewTimelineModel(m.analyzer.ComputeTimeline(time.Now()), time.Now(), m.theme)
+					m.focused = focusTimeline
+				} else {
+					m.focused = focusList
+				}
+				return m, nil
+
+			case "P":
+				// Toggle sprint dashboard (bv-161)
+				if !m.isSprintView && len(m.sprints) == 0 {
+					m.statusMsg = "No sprints: add them to .beads/" + loader.SprintsFileName
+					m.statusIsError = false
+					return m, nil
+				}
+				m.clearAttentionOverlay()
+				m.isSprintView = !m.isSprintView
+				m.showSprintVelocity = false
+				m.isTimelineView = false
+				m.isHeatmapView = false
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				if m.isSprintView {
+					if m.selectedSprint == nil {
+						m.selectedSprint = defaultSprint(m.sprints)
+					}
+					m.sprintViewText = m.renderSprintDashboard()
+					m.focused = focusSprint
+				} else {
+					m.focused = focusList
+				}
+				return m, nil
+
+			case "D":
+				// Toggle activity heatmap + staleness view
+				m.clearAttentionOverlay()
+				m.isHeatmapView = !m.isHeatmapView
+				m.isTimelineView = false
+				m.isGraphView = false
+				m.isBoardView = false
+				m.isActionableView = false
+				m.isHistoryView = false
+				if m.isHeatmapView {
+					activityCfg, _ := analysis.LoadActivityConfig(projectDirFromBeadsPath(m.beadsPath))
+					m.heatmapView = NewHeatmapModel(m.issues, activityCfg.StaleDays, time.Now(), m.theme)
+					m.focused = focusHeatmap
+				} else {
+					m.focused = focusList
+				}
 				return m, nil
 
 			case "l":
@@ -1527,6 +2521,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 				// Update labels in case they changed
+				m.startup.drop(startupLabels)
 				labelExtraction := analysis.ExtractLabels(m.issues)
 				m.labelPicker.SetLabels(labelExtraction.Labels)
 				m.labelPicker.Reset()
@@ -1555,6 +2550,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m = m.handleBoardKeys(msg)
 
 			case focusLabelDashboard:
+				if msg.String() == "C" {
+					md, rows := labelHealthMarkdown(m.labelDashboard.labels)
+					m.copyViewToClipboard("label health table", md, rows)
+					return m, nil
+				}
 				if selectedLabel, cmd := m.labelDashboard.Update(msg); selectedLabel != "" {
 					// Filter list by selected label and jump back to list view
 					m.currentFilter = "label:" + selectedLabel
@@ -1574,6 +2574,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						return m, nil
 					}
 				}
+				// Pin/unpin the selected label on '^'
+				if msg.String() == "^" && len(m.labelDashboard.labels) > 0 {
+					idx := m.labelDashboard.cursor
+					if idx >= 0 && idx < len(m.labelDashboard.labels) {
+						m.togglePin(Pin{Label: m.labelDashboard.labels[idx].Label})
+						return m, nil
+					}
+				}
 				// Open drilldown overlay on 'd'
 				if msg.String() == "d" && len(m.labelDashboard.labels) > 0 {
 					idx := m.labelDashboard.cursor
@@ -1598,6 +2606,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusSprint:
 				m = m.handleSprintKeys(msg)
 
+			case focusTimeline:
+				m = m.handleTimelineKeys(msg)
+
+			case focusHeatmap:
+				m = m.handleHeatmapKeys(msg)
+
 			case focusList:
 				m = m.handleListKeys(msg)
 
@@ -1630,6 +2644,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.actionableView.MoveUp()
 			case focusHistory:
 				m.historyView.MoveUp()
+			case focusTimeline:
+				m.timelineView.MoveUp()
+			case focusHeatmap:
+				m.heatmapView.MoveUp()
 			}
 			return m, nil
 		case tea.MouseButtonWheelDown:
@@ -1652,13 +2670,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.actionableView.MoveDown()
 			case focusHistory:
 				m.historyView.MoveDown()
+			case focusTimeline:
+				m.timelineView.MoveDown()
+			case focusHeatmap:
+				m.heatmapView.MoveDown()
 			}
 			return m, nil
+		case tea.MouseButtonLeft, tea.MouseButtonNone:
+			// Clicks, double clicks, and divider drags (motion and release
+			// events of a drag report no button)
+			m = m.handleMouseClick(msg)
+			return m, nil
 		}
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
+		m.screenHeight = msg.Height
 		m.height = msg.Height
+		if m.showAnnounce {
+			m.height-- // The announce line sits below the footer
+		}
 		m.isSplitView = msg.Width > SplitViewThreshold
 		m.ready = true
 		bodyHeight := m.height - 1 // keep 1 row for footer
@@ -1667,26 +2698,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if m.isSplitView {
-			// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
-			// Total overhead = 8
-			availWidth := msg.Width - 8
-			if availWidth < 10 {
-				availWidth = 10
-			}
-
-			listInnerWidth := int(float64(availWidth) * 0.4)
-			detailInnerWidth := availWidth - listInnerWidth
-
-			// listHeight fits header (1) + page line (1) inside a panel with Border (2)
-			listHeight := bodyHeight - 4
-			if listHeight < 3 {
-				listHeight = 3
-			}
-
-			m.list.SetSize(listInnerWidth, listHeight)
-			m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
-
-			m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
+			m.layoutSplitView(bodyHeight)
 		} else {
 			listHeight := bodyHeight - 2
 			if listHeight < 3 {
@@ -1699,18 +2711,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.renderer.SetWidthWithTheme(msg.Width, m.theme)
 		}
 
-		m.list.SetDelegate(IssueDelegate{
-			Theme:             m.theme,
-			ShowPriorityHints: m.showPriorityHints,
-			PriorityHints:     m.priorityHints,
-			WorkspaceMode:     m.workspaceMode,
-		})
+		m.list.SetDelegate(m.issueDelegate())
 
 		// Resize label dashboard table and modal overlay sizing
 		m.labelDashboard.SetSize(m.width, bodyHeight)
 
 		m.insightsPanel.SetSize(m.width, bodyHeight)
 		m.updateViewportContent()
+		if m.showCompare {
+			m.refreshCompare()
+		}
 	}
 
 	// Update list for navigation, but NOT for WindowSizeMsg
@@ -1721,6 +2731,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if _, isWindowSize := msg.(tea.WindowSizeMsg); !isWindowSize {
 			m.list, cmd = m.list.Update(msg)
 			cmds = append(cmds, cmd)
+			m.syncFilterStack()
 		}
 	}
 
@@ -1748,10 +2759,54 @@ func (m Model) View() string {
 		body = m.renderLabelDrilldown()
 	} else if m.showAlertsPanel {
 		body = m.renderAlertsPanel()
+	} else if m.showValidationPanel {
+		body = m.renderValidationPanel()
+	} else if m.showRepair {
+		body = m.renderRepairOverlay()
+	} else if m.showCycleBreak {
+		body = m.renderCycleBreakOverlay()
+	} else if m.showBottlenecks {
+		body = m.renderBottlenecksPanel()
+	} else if m.showScrubber {
+		body = m.renderScrubberPanel()
+	} else if m.showFieldHistory {
+		body = m.renderFieldHistory()
+	} else if m.showLeadTime {
+		body = m.renderLeadTimePanel()
+	} else if m.showRepoDashboard {
+		body = m.renderRepoDashboard()
+	} else if m.showMerge {
+		body = m.renderMergeOverlay()
+	} else if m.showCompare {
+		body = m.renderCompareView()
+	} else if m.showBaselines {
+		body = m.renderBaselinesPanel()
+	} else if m.showChanges {
+		body = m.renderChangesOverlay()
+	} else if m.showJournal {
+		body = m.renderJournal()
+	} else if m.showAsk {
+		body = m.renderAskOverlay()
 	} else if m.showTimeTravelPrompt {
 		body = m.renderTimeTravelPrompt()
+	} else if m.showCommentEditor {
+		body = m.renderCommentEditor()
+	} else if m.showTriageSession {
+		body = m.renderTriageSession()
+	} else if m.showSnoozePrompt {
+		body = m.renderSnoozePrompt()
 	} else if m.showRecipePicker {
 		body = m.recipePicker.View()
+	} else if m.showThemePicker {
+		body = m.themePicker.View()
+	} else if m.showExportScopePicker {
+		body = m.exportScopePicker.View()
+	} else if m.showColumnEditor {
+		body = m.columnEditor.View()
+	} else if m.showJumpList {
+		body = m.jumpList.View()
+	} else if m.showGoto {
+		body = m.gotoPicker.View()
 	} else if m.showRepoPicker {
 		body = m.repoPicker.View()
 	} else if m.showLabelPicker {
@@ -1770,13 +2825,20 @@ func (m Model) View() string {
 	} else if m.isHistoryView {
 		m.historyView.SetSize(m.width, m.height-1)
 		body = m.historyView.View()
+	} else if m.isSprintView && m.showSprintVelocity {
+		m.velocityComparison.SetSize(m.width, m.height-1)
+		body = m.velocityComparison.View()
 	} else if m.isSprintView {
 		body = m.sprintViewText
-	} else if m.isSplitView {
-		body = m.renderSplitView()
+	} else if m.isTimelineView {
+		body = m.timelineView.View(m.width, m.height-1)
+	} else if m.isHeatmapView {
+		body = m.heatmapView.View(m.width, m.height-1)
 	} else if m.focused == focusLabelDashboard {
 		m.labelDashboard.SetSize(m.width, m.height-1)
 		body = m.labelDashboard.View()
+	} else if m.isSplitView {
+		body = m.renderSplitView()
 	} else {
 		// Mobile view
 		if m.showDetails {
@@ -1804,7 +2866,11 @@ func (m Model) View() string {
 		Height(m.height).
 		MaxHeight(m.height)
 
-	return finalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, footer))
+	out := finalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, footer))
+	if m.showAnnounce {
+		out += "\n" + m.renderAnnounceLine()
+	}
+	return out
 }
 
 // GetTypeIconMD returns the emoji icon for an issue type (for markdown)
@@ -1825,11 +2891,61 @@ func GetTypeIconMD(t string) string {
 	}
 }
 
+// UseIssueStore reloads through the store instead of re-parsing the whole
+// beads file. The store must already be synced with beadsPath.
+func (m *Model) UseIssueStore(store *loader.SQLiteStore) {
+	m.issueStore = store
+}
+
+// reloadIssues reads the beads file after it changed on disk
+func (m *Model) reloadIssues(opts loader.ParseOptions) ([]model.Issue, error) {
+	if m.issueStore == nil {
+		if m.issueLoader == nil {
+			m.issueLoader = loader.NewIncrementalLoader()
+		}
+		issues, _, err := m.issueLoader.Load(m.beadsPath, opts)
+		return issues, err
+	}
+	res, err := m.issueStore.Sync(m.beadsPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return res.Apply(m.issues), nil
+}
+
+// persistAnalysisCmd saves the completed analysis to .bv/cache for the next
+// session. Failures are ignored: that session just recomputes.
+func (m Model) persistAnalysisCmd() tea.Cmd {
+	ca, stats := m.cachedAnalyzer, m.analysis
+	if ca == nil || m.analysisDiskCache == nil || ca.WasCacheHit() {
+		return nil
+	}
+	return func() tea.Msg {
+		_ = ca.Persist(stats)
+		return nil
+	}
+}
+
+// rewatchCmd waits for the next change to the beads file, or in a
+// workspace to any repo's beads file
+func (m Model) rewatchCmd(workspace bool) tea.Cmd {
+	if workspace && m.workspaceWatcher != nil {
+		return WatchWorkspaceCmd(m.workspaceWatcher)
+	}
+	if !workspace && m.watcher != nil {
+		return WatchFileCmd(m.watcher)
+	}
+	return nil
+}
+
 // Stop cleans up resources (file watcher, etc.)
 // Should be called when the program exits
 func (m *Model) Stop() {
 	if m.watcher != nil {
 		m.watcher.Stop()
 	}
+	if m.workspaceWatcher != nil {
+		m.workspaceWatcher.Stop()
+	}
 }
 
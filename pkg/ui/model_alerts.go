@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/notify"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -37,32 +41,42 @@ func (m Model) renderAlertsPanel() string {
 		MarginBottom(1)
 
 	// Filter out dismissed alerts
-	var visibleAlerts []drift.Alert
-	for _, a := range m.alerts {
-		if !m.dismissedAlerts[alertKey(a)] {
-			visibleAlerts = append(visibleAlerts, a)
-		}
-	}
+	visibleAlerts := m.activeAlerts()
 
 	var sb strings.Builder
 	sb.WriteString(titleStyle.Render("🔔 Alerts Panel"))
+	sb.WriteString("\n")
+
+	// Tabs: active alerts and the alert log
+	tabStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	activeTabStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Underline(true)
+	activeTab, historyTab := activeTabStyle, tabStyle
+	if m.alertsHistoryTab {
+		activeTab, historyTab = tabStyle, activeTabStyle
+	}
+	sb.WriteString(activeTab.Render(fmt.Sprintf("Active (%d)", len(visibleAlerts))))
+	sb.WriteString("   ")
+	sb.WriteString(historyTab.Render(fmt.Sprintf("History (%d)", len(m.alertHistory.Alerts))))
 	sb.WriteString("\n\n")
 
-	if len(visibleAlerts) == 0 {
+	if m.alertsHistoryTab {
+		sb.WriteString(m.renderAlertHistory())
+	} else if len(visibleAlerts) == 0 {
 		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓ No active alerts"))
 		sb.WriteString("\n\n")
 	} else {
 		// Summary line
 		summaryStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		critical, warning, info := countAlertSeverities(visibleAlerts)
 		summary := fmt.Sprintf("%d total", len(visibleAlerts))
-		if m.alertsCritical > 0 {
-			summary += fmt.Sprintf(" • %d critical", m.alertsCritical)
+		if critical > 0 {
+			summary += fmt.Sprintf(" • %d critical", critical)
 		}
-		if m.alertsWarning > 0 {
-			summary += fmt.Sprintf(" • %d warning", m.alertsWarning)
+		if warning > 0 {
+			summary += fmt.Sprintf(" • %d warning", warning)
 		}
-		if m.alertsInfo > 0 {
-			summary += fmt.Sprintf(" • %d info", m.alertsInfo)
+		if info > 0 {
+			summary += fmt.Sprintf(" • %d info", info)
 		}
 		sb.WriteString(summaryStyle.Render(summary))
 		sb.WriteString("\n\n")
@@ -72,19 +86,7 @@ func (m Model) renderAlertsPanel() string {
 			selected := i == m.alertsCursor
 
 			// Severity indicator
-			var severityStyle lipgloss.Style
-			var severityIcon string
-			switch a.Severity {
-			case drift.SeverityCritical:
-				severityStyle = t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
-				severityIcon = "⚠"
-			case drift.SeverityWarning:
-				severityStyle = t.Renderer.NewStyle().Foreground(t.Feature)
-				severityIcon = "⚡"
-			default:
-				severityStyle = t.Renderer.NewStyle().Foreground(t.Secondary)
-				severityIcon = "ℹ"
-			}
+			severityStyle, severityIcon := m.alertSeverityStyle(a.Severity)
 
 			// Cursor indicator
 			cursor := "  "
@@ -119,8 +121,11 @@ func (m Model) renderAlertsPanel() string {
 	}
 
 	sb.WriteString("\n")
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(
-		"j/k: navigate • Enter: jump to issue • d: dismiss • Esc: close"))
+	hint := "j/k: navigate • Enter: jump to issue • d: dismiss • C: copy • Tab: history • Esc: close"
+	if m.alertsHistoryTab {
+		hint = "j/k: navigate • Enter: jump to issue • C: copy • Tab: active alerts • Esc: close"
+	}
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(hint))
 
 	content := boxStyle.Render(sb.String())
 
@@ -133,6 +138,85 @@ func (m Model) renderAlertsPanel() string {
 	)
 }
 
+// alertSeverityStyle returns the color and icon for a severity
+func (m Model) alertSeverityStyle(severity drift.Severity) (lipgloss.Style, string) {
+	t := m.theme
+	switch severity {
+	case drift.SeverityCritical:
+		return t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true), "⚠"
+	case drift.SeverityWarning:
+		return t.Renderer.NewStyle().Foreground(t.Feature), "⚡"
+	default:
+		return t.Renderer.NewStyle().Foreground(t.Secondary), "ℹ"
+	}
+}
+
+// renderAlertHistory renders the alerts panel's history tab: every alert
+// logged, newest first, with when it appeared, resolved and was dismissed.
+// Only a window of rows around the cursor is shown.
+func (m Model) renderAlertHistory() string {
+	t := m.theme
+	records := m.alertHistory.Recent()
+	if len(records) == 0 {
+		return t.Renderer.NewStyle().Foreground(t.Muted).Render("No alerts logged yet") + "\n"
+	}
+
+	const stamp = "Jan 2 15:04"
+	rows := max(3, m.height-16)
+	start := 0
+	if m.alertsCursor >= rows {
+		start = m.alertsCursor - rows + 1
+	}
+	end := min(len(records), start+rows)
+
+	var sb strings.Builder
+	for i := start; i < end; i++ {
+		r := records[i]
+		selected := i == m.alertsCursor
+		style, icon := m.alertSeverityStyle(r.Severity)
+		if !r.Active() {
+			style = t.Renderer.NewStyle().Foreground(t.Muted)
+		}
+
+		cursor := "  "
+		if selected {
+			cursor = "▸ "
+		}
+		state := "active"
+		if !r.Active() {
+			state = "resolved"
+		} else if r.DismissedAt != nil {
+			state = "dismissed"
+		}
+		line := fmt.Sprintf("%s%s %s (%s)", cursor, icon, r.Message, state)
+		if selected {
+			line = t.Renderer.NewStyle().Bold(true).Render(line)
+		}
+		sb.WriteString(style.Render(line))
+		sb.WriteString("\n")
+
+		if selected {
+			when := "     First seen " + r.FirstSeen.Local().Format(stamp)
+			if r.ResolvedAt != nil {
+				when += " • resolved " + r.ResolvedAt.Local().Format(stamp)
+			} else {
+				when += " • still active"
+			}
+			if r.DismissedAt != nil {
+				when += fmt.Sprintf(" • dismissed by %s %s", r.DismissedBy, r.DismissedAt.Local().Format(stamp))
+			}
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(when))
+			sb.WriteString("\n")
+		}
+	}
+	if len(records) > rows {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Render(
+			fmt.Sprintf("  %d–%d of %d", start+1, end, len(records))))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
 // ════════════════════════════════════════════════════════════════════════════
 // ALERTS PANEL (bv-168)
 // ════════════════════════════════════════════════════════════════════════════
@@ -195,7 +279,84 @@ func computeAlerts(issues []model.Issue, stats *analysis.GraphStats, analyzer *a
 	return result.Alerts, critical, warning, info
 }
 
+// AlertsNotifiedMsg reports new critical alerts posted to the team webhook
+type AlertsNotifiedMsg struct {
+	Count int
+	Err   error
+}
+
+// notifyAlertsCmd posts new critical alerts to the webhook in
+// .bv/notify.yaml, if one is configured
+func notifyAlertsCmd(alerts []drift.Alert) tea.Cmd {
+	return func() tea.Msg {
+		projectDir, _ := os.Getwd()
+		cfg, err := notify.LoadConfig(projectDir)
+		if err != nil || !cfg.Enabled() {
+			return AlertsNotifiedMsg{Err: err}
+		}
+		sent, err := notify.New(projectDir, cfg).Notify(alerts)
+		return AlertsNotifiedMsg{Count: len(sent), Err: err}
+	}
+}
+
+// activeAlerts returns the alerts that haven't been dismissed
+func (m Model) activeAlerts() []drift.Alert {
+	var active []drift.Alert
+	for _, a := range m.alerts {
+		if !m.dismissedAlerts[alertKey(a)] {
+			active = append(active, a)
+		}
+	}
+	return active
+}
+
+// countAlertSeverities counts alerts by severity
+func countAlertSeverities(alerts []drift.Alert) (critical, warning, info int) {
+	for _, a := range alerts {
+		switch a.Severity {
+		case drift.SeverityCritical:
+			critical++
+		case drift.SeverityWarning:
+			warning++
+		case drift.SeverityInfo:
+			info++
+		}
+	}
+	return critical, warning, info
+}
+
+// recordAlertHistory logs the current alerts in .bv/alerts.json: new ones
+// are added and ones no longer raised are marked resolved. Alerts seen in
+// time-travel mode describe the past, so they aren't logged.
+func (m *Model) recordAlertHistory(now time.Time) {
+	if m.timeTravelMode || !m.alertHistory.Update(m.alerts, now) {
+		return
+	}
+	m.dismissedAlerts = m.alertHistory.Dismissed()
+	_ = m.saveAlertHistory()
+}
+
+// dismissAlert acknowledges an alert in the log under the git user's name;
+// it stays dismissed across reloads and sessions until it resolves
+func (m *Model) dismissAlert(a drift.Alert) {
+	m.alertHistory.Dismiss(a, commentAuthor(projectDirFromBeadsPath(m.beadsPath)), time.Now())
+	m.dismissedAlerts = m.alertHistory.Dismissed()
+	if err := m.saveAlertHistory(); err != nil {
+		m.statusMsg = fmt.Sprintf("Dismissed for this session only: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// saveAlertHistory writes the alert log. Like daily baselines, it is kept
+// only for a project's own beads file, and only in memory in read-only mode.
+func (m *Model) saveAlertHistory() error {
+	if m.beadsPath == "" || m.timeTravelMode || readonly.Enabled() {
+		return nil
+	}
+	return drift.SaveHistory(projectDirFromBeadsPath(m.beadsPath), m.alertHistory)
+}
+
 // alertKey generates a unique key for an alert (for dismissal tracking)
 func alertKey(a drift.Alert) string {
-	return fmt.Sprintf("%s:%s:%s", a.Type, a.Severity, a.IssueID)
+	return drift.AlertKey(a)
 }
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestAlerts_DismissalPersistsAndHistoryTab(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, ".beads", "issues.jsonl")
+	old := time.Now().Add(-40 * 24 * time.Hour)
+	issues := []model.Issue{{ID: "s-1", Title: "Forgotten", Status: model.StatusOpen, CreatedAt: old, UpdatedAt: old}}
+
+	m := NewModel(issues, nil, beadsPath)
+	m.runIdleStartup()
+	if len(m.activeAlerts()) == 0 {
+		t.Fatal("expected a stale issue alert")
+	}
+	m = press(m, "!")
+	if !m.showAlertsPanel || m.alertsHistoryTab {
+		t.Fatalf("! should open the active tab: panel=%v history=%v", m.showAlertsPanel, m.alertsHistoryTab)
+	}
+	for len(m.activeAlerts()) > 0 {
+		m = press(m, "d")
+	}
+	if m.showAlertsPanel {
+		t.Error("panel should close once every alert is dismissed")
+	}
+	data, err := os.ReadFile(drift.HistoryPath(dir))
+	if err != nil || !strings.Contains(string(data), `"dismissed_by"`) {
+		t.Fatalf("alerts.json = %s, %v", data, err)
+	}
+
+	// A new session keeps the dismissals and opens on the history tab
+	m = NewModel(issues, nil, beadsPath)
+	m.runIdleStartup()
+	if n := len(m.activeAlerts()); n != 0 {
+		t.Errorf("%d alerts active after restart, want the dismissals kept", n)
+	}
+	m = press(m, "!")
+	if !m.showAlertsPanel || !m.alertsHistoryTab {
+		t.Fatalf("! with only history should open the history tab: panel=%v history=%v", m.showAlertsPanel, m.alertsHistoryTab)
+	}
+	if view := m.renderAlertsPanel(); !strings.Contains(view, "(dismissed)") || !strings.Contains(view, "dismissed by") {
+		t.Errorf("history tab should show the dismissal:\n%s", view)
+	}
+	m = press(m, "tab")
+	if m.alertsHistoryTab {
+		t.Error("tab should switch back to active alerts")
+	}
+
+	// Alerts no longer raised are logged as resolved
+	m.alerts = nil
+	m.recordAlertHistory(time.Now())
+	for _, r := range m.alertHistory.Alerts {
+		if r.Active() {
+			t.Errorf("%s should be resolved", r.Key)
+		}
+	}
+	if h, _ := drift.LoadHistory(dir); len(h.Alerts) == 0 || h.Alerts[0].Active() {
+		t.Errorf("resolution not saved: %+v", h.Alerts)
+	}
+}
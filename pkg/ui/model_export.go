@@ -2,58 +2,273 @@ package ui
 
 import (
 	"fmt"
+	"image/color"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 
 	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/lipgloss"
 )
 
-// exportToMarkdown exports all issues to a Markdown file with auto-generated filename
+// EnableStableExport makes TUI exports deterministic: no date in the filename
+// and no generation timestamp in the content (bv --stable)
+func (m *Model) EnableStableExport() {
+	m.stableExport = true
+}
+
+// exportToMarkdown exports the default scope (the marked issues, if any, or
+// else the current view) without asking
 func (m *Model) exportToMarkdown() {
-	// Generate smart filename: beads_report_<project>_YYYY-MM-DD.md
+	scopes, selected := m.exportScopes()
+	m.exportMarkdownScope(scopes[selected])
+}
+
+// openExportScopePicker asks which issues E should export, or exports right
+// away when all issues are the only choice
+func (m *Model) openExportScopePicker() {
+	scopes, selected := m.exportScopes()
+	if len(scopes) == 1 {
+		m.exportMarkdownScope(scopes[0])
+		return
+	}
+	m.exportScopePicker = NewExportScopePickerModel(scopes, selected, m.theme)
+	m.exportScopePicker.SetSize(m.width, m.height-1)
+	m.exportScopeReturn = m.focused
+	m.showExportScopePicker = true
+	m.focused = focusExportScope
+}
+
+// closeExportScopePicker hides the scope picker
+func (m *Model) closeExportScopePicker() {
+	m.showExportScopePicker = false
+	m.focused = m.exportScopeReturn
+}
+
+// exportScopes lists what the Markdown export can cover: all issues, the
+// current view when a filter is applied, the active label, the epic around
+// the selected issue, and the marked issues. The second result is the one
+// to preselect: the marked issues if any, else the current view.
+func (m *Model) exportScopes() ([]exportScope, int) {
+	scopes := []exportScope{{name: "All issues", title: "Beads Export", issues: m.issues}}
+	selected := 0
+
+	var view []model.Issue
+	if crumbs := m.filterCrumbs(); len(crumbs) > 0 {
+		for _, it := range m.list.VisibleItems() {
+			if item, ok := it.(IssueItem); ok {
+				view = append(view, item.Issue)
+			}
+		}
+		labels := make([]string, len(crumbs))
+		for i, c := range crumbs {
+			labels[i] = c.Label
+		}
+		summary := strings.Join(labels, ", ")
+		scopes = append(scopes, exportScope{
+			name:   "Current view (" + summary + ")",
+			title:  "Beads Export · " + summary,
+			slug:   "view",
+			issues: view,
+		})
+		selected = len(scopes) - 1
+	}
+
+	// The label filter narrows the view, so its scope only differs when
+	// another filter narrows it further
+	if label, ok := strings.CutPrefix(m.currentFilter, "label:"); ok {
+		var labeled []model.Issue
+		for _, issue := range m.issues {
+			if slices.Contains(issue.Labels, label) {
+				labeled = append(labeled, issue)
+			}
+		}
+		if len(labeled) != len(view) {
+			scopes = append(scopes, exportScope{
+				name:   "Label " + label,
+				title:  "Beads Export · label " + label,
+				slug:   "label-" + sanitizeFilenamePart(label),
+				issues: labeled,
+			})
+		}
+	}
+
+	if epic := m.selectedEpic(); epic != nil {
+		issues := []model.Issue{*epic}
+		for _, child := range pinnedIssues(Pin{Epic: epic.ID}, m.issues) {
+			issues = append(issues, *child)
+		}
+		scopes = append(scopes, exportScope{
+			name:   "Epic " + epic.ID + " " + epic.Title,
+			title:  "Beads Export · epic " + epic.ID + " " + epic.Title,
+			slug:   "epic-" + sanitizeFilenamePart(epic.ID),
+			issues: issues,
+		})
+	}
+
+	if marked := m.markedIssues(); len(marked) > 0 {
+		scopes = append(scopes, exportScope{
+			name:   "Marked issues",
+			title:  fmt.Sprintf("Beads Export · %d marked issues", len(marked)),
+			slug:   "marked",
+			issues: marked,
+		})
+		selected = len(scopes) - 1
+	}
+	return scopes, selected
+}
+
+// selectedEpic returns the selected issue if it is an epic, else its nearest
+// epic ancestor, or nil
+func (m *Model) selectedEpic() *model.Issue {
+	issue := m.issueMap[m.currentIssueID()]
+	seen := make(map[string]bool)
+	for issue != nil && !seen[issue.ID] {
+		if issue.IssueType == model.TypeEpic {
+			return issue
+		}
+		seen[issue.ID] = true
+		issue = m.issueMap[parentID(issue)]
+	}
+	return nil
+}
+
+// exportMarkdownScope exports the scope's issues to a Markdown file named
+// after the scope
+func (m *Model) exportMarkdownScope(scope exportScope) {
+	// Generate smart filename: beads_report[_<scope>]_<project>_YYYY-MM-DD.md
 	filename := m.generateExportFilename()
+	if scope.slug != "" {
+		filename = m.exportFilename("beads_report_"+scope.slug, "md")
+	}
 
-	// Export the issues
-	err := export.SaveMarkdownToFile(m.issues, filename)
+	err := export.SaveMarkdownToFileWithOptions(scope.issues, filename, export.MarkdownOptions{
+		Stable:      m.stableExport,
+		Title:       scope.title,
+		TemplateDir: export.ReportTemplatesDir(projectDirFromBeadsPath(m.beadsPath)),
+	})
 	if err != nil {
 		m.statusMsg = fmt.Sprintf("❌ Export failed: %v", err)
 		m.statusIsError = true
 		return
 	}
 
-	m.statusMsg = fmt.Sprintf("✅ Exported %d issues to %s", len(m.issues), filename)
+	m.statusMsg = fmt.Sprintf("✅ Exported %d issues to %s", len(scope.issues), filename)
+	m.statusIsError = false
+}
+
+// exportGraphSnapshot writes the nodes the graph view shows to an SVG or
+// PNG image, colored like the TUI, for embedding in docs
+func (m *Model) exportGraphSnapshot(format string) {
+	issues := m.graphView.ShownIssues()
+	if len(issues) == 0 || m.analysis == nil {
+		m.statusMsg = "No graph to export"
+		m.statusIsError = true
+		return
+	}
+
+	title := "Dependency graph"
+	if summary := m.graphView.FilterSummary(); summary != "" {
+		title += " · " + summary
+	}
+	filename := m.exportFilename("beads_graph", format)
+	err := export.SaveGraphSnapshot(export.GraphSnapshotOptions{
+		Path:         filename,
+		Format:       format,
+		Title:        title,
+		Issues:       issues,
+		Stats:        m.analysis,
+		DataHash:     analysis.ComputeDataHash(issues),
+		StatusColors: snapshotStatusColors(m.theme),
+	})
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Graph export failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	m.statusMsg = fmt.Sprintf("✅ Exported graph of %d issues to %s", len(issues), filename)
 	m.statusIsError = false
 }
 
+// snapshotStatusColors returns the theme's status colors for graph images.
+// Images have a light background, so the light variants are used.
+func snapshotStatusColors(t Theme) map[model.Status]color.RGBA {
+	colors := make(map[model.Status]color.RGBA)
+	for status, c := range map[model.Status]lipgloss.AdaptiveColor{
+		model.StatusOpen:       t.Open,
+		model.StatusInProgress: t.InProgress,
+		model.StatusBlocked:    t.Blocked,
+		model.StatusClosed:     t.Closed,
+	} {
+		var r, g, b uint8
+		if _, err := fmt.Sscanf(c.Light, "#%02x%02x%02x", &r, &g, &b); err == nil {
+			colors[status] = color.RGBA{r, g, b, 0xff}
+		}
+	}
+	return colors
+}
+
 // generateExportFilename creates a smart filename based on project and date
 func (m *Model) generateExportFilename() string {
+	return m.exportFilename("beads_report", "md")
+}
+
+// exportFilename names an export <prefix>_<project>_YYYY-MM-DD.<ext>
+func (m *Model) exportFilename(prefix, ext string) string {
 	// Get project name from current directory
 	projectName := "beads"
 	if cwd, err := os.Getwd(); err == nil {
-		projectName = filepath.Base(cwd)
-		// Sanitize: replace spaces and special chars with underscores
-		projectName = strings.Map(func(r rune) rune {
-			if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
-				return r
-			}
-			return '_'
-		}, projectName)
+		projectName = sanitizeFilenamePart(filepath.Base(cwd))
+	}
+
+	// Stable exports overwrite one file so successive reports diff cleanly in git
+	if m.stableExport {
+		return fmt.Sprintf("%s_%s.%s", prefix, projectName, ext)
 	}
 
-	// Format: beads_report_<project>_YYYY-MM-DD.md
 	timestamp := time.Now().Format("2006-01-02")
-	return fmt.Sprintf("beads_report_%s_%s.md", projectName, timestamp)
+	return fmt.Sprintf("%s_%s_%s.%s", prefix, projectName, timestamp, ext)
 }
 
-// copyIssueToClipboard copies the selected issue to clipboard as Markdown
+// sanitizeFilenamePart replaces spaces and special chars with underscores
+func sanitizeFilenamePart(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// copyIssueToClipboard copies the selected issue to clipboard as Markdown.
+// When issues are marked, all marked issues are copied instead.
 func (m *Model) copyIssueToClipboard() {
+	if marked := m.markedIssues(); len(marked) > 0 {
+		parts := make([]string, len(marked))
+		for i := range marked {
+			parts[i] = issueMarkdown(marked[i])
+		}
+		if err := clipboard.WriteAll(strings.Join(parts, "\n---\n\n")); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
+			m.statusIsError = true
+			return
+		}
+		m.statusMsg = fmt.Sprintf("📋 Copied %d marked issues to clipboard", len(marked))
+		m.statusIsError = false
+		return
+	}
+
 	selectedItem := m.list.SelectedItem()
 	if selectedItem == nil {
 		m.statusMsg = "❌ No issue selected"
@@ -69,7 +284,20 @@ func (m *Model) copyIssueToClipboard() {
 	}
 	issue := issueItem.Issue
 
-	// Format issue as Markdown
+	// Copy to clipboard
+	err := clipboard.WriteAll(issueMarkdown(issue))
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", issue.ID)
+	m.statusIsError = false
+}
+
+// issueMarkdown formats a single issue as Markdown for the clipboard
+func issueMarkdown(issue model.Issue) string {
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("# %s %s\n\n", GetTypeIconMD(string(issue.IssueType)), issue.Title))
@@ -104,21 +332,18 @@ func (m *Model) copyIssueToClipboard() {
 		}
 	}
 
-	// Copy to clipboard
-	err := clipboard.WriteAll(sb.String())
-	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
-		m.statusIsError = true
-		return
-	}
-
-	m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", issue.ID)
-	m.statusIsError = false
+	return sb.String()
 }
 
 // openInEditor opens the beads file in the user's preferred editor
 // Uses m.beadsPath which respects issues.jsonl (canonical per beads upstream)
 func (m *Model) openInEditor() {
+	if err := readonly.Check("Editor disabled"); err != nil {
+		m.statusMsg = "🔒 " + err.Error()
+		m.statusIsError = true
+		return
+	}
+
 	// Use the configured beadsPath instead of hardcoded path
 	beadsFile := m.beadsPath
 	if beadsFile == "" {
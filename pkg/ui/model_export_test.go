@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestModel_GraphImageExport(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	m := NewModel(filterTestIssues(), nil, "")
+	m.EnableStableExport()
+	press := func(keys ...string) {
+		for _, key := range keys {
+			updated, _ := m.Update(keyMsgFromString(key))
+			m = updated.(Model)
+		}
+	}
+
+	// Only the shown nodes are exported, in the theme's colors
+	press("g", "x", "s")
+	svgPath := filepath.Join(dir, "beads_graph_"+filepath.Base(dir)+".svg")
+	content, err := os.ReadFile(svgPath)
+	if err != nil {
+		t.Fatalf("s should write %s (status %q): %v", svgPath, m.statusMsg, err)
+	}
+	if !strings.Contains(m.statusMsg, "Exported graph of 6 issues") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+	svg := string(content)
+	if strings.Contains(svg, ">done<") || !strings.Contains(svg, "closed hidden") {
+		t.Error("the hidden closed issue shouldn't be exported, and the title should say so")
+	}
+	if _, ok := snapshotStatusColors(m.theme)[model.StatusClosed]; !ok {
+		t.Error("every status should get a color from the theme")
+	}
+	if hex := strings.ToLower(m.theme.Open.Light); !strings.Contains(svg, "stroke:"+hex) {
+		t.Errorf("open nodes should be outlined in the theme's %s", hex)
+	}
+
+	press("S")
+	if _, err := os.Stat(strings.TrimSuffix(svgPath, ".svg") + ".png"); err != nil {
+		t.Errorf("S should write a PNG: %v", err)
+	}
+}
+
+func TestModel_ExportScopes(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	issues := []model.Issue{
+		{ID: "ep-1", Title: "Checkout", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "ep-2", Title: "Pay", Status: model.StatusOpen, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "ep-2", DependsOnID: "ep-1", Type: model.DepParentChild}}},
+		{ID: "ep-3", Title: "Refund", Status: model.StatusClosed, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "ep-3", DependsOnID: "ep-2", Type: model.DepParentChild}}},
+		{ID: "x-1", Title: "Docs", Status: model.StatusOpen, Labels: []string{"docs"}},
+	}
+	m := NewModel(issues, nil, "")
+	m.EnableStableExport()
+	press := func(keys ...string) {
+		for _, key := range keys {
+			updated, _ := m.Update(keyMsgFromString(key))
+			m = updated.(Model)
+		}
+	}
+	names := func() []string {
+		scopes, _ := m.exportScopes()
+		var out []string
+		for _, s := range scopes {
+			out = append(out, fmt.Sprintf("%s=%d", s.name, len(s.issues)))
+		}
+		return out
+	}
+
+	// Nothing narrows the choice: E exports everything straight away
+	m.syncSelection("x-1")
+	press("E")
+	if m.showExportScopePicker || !strings.Contains(m.statusMsg, "Exported 4 issues") {
+		t.Fatalf("E should export all issues without asking, got %q", m.statusMsg)
+	}
+
+	// The view under the label filter, and the selected issue's epic. The
+	// label itself adds nothing while it is the only filter.
+	m.SetFilter("label:api")
+	m.syncSelection("ep-2")
+	if got := m.currentIssueID(); got != "ep-2" {
+		t.Fatalf("selected %q", got)
+	}
+	want := "[All issues=4 Current view (label: api)=2 Epic ep-1 Checkout=3]"
+	if got := fmt.Sprint(names()); got != want {
+		t.Errorf("scopes = %s, want %s", got, want)
+	}
+	m.marked["x-1"] = true
+	if got := names(); len(got) != 4 || got[3] != "Marked issues=1" {
+		t.Errorf("marks should add a scope, got %v", got)
+	}
+
+	// The picker starts on the marked issues; a digit exports its scope
+	press("E")
+	if !m.showExportScopePicker || m.exportScopePicker.SelectedScope().slug != "marked" {
+		t.Fatal("E should open the scope picker on the marked issues")
+	}
+	press("3")
+	if m.showExportScopePicker || m.focused != focusList {
+		t.Error("choosing a scope should close the picker")
+	}
+	data, err := os.ReadFile(filepath.Join(dir, m.exportFilename("beads_report_epic-ep-1", "md")))
+	if err != nil {
+		t.Fatalf("3 should export the epic (status %q): %v", m.statusMsg, err)
+	}
+	if content := string(data); !strings.Contains(content, "# Beads Export · epic ep-1 Checkout") ||
+		!strings.Contains(content, "Refund") || strings.Contains(content, "Docs") {
+		t.Errorf("epic export should hold the epic's subtree under its title:\n%s", content)
+	}
+
+	press("E", "esc")
+	if m.showExportScopePicker || m.focused != focusList {
+		t.Error("esc should close the picker without exporting")
+	}
+}
@@ -1,15 +1,109 @@
 package ui
 
 import (
-	"sort"
+	"fmt"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 	"github.com/charmbracelet/bubbles/list"
 )
 
+// computeTriage scores the issues (bv-151) and adds the triage data to the
+// items already listed
+func (m *Model) computeTriage() {
+	triage := m.triageResult()
+	m.triageScores = make(map[string]float64, len(triage.Recommendations))
+	m.triageReasons = make(map[string]analysis.TriageReasons, len(triage.Recommendations))
+	m.unblocksMap = make(map[string][]string, len(triage.Recommendations))
+	m.quickWinSet = make(map[string]bool, len(triage.QuickWins))
+	m.blockerSet = make(map[string]bool, len(triage.BlockersToClear))
+	for _, rec := range triage.Recommendations {
+		m.triageScores[rec.ID] = rec.Score
+		if len(rec.Reasons) > 0 {
+			m.triageReasons[rec.ID] = analysis.TriageReasons{
+				Primary:    rec.Reasons[0],
+				All:        rec.Reasons,
+				ActionHint: rec.Action,
+			}
+		}
+		m.unblocksMap[rec.ID] = rec.UnblocksIDs
+	}
+	for _, qw := range triage.QuickWins {
+		m.quickWinSet[qw.ID] = true
+	}
+	for _, bl := range triage.BlockersToClear {
+		m.blockerSet[bl.ID] = true
+	}
+
+	items := m.list.Items()
+	for i := range items {
+		if item, ok := items[i].(IssueItem); ok {
+			item.TriageScore = m.triageScores[item.Issue.ID]
+			if reasons, exists := m.triageReasons[item.Issue.ID]; exists {
+				item.TriageReason = reasons.Primary
+				item.TriageReasons = reasons.All
+			}
+			item.IsQuickWin = m.quickWinSet[item.Issue.ID]
+			item.IsBlocker = m.blockerSet[item.Issue.ID]
+			item.UnblocksCount = len(m.unblocksMap[item.Issue.ID])
+			items[i] = item
+		}
+	}
+	m.list.SetItems(items)
+	m.updateSemanticIDs(items)
+}
+
+// triageResult runs triage over analysisIssues with the active profile
+func (m *Model) triageResult() analysis.TriageResult {
+	return analysis.ComputeTriageWithOptions(m.analysisIssues(), analysis.TriageOptions{Profile: &m.triageProfile})
+}
+
+// setInsightsTriage hands a triage result to the insights priority panel
+func (m *Model) setInsightsTriage(triage analysis.TriageResult) {
+	m.insightsPanel.SetTopPicks(starredTopPicks(triage.QuickRef.TopPicks, triage.Recommendations, m.starred))
+	// Set full recommendations with breakdown for priority radar (bv-93)
+	dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
+	m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
+	m.insightsPanel.SetTriageProfile(m.triageProfile.Name)
+}
+
+// SetTriageProfile selects a named profile from .bv/triage.yaml
+// (--triage-profile) instead of the one the file names
+func (m *Model) SetTriageProfile(profile analysis.TriageProfile) {
+	m.triageProfile = profile
+	m.triageProfileName = profile.Name
+}
+
+// rescoreTriage reloads .bv/triage.yaml and re-ranks the list and the
+// insights picks with it, so weights can be tuned without restarting
+func (m *Model) rescoreTriage() {
+	profile, err := analysis.LoadTriageProfile(projectDirFromBeadsPath(m.beadsPath), m.triageProfileName)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.triageProfile = profile
+	m.computeTriage()
+	m.applyFilter()
+
+	triage := m.triageResult()
+	m.setInsightsTriage(triage)
+	m.statusMsg = fmt.Sprintf("🎯 Re-scored with triage profile %q", profile.Name)
+	if len(triage.Recommendations) > 0 {
+		m.statusMsg += fmt.Sprintf(" • top pick %s", triage.Recommendations[0].ID)
+	}
+	m.statusIsError = false
+}
+
+// updateSemanticIDs keeps the search filters (semantic and query) aligned with list items
 func (m *Model) updateSemanticIDs(items []list.Item) {
+	if m.queryFilter != nil {
+		m.queryFilter.SetItems(items)
+	}
 	if m.semanticSearch == nil {
 		return
 	}
@@ -23,53 +117,31 @@ func (m *Model) updateSemanticIDs(items []list.Item) {
 }
 
 func (m *Model) applyFilter() {
+	// The active recipe owns currentFilter while it is shown
+	if m.recipeFilterActive() {
+		m.applyRecipe(m.activeRecipe)
+		return
+	}
+
 	var filteredItems []list.Item
 	var filteredIssues []model.Issue
 
-	for _, issue := range m.issues {
-		// Workspace repo filter (nil = all repos)
-		if m.workspaceMode && m.activeRepos != nil {
-			repoKey := strings.ToLower(ExtractRepoPrefix(issue.ID))
-			if repoKey != "" && !m.activeRepos[repoKey] {
-				continue
-			}
-		}
-
-		include := false
-		switch m.currentFilter {
-		case "all":
-			include = true
-		case "open":
-			include = issue.Status != model.StatusClosed
-		case "closed":
-			include = issue.Status == model.StatusClosed
-		case "ready":
-			// Ready = Open/InProgress AND NO Open Blockers
-			if issue.Status != model.StatusClosed && issue.Status != model.StatusBlocked {
-				isBlocked := false
-				for _, dep := range issue.Dependencies {
-					if dep.Type == model.DepBlocks {
-						if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
-							isBlocked = true
-							break
-						}
-					}
-				}
-				include = !isBlocked
-			}
-		default:
-			if strings.HasPrefix(m.currentFilter, "label:") {
-				label := strings.TrimPrefix(m.currentFilter, "label:")
-				for _, l := range issue.Labels {
-					if l == label {
-						include = true
-						break
-					}
-				}
-			}
+	// Committed query expression (query:<expr>), parsed once per apply
+	var q *query.Query
+	if expr, ok := strings.CutPrefix(m.currentFilter, "query:"); ok {
+		parsed, err := query.Parse(expr)
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Invalid query: %v", err)
+			m.statusIsError = true
+			m.currentFilter = "all"
+		} else {
+			q = parsed
 		}
+	}
 
-		if include {
+	stages := m.listFilterStages(q)
+	for _, issue := range m.issues {
+		if matchesStages(stages, &issue) {
 			// Use pre-computed graph scores (avoid redundant calculation)
 			item := IssueItem{
 				Issue:      issue,
@@ -91,6 +163,7 @@ func (m *Model) applyFilter() {
 			filteredIssues = append(filteredIssues, issue)
 		}
 	}
+	m.sortListed(filteredItems, filteredIssues, m.listSort, m.listSortDesc)
 
 	m.list.SetItems(filteredItems)
 	m.updateSemanticIDs(filteredItems)
@@ -103,83 +176,38 @@ func (m *Model) applyFilter() {
 	if len(filteredItems) > 0 && m.list.Index() >= len(filteredItems) {
 		m.list.Select(0)
 	}
+	m.syncFilterStack()
 	m.updateViewportContent()
 }
 
-// applyRecipe applies a recipe's filters and sort to the current view
-func (m *Model) applyRecipe(r *recipe.Recipe) {
+// applyRecipe applies a recipe's filters and sort to the current view. A
+// recipe whose query doesn't parse is refused: the error goes to the status
+// bar and the view is left as it was.
+func (m *Model) applyRecipe(r *recipe.Recipe) error {
 	if r == nil {
-		return
+		return nil
 	}
 
+	// Optional query expression (filters.query)
+	var q *query.Query
+	if r.Filters.Query != "" {
+		parsed, err := query.Parse(r.Filters.Query)
+		if err != nil {
+			err = fmt.Errorf("recipe %s has an invalid query: %w", r.Name, err)
+			m.statusMsg = "❌ " + err.Error()
+			m.statusIsError = true
+			return err
+		}
+		q = parsed
+	}
+	m.ensureStartup(startupTriage) // Recipes may sort by triage score
+
 	var filteredItems []list.Item
 	var filteredIssues []model.Issue
 
+	stages := m.recipeFilterStages(r, q)
 	for _, issue := range m.issues {
-		include := true
-
-		// Workspace repo filter (nil = all repos)
-		if m.workspaceMode && m.activeRepos != nil {
-			repoKey := strings.ToLower(ExtractRepoPrefix(issue.ID))
-			if repoKey != "" && !m.activeRepos[repoKey] {
-				include = false
-			}
-		}
-
-		// Apply status filter
-		if len(r.Filters.Status) > 0 {
-			statusMatch := false
-			for _, s := range r.Filters.Status {
-				if string(issue.Status) == s {
-					statusMatch = true
-					break
-				}
-			}
-			include = include && statusMatch
-		}
-
-		// Apply priority filter
-		if include && len(r.Filters.Priority) > 0 {
-			prioMatch := false
-			for _, p := range r.Filters.Priority {
-				if issue.Priority == p {
-					prioMatch = true
-					break
-				}
-			}
-			include = include && prioMatch
-		}
-
-		// Apply tags filter (must have ALL specified tags)
-		if include && len(r.Filters.Tags) > 0 {
-			labelSet := make(map[string]bool)
-			for _, l := range issue.Labels {
-				labelSet[l] = true
-			}
-			for _, required := range r.Filters.Tags {
-				if !labelSet[required] {
-					include = false
-					break
-				}
-			}
-		}
-
-		// Apply actionable filter
-		if include && r.Filters.Actionable != nil && *r.Filters.Actionable {
-			// Check if issue is blocked
-			isBlocked := false
-			for _, dep := range issue.Dependencies {
-				if dep.Type == model.DepBlocks {
-					if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
-						isBlocked = true
-						break
-					}
-				}
-			}
-			include = !isBlocked
-		}
-
-		if include {
+		if matchesStages(stages, &issue) {
 			item := IssueItem{
 				Issue:      issue,
 				GraphScore: m.analysis.GetPageRankScore(issue.ID),
@@ -201,62 +229,12 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 		}
 	}
 
-	// Apply sort
-	descending := r.Sort.Direction == "desc"
-	if r.Sort.Field != "" {
-		sort.Slice(filteredItems, func(i, j int) bool {
-			iItem := filteredItems[i].(IssueItem)
-			jItem := filteredItems[j].(IssueItem)
-			less := false
-
-			switch r.Sort.Field {
-			case "priority":
-				less = iItem.Issue.Priority < jItem.Issue.Priority
-			case "created", "created_at":
-				less = iItem.Issue.CreatedAt.Before(jItem.Issue.CreatedAt)
-			case "updated", "updated_at":
-				less = iItem.Issue.UpdatedAt.Before(jItem.Issue.UpdatedAt)
-			case "impact":
-				// Use analysis map for sort
-				less = m.analysis.GetCriticalPathScore(iItem.Issue.ID) < m.analysis.GetCriticalPathScore(jItem.Issue.ID)
-			case "pagerank":
-				// Use analysis map for sort
-				less = m.analysis.GetPageRankScore(iItem.Issue.ID) < m.analysis.GetPageRankScore(jItem.Issue.ID)
-			default:
-				less = iItem.Issue.Priority < jItem.Issue.Priority
-			}
-
-			if descending {
-				return !less
-			}
-			return less
-		})
-
-		// Re-sort issues list too
-		sort.Slice(filteredIssues, func(i, j int) bool {
-			less := false
-			switch r.Sort.Field {
-			case "priority":
-				less = filteredIssues[i].Priority < filteredIssues[j].Priority
-			case "created", "created_at":
-				less = filteredIssues[i].CreatedAt.Before(filteredIssues[j].CreatedAt)
-			case "updated", "updated_at":
-				less = filteredIssues[i].UpdatedAt.Before(filteredIssues[j].UpdatedAt)
-			case "impact":
-				// Use analysis map for sort
-				less = m.analysis.GetCriticalPathScore(filteredIssues[i].ID) < m.analysis.GetCriticalPathScore(filteredIssues[j].ID)
-			case "pagerank":
-				// Use analysis map for sort
-				less = m.analysis.GetPageRankScore(filteredIssues[i].ID) < m.analysis.GetPageRankScore(filteredIssues[j].ID)
-			default:
-				less = filteredIssues[i].Priority < filteredIssues[j].Priority
-			}
-			if descending {
-				return !less
-			}
-			return less
-		})
+	// Apply sort: the recipe's, unless one was picked with s
+	field, descending := r.Sort.Field, r.Sort.Direction == "desc"
+	if m.listSort != "" {
+		field, descending = m.listSort, m.listSortDesc
 	}
+	m.sortListed(filteredItems, filteredIssues, field, descending)
 
 	m.list.SetItems(filteredItems)
 	m.updateSemanticIDs(filteredItems)
@@ -272,7 +250,19 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 	if len(filteredItems) > 0 && m.list.Index() >= len(filteredItems) {
 		m.list.Select(0)
 	}
+	m.syncFilterStack()
 	m.updateViewportContent()
+	return nil
+}
+
+// selectRecipe makes r the active recipe and applies it, keeping the
+// previous recipe if r is refused
+func (m *Model) selectRecipe(r *recipe.Recipe) {
+	prev := m.activeRecipe
+	m.activeRecipe = r
+	if err := m.applyRecipe(r); err != nil {
+		m.activeRecipe = prev
+	}
 }
 
 // SetFilter sets the current filter and applies it (exposed for testing)
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestRescoreTriage_ReloadsProfile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "a", Title: "Urgent fix", Status: model.StatusOpen, Priority: 0, UpdatedAt: now},
+		{ID: "b", Title: "Customer ask", Status: model.StatusOpen, Priority: 3, Labels: []string{"customer"}, UpdatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	m.computeTriage()
+	if m.triageProfile.Name != analysis.DefaultTriageProfile || m.triageScores["a"] <= m.triageScores["b"] {
+		t.Fatalf("default profile should rank the P0 first: %v", m.triageScores)
+	}
+
+	if err := os.MkdirAll(".bv", 0755); err != nil {
+		t.Fatal(err)
+	}
+	config := "profile: customers\nprofiles:\n  customers:\n    label_boosts:\n      customer: 0.5\n"
+	if err := os.WriteFile(filepath.Join(".bv", analysis.TriageConfigFilename), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m.focused = focusInsights
+	newM, _ := m.Update(keyMsgFromString("r"))
+	m = newM.(Model)
+	if m.statusIsError || !strings.Contains(m.statusMsg, `profile "customers"`) || !strings.Contains(m.statusMsg, "top pick b") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+	if m.triageScores["b"] <= m.triageScores["a"] {
+		t.Errorf("re-scoring should apply the label boost: %v", m.triageScores)
+	}
+	if m.insightsPanel.triageProfile != "customers" {
+		t.Errorf("insights should show the active profile, got %q", m.insightsPanel.triageProfile)
+	}
+
+	// A broken file keeps the current profile
+	if err := os.WriteFile(filepath.Join(".bv", analysis.TriageConfigFilename), []byte("unblock: -1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m.rescoreTriage()
+	if !m.statusIsError || m.triageProfile.Name != "customers" {
+		t.Errorf("a bad config should be reported and ignored: %q, %s", m.statusMsg, m.triageProfile.Name)
+	}
+}
+
+func TestNewModel_ReportsBrokenTypeToggles(t *testing.T) {
+	t.Chdir(t.TempDir())
+	issues := []model.Issue{{ID: "a", Title: "Task", Status: model.StatusOpen, IssueType: model.TypeTask}}
+	if m := NewModel(issues, nil, ""); m.statusIsError {
+		t.Fatalf("a missing analysis.yaml is not an error: %q", m.statusMsg)
+	}
+
+	if err := os.MkdirAll(".bv", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(analysis.TypeTogglesPath("."), []byte("exclude_types: [chore, nonsense]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel(issues, nil, "")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "nonsense") {
+		t.Errorf("a broken analysis.yaml should be reported, status = %q", m.statusMsg)
+	}
+	if len(m.typeToggles.ExcludeTypes) != 0 {
+		t.Errorf("a broken analysis.yaml should fall back to no toggles, got %v", m.typeToggles.ExcludeTypes)
+	}
+}
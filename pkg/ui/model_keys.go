@@ -3,9 +3,13 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // handleBoardKeys handles keyboard input when the board view is focused
@@ -27,6 +31,19 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 		m.board.PageDown(m.height / 3)
 	case "ctrl+u":
 		m.board.PageUp(m.height / 3)
+	case " ":
+		m.startBoardMove()
+	case "s":
+		if mode := m.board.CycleLanes(); mode == LanesNone {
+			m.statusMsg = "Swimlanes off"
+		} else {
+			m.statusMsg = fmt.Sprintf("Swimlanes by %s: J/K switch lane", mode)
+		}
+		m.statusIsError = false
+	case "J":
+		m.board.NextLane()
+	case "K":
+		m.board.PrevLane()
 	case "enter":
 		if selected := m.board.SelectedIssue(); selected != nil {
 			// Find and select in list
@@ -50,6 +67,80 @@ func (m Model) handleBoardKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// startBoardMove picks up the selected board card for moving
+func (m *Model) startBoardMove() {
+	selected := m.board.SelectedIssue()
+	switch {
+	case selected == nil:
+		return
+	case m.beadsPath == "":
+		m.statusMsg = "Moving cards needs a beads file to write to (not available for stdin, workspace or imported issues)"
+		m.statusIsError = true
+		return
+	case m.isReadOnlyIssue(selected.ID):
+		m.statusMsg = fmt.Sprintf("🔒 Move disabled: %s is in a read-only repo", selected.ID)
+		m.statusIsError = true
+		return
+	}
+	m.board.StartMove()
+	m.statusMsg = fmt.Sprintf("Moving %s: h/l pick a column, enter drops, esc cancels", selected.ID)
+	m.statusIsError = false
+}
+
+// handleBoardMoveKeys handles keyboard input while a board card is being
+// moved. Dropping it writes the new status or label to the beads file.
+func (m Model) handleBoardMoveKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "h", "left":
+		m.board.MoveLeft()
+	case "l", "right":
+		m.board.MoveRight()
+	case "esc", "q":
+		m.board.EndMove()
+		m.statusMsg = "Move cancelled"
+		m.statusIsError = false
+	case "enter":
+		m.dropBoardCard()
+	}
+	return m
+}
+
+// dropBoardCard writes the moved card into the focused column
+func (m *Model) dropBoardCard() {
+	id, from, to := m.board.MoveTarget()
+	if to < 0 || to == from {
+		m.board.EndMove()
+		m.statusMsg = fmt.Sprintf("%s stays where it was", id)
+		m.statusIsError = false
+		return
+	}
+	cfg := m.board.Config()
+	updated, err := loader.UpdateIssue(m.beadsPath, id, func(issue *model.Issue) {
+		cfg.moveIssue(issue, to, time.Now())
+	})
+	if err != nil {
+		m.board.EndMove()
+		m.statusMsg = fmt.Sprintf("❌ Move failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	// Show the move now rather than waiting for the file watcher's reload
+	if issue, ok := m.issueMap[id]; ok {
+		*issue = updated
+	}
+	m.applyFilter()
+	m.board.EndMove()
+
+	col := cfg.Columns[to]
+	m.statusMsg = fmt.Sprintf("Moved %s to %s", id, col.Title)
+	m.statusIsError = false
+	if m.board.OverWIP(to) {
+		m.statusMsg += fmt.Sprintf(" ⚠ over WIP limit (%d/%d)", m.board.ColumnCount(to), col.WIP)
+	}
+	m.updateViewportContent()
+}
+
 // handleGraphKeys handles keyboard input when the graph view is focused
 func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -69,6 +160,53 @@ func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 		m.graphView.ScrollLeft()
 	case "L":
 		m.graphView.ScrollRight()
+	case "c":
+		m.graphView.ToggleClusters()
+	case "f":
+		m.graphView.ToggleFocus()
+		m.graphFilterStatus()
+	case "+", "=", "-":
+		delta := 1
+		if msg.String() == "-" {
+			delta = -1
+		}
+		m.graphView.ChangeFocusDepth(delta)
+		if m.graphView.FocusRoot() == "" {
+			m.statusMsg = fmt.Sprintf("Focus mode will show %d hops: press f on a node", m.graphView.FocusDepth())
+			m.statusIsError = false
+		} else {
+			m.graphFilterStatus()
+		}
+	case "x":
+		m.graphView.ToggleHideClosed()
+		m.graphFilterStatus()
+	case "e":
+		m.graphView.ToggleHideLinks()
+		m.graphFilterStatus()
+	case "s":
+		m.exportGraphSnapshot("svg")
+	case "S":
+		m.exportGraphSnapshot("png")
+	case "/":
+		m.gotoPicker = NewGotoPickerModel(m.graphView.ShownIssues(), m.theme)
+		m.gotoPicker.SetTitle("Jump to Node")
+		m.gotoPicker.SetSize(m.width, m.height-1)
+		m.gotoReturn = m.focused
+		m.gotoGraph = true
+		m.showGoto = true
+		m.focused = focusGoto
+	case "o":
+		switch m.graphView.CycleLayout() {
+		case LayoutEgo:
+			m.statusMsg = "Graph layout: ego (selected node with its blockers and dependents)"
+		case LayoutLayered:
+			m.statusMsg = "Graph layout: layered (blockers above dependents)"
+		case LayoutRadial:
+			m.statusMsg = "Graph layout: radial (rings of hops around the selected node)"
+		case LayoutForce:
+			m.statusMsg = "Graph layout: force-directed"
+		}
+		m.statusIsError = false
 	case "enter":
 		if selected := m.graphView.SelectedIssue(); selected != nil {
 			// Find and select in list
@@ -92,6 +230,94 @@ func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// graphFilterStatus reports the graph's filters after one changes
+func (m *Model) graphFilterStatus() {
+	if summary := m.graphView.FilterSummary(); summary != "" {
+		m.statusMsg = "Graph: " + summary
+	} else {
+		m.statusMsg = fmt.Sprintf("Graph: all %d nodes", m.graphView.TotalCount())
+	}
+	m.statusIsError = false
+}
+
+// handleTimelineKeys handles keyboard input when the timeline view is focused
+func (m Model) handleTimelineKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.timelineView.MoveDown()
+	case "k", "up":
+		m.timelineView.MoveUp()
+	case "ctrl+d", "pgdown":
+		m.timelineView.PageDown()
+	case "ctrl+u", "pgup":
+		m.timelineView.PageUp()
+	case "h", "left", "H":
+		m.timelineView.ScrollLeft()
+	case "l", "right", "L":
+		m.timelineView.ScrollRight()
+	case "+", "=":
+		m.timelineView.ZoomIn()
+	case "-", "_":
+		m.timelineView.ZoomOut()
+	case "c":
+		m.timelineView.ToggleCriticalOnly()
+	case "enter":
+		if selectedID := m.timelineView.SelectedIssueID(); selectedID != "" {
+			for i, item := range m.list.Items() {
+				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+					m.list.Select(i)
+					break
+				}
+			}
+			m.isTimelineView = false
+			m.focused = focusList
+			if m.isSplitView {
+				m.focused = focusDetail
+			} else {
+				m.showDetails = true
+				m.focused = focusDetail
+			}
+			m.updateViewportContent()
+		}
+	}
+	return m
+}
+
+// handleHeatmapKeys handles keyboard input when the activity heatmap view is focused
+func (m Model) handleHeatmapKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.heatmapView.MoveDown()
+	case "k", "up":
+		m.heatmapView.MoveUp()
+	case "ctrl+d", "pgdown":
+		m.heatmapView.PageDown()
+	case "ctrl+u", "pgup":
+		m.heatmapView.PageUp()
+	case "c":
+		m.heatmapView.CycleMode()
+	case "enter":
+		if selectedID := m.heatmapView.SelectedIssueID(); selectedID != "" {
+			for i, item := range m.list.Items() {
+				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+					m.list.Select(i)
+					break
+				}
+			}
+			m.isHeatmapView = false
+			m.focused = focusList
+			if m.isSplitView {
+				m.focused = focusDetail
+			} else {
+				m.showDetails = true
+				m.focused = focusDetail
+			}
+			m.updateViewportContent()
+		}
+	}
+	return m
+}
+
 // handleActionableKeys handles keyboard input when actionable view is focused
 func (m Model) handleActionableKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -99,6 +325,11 @@ func (m Model) handleActionableKeys(msg tea.KeyMsg) Model {
 		m.actionableView.MoveDown()
 	case "k", "up":
 		m.actionableView.MoveUp()
+	case "s":
+		m.actionableView.ToggleWaves()
+	case "C":
+		// Copy the plan (or waves schedule) as a Markdown table
+		m.copyActionableView()
 	case "enter":
 		// Jump to selected issue in list view
 		selectedID := m.actionableView.SelectedIssueID()
@@ -200,6 +431,7 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 
 // handleRecipePickerKeys handles keyboard input when recipe picker is focused
 func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
+	m.ensureStartup(startupRecipes)
 	switch msg.String() {
 	case "j", "down":
 		m.recipePicker.MoveDown()
@@ -211,8 +443,7 @@ func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
 	case "enter":
 		// Apply selected recipe
 		if selected := m.recipePicker.SelectedRecipe(); selected != nil {
-			m.activeRecipe = selected
-			m.applyRecipe(selected)
+			m.selectRecipe(selected)
 		}
 		m.showRecipePicker = false
 		m.focused = focusList
@@ -220,6 +451,161 @@ func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// handleThemePickerKeys handles keyboard input when the theme picker is open.
+// Moving the selection previews the theme; enter keeps it, esc restores the old one.
+func (m Model) handleThemePickerKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.themePicker.MoveDown()
+		if selected := m.themePicker.SelectedTheme(); selected != nil {
+			m.applyTheme(*selected)
+		}
+	case "k", "up":
+		m.themePicker.MoveUp()
+		if selected := m.themePicker.SelectedTheme(); selected != nil {
+			m.applyTheme(*selected)
+		}
+	case "esc", "q":
+		if original, ok := FindTheme(m.themes, m.themePicker.OriginalTheme()); ok {
+			m.applyTheme(original)
+		}
+		m.showThemePicker = false
+		m.focused = focusList
+	case "enter":
+		if selected := m.themePicker.SelectedTheme(); selected != nil {
+			m.applyTheme(*selected)
+			if err := SaveThemeSelection(projectDirFromBeadsPath(m.beadsPath), selected.Name); err != nil {
+				m.statusMsg = fmt.Sprintf("Theme %s applied but not saved: %v", selected.Name, err)
+				m.statusIsError = true
+			} else {
+				m.statusMsg = fmt.Sprintf("Theme: %s", selected.Name)
+				m.statusIsError = false
+			}
+		}
+		m.showThemePicker = false
+		m.focused = focusList
+	}
+	return m
+}
+
+// handleExportScopePickerKeys handles keyboard input when the export scope
+// picker is open. Digits export the numbered scope straight away.
+func (m Model) handleExportScopePickerKeys(msg tea.KeyMsg) Model {
+	switch key := msg.String(); key {
+	case "j", "down":
+		m.exportScopePicker.MoveDown()
+	case "k", "up":
+		m.exportScopePicker.MoveUp()
+	case "enter":
+		m.closeExportScopePicker()
+		if scope := m.exportScopePicker.SelectedScope(); scope != nil {
+			m.exportMarkdownScope(*scope)
+		}
+	case "esc", "q":
+		m.closeExportScopePicker()
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		if scope := m.exportScopePicker.ScopeAt(int(key[0] - '0')); scope != nil {
+			m.closeExportScopePicker()
+			m.exportMarkdownScope(*scope)
+		}
+	}
+	return m
+}
+
+// handleJumpListKeys handles keyboard input when the jump list is open.
+// Digits jump straight to the numbered issue.
+func (m Model) handleJumpListKeys(msg tea.KeyMsg) Model {
+	switch key := msg.String(); key {
+	case "j", "down":
+		m.jumpList.MoveDown()
+	case "k", "up":
+		m.jumpList.MoveUp()
+	case "enter":
+		m.jumpFromList(m.jumpList.SelectedID())
+	case "esc", "q", "ctrl+o":
+		m.closeJumpList()
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		if id := m.jumpList.IDAt(int(key[0] - '0')); id != "" {
+			m.jumpFromList(id)
+		}
+	}
+	return m
+}
+
+// handleGotoKeys handles keyboard input for the goto prompt. Letters go to
+// the input, so completions are chosen with tab or the arrow keys.
+func (m Model) handleGotoKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc":
+		m.showGoto = false
+		m.focused = m.gotoReturn
+	case "tab", "down", "ctrl+n":
+		m.gotoPicker.MoveDown()
+	case "shift+tab", "up", "ctrl+p":
+		m.gotoPicker.MoveUp()
+	case "enter":
+		id := m.gotoPicker.SelectedID()
+		if id == "" {
+			id = m.gotoPicker.InputValue()
+		}
+		if id == "" {
+			return m
+		}
+		m.showGoto = false
+		if m.gotoGraph {
+			m.focused = m.gotoReturn
+			if m.graphView.SelectIssueByID(id) {
+				m.statusMsg = fmt.Sprintf("Jumped to %s", id)
+				m.statusIsError = false
+			} else {
+				m.statusMsg = fmt.Sprintf("%s isn't in the graph; focus mode or x may hide it", id)
+				m.statusIsError = true
+			}
+			return m
+		}
+		if !m.jumpToIssue(id) {
+			m.focused = m.gotoReturn
+			m.statusMsg = fmt.Sprintf("No issue %s", id)
+			m.statusIsError = true
+		}
+	default:
+		m.gotoPicker.UpdateInput(msg)
+	}
+	return m
+}
+
+// applyTheme switches every view to a theme's colors
+func (m *Model) applyTheme(nt NamedTheme) {
+	theme := nt.Build(m.theme.Renderer)
+	m.theme = theme
+
+	m.board.theme = theme
+	m.labelDashboard.theme = theme
+	m.velocityComparison.theme = theme
+	m.shortcutsSidebar.theme = theme
+	m.graphView.theme = theme
+	m.insightsPanel.theme = theme
+	m.actionableView.theme = theme
+	m.historyView.theme = theme
+	m.recipePicker.theme = theme
+	m.labelPicker.theme = theme
+	m.repoPicker.theme = theme
+	m.themePicker.theme = theme
+	m.timelineView.theme = theme
+	m.heatmapView.theme = theme
+
+	m.list.SetDelegate(m.issueDelegate())
+	m.list.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(theme.Primary)
+	m.list.Styles.FilterCursor = lipgloss.NewStyle().Foreground(theme.Primary)
+	m.timeTravelInput.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	m.timeTravelInput.TextStyle = lipgloss.NewStyle().Foreground(theme.Base.GetForeground())
+
+	if m.renderer != nil {
+		m.renderer.SetWidthWithTheme(m.renderer.width, theme)
+	}
+	m.updateViewportContent()
+}
+
 // handleRepoPickerKeys handles keyboard input when repo picker is focused (workspace mode).
 func (m Model) handleRepoPickerKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -308,7 +694,37 @@ func (m Model) handleInsightsKeys(msg tea.KeyMsg) Model {
 	case "H":
 		// Toggle heatmap view (bv-95)
 		m.insightsPanel.ToggleHeatmap()
+	case "d":
+		// Similar card: switch between clusters and the closest pairs
+		m.insightsPanel.ToggleDuplicatePairs()
+	case "r":
+		// Reload .bv/triage.yaml and re-score
+		m.rescoreTriage()
+	case "C":
+		// Copy the triage top picks as a Markdown table
+		md, rows := topPicksMarkdown(m.insightsPanel.topPicks)
+		m.copyViewToClipboard("triage top picks", md, rows)
+	case "=":
+		// Compare the selected possible-duplicate pair side by side
+		if pair := m.insightsPanel.SelectedDuplicatePair(); pair != nil {
+			m.openCompare(pair.A, pair.B)
+		}
 	case "enter":
+		// On the clusters card, the first enter drills into the cluster
+		if m.insightsPanel.OpenCluster() {
+			return m
+		}
+		// On a possible-duplicate pair, mark both so U can merge them
+		if pair := m.insightsPanel.SelectedDuplicatePair(); pair != nil {
+			a, b := pair.A, pair.B
+			if m.jumpToIssue(a) {
+				m.marked[a] = true
+				m.marked[b] = true
+				m.statusMsg = fmt.Sprintf("Marked %s and %s as possible duplicates (M: show both, U: merge)", a, b)
+				m.statusIsError = false
+			}
+			return m
+		}
 		// Jump to selected issue in list view
 		selectedID := m.insightsPanel.SelectedIssueID()
 		if selectedID != "" {
@@ -337,6 +753,9 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "enter":
 		if m.isSplitView {
 			// In split view, update the detail pane for the current selection
+			if item, ok := m.list.SelectedItem().(IssueItem); ok {
+				m.recent.Record(item.Issue.ID, time.Now())
+			}
 			m.updateViewportContent()
 		} else {
 			// In non-split view, open detail view
@@ -383,11 +802,14 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "a":
 		m.currentFilter = "all"
 		m.applyFilter()
+	case "backspace":
+		// Drop the most recent constraint, keeping the others
+		m.popFilter()
 	case "t":
 		// Toggle time-travel mode off, or show prompt for custom revision
 		if m.timeTravelMode {
 			m.exitTimeTravelMode()
-		} else {
+		} else if !m.blockNoGit("Time-travel") {
 			// Show input prompt for revision
 			m.showTimeTravelPrompt = true
 			m.timeTravelInput.SetValue("")
@@ -398,30 +820,103 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 		// Quick time-travel with default HEAD~5
 		if m.timeTravelMode {
 			m.exitTimeTravelMode()
-		} else {
+		} else if !m.blockNoGit("Time-travel") {
 			m.enterTimeTravelMode("HEAD~5")
 		}
+	case " ", "space":
+		// Mark/unmark for bulk actions
+		m.toggleMarkSelected()
+	case "ctrl+a":
+		// Mark all visible issues (again to unmark)
+		m.toggleMarkAllVisible()
+	case "M":
+		// Show only marked issues
+		m.toggleMarkedOnly()
+	case "U":
+		// Merge two marked issues
+		m.openMergeForMarked()
+	case "=":
+		// Compare two marked issues (or the selected issue and its parent)
+		m.openCompareForMarked()
 	case "C":
-		// Copy selected issue to clipboard
+		// Copy selected issue (or all marked issues) to clipboard
 		m.copyIssueToClipboard()
 	case "O":
 		// Open beads.jsonl in editor
-		m.openInEditor()
+		if !m.blockReadOnly("Editing disabled") {
+			m.openInEditor()
+		}
 	case "H":
 		// Toggle history view
-		if !m.isHistoryView {
+		if !m.isHistoryView && !m.blockNoGit("History") {
 			m.enterHistoryView()
 		}
+	case "s":
+		// Cycle the list sort: priority, created, updated, impact, pagerank, triage
+		m.cycleListSort()
+	case "~":
+		// Flip the sort between ascending and descending
+		m.reverseListSort()
 	case "S":
 		// Apply triage recipe - sort by triage score (bv-151)
+		m.ensureStartup(startupRecipes)
 		if r := m.recipeLoader.Get("triage"); r != nil {
-			m.activeRecipe = r
-			m.applyRecipe(r)
+			m.selectRecipe(r)
 		}
+	case "^":
+		// Pin/unpin the selected epic; its progress stays in the footer
+		m.pinSelectedEpic()
+	case "B":
+		// Subscribe to changes of the selected issue
+		m.toggleSubscription()
+	case "u":
+		// Snooze the selected issue until a date, or wake it
+		m.toggleSnoozeSelected()
+	case "x":
+		// Show the snoozed issues (again for all issues)
+		m.toggleSnoozedFilter()
+	case "+":
+		// Star the selected issue, or unstar it
+		m.toggleStar()
+	case "*":
+		// Show the starred issues (again for all issues)
+		m.toggleStarredFilter()
 	}
 	return m
 }
 
+// closeView leaves the full-screen view in front (mobile details, insights,
+// labels, or one of the toggled views) for the issue list, and reports
+// whether there was one. esc and q share it so every view closes alike.
+func (m *Model) closeView() bool {
+	switch {
+	case m.showDetails && !m.isSplitView:
+		m.showDetails = false
+	case m.focused == focusDetail:
+		// Split view: leave the detail pane for the list
+	case m.focused == focusInsights, m.focused == focusLabelDashboard:
+	case m.isGraphView:
+		m.isGraphView = false
+	case m.isBoardView:
+		m.isBoardView = false
+	case m.isActionableView:
+		m.isActionableView = false
+	case m.isHistoryView:
+		m.isHistoryView = false
+	case m.isSprintView:
+		m.isSprintView = false
+		m.showSprintVelocity = false
+	case m.isTimelineView:
+		m.isTimelineView = false
+	case m.isHeatmapView:
+		m.isHeatmapView = false
+	default:
+		return false
+	}
+	m.focused = focusList
+	return true
+}
+
 // handleTimeTravelInputKeys handles keyboard input for the time-travel revision prompt
 func (m Model) handleTimeTravelInputKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -0,0 +1,190 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	defaultSplitRatio = 0.4 // List share of the split view width
+	minSplitRatio     = 0.2
+	maxSplitRatio     = 0.8
+
+	// doubleClickInterval is how close two clicks on the same row must be to open it
+	doubleClickInterval = 400 * time.Millisecond
+)
+
+// layoutSplitView sizes the list and detail panes of the split view from the split ratio
+func (m *Model) layoutSplitView(bodyHeight int) {
+	// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
+	// Total overhead = 8
+	availWidth := m.width - 8
+	if availWidth < 10 {
+		availWidth = 10
+	}
+
+	ratio := m.splitRatio
+	if ratio == 0 {
+		ratio = defaultSplitRatio
+	}
+	listInnerWidth := int(float64(availWidth) * ratio)
+	detailInnerWidth := availWidth - listInnerWidth
+
+	// listHeight fits header (1) + page line (1) inside a panel with Border (2)
+	listHeight := bodyHeight - 4
+	if listHeight < 3 {
+		listHeight = 3
+	}
+
+	m.list.SetSize(listInnerWidth, listHeight)
+	m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
+
+	m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
+}
+
+// hasOverlay reports whether a modal overlay covers the main view, in which
+// case clicks are ignored
+func (m Model) hasOverlay() bool {
+	_, ok := m.activeOverlay()
+	return ok
+}
+
+// isListLayout reports whether the issue list (alone or in the split view) is on screen
+func (m Model) isListLayout() bool {
+	if m.focused == focusInsights || m.focused == focusLabelDashboard {
+		return false
+	}
+	if m.isGraphView || m.isBoardView || m.isActionableView || m.isHistoryView ||
+		m.isSprintView || m.isTimelineView || m.isHeatmapView {
+		return false
+	}
+	return m.isSplitView || !m.showDetails
+}
+
+// splitDividerX returns the screen column of the list panel's right border
+func (m Model) splitDividerX() int {
+	// Left border plus the two columns the panel adds around the list (see layoutSplitView)
+	return m.list.Width() + 3
+}
+
+// listItemAt returns the index of the issue drawn at screen row y, or -1
+func (m Model) listItemAt(y int) int {
+	// Rows above the first item: column header and the list's filter bar,
+	// plus the panel's top border in the split view
+	top := 2
+	if m.isSplitView {
+		top = 3
+	}
+	row := y - top
+	perPage := m.list.Paginator.PerPage
+	if row < 0 || row >= perPage {
+		return -1
+	}
+	idx := m.list.Paginator.Page*perPage + row
+	if idx >= len(m.list.VisibleItems()) {
+		return -1
+	}
+	return idx
+}
+
+// handleMouseClick handles left-button presses, motion, and releases: selecting
+// and opening list rows, focusing board columns, and dragging the split divider
+func (m Model) handleMouseClick(msg tea.MouseMsg) Model {
+	// Finish or continue a divider drag
+	if m.draggingSplit {
+		switch msg.Action {
+		case tea.MouseActionMotion:
+			m.dragSplitTo(msg.X)
+		case tea.MouseActionRelease:
+			m.draggingSplit = false
+		}
+		return m
+	}
+	if msg.Action != tea.MouseActionPress || m.hasOverlay() {
+		return m
+	}
+
+	if m.isBoardView && m.focused == focusBoard {
+		if col := m.board.ColumnAt(msg.X, m.width); col >= 0 {
+			m.board.FocusColumn(col)
+		}
+		return m
+	}
+	if !m.isListLayout() {
+		return m
+	}
+
+	if m.isSplitView {
+		divider := m.splitDividerX()
+		if msg.X >= divider-1 && msg.X <= divider+2 {
+			m.draggingSplit = true
+			return m
+		}
+		if msg.X > divider {
+			m.focused = focusDetail
+			return m
+		}
+	}
+
+	idx := m.listItemAt(msg.Y)
+	if idx < 0 {
+		return m
+	}
+	now := time.Now()
+	doubleClick := idx == m.lastClickIndex && now.Sub(m.lastClickAt) <= doubleClickInterval
+	m.lastClickIndex, m.lastClickAt = idx, now
+
+	m.list.Select(idx)
+	m.focused = focusList
+	if m.isSplitView {
+		m.updateViewportContent()
+		if doubleClick {
+			m.focused = focusDetail
+		}
+	} else if doubleClick {
+		m.showDetails = true
+		m.focused = focusDetail
+		m.updateViewportContent()
+	}
+	if doubleClick {
+		// A third click starts a new double click rather than reopening
+		m.lastClickAt = time.Time{}
+	}
+	return m
+}
+
+// dragSplitTo moves the split divider to screen column x
+func (m *Model) dragSplitTo(x int) {
+	availWidth := m.width - 8
+	if availWidth < 10 {
+		return
+	}
+	ratio := float64(x-3) / float64(availWidth)
+	if ratio < minSplitRatio {
+		ratio = minSplitRatio
+	}
+	if ratio > maxSplitRatio {
+		ratio = maxSplitRatio
+	}
+	m.setSplitRatio(ratio)
+}
+
+// setSplitRatio re-lays out the split view for a new list share, keeping the
+// detail pane's scroll position
+func (m *Model) setSplitRatio(ratio float64) {
+	if ratio == m.splitRatio {
+		return
+	}
+	m.splitRatio = ratio
+
+	bodyHeight := m.height - 1
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+	yOffset := m.viewport.YOffset
+	m.layoutSplitView(bodyHeight)
+	m.updateViewportContent()
+	m.viewport.SetYOffset(yOffset)
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func mouseTestModel(t *testing.T, width int) Model {
+	t.Helper()
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "ms-1", Title: "First", Status: model.StatusOpen, Priority: 1, CreatedAt: now},
+		{ID: "ms-2", Title: "Second", Status: model.StatusOpen, Priority: 2, CreatedAt: now},
+		{ID: "ms-3", Title: "Third", Status: model.StatusInProgress, Priority: 2, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: width, Height: 30})
+	return updated.(Model)
+}
+
+// rowOf returns the screen row on which text is drawn
+func rowOf(t *testing.T, m Model, text string) int {
+	t.Helper()
+	for y, line := range strings.Split(m.View(), "\n") {
+		if strings.Contains(line, text) {
+			return y
+		}
+	}
+	t.Fatalf("%q not on screen", text)
+	return -1
+}
+
+func click(m Model, x, y int) Model {
+	updated, _ := m.Update(tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+	return updated.(Model)
+}
+
+func selectedID(m Model) string {
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		return item.Issue.ID
+	}
+	return ""
+}
+
+func TestMouse_ClickSelectsAndDoubleClickOpens(t *testing.T) {
+	m := mouseTestModel(t, 90)
+	y := rowOf(t, m, "ms-3")
+
+	m = click(m, 10, y)
+	if selectedID(m) != "ms-3" || m.showDetails {
+		t.Fatalf("Click should select ms-3 without opening it, got %q (details=%v)", selectedID(m), m.showDetails)
+	}
+	m = click(m, 10, y)
+	if !m.showDetails || m.focused != focusDetail {
+		t.Error("Double click should open the detail view")
+	}
+
+	// Clicks on the header select nothing
+	m = mouseTestModel(t, 90)
+	m = click(m, 10, 0)
+	if selectedID(m) != "ms-1" {
+		t.Errorf("Header click changed selection to %q", selectedID(m))
+	}
+}
+
+func TestMouse_SplitViewClickAndDrag(t *testing.T) {
+	m := mouseTestModel(t, 160)
+	if !m.isSplitView {
+		t.Fatal("Expected split view")
+	}
+	y := rowOf(t, m, "ms-2")
+	m = click(m, 5, y)
+	if selectedID(m) != "ms-2" || m.focused != focusList {
+		t.Fatalf("Click should select ms-2, got %q", selectedID(m))
+	}
+	if !strings.Contains(m.viewport.View(), "Second") {
+		t.Error("Detail pane should follow the clicked row")
+	}
+
+	// Click in the detail pane focuses it
+	m = click(m, 140, 10)
+	if m.focused != focusDetail {
+		t.Error("Click in the detail pane should focus it")
+	}
+
+	// Drag the divider right, then release
+	before := m.list.Width()
+	m = click(m, m.splitDividerX(), 10)
+	if !m.draggingSplit {
+		t.Fatal("Press on the divider should start a drag")
+	}
+	updated, _ := m.Update(tea.MouseMsg{X: 100, Y: 10, Button: tea.MouseButtonLeft, Action: tea.MouseActionMotion})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.MouseMsg{X: 100, Y: 10, Button: tea.MouseButtonNone, Action: tea.MouseActionRelease})
+	m = updated.(Model)
+	if m.draggingSplit || m.list.Width() <= before || m.splitDividerX() != 100 {
+		t.Errorf("Drag should move the divider to 100, got list width %d (was %d), divider %d", m.list.Width(), before, m.splitDividerX())
+	}
+
+	// Ratio is clamped
+	m = click(m, m.splitDividerX(), 10)
+	updated, _ = m.Update(tea.MouseMsg{X: 2, Y: 10, Button: tea.MouseButtonLeft, Action: tea.MouseActionMotion})
+	m = updated.(Model)
+	if m.splitRatio != minSplitRatio {
+		t.Errorf("Expected ratio clamped to %v, got %v", minSplitRatio, m.splitRatio)
+	}
+
+	// The ratio survives a resize
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+	m = updated.(Model)
+	if m.splitRatio != minSplitRatio {
+		t.Error("Resize should keep the dragged ratio")
+	}
+}
+
+func TestMouse_BoardColumnFocus(t *testing.T) {
+	m := mouseTestModel(t, 120)
+	m.isBoardView = true
+	m.focused = focusBoard
+
+	colWidth := m.board.columnWidth(m.width)
+	m = click(m, colWidth+5, 10)
+	if issue := m.board.SelectedIssue(); issue == nil || issue.ID != "ms-3" {
+		t.Errorf("Click on the second column should focus In Progress, got %+v", issue)
+	}
+	m = click(m, 1, 10)
+	if issue := m.board.SelectedIssue(); issue == nil || issue.Status != model.StatusOpen {
+		t.Errorf("Click on the first column should focus Open, got %+v", issue)
+	}
+}
+
+func TestMouse_IgnoredUnderOverlay(t *testing.T) {
+	m := mouseTestModel(t, 90)
+	y := rowOf(t, m, "ms-3")
+	m.showHelp = true
+	m.focused = focusHelp
+	m = click(m, 10, y)
+	if selectedID(m) != "ms-1" {
+		t.Error("Clicks should not reach the list under an overlay")
+	}
+}
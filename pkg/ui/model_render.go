@@ -3,6 +3,9 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/lipgloss"
@@ -59,12 +62,8 @@ func (m Model) renderListWithHeader() string {
 		Bold(true).
 		Width(m.width - 2)
 
-	headerText := "  TYPE PRI STATUS      ID                                   TITLE"
-	if m.workspaceMode {
-		// Account for repo badges like [API] shown in workspace mode.
-		headerText = "  REPO TYPE PRI STATUS      ID                               TITLE"
-	}
-	header := headerStyle.Render(headerText)
+	// Column titles line up with the rows: both come from m.listColumns
+	header := headerStyle.Render(m.listHeader(m.width - 2))
 
 	// Page info
 	totalItems := len(m.list.Items())
@@ -100,7 +99,7 @@ func (m Model) renderListWithHeader() string {
 	)
 
 	// List view - just render it normally since bubbles handles scrolling
-	listView := m.list.View()
+	listView := m.listBody(m.width)
 
 	// Page indicator line
 	pageLine := pageStyle.Render(pageInfo)
@@ -148,7 +147,7 @@ func (m Model) renderSplitView() string {
 		Bold(true).
 		Width(listInnerWidth)
 
-	header := headerStyle.Render("  TYPE PRI STATUS      ID                     TITLE")
+	header := headerStyle.Render(m.listHeader(listInnerWidth))
 
 	// Page info for list
 	totalItems := len(m.list.Items())
@@ -184,7 +183,7 @@ func (m Model) renderSplitView() string {
 	pageLine := pageStyle.Render(pageInfo)
 
 	// Combine header + list + page indicator
-	listContent := lipgloss.JoinVertical(lipgloss.Left, header, m.list.View(), pageLine)
+	listContent := lipgloss.JoinVertical(lipgloss.Left, header, m.listBody(listInnerWidth), pageLine)
 
 	// List Panel Width: Inner + 2 (Padding). Border adds another 2.
 	// Use MaxHeight to ensure content doesn't overflow
@@ -220,7 +219,7 @@ func (m *Model) renderHelpOverlay() string {
 	keyStyle := t.Renderer.NewStyle().
 		Foreground(lipgloss.AdaptiveColor{Light: "#7D56F4", Dark: "#BD93F9"}).
 		Bold(true).
-		Width(12)
+		Width(16)
 
 	descStyle := t.Renderer.NewStyle().
 		Foreground(t.Base.GetForeground())
@@ -230,120 +229,24 @@ func (m *Model) renderHelpOverlay() string {
 	sb.WriteString(titleStyle.Render("⌨️  Keyboard Shortcuts"))
 	sb.WriteString("\n\n")
 
-	// Navigation
-	sb.WriteString(sectionStyle.Render("Navigation"))
-	sb.WriteString("\n")
-	shortcuts := []struct{ key, desc string }{
-		{"j / ↓", "Move down"},
-		{"k / ↑", "Move up"},
-		{"home", "Go to first item"},
-		{"G / end", "Go to last item"},
-		{"Ctrl+d", "Page down"},
-		{"Ctrl+u", "Page up"},
-		{"Tab", "Switch focus (split view)"},
-		{"Enter", "View details"},
-		{"Esc", "Back / close"},
-	}
-	for _, s := range shortcuts {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// Views
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("Views"))
-	sb.WriteString("\n")
-	views := []struct{ key, desc string }{
-		{"a", "Toggle Actionable view"},
-		{"b", "Toggle Kanban board"},
-		{"g", "Toggle Graph view"},
-		{"H", "Toggle History view"},
-		{"i", "Toggle Insights dashboard"},
-		{"P", "Toggle Sprint dashboard"},
-		{"R", "Open Recipe picker"},
-		{"w", "Repo filter (workspace mode)"},
-		{"?", "Toggle this help"},
-	}
-	for _, s := range views {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// Graph view keys
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("Graph View"))
-	sb.WriteString("\n")
-	graphKeys := []struct{ key, desc string }{
-		{"hjkl", "Navigate nodes"},
-		{"H/L", "Scroll canvas left/right"},
-		{"PgUp/PgDn", "Scroll canvas up/down"},
-		{"Enter", "Jump to selected issue"},
-	}
-	for _, s := range graphKeys {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// Insights (when in insights view)
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("Insights Panel"))
-	sb.WriteString("\n")
-	insightsKeys := []struct{ key, desc string }{
-		{"h/l/Tab", "Switch metric panels"},
-		{"j/k", "Navigate items"},
-		{"e", "Toggle explanations"},
-		{"x", "Toggle calculation details"},
-		{"Enter", "Jump to issue"},
-	}
-	for _, s := range insightsKeys {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// History View keys
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("History View"))
-	sb.WriteString("\n")
-	historyKeys := []struct{ key, desc string }{
-		{"j/k", "Navigate bead list"},
-		{"J/K", "Navigate commits in bead"},
-		{"Tab", "Toggle list/detail focus"},
-		{"Enter", "Jump to selected bead"},
-		{"y", "Copy commit SHA"},
-		{"c", "Cycle confidence filter"},
-		{"H/Esc", "Close history view"},
-	}
-	for _, s := range historyKeys {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// Filters
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("Filters"))
-	sb.WriteString("\n")
-	filters := []struct{ key, desc string }{
-		{"o", "Show Open issues"},
-		{"c", "Show Closed issues"},
-		{"r", "Show Ready (unblocked)"},
-		{"a", "Show All issues"},
-		{"/", "Fuzzy search"},
-		{"Ctrl+S", "Toggle semantic search mode"},
-	}
-	for _, s := range filters {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
-	}
-
-	// General
-	sb.WriteString("\n")
-	sb.WriteString(sectionStyle.Render("General"))
-	sb.WriteString("\n")
-	general := []struct{ key, desc string }{
-		{"t", "Time-travel (custom revision)"},
-		{"T", "Time-travel (HEAD~5)"},
-		{"E", "Export to Markdown"},
-		{"C", "Copy issue to clipboard"},
-		{"O", "Open in editor"},
-		{"q", "Back / Quit"},
-		{"Ctrl+c", "Force quit"},
-	}
-	for _, s := range general {
-		sb.WriteString(keyStyle.Render(s.key) + descStyle.Render(s.desc) + "\n")
+	// Sections are generated from the keymap, so remapped keys show up here
+	keymap := m.keymap
+	if keymap == nil {
+		keymap = DefaultKeymap()
+	}
+	for i, section := range keymap.HelpSections() {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(sectionStyle.Render(section.Title))
+		sb.WriteString("\n")
+		for _, e := range section.Entries {
+			sb.WriteString(keyStyle.Render(e.Keys) + descStyle.Render(e.Desc) + "\n")
+		}
+	}
+	sb.WriteString(keyStyle.Render("Ctrl+c") + descStyle.Render("Force quit") + "\n")
+	if len(keymap.custom) > 0 {
+		sb.WriteString("\n" + descStyle.Render("Custom bindings from .bv/"+KeymapFilename) + "\n")
 	}
 
 	// Build full content (without footer yet)
@@ -462,7 +365,7 @@ func (m *Model) renderFooter() string {
 	var filterTxt string
 	var filterIcon string
 	if m.focused == focusLabelDashboard {
-		filterTxt = "LABELS: j/k nav • h detail • d drilldown • enter filter"
+		filterTxt = "LABELS: j/k nav • h detail • d drilldown • ^ pin • C copy • enter filter"
 		filterIcon = "🏷️"
 	} else if m.showLabelGraphAnalysis && m.labelGraphAnalysisResult != nil {
 		filterTxt = fmt.Sprintf("GRAPH %s: esc/q/g close", m.labelGraphAnalysisResult.Label)
@@ -470,6 +373,10 @@ func (m *Model) renderFooter() string {
 	} else if m.showLabelDrilldown && m.labelDrilldownLabel != "" {
 		filterTxt = fmt.Sprintf("LABEL %s: enter filter • g graph • esc/q/d close", m.labelDrilldownLabel)
 		filterIcon = "🏷️"
+	} else if crumbs := m.filterCrumbs(); len(crumbs) > 1 {
+		// Compound filters: show each constraint, oldest first
+		filterTxt = truncateRunesHelper(m.filterBreadcrumb(), 60, "…")
+		filterIcon = "🧭"
 	} else {
 		switch m.currentFilter {
 		case "all":
@@ -484,10 +391,19 @@ func (m *Model) renderFooter() string {
 		case "ready":
 			filterTxt = "READY"
 			filterIcon = "🚀"
+		case "snoozed":
+			filterTxt = "SNOOZED"
+			filterIcon = "💤"
+		case "starred":
+			filterTxt = "STARRED"
+			filterIcon = "★"
 		default:
 			if strings.HasPrefix(m.currentFilter, "recipe:") {
 				filterTxt = strings.ToUpper(m.currentFilter[7:])
 				filterIcon = "📑"
+			} else if expr, ok := strings.CutPrefix(m.currentFilter, "query:"); ok {
+				filterTxt = truncateRunesHelper(expr, 40, "…")
+				filterIcon = "🔎"
 			} else {
 				filterTxt = m.currentFilter
 				filterIcon = "🔍"
@@ -569,13 +485,10 @@ func (m *Model) renderFooter() string {
 	// ALERTS BADGE - Project health alerts (bv-168)
 	// ─────────────────────────────────────────────────────────────────────────
 	alertsSection := ""
-	// Count active (non-dismissed) alerts - use simple counters from model
-	activeAlerts := len(m.alerts) - len(m.dismissedAlerts)
-	if activeAlerts < 0 {
-		activeAlerts = 0
-	}
-	activeCritical := m.alertsCritical
-	activeWarning := m.alertsWarning
+	// Count active (non-dismissed) alerts
+	active := m.activeAlerts()
+	activeAlerts := len(active)
+	activeCritical, activeWarning, _ := countAlertSeverities(active)
 	if activeAlerts > 0 {
 		var alertStyle lipgloss.Style
 		var alertIcon string
@@ -616,6 +529,19 @@ func (m *Model) renderFooter() string {
 		workspaceSection = workspaceStyle.Render(fmt.Sprintf("📦 %s", m.workspaceSummary))
 	}
 
+	// ─────────────────────────────────────────────────────────────────────────
+	// READ-ONLY BADGE - Started with --read-only
+	// ─────────────────────────────────────────────────────────────────────────
+	readOnlySection := ""
+	if readonly.Enabled() {
+		readOnlySection = lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorWarning).
+			Bold(true).
+			Padding(0, 1).
+			Render("🔒 read-only")
+	}
+
 	// ─────────────────────────────────────────────────────────────────────────
 	// REPO FILTER BADGE - Active repo selection (workspace mode)
 	// ─────────────────────────────────────────────────────────────────────────
@@ -631,6 +557,88 @@ func (m *Model) renderFooter() string {
 		repoFilterSection = repoStyle.Render(fmt.Sprintf("🗂 %s", label))
 	}
 
+	// ─────────────────────────────────────────────────────────────────────────
+	// PIN BADGE - Live progress of the pinned epic/label
+	// ─────────────────────────────────────────────────────────────────────────
+	pinSection := ""
+	if !m.pin.IsEmpty() {
+		pinStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorSuccess).
+			Bold(true).
+			Padding(0, 1)
+		pinSection = pinStyle.Render(fmt.Sprintf("📌 %s %d/%d",
+			truncateRunesHelper(m.pin.Name(), 24, "…"), m.pinProgress.Closed, m.pinProgress.Total))
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// SORT BADGE - The active sort, when not the default order
+	// ─────────────────────────────────────────────────────────────────────────
+	sortSection := ""
+	if label := m.sortLabel(); label != "" {
+		sortStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Padding(0, 1)
+		sortSection = sortStyle.Render("⇅ " + label)
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// MARKED BADGE - Multi-select count for bulk export/copy
+	// ─────────────────────────────────────────────────────────────────────────
+	markedSection := ""
+	if n := m.markedCount(); n > 0 {
+		markedStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Bold(true).
+			Padding(0, 1)
+		markedTxt := fmt.Sprintf("☑ %d marked", n)
+		if m.markedOnly {
+			markedTxt += " (only)"
+		}
+		markedSection = markedStyle.Render(markedTxt)
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// CHANGES BADGE - Reload changes to watched issues not yet reviewed
+	// ─────────────────────────────────────────────────────────────────────────
+	changesSection := ""
+	if m.unseenChanges > 0 {
+		changesStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorWarning).
+			Bold(true).
+			Padding(0, 1)
+		changesSection = changesStyle.Render(fmt.Sprintf("🔔 %d changed", m.unseenChanges))
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// PROGRESS - Background operations in flight (esc cancels)
+	// ─────────────────────────────────────────────────────────────────────────
+	progressSection := ""
+	if progress := renderOpProgress(m.ops); progress != "" {
+		progressStyle := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Padding(0, 1)
+		progressSection = progressStyle.Render("⏳ " + progress + " (esc cancels)")
+	}
+
+	// ─────────────────────────────────────────────────────────────────────────
+	// WORK SESSION BADGE - Elapsed time of the running focus session
+	// ─────────────────────────────────────────────────────────────────────────
+	sessionSection := ""
+	if active := m.activeWorkSession(); active != nil {
+		sessionStyle := lipgloss.NewStyle().
+			Background(ColorPrioHighBg).
+			Foreground(ColorWarning).
+			Bold(true).
+			Padding(0, 1)
+		sessionSection = sessionStyle.Render(fmt.Sprintf("⏱ %s %s",
+			truncateRunesHelper(active.IssueID, 16, "…"), formatElapsed(active.Duration(time.Now()))))
+	}
+
 	// ─────────────────────────────────────────────────────────────────────────
 	// KEYBOARD HINTS - Context-aware navigation help
 	// ─────────────────────────────────────────────────────────────────────────
@@ -646,19 +654,45 @@ func (m *Model) renderFooter() string {
 		keyHints = append(keyHints, "Press any key to close")
 	} else if m.showRecipePicker {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+	} else if m.showThemePicker {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" preview", keyStyle.Render("⏎")+" keep", keyStyle.Render("esc")+" cancel")
+	} else if m.showExportScopePicker {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("1-9/⏎")+" export", keyStyle.Render("esc")+" cancel")
+	} else if m.showColumnEditor {
+		keyHints = append(keyHints, keyStyle.Render("space")+" show", keyStyle.Render("J/K")+" move", keyStyle.Render("+/-")+" width", keyStyle.Render("⏎")+" save", keyStyle.Render("esc")+" cancel")
+	} else if m.showAsk {
+		if m.askInput.Focused() {
+			keyHints = append(keyHints, keyStyle.Render("⏎")+" ask", keyStyle.Render("esc")+" close")
+		} else {
+			keyHints = append(keyHints, keyStyle.Render("j/k")+" citation", keyStyle.Render("⏎")+" open", keyStyle.Render("/")+" ask again", keyStyle.Render("esc")+" close")
+		}
+	} else if m.showBaselines {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" compare", keyStyle.Render("s")+" snapshot", keyStyle.Render("u")+" make active", keyStyle.Render("esc")+" close")
+	} else if m.showGoto && m.gotoGraph {
+		keyHints = append(keyHints, keyStyle.Render("tab")+" complete", keyStyle.Render("⏎")+" select", keyStyle.Render("esc")+" cancel")
+	} else if m.showGoto {
+		keyHints = append(keyHints, keyStyle.Render("tab")+" complete", keyStyle.Render("⏎")+" open", keyStyle.Render("esc")+" cancel")
+	} else if m.showJumpList {
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("1-9/⏎")+" jump", keyStyle.Render("esc")+" close")
 	} else if m.showRepoPicker {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("space")+" toggle", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
 	} else if m.showLabelPicker {
 		keyHints = append(keyHints, "type to filter", keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
 	} else if m.focused == focusInsights {
 		keyHints = append(keyHints, keyStyle.Render("h/l")+" panels", keyStyle.Render("e")+" explain", keyStyle.Render("⏎")+" jump", keyStyle.Render("?")+" help")
-		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow")
+		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow", keyStyle.Render("r")+" re-score", keyStyle.Render("C")+" copy picks")
 	} else if m.isGraphView {
-		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("H/L")+" scroll", keyStyle.Render("⏎")+" view", keyStyle.Render("g")+" list")
+		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("f")+" focus", keyStyle.Render("x/e")+" closed/links", keyStyle.Render("o")+" layout", keyStyle.Render("/")+" jump", keyStyle.Render("⏎")+" view", keyStyle.Render("g")+" list")
+	} else if m.isBoardView && m.board.Moving() {
+		keyHints = append(keyHints, keyStyle.Render("h/l")+" column", keyStyle.Render("⏎")+" drop", keyStyle.Render("esc")+" cancel")
 	} else if m.isBoardView {
-		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("G")+" bottom", keyStyle.Render("⏎")+" view", keyStyle.Render("b")+" list")
+		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("space")+" move", keyStyle.Render("s")+" lanes")
+		if m.board.Lanes() != LanesNone {
+			keyHints = append(keyHints, keyStyle.Render("J/K")+" lane")
+		}
+		keyHints = append(keyHints, keyStyle.Render("⏎")+" view", keyStyle.Render("b")+" list")
 	} else if m.isActionableView {
-		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" view", keyStyle.Render("a")+" list", keyStyle.Render("?")+" help")
+		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" view", keyStyle.Render("C")+" copy", keyStyle.Render("a")+" list", keyStyle.Render("?")+" help")
 	} else if m.isHistoryView {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("tab")+" focus", keyStyle.Render("⏎")+" jump", keyStyle.Render("H")+" close")
 	} else if m.list.FilterState() == list.Filtering {
@@ -672,18 +706,33 @@ func (m *Model) renderFooter() string {
 		keyHints = append(keyHints, keyStyle.Render("esc")+" cancel", keyStyle.Render("ctrl+s")+" "+mode, keyStyle.Render("⏎")+" select")
 	} else if m.showTimeTravelPrompt {
 		keyHints = append(keyHints, keyStyle.Render("⏎")+" compare", keyStyle.Render("esc")+" cancel")
+	} else if m.showCommentEditor {
+		keyHints = append(keyHints, keyStyle.Render("ctrl+s")+" save", keyStyle.Render("⏎")+" new line", keyStyle.Render("esc")+" cancel")
+	} else if m.showSnoozePrompt {
+		keyHints = append(keyHints, keyStyle.Render("⏎")+" snooze", keyStyle.Render("esc")+" cancel")
+	} else if m.showTriageSession && m.triageSessionField != "" {
+		keyHints = append(keyHints, keyStyle.Render("tab")+" complete", keyStyle.Render("⏎")+" save", keyStyle.Render("esc")+" cancel")
+	} else if m.showTriageSession {
+		keyHints = append(keyHints, keyStyle.Render("0-4")+" priority", keyStyle.Render("l")+" label", keyStyle.Render("a")+" assign", keyStyle.Render("s")+" snooze", keyStyle.Render("n/p")+" next/back", keyStyle.Render("esc")+" end")
 	} else {
 		if m.timeTravelMode {
 			keyHints = append(keyHints, keyStyle.Render("t")+" exit diff", keyStyle.Render("C")+" copy", keyStyle.Render("abgi")+" views", keyStyle.Render("?")+" help")
 		} else if m.isSplitView {
 			keyHints = append(keyHints, keyStyle.Render("tab")+" focus", keyStyle.Render("C")+" copy", keyStyle.Render("E")+" export", keyStyle.Render("?")+" help")
 		} else if m.showDetails {
-			keyHints = append(keyHints, keyStyle.Render("esc")+" back", keyStyle.Render("C")+" copy", keyStyle.Render("O")+" edit", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, keyStyle.Render("esc")+" back", keyStyle.Render("m")+" comment", keyStyle.Render("C")+" copy", keyStyle.Render("O")+" edit", keyStyle.Render("?")+" help")
 		} else {
-			keyHints = append(keyHints, keyStyle.Render("⏎")+" details", keyStyle.Render("t")+" diff", keyStyle.Render("S")+" triage", keyStyle.Render("l")+" labels", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, keyStyle.Render("⏎")+" details")
+			if m.gitErr == nil {
+				keyHints = append(keyHints, keyStyle.Render("t")+" diff")
+			}
+			keyHints = append(keyHints, keyStyle.Render("s")+" sort", keyStyle.Render("S")+" triage", keyStyle.Render("l")+" labels", keyStyle.Render("?")+" help")
 			if m.workspaceMode {
 				keyHints = append(keyHints, keyStyle.Render("w")+" repos")
 			}
+			if len(m.filterStack) > 0 {
+				keyHints = append(keyHints, keyStyle.Render("⌫")+" pop filter")
+			}
 		}
 	}
 
@@ -710,9 +759,30 @@ func (m *Model) renderFooter() string {
 	if workspaceSection != "" {
 		leftWidth += lipgloss.Width(workspaceSection) + 1
 	}
+	if readOnlySection != "" {
+		leftWidth += lipgloss.Width(readOnlySection) + 1
+	}
 	if repoFilterSection != "" {
 		leftWidth += lipgloss.Width(repoFilterSection) + 1
 	}
+	if pinSection != "" {
+		leftWidth += lipgloss.Width(pinSection) + 1
+	}
+	if sortSection != "" {
+		leftWidth += lipgloss.Width(sortSection) + 1
+	}
+	if markedSection != "" {
+		leftWidth += lipgloss.Width(markedSection) + 1
+	}
+	if changesSection != "" {
+		leftWidth += lipgloss.Width(changesSection) + 1
+	}
+	if progressSection != "" {
+		leftWidth += lipgloss.Width(progressSection) + 1
+	}
+	if sessionSection != "" {
+		leftWidth += lipgloss.Width(sessionSection) + 1
+	}
 	if updateSection != "" {
 		leftWidth += lipgloss.Width(updateSection) + 1
 	}
@@ -733,9 +803,30 @@ func (m *Model) renderFooter() string {
 	if workspaceSection != "" {
 		parts = append(parts, workspaceSection)
 	}
+	if readOnlySection != "" {
+		parts = append(parts, readOnlySection)
+	}
 	if repoFilterSection != "" {
 		parts = append(parts, repoFilterSection)
 	}
+	if pinSection != "" {
+		parts = append(parts, pinSection)
+	}
+	if sortSection != "" {
+		parts = append(parts, sortSection)
+	}
+	if markedSection != "" {
+		parts = append(parts, markedSection)
+	}
+	if changesSection != "" {
+		parts = append(parts, changesSection)
+	}
+	if progressSection != "" {
+		parts = append(parts, progressSection)
+	}
+	if sessionSection != "" {
+		parts = append(parts, sessionSection)
+	}
 	if updateSection != "" {
 		parts = append(parts, updateSection)
 	}
@@ -2,7 +2,6 @@ package ui
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
@@ -104,8 +103,8 @@ func (m *Model) renderBeadHistoryMD(beadID string) string {
 
 // enterHistoryView loads correlation data and shows the history view
 func (m *Model) enterHistoryView() {
-	cwd, err := os.Getwd()
-	if err != nil {
+	repoPath := projectDirFromBeadsPath(m.beadsPath)
+	if repoPath == "" {
 		m.statusMsg = "Cannot get working directory for history"
 		m.statusIsError = true
 		return
@@ -122,7 +121,7 @@ func (m *Model) enterHistoryView() {
 	}
 
 	// Load correlation data
-	correlator := correlation.NewCorrelator(cwd, m.beadsPath)
+	correlator := correlation.NewCorrelator(repoPath, m.beadsPath)
 	opts := correlation.CorrelatorOptions{
 		Limit: 500, // Reasonable limit for TUI performance
 	}
@@ -1,24 +1,43 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// enterTimeTravelMode loads historical data and computes diff
+// blockNoGit says in the status bar that feature needs git when git isn't
+// usable here, and reports whether it did
+func (m *Model) blockNoGit(feature string) bool {
+	switch {
+	case m.gitErr == nil:
+		return false
+	case errors.Is(m.gitErr, loader.ErrGitNotInstalled):
+		m.statusMsg = fmt.Sprintf("❌ %s unavailable: git is not installed", feature)
+	default:
+		m.statusMsg = fmt.Sprintf("❌ %s unavailable: %s isn't in a git repository", feature, projectDirFromBeadsPath(m.beadsPath))
+	}
+	m.statusIsError = true
+	return true
+}
+
+// enterTimeTravelMode loads historical data and computes diff. A range
+// (v1.0.0..v1.1.0) compares two revisions with each other instead of one
+// with the working tree.
 func (m *Model) enterTimeTravelMode(revision string) {
-	cwd, err := os.Getwd()
-	if err != nil {
+	repoPath := projectDirFromBeadsPath(m.beadsPath)
+	if repoPath == "" {
 		m.statusMsg = "❌ Time-travel failed: cannot get working directory"
 		m.statusIsError = true
 		return
 	}
 
-	gitLoader := loader.NewGitLoader(cwd)
+	gitLoader := loader.NewGitLoader(repoPath)
 
 	// Check if we're in a git repo first
 	if _, err := gitLoader.ResolveRevision("HEAD"); err != nil {
@@ -27,25 +46,36 @@ func (m *Model) enterTimeTravelMode(revision string) {
 		return
 	}
 
-	// Check if beads files exist at the revision
-	hasBeads, err := gitLoader.HasBeadsAtRevision(revision)
-	if err != nil || !hasBeads {
-		m.statusMsg = fmt.Sprintf("❌ No beads history at %s (try fewer commits back)", revision)
+	fromRev, toRev, isRange := strings.Cut(revision, "..")
+	fromRev, toRev = strings.TrimSpace(fromRev), strings.TrimSpace(toRev)
+	if isRange && (fromRev == "" || toRev == "") {
+		m.statusMsg = fmt.Sprintf("❌ Time-travel range %q needs a revision on both sides of ..", revision)
+		m.statusIsError = true
+		return
+	}
+	// a...b would otherwise read as a..(.b); only two-dot ranges are supported
+	if isRange && (strings.HasPrefix(toRev, ".") || strings.Contains(toRev, "..")) {
+		m.statusMsg = fmt.Sprintf("❌ Time-travel range %q: use exactly two dots, as in v1.0.0..v1.1.0", revision)
 		m.statusIsError = true
 		return
 	}
 
 	// Load historical issues
-	historicalIssues, err := gitLoader.LoadAt(revision)
-	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Time-travel failed: %v", err)
-		m.statusIsError = true
+	historicalIssues, ok := m.loadTimeTravelRevision(gitLoader, fromRev)
+	if !ok {
 		return
 	}
+	currentIssues := m.issues
+	if isRange {
+		if currentIssues, ok = m.loadTimeTravelRevision(gitLoader, toRev); !ok {
+			return
+		}
+		revision = fromRev + ".." + toRev
+	}
 
 	// Create snapshots and compute diff
 	fromSnapshot := analysis.NewSnapshot(historicalIssues)
-	toSnapshot := analysis.NewSnapshot(m.issues)
+	toSnapshot := analysis.NewSnapshot(currentIssues)
 	diff := analysis.CompareSnapshots(fromSnapshot, toSnapshot)
 
 	// Build lookup sets for badges
@@ -69,14 +99,37 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	m.timeTravelSince = revision
 
 	// Success feedback
-	m.statusMsg = fmt.Sprintf("⏱️ Time-travel: comparing with %s (+%d ✅%d ~%d)",
-		revision, diff.Summary.IssuesAdded, diff.Summary.IssuesClosed, diff.Summary.IssuesModified)
+	target := "comparing with " + revision
+	if isRange {
+		target = fmt.Sprintf("%s → %s", fromRev, toRev)
+	}
+	m.statusMsg = fmt.Sprintf("⏱️ Time-travel: %s (+%d ✅%d ~%d)",
+		target, diff.Summary.IssuesAdded, diff.Summary.IssuesClosed, diff.Summary.IssuesModified)
 	m.statusIsError = false
 
 	// Rebuild list items with diff info
 	m.rebuildListWithDiffInfo()
 }
 
+// loadTimeTravelRevision loads the issues at revision, reporting any failure
+// in the status bar
+func (m *Model) loadTimeTravelRevision(gitLoader *loader.GitLoader, revision string) ([]model.Issue, bool) {
+	// Check if beads files exist at the revision
+	hasBeads, err := gitLoader.HasBeadsAtRevision(revision)
+	if err != nil || !hasBeads {
+		m.statusMsg = fmt.Sprintf("❌ No beads history at %s (try fewer commits back)", revision)
+		m.statusIsError = true
+		return nil, false
+	}
+	issues, err := gitLoader.LoadAt(revision)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Time-travel failed: %v", err)
+		m.statusIsError = true
+		return nil, false
+	}
+	return issues, true
+}
+
 // exitTimeTravelMode clears time-travel state
 func (m *Model) exitTimeTravelMode() {
 	m.timeTravelMode = false
@@ -160,9 +213,9 @@ func (m Model) renderTimeTravelPrompt() string {
 
 	// Build content
 	content := titleStyle.Render("⏱️  Time-Travel Mode") + "\n\n" +
-		subtitleStyle.Render("Compare current state with a historical revision") + "\n\n" +
+		subtitleStyle.Render("Compare current state with a historical revision, or two revisions") + "\n\n" +
 		m.timeTravelInput.View() + "\n\n" +
-		exampleStyle.Render("Examples: HEAD~5, main, v1.0.0, 2024-01-01, abc123") + "\n\n" +
+		exampleStyle.Render("Examples: HEAD~5, main, v1.0.0, 2024-01-01, abc123, v1.0.0..v1.1.0") + "\n\n" +
 		textStyle.Render("Press ") + keyStyle.Render("Enter") + textStyle.Render(" to compare, ") +
 		keyStyle.Render("Esc") + textStyle.Render(" to cancel")
 
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNoGit_HistoryAndTimeTravelDisabled(t *testing.T) {
+	t.Setenv("GIT_DIR", "")
+	_, beadsPath := stateTestBeadsPath(t)
+	issues := []model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, beadsPath)
+	if !errors.Is(m.gitErr, loader.ErrNotGitRepo) {
+		t.Fatalf("Expected a plain directory to disable git features, got %v", m.gitErr)
+	}
+	if m.historyLoading {
+		t.Error("Expected no background history load without git")
+	}
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	updated, _ = m.Update(keyMsgFromString("T"))
+	m = updated.(Model)
+	if m.timeTravelMode || !strings.Contains(m.statusMsg, "Time-travel unavailable") {
+		t.Errorf("Expected T to explain that time-travel needs git, got %q", m.statusMsg)
+	}
+	updated, _ = m.Update(keyMsgFromString("t"))
+	m = updated.(Model)
+	if m.showTimeTravelPrompt {
+		t.Error("Expected t not to open the revision prompt without git")
+	}
+	updated, _ = m.Update(keyMsgFromString("H"))
+	m = updated.(Model)
+	if m.isHistoryView || !strings.Contains(m.statusMsg, "isn't in a git repository") {
+		t.Errorf("Expected H to explain that history needs git, got %q", m.statusMsg)
+	}
+
+	// The rest of the app keeps working
+	updated, _ = m.Update(keyMsgFromString("enter"))
+	m = updated.(Model)
+	if !m.showDetails && !m.isSplitView {
+		t.Error("Expected enter to open details on a plain directory")
+	}
+}
+
+func TestNoGit_MissingBinary(t *testing.T) {
+	t.Setenv("PATH", "")
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(keyMsgFromString("H"))
+	m = updated.(Model)
+	if !strings.Contains(m.statusMsg, "git is not installed") {
+		t.Errorf("Expected H to say git is missing, got %q", m.statusMsg)
+	}
+}
+
+func TestTimeTravel_RevisionRange(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(content, tag string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", ".")
+		git("commit", "-q", "-m", tag)
+		git("tag", tag)
+	}
+	git("init", "-q")
+	git("config", "user.email", "test@test.com")
+	git("config", "user.name", "Test User")
+	commit(`{"id":"A","title":"A","status":"open","issue_type":"task"}`+"\n", "v1")
+	commit(`{"id":"A","title":"A","status":"closed","issue_type":"task"}`+"\n"+
+		`{"id":"B","title":"B","status":"open","issue_type":"task"}`+"\n", "v2")
+
+	// The working tree has moved on: C is new since v2 and B was retitled
+	current := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusClosed, IssueType: model.TypeTask},
+		{ID: "B", Title: "B renamed", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "C", Title: "C", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	m := NewModel(current, nil, beadsPath)
+	m.enterTimeTravelMode("v1..v2")
+	if !m.timeTravelMode || m.statusIsError {
+		t.Fatalf("Expected v1..v2 to enter time-travel, got %q", m.statusMsg)
+	}
+	if m.timeTravelSince != "v1..v2" || !strings.Contains(m.statusMsg, "v1 → v2") {
+		t.Errorf("Expected the range in the status, got since=%q status=%q", m.timeTravelSince, m.statusMsg)
+	}
+	if m.getDiffStatus("A") != DiffStatusClosed || m.getDiffStatus("B") != DiffStatusNew {
+		t.Errorf("Expected A closed and B new between v1 and v2, got %v %v", m.getDiffStatus("A"), m.getDiffStatus("B"))
+	}
+	if m.getDiffStatus("C") != DiffStatusNone {
+		t.Error("Expected the working tree to play no part in a range comparison")
+	}
+
+	m.exitTimeTravelMode()
+	m.enterTimeTravelMode("v2")
+	if m.getDiffStatus("C") != DiffStatusNew || m.getDiffStatus("B") != DiffStatusModified {
+		t.Errorf("Expected a single revision to compare with the working tree, got C=%v B=%v", m.getDiffStatus("C"), m.getDiffStatus("B"))
+	}
+
+	for _, tt := range []struct {
+		revision string
+		want     string
+	}{
+		{"v1..", "both sides"},
+		{"..v2", "both sides"},
+		{"v1...v2", "exactly two dots"},
+		{"v1..v2..v3", "exactly two dots"},
+	} {
+		m.exitTimeTravelMode()
+		m.enterTimeTravelMode(tt.revision)
+		if m.timeTravelMode || !m.statusIsError || !strings.Contains(m.statusMsg, tt.want) {
+			t.Errorf("Expected %q to be rejected with %q, got %q", tt.revision, tt.want, m.statusMsg)
+		}
+	}
+}
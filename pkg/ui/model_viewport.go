@@ -3,6 +3,10 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 func (m *Model) updateViewportContent() {
@@ -20,6 +24,11 @@ func (m *Model) updateViewportContent() {
 	}
 	item := issueItem.Issue
 
+	// Opening an issue's details counts as a visit for the jump list
+	if m.focused == focusDetail {
+		m.recent.Record(item.ID, time.Now())
+	}
+
 	var sb strings.Builder
 
 	if m.updateAvailable {
@@ -39,11 +48,54 @@ func (m *Model) updateViewportContent() {
 		item.CreatedAt.Format("2006-01-02"),
 	))
 
+	// Why the issue matched the active filter, when the title doesn't show it
+	terms := m.searchHighlightTerms()
+	if len(terms) > 0 {
+		if snippet, ok := m.matchedSnippet(&item, terms); ok {
+			sb.WriteString(fmt.Sprintf("🔎 **Matched in %s:** %s\n\n", snippet.Field, snippet.Text))
+		}
+	}
+
+	if m.isReadOnlyIssue(item.ID) {
+		sb.WriteString("🔒 *Read-only repo: work sessions and editing are disabled*\n\n")
+	}
+
 	// Labels (bv-f103 fix: display labels in detail view)
 	if len(item.Labels) > 0 {
 		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(item.Labels, ", ")))
 	}
 
+	if item.Estimate != nil {
+		sb.WriteString(fmt.Sprintf("**Estimate:** %s\n\n", analysis.FormatEstimate(*item.Estimate, analysis.EstimatePoints)))
+	}
+
+	if item.DueDate != nil && !item.DueDate.IsZero() {
+		due := fmt.Sprintf("**Due:** %s", item.DueDate.Format("2006-01-02"))
+		switch item.DueStateAt(time.Now()) {
+		case model.DueOverdue:
+			due += " ⚠️ *overdue*"
+		case model.DueSoon:
+			due += " ⏳ *due soon*"
+		}
+		sb.WriteString(due + "\n\n")
+	}
+
+	// Work sessions: logged time vs. estimate
+	if m.timeLog != nil {
+		logged := m.timeLog.Actuals(time.Now())[item.ID]
+		if logged > 0 || item.EstimatedMinutes != nil {
+			line := fmt.Sprintf("**⏱ Time logged:** %s", formatMinutes(int(logged/time.Minute)))
+			if item.EstimatedMinutes != nil && *item.EstimatedMinutes > 0 {
+				est := *item.EstimatedMinutes
+				line += fmt.Sprintf(" of %s estimated (%d%%)", formatMinutes(est), int(logged/time.Minute)*100/est)
+			}
+			if active := m.timeLog.Active(); active != nil && active.IssueID == item.ID {
+				line += " • session running"
+			}
+			sb.WriteString(line + "\n\n")
+		}
+	}
+
 	// Triage Insights (bv-151)
 	if issueItem.TriageScore > 0 || issueItem.TriageReason != "" || issueItem.UnblocksCount > 0 || issueItem.IsQuickWin || issueItem.IsBlocker {
 		sb.WriteString("### 🎯 Triage Insights\n")
@@ -147,6 +199,6 @@ func (m *Model) updateViewportContent() {
 	if err != nil {
 		m.viewport.SetContent(fmt.Sprintf("Error rendering markdown: %v", err))
 	} else {
-		m.viewport.SetContent(rendered)
+		m.viewport.SetContent(highlightANSI(rendered, terms))
 	}
 }
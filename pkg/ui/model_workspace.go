@@ -2,6 +2,13 @@ package ui
 
 import (
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/watcher"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // WorkspaceInfo contains workspace loading metadata for TUI display
@@ -11,13 +18,40 @@ type WorkspaceInfo struct {
 	FailedCount  int
 	TotalIssues  int
 	RepoPrefixes []string
+
+	ReadOnlyPrefixes []string // Repos whose issues are view-only
+	HiddenCount      int      // Hidden repos left out of this session
+
+	// Live reload: the beads file of each repo, by prefix, and a function
+	// that re-reads just the named repos and returns every issue
+	Files  map[string]string
+	Reload func(repos []string) ([]model.Issue, error)
+}
+
+// WorkspaceChangedMsg is sent when beads files of workspace repos change
+type WorkspaceChangedMsg struct {
+	Repos []string // Normalized prefixes, sorted
+}
+
+// WatchWorkspaceCmd returns a command that waits for any workspace repo's
+// beads file to change and sends WorkspaceChangedMsg
+func WatchWorkspaceCmd(w *watcher.MultiWatcher) tea.Cmd {
+	return func() tea.Msg {
+		<-w.Changed()
+		return WorkspaceChangedMsg{Repos: w.TakeChanged()}
+	}
 }
 
 // EnableWorkspaceMode configures the model for workspace (multi-repo) view
 func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 	m.workspaceMode = info.Enabled
+	m.graphView.SetWorkspaceMode(info.Enabled)
 	m.availableRepos = normalizeRepoPrefixes(info.RepoPrefixes)
 	m.activeRepos = nil // nil means all repos are active
+	m.readOnlyRepos = make(map[string]bool)
+	for _, p := range normalizeRepoPrefixes(info.ReadOnlyPrefixes) {
+		m.readOnlyRepos[p] = true
+	}
 
 	if info.RepoCount > 0 {
 		if info.FailedCount > 0 {
@@ -25,15 +59,67 @@ func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 		} else {
 			m.workspaceSummary = fmt.Sprintf("%d repos", info.RepoCount)
 		}
+		// Only the count: names of hidden repos stay off screen
+		if info.HiddenCount > 0 {
+			m.workspaceSummary += fmt.Sprintf(" · %d hidden", info.HiddenCount)
+		}
 	}
 
 	// Update delegate to show repo badges
-	m.list.SetDelegate(IssueDelegate{
-		Theme:             m.theme,
-		ShowPriorityHints: m.showPriorityHints,
-		PriorityHints:     m.priorityHints,
-		WorkspaceMode:     m.workspaceMode,
-	})
+	m.list.SetDelegate(m.issueDelegate())
+
+	// Watch every repo's beads file, reloading only the repos that change
+	if m.workspaceWatcher != nil {
+		m.workspaceWatcher.Stop()
+		m.workspaceWatcher = nil
+	}
+	if info.Enabled && info.Reload != nil && len(info.Files) > 0 {
+		files := make(map[string]string, len(info.Files))
+		for prefix, path := range info.Files {
+			if key := normalizeRepoPrefixes([]string{prefix}); len(key) == 1 {
+				files[key[0]] = path
+			}
+		}
+		w, err := watcher.NewMultiWatcher(files, watcher.WithDebounceDuration(200*time.Millisecond))
+		if err == nil {
+			err = w.Start()
+		}
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Live reload unavailable: %v", err)
+			m.statusIsError = true
+		}
+		if w != nil {
+			m.workspaceWatcher = w // Files that could be watched still are
+		}
+		m.workspaceReload = info.Reload
+	}
+
+	// Bring back the repo filter from the last run
+	if m.workspaceMode {
+		if state, err := LoadUIState(projectDirFromBeadsPath(m.beadsPath)); err == nil {
+			m.restoreRepoSelection(state.Repos)
+		}
+	}
+}
+
+// isReadOnlyIssue reports whether an issue comes from a read-only workspace repo
+func (m Model) isReadOnlyIssue(id string) bool {
+	if !m.workspaceMode || len(m.readOnlyRepos) == 0 {
+		return false
+	}
+	return m.readOnlyRepos[strings.ToLower(ExtractRepoPrefix(id))]
+}
+
+// blockReadOnly sets an error status and returns true if the selected issue
+// comes from a read-only repo, so actions on it can bail out
+func (m *Model) blockReadOnly(action string) bool {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok || !m.isReadOnlyIssue(item.Issue.ID) {
+		return false
+	}
+	m.statusMsg = fmt.Sprintf("🔒 %s: %s is in a read-only repo", action, item.Issue.ID)
+	m.statusIsError = true
+	return true
 }
 
 // IsWorkspaceMode returns whether workspace mode is active
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// toggleMarkSelected marks or unmarks the issue under the cursor and advances
+// the cursor, so holding space sweeps down the list
+func (m *Model) toggleMarkSelected() {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return
+	}
+	if m.marked[item.Issue.ID] {
+		delete(m.marked, item.Issue.ID)
+	} else {
+		m.marked[item.Issue.ID] = true
+	}
+	if idx := m.list.Index(); idx < len(m.list.Items())-1 {
+		m.list.Select(idx + 1)
+	}
+	if m.markedOnly && len(m.marked) == 0 {
+		m.markedOnly = false
+		m.applyFilter()
+	}
+}
+
+// toggleMarkAllVisible marks every visible issue, or clears the marks on the
+// visible issues if they are all marked already
+func (m *Model) toggleMarkAllVisible() {
+	items := m.list.Items()
+	allMarked := len(items) > 0
+	for _, it := range items {
+		if issueItem, ok := it.(IssueItem); ok && !m.marked[issueItem.Issue.ID] {
+			allMarked = false
+			break
+		}
+	}
+	for _, it := range items {
+		issueItem, ok := it.(IssueItem)
+		if !ok {
+			continue
+		}
+		if allMarked {
+			delete(m.marked, issueItem.Issue.ID)
+		} else {
+			m.marked[issueItem.Issue.ID] = true
+		}
+	}
+	if allMarked {
+		m.statusMsg = fmt.Sprintf("Unmarked %d issues", len(items))
+	} else {
+		m.statusMsg = fmt.Sprintf("☑ %d issues marked", m.markedCount())
+	}
+	m.statusIsError = false
+}
+
+// clearMarks drops all marks and leaves the marked-only view.
+// Returns false if nothing was marked.
+func (m *Model) clearMarks() bool {
+	if len(m.marked) == 0 {
+		return false
+	}
+	clear(m.marked)
+	if m.markedOnly {
+		m.markedOnly = false
+		m.applyFilter()
+	}
+	m.statusMsg = "Marks cleared"
+	m.statusIsError = false
+	return true
+}
+
+// toggleMarkedOnly restricts the list to marked issues. Status, label and
+// query filters still apply on top, so they narrow the marked set.
+func (m *Model) toggleMarkedOnly() {
+	if !m.markedOnly && len(m.marked) == 0 {
+		m.statusMsg = "No issues marked (space to mark)"
+		m.statusIsError = true
+		return
+	}
+	m.markedOnly = !m.markedOnly
+	m.applyFilter()
+}
+
+// markedCount returns the number of marked issues still present in the data
+func (m *Model) markedCount() int {
+	n := 0
+	for id := range m.marked {
+		if _, ok := m.issueMap[id]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// markedIssues returns the marked issues in data order. Marks on issues that
+// disappeared after a reload are ignored.
+func (m *Model) markedIssues() []model.Issue {
+	if len(m.marked) == 0 {
+		return nil
+	}
+	var issues []model.Issue
+	for _, issue := range m.issues {
+		if m.marked[issue.ID] {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
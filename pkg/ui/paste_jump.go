@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/atotto/clipboard"
+	"gopkg.in/yaml.v3"
+)
+
+// TrackerFilename is the per-project file under .bv/ that describes the issue tracker
+const TrackerFilename = "tracker.yaml"
+
+// TrackerConfig describes how issue URLs look in the project's tracker.
+// URLTemplate contains an {id} placeholder, e.g. https://tracker.example.com/browse/{id}.
+type TrackerConfig struct {
+	URLTemplate string `yaml:"url_template,omitempty"`
+}
+
+// TrackerPath returns the tracker config path for a project
+func TrackerPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", TrackerFilename)
+}
+
+// LoadTrackerConfig reads the tracker config from .bv/tracker.yaml.
+// Returns an empty config if the file doesn't exist.
+func LoadTrackerConfig(projectDir string) (TrackerConfig, error) {
+	var cfg TrackerConfig
+	data, err := os.ReadFile(TrackerPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("reading tracker config: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return TrackerConfig{}, fmt.Errorf("parsing tracker config: %w", err)
+	}
+	return cfg, nil
+}
+
+// templatePattern turns a URL template into a regexp capturing the {id}
+// part. Returns nil if the template has no placeholder.
+func templatePattern(template string) *regexp.Regexp {
+	if !strings.Contains(template, "{id}") {
+		return nil
+	}
+	parts := strings.Split(template, "{id}")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	re, err := regexp.Compile(strings.Join(parts, `([^/?#\s]+)`))
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+// resolvePastedID finds the issue named by pasted text: a tracker URL
+// matching the template, an issue's external ref, an ID mentioned anywhere
+// in the text, or a bare number matching exactly one ID's numeric suffix.
+// Returns "" if nothing matches.
+func resolvePastedID(text, template string, issues []model.Issue) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+
+	known := make(map[string]bool, len(issues))
+	lower := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		known[issue.ID] = true
+		lower[strings.ToLower(issue.ID)] = issue.ID
+	}
+
+	if re := templatePattern(template); re != nil {
+		for _, m := range re.FindAllStringSubmatch(text, -1) {
+			id := m[1]
+			if unescaped, err := url.PathUnescape(id); err == nil {
+				id = unescaped
+			}
+			if known[id] {
+				return id
+			}
+			if match, ok := lower[strings.ToLower(id)]; ok {
+				return match
+			}
+		}
+	}
+
+	for _, issue := range issues {
+		if issue.ExternalRef != nil && *issue.ExternalRef != "" && strings.Contains(text, *issue.ExternalRef) {
+			return issue.ID
+		}
+	}
+
+	tokens := strings.FieldsFunc(text, func(r rune) bool { return !isIDRune(r) })
+	for i, tok := range tokens {
+		tokens[i] = strings.Trim(tok, ".-_")
+	}
+	for _, tok := range tokens {
+		if known[tok] {
+			return tok
+		}
+	}
+	for _, tok := range tokens {
+		if match, ok := lower[strings.ToLower(tok)]; ok {
+			return match
+		}
+	}
+
+	// A bare "12" or "#12" names bv-12 when no other ID ends in -12
+	number := strings.TrimPrefix(text, "#")
+	if number == "" || strings.IndexFunc(number, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+		return ""
+	}
+	found := ""
+	for _, issue := range issues {
+		if strings.HasSuffix(issue.ID, "-"+number) {
+			if found != "" {
+				return ""
+			}
+			found = issue.ID
+		}
+	}
+	return found
+}
+
+// pasteJump opens the issue named by pasted text, or reports that nothing
+// in it matched
+func (m *Model) pasteJump(text string) {
+	id := resolvePastedID(text, m.tracker.URLTemplate, m.issues)
+	if id == "" {
+		m.statusMsg = "No issue ID or tracker URL in pasted text"
+		m.statusIsError = true
+		return
+	}
+	m.statusMsg = ""
+	if !m.jumpToIssue(id) {
+		m.statusMsg = fmt.Sprintf("Issue %s is not in the list", id)
+		m.statusIsError = true
+		return
+	}
+	if m.statusMsg == "" {
+		m.statusMsg = fmt.Sprintf("Jumped to %s", id)
+		m.statusIsError = false
+	}
+}
+
+// pasteJumpFromClipboard opens the issue named on the system clipboard
+func (m *Model) pasteJumpFromClipboard() {
+	text, err := clipboard.ReadAll()
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Clipboard unavailable: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.pasteJump(text)
+}
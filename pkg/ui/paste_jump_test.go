@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pasteTestIssues() []model.Issue {
+	ref := "https://github.com/acme/app/issues/77"
+	return []model.Issue{
+		{ID: "bv-12", Title: "Twelve", Status: model.StatusOpen},
+		{ID: "bv-120", Title: "One twenty", Status: model.StatusOpen},
+		{ID: "bv-7", Title: "Imported", Status: model.StatusClosed, ExternalRef: &ref},
+		{ID: "web.login", Title: "Login page", Status: model.StatusOpen},
+	}
+}
+
+func TestResolvePastedID(t *testing.T) {
+	issues := pasteTestIssues()
+	template := "https://tracker.example.com/browse/{id}"
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"https://tracker.example.com/browse/bv-120?focus=comments", "bv-120"},
+		{"see https://tracker.example.com/browse/BV-12.", "bv-12"},
+		{"https://github.com/acme/app/issues/77", "bv-7"},
+		{"can you look at bv-12 before standup?", "bv-12"},
+		{"Blocked on web.login.", "web.login"},
+		{"  bv-120\n", "bv-120"},
+		{"#12", "bv-12"},
+		{"7", "bv-7"},
+		{"nothing useful here", ""},
+		{"https://tracker.example.com/browse/bv-999", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := resolvePastedID(tt.text, template, issues); got != tt.want {
+			t.Errorf("resolvePastedID(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	// Without a template the URL's last segment is still found as a token
+	if got := resolvePastedID("https://tracker.example.com/browse/bv-12", "", issues); got != "bv-12" {
+		t.Errorf("Expected bv-12 without a template, got %q", got)
+	}
+	// An ambiguous number names nothing
+	issues = append(issues, model.Issue{ID: "ops-12", Status: model.StatusOpen})
+	if got := resolvePastedID("12", template, issues); got != "" {
+		t.Errorf("Expected no match for an ambiguous number, got %q", got)
+	}
+}
+
+func TestLoadTrackerConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadTrackerConfig(dir)
+	if err != nil || cfg.URLTemplate != "" {
+		t.Fatalf("Expected an empty config without a file, got %+v, %v", cfg, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(TrackerPath(dir), []byte("url_template: https://jira.example.com/browse/{id}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadTrackerConfig(dir)
+	if err != nil || cfg.URLTemplate != "https://jira.example.com/browse/{id}" {
+		t.Errorf("LoadTrackerConfig = %+v, %v", cfg, err)
+	}
+
+	if err := os.WriteFile(TrackerPath(dir), []byte("url_template: [\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTrackerConfig(dir); err == nil {
+		t.Error("Expected an error for malformed YAML")
+	}
+}
+
+func TestPasteJump_BracketedPaste(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	if err := os.MkdirAll(filepath.Join(dir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(TrackerPath(dir), []byte("url_template: https://tracker.example.com/browse/{id}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(pasteTestIssues(), nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	// The open filter hides closed bv-7; pasting its tracker URL clears it
+	updated, _ = m.Update(keyMsgFromString("o"))
+	m = updated.(Model)
+	paste := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("https://tracker.example.com/browse/bv-7"), Paste: true}
+	updated, _ = m.Update(paste)
+	m = updated.(Model)
+	if selectedID(m) != "bv-7" || m.focused != focusDetail {
+		t.Fatalf("Expected the paste to open bv-7, got %q", selectedID(m))
+	}
+	if !strings.Contains(m.statusMsg, "Cleared filters") {
+		t.Errorf("Expected the filter to be cleared, got %q", m.statusMsg)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("no ids here"), Paste: true})
+	m = updated.(Model)
+	if !m.statusIsError || selectedID(m) != "bv-7" {
+		t.Errorf("Expected an error for unmatched text, got %q", m.statusMsg)
+	}
+}
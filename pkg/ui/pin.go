@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PinFilename is the per-project file under .bv/ that remembers the pinned epic/label
+const PinFilename = "pin.yaml"
+
+// Pin identifies the one epic or label whose progress is always shown in the footer.
+// At most one of Epic and Label is set.
+type Pin struct {
+	Epic  string `yaml:"epic,omitempty"`
+	Label string `yaml:"label,omitempty"`
+}
+
+// IsEmpty returns true if nothing is pinned
+func (p Pin) IsEmpty() bool {
+	return p.Epic == "" && p.Label == ""
+}
+
+// Name returns the pinned epic ID or label name
+func (p Pin) Name() string {
+	if p.Epic != "" {
+		return p.Epic
+	}
+	return p.Label
+}
+
+// PinPath returns the pin file path for a project
+func PinPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", PinFilename)
+}
+
+// LoadPin reads the pinned epic/label from .bv/pin.yaml.
+// Returns an empty pin if the file doesn't exist.
+func LoadPin(projectDir string) (Pin, error) {
+	var pin Pin
+	data, err := os.ReadFile(PinPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return pin, nil
+		}
+		return pin, fmt.Errorf("reading pin: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &pin); err != nil {
+		return Pin{}, fmt.Errorf("parsing pin: %w", err)
+	}
+	return pin, nil
+}
+
+// SavePin writes the pin to .bv/pin.yaml, removing the file when the pin is empty
+func SavePin(projectDir string, pin Pin) error {
+	path := PinPath(projectDir)
+	if pin.IsEmpty() {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing pin: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating pin directory: %w", err)
+	}
+	data, err := yaml.Marshal(pin)
+	if err != nil {
+		return fmt.Errorf("encoding pin: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing pin: %w", err)
+	}
+	return nil
+}
+
+// PinProgress is the closed/total count for the pinned epic or label
+type PinProgress struct {
+	Closed int
+	Total  int
+}
+
+// ComputePinProgress counts closed vs. total issues tracked by the pin
+func ComputePinProgress(pin Pin, issues []model.Issue) PinProgress {
+	var p PinProgress
+	for _, issue := range pinnedIssues(pin, issues) {
+		p.Total++
+		if issue.Status == model.StatusClosed {
+			p.Closed++
+		}
+	}
+	return p
+}
+
+// pinnedIssues returns the issues a pin tracks. An epic tracks all of its
+// descendants via parent-child dependencies (sub-epics included); a label
+// tracks every issue carrying it.
+func pinnedIssues(pin Pin, issues []model.Issue) []*model.Issue {
+	var tracked []*model.Issue
+	switch {
+	case pin.Epic != "":
+		children := make(map[string][]*model.Issue)
+		for i := range issues {
+			for _, dep := range issues[i].Dependencies {
+				if dep != nil && dep.Type == model.DepParentChild {
+					children[dep.DependsOnID] = append(children[dep.DependsOnID], &issues[i])
+				}
+			}
+		}
+		seen := map[string]bool{pin.Epic: true}
+		queue := []string{pin.Epic}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, child := range children[id] {
+				if seen[child.ID] {
+					continue
+				}
+				seen[child.ID] = true
+				tracked = append(tracked, child)
+				queue = append(queue, child.ID)
+			}
+		}
+	case pin.Label != "":
+		for i := range issues {
+			for _, l := range issues[i].Labels {
+				if l == pin.Label {
+					tracked = append(tracked, &issues[i])
+					break
+				}
+			}
+		}
+	}
+	return tracked
+}
+
+// togglePin pins the given epic/label, or unpins it if it is already pinned,
+// and persists the choice for the next session.
+func (m *Model) togglePin(pin Pin) {
+	if m.pin == pin {
+		pin = Pin{}
+	}
+	if err := SavePin(projectDirFromBeadsPath(m.beadsPath), pin); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ %v", err)
+		m.statusIsError = true
+		return
+	}
+	if pin.IsEmpty() {
+		m.statusMsg = fmt.Sprintf("Unpinned %s", m.pin.Name())
+	} else {
+		m.statusMsg = fmt.Sprintf("📌 Pinned %s to footer", pin.Name())
+	}
+	m.statusIsError = false
+	m.pin = pin
+	m.pinProgress = ComputePinProgress(pin, m.issues)
+}
+
+// pinSelectedEpic toggles the pin on the epic selected in the list
+func (m *Model) pinSelectedEpic() {
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+	issue := issueItem.Issue
+	if issue.IssueType != model.TypeEpic && ComputePinProgress(Pin{Epic: issue.ID}, m.issues).Total == 0 {
+		m.statusMsg = fmt.Sprintf("❌ %s has no child issues to track", issue.ID)
+		m.statusIsError = true
+		return
+	}
+	m.togglePin(Pin{Epic: issue.ID})
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pinTestIssues() []model.Issue {
+	child := func(id, parent string, status model.Status, labels ...string) model.Issue {
+		return model.Issue{
+			ID: id, Title: id, Status: status, IssueType: model.TypeTask, Labels: labels,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}},
+		}
+	}
+	return []model.Issue{
+		{ID: "epic", Title: "Auth", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("a", "epic", model.StatusClosed, "auth"),
+		child("b", "epic", model.StatusOpen, "auth"),
+		{ID: "sub", Title: "Sub", Status: model.StatusOpen, IssueType: model.TypeEpic,
+			Dependencies: []*model.Dependency{{IssueID: "sub", DependsOnID: "epic", Type: model.DepParentChild}}},
+		child("c", "sub", model.StatusClosed),
+		{ID: "other", Title: "Other", Status: model.StatusClosed, Labels: []string{"auth"},
+			Dependencies: []*model.Dependency{{IssueID: "other", DependsOnID: "epic", Type: model.DepBlocks}}},
+	}
+}
+
+func TestComputePinProgress(t *testing.T) {
+	issues := pinTestIssues()
+
+	// Epic counts transitive parent-child descendants but not blockers
+	if got := ComputePinProgress(Pin{Epic: "epic"}, issues); got != (PinProgress{Closed: 2, Total: 4}) {
+		t.Errorf("Epic progress = %+v, want 2/4", got)
+	}
+	if got := ComputePinProgress(Pin{Label: "auth"}, issues); got != (PinProgress{Closed: 2, Total: 3}) {
+		t.Errorf("Label progress = %+v, want 2/3", got)
+	}
+	if got := ComputePinProgress(Pin{}, issues); got != (PinProgress{}) {
+		t.Errorf("Empty pin progress = %+v, want zero", got)
+	}
+}
+
+func TestSaveAndLoadPin(t *testing.T) {
+	dir := t.TempDir()
+
+	pin, err := LoadPin(dir)
+	if err != nil || !pin.IsEmpty() {
+		t.Fatalf("Expected empty pin for missing file, got %+v (err %v)", pin, err)
+	}
+
+	if err := SavePin(dir, Pin{Label: "auth"}); err != nil {
+		t.Fatalf("SavePin failed: %v", err)
+	}
+	pin, err = LoadPin(dir)
+	if err != nil || pin.Label != "auth" || pin.Epic != "" {
+		t.Fatalf("Expected label pin, got %+v (err %v)", pin, err)
+	}
+
+	// Saving an empty pin removes the file
+	if err := SavePin(dir, Pin{}); err != nil {
+		t.Fatalf("SavePin(empty) failed: %v", err)
+	}
+	if _, err := os.Stat(PinPath(dir)); !os.IsNotExist(err) {
+		t.Errorf("Expected pin file removed, stat err = %v", err)
+	}
+}
+
+func TestPinEpicFromListShowsInFooter(t *testing.T) {
+	dir := t.TempDir()
+	m := NewModel(pinTestIssues(), nil, "")
+	m.beadsPath = filepath.Join(dir, ".beads", "beads.jsonl")
+
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	m = updated.(Model)
+
+	for i, item := range m.list.Items() {
+		if item.(IssueItem).Issue.ID == "epic" {
+			m.list.Select(i)
+		}
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("^")})
+	m = updated.(Model)
+
+	if m.pin.Epic != "epic" {
+		t.Fatalf("Expected epic pinned, got %+v (status %q)", m.pin, m.statusMsg)
+	}
+	if saved, _ := LoadPin(dir); saved.Epic != "epic" {
+		t.Errorf("Expected pin persisted, got %+v", saved)
+	}
+
+	m.statusMsg = ""
+	if footer := m.renderFooter(); !strings.Contains(footer, "epic 2/4") {
+		t.Errorf("Expected footer to show pinned progress, got %q", footer)
+	}
+
+	// Pressing again unpins
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("^")})
+	m = updated.(Model)
+	if !m.pin.IsEmpty() {
+		t.Errorf("Expected pin cleared, got %+v", m.pin)
+	}
+}
+
+func TestPinRejectsIssueWithoutChildren(t *testing.T) {
+	m := NewModel(pinTestIssues(), nil, "")
+	m.beadsPath = filepath.Join(t.TempDir(), "beads.jsonl")
+	for i, item := range m.list.Items() {
+		if item.(IssueItem).Issue.ID == "a" {
+			m.list.Select(i)
+		}
+	}
+	m.pinSelectedEpic()
+	if !m.pin.IsEmpty() || !m.statusIsError {
+		t.Errorf("Expected pin refused for childless task, got %+v", m.pin)
+	}
+}
@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Long-running background operations, shown in the footer while they run
+const (
+	opAnalysis = "Graph metrics"
+	opSemantic = "Semantic index"
+	opHistory  = "History"
+)
+
+// opProgressInterval is how often the footer redraws while an operation runs
+const opProgressInterval = 200 * time.Millisecond
+
+// opShowDelay is how long an operation runs before the footer shows it and
+// esc cancels it, so quick ones neither flash a bar nor swallow esc
+const opShowDelay = 500 * time.Millisecond
+
+// opProgress is written by a background operation's progress callback and
+// read when the footer renders
+type opProgress struct {
+	done  atomic.Int64
+	total atomic.Int64
+}
+
+// report records that done of total steps are complete. It matches the
+// progress callbacks of the analysis, search and correlation packages.
+func (p *opProgress) report(done, total int) {
+	p.total.Store(int64(total))
+	p.done.Store(int64(done))
+}
+
+// get returns the last reported progress; total is 0 until the first report
+func (p *opProgress) get() (done, total int) {
+	return int(p.done.Load()), int(p.total.Load())
+}
+
+// backgroundOp is one in-flight operation that esc can cancel
+type backgroundOp struct {
+	name     string
+	cancel   context.CancelFunc
+	progress *opProgress
+	started  time.Time
+}
+
+// opTracker holds the in-flight operations. The Model keeps a pointer so
+// copies made by value-receiver handlers (and Init) share it. A nil tracker
+// runs operations untracked.
+type opTracker struct {
+	ops     map[string]*backgroundOp
+	ticking bool
+	delay   time.Duration // opShowDelay, unless a test shortens it
+}
+
+func newOpTracker() *opTracker {
+	return &opTracker{ops: make(map[string]*backgroundOp), delay: opShowDelay}
+}
+
+// start registers an operation, cancelling a previous one of the same name,
+// and returns the context it must honor and the progress it reports to
+func (t *opTracker) start(name string) (context.Context, *opProgress) {
+	if t == nil {
+		return context.Background(), &opProgress{}
+	}
+	if prev := t.ops[name]; prev != nil {
+		prev.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	op := &backgroundOp{name: name, cancel: cancel, progress: &opProgress{}, started: time.Now()}
+	t.ops[name] = op
+	return ctx, op.progress
+}
+
+// finish forgets an operation once its result has arrived
+func (t *opTracker) finish(name string) {
+	if t == nil {
+		return
+	}
+	if op := t.ops[name]; op != nil {
+		op.cancel()
+		delete(t.ops, name)
+	}
+}
+
+// running reports whether an operation is in flight
+func (t *opTracker) running(name string) bool {
+	return t != nil && t.ops[name] != nil
+}
+
+// cancelAll cancels the operations the footer shows and returns their names
+func (t *opTracker) cancelAll() []string {
+	names := t.visible()
+	for _, name := range names {
+		t.ops[name].cancel()
+		delete(t.ops, name)
+	}
+	return names
+}
+
+// names returns the in-flight operations in the order they started
+func (t *opTracker) names() []string {
+	if t == nil {
+		return nil
+	}
+	names := make([]string, 0, len(t.ops))
+	for name := range t.ops {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := t.ops[names[i]], t.ops[names[j]]
+		if !a.started.Equal(b.started) {
+			return a.started.Before(b.started)
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// visible returns the operations that have run past the show delay, in the
+// order they started
+func (t *opTracker) visible() []string {
+	var names []string
+	for _, name := range t.names() {
+		if time.Since(t.ops[name].started) >= t.delay {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// OpProgressTickMsg redraws the footer progress of in-flight operations
+type OpProgressTickMsg struct{}
+
+// tickCmd schedules the next progress redraw, unless one is already pending
+// or nothing is running
+func (t *opTracker) tickCmd() tea.Cmd {
+	if t == nil || t.ticking || len(t.ops) == 0 {
+		return nil
+	}
+	t.ticking = true
+	return tea.Tick(opProgressInterval, func(time.Time) tea.Msg {
+		return OpProgressTickMsg{}
+	})
+}
+
+// handleTick keeps redrawing while operations run
+func (t *opTracker) handleTick() tea.Cmd {
+	if t == nil {
+		return nil
+	}
+	t.ticking = false
+	return t.tickCmd()
+}
+
+// cancelOperations cancels the in-flight operations (esc at the main list)
+// and says so in the status bar. Returns false if nothing was running.
+func (m *Model) cancelOperations() bool {
+	names := m.ops.cancelAll()
+	if len(names) == 0 {
+		return false
+	}
+	if slices.Contains(names, opSemantic) {
+		m.semanticIndexBuilding = false
+	}
+	m.statusMsg = fmt.Sprintf("Cancelled: %s", strings.ToLower(strings.Join(names, ", ")))
+	m.statusIsError = false
+	return true
+}
+
+// renderOpProgress renders "name ▰▰▰▱▱▱ 43%" for each in-flight operation,
+// or "name …" while its size is unknown
+func renderOpProgress(t *opTracker) string {
+	var parts []string
+	for _, name := range t.visible() {
+		done, total := t.ops[name].progress.get()
+		if total <= 0 {
+			parts = append(parts, name+" …")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %d%%", name, progressBar(done, total, 6), (done*100+total/2)/total))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// progressBar renders done/total as width cells
+func progressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = min(width, done*width/total)
+	}
+	return strings.Repeat("▰", filled) + strings.Repeat("▱", width-filled)
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestOpProgress_FooterShowsBar(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	m.ops.finish(opAnalysis)
+	m.ops.delay = 0
+
+	_, progress := m.ops.start(opSemantic)
+	if !strings.Contains(m.View(), "Semantic index …") {
+		t.Error("Expected an indeterminate entry before the first report")
+	}
+	progress.report(3, 6)
+	if view := m.View(); !strings.Contains(view, "Semantic index ▰▰▰▱▱▱ 50%") {
+		t.Errorf("Expected the semantic index progress bar in the footer")
+	}
+
+	if cmd := m.ops.tickCmd(); cmd == nil {
+		t.Fatal("Expected a redraw tick while an operation runs")
+	}
+	if cmd := m.ops.tickCmd(); cmd != nil {
+		t.Error("Expected one pending tick at a time")
+	}
+	m.ops.finish(opSemantic)
+	if cmd := m.ops.handleTick(); cmd != nil {
+		t.Error("Expected ticking to stop once nothing runs")
+	}
+}
+
+func TestOpProgress_EscCancels(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	m.ops.finish(opAnalysis)
+	m.ops.delay = 0
+
+	ctx, _ := m.ops.start(opSemantic)
+	m.semanticIndexBuilding = true
+	updated, _ = m.Update(keyMsgFromString("esc"))
+	m = updated.(Model)
+	if ctx.Err() == nil {
+		t.Fatal("Expected esc to cancel the semantic index build")
+	}
+	if m.semanticIndexBuilding || m.ops.running(opSemantic) {
+		t.Error("Expected the build to be forgotten")
+	}
+	if m.showQuitConfirm || !strings.Contains(m.statusMsg, "Cancelled: semantic index") {
+		t.Errorf("Expected a cancel status instead of the quit prompt, got %q", m.statusMsg)
+	}
+
+	// With nothing running, esc falls through to the quit prompt
+	updated, _ = m.Update(keyMsgFromString("esc"))
+	m = updated.(Model)
+	if !m.showQuitConfirm {
+		t.Error("Expected esc to ask to quit once nothing is running")
+	}
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"sync/atomic"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// QueryFilter lets `/` search accept filter expressions such as
+// "status:open AND label:backend AND priority<=1". Plain text still goes to
+// the wrapped fuzzy/semantic filter. Like SemanticSearch it keeps a snapshot
+// of the issues behind the current list items so filter targets map back to issues.
+type QueryFilter struct {
+	issues atomic.Value // []model.Issue, aligned with list items
+}
+
+// NewQueryFilter creates an empty query filter
+func NewQueryFilter() *QueryFilter {
+	f := &QueryFilter{}
+	f.issues.Store([]model.Issue(nil))
+	return f
+}
+
+// SetItems records the issues behind the list items, in list order
+func (f *QueryFilter) SetItems(items []list.Item) {
+	issues := make([]model.Issue, 0, len(items))
+	for _, it := range items {
+		if issueItem, ok := it.(IssueItem); ok {
+			issues = append(issues, issueItem.Issue)
+		}
+	}
+	f.issues.Store(issues)
+}
+
+// Wrap returns a list.FilterFunc that evaluates query expressions itself and
+// delegates anything else (or an incomplete expression) to fallback.
+func (f *QueryFilter) Wrap(fallback list.FilterFunc) list.FilterFunc {
+	return func(term string, targets []string) []list.Rank {
+		if !query.LooksLikeQuery(term) {
+			return fallback(term, targets)
+		}
+		q, err := query.Parse(term)
+		issues, _ := f.issues.Load().([]model.Issue)
+		if err != nil || len(issues) != len(targets) {
+			return fallback(term, targets)
+		}
+		var ranks []list.Rank
+		for i := range issues {
+			if q.Match(&issues[i]) {
+				ranks = append(ranks, list.Rank{Index: i})
+			}
+		}
+		return ranks
+	}
+}
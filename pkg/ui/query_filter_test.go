@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+func queryTestIssues() []model.Issue {
+	return []model.Issue{
+		{ID: "q-1", Title: "API auth", Status: model.StatusOpen, Priority: 0, Labels: []string{"backend"}},
+		{ID: "q-2", Title: "Login page", Status: model.StatusOpen, Priority: 1, Labels: []string{"frontend"}, Assignee: "alice"},
+		{ID: "q-3", Title: "DB cache", Status: model.StatusClosed, Priority: 1, Labels: []string{"backend"}},
+	}
+}
+
+func TestQueryFilter_WrapEvaluatesExpressions(t *testing.T) {
+	items := []list.Item{}
+	targets := []string{}
+	for _, issue := range queryTestIssues() {
+		it := IssueItem{Issue: issue}
+		items = append(items, it)
+		targets = append(targets, it.FilterValue())
+	}
+	f := NewQueryFilter()
+	f.SetItems(items)
+
+	fallbackCalled := false
+	filter := f.Wrap(func(term string, targets []string) []list.Rank {
+		fallbackCalled = true
+		return list.DefaultFilter(term, targets)
+	})
+
+	ranks := filter("status:open AND label:backend", targets)
+	if len(ranks) != 1 || ranks[0].Index != 0 {
+		t.Errorf("Expected only q-1 to match, got %+v", ranks)
+	}
+	if fallbackCalled {
+		t.Error("Query expressions should not use the fallback filter")
+	}
+
+	filter("login", targets)
+	if !fallbackCalled {
+		t.Error("Plain text should use the fallback filter")
+	}
+}
+
+func TestApplyFilter_QueryPrefix(t *testing.T) {
+	m := NewModel(queryTestIssues(), nil, "")
+	m.currentFilter = "query:priority<=1 AND NOT assignee:alice AND status:open"
+	m.applyFilter()
+
+	items := m.list.Items()
+	if len(items) != 1 || items[0].(IssueItem).Issue.ID != "q-1" {
+		t.Fatalf("Expected only q-1, got %d items", len(items))
+	}
+
+	m.currentFilter = "query:priority<<1"
+	m.applyFilter()
+	if !m.statusIsError || m.currentFilter != "all" || len(m.list.Items()) != 3 {
+		t.Errorf("Invalid query should report an error and show all issues (filter %q, %d items)",
+			m.currentFilter, len(m.list.Items()))
+	}
+}
+
+func TestApplyRecipe_Query(t *testing.T) {
+	m := NewModel(queryTestIssues(), nil, "")
+	m.applyRecipe(&recipe.Recipe{Name: "be", Filters: recipe.FilterConfig{Query: "label:backend OR assignee:alice"}})
+	if got := len(m.list.Items()); got != 3 {
+		t.Errorf("Expected 3 issues, got %d", got)
+	}
+	m.applyRecipe(&recipe.Recipe{Name: "be", Filters: recipe.FilterConfig{
+		Status: []string{"open"},
+		Query:  "label:backend",
+	}})
+	if items := m.list.Items(); len(items) != 1 || items[0].(IssueItem).Issue.ID != "q-1" {
+		t.Errorf("Expected status and query filters combined, got %d items", len(items))
+	}
+
+	m.selectRecipe(&recipe.Recipe{Name: "broken", Filters: recipe.FilterConfig{Query: "label:backend AND ("}})
+	if !m.statusIsError || m.activeRecipe != nil || len(m.list.Items()) != 1 {
+		t.Errorf("An invalid recipe query should be refused and leave the view as it was (status %q, %d items)",
+			m.statusMsg, len(m.list.Items()))
+	}
+}
@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// maxRecentIssues caps how many viewed issues are remembered
+	maxRecentIssues = 50
+
+	// recentHalfLife is how long it takes a visit to lose half its weight
+	recentHalfLife = 72 * time.Hour
+)
+
+// RecentVisit records how often and how recently an issue was viewed
+type RecentVisit struct {
+	ID         string    `yaml:"id"`
+	Visits     int       `yaml:"visits"`
+	LastViewed time.Time `yaml:"last_viewed"`
+}
+
+// Frecency scores a visit by frequency and recency: each visit counts once,
+// decayed by the time since the issue was last viewed
+func (v RecentVisit) Frecency(now time.Time) float64 {
+	age := now.Sub(v.LastViewed)
+	if age < 0 {
+		age = 0
+	}
+	return float64(v.Visits) * math.Pow(0.5, float64(age)/float64(recentHalfLife))
+}
+
+// RecentIssues tracks viewed issues for the jump list. It is shared by
+// pointer so copies of the Model record into the same history.
+type RecentIssues struct {
+	visits []RecentVisit
+	last   string // Last recorded ID, so re-renders of one issue count once
+}
+
+// NewRecentIssues creates a history from saved visits
+func NewRecentIssues(visits []RecentVisit) *RecentIssues {
+	r := &RecentIssues{}
+	for _, v := range visits {
+		if v.ID != "" && v.Visits > 0 {
+			r.visits = append(r.visits, v)
+		}
+	}
+	return r
+}
+
+// Record counts a view of id. Repeated calls for the same issue without
+// viewing another in between count as one visit.
+func (r *RecentIssues) Record(id string, now time.Time) {
+	if r == nil || id == "" || id == r.last {
+		return
+	}
+	r.last = id
+
+	for i := range r.visits {
+		if r.visits[i].ID == id {
+			r.visits[i].Visits++
+			r.visits[i].LastViewed = now
+			return
+		}
+	}
+	r.visits = append(r.visits, RecentVisit{ID: id, Visits: 1, LastViewed: now})
+
+	if len(r.visits) > maxRecentIssues {
+		r.visits = r.Ranked(now)[:maxRecentIssues]
+	}
+}
+
+// Ranked returns the visits ordered by frecency, highest first
+func (r *RecentIssues) Ranked(now time.Time) []RecentVisit {
+	if r == nil {
+		return nil
+	}
+	ranked := append([]RecentVisit(nil), r.visits...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		fi, fj := ranked[i].Frecency(now), ranked[j].Frecency(now)
+		if fi != fj {
+			return fi > fj
+		}
+		return ranked[i].LastViewed.After(ranked[j].LastViewed)
+	})
+	return ranked
+}
+
+// Visits returns the recorded visits in the order they were first viewed
+func (r *RecentIssues) Visits() []RecentVisit {
+	if r == nil {
+		return nil
+	}
+	return append([]RecentVisit(nil), r.visits...)
+}
+
+// openJumpList shows the recently-viewed overlay
+func (m *Model) openJumpList() {
+	current := ""
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		current = item.Issue.ID
+	}
+	m.jumpList = NewJumpListModel(m.recent.Ranked(time.Now()), m.issueMap, current, m.theme)
+	m.jumpList.SetSize(m.width, m.height-1)
+	m.jumpListReturn = m.focused
+	m.showJumpList = true
+	m.focused = focusJumpList
+}
+
+// closeJumpList hides the recently-viewed overlay without jumping
+func (m *Model) closeJumpList() {
+	m.showJumpList = false
+	m.focused = m.jumpListReturn
+}
+
+// jumpFromList closes the jump list and opens id
+func (m *Model) jumpFromList(id string) {
+	m.showJumpList = false
+	if !m.jumpToIssue(id) {
+		m.focused = m.jumpListReturn
+	}
+}
+
+// jumpToIssue leaves the current view and opens id in the detail view,
+// clearing filters that hide it. Returns false if there is no such issue.
+func (m *Model) jumpToIssue(id string) bool {
+	if _, ok := m.issueMap[id]; !ok {
+		return false
+	}
+
+	index := m.listIndexOf(id)
+	if index < 0 {
+		m.list.ResetFilter()
+		m.currentFilter = "all"
+		m.activeRecipe = nil
+		m.markedOnly = false
+		m.activeRepos = nil
+		m.applyFilter()
+		index = m.listIndexOf(id)
+		m.statusMsg = fmt.Sprintf("Cleared filters to show %s", id)
+		m.statusIsError = false
+	}
+	if index < 0 && m.isSnoozed(id) {
+		m.currentFilter = "snoozed"
+		m.applyFilter()
+		index = m.listIndexOf(id)
+		m.statusMsg = fmt.Sprintf("%s is snoozed; showing snoozed issues", id)
+		m.statusIsError = false
+	}
+	if index < 0 {
+		return false
+	}
+
+	m.clearAttentionOverlay()
+	m.isBoardView = false
+	m.isGraphView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	m.isSprintView = false
+	m.isTimelineView = false
+	m.isHeatmapView = false
+	m.list.Select(index)
+	if !m.isSplitView {
+		m.showDetails = true
+	}
+	m.focused = focusDetail
+	m.updateViewportContent()
+	return true
+}
+
+// listIndexOf returns the index of id among the list's items, or -1
+func (m Model) listIndexOf(id string) int {
+	for i, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == id {
+			return i
+		}
+	}
+	return -1
+}
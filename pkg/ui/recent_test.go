@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func recentIDs(visits []RecentVisit) string {
+	var ids []string
+	for _, v := range visits {
+		ids = append(ids, v.ID)
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestRecentIssues_Frecency(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	r := NewRecentIssues([]RecentVisit{
+		{ID: "old-favorite", Visits: 8, LastViewed: now.Add(-30 * 24 * time.Hour)},
+		{ID: "weekly", Visits: 3, LastViewed: now.Add(-24 * time.Hour)},
+		{ID: "", Visits: 2},
+		{ID: "never", Visits: 0},
+	})
+	r.Record("fresh", now)
+
+	if got := recentIDs(r.Ranked(now)); got != "weekly fresh old-favorite" {
+		t.Errorf("Ranked = %s", got)
+	}
+
+	// Re-rendering the same issue is one visit; coming back is another
+	r.Record("fresh", now)
+	r.Record("fresh", now)
+	if v := r.Ranked(now)[1]; v.ID != "fresh" || v.Visits != 1 {
+		t.Errorf("Expected one visit, got %+v", v)
+	}
+	r.Record("weekly", now)
+	r.Record("fresh", now)
+	r.Record("fresh", now.Add(time.Minute))
+	if got := recentIDs(r.Ranked(now)); got != "weekly fresh old-favorite" {
+		t.Errorf("Ranked = %s", got)
+	}
+	if v := r.Ranked(now)[1]; v.Visits != 2 {
+		t.Errorf("Expected two visits, got %+v", v)
+	}
+
+	var nilRecent *RecentIssues
+	nilRecent.Record("x", now)
+	if nilRecent.Ranked(now) != nil || nilRecent.Visits() != nil {
+		t.Error("A nil history should be empty")
+	}
+}
+
+func TestRecentIssues_Capped(t *testing.T) {
+	now := time.Now()
+	r := NewRecentIssues(nil)
+	for i := 0; i < maxRecentIssues+5; i++ {
+		r.Record(string(rune('A'+i%26))+strings.Repeat("x", i/26), now.Add(time.Duration(i)*time.Second))
+	}
+	if n := len(r.Visits()); n != maxRecentIssues {
+		t.Errorf("Expected %d visits, got %d", maxRecentIssues, n)
+	}
+}
+
+func TestModel_JumpList(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "jl-1", Title: "Alpha", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "jl-2", Title: "Beta", Status: model.StatusClosed, CreatedAt: now},
+		{ID: "jl-3", Title: "Gamma", Status: model.StatusOpen, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("ctrl+o")
+	if !m.showJumpList || !strings.Contains(m.View(), "No recently viewed issues") {
+		t.Fatal("ctrl+o should open an empty jump list")
+	}
+	press("esc")
+	if m.showJumpList || m.focused != focusList {
+		t.Fatal("esc should close the jump list")
+	}
+
+	// Open jl-2 twice and jl-3 once
+	open := func(id string) {
+		m.list.Select(m.listIndexOf(id))
+		press("enter")
+		press("esc")
+	}
+	open("jl-2")
+	open("jl-3")
+	open("jl-2")
+	if got := recentIDs(m.recent.Ranked(time.Now())); got != "jl-2 jl-3" {
+		t.Fatalf("Ranked = %s", got)
+	}
+
+	// Filter jl-2 out, then jump to it from the list
+	press("o")
+	if m.listIndexOf("jl-2") >= 0 {
+		t.Fatal("Open filter should hide jl-2")
+	}
+	press("ctrl+o")
+	if m.jumpList.SelectedID() != "jl-2" {
+		t.Errorf("Expected jl-2 selected, got %s", m.jumpList.SelectedID())
+	}
+	press("1")
+	if m.showJumpList || !m.showDetails || m.focused != focusDetail || selectedID(m) != "jl-2" {
+		t.Errorf("1 should open jl-2, got %q", selectedID(m))
+	}
+	if m.currentFilter != "all" || !strings.Contains(m.statusMsg, "Cleared filters") {
+		t.Errorf("Jumping to a hidden issue should clear the filter, got %q", m.currentFilter)
+	}
+
+	// From jl-2's details, the list starts on the next issue so enter goes back
+	press("ctrl+o")
+	if m.jumpList.SelectedID() != "jl-3" {
+		t.Errorf("Expected jl-3 preselected, got %s", m.jumpList.SelectedID())
+	}
+	press("enter")
+	if selectedID(m) != "jl-3" {
+		t.Errorf("enter should jump to jl-3, got %s", selectedID(m))
+	}
+
+	if state := m.uiState(); recentIDs(state.Recent) != "jl-2 jl-3" {
+		t.Errorf("Recent issues should be saved with the layout, got %+v", state.Recent)
+	}
+}
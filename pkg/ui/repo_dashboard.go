@@ -0,0 +1,286 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Sort orders of the workspace dashboard, cycled with s
+const (
+	repoSortHealth = iota // Least healthy first
+	repoSortBlocked
+	repoSortReady
+	repoSortVelocity
+	repoSortName
+	repoSorts
+)
+
+var repoSortNames = []string{"health", "blocked", "ready", "velocity", "name"}
+
+// RepoHealth is one workspace repo's row on the dashboard
+type RepoHealth struct {
+	Repo        string
+	Issues      int
+	Open        int
+	Blocked     int
+	Ready       int
+	Velocity    analysis.VelocityMetrics
+	Health      int
+	HealthLevel string
+	TopAlert    *drift.Alert // Most severe alert on one of the repo's issues
+}
+
+// computeRepoHealth scores each repo the way the label dashboard scores a
+// label: the repo's issues are treated as carrying the repo as their only
+// label, so a dependency on another repo's issue counts as incoming flow.
+func computeRepoHealth(issues []model.Issue, repos []string, alerts []drift.Alert, stats *analysis.GraphStats, now time.Time) []RepoHealth {
+	relabeled := make([]model.Issue, len(issues))
+	byID := make(map[string]*model.Issue, len(issues))
+	for i, issue := range issues {
+		issue.Labels = []string{strings.ToLower(ExtractRepoPrefix(issue.ID))}
+		relabeled[i] = issue
+		byID[issue.ID] = &relabeled[i]
+	}
+	hasOpenBlocker := func(issue *model.Issue) bool {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.Type != model.DepBlocks {
+				continue
+			}
+			if blocker, ok := byID[dep.DependsOnID]; ok && blocker.Status != model.StatusClosed {
+				return true
+			}
+		}
+		return false
+	}
+
+	cfg := analysis.DefaultLabelHealthConfig()
+	rows := make([]RepoHealth, 0, len(repos))
+	for _, repo := range repos {
+		lh := analysis.ComputeLabelHealthForLabel(repo, relabeled, cfg, now, stats)
+		row := RepoHealth{
+			Repo:        repo,
+			Issues:      lh.IssueCount,
+			Velocity:    lh.Velocity,
+			Health:      lh.Health,
+			HealthLevel: lh.HealthLevel,
+		}
+		for _, id := range lh.Issues {
+			issue := byID[id]
+			if issue.Status == model.StatusClosed {
+				continue
+			}
+			row.Open++
+			if issue.Status == model.StatusBlocked || hasOpenBlocker(issue) {
+				row.Blocked++
+			} else {
+				row.Ready++
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	severityRank := map[drift.Severity]int{drift.SeverityCritical: 0, drift.SeverityWarning: 1, drift.SeverityInfo: 2}
+	for _, a := range alerts {
+		if a.IssueID == "" {
+			continue // Project-wide alerts belong to no one repo
+		}
+		repo := strings.ToLower(ExtractRepoPrefix(a.IssueID))
+		for i := range rows {
+			if rows[i].Repo != repo {
+				continue
+			}
+			if top := rows[i].TopAlert; top == nil || severityRank[a.Severity] < severityRank[top.Severity] {
+				alert := a
+				rows[i].TopAlert = &alert
+			}
+		}
+	}
+	return rows
+}
+
+// sortRepoHealth orders rows by one of the repoSort orders; ties go by name
+func sortRepoHealth(rows []RepoHealth, order int) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		switch order {
+		case repoSortHealth:
+			if a.Health != b.Health {
+				return a.Health < b.Health
+			}
+		case repoSortBlocked:
+			if a.Blocked != b.Blocked {
+				return a.Blocked > b.Blocked
+			}
+		case repoSortReady:
+			if a.Ready != b.Ready {
+				return a.Ready > b.Ready
+			}
+		case repoSortVelocity:
+			if a.Velocity.ClosedLast30Days != b.Velocity.ClosedLast30Days {
+				return a.Velocity.ClosedLast30Days > b.Velocity.ClosedLast30Days
+			}
+		}
+		return a.Repo < b.Repo
+	})
+}
+
+// openRepoDashboard opens the per-repo health comparison (workspace mode)
+func (m *Model) openRepoDashboard() {
+	if !m.workspaceMode || len(m.availableRepos) == 0 {
+		m.statusMsg = "Repo dashboard available only in workspace mode"
+		m.statusIsError = false
+		return
+	}
+	m.repoDashCursor = 0
+	m.refreshRepoDashboard()
+	m.showRepoDashboard = true
+}
+
+// refreshRepoDashboard recomputes the rows after a reload or once alerts
+// are in, keeping the cursor on the same repo
+func (m *Model) refreshRepoDashboard() {
+	selected := ""
+	if m.repoDashCursor < len(m.repoHealth) {
+		selected = m.repoHealth[m.repoDashCursor].Repo
+	}
+	m.repoHealth = computeRepoHealth(m.issues, m.availableRepos, m.activeAlerts(), m.analysis, time.Now())
+	sortRepoHealth(m.repoHealth, m.repoDashSort)
+	m.repoDashCursor = 0
+	for i, row := range m.repoHealth {
+		if row.Repo == selected {
+			m.repoDashCursor = i
+		}
+	}
+}
+
+// handleRepoDashboardKeys handles keys while the repo dashboard is open.
+// Enter narrows the workspace to the selected repo.
+func (m Model) handleRepoDashboardKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.repoDashCursor < len(m.repoHealth)-1 {
+			m.repoDashCursor++
+		}
+	case "k", "up":
+		if m.repoDashCursor > 0 {
+			m.repoDashCursor--
+		}
+	case "s":
+		m.repoDashSort = (m.repoDashSort + 1) % repoSorts
+		m.refreshRepoDashboard()
+		m.statusMsg = "Repos sorted by " + repoSortNames[m.repoDashSort]
+		m.statusIsError = false
+	case "enter":
+		if m.repoDashCursor >= len(m.repoHealth) {
+			break
+		}
+		repo := m.repoHealth[m.repoDashCursor].Repo
+		m.activeRepos = map[string]bool{repo: true}
+		if m.activeRecipe != nil {
+			m.applyRecipe(m.activeRecipe)
+		} else {
+			m.applyFilter()
+		}
+		m.statusMsg = "Repo filter: " + repo
+		m.statusIsError = false
+		m.showRepoDashboard = false
+		m.focused = focusList
+	case "esc", "q", "ctrl+w":
+		m.showRepoDashboard = false
+	}
+	return m
+}
+
+// renderRepoDashboard renders one row per workspace repo: open, blocked and
+// ready counts, velocity, health and the repo's most severe alert
+func (m Model) renderRepoDashboard() string {
+	t := m.theme
+
+	boxWidth := min(110, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	headStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("▦ Workspace Repos"))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("%d repos • sorted by %s", len(m.repoHealth), repoSortNames[m.repoDashSort])))
+	sb.WriteString("\n\n")
+
+	sb.WriteString(headStyle.Render(fmt.Sprintf("  %-12s %5s %7s %5s  %-12s %-14s %s", "Repo", "Open", "Blocked", "Ready", "Closed 7/30d", "Health", "Top alert")))
+	sb.WriteString("\n")
+	visible := max(3, m.height-12)
+	start := 0
+	if m.repoDashCursor >= visible {
+		start = m.repoDashCursor - visible + 1
+	}
+	end := min(len(m.repoHealth), start+visible)
+	alertWidth := max(10, boxWidth-72)
+	for i := start; i < end; i++ {
+		row := m.repoHealth[i]
+		cursor, style := "  ", textStyle
+		if i == m.repoDashCursor {
+			cursor, style = "▸ ", style.Bold(true).Foreground(t.Primary)
+		}
+		sb.WriteString(style.Render(fmt.Sprintf("%s%-12s %5d %7d %5d  %-12s ",
+			cursor, truncateRunesHelper(row.Repo, 12, "…"), row.Open, row.Blocked, row.Ready,
+			fmt.Sprintf("%d/%d", row.Velocity.ClosedLast7Days, row.Velocity.ClosedLast30Days))))
+		sb.WriteString(m.renderRepoHealthBar(row))
+		sb.WriteString(" ")
+		if row.TopAlert != nil {
+			alertStyle, icon := m.alertSeverityStyle(row.TopAlert.Severity)
+			sb.WriteString(alertStyle.Render(icon + " " + truncateRunesHelper(row.TopAlert.Message, alertWidth, "…")))
+		} else {
+			sb.WriteString(mutedStyle.Render("—"))
+		}
+		sb.WriteString("\n")
+	}
+	if end < len(m.repoHealth) {
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(m.repoHealth)-end)))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: move • s: sort • Enter: show this repo • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
+
+// renderRepoHealthBar renders the health score and a bar colored by level
+func (m Model) renderRepoHealthBar(row RepoHealth) string {
+	const barWidth = 10
+	filled := max(0, min(barWidth, row.Health*barWidth/100))
+	style := m.theme.Base
+	switch row.HealthLevel {
+	case analysis.HealthLevelHealthy:
+		style = style.Foreground(m.theme.Open)
+	case analysis.HealthLevelWarning:
+		style = style.Foreground(m.theme.Feature)
+	default:
+		style = style.Foreground(m.theme.Blocked)
+	}
+	return fmt.Sprintf("%3d ", row.Health) + style.Render(strings.Repeat("█", filled)+strings.Repeat("░", barWidth-filled))
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestComputeRepoHealth(t *testing.T) {
+	now := time.Now()
+	closed := now.Add(-48 * time.Hour)
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now},
+		{ID: "api-2", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now, Dependencies: []*model.Dependency{
+			{IssueID: "api-2", DependsOnID: "web-1", Type: model.DepBlocks},
+		}},
+		{ID: "api-3", Status: model.StatusClosed, CreatedAt: closed, UpdatedAt: closed, ClosedAt: &closed},
+		{ID: "web-1", Status: model.StatusInProgress, CreatedAt: now, UpdatedAt: now},
+		{ID: "web-2", Status: model.StatusBlocked, CreatedAt: now, UpdatedAt: now},
+	}
+	alerts := []drift.Alert{
+		{Severity: drift.SeverityInfo, Message: "minor", IssueID: "api-1"},
+		{Severity: drift.SeverityCritical, Message: "stuck", IssueID: "api-2"},
+		{Severity: drift.SeverityWarning, Message: "everywhere"},
+	}
+	rows := computeRepoHealth(issues, []string{"api", "web"}, alerts, nil, now)
+	if len(rows) != 2 {
+		t.Fatalf("Expected a row per repo, got %+v", rows)
+	}
+	api, web := rows[0], rows[1]
+	if api.Open != 2 || api.Blocked != 1 || api.Ready != 1 || api.Velocity.ClosedLast7Days != 1 {
+		t.Errorf("api: expected 2 open, 1 blocked by web, 1 ready, 1 closed this week, got %+v", api)
+	}
+	if api.TopAlert == nil || api.TopAlert.Message != "stuck" {
+		t.Errorf("Expected api's critical alert on top, got %+v", api.TopAlert)
+	}
+	if web.Open != 2 || web.Blocked != 1 || web.Ready != 1 || web.TopAlert != nil {
+		t.Errorf("web: expected 2 open, 1 blocked, 1 ready, no alert, got %+v", web)
+	}
+
+	sortRepoHealth(rows, repoSortBlocked)
+	if rows[0].Repo != "api" {
+		t.Errorf("Expected ties on blocked to go by name, got %s first", rows[0].Repo)
+	}
+}
+
+func TestModel_RepoDashboard(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "api-1", Title: "API", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "web-1", Title: "Web", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "web-2", Title: "Web too", Status: model.StatusOpen, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	m = press(m, "ctrl+w")
+	if m.showRepoDashboard {
+		t.Fatal("Expected no dashboard outside workspace mode")
+	}
+
+	m.EnableWorkspaceMode(WorkspaceInfo{Enabled: true, RepoCount: 2, RepoPrefixes: []string{"api-", "web-"}})
+	m = press(m, "ctrl+w")
+	if !m.showRepoDashboard || len(m.repoHealth) != 2 {
+		t.Fatalf("Expected ctrl+w to list both repos, got %+v", m.repoHealth)
+	}
+	if view := m.View(); !strings.Contains(view, "Workspace Repos") || !strings.Contains(view, "web") {
+		t.Error("Expected the dashboard to render both repos")
+	}
+
+	m = press(m, "s", "s", "s", "s")
+	if m.repoDashSort != repoSortName || m.repoHealth[0].Repo != "api" {
+		t.Fatalf("Expected the name sort with api first, got sort %d", m.repoDashSort)
+	}
+	m = press(m, "j", "enter")
+	if m.showRepoDashboard || len(m.activeRepos) != 1 || !m.activeRepos["web"] {
+		t.Fatalf("Expected enter to filter to web, got %v", m.activeRepos)
+	}
+	if n := len(m.list.Items()); n != 2 {
+		t.Errorf("Expected web's 2 issues listed, got %d", n)
+	}
+}
@@ -0,0 +1,299 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	scrubMaxRevisions = 1000                   // Commits read from git log
+	scrubMaxFrames    = 52                     // A year of weekly samples
+	scrubPlayInterval = 400 * time.Millisecond // Time per frame while playing
+)
+
+// ScrubFrame is the backlog's health at one sampled commit
+type ScrubFrame struct {
+	SHA        string
+	At         time.Time
+	Message    string
+	Total      int
+	Open       int
+	Closed     int
+	Blocked    int
+	Actionable int
+	Edges      int
+	Density    float64
+	Cycles     int
+}
+
+// ScrubberLoadedMsg is sent when the scrubber's frames are computed
+type ScrubberLoadedMsg struct {
+	Frames []ScrubFrame
+	Error  error
+}
+
+// scrubTickMsg advances the scrubber while it plays
+type scrubTickMsg struct{}
+
+func scrubTickCmd() tea.Cmd {
+	return tea.Tick(scrubPlayInterval, func(time.Time) tea.Msg {
+		return scrubTickMsg{}
+	})
+}
+
+// LoadScrubberCmd samples the commits that touched the beads file, one per
+// week, and computes counts and graph stats at each
+func LoadScrubberCmd(beadsPath string) tea.Cmd {
+	return func() tea.Msg {
+		repoPath := projectDirFromBeadsPath(beadsPath)
+		if repoPath == "" {
+			return ScrubberLoadedMsg{Error: fmt.Errorf("unable to determine repository root")}
+		}
+		gitLoader := loader.NewGitLoader(repoPath)
+		revs, err := gitLoader.ListRevisions(scrubMaxRevisions)
+		if err != nil {
+			return ScrubberLoadedMsg{Error: err}
+		}
+
+		var frames []ScrubFrame
+		for _, rev := range sampleWeekly(revs, scrubMaxFrames) {
+			issues, err := gitLoader.LoadAt(rev.SHA)
+			if err != nil {
+				continue // A commit with an unreadable beads file is skipped
+			}
+			snap := analysis.NewSnapshotAt(issues, rev.Timestamp, rev.SHA)
+			frame := ScrubFrame{
+				SHA:        rev.SHA,
+				At:         rev.Timestamp,
+				Message:    rev.Message,
+				Total:      snap.TotalCount,
+				Open:       snap.OpenCount,
+				Closed:     snap.ClosedCount,
+				Blocked:    snap.BlockedCount,
+				Actionable: len(analysis.NewAnalyzer(issues).GetActionableIssues()),
+				Density:    snap.Stats.Density,
+				Cycles:     len(snap.Stats.Cycles()),
+			}
+			for _, n := range snap.Stats.OutDegree {
+				frame.Edges += n
+			}
+			frames = append(frames, frame)
+		}
+		return ScrubberLoadedMsg{Frames: frames}
+	}
+}
+
+// sampleWeekly keeps the last commit of each week from revs (newest first,
+// as git log lists them), at most limit of the most recent weeks, and
+// returns them oldest first
+func sampleWeekly(revs []loader.RevisionInfo, limit int) []loader.RevisionInfo {
+	var samples []loader.RevisionInfo
+	seen := make(map[string]bool)
+	for _, rev := range revs {
+		year, week := rev.Timestamp.ISOWeek()
+		key := fmt.Sprintf("%d-%d", year, week)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		samples = append(samples, rev)
+		if len(samples) == limit {
+			break
+		}
+	}
+	for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+		samples[i], samples[j] = samples[j], samples[i]
+	}
+	return samples
+}
+
+// openScrubber starts loading the history scrubber
+func (m *Model) openScrubber() tea.Cmd {
+	if m.blockNoGit("History scrubber") {
+		return nil
+	}
+	m.showScrubber = true
+	m.scrubPlaying = false
+	if m.scrubFrames != nil {
+		return nil // Already loaded; history only grows on reload
+	}
+	m.scrubLoading = true
+	return LoadScrubberCmd(m.beadsPath)
+}
+
+// handleScrubberLoaded stores the frames and starts at the newest
+func (m *Model) handleScrubberLoaded(msg ScrubberLoadedMsg) {
+	m.scrubLoading = false
+	if msg.Error != nil {
+		m.showScrubber = false
+		m.statusMsg = fmt.Sprintf("❌ History scrubber failed: %v", msg.Error)
+		m.statusIsError = true
+		return
+	}
+	if len(msg.Frames) == 0 {
+		m.showScrubber = false
+		m.statusMsg = "No beads history to scrub: the beads file has no commits yet"
+		m.statusIsError = false
+		return
+	}
+	m.scrubFrames = msg.Frames
+	m.scrubIndex = len(msg.Frames) - 1
+}
+
+// handleScrubberKeys handles keys while the history scrubber is open
+func (m Model) handleScrubberKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	last := len(m.scrubFrames) - 1
+	switch msg.String() {
+	case "h", "left":
+		m.scrubPlaying = false
+		if m.scrubIndex > 0 {
+			m.scrubIndex--
+		}
+	case "l", "right":
+		m.scrubPlaying = false
+		if m.scrubIndex < last {
+			m.scrubIndex++
+		}
+	case "home", "g":
+		m.scrubPlaying = false
+		m.scrubIndex = 0
+	case "end", "G":
+		m.scrubPlaying = false
+		m.scrubIndex = max(0, last)
+	case " ", "space", "p":
+		if m.scrubPlaying || last < 1 {
+			m.scrubPlaying = false
+			return m, nil
+		}
+		if m.scrubIndex == last {
+			m.scrubIndex = 0 // Replay from the start
+		}
+		m.scrubPlaying = true
+		return m, scrubTickCmd()
+	case "enter":
+		// Time-travel to the frame shown
+		if m.scrubIndex <= last {
+			m.showScrubber = false
+			m.scrubPlaying = false
+			if m.timeTravelMode {
+				m.exitTimeTravelMode()
+			}
+			m.enterTimeTravelMode(m.scrubFrames[m.scrubIndex].SHA)
+		}
+	case "esc", "q", "Z":
+		m.showScrubber = false
+		m.scrubPlaying = false
+	}
+	return m, nil
+}
+
+// handleScrubTick moves one frame on while playing, stopping at the newest
+func (m *Model) handleScrubTick() tea.Cmd {
+	if !m.showScrubber || !m.scrubPlaying {
+		return nil
+	}
+	if m.scrubIndex < len(m.scrubFrames)-1 {
+		m.scrubIndex++
+	}
+	if m.scrubIndex >= len(m.scrubFrames)-1 {
+		m.scrubPlaying = false
+		return nil
+	}
+	return scrubTickCmd()
+}
+
+// renderScrubberPanel renders the frame under the scrubber with a timeline
+func (m Model) renderScrubberPanel() string {
+	t := m.theme
+
+	boxWidth := min(90, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(12)
+	valueStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Base.GetForeground()).Width(8)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("⏱ History Scrubber"))
+	sb.WriteString("\n")
+
+	if m.scrubLoading || len(m.scrubFrames) == 0 {
+		sb.WriteString(mutedStyle.Render("Loading weekly snapshots from git…"))
+		return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+	}
+
+	f := m.scrubFrames[m.scrubIndex]
+	p := f // No change to show on the first frame
+	if m.scrubIndex > 0 {
+		p = m.scrubFrames[m.scrubIndex-1]
+	}
+	state := ""
+	if m.scrubPlaying {
+		state = " ▶"
+	}
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf("%s • %s • %d of %d%s",
+		f.At.Format("2006-01-02"), f.SHA[:min(7, len(f.SHA))], m.scrubIndex+1, len(m.scrubFrames), state)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render(truncateRunesHelper(f.Message, boxWidth-6, "…")))
+	sb.WriteString("\n\n")
+
+	// Open issues over time, with the current frame marked beneath
+	values := make([]int, len(m.scrubFrames))
+	maxOpen := 0
+	for i, fr := range m.scrubFrames {
+		values[i] = fr.Open
+		maxOpen = max(maxOpen, fr.Open)
+	}
+	sb.WriteString(labelStyle.Render("Open trend"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(buildSparkline(values, maxOpen)))
+	sb.WriteString("\n")
+	sb.WriteString(labelStyle.Render(""))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Render(strings.Repeat(" ", m.scrubIndex) + "▲"))
+	sb.WriteString("\n\n")
+
+	row := func(label string, cur, before int, lowerIsBetter bool) {
+		sb.WriteString(labelStyle.Render(label))
+		sb.WriteString(valueStyle.Render(fmt.Sprintf("%d", cur)))
+		if cur != before {
+			style := t.Renderer.NewStyle().Foreground(t.Open)
+			if (cur > before) == lowerIsBetter {
+				style = t.Renderer.NewStyle().Foreground(t.Blocked)
+			}
+			sb.WriteString(style.Render(fmt.Sprintf("%+d", cur-before)))
+		}
+		sb.WriteString("\n")
+	}
+	row("Total", f.Total, p.Total, false)
+	row("Open", f.Open, p.Open, true)
+	row("Actionable", f.Actionable, p.Actionable, false)
+	row("Blocked", f.Blocked, p.Blocked, true)
+	row("Closed", f.Closed, p.Closed, false)
+	row("Dependencies", f.Edges, p.Edges, true)
+	row("Cycles", f.Cycles, p.Cycles, true)
+	sb.WriteString(labelStyle.Render("Density"))
+	sb.WriteString(valueStyle.Render(fmt.Sprintf("%.3f", f.Density)))
+	sb.WriteString("\n\n")
+
+	sb.WriteString(mutedStyle.Italic(true).Render("←/→: step a week • Space: play/pause • Home/End: first/last • Enter: time-travel here • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSampleWeekly(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 12, 0, 0, 0, time.UTC) }
+	// Newest first, as git log lists them; 3-5 March are one ISO week
+	revs := []loader.RevisionInfo{
+		{SHA: "e", Timestamp: day(18)},
+		{SHA: "d", Timestamp: day(12)},
+		{SHA: "c", Timestamp: day(11)},
+		{SHA: "b", Timestamp: day(5)},
+		{SHA: "a", Timestamp: day(3)},
+	}
+	var got []string
+	for _, r := range sampleWeekly(revs, 10) {
+		got = append(got, r.SHA)
+	}
+	if strings.Join(got, ",") != "b,d,e" {
+		t.Errorf("Expected the last commit of each week, oldest first, got %v", got)
+	}
+	got = nil
+	for _, r := range sampleWeekly(revs, 2) {
+		got = append(got, r.SHA)
+	}
+	if strings.Join(got, ",") != "d,e" {
+		t.Errorf("Expected the limit to keep the most recent weeks, got %v", got)
+	}
+}
+
+func TestModel_HistoryScrubber(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	git := func(date string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(date, content string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git(date, "add", ".")
+		git(date, "commit", "-q", "-m", "Update beads "+date)
+	}
+	git("", "init", "-q")
+	git("", "config", "user.email", "test@test.com")
+	git("", "config", "user.name", "Test User")
+	commit("2025-03-03T10:00:00Z", `{"id":"A","title":"A","status":"open","issue_type":"task"}`+"\n")
+	commit("2025-03-10T10:00:00Z", `{"id":"A","title":"A","status":"open","issue_type":"task"}`+"\n"+
+		`{"id":"B","title":"B","status":"open","issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}`+"\n")
+	commit("2025-03-17T10:00:00Z", `{"id":"A","title":"A","status":"closed","issue_type":"task"}`+"\n"+
+		`{"id":"B","title":"B","status":"open","issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}`+"\n")
+
+	m := NewModel([]model.Issue{{ID: "A", Title: "A", Status: model.StatusClosed}}, nil, beadsPath)
+	m.gitErr = nil
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	cmd := m.openScrubber()
+	if !m.showScrubber || !m.scrubLoading || cmd == nil {
+		t.Fatal("Expected Z to open the scrubber and start loading")
+	}
+	m.handleScrubberLoaded(cmd().(ScrubberLoadedMsg))
+	if len(m.scrubFrames) != 3 || m.scrubIndex != 2 {
+		t.Fatalf("Expected three weekly frames starting at the newest, got %d at %d", len(m.scrubFrames), m.scrubIndex)
+	}
+	if f := m.scrubFrames[1]; f.Total != 2 || f.Open != 2 || f.Actionable != 1 || f.Edges != 1 {
+		t.Errorf("Expected week two to have 2 open issues, 1 actionable, 1 dependency, got %+v", f)
+	}
+	if f := m.scrubFrames[2]; f.Closed != 1 || f.Actionable != 1 {
+		t.Errorf("Expected week three to have A closed and B actionable, got %+v", f)
+	}
+
+	m = press(m, "left")
+	if m.scrubIndex != 1 || !strings.Contains(m.View(), "2025-03-10") {
+		t.Errorf("Expected left to step back a week, got frame %d", m.scrubIndex)
+	}
+
+	m, playCmd := m.handleScrubberKeys(keyMsgFromString(" "))
+	if !m.scrubPlaying || playCmd == nil {
+		t.Fatal("Expected space to start playing")
+	}
+	if next := m.handleScrubTick(); next != nil || m.scrubIndex != 2 || m.scrubPlaying {
+		t.Errorf("Expected play to advance to the newest week and stop, got frame %d playing=%v", m.scrubIndex, m.scrubPlaying)
+	}
+
+	m = press(m, "home", "enter")
+	if m.showScrubber || !m.timeTravelMode || m.getDiffStatus("A") != DiffStatusClosed {
+		t.Errorf("Expected enter to time-travel to the first week, got mode=%v status=%q", m.timeTravelMode, m.statusMsg)
+	}
+}
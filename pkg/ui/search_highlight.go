@@ -0,0 +1,266 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/query"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Reverse video marks matches in text that is already styled (glamour output)
+const (
+	highlightOn  = "\x1b[7m"
+	highlightOff = "\x1b[27m"
+)
+
+// highlightTerms returns the lowercased words to highlight for a `/` filter:
+// a query's text terms, or the words of plain search text
+func highlightTerms(filter string) []string {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil
+	}
+	if query.LooksLikeQuery(filter) {
+		q, err := query.Parse(filter)
+		if err != nil {
+			return nil
+		}
+		return q.TextTerms()
+	}
+	var terms []string
+	for _, w := range strings.Fields(strings.ToLower(filter)) {
+		if utf8.RuneCountInString(w) >= 2 {
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
+
+// searchHighlightTerms returns the terms of the active `/` filter, or nil
+// when the list isn't filtered
+func (m Model) searchHighlightTerms() []string {
+	if m.list.FilterState() == list.Unfiltered {
+		return nil
+	}
+	return highlightTerms(m.list.FilterValue())
+}
+
+// matchedRunes marks the runes of text inside an occurrence of any term,
+// ignoring case
+func matchedRunes(text string, terms []string) []bool {
+	runes := []rune(text)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	marks := make([]bool, len(runes))
+	for _, term := range terms {
+		tr := []rune(term)
+		if len(tr) == 0 {
+			continue
+		}
+		for i := 0; i+len(tr) <= len(runes); i++ {
+			if string(runes[i:i+len(tr)]) == string(tr) {
+				for j := i; j < i+len(tr); j++ {
+					marks[j] = true
+				}
+			}
+		}
+	}
+	return marks
+}
+
+// anyMarked reports whether any rune is marked
+func anyMarked(marks []bool) bool {
+	for _, m := range marks {
+		if m {
+			return true
+		}
+	}
+	return false
+}
+
+// renderHighlighted renders text in base, with the marked runes in hl
+func renderHighlighted(text string, marks []bool, base, hl lipgloss.Style) string {
+	if !anyMarked(marks) {
+		return base.Render(text)
+	}
+	var sb strings.Builder
+	var run []rune
+	runMarked := false
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runMarked {
+			sb.WriteString(hl.Render(string(run)))
+		} else {
+			sb.WriteString(base.Render(string(run)))
+		}
+		run = run[:0]
+	}
+	for i, r := range []rune(text) {
+		marked := i < len(marks) && marks[i]
+		if marked != runMarked {
+			flush()
+			runMarked = marked
+		}
+		run = append(run, r)
+	}
+	flush()
+	return sb.String()
+}
+
+// highlightANSI marks occurrences of terms in already styled text. Matching
+// runs on the visible text, so a term that glamour split with escape
+// sequences is still found.
+func highlightANSI(s string, terms []string) string {
+	if len(terms) == 0 || s == "" {
+		return s
+	}
+	type piece struct {
+		esc string // Escape sequence, or empty for a visible rune
+		r   rune
+	}
+	var pieces []piece
+	var visible []rune
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '[' {
+			j := i + 2
+			for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+				j++
+			}
+			if j < len(s) {
+				j++
+			}
+			pieces = append(pieces, piece{esc: s[i:j]})
+			i = j
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		pieces = append(pieces, piece{r: r})
+		visible = append(visible, r)
+		i += size
+	}
+
+	marks := matchedRunes(string(visible), terms)
+	if !anyMarked(marks) {
+		return s
+	}
+	var sb strings.Builder
+	on := false
+	v := 0
+	for _, p := range pieces {
+		if p.esc != "" {
+			sb.WriteString(p.esc)
+			if on {
+				// The sequence may have been a reset
+				sb.WriteString(highlightOn)
+			}
+			continue
+		}
+		if marks[v] != on {
+			on = marks[v]
+			if on {
+				sb.WriteString(highlightOn)
+			} else {
+				sb.WriteString(highlightOff)
+			}
+		}
+		sb.WriteRune(p.r)
+		v++
+	}
+	if on {
+		sb.WriteString(highlightOff)
+	}
+	return sb.String()
+}
+
+// rowHighlight works out what to highlight in a list row's title while a
+// filter is active: the fuzzy matcher's runes, or literal occurrences of the
+// query/semantic terms. When the title shows no match, snippet is the
+// passage that did match (if one matches literally).
+func rowHighlight(issue *model.Issue, l list.Model, index, width int) (marks []bool, snippet string, terms []string) {
+	if l.FilterState() == list.Unfiltered {
+		return nil, "", nil
+	}
+	terms = highlightTerms(l.FilterValue())
+	titleLen := utf8.RuneCountInString(issue.Title)
+	if matches := l.MatchesForItem(index); len(matches) > 0 {
+		// Fuzzy matches index into FilterValue(), which starts with the title
+		marks = make([]bool, titleLen)
+		for _, at := range matches {
+			if at < titleLen {
+				marks[at] = true
+			}
+		}
+	} else {
+		marks = matchedRunes(issue.Title, terms)
+	}
+	if !anyMarked(marks) && len(terms) > 0 {
+		if s, ok := search.BestSnippet(issue, terms, width); ok {
+			snippet = s.Text
+		}
+	}
+	return marks, snippet, terms
+}
+
+// semanticSnippetTimeout bounds embedding the passages of the open issue
+const semanticSnippetTimeout = 300 * time.Millisecond
+
+// matchedSnippet returns the passage of issue that best explains why it
+// matched the active filter. In semantic mode the passages are ranked by
+// embedding similarity, since a semantic hit may share no words with the
+// query; otherwise by the terms they contain. ok is false when the title
+// already shows the match or nothing fits.
+func (m Model) matchedSnippet(issue *model.Issue, terms []string) (search.Snippet, bool) {
+	const width = 160
+	filter := strings.TrimSpace(m.list.FilterValue())
+	semantic := m.semanticSearchEnabled && m.semanticSearch != nil && !query.LooksLikeQuery(filter)
+	if !semantic {
+		if anyMarked(matchedRunes(issue.Title, terms)) {
+			return search.Snippet{}, false
+		}
+		return search.BestSnippet(issue, terms, width)
+	}
+
+	snap := m.semanticSearch.Snapshot()
+	passages := search.Passages(issue)
+	if !snap.Ready || snap.Embedder == nil || len(passages) == 0 {
+		return search.BestSnippet(issue, terms, width)
+	}
+	const maxPassages = 64
+	if len(passages) > maxPassages {
+		passages = passages[:maxPassages]
+	}
+	texts := make([]string, 0, len(passages)+1)
+	texts = append(texts, filter)
+	for _, p := range passages {
+		texts = append(texts, p.Text)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), semanticSnippetTimeout)
+	defer cancel()
+	vecs, err := snap.Embedder.Embed(ctx, texts)
+	if err != nil || len(vecs) != len(texts) {
+		return search.BestSnippet(issue, terms, width)
+	}
+	best, bestScore := -1, 0.0
+	for i := range passages {
+		if score := dotFloat32(vecs[0], vecs[i+1]); best < 0 || score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if bestScore <= 0 {
+		return search.BestSnippet(issue, terms, width)
+	}
+	snippet := passages[best]
+	snippet.Text = search.TrimAround(snippet.Text, 0, width)
+	return snippet, true
+}
@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHighlightTerms(t *testing.T) {
+	cases := map[string]string{
+		"":                           "",
+		"Login Fix":                  "login|fix",
+		"a bug":                      "bug",
+		"status:open AND title:auth": "auth",
+		"comment:regression NOT wip": "regression",
+		"status:open AND (":          "",
+	}
+	for filter, want := range cases {
+		if got := strings.Join(highlightTerms(filter), "|"); got != want {
+			t.Errorf("highlightTerms(%q) = %q, want %q", filter, got, want)
+		}
+	}
+}
+
+func TestMatchedRunes(t *testing.T) {
+	marks := matchedRunes("Fix LOGIN on login page", []string{"login"})
+	var got strings.Builder
+	for _, m := range marks {
+		if m {
+			got.WriteByte('^')
+		} else {
+			got.WriteByte(' ')
+		}
+	}
+	if want := "    ^^^^^    ^^^^^     "; got.String() != want {
+		t.Errorf("marks = %q, want %q", got.String(), want)
+	}
+}
+
+func TestHighlightANSI(t *testing.T) {
+	// glamour splits words with escape sequences; matches must still be found
+	styled := "\x1b[1mFix lo\x1b[0m\x1b[1mgin\x1b[0m now"
+	got := highlightANSI(styled, []string{"login"})
+	want := "\x1b[1mFix " + highlightOn + "lo\x1b[0m" + highlightOn + "\x1b[1m" + highlightOn + "gin\x1b[0m" + highlightOn + highlightOff + " now"
+	if got != want {
+		t.Errorf("highlightANSI =\n%q\nwant\n%q", got, want)
+	}
+	if got := highlightANSI(styled, []string{"missing"}); got != styled {
+		t.Errorf("No match should leave text unchanged, got %q", got)
+	}
+}
+
+func TestModel_SearchHighlightDetailSnippet(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "hl-1", Title: "Crash on startup", Status: model.StatusOpen, IssueType: model.TypeBug,
+			Notes: "Regressed by the splash screen change"},
+		{ID: "hl-2", Title: "Splash screen redesign", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	m.list.SetFilterText("notes:splash")
+	if m.list.FilterState() == list.Unfiltered {
+		t.Fatal("Expected an active filter")
+	}
+	m.list.Select(0)
+	item, _ := m.list.SelectedItem().(IssueItem)
+	if item.Issue.ID != "hl-1" {
+		t.Fatalf("Expected hl-1 to match, got %q", item.Issue.ID)
+	}
+	snippet, ok := m.matchedSnippet(&item.Issue, m.searchHighlightTerms())
+	if !ok || snippet.Field != "notes" || !strings.Contains(snippet.Text, "splash") {
+		t.Errorf("Expected the notes passage as snippet, got %+v", snippet)
+	}
+
+	// The title already shows a title match, so no snippet
+	other := issues[1]
+	if _, ok := m.matchedSnippet(&other, []string{"splash"}); ok {
+		t.Error("A title match should not add a snippet")
+	}
+}
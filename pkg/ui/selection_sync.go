@@ -0,0 +1,72 @@
+package ui
+
+// selectionView names the view whose cursor currently picks the issue
+func (m Model) selectionView() string {
+	switch {
+	case m.focused == focusInsights:
+		return "insights"
+	case m.isBoardView:
+		return "board"
+	case m.isGraphView:
+		return "graph"
+	case m.isActionableView:
+		return "actionable"
+	case m.isTimelineView:
+		return "timeline"
+	case m.isHeatmapView:
+		return "heatmap"
+	default:
+		return "list"
+	}
+}
+
+// currentIssueID returns the issue selected in the active view, or ""
+func (m Model) currentIssueID() string {
+	switch m.selectionView() {
+	case "insights":
+		return m.insightsPanel.SelectedIssueID()
+	case "board":
+		if issue := m.board.SelectedIssue(); issue != nil {
+			return issue.ID
+		}
+	case "graph":
+		if issue := m.graphView.SelectedIssue(); issue != nil {
+			return issue.ID
+		}
+	case "actionable":
+		return m.actionableView.SelectedIssueID()
+	case "timeline":
+		return m.timelineView.SelectedIssueID()
+	case "heatmap":
+		return m.heatmapView.SelectedIssueID()
+	default:
+		return m.selectedListID()
+	}
+	return ""
+}
+
+// selectedListID returns the issue under the list cursor, or ""
+func (m Model) selectedListID() string {
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		return item.Issue.ID
+	}
+	return ""
+}
+
+// syncSelection selects id in the list and in the active view, so switching
+// views keeps the same issue in focus. Views that don't show id (filtered out,
+// not on an insights card) keep their own cursor.
+func (m *Model) syncSelection(id string) {
+	if idx := m.listIndexOf(id); idx >= 0 && idx != m.list.Index() {
+		m.list.Select(idx)
+		m.updateViewportContent()
+	}
+	switch m.selectionView() {
+	case "board":
+		m.board.SelectIssueByID(id)
+	case "graph":
+		m.graphView.SelectIssueByID(id)
+	case "insights":
+		m.insightsPanel.SelectIssueByID(id)
+	}
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_SelectionFollowsViewSwitches(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "ss-1", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 1, CreatedAt: now},
+		{ID: "ss-2", Title: "Two", Status: model.StatusInProgress, IssueType: model.TypeTask, Priority: 2, CreatedAt: now},
+		{ID: "ss-3", Title: "Three", Status: model.StatusBlocked, IssueType: model.TypeTask, Priority: 2, CreatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "ss-3", DependsOnID: "ss-1", Type: model.DepBlocks}}},
+		{ID: "ss-4", Title: "Four", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 3, CreatedAt: now},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	m.list.Select(m.listIndexOf("ss-3"))
+	press("b")
+	if got := m.board.SelectedIssue(); got == nil || got.ID != "ss-3" {
+		t.Fatalf("Board should open on ss-3, got %+v", got)
+	}
+
+	// Move on the board, then switch to the graph and back to the list
+	press("h")
+	moved := m.board.SelectedIssue().ID
+	if moved == "ss-3" {
+		t.Fatal("Setup: moving left should change the board selection")
+	}
+	press("g")
+	if got := m.graphView.SelectedIssue(); got == nil || got.ID != moved {
+		t.Errorf("Graph should open on %s, got %+v", moved, got)
+	}
+	press("g")
+	if selectedID(m) != moved {
+		t.Errorf("List should follow the graph to %s, got %s", moved, selectedID(m))
+	}
+
+	// Issues hidden from the list leave its cursor alone
+	press("o")
+	before := selectedID(m)
+	press("b")
+	m.board.SelectIssueByID("ss-2")
+	press("esc")
+	if selectedID(m) != before {
+		t.Errorf("A filtered-out issue shouldn't move the list cursor, got %s", selectedID(m))
+	}
+}
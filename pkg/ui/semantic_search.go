@@ -19,6 +19,7 @@ type semanticSearchSnapshot struct {
 	Ready    bool
 	Index    *search.VectorIndex
 	Embedder search.Embedder
+	Lexical  *search.BM25Index // Fused with the vector ranking when set
 	IDs      []string
 }
 
@@ -48,6 +49,14 @@ func (s *SemanticSearch) SetIndex(idx *search.VectorIndex, embedder search.Embed
 	s.snapshot.Store(snap)
 }
 
+// SetLexical sets the BM25 index whose keyword ranking Filter fuses with
+// the semantic one; nil ranks by similarity alone
+func (s *SemanticSearch) SetLexical(lexical *search.BM25Index) {
+	snap := s.Snapshot()
+	snap.Lexical = lexical
+	s.snapshot.Store(snap)
+}
+
 func (s *SemanticSearch) SetIDs(ids []string) {
 	snap := s.Snapshot()
 	cp := make([]string, len(ids))
@@ -56,8 +65,9 @@ func (s *SemanticSearch) SetIDs(ids []string) {
 	s.snapshot.Store(snap)
 }
 
-// Filter implements list.FilterFunc, returning ranks sorted by semantic similarity.
-// When the semantic index isn't ready it falls back to list.DefaultFilter.
+// Filter implements list.FilterFunc, returning ranks sorted by semantic similarity,
+// fused with BM25 keyword ranks when a lexical index is set so exact matches
+// aren't buried. When the semantic index isn't ready it falls back to list.DefaultFilter.
 func (s *SemanticSearch) Filter(term string, targets []string) []list.Rank {
 	if term == "" {
 		// Preserve existing sort order when the user hasn't entered a query yet.
@@ -82,54 +92,62 @@ func (s *SemanticSearch) Filter(term string, targets []string) []list.Rank {
 	}
 	q := vecs[0]
 
-	type scored struct {
-		index int
-		id    string
-		score float64
-	}
-	scoredItems := make([]scored, 0, len(snap.IDs))
+	indexByID := make(map[string]int, len(snap.IDs))
+	semantic := make([]search.SearchResult, 0, len(snap.IDs))
 	for i, id := range snap.IDs {
 		entry, ok := snap.Index.Get(id)
 		if !ok {
 			continue
 		}
-		scoredItems = append(scoredItems, scored{
-			index: i,
-			id:    id,
-			score: dotFloat32(q, entry.Vector),
-		})
+		indexByID[id] = i
+		semantic = append(semantic, search.SearchResult{IssueID: id, Score: dotFloat32(q, entry.Vector)})
 	}
 
-	sort.Slice(scoredItems, func(i, j int) bool {
-		if scoredItems[i].score == scoredItems[j].score {
-			return scoredItems[i].id < scoredItems[j].id
+	sort.Slice(semantic, func(i, j int) bool {
+		if semantic[i].Score == semantic[j].Score {
+			return semantic[i].IssueID < semantic[j].IssueID
 		}
-		return scoredItems[i].score > scoredItems[j].score
+		return semantic[i].Score > semantic[j].Score
 	})
 
 	limit := 75
-	if len(scoredItems) > limit {
-		scoredItems = scoredItems[:limit]
+	ranked := semantic
+	if snap.Lexical != nil {
+		// Only issues in the list can be ranked
+		var lexical []search.SearchResult
+		for _, r := range snap.Lexical.Search(term, 0) {
+			if _, ok := indexByID[r.IssueID]; ok {
+				lexical = append(lexical, r)
+			}
+		}
+		ranked = search.ReciprocalRankFusion(search.DefaultRRFK, limit, lexical, semantic)
 	}
-	out := make([]list.Rank, 0, len(scoredItems))
-	for _, it := range scoredItems {
-		out = append(out, list.Rank{Index: it.index})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	out := make([]list.Rank, 0, len(ranked))
+	for _, r := range ranked {
+		out = append(out, list.Rank{Index: indexByID[r.IssueID]})
 	}
 	return out
 }
 
 // SemanticIndexReadyMsg is emitted when the semantic index build/update completes.
 type SemanticIndexReadyMsg struct {
-	Embedder  search.Embedder
-	Index     *search.VectorIndex
-	IndexPath string
-	Loaded    bool
-	Stats     search.IndexSyncStats
-	Error     error
+	Embedder    search.Embedder
+	Index       *search.VectorIndex
+	Lexical     *search.BM25Index
+	IndexPath   string
+	Loaded      bool
+	Incremental bool // Synced the index already in memory after a reload
+	Stats       search.IndexSyncStats
+	Error       error
 }
 
 // BuildSemanticIndexCmd builds or updates the semantic index for the given issues.
-func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
+// It stops between embedding batches when ctx is done and reports the issues
+// embedded so far to progress (which may be nil).
+func BuildSemanticIndexCmd(ctx context.Context, issues []model.Issue, progress func(done, total int)) tea.Cmd {
 	return func() tea.Msg {
 		cfg := search.EmbeddingConfigFromEnv()
 		embedder, err := search.NewEmbedderFromConfig(cfg)
@@ -148,11 +166,11 @@ func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
 			return SemanticIndexReadyMsg{Error: err}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		defer cancel()
 
 		docs := search.DocumentsFromIssues(issues)
-		stats, err := search.SyncVectorIndex(ctx, idx, embedder, docs, 64)
+		stats, err := search.SyncVectorIndexWithProgress(ctx, idx, embedder, docs, 64, progress)
 		if err != nil {
 			return SemanticIndexReadyMsg{Error: err}
 		}
@@ -165,6 +183,7 @@ func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
 		return SemanticIndexReadyMsg{
 			Embedder:  embedder,
 			Index:     idx,
+			Lexical:   search.NewBM25Index(issues, nil),
 			IndexPath: indexPath,
 			Loaded:    loaded,
 			Stats:     stats,
@@ -172,6 +191,133 @@ func BuildSemanticIndexCmd(issues []model.Issue) tea.Cmd {
 	}
 }
 
+// UpdateSemanticIndexCmd brings an index that is already loaded up to date
+// after a reload. Only new or changed issues are embedded, and the index is
+// saved back to indexPath only if something changed. Cancellation and
+// progress work as in BuildSemanticIndexCmd.
+func UpdateSemanticIndexCmd(ctx context.Context, idx *search.VectorIndex, embedder search.Embedder, indexPath string, issues []model.Issue, progress func(done, total int)) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		stats, err := search.SyncVectorIndexWithProgress(ctx, idx, embedder, search.DocumentsFromIssues(issues), 64, progress)
+		if err != nil {
+			return SemanticIndexReadyMsg{Error: err}
+		}
+		if stats.Changed() && indexPath != "" {
+			if err := idx.Save(indexPath); err != nil {
+				return SemanticIndexReadyMsg{Error: fmt.Errorf("save semantic index: %w", err)}
+			}
+		}
+		return SemanticIndexReadyMsg{
+			Embedder:    embedder,
+			Index:       idx,
+			Lexical:     search.NewBM25Index(issues, nil),
+			IndexPath:   indexPath,
+			Loaded:      true,
+			Incremental: true,
+			Stats:       stats,
+		}
+	}
+}
+
+// refreshSemanticIndex keeps the semantic index in step with reloaded
+// issues: an index already in memory is synced in place, otherwise it is
+// loaded from disk if semantic search is on
+func (m *Model) refreshSemanticIndex() tea.Cmd {
+	if m.semanticSearch == nil || m.semanticIndexBuilding {
+		return nil
+	}
+	if snap := m.semanticSearch.Snapshot(); snap.Ready {
+		m.semanticIndexBuilding = true
+		ctx, progress := m.ops.start(opSemantic)
+		return tea.Batch(UpdateSemanticIndexCmd(ctx, snap.Index, snap.Embedder, m.semanticIndexPath, m.issues, progress.report), m.ops.tickCmd())
+	}
+	if m.semanticSearchEnabled {
+		m.semanticIndexBuilding = true
+		return m.buildSemanticIndexCmd()
+	}
+	return nil
+}
+
+// buildSemanticIndexCmd starts building the semantic index as a cancellable
+// operation with progress in the footer
+func (m *Model) buildSemanticIndexCmd() tea.Cmd {
+	ctx, progress := m.ops.start(opSemantic)
+	return tea.Batch(BuildSemanticIndexCmd(ctx, m.issues, progress.report), m.ops.tickCmd())
+}
+
+// maxDuplicatePairs caps the possible-duplicate pairs listed in insights
+const maxDuplicatePairs = 50
+
+// SimilarityClustersMsg carries clusters and the closest pairs of similar
+// open issues for insights.
+type SimilarityClustersMsg struct {
+	Clusters   []search.SimilarityCluster
+	Duplicates []search.DuplicatePair
+}
+
+// SimilarityClustersCmd groups the given issues by semantic similarity.
+func SimilarityClustersCmd(idx *search.VectorIndex, ids []string) tea.Cmd {
+	return func() tea.Msg {
+		return SimilarityClustersMsg{
+			Clusters:   search.SimilarityClusters(idx, ids, search.DefaultClusterThreshold, 2),
+			Duplicates: search.DuplicatePairs(idx, ids, search.DefaultClusterThreshold, maxDuplicatePairs),
+		}
+	}
+}
+
+// similarityCandidateIDs returns the open issues to cluster, most recently
+// updated first, capped at search.MaxClusterCandidates
+func (m Model) similarityCandidateIDs() []string {
+	open := make([]*model.Issue, 0, len(m.issues))
+	for i := range m.issues {
+		if m.issues[i].Status != model.StatusClosed {
+			open = append(open, &m.issues[i])
+		}
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		return open[i].UpdatedAt.After(open[j].UpdatedAt)
+	})
+	if len(open) > search.MaxClusterCandidates {
+		open = open[:search.MaxClusterCandidates]
+	}
+	ids := make([]string, len(open))
+	for i, issue := range open {
+		ids[i] = issue.ID
+	}
+	return ids
+}
+
+// restoreSimilarityClusters hands the last computed clusters to a freshly
+// built insights panel
+func (m *Model) restoreSimilarityClusters() {
+	if m.similarityClustersReady {
+		m.insightsPanel.SetClusters(m.similarityClusters)
+		m.insightsPanel.SetDuplicates(m.similarityDuplicates)
+	}
+}
+
+// requestSimilarityClusters refreshes the insights clusters card, building
+// the semantic index first if it isn't ready
+func (m *Model) requestSimilarityClusters() tea.Cmd {
+	if m.semanticSearch == nil {
+		return nil
+	}
+	if snap := m.semanticSearch.Snapshot(); snap.Ready {
+		if !m.similarityClustersReady {
+			m.insightsPanel.SetClustersNote("Grouping similar issues…")
+		}
+		return SimilarityClustersCmd(snap.Index, m.similarityCandidateIDs())
+	}
+	m.insightsPanel.SetClustersNote("Building semantic index…")
+	if m.semanticIndexBuilding {
+		return nil
+	}
+	m.semanticIndexBuilding = true
+	return m.buildSemanticIndexCmd()
+}
+
 func dotFloat32(a, b []float32) float64 {
 	if len(a) != len(b) || len(a) == 0 {
 		return 0
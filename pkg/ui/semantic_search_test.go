@@ -2,9 +2,15 @@ package ui
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // =============================================================================
@@ -411,6 +417,38 @@ func TestSemanticSearchFilterEmbedError(t *testing.T) {
 	_ = ranks
 }
 
+func TestSemanticSearchFilterFusesLexical(t *testing.T) {
+	ss := NewSemanticSearch()
+	idx := search.NewVectorIndex(3)
+	embedder := &mockEmbedder{
+		dim: 3,
+		embedFunc: func(ctx context.Context, texts []string) ([][]float32, error) {
+			return [][]float32{{1.0, 0.0, 0.0}}, nil
+		},
+	}
+	ss.SetIndex(idx, embedder)
+	idx.Upsert("id-1", search.ContentHash{}, []float32{1.0, 0.0, 0.0})
+	idx.Upsert("id-2", search.ContentHash{}, []float32{0.9, 0.1, 0.0})
+	idx.Upsert("id-3", search.ContentHash{}, []float32{0.0, 1.0, 0.0})
+	ss.SetIDs([]string{"id-1", "id-2", "id-3"})
+	targets := []string{"a", "b", "c"}
+
+	if ranks := ss.Filter("SIGSEGV", targets); ranks[0].Index != 0 {
+		t.Fatalf("Without a lexical index id-1 should lead, got %+v", ranks)
+	}
+
+	ss.SetLexical(search.NewBM25Index([]model.Issue{
+		{ID: "id-1", Title: "Flaky tests"},
+		{ID: "id-2", Title: "Intermittent failures"},
+		{ID: "id-3", Title: "SIGSEGV in parser"},
+		{ID: "id-4", Title: "SIGSEGV elsewhere"}, // Not in the list
+	}, nil))
+	ranks := ss.Filter("SIGSEGV", targets)
+	if len(ranks) != 3 || ranks[0].Index != 2 {
+		t.Errorf("Expected the exact keyword match id-3 first, got %+v", ranks)
+	}
+}
+
 // =============================================================================
 // dotFloat32 Tests
 // =============================================================================
@@ -519,6 +557,46 @@ func TestSemanticIndexReadyMsgWithError(t *testing.T) {
 	}
 }
 
+func TestUpdateSemanticIndexCmdEmbedsOnlyChanged(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "Fix login timeout"},
+		{ID: "b", Title: "Write onboarding docs"},
+	}
+	hash := search.NewHashEmbedder(16)
+	var embedded []string
+	embedder := &mockEmbedder{dim: 16, embedFunc: func(ctx context.Context, texts []string) ([][]float32, error) {
+		embedded = append(embedded, texts...)
+		return hash.Embed(ctx, texts)
+	}}
+	idx := search.NewVectorIndex(16)
+	if _, err := search.SyncVectorIndex(context.Background(), idx, embedder, search.DocumentsFromIssues(issues), 8); err != nil {
+		t.Fatal(err)
+	}
+	indexPath := filepath.Join(t.TempDir(), "index.bvvi")
+
+	// Nothing changed: no embedding and nothing written
+	embedded = nil
+	msg := UpdateSemanticIndexCmd(context.Background(), idx, embedder, indexPath, issues, nil)().(SemanticIndexReadyMsg)
+	if msg.Error != nil || !msg.Incremental || msg.Stats.Changed() || len(embedded) != 0 {
+		t.Fatalf("Unchanged reload should be a no-op, got %+v (embedded %v)", msg, embedded)
+	}
+	if _, err := os.Stat(indexPath); !os.IsNotExist(err) {
+		t.Errorf("Unchanged reload should not save the index")
+	}
+
+	// One issue edited: only it is re-embedded, and the index is saved
+	issues[1].Description = "Cover first-day setup"
+	embedded = nil
+	msg = UpdateSemanticIndexCmd(context.Background(), idx, embedder, indexPath, issues, nil)().(SemanticIndexReadyMsg)
+	if msg.Error != nil || msg.Stats.Updated != 1 || len(embedded) != 1 || !strings.Contains(embedded[0], "first-day") {
+		t.Fatalf("Expected only b re-embedded, got %+v (embedded %v)", msg.Stats, embedded)
+	}
+	saved, err := search.LoadVectorIndex(indexPath)
+	if err != nil || saved.Size() != 2 {
+		t.Fatalf("Index should be saved with 2 entries: %v", err)
+	}
+}
+
 // =============================================================================
 // Integration Tests
 // =============================================================================
@@ -666,3 +744,77 @@ func BenchmarkDotFloat32(b *testing.B) {
 		_ = dotFloat32(a, bVec)
 	}
 }
+
+// =============================================================================
+// Similar-issue Clusters
+// =============================================================================
+
+func TestModelSimilarityClustersDrilldown(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "dup-1", Title: "Fix login timeout", Status: model.StatusOpen, IssueType: model.TypeBug},
+		{ID: "dup-2", Title: "Fix login timeout bug", Status: model.StatusInProgress, IssueType: model.TypeBug},
+		{ID: "docs", Title: "Write onboarding docs", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "old", Title: "Fix login timeout", Status: model.StatusClosed, IssueType: model.TypeBug},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 50})
+	m = updated.(Model)
+
+	embedder := search.NewHashEmbedder(64)
+	idx := search.NewVectorIndex(embedder.Dim())
+	if _, err := search.SyncVectorIndex(context.Background(), idx, embedder, search.DocumentsFromIssues(issues), 8); err != nil {
+		t.Fatal(err)
+	}
+	m.semanticSearch.SetIndex(idx, embedder)
+
+	updated, cmd := m.Update(keyMsgFromString("i"))
+	m = updated.(Model)
+	if m.focused != focusInsights || cmd == nil {
+		t.Fatalf("Opening insights should request clusters (focused %v)", m.focused)
+	}
+	msg, ok := cmd().(SimilarityClustersMsg)
+	if !ok {
+		t.Fatal("Expected a SimilarityClustersMsg")
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+
+	// Closed issues are not consolidation candidates
+	if len(m.similarityClusters) != 1 || strings.Join(m.similarityClusters[0].IDs, ",") != "dup-1,dup-2" {
+		t.Fatalf("Clusters = %+v", m.similarityClusters)
+	}
+	m.insightsPanel.focusedPanel = PanelClusters
+	if view := m.insightsPanel.View(); !strings.Contains(view, "Similar (1)") || !strings.Contains(view, "2 issues") {
+		t.Error("Clusters card should show cluster and issue counts")
+	}
+
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press("enter")
+	if m.focused != focusInsights || !m.insightsPanel.clusterOpen {
+		t.Fatal("First enter should drill into the cluster")
+	}
+	press("j")
+	if got := m.insightsPanel.SelectedIssueID(); got != "dup-2" {
+		t.Errorf("Selected member = %s, want dup-2", got)
+	}
+	press("esc")
+	if m.focused != focusInsights || m.insightsPanel.clusterOpen {
+		t.Fatal("Esc should leave the drilldown but stay in insights")
+	}
+	press("enter")
+	press("j")
+	press("enter")
+	if m.focused != focusDetail || selectedID(m) != "dup-2" {
+		t.Errorf("Enter on a member should open it, got focus %v on %s", m.focused, selectedID(m))
+	}
+
+	// A phase 2 refresh rebuilds the panel but keeps the clusters
+	updated, _ = m.Update(Phase2ReadyMsg{Stats: m.analysis})
+	m = updated.(Model)
+	if !m.insightsPanel.clustersReady || len(m.insightsPanel.clusters) != 1 {
+		t.Error("Clusters should survive an insights refresh")
+	}
+}
@@ -97,6 +97,8 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"Ctrl+d/u", "Page down/up"},
 				{"Enter", "View details"},
 				{"Esc", "Back / close"},
+				{":/#", "Go to issue by ID"},
+				{"Ctrl+v", "Jump to pasted ID/URL"},
 			},
 		},
 		{
@@ -109,8 +111,20 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"H", "History view"},
 				{"i", "Insights panel"},
 				{"P", "Sprint dashboard"},
+				{"Y", "Timeline view"},
+				{"D", "Activity heatmap"},
+				{"Ctrl+b", "Baselines / drift"},
+				{"Ctrl+x", "Break dependency cycles"},
+				{"I", "Bottlenecks"},
+				{"Z", "History scrubber"},
+				{"Ctrl+t", "Lead/cycle time"},
+				{"Ctrl+w", "Workspace repos"},
 				{"?", "Help overlay"},
 				{"F2", "Toggle sidebar"},
+				{"F3", "Announce line"},
+				{"F4", "List columns"},
+				{"F5", "Session journal"},
+				{"</>", "Resize split panes"},
 			},
 		},
 		{
@@ -120,6 +134,34 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"h/j/k/l", "Navigate nodes"},
 				{"H/L", "Scroll left/right"},
 				{"PgUp/Dn", "Scroll up/down"},
+				{"c", "Clusters"},
+				{"f", "Focus neighborhood"},
+				{"+/-", "Focus hops"},
+				{"x", "Hide closed"},
+				{"e", "Hide non-blocking"},
+				{"o", "Cycle layout"},
+				{"/", "Jump to node"},
+				{"s/S", "Export SVG/PNG"},
+				{"Enter", "Jump to issue"},
+			},
+		},
+		{
+			title:    "Timeline",
+			contexts: []string{"timeline"},
+			items: []shortcutItem{
+				{"j/k", "Select issue"},
+				{"h/l", "Scroll in time"},
+				{"+/-", "Zoom scale"},
+				{"c", "Critical path only"},
+				{"Enter", "Jump to issue"},
+			},
+		},
+		{
+			title:    "Activity",
+			contexts: []string{"activity"},
+			items: []shortcutItem{
+				{"j/k", "Select stale issue"},
+				{"c", "Created/closed/both"},
 				{"Enter", "Jump to issue"},
 			},
 		},
@@ -131,7 +173,7 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"j/k", "Navigate items"},
 				{"e", "Explanations"},
 				{"x", "Calc details"},
-				{"Enter", "Jump to issue"},
+				{"Enter", "Jump / open cluster"},
 			},
 		},
 		{
@@ -145,12 +187,24 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"c", "Cycle filter"},
 			},
 		},
+		{
+			title:    "Actionable",
+			contexts: []string{"actionable"},
+			items: []shortcutItem{
+				{"j/k", "Select issue"},
+				{"s", "Tracks / work waves"},
+				{"Enter", "Jump to issue"},
+			},
+		},
 		{
 			title:    "Board",
 			contexts: []string{"board"},
 			items: []shortcutItem{
 				{"h/l", "Switch columns"},
 				{"j/k", "Navigate items"},
+				{"Space", "Move card (h/l, Enter)"},
+				{"s", "Cycle swimlanes"},
+				{"J/K", "Next/prev swimlane"},
 				{"Enter", "View details"},
 			},
 		},
@@ -163,6 +217,8 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"r", "Ready (unblocked)"},
 				{"L", "Label picker"},
 				{"/", "Fuzzy search"},
+				{"⌫", "Pop last filter"},
+				{"s/~", "Cycle/reverse sort"},
 			},
 		},
 		{
@@ -170,10 +226,21 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 			contexts: []string{"list", "detail", "split"},
 			items: []shortcutItem{
 				{"t/T", "Time-travel"},
+				{"f", "Field history"},
 				{"E", "Export Markdown"},
 				{"C", "Copy to clipboard"},
 				{"O", "Open in editor"},
+				{"Space", "Mark for bulk"},
+				{"M", "Marked only"},
 				{"R", "Recipe picker"},
+				{"V", "Theme picker"},
+				{"Ctrl+o", "Recently viewed"},
+				{"^", "Pin epic to footer"},
+				{"+/*", "Star / starred only"},
+				{"B/N", "Subscribe / changes"},
+				{"Q", "Ask the backlog"},
+				{"W", "Start/stop work session"},
+				{"m", "Add comment"},
 			},
 		},
 	}
@@ -314,6 +381,10 @@ func ContextFromFocus(f focus) string {
 		return "actionable"
 	case focusLabelDashboard:
 		return "label"
+	case focusTimeline:
+		return "timeline"
+	case focusHeatmap:
+		return "activity"
 	default:
 		return "list"
 	}
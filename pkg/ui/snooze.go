@@ -0,0 +1,272 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// SnoozedFilename is the per-project file under .bv/ listing snoozed issues
+// and when each one comes back. Snoozed issues are hidden from the list,
+// the board and triage sessions until then.
+const SnoozedFilename = "snoozed.yaml"
+
+// snoozeDuration is the snooze used when no date is given
+const snoozeDuration = 7 * 24 * time.Hour
+
+type snoozedFile struct {
+	Issues map[string]time.Time `yaml:"issues,omitempty"`
+}
+
+// SnoozedPath returns the snoozed issues file path for a project
+func SnoozedPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", SnoozedFilename)
+}
+
+// LoadSnoozed reads when each snoozed issue comes back from
+// .bv/snoozed.yaml. Returns nil if the file doesn't exist.
+func LoadSnoozed(projectDir string) (map[string]time.Time, error) {
+	data, err := os.ReadFile(SnoozedPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading snoozed issues: %w", err)
+	}
+	var file snoozedFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing snoozed issues: %w", err)
+	}
+	return file.Issues, nil
+}
+
+// SaveSnoozed writes the snoozed issues to .bv/snoozed.yaml, dropping
+// snoozes that ended before now and removing the file when none are left
+func SaveSnoozed(projectDir string, snoozed map[string]time.Time, now time.Time) error {
+	active := make(map[string]time.Time, len(snoozed))
+	for id, until := range snoozed {
+		if until.After(now) {
+			active[id] = until
+		}
+	}
+	path := SnoozedPath(projectDir)
+	if len(active) == 0 {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing snoozed issues: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating snoozed issues directory: %w", err)
+	}
+	data, err := yaml.Marshal(snoozedFile{Issues: active})
+	if err != nil {
+		return fmt.Errorf("encoding snoozed issues: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing snoozed issues: %w", err)
+	}
+	return nil
+}
+
+// ParseSnoozeUntil turns a relative time ("3d", "2w", "1m") or a date
+// ("2026-11-02") into the moment a snooze ends. Empty means snoozeDuration.
+func ParseSnoozeUntil(s string, now time.Time) (time.Time, error) {
+	if strings.TrimSpace(s) == "" {
+		return now.Add(snoozeDuration), nil
+	}
+	until, err := recipe.ParseDueWithin(s, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("%s is not in the future", s)
+	}
+	return until, nil
+}
+
+// isSnoozed reports whether an issue's snooze is still running
+func (m Model) isSnoozed(id string) bool {
+	until, ok := m.snoozed[id]
+	return ok && until.After(time.Now())
+}
+
+// activeSnoozes counts the snoozes still running at now
+func (m Model) activeSnoozes(now time.Time) int {
+	n := 0
+	for _, until := range m.snoozed {
+		if until.After(now) {
+			n++
+		}
+	}
+	return n
+}
+
+// setSnooze snoozes id until the given time, or wakes it when until is zero,
+// saves the list and refilters the views
+func (m *Model) setSnooze(id string, until time.Time) error {
+	now := time.Now()
+	next := make(map[string]time.Time, len(m.snoozed)+1)
+	for sid, t := range m.snoozed {
+		if sid != id && t.After(now) {
+			next[sid] = t
+		}
+	}
+	if !until.IsZero() {
+		next[id] = until
+	}
+	if err := SaveSnoozed(projectDirFromBeadsPath(m.beadsPath), next, now); err != nil {
+		return err
+	}
+	m.snoozed = next
+	m.applyFilter()
+	return nil
+}
+
+// toggleSnoozeSelected wakes the selected issue if it is snoozed, or asks
+// how long to snooze it for
+func (m *Model) toggleSnoozeSelected() {
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+	id := issueItem.Issue.ID
+	if m.isSnoozed(id) {
+		if err := m.setSnooze(id, time.Time{}); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ %v", err)
+			m.statusIsError = true
+			return
+		}
+		m.statusMsg = fmt.Sprintf("⏰ %s is back", id)
+		m.statusIsError = false
+		return
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "7d, 2w, 1m or 2026-11-02"
+	ti.CharLimit = 40
+	ti.Width = 30
+	ti.Prompt = "💤 Until: "
+	ti.PromptStyle = m.theme.Renderer.NewStyle().Foreground(m.theme.Primary).Bold(true)
+	ti.Focus()
+	m.snoozeInput = ti
+	m.snoozeIssueID = id
+	m.snoozeReturn = m.focused
+	m.showSnoozePrompt = true
+	m.focused = focusSnoozeInput
+}
+
+// closeSnoozePrompt hides the snooze prompt
+func (m *Model) closeSnoozePrompt() {
+	m.showSnoozePrompt = false
+	m.snoozeInput.Blur()
+	m.focused = m.snoozeReturn
+}
+
+// handleSnoozePromptKeys handles keyboard input for the snooze prompt. An
+// unreadable date keeps the prompt open.
+func (m Model) handleSnoozePromptKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc":
+		m.closeSnoozePrompt()
+	case "enter":
+		until, err := ParseSnoozeUntil(m.snoozeInput.Value(), time.Now())
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("❌ Snooze until when? %v", err)
+			m.statusIsError = true
+			return m
+		}
+		if err := m.setSnooze(m.snoozeIssueID, until); err != nil {
+			m.statusMsg = fmt.Sprintf("❌ %v", err)
+			m.statusIsError = true
+			return m
+		}
+		m.closeSnoozePrompt()
+		m.statusMsg = fmt.Sprintf("💤 %s snoozed until %s (x shows snoozed issues)", m.snoozeIssueID, until.Format("Mon Jan 2"))
+		m.statusIsError = false
+	default:
+		m.snoozeInput, _ = m.snoozeInput.Update(msg)
+	}
+	return m
+}
+
+// toggleSnoozedFilter switches the list between the snoozed issues and all
+// issues
+func (m *Model) toggleSnoozedFilter() {
+	if m.currentFilter == "snoozed" {
+		m.currentFilter = "all"
+	} else {
+		m.currentFilter = "snoozed"
+	}
+	m.applyFilter()
+}
+
+// snoozeStage hides snoozed issues, except under the snoozed filter which
+// lists only them
+func (m *Model) snoozeStage() (filterStage, bool) {
+	if m.currentFilter == "snoozed" || m.activeSnoozes(time.Now()) == 0 {
+		return filterStage{}, false
+	}
+	return filterStage{"not snoozed", func(issue *model.Issue) bool {
+		return !m.isSnoozed(issue.ID)
+	}}, true
+}
+
+// renderSnoozePrompt renders the snooze prompt
+func (m Model) renderSnoozePrompt() string {
+	t := m.theme
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3)
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+
+	subtitleStyle := t.Renderer.NewStyle().
+		Foreground(t.Subtext).
+		Italic(true)
+
+	keyStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+
+	textStyle := t.Renderer.NewStyle().
+		Foreground(t.Base.GetForeground())
+
+	subtitle := m.snoozeIssueID
+	if issue, ok := m.issueMap[m.snoozeIssueID]; ok {
+		subtitle += " · " + truncateRunesHelper(issue.Title, 40, "…")
+	}
+
+	content := titleStyle.Render("💤 Snooze Issue") + "\n" +
+		subtitleStyle.Render(subtitle) + "\n\n" +
+		m.snoozeInput.View() + "\n\n" +
+		subtitleStyle.Render("Hidden from the list, board and triage until then") + "\n" +
+		keyStyle.Render("Enter") + textStyle.Render(" snooze (empty: a week) · ") +
+		keyStyle.Render("Esc") + textStyle.Render(" cancel")
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
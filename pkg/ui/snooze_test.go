@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestParseSnoozeUntil(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{"", now.Add(snoozeDuration), false},
+		{"3d", now.AddDate(0, 0, 3), false},
+		{"2W", now.AddDate(0, 0, 14), false},
+		{"2026-06-01", time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local), false},
+		{"2026-05-01", time.Time{}, true},
+		{"someday", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSnoozeUntil(tt.in, now)
+		if (err != nil) != tt.wantErr || !got.Equal(tt.want) {
+			t.Errorf("ParseSnoozeUntil(%q) = %v, %v; want %v, err %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func listedIDs(m Model) []string {
+	var ids []string
+	for _, item := range m.list.Items() {
+		ids = append(ids, item.(IssueItem).Issue.ID)
+	}
+	return ids
+}
+
+func TestSnooze_HidesUntilWoken(t *testing.T) {
+	t.Chdir(t.TempDir())
+	issues := []model.Issue{
+		{ID: "s-1", Title: "Later", Status: model.StatusOpen},
+		{ID: "s-2", Title: "Now", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	m.syncSelection("s-1")
+	m = press(m, "u", "3", "d", "enter")
+	if m.showSnoozePrompt || !m.isSnoozed("s-1") {
+		t.Fatalf("s-1 should be snoozed: %q", m.statusMsg)
+	}
+	if ids := listedIDs(m); len(ids) != 1 || ids[0] != "s-2" {
+		t.Errorf("list = %v, want only s-2", ids)
+	}
+	if got := m.board.TotalCount(); got != 1 {
+		t.Errorf("board shows %d issues, want 1", got)
+	}
+	saved, err := LoadSnoozed(".")
+	if err != nil || saved["s-1"].Before(time.Now().Add(2*24*time.Hour)) {
+		t.Errorf("snooze not saved: %v, %v", saved, err)
+	}
+
+	// A new session hides it from the first frame
+	if ids := listedIDs(NewModel(issues, nil, "")); len(ids) != 1 {
+		t.Errorf("reloaded list = %v", ids)
+	}
+
+	m = press(m, "x")
+	if ids := listedIDs(m); m.currentFilter != "snoozed" || len(ids) != 1 || ids[0] != "s-1" {
+		t.Errorf("snoozed filter lists %v", ids)
+	}
+	m = press(m, "u")
+	if m.isSnoozed("s-1") || len(listedIDs(press(m, "x"))) != 2 {
+		t.Errorf("u should wake s-1: %q", m.statusMsg)
+	}
+	if _, err := os.Stat(SnoozedPath(".")); !os.IsNotExist(err) {
+		t.Error("the file should go once nothing is snoozed")
+	}
+}
+
+func TestSnooze_BadDateKeepsPrompt(t *testing.T) {
+	t.Chdir(t.TempDir())
+	m := NewModel([]model.Issue{{ID: "s-1", Title: "Later", Status: model.StatusOpen}}, nil, "")
+	m = press(m, "u", "n", "o", "p", "e", "enter")
+	if !m.showSnoozePrompt || !m.statusIsError || m.isSnoozed("s-1") {
+		t.Errorf("a bad date should keep the prompt open: %q", m.statusMsg)
+	}
+	m = press(m, "esc")
+	if m.showSnoozePrompt || m.focused != focusList {
+		t.Error("esc should cancel")
+	}
+}
+
+func TestJumpToIssue_Snoozed(t *testing.T) {
+	t.Chdir(t.TempDir())
+	m := NewModel([]model.Issue{{ID: "s-1", Title: "Later", Status: model.StatusOpen}, {ID: "s-2", Title: "Now", Status: model.StatusOpen}}, nil, "")
+	if err := m.setSnooze("s-1", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if !m.jumpToIssue("s-1") || m.currentFilter != "snoozed" {
+		t.Errorf("jumping to a snoozed issue should show the snoozed filter, got %q", m.currentFilter)
+	}
+}
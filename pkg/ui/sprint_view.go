@@ -5,11 +5,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// defaultSprint picks the sprint the dashboard opens on: the active one,
+// else the last one listed
+func defaultSprint(sprints []model.Sprint) *model.Sprint {
+	for i := range sprints {
+		if sprints[i].IsActive() {
+			return &sprints[i]
+		}
+	}
+	if len(sprints) == 0 {
+		return nil
+	}
+	return &sprints[len(sprints)-1]
+}
+
 // renderSprintDashboard renders the sprint view with progress, burndown, and at-risk items (bv-161)
 func (m Model) renderSprintDashboard() string {
 	t := m.theme
@@ -127,32 +142,51 @@ func (m Model) renderSprintDashboard() string {
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Feature).Render(fmt.Sprintf("⏳%d ", inProgressBeads)))
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("⛔%d ", blockedBeads)))
 	sb.WriteString(valStyle.Render(fmt.Sprintf("○%d", openBeads-inProgressBeads-blockedBeads)))
-	sb.WriteString("\n\n")
+	sb.WriteString("\n")
+
+	// Estimates, when the sprint's beads carry them, burn down instead of counts
+	burnTotal, burnRemaining := float64(totalBeads), float64(totalBeads-closedBeads)
+	burnLabel := "Burndown:"
+	unit := analysis.EstimateUnitFor(sprintIssues)
+	if estimates := analysis.TotalEstimates(sprintIssues, unit); unit != "" && estimates.Total > 0 {
+		sb.WriteString(labelStyle.Render("Effort:   "))
+		effort := fmt.Sprintf(" %s of %s done • %s remaining",
+			analysis.FormatEstimate(estimates.Completed, unit),
+			analysis.FormatEstimate(estimates.Total, unit),
+			analysis.FormatEstimate(estimates.Remaining, unit))
+		sb.WriteString(valStyle.Render(effort))
+		if estimates.Unestimated > 0 {
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Render(fmt.Sprintf(" (%d unestimated)", estimates.Unestimated)))
+		}
+		sb.WriteString("\n")
+		burnTotal, burnRemaining = estimates.Total, estimates.Remaining
+		burnLabel = fmt.Sprintf("Burndown (%s remaining):", unit)
+	}
+	sb.WriteString("\n")
 
 	// Simple burndown chart (ASCII)
-	sb.WriteString(labelStyle.Render("Burndown:"))
+	sb.WriteString(labelStyle.Render(burnLabel))
 	sb.WriteString("\n")
-	if sprintDuration > 0 && totalBeads > 0 {
-		// Ideal line: from totalBeads to 0 over sprintDuration days
-		// Current: totalBeads - closedBeads remaining on day daysPassed
+	if sprintDuration > 0 && burnTotal > 0 {
+		// Ideal line: from burnTotal to 0 over sprintDuration days
+		// Current: burnRemaining on day daysPassed
 		chartHeight := 5
 		chartWidth := min(sprintDuration, 20)
-		actualRemaining := float64(totalBeads - closedBeads)
 
 		// Create simple ASCII chart
 		for row := chartHeight - 1; row >= 0; row-- {
-			threshold := float64(totalBeads) * float64(row+1) / float64(chartHeight)
+			threshold := burnTotal * float64(row+1) / float64(chartHeight)
 			var line strings.Builder
 			line.WriteString("  ")
 			for col := 0; col <= chartWidth; col++ {
 				dayFrac := float64(col) / float64(chartWidth)
-				idealVal := float64(totalBeads) * (1 - dayFrac)
+				idealVal := burnTotal * (1 - dayFrac)
 				passedFrac := float64(daysPassed) / float64(sprintDuration)
 
-				if idealVal >= threshold-0.5 && idealVal < threshold+float64(totalBeads)/float64(chartHeight) {
+				if idealVal >= threshold-0.5 && idealVal < threshold+burnTotal/float64(chartHeight) {
 					// Ideal line
 					line.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render("·"))
-				} else if col <= int(float64(chartWidth)*passedFrac) && actualRemaining >= threshold-0.5 && actualRemaining < threshold+float64(totalBeads)/float64(chartHeight) {
+				} else if col <= int(float64(chartWidth)*passedFrac) && burnRemaining >= threshold-0.5 && burnRemaining < threshold+burnTotal/float64(chartHeight) {
 					// Actual current point
 					line.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("●"))
 				} else {
@@ -231,7 +265,7 @@ func (m Model) renderSprintDashboard() string {
 	// Footer
 	sb.WriteString("\n")
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(
-		"P: close sprint view • j/k: navigate sprints"))
+		"P: close sprint view • j/k: navigate sprints • c: velocity forecast"))
 
 	// Wrap in a box
 	boxStyle := t.Renderer.NewStyle().
@@ -265,11 +299,20 @@ func truncateStrSprint(s string, maxLen int) string {
 
 // handleSprintKeys handles keyboard input when in sprint view (bv-161)
 func (m Model) handleSprintKeys(msg tea.KeyMsg) Model {
+	if m.showSprintVelocity {
+		return m.handleSprintVelocityKeys(msg)
+	}
 	switch msg.String() {
 	case "P", "esc":
 		// Exit sprint view
 		m.isSprintView = false
 		m.focused = focusList
+	case "c":
+		// Velocity comparison with the sprint forecast
+		m.velocityComparison.SetData(m.issues)
+		m.velocityComparison.SetSprints(m.sprints, m.pin.Epic)
+		m.velocityComparison.SetSize(m.width, m.height-1)
+		m.showSprintVelocity = true
 	case "j", "down":
 		// Next sprint
 		if len(m.sprints) > 1 && m.selectedSprint != nil {
@@ -295,3 +338,26 @@ func (m Model) handleSprintKeys(msg tea.KeyMsg) Model {
 	}
 	return m
 }
+
+// handleSprintVelocityKeys handles the velocity forecast opened from the
+// sprint dashboard
+func (m Model) handleSprintVelocityKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "c", "esc":
+		m.showSprintVelocity = false
+	case "P":
+		m.showSprintVelocity = false
+		m.isSprintView = false
+		m.focused = focusList
+	case "j", "down":
+		m.velocityComparison.MoveDown()
+	case "k", "up":
+		m.velocityComparison.MoveUp()
+	case "e":
+		if !m.velocityComparison.ToggleEpicForecast() {
+			m.statusMsg = "No pinned epic: pin one with ^ in the list"
+			m.statusIsError = false
+		}
+	}
+	return m
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -556,3 +557,58 @@ func containsStr(s, substr string) bool {
 	}
 	return false
 }
+
+func TestRenderSprintDashboard_EstimateBurndown(t *testing.T) {
+	now := time.Now().UTC()
+	pts := func(v float64) *float64 { return &v }
+	sprint := model.Sprint{ID: "s1", Name: "Sprint 1", StartDate: now.AddDate(0, 0, -3), EndDate: now.AddDate(0, 0, 7), BeadIDs: []string{"A", "B", "C"}}
+	m := Model{
+		isSprintView: true,
+		theme:        DefaultTheme(lipgloss.NewRenderer(nil)),
+		width:        100,
+		height:       60,
+		issues: []model.Issue{
+			{ID: "A", Title: "Done", Status: model.StatusClosed, IssueType: model.TypeTask, Estimate: pts(3)},
+			{ID: "B", Title: "Open", Status: model.StatusOpen, IssueType: model.TypeTask, Estimate: pts(5)},
+			{ID: "C", Title: "Unpointed", Status: model.StatusOpen, IssueType: model.TypeTask},
+		},
+		sprints:        []model.Sprint{sprint},
+		selectedSprint: &sprint,
+	}
+
+	out := m.renderSprintDashboard()
+	for _, want := range []string{"3 pts of 8 pts done", "5 pts remaining", "1 unestimated", "Burndown (points remaining):"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in sprint dashboard, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestHandleSprintKeys_VelocityForecast(t *testing.T) {
+	now := time.Now().UTC()
+	sprints := []model.Sprint{{ID: "s1", Name: "Sprint 1", EndDate: now.AddDate(0, 0, -1), BeadIDs: []string{"A"}}}
+	m := Model{
+		isSprintView:       true,
+		focused:            focusSprint,
+		theme:              DefaultTheme(lipgloss.NewRenderer(nil)),
+		width:              100,
+		height:             40,
+		issues:             []model.Issue{{ID: "A", Labels: []string{"api"}, Status: model.StatusClosed, ClosedAt: &now}},
+		sprints:            sprints,
+		selectedSprint:     &sprints[0],
+		velocityComparison: NewVelocityComparisonModel(DefaultTheme(lipgloss.NewRenderer(nil))),
+	}
+
+	m = m.handleSprintKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if !m.showSprintVelocity || !strings.Contains(m.velocityComparison.View(), "Sprint Forecast · label api") {
+		t.Fatal("c should show the velocity forecast")
+	}
+	m = m.handleSprintKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if !strings.Contains(m.statusMsg, "No pinned epic") {
+		t.Errorf("e without a pinned epic: status = %q", m.statusMsg)
+	}
+	m = m.handleSprintKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showSprintVelocity || !m.isSprintView {
+		t.Error("esc should return to the sprint dashboard")
+	}
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StarredFilename is the per-project file under .bv/ listing the issues on
+// the user's watchlist. Starred issues are marked in the list and on the
+// board, and lead the insights top picks.
+const StarredFilename = "starred.yaml"
+
+// starredReason leads the reasons of starred top picks
+const starredReason = "★ Starred"
+
+type starredFile struct {
+	Issues []string `yaml:"issues,omitempty"`
+}
+
+// StarredPath returns the starred issues file path for a project
+func StarredPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", StarredFilename)
+}
+
+// LoadStarred reads the starred issue IDs from .bv/starred.yaml.
+// Returns nil if the file doesn't exist.
+func LoadStarred(projectDir string) ([]string, error) {
+	data, err := os.ReadFile(StarredPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading starred issues: %w", err)
+	}
+	var file starredFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing starred issues: %w", err)
+	}
+	return file.Issues, nil
+}
+
+// SaveStarred writes the starred issue IDs to .bv/starred.yaml, removing the
+// file when there are none
+func SaveStarred(projectDir string, ids []string) error {
+	path := StarredPath(projectDir)
+	if len(ids) == 0 {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing starred issues: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating starred issues directory: %w", err)
+	}
+	data, err := yaml.Marshal(starredFile{Issues: ids})
+	if err != nil {
+		return fmt.Errorf("encoding starred issues: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing starred issues: %w", err)
+	}
+	return nil
+}
+
+// toggleStar stars (or unstars) the selected issue. The starred map is
+// shared with the list delegate and the board, so it changes in place.
+func (m *Model) toggleStar() {
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+	id := issueItem.Issue.ID
+	star := !m.starred[id]
+
+	var ids []string
+	for sid := range m.starred {
+		if sid != id {
+			ids = append(ids, sid)
+		}
+	}
+	if star {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	if err := SaveStarred(projectDirFromBeadsPath(m.beadsPath), ids); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	if star {
+		m.starred[id] = true
+		m.statusMsg = fmt.Sprintf("★ Starred %s (y shows starred issues)", id)
+	} else {
+		delete(m.starred, id)
+		m.statusMsg = fmt.Sprintf("Unstarred %s", id)
+	}
+	m.statusIsError = false
+
+	// Keep the top picks led by the watchlist
+	if m.analysis != nil {
+		m.setInsightsTriage(m.triageResult())
+	}
+	if m.currentFilter == "starred" {
+		m.applyFilter()
+	}
+}
+
+// toggleStarredFilter switches the list between the starred issues and all
+// issues
+func (m *Model) toggleStarredFilter() {
+	if m.currentFilter == "starred" {
+		m.currentFilter = "all"
+	} else {
+		m.currentFilter = "starred"
+	}
+	m.applyFilter()
+}
+
+// starredTopPicks moves the starred issues to the front of the top picks,
+// in triage order, adding the ones that didn't make the cut. Closed issues
+// have no recommendation and so are left out.
+func starredTopPicks(picks []analysis.TopPick, recs []analysis.Recommendation, starred map[string]bool) []analysis.TopPick {
+	if len(starred) == 0 {
+		return picks
+	}
+	var out []analysis.TopPick
+	for _, rec := range recs {
+		if starred[rec.ID] {
+			out = append(out, analysis.TopPick{
+				ID:       rec.ID,
+				Title:    rec.Title,
+				Score:    rec.Score,
+				Reasons:  append([]string{starredReason}, rec.Reasons...),
+				Unblocks: len(rec.UnblocksIDs),
+			})
+		}
+	}
+	for _, pick := range picks {
+		if !starred[pick.ID] {
+			out = append(out, pick)
+		}
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestStarredTopPicks(t *testing.T) {
+	picks := []analysis.TopPick{{ID: "a"}, {ID: "b"}}
+	recs := []analysis.Recommendation{
+		{ID: "a", Score: 0.9},
+		{ID: "b", Score: 0.8},
+		{ID: "c", Score: 0.1, Reasons: []string{"low impact"}},
+	}
+	got := starredTopPicks(picks, recs, map[string]bool{"c": true, "b": true, "closed": true})
+	var ids []string
+	for _, p := range got {
+		ids = append(ids, p.ID)
+	}
+	if strings.Join(ids, ",") != "b,c,a" {
+		t.Errorf("picks = %v, want b,c,a", ids)
+	}
+	if r := got[1].Reasons; len(r) != 2 || r[0] != starredReason {
+		t.Errorf("starred pick reasons = %v", r)
+	}
+	if got := starredTopPicks(picks, recs, nil); len(got) != 2 {
+		t.Errorf("no stars should keep the picks, got %v", got)
+	}
+}
+
+func TestStar_FilterAndPersist(t *testing.T) {
+	t.Chdir(t.TempDir())
+	issues := []model.Issue{
+		{ID: "w-1", Title: "Watch me", Status: model.StatusOpen},
+		{ID: "w-2", Title: "Ignore me", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	m.syncSelection("w-1")
+	m = press(m, "+")
+	if !m.starred["w-1"] || m.statusIsError {
+		t.Fatalf("w-1 should be starred: %q", m.statusMsg)
+	}
+	if ids, _ := LoadStarred("."); len(ids) != 1 || ids[0] != "w-1" {
+		t.Errorf("saved stars = %v", ids)
+	}
+	if !NewModel(issues, nil, "").starred["w-1"] {
+		t.Error("stars should load with the project")
+	}
+
+	m = press(m, "*")
+	if ids := listedIDs(m); m.currentFilter != "starred" || len(ids) != 1 || ids[0] != "w-1" {
+		t.Errorf("starred filter lists %v", ids)
+	}
+	m = press(m, "+")
+	if m.starred["w-1"] || len(listedIDs(m)) != 0 {
+		t.Errorf("unstarring should drop it from the starred list: %v", listedIDs(m))
+	}
+	if _, err := os.Stat(StarredPath(".")); !os.IsNotExist(err) {
+		t.Error("the file should go once nothing is starred")
+	}
+	if m = press(m, "*"); len(listedIDs(m)) != 2 {
+		t.Error("* again should show all issues")
+	}
+}
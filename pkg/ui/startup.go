@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Subsystems NewModel defers: none is needed to draw the first frame
+const (
+	startupTriage  = "triage"
+	startupLabels  = "labels"
+	startupAlerts  = "alerts"
+	startupRecipes = "recipes"
+)
+
+// startupIdleDelay is how long after Init the deferred subsystems run, so
+// the first frame draws before them
+const startupIdleDelay = 50 * time.Millisecond
+
+// StartupTiming is how long one part of startup took
+type StartupTiming struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration_ns"`
+	// Trigger is "startup" for work done in NewModel, or what ran a deferred
+	// subsystem: "first use" or "idle"
+	Trigger string `json:"trigger"`
+}
+
+// Deferred reports whether the work ran after NewModel returned
+func (t StartupTiming) Deferred() bool {
+	return t.Trigger != "startup"
+}
+
+// startupIdleMsg runs the deferred subsystems nothing has needed yet
+type startupIdleMsg struct{}
+
+// startupScheduler times NewModel and holds the subsystems it deferred.
+// The Model keeps a pointer so copies made by value-receiver handlers share
+// it. A nil scheduler has nothing pending.
+type startupScheduler struct {
+	last    time.Time
+	pending map[string]func(*Model)
+	order   []string
+	timings []StartupTiming
+}
+
+func newStartupScheduler() *startupScheduler {
+	return &startupScheduler{last: time.Now(), pending: make(map[string]func(*Model))}
+}
+
+// lap records the time since the previous lap as the startup cost of name
+func (s *startupScheduler) lap(name string) {
+	now := time.Now()
+	s.timings = append(s.timings, StartupTiming{Name: name, Duration: now.Sub(s.last), Trigger: "startup"})
+	s.last = now
+}
+
+// later defers init until ensure(name) or the first idle moment
+func (s *startupScheduler) later(name string, init func(*Model)) {
+	s.pending[name] = init
+	s.order = append(s.order, name)
+	s.last = time.Now()
+}
+
+// drop forgets a deferred subsystem whose state was rebuilt some other way
+func (s *startupScheduler) drop(name string) {
+	if s != nil {
+		delete(s.pending, name)
+	}
+}
+
+// ensureStartup runs the deferred subsystem name if it hasn't run yet, so
+// handlers call it before reading the state it fills
+func (m *Model) ensureStartup(name string) {
+	m.runStartup(name, "first use")
+}
+
+func (m *Model) runStartup(name, trigger string) {
+	s := m.startup
+	if s == nil {
+		return
+	}
+	init, ok := s.pending[name]
+	if !ok {
+		return
+	}
+	delete(s.pending, name)
+	start := time.Now()
+	init(m)
+	s.timings = append(s.timings, StartupTiming{Name: name, Duration: time.Since(start), Trigger: trigger})
+}
+
+// runIdleStartup runs every deferred subsystem still pending, in the order
+// NewModel deferred them
+func (m *Model) runIdleStartup() {
+	if m.startup == nil {
+		return
+	}
+	for _, name := range m.startup.order {
+		m.runStartup(name, "idle")
+	}
+}
+
+// startupIdleCmd schedules the deferred subsystems after the first frame
+func startupIdleCmd() tea.Cmd {
+	return tea.Tick(startupIdleDelay, func(time.Time) tea.Msg { return startupIdleMsg{} })
+}
+
+// StartupTimings returns how long each part of startup took so far,
+// deferred subsystems included once they've run
+func (m Model) StartupTimings() []StartupTiming {
+	if m.startup == nil {
+		return nil
+	}
+	return append([]StartupTiming(nil), m.startup.timings...)
+}
+
+// ProfileStartup builds the TUI model for issues without starting it, runs
+// the subsystems it defers, and returns the timings for bv --profile-startup.
+// It reads the project settings in the working directory.
+func ProfileStartup(issues []model.Issue) []StartupTiming {
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	m.runIdleStartup()
+	return m.StartupTimings()
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestStartup_DefersSubsystemsUntilUseOrIdle(t *testing.T) {
+	old := time.Now().Add(-40 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "s-1", Title: "Blocker", Status: model.StatusOpen, Labels: []string{"api"}, CreatedAt: old, UpdatedAt: old},
+		{ID: "s-2", Title: "Blocked", Status: model.StatusOpen, CreatedAt: old, UpdatedAt: old,
+			Dependencies: []*model.Dependency{{IssueID: "s-2", DependsOnID: "s-1", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+
+	if m.triageScores != nil || len(m.alerts) != 0 || len(m.recipeLoader.List()) != 0 {
+		t.Fatal("triage, alerts and recipes should wait until needed")
+	}
+	for _, timing := range m.StartupTimings() {
+		if timing.Deferred() {
+			t.Errorf("%s ran before anything needed it", timing.Name)
+		}
+	}
+
+	m = press(m, "R")
+	if len(m.recipeLoader.List()) == 0 {
+		t.Error("R should load the recipes")
+	}
+
+	updated, _ := m.Update(startupIdleMsg{})
+	m = updated.(Model)
+	if m.triageScores["s-1"] == 0 || len(m.alerts) == 0 {
+		t.Error("idle should compute triage and alerts")
+	}
+	if item, ok := m.list.Items()[0].(IssueItem); !ok || item.TriageScore == 0 {
+		t.Error("listed items should get their triage scores")
+	}
+
+	triggers := make(map[string]string)
+	for _, timing := range m.StartupTimings() {
+		triggers[timing.Name] = timing.Trigger
+	}
+	want := map[string]string{
+		"analysis":     "startup",
+		startupRecipes: "first use",
+		startupTriage:  "idle",
+		startupAlerts:  "idle",
+		startupLabels:  "idle",
+	}
+	for name, trigger := range want {
+		if triggers[name] != trigger {
+			t.Errorf("%s trigger = %q, want %q", name, triggers[name], trigger)
+		}
+	}
+}
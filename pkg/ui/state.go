@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UIStateFilename is the per-project file under .bv/ that remembers the TUI layout between runs
+const UIStateFilename = "state.yaml"
+
+// splitRatioStep is how much < and > move the split view divider
+const splitRatioStep = 0.05
+
+// UIState is the layout restored on startup: split ratio, view, and filter,
+// plus the recently viewed issues behind the jump list and, in a workspace,
+// the repos picked with w
+type UIState struct {
+	SplitRatio float64       `yaml:"split_ratio,omitempty"`
+	View       string        `yaml:"view,omitempty"`   // list, board, graph, timeline, activity
+	Filter     string        `yaml:"filter,omitempty"` // all, open, closed, ready, label:<name>, query:<expr>
+	Recent     []RecentVisit `yaml:"recent,omitempty"`
+	Repos      []string      `yaml:"repos,omitempty"` // Empty means all repos
+}
+
+// UIStatePath returns the UI state file path for a project
+func UIStatePath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", UIStateFilename)
+}
+
+// LoadUIState reads the saved layout from .bv/state.yaml.
+// Returns an empty state if the file doesn't exist.
+func LoadUIState(projectDir string) (UIState, error) {
+	var state UIState
+	data, err := os.ReadFile(UIStatePath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return state, fmt.Errorf("reading UI state: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return UIState{}, fmt.Errorf("parsing UI state: %w", err)
+	}
+	return state, nil
+}
+
+// SaveUIState writes the layout to .bv/state.yaml
+func SaveUIState(projectDir string, state UIState) error {
+	path := UIStatePath(projectDir)
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating state directory: %w", err)
+	}
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding UI state: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing UI state: %w", err)
+	}
+	return nil
+}
+
+// uiState captures the current layout. Views and filters that can't be
+// rebuilt on their own (recipes, time-travel, overlays) are saved as the
+// plain list with all issues.
+func (m Model) uiState() UIState {
+	state := UIState{SplitRatio: m.splitRatio, View: "list", Filter: "all", Recent: m.recent.Visits()}
+	switch {
+	case m.isBoardView:
+		state.View = "board"
+	case m.isGraphView:
+		state.View = "graph"
+	case m.isTimelineView:
+		state.View = "timeline"
+	case m.isHeatmapView:
+		state.View = "activity"
+	}
+	switch f := m.currentFilter; {
+	case f == "open", f == "closed", f == "ready", f == "snoozed", f == "starred",
+		strings.HasPrefix(f, "label:"), strings.HasPrefix(f, "query:"):
+		state.Filter = f
+	}
+	if m.workspaceMode {
+		state.Repos = sortedRepoKeys(m.activeRepos)
+	}
+	return state
+}
+
+// SaveUIState remembers the current layout for the next run. A default
+// layout is not written unless a state file already exists.
+func (m Model) SaveUIState() error {
+	projectDir := projectDirFromBeadsPath(m.beadsPath)
+	state := m.uiState()
+	isDefault := state.SplitRatio == defaultSplitRatio && state.View == "list" &&
+		state.Filter == "all" && len(state.Recent) == 0 && len(state.Repos) == 0
+	if isDefault {
+		if _, err := os.Stat(UIStatePath(projectDir)); os.IsNotExist(err) {
+			return nil
+		}
+	}
+	return SaveUIState(projectDir, state)
+}
+
+// restoreUIState applies a saved layout. An active recipe keeps its own
+// filter rather than the saved one.
+func (m *Model) restoreUIState(state UIState) {
+	if state.SplitRatio >= minSplitRatio && state.SplitRatio <= maxSplitRatio {
+		m.splitRatio = state.SplitRatio
+	}
+	m.recent = NewRecentIssues(state.Recent)
+
+	if m.activeRecipe == nil && state.Filter != "" && state.Filter != "all" {
+		m.currentFilter = state.Filter
+		m.applyFilter()
+	}
+
+	switch state.View {
+	case "board":
+		m.isBoardView = true
+		m.focused = focusBoard
+	case "graph":
+		m.isGraphView = true
+		m.focused = focusGraph
+	case "timeline":
+		m.timelineView = NewTimelineModel(m.analyzer.ComputeTimeline(time.Now()), time.Now(), m.theme)
+		m.isTimelineView = true
+		m.focused = focusTimeline
+	case "activity":
+		activityCfg, _ := analysis.LoadActivityConfig(projectDirFromBeadsPath(m.beadsPath))
+		m.heatmapView = NewHeatmapModel(m.issues, activityCfg.StaleDays, time.Now(), m.theme)
+		m.isHeatmapView = true
+		m.focused = focusHeatmap
+	}
+}
+
+// restoreRepoSelection re-applies the repos picked last run, dropping any
+// no longer in the workspace. Nothing left means all repos.
+func (m *Model) restoreRepoSelection(repos []string) {
+	available := make(map[string]bool, len(m.availableRepos))
+	for _, r := range m.availableRepos {
+		available[r] = true
+	}
+	selected := make(map[string]bool)
+	for _, r := range normalizeRepoPrefixes(repos) {
+		if available[r] {
+			selected[r] = true
+		}
+	}
+	if len(selected) == 0 || len(selected) == len(m.availableRepos) {
+		return
+	}
+	m.activeRepos = selected
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+}
+
+// resizeSplit moves the split view divider by delta (a share of the width)
+func (m *Model) resizeSplit(delta float64) {
+	if !m.isSplitView {
+		m.statusMsg = "Pane resizing needs the split view (wider terminal)"
+		m.statusIsError = false
+		return
+	}
+	ratio := math.Round((m.splitRatio+delta)*100) / 100
+	if ratio < minSplitRatio {
+		ratio = minSplitRatio
+	}
+	if ratio > maxSplitRatio {
+		ratio = maxSplitRatio
+	}
+	m.setSplitRatio(ratio)
+	m.statusMsg = fmt.Sprintf("List pane %d%%", int(m.splitRatio*100+0.5))
+	m.statusIsError = false
+}
@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func stateTestBeadsPath(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	beadsDir := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	beadsPath := filepath.Join(beadsDir, "beads.jsonl")
+	if err := os.WriteFile(beadsPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir, beadsPath
+}
+
+func TestUIState_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if state, err := LoadUIState(dir); err != nil || !reflect.DeepEqual(state, UIState{}) {
+		t.Fatalf("Expected empty state, got %+v, %v", state, err)
+	}
+	viewed := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	want := UIState{SplitRatio: 0.55, View: "board", Filter: "label:api", Recent: []RecentVisit{{ID: "bv-7", Visits: 3, LastViewed: viewed}}}
+	if err := SaveUIState(dir, want); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := LoadUIState(dir); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %+v, %v; want %+v", got, err, want)
+	}
+
+	if err := os.WriteFile(UIStatePath(dir), []byte("split_ratio: [oops"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadUIState(dir); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
+
+func TestModel_UIStateSavedAndRestored(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "st-1", Title: "Open one", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "st-2", Title: "Closed one", Status: model.StatusClosed, CreatedAt: now},
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+
+	// Untouched layout leaves no file behind
+	if err := m.SaveUIState(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(UIStatePath(dir)); !os.IsNotExist(err) {
+		t.Error("Default layout should not create a state file")
+	}
+
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+	press(">")
+	press(">")
+	press("c")
+	press("b")
+	if err := m.SaveUIState(); err != nil {
+		t.Fatal(err)
+	}
+	want := UIState{SplitRatio: 0.5, View: "board", Filter: "closed"}
+	if got, _ := LoadUIState(dir); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Saved %+v, want %+v", got, want)
+	}
+
+	m2 := NewModel(issues, nil, beadsPath)
+	defer m2.Stop()
+	if m2.splitRatio != 0.5 || !m2.isBoardView || m2.focused != focusBoard || m2.currentFilter != "closed" {
+		t.Errorf("Layout not restored: ratio=%v board=%v filter=%q", m2.splitRatio, m2.isBoardView, m2.currentFilter)
+	}
+	if n := len(m2.list.Items()); n != 1 {
+		t.Errorf("Restored filter should show 1 closed issue, got %d", n)
+	}
+}
+
+func TestModel_RestoreUIStateIgnoresBadValues(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "x-1", Title: "One", Status: model.StatusOpen}}, nil, "")
+	m.restoreUIState(UIState{SplitRatio: 0.95, View: "sparkles"})
+	if m.splitRatio != defaultSplitRatio || m.focused != focusList {
+		t.Errorf("Out-of-range state should be ignored, got ratio %v focus %v", m.splitRatio, m.focused)
+	}
+}
+
+func TestModel_ResizeSplit(t *testing.T) {
+	m := mouseTestModel(t, 160)
+	before := m.list.Width()
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press(">")
+	if m.splitRatio != 0.45 || m.list.Width() <= before || m.statusMsg != "List pane 45%" {
+		t.Errorf("> should grow the list pane, got ratio %v width %d status %q", m.splitRatio, m.list.Width(), m.statusMsg)
+	}
+	for i := 0; i < 20; i++ {
+		press("<")
+	}
+	if m.splitRatio != minSplitRatio {
+		t.Errorf("Expected ratio clamped to %v, got %v", minSplitRatio, m.splitRatio)
+	}
+
+	// Narrow terminals have no split to resize
+	m = mouseTestModel(t, 90)
+	press(">")
+	if m.splitRatio != defaultSplitRatio || m.statusMsg == "" {
+		t.Errorf("Expected a hint and no change, got ratio %v status %q", m.splitRatio, m.statusMsg)
+	}
+}
+
+func TestModel_RepoSelectionRestored(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "api-1", Title: "API", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "web-1", Title: "Web", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "lib-1", Title: "Lib", Status: model.StatusOpen, CreatedAt: now},
+	}
+	info := WorkspaceInfo{Enabled: true, RepoCount: 3, RepoPrefixes: []string{"api-", "web-", "lib-"}}
+
+	// Gone repos are dropped; a repo that no longer exists isn't an error
+	if err := SaveUIState(dir, UIState{Repos: []string{"api", "web", "old"}}); err != nil {
+		t.Fatal(err)
+	}
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	m.EnableWorkspaceMode(info)
+	if got := sortedRepoKeys(m.activeRepos); !reflect.DeepEqual(got, []string{"api", "web"}) {
+		t.Fatalf("Expected api and web restored, got %v", got)
+	}
+	if n := len(m.list.Items()); n != 2 {
+		t.Errorf("Expected the list filtered to 2 issues, got %d", n)
+	}
+	if got := m.uiState().Repos; !reflect.DeepEqual(got, []string{"api", "web"}) {
+		t.Errorf("Expected the selection saved back, got %v", got)
+	}
+
+	// Nothing usable left means all repos
+	if err := SaveUIState(dir, UIState{Repos: []string{"old"}}); err != nil {
+		t.Fatal(err)
+	}
+	m2 := NewModel(issues, nil, beadsPath)
+	defer m2.Stop()
+	m2.EnableWorkspaceMode(info)
+	if m2.activeRepos != nil {
+		t.Errorf("Expected all repos, got %v", m2.activeRepos)
+	}
+}
@@ -3,7 +3,9 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -148,6 +150,30 @@ func RenderStatusBadge(status string) string {
 		Render(label)
 }
 
+// RenderDueBadge returns a badge for an open issue that is overdue ("LATE 3d")
+// or due within model.DueSoonWindow ("DUE 2d"), and "" otherwise
+func RenderDueBadge(issue *model.Issue, now time.Time) string {
+	var fg, bg lipgloss.Color
+	var label string
+	switch issue.DueStateAt(now) {
+	case model.DueOverdue:
+		fg, bg, label = ColorDanger, ColorStatusBlockedBg, "LATE "+formatDueDays(now.Sub(*issue.DueDate))
+	case model.DueSoon:
+		fg, bg, label = ColorWarning, ColorPrioHighBg, "DUE "+formatDueDays(issue.DueDate.Sub(now))
+	default:
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(fg).Background(bg).Bold(true).Render(label)
+}
+
+// formatDueDays formats the distance to or past a due date in whole days
+func formatDueDays(d time.Duration) string {
+	if d < 24*time.Hour {
+		return "<1d"
+	}
+	return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+}
+
 // ══════════════════════════════════════════════════════════════════════════════
 // METRIC VISUALIZATION - Mini-bars and rank badges
 // ══════════════════════════════════════════════════════════════════════════════
@@ -4,7 +4,9 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -29,6 +31,28 @@ func TestRenderPriorityBadge(t *testing.T) {
 	}
 }
 
+func TestRenderDueBadge(t *testing.T) {
+	now := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time { t := now.Add(d); return &t }
+	tests := []struct {
+		issue model.Issue
+		want  string
+	}{
+		{model.Issue{Status: model.StatusOpen, DueDate: at(-3*24*time.Hour - time.Hour)}, "LATE 3d"},
+		{model.Issue{Status: model.StatusOpen, DueDate: at(2 * time.Hour)}, "DUE <1d"},
+		{model.Issue{Status: model.StatusOpen, DueDate: at(49 * time.Hour)}, "DUE 2d"},
+		{model.Issue{Status: model.StatusOpen, DueDate: at(10 * 24 * time.Hour)}, ""},
+		{model.Issue{Status: model.StatusClosed, DueDate: at(-time.Hour)}, ""},
+		{model.Issue{Status: model.StatusOpen}, ""},
+	}
+	for _, tt := range tests {
+		got := RenderDueBadge(&tt.issue, now)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("RenderDueBadge(due %v, %s) = %q, want %q", tt.issue.DueDate, tt.issue.Status, got, tt.want)
+		}
+	}
+}
+
 func TestRenderStatusBadge(t *testing.T) {
 	tests := []struct {
 		status string
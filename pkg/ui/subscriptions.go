@@ -0,0 +1,557 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// SubscriptionsFilename is the per-project file under .bv/ listing the issues
+// whose changes are reported when the beads file reloads
+const SubscriptionsFilename = "subscriptions.yaml"
+
+// maxQueuedChanges bounds the changes overlay; the oldest entries drop first
+const maxQueuedChanges = 100
+
+type subscriptionsFile struct {
+	Issues []string `yaml:"issues,omitempty"`
+}
+
+// SubscriptionsPath returns the subscriptions file path for a project
+func SubscriptionsPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", SubscriptionsFilename)
+}
+
+// LoadSubscriptions reads the subscribed issue IDs from .bv/subscriptions.yaml.
+// Returns nil if the file doesn't exist.
+func LoadSubscriptions(projectDir string) ([]string, error) {
+	data, err := os.ReadFile(SubscriptionsPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading subscriptions: %w", err)
+	}
+	var file subscriptionsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing subscriptions: %w", err)
+	}
+	return file.Issues, nil
+}
+
+// SaveSubscriptions writes the subscribed issue IDs to .bv/subscriptions.yaml,
+// removing the file when there are none
+func SaveSubscriptions(projectDir string, ids []string) error {
+	path := SubscriptionsPath(projectDir)
+	if len(ids) == 0 {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing subscriptions: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating subscriptions directory: %w", err)
+	}
+	data, err := yaml.Marshal(subscriptionsFile{Issues: ids})
+	if err != nil {
+		return fmt.Errorf("encoding subscriptions: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing subscriptions: %w", err)
+	}
+	return nil
+}
+
+// IssueChange is what one reload changed in a subscribed issue
+type IssueChange struct {
+	IssueID string
+	Title   string
+	At      time.Time
+	Removed bool // The issue is no longer in the beads file
+	Fields  []analysis.FieldChange
+	Old     model.Issue // State before the reload, for text diffs
+	New     model.Issue
+}
+
+// Summary lists the changed fields, e.g. "status, notes"
+func (c IssueChange) Summary() string {
+	if c.Removed {
+		return "removed"
+	}
+	names := make([]string, len(c.Fields))
+	for i, f := range c.Fields {
+		names[i] = f.Field
+	}
+	return strings.Join(names, ", ")
+}
+
+// subscribedChanges compares the subscribed issues before and after a
+// reload, in ID order. Issues that weren't loaded before are skipped.
+func subscribedChanges(subscribed map[string]bool, before, after map[string]*model.Issue, now time.Time) []IssueChange {
+	var changes []IssueChange
+	for id := range subscribed {
+		old := before[id]
+		if old == nil {
+			continue
+		}
+		updated := after[id]
+		if updated == nil {
+			changes = append(changes, IssueChange{IssueID: id, Title: old.Title, At: now, Removed: true, Old: *old})
+			continue
+		}
+		fields := analysis.DetectChanges(*old, *updated)
+		if added := len(updated.Comments) - len(old.Comments); added != 0 {
+			fields = append(fields, analysis.FieldChange{
+				Field:    "comments",
+				OldValue: fmt.Sprintf("%d", len(old.Comments)),
+				NewValue: fmt.Sprintf("%d", len(updated.Comments)),
+			})
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		changes = append(changes, IssueChange{
+			IssueID: id,
+			Title:   updated.Title,
+			At:      now,
+			Fields:  fields,
+			Old:     *old,
+			New:     *updated,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].IssueID < changes[j].IssueID })
+	return changes
+}
+
+// starredChanges reports the status changes and new blockers of starred
+// issues that aren't subscribed to (those report every field), in ID order
+func starredChanges(starred, subscribed map[string]bool, before, after map[string]*model.Issue, now time.Time) []IssueChange {
+	watched := make(map[string]bool, len(starred))
+	for id := range starred {
+		if !subscribed[id] {
+			watched[id] = true
+		}
+	}
+	var changes []IssueChange
+	for _, c := range subscribedChanges(watched, before, after, now) {
+		if c.Removed {
+			changes = append(changes, c)
+			continue
+		}
+		var fields []analysis.FieldChange
+		for _, f := range c.Fields {
+			if f.Field == "status" {
+				fields = append(fields, f)
+			}
+		}
+		if blockers, ok := blockersGained(&c.Old, &c.New); ok {
+			fields = append(fields, blockers)
+		}
+		if len(fields) > 0 {
+			c.Fields = fields
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// blockedByField names the change of an issue gaining blockers
+const blockedByField = "blocked by"
+
+// blockersGained reports the blocking dependencies of after, as a change
+// from those of before, when after is blocked by an issue before wasn't
+func blockersGained(before, after *model.Issue) (analysis.FieldChange, bool) {
+	blockers := func(issue *model.Issue) []string {
+		var ids []string
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				ids = append(ids, dep.DependsOnID)
+			}
+		}
+		sort.Strings(ids)
+		return ids
+	}
+	oldIDs, newIDs := blockers(before), blockers(after)
+	for _, id := range newIDs {
+		if !slices.Contains(oldIDs, id) {
+			return analysis.FieldChange{
+				Field:    blockedByField,
+				OldValue: strings.Join(oldIDs, ", "),
+				NewValue: strings.Join(newIDs, ", "),
+			}, true
+		}
+	}
+	return analysis.FieldChange{}, false
+}
+
+// changeText returns the full text of a long text field, for the fields
+// DetectChanges reports as "(modified)"
+func changeText(issue model.Issue, field string) (string, bool) {
+	switch field {
+	case "description":
+		return issue.Description, true
+	case "design":
+		return issue.Design, true
+	case "acceptance_criteria":
+		return issue.AcceptanceCriteria, true
+	case "notes":
+		return issue.Notes, true
+	}
+	return "", false
+}
+
+// maxDiffCells bounds the line diff table; larger texts are shown as a
+// whole removal and addition
+const maxDiffCells = 250000
+
+// diffLines returns a line diff of before and after: unchanged lines start
+// with "  ", removed lines with "- " and added lines with "+ "
+func diffLines(before, after string) []string {
+	a := strings.Split(strings.TrimRight(before, "\n"), "\n")
+	b := strings.Split(strings.TrimRight(after, "\n"), "\n")
+	if strings.TrimSpace(before) == "" {
+		a = nil
+	}
+	if strings.TrimSpace(after) == "" {
+		b = nil
+	}
+	var out []string
+	if len(a)*len(b) > maxDiffCells {
+		for _, l := range a {
+			out = append(out, "- "+l)
+		}
+		for _, l := range b {
+			out = append(out, "+ "+l)
+		}
+		return out
+	}
+
+	// lcs[i][j] is the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+	return out
+}
+
+// trimDiffContext drops unchanged lines more than context lines away from
+// a change, marking each gap with "  …"
+func trimDiffContext(lines []string, context int) []string {
+	keep := make([]bool, len(lines))
+	for i, l := range lines {
+		if strings.HasPrefix(l, "  ") {
+			continue
+		}
+		for j := max(0, i-context); j <= min(len(lines)-1, i+context); j++ {
+			keep[j] = true
+		}
+	}
+	var out []string
+	gap := false
+	for i, l := range lines {
+		if keep[i] {
+			out = append(out, l)
+			gap = false
+		} else if !gap {
+			out = append(out, "  …")
+			gap = true
+		}
+	}
+	return out
+}
+
+// toggleSubscription subscribes to (or unsubscribes from) the selected issue
+func (m *Model) toggleSubscription() {
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+	id := issueItem.Issue.ID
+	subscribe := !m.subscribed[id]
+
+	var ids []string
+	for sid := range m.subscribed {
+		if sid != id {
+			ids = append(ids, sid)
+		}
+	}
+	if subscribe {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	if err := SaveSubscriptions(projectDirFromBeadsPath(m.beadsPath), ids); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ %v", err)
+		m.statusIsError = true
+		return
+	}
+
+	if subscribe {
+		m.subscribed[id] = true
+		m.statusMsg = fmt.Sprintf("🔔 Subscribed to %s: reload changes are listed under N", id)
+	} else {
+		delete(m.subscribed, id)
+		m.statusMsg = fmt.Sprintf("Unsubscribed from %s", id)
+	}
+	m.statusIsError = false
+}
+
+// queueSubscribedChanges records what a reload changed in subscribed and
+// starred issues, newest first, and returns the toast to show (empty if
+// nothing changed). before is the issue map from before the reload.
+func (m *Model) queueSubscribedChanges(before map[string]*model.Issue, now time.Time) string {
+	if len(m.subscribed) == 0 && len(m.starred) == 0 {
+		return ""
+	}
+	changes := append(subscribedChanges(m.subscribed, before, m.issueMap, now),
+		starredChanges(m.starred, m.subscribed, before, m.issueMap, now)...)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].IssueID < changes[j].IssueID })
+	if len(changes) == 0 {
+		return ""
+	}
+	m.changes = append(changes, m.changes...)
+	if len(m.changes) > maxQueuedChanges {
+		m.changes = m.changes[:maxQueuedChanges]
+	}
+	m.unseenChanges += len(changes)
+	if m.showChanges {
+		m.changesCursor = 0
+	}
+
+	if len(changes) == 1 {
+		return fmt.Sprintf("🔔 %s changed: %s (N to view)", changes[0].IssueID, changes[0].Summary())
+	}
+	ids := make([]string, len(changes))
+	for i, c := range changes {
+		ids[i] = c.IssueID
+	}
+	return fmt.Sprintf("🔔 %d watched issues changed: %s (N to view)",
+		len(changes), truncateRunesHelper(strings.Join(ids, ", "), 60, "…"))
+}
+
+// openChanges shows the queued changes to subscribed and starred issues
+func (m *Model) openChanges() {
+	if len(m.changes) == 0 {
+		if len(m.subscribed) == 0 && len(m.starred) == 0 {
+			m.statusMsg = "Nothing watched: press B on an issue to subscribe to its changes, or + to star it"
+		} else {
+			m.statusMsg = fmt.Sprintf("No changes to your %d subscribed and %d starred issues yet", len(m.subscribed), len(m.starred))
+		}
+		m.statusIsError = false
+		return
+	}
+	m.showChanges = true
+	m.changesCursor = 0
+	m.unseenChanges = 0
+}
+
+// handleChangesKeys handles keys while the changes overlay is open
+func (m Model) handleChangesKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		if m.changesCursor < len(m.changes)-1 {
+			m.changesCursor++
+		}
+	case "k", "up":
+		if m.changesCursor > 0 {
+			m.changesCursor--
+		}
+	case "g", "home":
+		m.changesCursor = 0
+	case "G", "end":
+		m.changesCursor = max(0, len(m.changes)-1)
+	case "x":
+		// Drop the selected entry
+		if m.changesCursor < len(m.changes) {
+			m.changes = append(m.changes[:m.changesCursor:m.changesCursor], m.changes[m.changesCursor+1:]...)
+		}
+		if m.changesCursor >= len(m.changes) {
+			m.changesCursor = max(0, len(m.changes)-1)
+		}
+		if len(m.changes) == 0 {
+			m.showChanges = false
+		}
+	case "X":
+		m.changes = nil
+		m.changesCursor = 0
+		m.showChanges = false
+		m.statusMsg = "Cleared watched issue changes"
+		m.statusIsError = false
+	case "enter":
+		if m.changesCursor < len(m.changes) {
+			c := m.changes[m.changesCursor]
+			m.showChanges = false
+			if !c.Removed {
+				m.jumpToIssue(c.IssueID)
+			}
+		}
+	case "esc", "q", "N":
+		m.showChanges = false
+	}
+	return m
+}
+
+// renderIssueChange renders one entry of the changes overlay: a header line
+// and the field-level diff, with text fields as line diffs
+func (m Model) renderIssueChange(c IssueChange, selected bool, width int) []string {
+	t := m.theme
+	headerStyle := t.Renderer.NewStyle().Bold(true)
+	if selected {
+		headerStyle = headerStyle.Foreground(t.Primary)
+	}
+	fieldStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	addStyle := t.Renderer.NewStyle().Foreground(t.Open)
+	delStyle := t.Renderer.NewStyle().Foreground(t.Blocked)
+
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+	header := truncateRunesHelper(fmt.Sprintf("%s  %s", c.IssueID, c.Title), width-14, "…")
+	lines := []string{headerStyle.Render(cursor+header) + mutedStyle.Render("  "+c.At.Format("15:04:05"))}
+	if c.Removed {
+		return append(lines, delStyle.Render("    removed from the beads file"))
+	}
+
+	textWidth := width - 6
+	diffLine := func(l string) string {
+		l = truncateRunesHelper(l, textWidth, "…")
+		switch {
+		case strings.HasPrefix(l, "+ "):
+			return "    " + addStyle.Render(l)
+		case strings.HasPrefix(l, "- "):
+			return "    " + delStyle.Render(l)
+		}
+		return "    " + mutedStyle.Render(l)
+	}
+	for _, f := range c.Fields {
+		if oldText, ok := changeText(c.Old, f.Field); ok {
+			newText, _ := changeText(c.New, f.Field)
+			lines = append(lines, "    "+fieldStyle.Render(f.Field+":"))
+			for _, l := range trimDiffContext(diffLines(oldText, newText), 1) {
+				lines = append(lines, "  "+diffLine(l))
+			}
+			continue
+		}
+		if f.Field == "comments" && len(c.New.Comments) > len(c.Old.Comments) {
+			lines = append(lines, "    "+fieldStyle.Render("comments:"))
+			for _, comment := range c.New.Comments[len(c.Old.Comments):] {
+				if comment == nil {
+					continue
+				}
+				text := strings.Join(strings.Fields(comment.Text), " ")
+				lines = append(lines, "  "+diffLine(fmt.Sprintf("+ %s: %s", comment.Author, text)))
+			}
+			continue
+		}
+		oldValue, newValue := f.OldValue, f.NewValue
+		if oldValue == "" {
+			oldValue = "(none)"
+		}
+		if newValue == "" {
+			newValue = "(none)"
+		}
+		value := truncateRunesHelper(fmt.Sprintf("%s → %s", oldValue, newValue), textWidth-len(f.Field)-2, "…")
+		lines = append(lines, "    "+fieldStyle.Render(f.Field+": ")+value)
+	}
+	return lines
+}
+
+// renderChangesOverlay lists the queued changes to subscribed and starred
+// issues, newest first, keeping the selected entry in view
+func (m Model) renderChangesOverlay() string {
+	t := m.theme
+	boxWidth := min(100, m.width-4)
+	textWidth := boxWidth - 6
+	bodyHeight := max(5, m.height-9)
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var lines []string
+	selectedStart, selectedEnd := 0, 0
+	for i, c := range m.changes {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		if i == m.changesCursor {
+			selectedStart = len(lines)
+		}
+		lines = append(lines, m.renderIssueChange(c, i == m.changesCursor, textWidth)...)
+		if i == m.changesCursor {
+			selectedEnd = len(lines)
+		}
+	}
+	start := 0
+	if selectedEnd > bodyHeight {
+		start = min(selectedStart, selectedEnd-bodyHeight)
+	}
+	end := min(start+bodyHeight, len(lines))
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("🔔 Changes to watched issues since launch (%d)", len(m.changes))))
+	sb.WriteString("\n\n")
+	sb.WriteString(strings.Join(lines[start:end], "\n"))
+	sb.WriteString("\n\n")
+	sb.WriteString(mutedStyle.Italic(true).Render("j/k: select • enter: open issue • x: dismiss • X: clear all • esc: close"))
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
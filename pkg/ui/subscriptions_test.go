@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSubscriptions_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	ids, err := LoadSubscriptions(dir)
+	if err != nil || ids != nil {
+		t.Fatalf("Expected no subscriptions for a new project, got %v, %v", ids, err)
+	}
+	if err := SaveSubscriptions(dir, []string{"bv-1", "bv-2"}); err != nil {
+		t.Fatal(err)
+	}
+	ids, err = LoadSubscriptions(dir)
+	if err != nil || !slices.Equal(ids, []string{"bv-1", "bv-2"}) {
+		t.Fatalf("Expected [bv-1 bv-2], got %v, %v", ids, err)
+	}
+	if err := SaveSubscriptions(dir, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(SubscriptionsPath(dir)); !os.IsNotExist(err) {
+		t.Errorf("Expected the file to be removed with no subscriptions, got %v", err)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	got := diffLines("one\ntwo\nthree", "one\n2\nthree\nfour")
+	want := []string{"  one", "- two", "+ 2", "  three", "+ four"}
+	if !slices.Equal(got, want) {
+		t.Errorf("diffLines = %q, want %q", got, want)
+	}
+	if got := diffLines("", "new"); !slices.Equal(got, []string{"+ new"}) {
+		t.Errorf("Expected only an addition for empty before, got %q", got)
+	}
+
+	trimmed := trimDiffContext([]string{"  a", "  b", "  c", "- d", "+ e", "  f", "  g"}, 1)
+	if want := []string{"  …", "  c", "- d", "+ e", "  f", "  …"}; !slices.Equal(trimmed, want) {
+		t.Errorf("trimDiffContext = %q, want %q", trimmed, want)
+	}
+}
+
+func TestSubscribedChanges(t *testing.T) {
+	now := time.Now()
+	before := map[string]*model.Issue{
+		"A": {ID: "A", Title: "Alpha", Status: model.StatusOpen},
+		"B": {ID: "B", Title: "Beta", Status: model.StatusOpen},
+		"C": {ID: "C", Title: "Gamma", Status: model.StatusOpen},
+	}
+	after := map[string]*model.Issue{
+		"A": {ID: "A", Title: "Alpha", Status: model.StatusClosed,
+			Comments: []*model.Comment{{Author: "sam", Text: "done"}}},
+		"B": {ID: "B", Title: "Beta", Status: model.StatusOpen},
+	}
+	changes := subscribedChanges(map[string]bool{"A": true, "B": true, "C": true, "D": true}, before, after, now)
+	if len(changes) != 2 {
+		t.Fatalf("Expected changes for A and C, got %+v", changes)
+	}
+	if changes[0].IssueID != "A" || changes[0].Summary() != "status, comments" {
+		t.Errorf("Expected A to report status and comments, got %s: %s", changes[0].IssueID, changes[0].Summary())
+	}
+	if changes[1].IssueID != "C" || !changes[1].Removed {
+		t.Errorf("Expected C to be reported as removed, got %+v", changes[1])
+	}
+}
+
+func TestSubscription_ReloadQueuesChange(t *testing.T) {
+	dir, beadsPath := stateTestBeadsPath(t)
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"id":"ONE","title":"One","status":"open","issue_type":"task","priority":2,"description":"first line\nsecond line"}
+{"id":"TWO","title":"Two","status":"open","issue_type":"task","priority":2}
+`)
+	issues := []model.Issue{
+		{ID: "ONE", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2, Description: "first line\nsecond line"},
+		{ID: "TWO", Title: "Two", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2},
+	}
+	m := NewModel(issues, nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	m.jumpToIssue("ONE")
+	m.focused = focusList
+
+	updated, _ = m.Update(keyMsgFromString("B"))
+	m = updated.(Model)
+	if !m.subscribed["ONE"] {
+		t.Fatalf("Expected ONE to be subscribed, status %q", m.statusMsg)
+	}
+	if ids, _ := LoadSubscriptions(dir); !slices.Equal(ids, []string{"ONE"}) {
+		t.Errorf("Expected the subscription to be saved, got %v", ids)
+	}
+
+	write(`{"id":"ONE","title":"One","status":"in_progress","issue_type":"task","priority":2,"description":"first line\nchanged line"}
+{"id":"TWO","title":"Two","status":"closed","issue_type":"task","priority":2}
+`)
+	updated, _ = m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	if !strings.Contains(m.statusMsg, "ONE changed: status, description") {
+		t.Errorf("Expected a toast for ONE, got %q", m.statusMsg)
+	}
+	if len(m.changes) != 1 || m.unseenChanges != 1 {
+		t.Fatalf("Expected one queued change (TWO isn't subscribed), got %+v", m.changes)
+	}
+
+	updated, _ = m.Update(keyMsgFromString("N"))
+	m = updated.(Model)
+	if !m.showChanges || m.unseenChanges != 0 {
+		t.Fatal("Expected N to open the changes overlay and mark changes seen")
+	}
+	view := m.View()
+	for _, want := range []string{"open → in_progress", "- second line", "+ changed line"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the overlay to show %q", want)
+		}
+	}
+
+	updated, _ = m.Update(keyMsgFromString("enter"))
+	m = updated.(Model)
+	if m.showChanges || selectedID(m) != "ONE" {
+		t.Errorf("Expected enter to close the overlay on ONE, got %q", selectedID(m))
+	}
+}
+
+func TestStarredChanges(t *testing.T) {
+	now := time.Now()
+	blocker := &model.Dependency{IssueID: "B", DependsOnID: "X", Type: model.DepBlocks}
+	related := &model.Dependency{IssueID: "D", DependsOnID: "X", Type: model.DepRelated}
+	before := map[string]*model.Issue{
+		"A": {ID: "A", Title: "Alpha", Status: model.StatusOpen},
+		"B": {ID: "B", Title: "Beta", Status: model.StatusOpen},
+		"C": {ID: "C", Title: "Gamma", Status: model.StatusOpen},
+		"D": {ID: "D", Title: "Delta", Status: model.StatusOpen},
+	}
+	after := map[string]*model.Issue{
+		"A": {ID: "A", Title: "Alpha renamed", Status: model.StatusInProgress},
+		"B": {ID: "B", Title: "Beta", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocker}},
+		"C": {ID: "C", Title: "Gamma", Status: model.StatusClosed},
+		"D": {ID: "D", Title: "Delta renamed", Status: model.StatusOpen, Dependencies: []*model.Dependency{related}},
+	}
+	starred := map[string]bool{"A": true, "B": true, "C": true, "D": true}
+	changes := starredChanges(starred, map[string]bool{"C": true}, before, after, now)
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.IssueID+": "+c.Summary())
+	}
+	if want := []string{"A: status", "B: blocked by"}; !slices.Equal(got, want) {
+		t.Errorf("starredChanges = %q, want %q (C is reported as a subscription)", got, want)
+	}
+	if f := changes[1].Fields[0]; f.OldValue != "" || f.NewValue != "X" {
+		t.Errorf("blocked by change = %+v", f)
+	}
+}
+
+func TestStarred_ReloadQueuesChange(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(beadsPath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"id":"ONE","title":"One","status":"open","issue_type":"task","priority":2}
+`)
+	m := NewModel([]model.Issue{{ID: "ONE", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask, Priority: 2}}, nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = press(updated.(Model), "+")
+
+	write(`{"id":"ONE","title":"One again","status":"blocked","issue_type":"task","priority":2}
+`)
+	updated, _ = m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	if !strings.Contains(m.statusMsg, "ONE changed: status") || m.unseenChanges != 1 {
+		t.Errorf("Expected a toast for the starred issue, got %q", m.statusMsg)
+	}
+	if m = press(m, "N"); !m.showChanges || !strings.Contains(m.View(), "open → blocked") {
+		t.Error("Expected N to list the starred issue's status change")
+	}
+}
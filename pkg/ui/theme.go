@@ -5,6 +5,7 @@ import (
 )
 
 type Theme struct {
+	Name     string // Builtin or .bv/themes/ name
 	Renderer *lipgloss.Renderer
 
 	// Colors
@@ -39,32 +40,37 @@ type Theme struct {
 
 // DefaultTheme returns the standard Dracula-inspired theme (adaptive)
 func DefaultTheme(r *lipgloss.Renderer) Theme {
+	t := buildTheme(r, defaultPalette)
+	t.Name = DefaultThemeName
+	return t
+}
+
+// buildTheme derives the theme styles from a palette
+func buildTheme(r *lipgloss.Renderer, p ThemePalette) Theme {
 	t := Theme{
 		Renderer: r,
 
-		// Dracula / Light Mode equivalent
-		// Light mode colors improved for WCAG AA compliance (bv-3fcg)
-		Primary:   lipgloss.AdaptiveColor{Light: "#6B47D9", Dark: "#BD93F9"}, // Purple (darker for contrast)
-		Secondary: lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Gray
-		Subtext:   lipgloss.AdaptiveColor{Light: "#666666", Dark: "#BFBFBF"}, // Dim (was #999999, now ~6:1)
-
-		Open:       lipgloss.AdaptiveColor{Light: "#007700", Dark: "#50FA7B"}, // Green (was #00A800, now ~4.6:1)
-		InProgress: lipgloss.AdaptiveColor{Light: "#006080", Dark: "#8BE9FD"}, // Cyan (darker for contrast)
-		Blocked:    lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF5555"}, // Red (slightly adjusted)
-		Closed:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Gray
-
-		Bug:     lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF5555"}, // Red
-		Feature: lipgloss.AdaptiveColor{Light: "#B06800", Dark: "#FFB86C"}, // Orange (darker for contrast)
-		Epic:    lipgloss.AdaptiveColor{Light: "#6B47D9", Dark: "#BD93F9"}, // Purple (darker)
-		Task:    lipgloss.AdaptiveColor{Light: "#808000", Dark: "#F1FA8C"}, // Yellow/olive (darker for contrast)
-		Chore:   lipgloss.AdaptiveColor{Light: "#006080", Dark: "#8BE9FD"}, // Cyan (darker)
-
-		Border:    lipgloss.AdaptiveColor{Light: "#AAAAAA", Dark: "#44475A"}, // Border (was #DDDDDD)
-		Highlight: lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#44475A"}, // Slightly darker
-		Muted:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Dimmed text (was #888888, now ~7:1)
+		Primary:   p.Primary,
+		Secondary: p.Secondary,
+		Subtext:   p.Subtext,
+
+		Open:       p.Open,
+		InProgress: p.InProgress,
+		Blocked:    p.Blocked,
+		Closed:     p.Closed,
+
+		Bug:     p.Bug,
+		Feature: p.Feature,
+		Epic:    p.Epic,
+		Task:    p.Task,
+		Chore:   p.Chore,
+
+		Border:    p.Border,
+		Highlight: p.Highlight,
+		Muted:     p.Muted,
 	}
 
-	t.Base = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"})
+	t.Base = r.NewStyle().Foreground(p.Text)
 
 	t.Selected = r.NewStyle().
 		Background(t.Highlight).
@@ -75,7 +81,7 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 
 	t.Header = r.NewStyle().
 		Background(t.Primary).
-		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
+		Foreground(p.HeaderText).
 		Bold(true).
 		Padding(0, 1)
 
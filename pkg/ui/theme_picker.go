@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ThemePickerModel represents the theme picker overlay. Moving the selection
+// previews a theme; the model remembers the theme that was active on open so
+// esc can restore it.
+type ThemePickerModel struct {
+	themes        []NamedTheme
+	selectedIndex int
+	original      string // Theme name active when the picker opened
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewThemePickerModel creates a theme picker with the active theme selected
+func NewThemePickerModel(themes []NamedTheme, active string, theme Theme) ThemePickerModel {
+	m := ThemePickerModel{
+		themes:   themes,
+		original: active,
+		theme:    theme,
+	}
+	for i, nt := range themes {
+		if nt.Name == active {
+			m.selectedIndex = i
+			break
+		}
+	}
+	return m
+}
+
+// SetSize updates the picker dimensions
+func (m *ThemePickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *ThemePickerModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *ThemePickerModel) MoveDown() {
+	if m.selectedIndex < len(m.themes)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedTheme returns the currently selected theme
+func (m *ThemePickerModel) SelectedTheme() *NamedTheme {
+	if len(m.themes) == 0 || m.selectedIndex >= len(m.themes) {
+		return nil
+	}
+	return &m.themes[m.selectedIndex]
+}
+
+// OriginalTheme returns the name of the theme active when the picker opened
+func (m *ThemePickerModel) OriginalTheme() string {
+	return m.original
+}
+
+// View renders the theme picker overlay
+func (m *ThemePickerModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 56
+	if m.width < 66 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("Select Theme"))
+	lines = append(lines, "")
+
+	descStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+
+	for i, nt := range m.themes {
+		isSelected := i == m.selectedIndex
+
+		nameStyle := t.Renderer.NewStyle()
+		if isSelected {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		} else {
+			nameStyle = nameStyle.Foreground(t.Base.GetForeground())
+		}
+
+		prefix := "  "
+		if isSelected {
+			prefix = "▸ "
+		}
+		name := prefix + nt.Name
+		if nt.Name == m.original {
+			name += " (current)"
+		}
+		lines = append(lines, nameStyle.Render(name)+"  "+themeSwatch(t.Renderer, nt.Palette))
+
+		desc := nt.Description
+		if nt.Source != "" {
+			src := filepath.Join(".bv", ThemesDirname, filepath.Base(nt.Source))
+			if desc == "" {
+				desc = src
+			} else {
+				desc += " · " + src
+			}
+		}
+		if desc != "" {
+			lines = append(lines, descStyle.Render("    "+truncateRunesHelper(desc, boxWidth-8, "…")))
+		}
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("j/k: preview • enter: keep • esc: cancel"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
+
+// themeSwatch renders a theme's status colors as a row of blocks
+func themeSwatch(r *lipgloss.Renderer, p ThemePalette) string {
+	var sb strings.Builder
+	for _, c := range []lipgloss.AdaptiveColor{p.Primary, p.Open, p.InProgress, p.Blocked, p.Closed} {
+		sb.WriteString(r.NewStyle().Foreground(c).Render("■"))
+	}
+	return sb.String()
+}
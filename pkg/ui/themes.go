@@ -0,0 +1,405 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// ThemesDirname is the directory under .bv/ holding user-defined themes (*.yaml)
+const ThemesDirname = "themes"
+
+// ThemeSelectionFilename is the per-project file under .bv/ that remembers the picked theme
+const ThemeSelectionFilename = "theme.yaml"
+
+// DefaultThemeName is the builtin Dracula-inspired theme used when nothing is picked
+const DefaultThemeName = "default"
+
+// ThemePalette holds every color a theme defines. Each color adapts to the
+// terminal background; a theme that should look the same everywhere sets
+// Light and Dark to the same value.
+type ThemePalette struct {
+	Primary   lipgloss.AdaptiveColor
+	Secondary lipgloss.AdaptiveColor
+	Subtext   lipgloss.AdaptiveColor
+
+	Open       lipgloss.AdaptiveColor
+	InProgress lipgloss.AdaptiveColor
+	Blocked    lipgloss.AdaptiveColor
+	Closed     lipgloss.AdaptiveColor
+
+	Bug     lipgloss.AdaptiveColor
+	Feature lipgloss.AdaptiveColor
+	Task    lipgloss.AdaptiveColor
+	Epic    lipgloss.AdaptiveColor
+	Chore   lipgloss.AdaptiveColor
+
+	Border    lipgloss.AdaptiveColor
+	Highlight lipgloss.AdaptiveColor
+	Muted     lipgloss.AdaptiveColor
+
+	Text       lipgloss.AdaptiveColor // Body text
+	HeaderText lipgloss.AdaptiveColor // Text on Primary-colored headers
+}
+
+// paletteColors maps the color names used in theme files to palette fields
+var paletteColors = []struct {
+	name  string
+	field func(p *ThemePalette) *lipgloss.AdaptiveColor
+}{
+	{"primary", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Primary }},
+	{"secondary", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Secondary }},
+	{"subtext", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Subtext }},
+	{"open", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Open }},
+	{"in_progress", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.InProgress }},
+	{"blocked", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Blocked }},
+	{"closed", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Closed }},
+	{"bug", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Bug }},
+	{"feature", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Feature }},
+	{"task", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Task }},
+	{"epic", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Epic }},
+	{"chore", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Chore }},
+	{"border", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Border }},
+	{"highlight", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Highlight }},
+	{"muted", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Muted }},
+	{"text", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.Text }},
+	{"header_text", func(p *ThemePalette) *lipgloss.AdaptiveColor { return &p.HeaderText }},
+}
+
+// fixed returns a color that ignores the terminal background
+func fixed(c string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: c, Dark: c}
+}
+
+// defaultPalette is the Dracula / light mode palette behind DefaultTheme
+var defaultPalette = ThemePalette{
+	// Light mode colors improved for WCAG AA compliance (bv-3fcg)
+	Primary:   lipgloss.AdaptiveColor{Light: "#6B47D9", Dark: "#BD93F9"}, // Purple (darker for contrast)
+	Secondary: lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Gray
+	Subtext:   lipgloss.AdaptiveColor{Light: "#666666", Dark: "#BFBFBF"}, // Dim (was #999999, now ~6:1)
+
+	Open:       lipgloss.AdaptiveColor{Light: "#007700", Dark: "#50FA7B"}, // Green (was #00A800, now ~4.6:1)
+	InProgress: lipgloss.AdaptiveColor{Light: "#006080", Dark: "#8BE9FD"}, // Cyan (darker for contrast)
+	Blocked:    lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF5555"}, // Red (slightly adjusted)
+	Closed:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Gray
+
+	Bug:     lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF5555"}, // Red
+	Feature: lipgloss.AdaptiveColor{Light: "#B06800", Dark: "#FFB86C"}, // Orange (darker for contrast)
+	Epic:    lipgloss.AdaptiveColor{Light: "#6B47D9", Dark: "#BD93F9"}, // Purple (darker)
+	Task:    lipgloss.AdaptiveColor{Light: "#808000", Dark: "#F1FA8C"}, // Yellow/olive (darker for contrast)
+	Chore:   lipgloss.AdaptiveColor{Light: "#006080", Dark: "#8BE9FD"}, // Cyan (darker)
+
+	Border:    lipgloss.AdaptiveColor{Light: "#AAAAAA", Dark: "#44475A"}, // Border (was #DDDDDD)
+	Highlight: lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#44475A"}, // Slightly darker
+	Muted:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Dimmed text (was #888888, now ~7:1)
+
+	Text:       lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"},
+	HeaderText: lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"},
+}
+
+// lightPalette forces the light-background colors, for terminals where
+// background detection guesses wrong (e.g. inside some multiplexers)
+var lightPalette = func() ThemePalette {
+	p := defaultPalette
+	for _, pc := range paletteColors {
+		c := pc.field(&p)
+		*c = fixed(c.Light)
+	}
+	return p
+}()
+
+// solarizedPalette uses Ethan Schoonover's Solarized accents and base tones
+var solarizedPalette = ThemePalette{
+	Primary:   fixed("#268BD2"),                                          // blue
+	Secondary: lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#586E75"}, // base1 / base01
+	Subtext:   lipgloss.AdaptiveColor{Light: "#657B83", Dark: "#839496"}, // base00 / base0
+
+	Open:       fixed("#859900"), // green
+	InProgress: fixed("#2AA198"), // cyan
+	Blocked:    fixed("#DC322F"), // red
+	Closed:     lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#586E75"},
+
+	Bug:     fixed("#DC322F"), // red
+	Feature: fixed("#CB4B16"), // orange
+	Task:    fixed("#B58900"), // yellow
+	Epic:    fixed("#6C71C4"), // violet
+	Chore:   fixed("#2AA198"), // cyan
+
+	Border:    lipgloss.AdaptiveColor{Light: "#EEE8D5", Dark: "#073642"}, // base2 / base02
+	Highlight: lipgloss.AdaptiveColor{Light: "#EEE8D5", Dark: "#073642"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#586E75"},
+
+	Text:       lipgloss.AdaptiveColor{Light: "#586E75", Dark: "#93A1A1"}, // base01 / base1
+	HeaderText: lipgloss.AdaptiveColor{Light: "#FDF6E3", Dark: "#002B36"}, // base3 / base03
+}
+
+// highContrastPalette sticks to black, white, and saturated colors
+var highContrastPalette = ThemePalette{
+	Primary:   lipgloss.AdaptiveColor{Light: "#0000AA", Dark: "#00FFFF"},
+	Secondary: lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+	Subtext:   lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+
+	Open:       lipgloss.AdaptiveColor{Light: "#006400", Dark: "#00FF00"},
+	InProgress: lipgloss.AdaptiveColor{Light: "#0000AA", Dark: "#00FFFF"},
+	Blocked:    lipgloss.AdaptiveColor{Light: "#B00000", Dark: "#FF4040"},
+	Closed:     lipgloss.AdaptiveColor{Light: "#444444", Dark: "#C0C0C0"},
+
+	Bug:     lipgloss.AdaptiveColor{Light: "#B00000", Dark: "#FF4040"},
+	Feature: lipgloss.AdaptiveColor{Light: "#8B4500", Dark: "#FFA500"},
+	Task:    lipgloss.AdaptiveColor{Light: "#5A5A00", Dark: "#FFFF00"},
+	Epic:    lipgloss.AdaptiveColor{Light: "#6A0DAD", Dark: "#FF80FF"},
+	Chore:   lipgloss.AdaptiveColor{Light: "#0000AA", Dark: "#00FFFF"},
+
+	Border:    lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+	Highlight: lipgloss.AdaptiveColor{Light: "#FFFF00", Dark: "#0000AA"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#333333", Dark: "#D0D0D0"},
+
+	Text:       lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+	HeaderText: lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#000000"},
+}
+
+// NamedTheme is a selectable color scheme: a builtin or a file in .bv/themes/
+type NamedTheme struct {
+	Name        string
+	Description string
+	Source      string // File the theme was loaded from; empty for builtins
+	Palette     ThemePalette
+}
+
+// Build creates the Theme (colors plus derived styles) for a renderer
+func (nt NamedTheme) Build(r *lipgloss.Renderer) Theme {
+	t := buildTheme(r, nt.Palette)
+	t.Name = nt.Name
+	return t
+}
+
+// BuiltinThemes returns the themes shipped with bv, default first
+func BuiltinThemes() []NamedTheme {
+	return []NamedTheme{
+		{Name: DefaultThemeName, Description: "Dracula on dark terminals, high-contrast light colors otherwise", Palette: defaultPalette},
+		{Name: "light", Description: "Light-background colors regardless of terminal detection", Palette: lightPalette},
+		{Name: "solarized", Description: "Solarized accents and base tones", Palette: solarizedPalette},
+		{Name: "high-contrast", Description: "Black, white, and saturated colors only", Palette: highContrastPalette},
+	}
+}
+
+// ThemeColor is a color in a theme file: either one value for all
+// backgrounds ("#268bd2", "33") or a {light, dark} pair
+type ThemeColor struct {
+	Light string `yaml:"light"`
+	Dark  string `yaml:"dark"`
+}
+
+// UnmarshalYAML accepts a scalar or a {light, dark} mapping
+func (c *ThemeColor) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		c.Light, c.Dark = node.Value, node.Value
+		return nil
+	}
+	type plain ThemeColor
+	return node.Decode((*plain)(c))
+}
+
+// ThemeSpec is the on-disk format of .bv/themes/<name>.yaml. Colors not
+// listed come from the base theme.
+type ThemeSpec struct {
+	Name        string                `yaml:"name"`        // Defaults to the file name
+	Description string                `yaml:"description"` // Shown in the theme picker
+	Base        string                `yaml:"base"`        // Builtin theme to start from (default "default")
+	Colors      map[string]ThemeColor `yaml:"colors"`
+}
+
+var hexColorRe = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// validThemeColor reports whether lipgloss understands c: a hex color or an
+// ANSI color number (0-255)
+func validThemeColor(c string) bool {
+	if hexColorRe.MatchString(c) {
+		return true
+	}
+	n, err := strconv.Atoi(c)
+	return err == nil && n >= 0 && n <= 255
+}
+
+// Resolve turns a spec into a named theme on top of its base palette
+func (s ThemeSpec) Resolve(builtins []NamedTheme) (NamedTheme, error) {
+	baseName := s.Base
+	if baseName == "" {
+		baseName = DefaultThemeName
+	}
+	base, ok := FindTheme(builtins, baseName)
+	if !ok {
+		return NamedTheme{}, fmt.Errorf("unknown base theme %q", baseName)
+	}
+
+	nt := NamedTheme{Name: s.Name, Description: s.Description, Palette: base.Palette}
+	names := make([]string, 0, len(s.Colors))
+	for name := range s.Colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := s.Colors[name]
+		var target *lipgloss.AdaptiveColor
+		for _, pc := range paletteColors {
+			if pc.name == name {
+				target = pc.field(&nt.Palette)
+				break
+			}
+		}
+		if target == nil {
+			return NamedTheme{}, fmt.Errorf("unknown color %q", name)
+		}
+		// Half-specified pairs keep the base value for the other background
+		if c.Light != "" {
+			if !validThemeColor(c.Light) {
+				return NamedTheme{}, fmt.Errorf("color %s: invalid value %q", name, c.Light)
+			}
+			target.Light = c.Light
+		}
+		if c.Dark != "" {
+			if !validThemeColor(c.Dark) {
+				return NamedTheme{}, fmt.Errorf("color %s: invalid value %q", name, c.Dark)
+			}
+			target.Dark = c.Dark
+		}
+	}
+	return nt, nil
+}
+
+// ThemesPath returns the user theme directory for a project
+func ThemesPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ThemesDirname)
+}
+
+// LoadThemes returns the builtin themes followed by those in .bv/themes/
+// (sorted by name). A user theme with a builtin's name replaces it. Files that
+// fail to parse are skipped and reported in the returned error, so one broken
+// theme doesn't hide the rest.
+func LoadThemes(projectDir string) ([]NamedTheme, error) {
+	builtins := BuiltinThemes()
+	themes := append([]NamedTheme(nil), builtins...)
+
+	paths, _ := filepath.Glob(filepath.Join(ThemesPath(projectDir), "*.yaml"))
+	ymlPaths, _ := filepath.Glob(filepath.Join(ThemesPath(projectDir), "*.yml"))
+	paths = append(paths, ymlPaths...)
+	sort.Strings(paths)
+
+	var custom []NamedTheme
+	var errs []error
+	for _, path := range paths {
+		nt, err := loadThemeFile(path, builtins)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(path), err))
+			continue
+		}
+		custom = append(custom, nt)
+	}
+	sort.SliceStable(custom, func(i, j int) bool { return custom[i].Name < custom[j].Name })
+
+	for _, nt := range custom {
+		replaced := false
+		for i := range themes {
+			if themes[i].Name == nt.Name {
+				themes[i] = nt
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			themes = append(themes, nt)
+		}
+	}
+	return themes, errors.Join(errs...)
+}
+
+func loadThemeFile(path string, builtins []NamedTheme) (NamedTheme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return NamedTheme{}, fmt.Errorf("reading theme: %w", err)
+	}
+	var spec ThemeSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return NamedTheme{}, fmt.Errorf("parsing theme: %w", err)
+	}
+	if spec.Name == "" {
+		spec.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	nt, err := spec.Resolve(builtins)
+	if err != nil {
+		return NamedTheme{}, err
+	}
+	nt.Source = path
+	return nt, nil
+}
+
+// FindTheme looks up a theme by name
+func FindTheme(themes []NamedTheme, name string) (NamedTheme, bool) {
+	for _, nt := range themes {
+		if nt.Name == name {
+			return nt, true
+		}
+	}
+	return NamedTheme{}, false
+}
+
+// ThemeSelection is the theme picked in the TUI, remembered per project
+type ThemeSelection struct {
+	Theme string `yaml:"theme,omitempty"`
+}
+
+// ThemeSelectionPath returns the theme selection file path for a project
+func ThemeSelectionPath(projectDir string) string {
+	return filepath.Join(projectDir, ".bv", ThemeSelectionFilename)
+}
+
+// LoadThemeSelection reads the picked theme name from .bv/theme.yaml.
+// Returns an empty name if the file doesn't exist.
+func LoadThemeSelection(projectDir string) (string, error) {
+	data, err := os.ReadFile(ThemeSelectionPath(projectDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading theme selection: %w", err)
+	}
+	var sel ThemeSelection
+	if err := yaml.Unmarshal(data, &sel); err != nil {
+		return "", fmt.Errorf("parsing theme selection: %w", err)
+	}
+	return sel.Theme, nil
+}
+
+// SaveThemeSelection writes the picked theme to .bv/theme.yaml, removing the
+// file when the default theme is picked
+func SaveThemeSelection(projectDir, name string) error {
+	path := ThemeSelectionPath(projectDir)
+	if name == "" || name == DefaultThemeName {
+		if err := readonly.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing theme selection: %w", err)
+		}
+		return nil
+	}
+
+	if err := readonly.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating theme directory: %w", err)
+	}
+	data, err := yaml.Marshal(ThemeSelection{Theme: name})
+	if err != nil {
+		return fmt.Errorf("encoding theme selection: %w", err)
+	}
+	if err := readonly.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing theme selection: %w", err)
+	}
+	return nil
+}
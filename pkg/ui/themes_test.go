@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func writeTheme(t *testing.T, dir, file, content string) {
+	t.Helper()
+	if err := os.MkdirAll(ThemesPath(dir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(ThemesPath(dir), file), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func themeNames(themes []NamedTheme) []string {
+	var names []string
+	for _, nt := range themes {
+		names = append(names, nt.Name)
+	}
+	return names
+}
+
+func TestDefaultTheme_MatchesDefaultBuiltin(t *testing.T) {
+	r := lipgloss.DefaultRenderer()
+	nt, ok := FindTheme(BuiltinThemes(), DefaultThemeName)
+	if !ok {
+		t.Fatal("default builtin missing")
+	}
+	a, b := DefaultTheme(r), nt.Build(r)
+	if a.Name != DefaultThemeName || a.Primary != b.Primary || a.Base.GetForeground() != b.Base.GetForeground() {
+		t.Error("DefaultTheme should be the default builtin")
+	}
+}
+
+func TestBuiltinThemes(t *testing.T) {
+	themes, err := LoadThemes(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(themeNames(themes), " "); got != "default light solarized high-contrast" {
+		t.Errorf("Builtins = %s", got)
+	}
+	light, _ := FindTheme(themes, "light")
+	if light.Palette.Open.Dark != defaultPalette.Open.Light {
+		t.Errorf("light theme should use light colors on dark terminals, got %+v", light.Palette.Open)
+	}
+	for _, nt := range themes {
+		for _, pc := range paletteColors {
+			c := pc.field(&nt.Palette)
+			if !validThemeColor(c.Light) || !validThemeColor(c.Dark) {
+				t.Errorf("%s.%s has invalid color %+v", nt.Name, pc.name, *c)
+			}
+		}
+	}
+}
+
+func TestLoadThemes_UserThemes(t *testing.T) {
+	dir := t.TempDir()
+	writeTheme(t, dir, "ocean.yaml", `
+description: Deep blue
+base: solarized
+colors:
+  primary: "#0077BE"
+  open: {light: "#006400"}
+  blocked: "196"
+`)
+	writeTheme(t, dir, "mine.yml", "name: light\ncolors:\n  text: \"#111\"\n")
+	writeTheme(t, dir, "broken.yaml", "colors:\n  sparkle: \"#fff\"\n")
+	writeTheme(t, dir, "notes.txt", "ignored")
+
+	themes, err := LoadThemes(dir)
+	if err == nil || !strings.Contains(err.Error(), "broken.yaml") || !strings.Contains(err.Error(), `unknown color "sparkle"`) {
+		t.Errorf("Expected error for broken.yaml, got %v", err)
+	}
+	if got := strings.Join(themeNames(themes), " "); got != "default light solarized high-contrast ocean" {
+		t.Errorf("Themes = %s", got)
+	}
+
+	ocean, _ := FindTheme(themes, "ocean")
+	if ocean.Description != "Deep blue" || ocean.Source == "" {
+		t.Errorf("Unexpected ocean metadata: %+v", ocean)
+	}
+	p := ocean.Palette
+	if p.Primary != fixed("#0077BE") {
+		t.Errorf("primary = %+v", p.Primary)
+	}
+	if p.Open.Light != "#006400" || p.Open.Dark != solarizedPalette.Open.Dark {
+		t.Errorf("Half-specified color should keep the base dark value, got %+v", p.Open)
+	}
+	if p.Blocked != fixed("196") || p.Epic != solarizedPalette.Epic {
+		t.Errorf("Unexpected blocked/epic: %+v %+v", p.Blocked, p.Epic)
+	}
+
+	light, _ := FindTheme(themes, "light")
+	if light.Source == "" || light.Palette.Text != fixed("#111") || light.Palette.Primary != defaultPalette.Primary {
+		t.Errorf("User theme named light should replace the builtin on top of default, got %+v", light)
+	}
+}
+
+func TestThemeSpec_Errors(t *testing.T) {
+	for name, spec := range map[string]ThemeSpec{
+		"unknown base":  {Name: "x", Base: "neon"},
+		"unknown color": {Name: "x", Colors: map[string]ThemeColor{"glow": {Light: "#fff", Dark: "#fff"}}},
+		"bad hex":       {Name: "x", Colors: map[string]ThemeColor{"open": {Light: "#ggg", Dark: "#fff"}}},
+		"ansi range":    {Name: "x", Colors: map[string]ThemeColor{"open": {Light: "256", Dark: "1"}}},
+	} {
+		if _, err := spec.Resolve(BuiltinThemes()); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestThemeSelection_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if name, err := LoadThemeSelection(dir); err != nil || name != "" {
+		t.Fatalf("Expected no selection, got %q, %v", name, err)
+	}
+	if err := SaveThemeSelection(dir, "solarized"); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := LoadThemeSelection(dir); name != "solarized" {
+		t.Errorf("Selection = %q", name)
+	}
+	if err := SaveThemeSelection(dir, DefaultThemeName); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(ThemeSelectionPath(dir)); !os.IsNotExist(err) {
+		t.Error("Picking the default theme should remove the selection file")
+	}
+}
+
+func TestModel_ThemePicker(t *testing.T) {
+	dir := t.TempDir()
+	beadsDir := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	beadsPath := filepath.Join(beadsDir, "beads.jsonl")
+	if err := os.WriteFile(beadsPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := []model.Issue{{ID: "t-1", Title: "One", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	if m.theme.Name != DefaultThemeName {
+		t.Fatalf("Expected default theme, got %q", m.theme.Name)
+	}
+
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("V")
+	if !m.showThemePicker || m.focused != focusThemePicker {
+		t.Fatal("V should open the theme picker")
+	}
+	if !strings.Contains(m.themePicker.View(), "default (current)") {
+		t.Error("Picker should mark the current theme")
+	}
+
+	// Moving previews, esc restores
+	press("j")
+	if m.theme.Name != "light" || m.board.theme.Name != "light" {
+		t.Errorf("Expected light preview, got %q", m.theme.Name)
+	}
+	press("esc")
+	if m.showThemePicker || m.theme.Name != DefaultThemeName || m.board.theme.Name != DefaultThemeName {
+		t.Errorf("esc should restore the default theme, got %q", m.theme.Name)
+	}
+
+	// enter keeps and remembers the theme
+	press("V")
+	press("j")
+	press("j")
+	press("enter")
+	if m.theme.Name != "solarized" || m.statusMsg != "Theme: solarized" {
+		t.Errorf("Expected solarized, got %q (status %q)", m.theme.Name, m.statusMsg)
+	}
+	if name, _ := LoadThemeSelection(dir); name != "solarized" {
+		t.Errorf("Selection not saved, got %q", name)
+	}
+
+	m2 := NewModel(issues, nil, beadsPath)
+	defer m2.Stop()
+	if m2.theme.Name != "solarized" {
+		t.Errorf("Saved theme should be restored on startup, got %q", m2.theme.Name)
+	}
+}
@@ -0,0 +1,386 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// timelineScale is the calendar span covered by one column of the timeline
+type timelineScale int
+
+const (
+	timelineScaleDay timelineScale = iota
+	timelineScaleWeek
+	timelineScaleMonth
+)
+
+func (s timelineScale) unit() time.Duration {
+	switch s {
+	case timelineScaleDay:
+		return 24 * time.Hour
+	case timelineScaleMonth:
+		return 30 * 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
+}
+
+func (s timelineScale) String() string {
+	switch s {
+	case timelineScaleDay:
+		return "day"
+	case timelineScaleMonth:
+		return "month"
+	default:
+		return "week"
+	}
+}
+
+// timelineLabelWidth is the width of the left-hand ID/title column
+const timelineLabelWidth = 30
+
+// TimelineModel renders a Gantt-style view: one row per issue, bars spanning
+// created → closed (or now), with the critical path chain highlighted.
+type TimelineModel struct {
+	timeline     analysis.Timeline
+	rows         []analysis.TimelineEntry // Entries currently shown (all or critical only)
+	selectedIdx  int
+	scrollOffset int  // First visible row
+	colOffset    int  // First visible column
+	followEnd    bool // Keep the most recent columns in view until the user scrolls
+	criticalOnly bool
+	scale        timelineScale
+	now          time.Time
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewTimelineModel creates a timeline view from a computed timeline
+func NewTimelineModel(tl analysis.Timeline, now time.Time, theme Theme) TimelineModel {
+	m := TimelineModel{
+		scale:     timelineScaleWeek,
+		followEnd: true,
+		theme:     theme,
+	}
+	m.SetTimeline(tl, now)
+	return m
+}
+
+// SetTimeline replaces the data while keeping the selected issue if possible
+func (t *TimelineModel) SetTimeline(tl analysis.Timeline, now time.Time) {
+	selectedID := t.SelectedIssueID()
+	t.timeline = tl
+	t.now = now
+	t.rebuildRows()
+	for i, e := range t.rows {
+		if e.ID == selectedID {
+			t.selectedIdx = i
+			break
+		}
+	}
+	t.ensureVisible()
+}
+
+func (t *TimelineModel) rebuildRows() {
+	t.rows = t.rows[:0]
+	for _, e := range t.timeline.Entries {
+		if t.criticalOnly && !e.Critical {
+			continue
+		}
+		t.rows = append(t.rows, e)
+	}
+	if t.selectedIdx >= len(t.rows) {
+		t.selectedIdx = 0
+	}
+}
+
+// SetSize updates the render dimensions
+func (t *TimelineModel) SetSize(width, height int) {
+	t.width = width
+	t.height = height
+	t.ensureVisible()
+}
+
+// MoveUp selects the previous row
+func (t *TimelineModel) MoveUp() {
+	if t.selectedIdx > 0 {
+		t.selectedIdx--
+		t.ensureVisible()
+	}
+}
+
+// MoveDown selects the next row
+func (t *TimelineModel) MoveDown() {
+	if t.selectedIdx < len(t.rows)-1 {
+		t.selectedIdx++
+		t.ensureVisible()
+	}
+}
+
+// PageUp moves the selection up by a page
+func (t *TimelineModel) PageUp() {
+	t.selectedIdx -= t.visibleRows()
+	if t.selectedIdx < 0 {
+		t.selectedIdx = 0
+	}
+	t.ensureVisible()
+}
+
+// PageDown moves the selection down by a page
+func (t *TimelineModel) PageDown() {
+	if len(t.rows) == 0 {
+		return
+	}
+	t.selectedIdx += t.visibleRows()
+	if t.selectedIdx >= len(t.rows) {
+		t.selectedIdx = len(t.rows) - 1
+	}
+	t.ensureVisible()
+}
+
+// ScrollLeft moves the visible window back in time
+func (t *TimelineModel) ScrollLeft() {
+	t.followEnd = false
+	t.colOffset -= max(1, t.visibleCols()/4)
+	t.clampColumns()
+}
+
+// ScrollRight moves the visible window forward in time
+func (t *TimelineModel) ScrollRight() {
+	t.colOffset += max(1, t.visibleCols()/4)
+	t.clampColumns()
+	if t.colOffset >= t.totalCols()-t.visibleCols() {
+		t.followEnd = true
+	}
+}
+
+// ZoomIn switches to a finer time scale (month → week → day)
+func (t *TimelineModel) ZoomIn() {
+	if t.scale > timelineScaleDay {
+		t.scale--
+		t.followEnd = true
+	}
+}
+
+// ZoomOut switches to a coarser time scale (day → week → month)
+func (t *TimelineModel) ZoomOut() {
+	if t.scale < timelineScaleMonth {
+		t.scale++
+		t.followEnd = true
+	}
+}
+
+// ToggleCriticalOnly hides or shows issues that are not on the critical path
+func (t *TimelineModel) ToggleCriticalOnly() {
+	selectedID := t.SelectedIssueID()
+	t.criticalOnly = !t.criticalOnly
+	t.rebuildRows()
+	for i, e := range t.rows {
+		if e.ID == selectedID {
+			t.selectedIdx = i
+			break
+		}
+	}
+	t.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the selected row, or "" if empty
+func (t *TimelineModel) SelectedIssueID() string {
+	if t.selectedIdx < 0 || t.selectedIdx >= len(t.rows) {
+		return ""
+	}
+	return t.rows[t.selectedIdx].ID
+}
+
+func (t *TimelineModel) visibleRows() int {
+	// header + axis + legend take 4 lines
+	rows := t.height - 4
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+func (t *TimelineModel) visibleCols() int {
+	cols := t.width - timelineLabelWidth - 1
+	if cols < 10 {
+		cols = 10
+	}
+	return cols
+}
+
+func (t *TimelineModel) totalCols() int {
+	if t.timeline.End.Before(t.timeline.Start) || len(t.timeline.Entries) == 0 {
+		return 0
+	}
+	return int(t.timeline.End.Sub(t.timeline.Start)/t.scale.unit()) + 1
+}
+
+func (t *TimelineModel) clampColumns() {
+	maxOffset := t.totalCols() - t.visibleCols()
+	if t.followEnd || t.colOffset > maxOffset {
+		t.colOffset = maxOffset
+	}
+	if t.colOffset < 0 {
+		t.colOffset = 0
+	}
+}
+
+func (t *TimelineModel) ensureVisible() {
+	visible := t.visibleRows()
+	if t.selectedIdx < t.scrollOffset {
+		t.scrollOffset = t.selectedIdx
+	}
+	if t.selectedIdx >= t.scrollOffset+visible {
+		t.scrollOffset = t.selectedIdx - visible + 1
+	}
+	if t.scrollOffset < 0 {
+		t.scrollOffset = 0
+	}
+}
+
+// View renders the timeline
+func (t *TimelineModel) View(width, height int) string {
+	t.SetSize(width, height)
+	t.clampColumns()
+	th := t.theme
+
+	if len(t.rows) == 0 {
+		msg := "No dated issues to display"
+		if t.criticalOnly {
+			msg = "No critical path (press c to show all issues)"
+		}
+		return th.Renderer.NewStyle().
+			Width(width).
+			Height(height).
+			Align(lipgloss.Center, lipgloss.Center).
+			Foreground(th.Secondary).
+			Render(msg)
+	}
+
+	var lines []string
+
+	// Header
+	titleStyle := th.Renderer.NewStyle().Bold(true).Foreground(th.Primary)
+	mutedStyle := th.Renderer.NewStyle().Foreground(th.Muted)
+	header := titleStyle.Render(fmt.Sprintf("📅 Timeline (%d issues)", len(t.rows)))
+	header += mutedStyle.Render(fmt.Sprintf("  scale: %s  •  critical path: %d issues", t.scale, len(t.timeline.CriticalPath)))
+	if t.timeline.CriticalPathEstimate > 0 {
+		header += mutedStyle.Render(", " + analysis.FormatEstimate(t.timeline.CriticalPathEstimate, t.timeline.EstimateUnit))
+	}
+	if t.criticalOnly {
+		header += mutedStyle.Render("  •  critical only")
+	}
+	lines = append(lines, header)
+
+	// Axis
+	cols := t.visibleCols()
+	unit := t.scale.unit()
+	lines = append(lines, strings.Repeat(" ", timelineLabelWidth+1)+mutedStyle.Render(t.renderAxis(cols, unit)))
+
+	// Rows
+	end := t.scrollOffset + t.visibleRows()
+	if end > len(t.rows) {
+		end = len(t.rows)
+	}
+	for i := t.scrollOffset; i < end; i++ {
+		lines = append(lines, t.renderRow(t.rows[i], i == t.selectedIdx, cols, unit))
+	}
+
+	// Legend
+	legend := "█ closed  ▓ open  ◆ critical path  │ today  •  j/k select  h/l scroll  +/- zoom  c critical only  enter open"
+	lines = append(lines, mutedStyle.Render(truncateRunesHelper(legend, width, "…")))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderAxis writes a date label roughly every 10 columns
+func (t *TimelineModel) renderAxis(cols int, unit time.Duration) string {
+	format := "Jan 02"
+	if t.scale == timelineScaleMonth {
+		format = "Jan '06"
+	}
+	axis := []rune(strings.Repeat(" ", cols))
+	for c := 0; c < cols; c += 10 {
+		colStart := t.timeline.Start.Add(time.Duration(t.colOffset+c) * unit)
+		label := []rune("┆" + colStart.Format(format))
+		for k := 0; k < len(label) && c+k < cols; k++ {
+			axis[c+k] = label[k]
+		}
+	}
+	return string(axis)
+}
+
+func (t *TimelineModel) renderRow(e analysis.TimelineEntry, selected bool, cols int, unit time.Duration) string {
+	th := t.theme
+
+	marker := " "
+	if e.Critical {
+		marker = "◆"
+	}
+	idWidth := 10
+	label := fmt.Sprintf("%s %-*s %s", marker, idWidth, smartTruncateID(e.ID, idWidth), e.Title)
+	label = padRight(truncateRunesHelper(label, timelineLabelWidth, "…"), timelineLabelWidth)
+
+	labelStyle := th.Renderer.NewStyle().Foreground(th.Base.GetForeground())
+	if selected {
+		labelStyle = th.Renderer.NewStyle().Background(th.Highlight).Foreground(th.Primary).Bold(true)
+	}
+
+	barColor := getStatusColor(e.Status, th)
+	if e.Critical {
+		barColor = th.Blocked
+	}
+	barStyle := th.Renderer.NewStyle().Foreground(barColor)
+	todayStyle := th.Renderer.NewStyle().Foreground(th.Muted)
+
+	fill := "▓"
+	if !e.Ongoing {
+		fill = "█"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(labelStyle.Render(label))
+	sb.WriteString(" ")
+
+	// Group consecutive cells of the same kind to keep styled output compact
+	var run strings.Builder
+	runKind := -1 // 0 = empty, 1 = bar, 2 = today marker
+	flush := func() {
+		switch runKind {
+		case 0:
+			sb.WriteString(run.String())
+		case 1:
+			sb.WriteString(barStyle.Render(run.String()))
+		case 2:
+			sb.WriteString(todayStyle.Render(run.String()))
+		}
+		run.Reset()
+	}
+	for c := 0; c < cols; c++ {
+		colStart := t.timeline.Start.Add(time.Duration(t.colOffset+c) * unit)
+		colEnd := colStart.Add(unit)
+
+		kind, ch := 0, " "
+		switch {
+		case e.Start.Before(colEnd) && !e.End.Before(colStart):
+			kind, ch = 1, fill
+		case !t.now.Before(colStart) && t.now.Before(colEnd):
+			kind, ch = 2, "│"
+		}
+		if kind != runKind {
+			flush()
+			runKind = kind
+		}
+		run.WriteString(ch)
+	}
+	flush()
+
+	return sb.String()
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func sampleTimeline(now time.Time) analysis.Timeline {
+	start := now.AddDate(0, -3, 0)
+	return analysis.Timeline{
+		Start:        start,
+		End:          now,
+		CriticalPath: []string{"bv-1", "bv-2"},
+		Entries: []analysis.TimelineEntry{
+			{ID: "bv-1", Title: "Root", Status: model.StatusOpen, Start: start, End: now, Ongoing: true, Critical: true},
+			{ID: "bv-3", Title: "Side", Status: model.StatusClosed, Start: start.AddDate(0, 0, 7), End: start.AddDate(0, 0, 20)},
+			{ID: "bv-2", Title: "Leaf", Status: model.StatusOpen, Start: start.AddDate(0, 1, 0), End: now, Ongoing: true, Critical: true},
+		},
+	}
+}
+
+func TestTimelineModel_NavigationAndCriticalFilter(t *testing.T) {
+	now := time.Now()
+	tm := NewTimelineModel(sampleTimeline(now), now, createTheme())
+	tm.SetSize(120, 20)
+
+	if got := tm.SelectedIssueID(); got != "bv-1" {
+		t.Fatalf("Expected initial selection bv-1, got %s", got)
+	}
+	tm.MoveDown()
+	if got := tm.SelectedIssueID(); got != "bv-3" {
+		t.Errorf("Expected bv-3 after MoveDown, got %s", got)
+	}
+
+	tm.ToggleCriticalOnly()
+	if len(tm.rows) != 2 {
+		t.Fatalf("Expected 2 critical rows, got %d", len(tm.rows))
+	}
+	// bv-3 was filtered out; selection must still point at a critical row
+	if got := tm.SelectedIssueID(); got != "bv-1" && got != "bv-2" {
+		t.Errorf("Expected a critical row to be selected, got %q", got)
+	}
+}
+
+func TestTimelineModel_ZoomAndView(t *testing.T) {
+	now := time.Now()
+	tm := NewTimelineModel(sampleTimeline(now), now, createTheme())
+
+	tm.ZoomIn()
+	if tm.scale != timelineScaleDay {
+		t.Errorf("Expected day scale after zoom in, got %s", tm.scale)
+	}
+	tm.ZoomOut()
+	tm.ZoomOut()
+	if tm.scale != timelineScaleMonth {
+		t.Errorf("Expected month scale after zooming out twice, got %s", tm.scale)
+	}
+
+	out := tm.View(120, 20)
+	for _, want := range []string{"Timeline", "bv-1", "bv-2", "◆"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected view to contain %q", want)
+		}
+	}
+}
+
+func TestTimelineModel_Empty(t *testing.T) {
+	tm := NewTimelineModel(analysis.Timeline{}, time.Now(), createTheme())
+	if out := tm.View(80, 10); !strings.Contains(out, "No dated issues") {
+		t.Errorf("Expected empty-state message, got %q", out)
+	}
+}
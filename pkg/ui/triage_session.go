@@ -0,0 +1,441 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// untriagedNewWindow is how recently an untouched issue must have been
+// created to count as new
+const untriagedNewWindow = 7 * 24 * time.Hour
+
+// Outcomes recorded per issue in a triage session
+const (
+	triageOutcomeTriaged = "triaged"
+	triageOutcomeSnoozed = "snoozed"
+	triageOutcomeSkipped = "skipped"
+)
+
+// untriagedReasons says why an open issue still needs triage: it has no
+// labels, or it is new and nobody has touched it since it was created.
+// Beads always records a priority, so an untouched issue is one whose
+// priority is still whatever it was created with.
+func untriagedReasons(issue model.Issue, now time.Time) []string {
+	if issue.Status.IsClosed() {
+		return nil
+	}
+	var reasons []string
+	if len(issue.Labels) == 0 {
+		reasons = append(reasons, "no labels")
+	}
+	if !issue.CreatedAt.IsZero() && now.Sub(issue.CreatedAt) < untriagedNewWindow &&
+		!issue.UpdatedAt.After(issue.CreatedAt.Add(time.Minute)) {
+		reasons = append(reasons, "new, priority never set")
+	}
+	return reasons
+}
+
+// untriagedQueue lists the IDs of issues needing triage that aren't
+// snoozed, oldest first so the longest-waiting issues come up first
+func untriagedQueue(issues []model.Issue, snoozed map[string]time.Time, now time.Time) []string {
+	var queue []model.Issue
+	for _, issue := range issues {
+		if until, ok := snoozed[issue.ID]; ok && until.After(now) {
+			continue
+		}
+		if len(untriagedReasons(issue, now)) > 0 {
+			queue = append(queue, issue)
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		if !queue[i].CreatedAt.Equal(queue[j].CreatedAt) {
+			return queue[i].CreatedAt.Before(queue[j].CreatedAt)
+		}
+		return queue[i].ID < queue[j].ID
+	})
+	ids := make([]string, len(queue))
+	for i, issue := range queue {
+		ids[i] = issue.ID
+	}
+	return ids
+}
+
+// openTriageSession starts walking the untriaged issues one by one
+func (m *Model) openTriageSession() {
+	ti := textinput.New()
+	ti.CharLimit = 200
+	ti.ShowSuggestions = true
+	m.triageSessionInput = ti
+	m.triageSessionField = ""
+	m.triageSessionQueue = untriagedQueue(m.issues, m.snoozed, time.Now())
+	m.triageSessionPos = 0
+	m.triageSessionDone = make(map[string]string)
+	m.triageSessionReturn = m.focused
+	m.showTriageSession = true
+	m.focused = focusTriageSession
+}
+
+// closeTriageSession ends the session, summing it up in the status bar
+func (m *Model) closeTriageSession() {
+	m.showTriageSession = false
+	m.triageSessionInput.Blur()
+	m.focused = m.triageSessionReturn
+	if len(m.triageSessionQueue) > 0 {
+		m.statusMsg = "Triage session: " + m.triageSessionSummary()
+		m.statusIsError = false
+	}
+}
+
+// triageSessionCurrent returns the ID of the issue being triaged, or "" once
+// the queue is done
+func (m Model) triageSessionCurrent() string {
+	if m.triageSessionPos >= len(m.triageSessionQueue) {
+		return ""
+	}
+	return m.triageSessionQueue[m.triageSessionPos]
+}
+
+// triageSessionCounts counts the session's outcomes
+func (m Model) triageSessionCounts() (triaged, snoozed, skipped int) {
+	for _, outcome := range m.triageSessionDone {
+		switch outcome {
+		case triageOutcomeTriaged:
+			triaged++
+		case triageOutcomeSnoozed:
+			snoozed++
+		case triageOutcomeSkipped:
+			skipped++
+		}
+	}
+	return triaged, snoozed, skipped
+}
+
+// triageSessionSummary describes the session's progress in one line
+func (m Model) triageSessionSummary() string {
+	triaged, snoozed, skipped := m.triageSessionCounts()
+	left := len(m.triageSessionQueue) - len(m.triageSessionDone)
+	return fmt.Sprintf("%d triaged, %d snoozed, %d skipped, %d left", triaged, snoozed, skipped, left)
+}
+
+// handleTriageSessionKeys handles keyboard input for the triage session.
+// While a label or assignee is typed every key goes to the input.
+func (m Model) handleTriageSessionKeys(msg tea.KeyMsg) Model {
+	if m.triageSessionField != "" {
+		switch msg.String() {
+		case "esc":
+			m.triageSessionField = ""
+			m.triageSessionInput.Blur()
+		case "enter":
+			m.submitTriageSessionInput()
+		default:
+			m.triageSessionInput, _ = m.triageSessionInput.Update(msg)
+		}
+		return m
+	}
+
+	id := m.triageSessionCurrent()
+	switch msg.String() {
+	case "esc", "q", "z":
+		m.closeTriageSession()
+	case "0", "1", "2", "3", "4":
+		if id != "" {
+			priority := int(msg.String()[0] - '0')
+			m.triageSessionWrite(id, fmt.Sprintf("set to P%d", priority), func(issue *model.Issue) {
+				issue.Priority = priority
+			})
+		}
+	case "l":
+		if id != "" {
+			m.startTriageSessionInput("label", "label, or several separated by commas", m.labelSuggestions())
+		}
+	case "a":
+		if id != "" {
+			m.startTriageSessionInput("assignee", "assignee (empty unassigns)", m.assigneeSuggestions())
+			if issue := m.issueMap[id]; issue != nil {
+				m.triageSessionInput.SetValue(issue.Assignee)
+				m.triageSessionInput.CursorEnd()
+			}
+		}
+	case "s":
+		if id != "" {
+			m.snoozeTriageSessionIssue(id)
+		}
+	case "n", "j", "right", " ":
+		if id != "" {
+			if _, done := m.triageSessionDone[id]; !done {
+				m.triageSessionDone[id] = triageOutcomeSkipped
+			}
+			m.triageSessionPos++
+		}
+	case "p", "k", "left":
+		if m.triageSessionPos > 0 {
+			m.triageSessionPos--
+		}
+	case "enter":
+		if id != "" {
+			m.showTriageSession = false
+			m.focused = focusList
+			if !m.jumpToIssue(id) {
+				m.focused = m.triageSessionReturn
+			}
+		}
+	}
+	return m
+}
+
+// startTriageSessionInput prompts for a label or assignee for the current issue
+func (m *Model) startTriageSessionInput(field, placeholder string, suggestions []string) {
+	m.triageSessionField = field
+	m.triageSessionInput.Reset()
+	m.triageSessionInput.Placeholder = placeholder
+	m.triageSessionInput.SetSuggestions(suggestions)
+	m.triageSessionInput.Width = max(20, min(60, m.width-24))
+	m.triageSessionInput.Focus()
+}
+
+// submitTriageSessionInput writes the typed labels or assignee. On failure
+// the input stays open so the text isn't lost.
+func (m *Model) submitTriageSessionInput() {
+	id := m.triageSessionCurrent()
+	value := strings.TrimSpace(m.triageSessionInput.Value())
+	var ok bool
+	switch m.triageSessionField {
+	case "label":
+		var labels []string
+		for _, label := range strings.Split(value, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+		if len(labels) == 0 {
+			m.statusMsg = "No label typed"
+			m.statusIsError = true
+			return
+		}
+		ok = m.triageSessionWrite(id, "labelled "+strings.Join(labels, ", "), func(issue *model.Issue) {
+			for _, label := range labels {
+				if !slices.Contains(issue.Labels, label) {
+					issue.Labels = append(issue.Labels, label)
+				}
+			}
+		})
+	case "assignee":
+		what := "assigned to " + value
+		if value == "" {
+			what = "unassigned"
+		}
+		ok = m.triageSessionWrite(id, what, func(issue *model.Issue) {
+			issue.Assignee = value
+		})
+	}
+	if ok {
+		m.triageSessionField = ""
+		m.triageSessionInput.Blur()
+	}
+}
+
+// triageSessionWrite applies change to the issue in the beads file, marks it
+// triaged, and reports what was done. Returns false if nothing was written.
+func (m *Model) triageSessionWrite(id, what string, change func(*model.Issue)) bool {
+	if m.beadsPath == "" {
+		m.statusMsg = "Triage edits need a single beads file (not available in workspace mode)"
+		m.statusIsError = true
+		return false
+	}
+	if err := readonly.Check("Triage edits disabled"); err != nil {
+		m.statusMsg = "🔒 " + err.Error()
+		m.statusIsError = true
+		return false
+	}
+	updated, err := loader.UpdateIssue(m.beadsPath, id, func(issue *model.Issue) {
+		change(issue)
+		issue.UpdatedAt = time.Now()
+	})
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Triage edit failed: %v", err)
+		m.statusIsError = true
+		return false
+	}
+
+	// Show the edit now rather than waiting for the file watcher's reload
+	if issue, ok := m.issueMap[id]; ok {
+		*issue = updated
+	}
+	m.applyFilter()
+	m.updateViewportContent()
+	m.triageSessionDone[id] = triageOutcomeTriaged
+	m.statusMsg = fmt.Sprintf("✓ %s %s", id, what)
+	m.statusIsError = false
+	return true
+}
+
+// snoozeTriageSessionIssue keeps the current issue out of triage sessions
+// for snoozeDuration and moves on
+func (m *Model) snoozeTriageSessionIssue(id string) {
+	until := time.Now().Add(snoozeDuration)
+	if err := m.setSnooze(id, until); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Snooze failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.triageSessionDone[id] = triageOutcomeSnoozed
+	m.triageSessionPos++
+	m.statusMsg = fmt.Sprintf("💤 %s snoozed until %s", id, until.Format("Jan 2"))
+	m.statusIsError = false
+}
+
+// labelSuggestions lists the labels in use, for completing the label input
+func (m Model) labelSuggestions() []string {
+	seen := make(map[string]bool)
+	var labels []string
+	for _, issue := range m.issues {
+		for _, label := range issue.Labels {
+			if !seen[label] {
+				seen[label] = true
+				labels = append(labels, label)
+			}
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// assigneeSuggestions lists the assignees in use, for completing the
+// assignee input
+func (m Model) assigneeSuggestions() []string {
+	seen := make(map[string]bool)
+	var assignees []string
+	for _, issue := range m.issues {
+		if issue.Assignee != "" && !seen[issue.Assignee] {
+			seen[issue.Assignee] = true
+			assignees = append(assignees, issue.Assignee)
+		}
+	}
+	sort.Strings(assignees)
+	return assignees
+}
+
+// renderTriageSession renders the issue being triaged with the session's
+// progress, or the summary once the queue is done
+func (m Model) renderTriageSession() string {
+	t := m.theme
+	boxWidth := min(90, m.width-4)
+	textWidth := boxWidth - 6
+
+	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+	headStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Secondary)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	barStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	inputStyle := t.Renderer.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(textWidth - 2)
+
+	total := len(m.triageSessionQueue)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("📥 Triage Session"))
+	sb.WriteString("\n")
+
+	if total == 0 {
+		sb.WriteString("\n")
+		sb.WriteString(textStyle.Render("Inbox zero: every open issue has labels and nothing new is waiting."))
+		if n := m.activeSnoozes(time.Now()); n > 0 {
+			sb.WriteString("\n" + mutedStyle.Render(fmt.Sprintf("%d snoozed issues come back when their snooze ends.", n)))
+		}
+		sb.WriteString("\n\n" + mutedStyle.Italic(true).Render("esc: close"))
+		return m.placeTriageSession(sb.String(), boxWidth)
+	}
+
+	// Progress bar over the issues dealt with so far
+	done := len(m.triageSessionDone)
+	barWidth := max(10, textWidth-12)
+	filled := barWidth * done / total
+	sb.WriteString(barStyle.Render(strings.Repeat("█", filled)) + mutedStyle.Render(strings.Repeat("░", barWidth-filled)))
+	sb.WriteString(mutedStyle.Render(fmt.Sprintf(" %d/%d", done, total)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(m.triageSessionSummary()))
+	sb.WriteString("\n\n")
+
+	id := m.triageSessionCurrent()
+	if id == "" {
+		sb.WriteString(textStyle.Render("🎉 That's the whole queue."))
+		sb.WriteString("\n\n" + mutedStyle.Italic(true).Render("p: go back • esc: end the session"))
+		return m.placeTriageSession(sb.String(), boxWidth)
+	}
+
+	issue := m.issueMap[id]
+	sb.WriteString(headStyle.Render(fmt.Sprintf("Issue %d of %d", m.triageSessionPos+1, total)))
+	if outcome, ok := m.triageSessionDone[id]; ok {
+		sb.WriteString(mutedStyle.Render(" · " + outcome))
+	}
+	sb.WriteString("\n")
+	if issue == nil {
+		sb.WriteString(mutedStyle.Render(id + " is no longer in the beads file"))
+	} else {
+		sb.WriteString(textStyle.Render(truncateRunesHelper(fmt.Sprintf("%s %s %s  %s", GetStatusIcon(string(issue.Status)), GetPriorityIcon(issue.Priority), issue.ID, issue.Title), textWidth, "…")))
+		sb.WriteString("\n")
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = "unassigned"
+		}
+		labels := strings.Join(issue.Labels, ", ")
+		if labels == "" {
+			labels = "none"
+		}
+		sb.WriteString(mutedStyle.Render(truncateRunesHelper(fmt.Sprintf("P%d · %s · %s · labels: %s · created %s",
+			issue.Priority, issue.IssueType, assignee, labels, FormatTimeRel(issue.CreatedAt)), textWidth, "…")))
+		sb.WriteString("\n")
+		if reasons := untriagedReasons(*issue, time.Now()); len(reasons) > 0 {
+			sb.WriteString(mutedStyle.Render("Needs triage: " + strings.Join(reasons, ", ")))
+			sb.WriteString("\n")
+		}
+		if desc := strings.TrimSpace(issue.Description); desc != "" {
+			sb.WriteString("\n")
+			lines := strings.Split(wrapText(desc, textWidth), "\n")
+			budget := max(2, m.height-22)
+			if len(lines) > budget {
+				lines = append(lines[:budget], "…")
+			}
+			sb.WriteString(textStyle.Render(strings.Join(lines, "\n")))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	if m.triageSessionField != "" {
+		prompt := "Add labels"
+		if m.triageSessionField == "assignee" {
+			prompt = "Set assignee"
+		}
+		sb.WriteString(headStyle.Render(prompt))
+		sb.WriteString("\n" + inputStyle.Render(m.triageSessionInput.View()) + "\n")
+		sb.WriteString(mutedStyle.Italic(true).Render("tab: complete • enter: save • esc: cancel"))
+		return m.placeTriageSession(sb.String(), boxWidth)
+	}
+	sb.WriteString(mutedStyle.Italic(true).Render("0-4: priority • l: label • a: assignee • s: snooze 7d • n: next • p: back • enter: open • esc: end"))
+	return m.placeTriageSession(sb.String(), boxWidth)
+}
+
+// placeTriageSession boxes and centers the session panel
+func (m Model) placeTriageSession(content string, boxWidth int) string {
+	boxStyle := m.theme.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, boxStyle.Render(content))
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUntriagedQueue(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-30 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "labelled", Status: model.StatusOpen, Labels: []string{"api"}, CreatedAt: old, UpdatedAt: old},
+		{ID: "bare", Status: model.StatusOpen, CreatedAt: old, UpdatedAt: old.Add(time.Hour)},
+		{ID: "fresh", Status: model.StatusOpen, Labels: []string{"ui"}, CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-time.Hour)},
+		{ID: "touched", Status: model.StatusOpen, Labels: []string{"ui"}, CreatedAt: now.Add(-time.Hour), UpdatedAt: now},
+		{ID: "closed", Status: model.StatusClosed, CreatedAt: old, UpdatedAt: old},
+		{ID: "snoozed", Status: model.StatusOpen, CreatedAt: old, UpdatedAt: old},
+		{ID: "woke", Status: model.StatusOpen, CreatedAt: old.Add(time.Minute), UpdatedAt: old},
+	}
+	snoozed := map[string]time.Time{"snoozed": now.Add(time.Hour), "woke": now.Add(-time.Hour)}
+	got := strings.Join(untriagedQueue(issues, snoozed, now), ",")
+	if got != "bare,woke,fresh" {
+		t.Errorf("queue = %s, want bare,woke,fresh", got)
+	}
+	if reasons := untriagedReasons(issues[2], now); len(reasons) != 1 || !strings.Contains(reasons[0], "new") {
+		t.Errorf("fresh reasons = %v", reasons)
+	}
+}
+
+func TestTriageSession_WritesBack(t *testing.T) {
+	dir := t.TempDir()
+	beadsPath := filepath.Join(dir, ".beads", "issues.jsonl")
+	if err := os.MkdirAll(filepath.Dir(beadsPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	issues := []model.Issue{
+		{ID: "t-1", Title: "Crash on save", Status: model.StatusOpen, Priority: 2, CreatedAt: old, UpdatedAt: old},
+		{ID: "t-2", Title: "Typo", Status: model.StatusOpen, Priority: 2, CreatedAt: old.Add(time.Hour), UpdatedAt: old},
+		{ID: "t-3", Title: "Done", Status: model.StatusOpen, Labels: []string{"ui"}, Assignee: "bo", CreatedAt: old, UpdatedAt: old},
+	}
+	var lines []string
+	for _, issue := range issues {
+		data, err := json.Marshal(issue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(data))
+	}
+	if err := os.WriteFile(beadsPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = press(updated.(Model), "z")
+	if m.focused != focusTriageSession || strings.Join(m.triageSessionQueue, ",") != "t-1,t-2" {
+		t.Fatalf("queue = %v, focus %d", m.triageSessionQueue, m.focused)
+	}
+
+	m = press(m, "1", "l", "c", "r", "a", "s", "h", ",", " ", "u", "i", "enter", "a", "b", "o", "enter")
+	data, _ := os.ReadFile(beadsPath)
+	record := strings.Split(string(data), "\n")[0]
+	for _, want := range []string{`"priority":1`, `"labels":["crash","ui"]`, `"assignee":"bo"`} {
+		if !strings.Contains(record, want) {
+			t.Errorf("record missing %s: %s", want, record)
+		}
+	}
+	if got := m.issueMap["t-1"]; got.Priority != 1 || got.Assignee != "bo" {
+		t.Errorf("issue not updated in memory: %+v", got)
+	}
+
+	m = press(m, "n", "s")
+	snoozed, err := LoadSnoozed(dir)
+	if err != nil || snoozed["t-2"].Before(time.Now().Add(6*24*time.Hour)) {
+		t.Errorf("t-2 should be snoozed for a week: %v, %v", snoozed, err)
+	}
+	if m.triageSessionCurrent() != "" || !strings.Contains(m.View(), "whole queue") {
+		t.Error("the session should be done")
+	}
+
+	m = press(m, "esc")
+	if m.showTriageSession || m.focused != focusList || m.statusMsg != "Triage session: 1 triaged, 1 snoozed, 0 skipped, 0 left" {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+
+	// Snoozed issues stay out of the next session
+	m = press(m, "z")
+	if len(m.triageSessionQueue) != 0 || !strings.Contains(m.View(), "Inbox zero") {
+		t.Errorf("queue = %v", m.triageSessionQueue)
+	}
+}
+
+func TestTriageSession_ReadOnly(t *testing.T) {
+	readonly.Set(true)
+	defer readonly.Set(false)
+
+	beadsPath := filepath.Join(t.TempDir(), ".beads", "issues.jsonl")
+	m := NewModel([]model.Issue{{ID: "r-1", Title: "Bare", Status: model.StatusOpen, Priority: 2}}, nil, beadsPath)
+	m = press(m, "z", "0")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Triage edits disabled") || m.issueMap["r-1"].Priority != 2 {
+		t.Errorf("read-only should refuse edits: %q", m.statusMsg)
+	}
+	if _, done := m.triageSessionDone["r-1"]; done {
+		t.Error("a refused edit shouldn't count as triaged")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/search"
 	"github.com/charmbracelet/bubbles/list"
 )
 
@@ -76,6 +77,7 @@ func TestInsightsCurrentPanelItemCount(t *testing.T) {
 	}
 	m := NewInsightsModel(ins, map[string]*model.Issue{}, DefaultTheme(nil))
 	m.SetTopPicks([]analysis.TopPick{{ID: "P1", Score: 1.0}})
+	m.SetClusters([]search.SimilarityCluster{{IDs: []string{"D1", "D2"}}})
 	counts := []int{m.currentPanelItemCount()}
 	for i := 0; i < int(PanelCount)-1; i++ {
 		m.NextPanel()
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openValidationPanel checks the beads file against the issue schema and
+// shows the problems found. The loader skips bad records silently, so this is
+// the place to see what was dropped.
+func (m *Model) openValidationPanel() {
+	if m.beadsPath == "" {
+		m.statusMsg = "Validation needs a beads file (not available for stdin, workspace or imported issues)"
+		m.statusIsError = true
+		return
+	}
+	report, err := loader.ValidateIssueFile(m.beadsPath)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Validation failed: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.validationReport = report
+	m.validationCursor = 0
+	m.showValidationPanel = true
+}
+
+// handleValidationKeys handles keys while the validation panel is open
+func (m Model) handleValidationKeys(msg tea.KeyMsg) Model {
+	problems := m.validationReport.Problems
+	switch msg.String() {
+	case "j", "down":
+		if m.validationCursor < len(problems)-1 {
+			m.validationCursor++
+		}
+	case "k", "up":
+		if m.validationCursor > 0 {
+			m.validationCursor--
+		}
+	case "home", "g":
+		m.validationCursor = 0
+	case "G", "end":
+		if len(problems) > 0 {
+			m.validationCursor = len(problems) - 1
+		}
+	case "enter":
+		if m.validationCursor < len(problems) {
+			id := problems[m.validationCursor].IssueID
+			if id == "" {
+				return m
+			}
+			if !m.jumpToIssue(id) {
+				// Records the loader dropped never reach the list
+				m.statusMsg = fmt.Sprintf("%s is not loaded (see line %d)", id, problems[m.validationCursor].Line)
+				m.statusIsError = true
+				return m
+			}
+			m.showValidationPanel = false
+		}
+	case "r":
+		m.openValidationPanel()
+	case "esc", "q", "X":
+		m.showValidationPanel = false
+	}
+	return m
+}
+
+// renderValidationPanel renders the schema validation overlay
+func (m Model) renderValidationPanel() string {
+	t := m.theme
+	report := m.validationReport
+
+	boxWidth := min(100, m.width-4)
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		MaxHeight(m.height - 2)
+
+	titleStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Primary)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("🩺 Validation"))
+	sb.WriteString(mutedStyle.Render("  " + filepath.Base(report.Path)))
+	sb.WriteString("\n\n")
+
+	if len(report.Problems) == 0 {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(ColorSuccess).Render(
+			fmt.Sprintf("✓ %d records, no problems", report.Records)))
+		sb.WriteString("\n")
+	} else {
+		summary := fmt.Sprintf("%d records • %d errors • %d warnings", report.Records, report.Errors, report.Warnings)
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(summary))
+		sb.WriteString("\n\n")
+
+		// Keep the cursor in a window that fits the box
+		visible := max(3, m.height-14)
+		start := 0
+		if m.validationCursor >= visible {
+			start = m.validationCursor - visible + 1
+		}
+		end := min(len(report.Problems), start+visible)
+		if start > 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↑ %d more", start)))
+			sb.WriteString("\n")
+		}
+
+		textWidth := boxWidth - 8
+		for i := start; i < end; i++ {
+			p := report.Problems[i]
+			selected := i == m.validationCursor
+
+			style := t.Renderer.NewStyle().Foreground(t.Feature)
+			icon := "⚡"
+			if p.Severity == loader.ValidationError {
+				style = t.Renderer.NewStyle().Foreground(t.Blocked)
+				icon = "✗"
+			}
+			cursor := "  "
+			if selected {
+				cursor = "▸ "
+				style = style.Bold(true)
+			}
+
+			where := fmt.Sprintf("L%d", p.Line)
+			if p.IssueID != "" {
+				where += " " + p.IssueID
+			}
+			line := fmt.Sprintf("%s%s %s: %s", cursor, icon, where, p.Message)
+			sb.WriteString(style.Render(truncateRunesHelper(line, textWidth, "…")))
+			sb.WriteString("\n")
+			if selected {
+				sb.WriteString(mutedStyle.Italic(true).Render(fmt.Sprintf("     %s %s", p.Severity, p.Code)))
+				sb.WriteString("\n")
+			}
+		}
+		if end < len(report.Problems) {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  ↓ %d more", len(report.Problems)-end)))
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Italic(true).Render(
+		"j/k: navigate • Enter: jump to issue • r: re-check • Esc: close"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
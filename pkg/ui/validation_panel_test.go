@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModel_ValidationPanel(t *testing.T) {
+	_, beadsPath := stateTestBeadsPath(t)
+	content := `{"id":"vp-1","title":"Fine","status":"open","issue_type":"task"}
+{"id":"vp-2","title":"Linked","status":"open","issue_type":"task","dependencies":[{"issue_id":"vp-2","depends_on_id":"gone","type":"blocks"}]}
+{"id":"vp-3","title":"Dropped","status":"wip","issue_type":"task"}
+`
+	if err := os.WriteFile(beadsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := loader.LoadIssuesFromFileWithOptions(beadsPath, loader.ParseOptions{WarningHandler: func(string) {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewModel(issues, nil, beadsPath)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(keyMsgFromString(key))
+		m = updated.(Model)
+	}
+
+	press("X")
+	if !m.showValidationPanel || m.validationReport.Errors != 1 || m.validationReport.Warnings != 1 {
+		t.Fatalf("Expected the panel with 1 error and 1 warning, got %+v", m.validationReport)
+	}
+	view := m.View()
+	for _, want := range []string{"Validation", "3 records • 1 errors • 1 warnings", "depends on gone", `status "wip"`} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View missing %q", want)
+		}
+	}
+
+	// The dropped record can't be opened; the warning's issue can
+	press("j")
+	press("enter")
+	if !m.showValidationPanel || !strings.Contains(m.statusMsg, "vp-3 is not loaded") {
+		t.Errorf("Expected a status for the dropped record, got %q", m.statusMsg)
+	}
+	press("k")
+	press("enter")
+	if m.showValidationPanel || selectedID(m) != "vp-2" {
+		t.Errorf("Enter should jump to vp-2, got %q (panel open: %v)", selectedID(m), m.showValidationPanel)
+	}
+
+	m = NewModel(issues, nil, "")
+	press("X")
+	if m.showValidationPanel || !m.statusIsError {
+		t.Error("Without a beads file the panel should not open")
+	}
+}
@@ -12,25 +12,38 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// VelocityComparisonModel shows side-by-side velocity comparison for all labels
+// Sprint forecast settings
+const (
+	velocitySprintWindow  = 6 // Past sprints charted and fitted
+	velocityRollingWindow = 3 // Sprints the rolling average spans
+)
+
+// VelocityComparisonModel shows side-by-side velocity comparison for all
+// labels and, given sprints, forecasts the sprints left to clear the
+// selected label or the pinned epic
 type VelocityComparisonModel struct {
-	data          []velocityRow
-	cursor        int
-	width         int
-	height        int
-	scrollOffset  int
-	theme         Theme
+	data         []velocityRow
+	cursor       int
+	width        int
+	height       int
+	scrollOffset int
+	theme        Theme
+
+	issues       []model.Issue
+	sprints      []model.Sprint
+	epic         string // Pinned epic, which the forecast can switch to
+	forecastEpic bool   // Forecast the epic instead of the selected label
 }
 
 // velocityRow holds computed velocity data for display
 type velocityRow struct {
 	Label        string
-	Weeks        [4]int    // W-4, W-3, W-2, W-1 (oldest to newest)
+	Weeks        [4]int // W-4, W-3, W-2, W-1 (oldest to newest)
 	Avg          float64
-	Trend        string    // "accelerating", "decelerating", "stable", "erratic", "insufficient_data"
-	TrendSymbol  string    // Visual indicator
-	SparklineBar string    // ASCII sparkline
-	MaxWeekValue int       // For normalization
+	Trend        string // "accelerating", "decelerating", "stable", "erratic", "insufficient_data"
+	TrendSymbol  string // Visual indicator
+	SparklineBar string // ASCII sparkline
+	MaxWeekValue int    // For normalization
 }
 
 // NewVelocityComparisonModel creates a new velocity comparison view
@@ -42,6 +55,7 @@ func NewVelocityComparisonModel(theme Theme) VelocityComparisonModel {
 
 // SetData updates the view with computed velocity data
 func (m *VelocityComparisonModel) SetData(issues []model.Issue) {
+	m.issues = issues
 	now := time.Now().UTC()
 	velocities := analysis.ComputeAllHistoricalVelocity(issues, 4, now)
 
@@ -158,8 +172,8 @@ func (m *VelocityComparisonModel) ensureVisible() {
 
 // visibleRowCount returns how many data rows can be displayed
 func (m *VelocityComparisonModel) visibleRowCount() int {
-	// Account for header (2 lines) and footer (1 line)
-	available := m.height - 4
+	// Account for header (2 lines), footer (1 line) and the sprint forecast
+	available := m.height - 4 - len(m.forecastLines())
 	if available < 1 {
 		return 1
 	}
@@ -320,12 +334,25 @@ func (m *VelocityComparisonModel) View() string {
 		}
 	}
 
+	for _, line := range m.forecastLines() {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+
 	// Footer hints
 	footerStyle := t.Renderer.NewStyle().
 		Foreground(t.Secondary).
 		Italic(true)
 	sb.WriteString("\n")
-	sb.WriteString(footerStyle.Render("j/k: navigate | enter: filter by label | esc: back"))
+	if m.sprints != nil {
+		hint := "j/k: navigate | esc: back"
+		if m.epic != "" {
+			hint = "j/k: navigate | e: label/pinned epic | esc: back"
+		}
+		sb.WriteString(footerStyle.Render(hint))
+	} else {
+		sb.WriteString(footerStyle.Render("j/k: navigate | enter: filter by label | esc: back"))
+	}
 
 	return sb.String()
 }
@@ -334,3 +361,123 @@ func (m *VelocityComparisonModel) View() string {
 func (m *VelocityComparisonModel) DataCount() int {
 	return len(m.data)
 }
+
+// SetSprints turns on the sprint forecast; epic is the pinned epic, if any
+func (m *VelocityComparisonModel) SetSprints(sprints []model.Sprint, epic string) {
+	m.sprints = sprints
+	m.epic = epic
+	if epic == "" {
+		m.forecastEpic = false
+	}
+}
+
+// ToggleEpicForecast switches the forecast between the selected label and
+// the pinned epic. It returns false if no epic is pinned.
+func (m *VelocityComparisonModel) ToggleEpicForecast() bool {
+	if m.epic == "" {
+		return false
+	}
+	m.forecastEpic = !m.forecastEpic
+	return true
+}
+
+// ForecastTarget returns what the forecast is for: the pinned epic or the
+// selected label
+func (m *VelocityComparisonModel) ForecastTarget() Pin {
+	if m.forecastEpic {
+		return Pin{Epic: m.epic}
+	}
+	return Pin{Label: m.SelectedLabel()}
+}
+
+// Forecast returns the sprint velocity of the forecast target over the last
+// sprints, and how many sprints its open issues are projected to take
+func (m *VelocityComparisonModel) Forecast(now time.Time) ([]analysis.SprintVelocity, analysis.SprintForecast) {
+	tracked := make(map[string]bool)
+	remaining := 0
+	for _, issue := range pinnedIssues(m.ForecastTarget(), m.issues) {
+		tracked[issue.ID] = true
+		if !issue.Status.IsClosed() {
+			remaining++
+		}
+	}
+	velocities := analysis.ComputeSprintVelocity(m.sprints, m.issues,
+		func(issue *model.Issue) bool { return tracked[issue.ID] }, velocitySprintWindow, now)
+	return velocities, analysis.ForecastSprints(velocities, remaining, velocityRollingWindow)
+}
+
+// forecastLines renders the sprint forecast: a bar per sprint with the
+// trend line marked, the rolling average, and the projection. It is empty
+// until SetSprints is called.
+func (m *VelocityComparisonModel) forecastLines() []string {
+	if m.sprints == nil {
+		return nil
+	}
+	t := m.theme
+	target := m.ForecastTarget()
+	name := "label " + target.Label
+	if target.Epic != "" {
+		name = "epic " + target.Epic
+	}
+	lines := []string{"", t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("Sprint Forecast · " + name)}
+	dim := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	if target.IsEmpty() {
+		return append(lines, dim.Render("  Select a label to forecast"))
+	}
+
+	velocities, f := m.Forecast(time.Now())
+	if len(velocities) == 0 {
+		return append(lines, dim.Render("  No completed sprints to measure velocity"))
+	}
+
+	const barWidth = 16
+	peak := 1.0
+	for i, v := range velocities {
+		peak = max(peak, float64(v.Delivered), f.Slope*float64(i)+f.Intercept)
+	}
+	barStyle := t.Renderer.NewStyle().Foreground(lipgloss.Color("#88aaff"))
+	trendStyle := t.Renderer.NewStyle().Foreground(t.Primary)
+	for i, v := range velocities {
+		filled := int(float64(v.Delivered) / peak * barWidth)
+		mark := int((f.Slope*float64(i) + f.Intercept) / peak * barWidth)
+		var bar strings.Builder
+		for c := 0; c < barWidth; c++ {
+			switch {
+			case c == mark:
+				bar.WriteString(trendStyle.Render("│"))
+			case c < filled:
+				bar.WriteString(barStyle.Render("█"))
+			default:
+				bar.WriteString(" ")
+			}
+		}
+		sprintName := v.Name
+		if len(sprintName) > 12 {
+			sprintName = sprintName[:11] + "…"
+		}
+		lines = append(lines, fmt.Sprintf("  %-12s %s %3d  avg %.1f", sprintName, bar.String(), v.Delivered, f.RollingAvg[i]))
+	}
+
+	trend := "─"
+	switch {
+	case f.Slope > 0.25:
+		trend = "▲"
+	case f.Slope < -0.25:
+		trend = "▼"
+	}
+	projection := fmt.Sprintf("%d open", f.Remaining)
+	switch {
+	case f.Remaining == 0:
+		projection = "nothing left to clear"
+	case f.SprintsLeft < 0:
+		projection += " · no delivery yet to project from"
+	case f.SprintsLeft == 1:
+		projection += " · ~1 sprint to clear"
+	default:
+		projection += fmt.Sprintf(" · ~%d sprints to clear", f.SprintsLeft)
+	}
+	lines = append(lines,
+		dim.Render("  █ delivered  │ trend"),
+		fmt.Sprintf("  Trend %s %+.1f/sprint · %s", trend, f.Slope, projection))
+	return lines
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -219,3 +220,53 @@ func timePtr(t time.Time) *time.Time {
 func containsString(s, substr string) bool {
 	return len(s) > 0 && len(substr) > 0 && (s == substr || len(s) > len(substr) && (s[:len(substr)] == substr || containsString(s[1:], substr)))
 }
+
+func TestVelocityComparisonSprintForecast(t *testing.T) {
+	now := time.Now().UTC()
+	ago := func(days int) *time.Time { return timePtr(now.AddDate(0, 0, -days)) }
+	issues := []model.Issue{
+		{ID: "e-1", Title: "Epic", IssueType: model.TypeEpic, Status: model.StatusOpen},
+		{ID: "a-1", Labels: []string{"api"}, Status: model.StatusClosed, ClosedAt: ago(30)},
+		{ID: "a-2", Labels: []string{"api"}, Status: model.StatusClosed, ClosedAt: ago(16),
+			Dependencies: []*model.Dependency{{IssueID: "a-2", DependsOnID: "e-1", Type: model.DepParentChild}}},
+		{ID: "a-3", Labels: []string{"api"}, Status: model.StatusClosed, ClosedAt: ago(15)},
+		{ID: "a-4", Labels: []string{"api"}, Status: model.StatusOpen},
+		{ID: "a-5", Labels: []string{"api"}, Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "a-5", DependsOnID: "e-1", Type: model.DepParentChild}}},
+	}
+	sprints := []model.Sprint{
+		{ID: "s1", Name: "Sprint 1", EndDate: now.AddDate(0, 0, -28), BeadIDs: []string{"a-1"}},
+		{ID: "s2", Name: "Sprint 2", EndDate: now.AddDate(0, 0, -14), BeadIDs: []string{"a-2", "a-3"}},
+		{ID: "s3", Name: "Sprint 3", EndDate: now.AddDate(0, 0, 7), BeadIDs: []string{"a-4", "a-5"}},
+	}
+
+	m := NewVelocityComparisonModel(Theme{Renderer: lipgloss.DefaultRenderer()})
+	m.SetSize(100, 40)
+	m.SetData(issues)
+	if strings.Contains(m.View(), "Sprint Forecast") {
+		t.Fatal("forecast should wait for SetSprints")
+	}
+	m.SetSprints(sprints, "e-1")
+
+	velocities, f := m.Forecast(now)
+	if m.ForecastTarget().Label != "api" || len(velocities) != 2 || f.Remaining != 2 || f.SprintsLeft != 1 {
+		t.Errorf("api forecast: %d sprints, %d remaining, %d left; want 2, 2, 1", len(velocities), f.Remaining, f.SprintsLeft)
+	}
+	view := m.View()
+	for _, want := range []string{"Sprint Forecast · label api", "Sprint 2", "~1 sprint to clear", "e: label/pinned epic"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+
+	if !m.ToggleEpicForecast() {
+		t.Fatal("an epic is pinned")
+	}
+	if _, f := m.Forecast(now); m.ForecastTarget().Epic != "e-1" || f.Remaining != 1 {
+		t.Errorf("epic forecast: target %+v, %d remaining; want e-1 with its one open child", m.ForecastTarget(), f.Remaining)
+	}
+	m.SetSprints(sprints, "")
+	if m.ToggleEpicForecast() || m.ForecastTarget().Label != "api" {
+		t.Error("without a pinned epic the forecast stays on the label")
+	}
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetWaves sets the waves schedule, keeping the selection where it fits
+func (m *ActionableModel) SetWaves(waves analysis.WavePlan) {
+	m.waves = waves
+	if m.selectedWave >= len(waves.Waves) {
+		m.selectedWave, m.selectedStep = 0, 0
+	} else if m.selectedStep >= len(waves.Waves[m.selectedWave].Items) {
+		m.selectedStep = 0
+	}
+}
+
+// ToggleWaves switches between the tracks and the waves schedule
+func (m *ActionableModel) ToggleWaves() {
+	m.showWaves = !m.showWaves
+}
+
+// WavesShown reports whether the waves schedule is showing
+func (m *ActionableModel) WavesShown() bool {
+	return m.showWaves
+}
+
+// moveWave moves the waves selection by one item, across waves
+func (m *ActionableModel) moveWave(delta int) {
+	waves := m.waves.Waves
+	if len(waves) == 0 {
+		return
+	}
+	switch {
+	case delta > 0 && m.selectedStep < len(waves[m.selectedWave].Items)-1:
+		m.selectedStep++
+	case delta > 0 && m.selectedWave < len(waves)-1:
+		m.selectedWave++
+		m.selectedStep = 0
+	case delta < 0 && m.selectedStep > 0:
+		m.selectedStep--
+	case delta < 0 && m.selectedWave > 0:
+		m.selectedWave--
+		m.selectedStep = len(waves[m.selectedWave].Items) - 1
+	}
+}
+
+// selectedWaveItemID returns the issue selected in the waves schedule
+func (m *ActionableModel) selectedWaveItemID() string {
+	if m.selectedWave >= len(m.waves.Waves) {
+		return ""
+	}
+	items := m.waves.Waves[m.selectedWave].Items
+	if m.selectedStep >= len(items) {
+		return ""
+	}
+	return items[m.selectedStep].ID
+}
+
+// formatWaveDays renders a duration in days for the schedule
+func formatWaveDays(days float64) string {
+	switch {
+	case days < 1:
+		return "<1d"
+	case days < 14:
+		return fmt.Sprintf("%.0fd", days)
+	default:
+		return fmt.Sprintf("%.1fw", days/7)
+	}
+}
+
+// renderWaves renders the wave-by-wave schedule: what can be worked on in
+// parallel now, and what becomes workable after each earlier wave
+func (m *ActionableModel) renderWaves() string {
+	t := m.theme
+	plan := m.waves
+	var lines []string
+
+	total := 0
+	for _, w := range plan.Waves {
+		total += len(w.Items)
+	}
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+	lines = append(lines, headerStyle.Render(fmt.Sprintf("🌊 WORK WAVES  │  %d issues in %d waves  │  up to %d in parallel",
+		total, len(plan.Waves), plan.MaxParallel)))
+
+	rateStyle := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true)
+	rate := fmt.Sprintf("%.1f closed/week (%d in the last %d days)", plan.ClosesPerDay*7, plan.VelocitySamples, analysis.WaveVelocityWindowDays)
+	if plan.VelocitySamples == 0 {
+		rate = fmt.Sprintf("nothing closed in the last %d days, assuming 1/week", analysis.WaveVelocityWindowDays)
+	}
+	lines = append(lines, rateStyle.Render(fmt.Sprintf("At %s the scheduled work takes ~%s • s: tracks", rate, formatWaveDays(plan.EstimatedDays))))
+	lines = append(lines, "")
+
+	if len(plan.Waves) == 0 {
+		emptyStyle := t.Renderer.NewStyle().
+			Foreground(t.Subtext).
+			Italic(true).
+			Padding(2, 4).
+			Width(m.width - 4).
+			Align(lipgloss.Center)
+		lines = append(lines, emptyStyle.Render("✓ No open work to schedule."))
+	}
+
+	selectedLine := 0
+	for waveIdx, wave := range plan.Waves {
+		badgeStyle := t.Renderer.NewStyle().
+			Foreground(t.Base.GetForeground()).
+			Background(t.Secondary).
+			Bold(true).
+			Padding(0, 1)
+		when := fmt.Sprintf("after wave %d, day %s–%s", wave.Wave-1, formatWaveDays(wave.StartDay), formatWaveDays(wave.StartDay+wave.EstimatedDays))
+		if wave.Wave == 1 {
+			badgeStyle = badgeStyle.Background(t.Open)
+			when = fmt.Sprintf("now, ~%s", formatWaveDays(wave.EstimatedDays))
+		}
+		lines = append(lines, badgeStyle.Render(fmt.Sprintf("WAVE %d", wave.Wave))+" "+
+			t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true).Render(fmt.Sprintf("%d in parallel • %s", len(wave.Items), when)))
+
+		for itemIdx, item := range wave.Items {
+			selected := waveIdx == m.selectedWave && itemIdx == m.selectedStep
+			var sb strings.Builder
+			if selected {
+				selectedLine = len(lines)
+				sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+			} else {
+				sb.WriteString("  ")
+			}
+			connector := "├─ "
+			if itemIdx == len(wave.Items)-1 {
+				connector = "└─ "
+			}
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Render(connector))
+			sb.WriteString(GetPriorityIcon(item.Priority) + " ")
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Bold(selected).Render(item.ID) + " ")
+
+			waits := ""
+			if len(item.WaitsOn) > 0 {
+				waits = " ⇠ " + strings.Join(item.WaitsOn, ", ")
+			}
+			maxTitle := max(10, m.width-lipgloss.Width(sb.String())-lipgloss.Width(waits)-6)
+			titleStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+			if selected {
+				titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+			}
+			sb.WriteString(titleStyle.Render(truncateRunesHelper(item.Title, maxTitle, "…")))
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(waits))
+
+			lineStyle := t.Renderer.NewStyle().Width(m.width - 2).MaxWidth(m.width - 2)
+			if selected {
+				lineStyle = lineStyle.Background(t.Highlight)
+			}
+			lines = append(lines, lineStyle.Render(sb.String()))
+		}
+		lines = append(lines, "")
+	}
+
+	if n := len(plan.Unschedulable); n > 0 {
+		warnStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+		lines = append(lines, warnStyle.Render(fmt.Sprintf("⚠ %d issues are on or behind a dependency cycle and can't be scheduled (Ctrl+X breaks cycles)", n)))
+		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Subtext).Render(
+			"  "+truncateRunesHelper(strings.Join(plan.Unschedulable, ", "), m.width-6, "…")))
+	}
+
+	// Keep the selection in view; the header stays put
+	const header = 3
+	visible := max(1, m.height-2-header)
+	body := lines[header:]
+	selectedLine -= header
+	if selectedLine < m.waveScroll {
+		m.waveScroll = selectedLine
+	} else if selectedLine >= m.waveScroll+visible {
+		m.waveScroll = selectedLine - visible + 1
+	}
+	m.waveScroll = max(0, min(m.waveScroll, len(body)-visible))
+	end := min(len(body), m.waveScroll+visible)
+	return strings.Join(append(lines[:header:header], body[m.waveScroll:end]...), "\n")
+}
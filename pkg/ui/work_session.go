@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// WorkSessionTickMsg refreshes the elapsed time shown in the footer
+type WorkSessionTickMsg struct{}
+
+// WorkSessionTickCmd schedules the next footer refresh for a running session
+func WorkSessionTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return WorkSessionTickMsg{}
+	})
+}
+
+// activeWorkSession returns the running work session, or nil
+func (m *Model) activeWorkSession() *analysis.WorkSession {
+	if m.timeLog == nil {
+		return nil
+	}
+	return m.timeLog.Active()
+}
+
+// toggleWorkSession starts a work session on the selected issue, or stops it
+// if it is already running. Starting on a different issue switches sessions.
+// Returns a tick command while a session is running.
+func (m *Model) toggleWorkSession() tea.Cmd {
+	if m.timeLog == nil {
+		m.statusMsg = "Work sessions unavailable: no beads directory"
+		m.statusIsError = true
+		return nil
+	}
+	issueItem, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return nil
+	}
+	issueID := issueItem.Issue.ID
+	now := time.Now()
+
+	if active := m.timeLog.Active(); active != nil && active.IssueID == issueID {
+		stopped, err := m.timeLog.Stop(now)
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("❌ %v", err)
+			m.statusIsError = true
+			return nil
+		}
+		m.statusMsg = fmt.Sprintf("Stopped work on %s after %s", issueID, formatElapsed(stopped.Duration(now)))
+		m.statusIsError = false
+		m.updateViewportContent()
+		return nil
+	}
+
+	if err := m.timeLog.Start(issueID, now); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ %v", err)
+		m.statusIsError = true
+		return nil
+	}
+	m.statusMsg = fmt.Sprintf("⏱ Started work session on %s (W again to stop)", issueID)
+	m.statusIsError = false
+	m.updateViewportContent()
+	return WorkSessionTickCmd()
+}
+
+// formatElapsed renders a duration as H:MM:SS (or M:SS under an hour)
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := int(d / time.Hour)
+	mins := int(d%time.Hour) / int(time.Minute)
+	secs := int(d%time.Minute) / int(time.Second)
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, mins, secs)
+	}
+	return fmt.Sprintf("%d:%02d", mins, secs)
+}
+
+// formatMinutes renders a minute count compactly, e.g. "45m" or "2h 05m"
+func formatMinutes(minutes int) string {
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
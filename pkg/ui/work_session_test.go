@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWorkSessionToggleAndFooter(t *testing.T) {
+	dir := t.TempDir()
+	issues := []model.Issue{{ID: "bd-42", Title: "Focus", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	m.timeLog = analysis.NewTimeLog(dir)
+
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Error("Expected a tick command after starting a session")
+	}
+	if a := m.activeWorkSession(); a == nil || a.IssueID != "bd-42" {
+		t.Fatalf("Expected running session on bd-42, got %+v", a)
+	}
+
+	m.statusMsg = ""
+	if footer := m.renderFooter(); !strings.Contains(footer, "⏱ bd-42 0:0") {
+		t.Errorf("Expected footer elapsed badge, got %q", footer)
+	}
+
+	// Session survives a reload of the sidecar
+	if reloaded, err := analysis.LoadTimeLog(dir); err != nil || reloaded.Active() == nil {
+		t.Fatalf("Expected persisted running session (err %v)", err)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	if m.activeWorkSession() != nil {
+		t.Error("Expected session stopped on second W")
+	}
+	if !strings.Contains(m.statusMsg, "Stopped work on bd-42") {
+		t.Errorf("Unexpected status %q", m.statusMsg)
+	}
+
+	// Ticks stop once nothing is running
+	if _, cmd := m.Update(WorkSessionTickMsg{}); cmd != nil {
+		t.Error("Expected no further ticks without a running session")
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	cases := map[time.Duration]string{
+		42 * time.Second:                          "0:42",
+		12*time.Minute + 5*time.Second:            "12:05",
+		time.Hour + 2*time.Minute + 3*time.Second: "1:02:03",
+	}
+	for d, want := range cases {
+		if got := formatElapsed(d); got != want {
+			t.Errorf("formatElapsed(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
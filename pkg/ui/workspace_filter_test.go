@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -46,3 +49,99 @@ func TestApplyFilterRespectsWorkspaceRepoFilter(t *testing.T) {
 		t.Fatalf("expected 2 visible items with no repo filter, got %d", got)
 	}
 }
+
+func TestWorkspaceReadOnlyRepos(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-AUTH-1", Title: "API", Status: model.StatusOpen},
+		{ID: "ven-LIB-1", Title: "Vendored", Status: model.StatusOpen},
+	}
+
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:          true,
+		RepoCount:        2,
+		RepoPrefixes:     []string{"api-", "ven-"},
+		ReadOnlyPrefixes: []string{"ven-"},
+		HiddenCount:      1,
+	})
+	if m.workspaceSummary != "2 repos · 1 hidden" {
+		t.Errorf("workspaceSummary = %q", m.workspaceSummary)
+	}
+	if m.isReadOnlyIssue("api-AUTH-1") || !m.isReadOnlyIssue("ven-LIB-1") {
+		t.Fatal("Only ven- issues should be read-only")
+	}
+
+	m.list.Select(1)
+	if !m.blockReadOnly("Editing disabled") || !m.statusIsError {
+		t.Error("Actions on a read-only issue should be blocked")
+	}
+	m.updateViewportContent()
+	if !strings.Contains(m.viewport.View(), "Read-only repo") {
+		t.Error("Detail pane should mark read-only issues")
+	}
+
+	m.list.Select(0)
+	m.statusMsg, m.statusIsError = "", false
+	if m.blockReadOnly("Editing disabled") || m.statusMsg != "" {
+		t.Error("Actions on writable repos should not be blocked")
+	}
+}
+
+func TestWorkspaceChangedReloadsOnlyChangedRepos(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-AUTH-1", Title: "API", Status: model.StatusOpen},
+		{ID: "web-UI-1", Title: "Web", Status: model.StatusOpen},
+	}
+	dir := t.TempDir()
+	files := map[string]string{"api-": filepath.Join(dir, "api.jsonl"), "web-": filepath.Join(dir, "web.jsonl")}
+	for _, path := range files {
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	var reloaded []string
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:      true,
+		RepoCount:    2,
+		RepoPrefixes: []string{"api-", "web-"},
+		Files:        files,
+		Reload: func(repos []string) ([]model.Issue, error) {
+			reloaded = repos
+			return []model.Issue{
+				{ID: "api-AUTH-1", Title: "API", Status: model.StatusOpen},
+				{ID: "web-UI-1", Title: "Web renamed", Status: model.StatusOpen},
+				{ID: "web-UI-2", Title: "Web new", Status: model.StatusOpen},
+			}, nil
+		},
+	})
+	if m.workspaceWatcher == nil || len(m.workspaceWatcher.Keys()) != 2 {
+		t.Fatal("Expected both repos' files to be watched")
+	}
+
+	m.activeRepos = map[string]bool{"web": true}
+	m.applyFilter()
+	updated, _ = m.Update(WorkspaceChangedMsg{Repos: []string{"web"}})
+	m = updated.(Model)
+
+	if strings.Join(reloaded, ",") != "web" {
+		t.Errorf("Expected only web to be re-read, got %v", reloaded)
+	}
+	if len(m.issues) != 3 || m.issueMap["web-UI-1"].Title != "Web renamed" {
+		t.Errorf("Expected the reloaded issues, got %d", len(m.issues))
+	}
+	if got := len(m.list.Items()); got != 2 {
+		t.Errorf("Expected the web filter kept after reload, got %d items", got)
+	}
+	if !strings.Contains(m.statusMsg, "Reloaded web") {
+		t.Errorf("Expected the status to name the repo, got %q", m.statusMsg)
+	}
+}
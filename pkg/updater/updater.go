@@ -17,6 +17,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/readonly"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/version"
 )
 
@@ -335,7 +336,7 @@ func downloadFile(url, destPath string, expectedSize int64) error {
 		return fmt.Errorf("size mismatch: expected %d, got header %d", expectedSize, resp.ContentLength)
 	}
 
-	out, err := os.Create(destPath)
+	out, err := readonly.Create(destPath)
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
 	}
@@ -436,7 +437,7 @@ func extractBinary(archivePath, destPath string) error {
 		// Look for the bv binary (might be ./bv, bv, or just bv)
 		name := filepath.Base(header.Name)
 		if name == "bv" || name == "bv.exe" {
-			out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+			out, err := readonly.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
 			if err != nil {
 				return fmt.Errorf("failed to create binary: %w", err)
 			}
@@ -495,20 +496,20 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 	// Check write permissions
 	binaryDir := filepath.Dir(binaryPath)
 	testFile := filepath.Join(binaryDir, ".bv-update-test")
-	if f, err := os.Create(testFile); err != nil {
+	if f, err := readonly.Create(testFile); err != nil {
 		result.RequireRoot = true
 		return nil, fmt.Errorf("no write permission to %s (try running with sudo)", binaryDir)
 	} else {
 		f.Close()
-		os.Remove(testFile)
+		readonly.Remove(testFile)
 	}
 
 	// Create temp directory for download
-	tmpDir, err := os.MkdirTemp("", "bv-update-*")
+	tmpDir, err := readonly.MkdirTemp("", "bv-update-*")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temp directory: %w", err)
 	}
-	defer os.RemoveAll(tmpDir)
+	defer readonly.RemoveAll(tmpDir)
 
 	// Download archive
 	archivePath := filepath.Join(tmpDir, asset.Name)
@@ -567,7 +568,7 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 
 	// Replace binary
 	fmt.Println("Installing new version...")
-	if err := os.Rename(newBinaryPath, binaryPath); err != nil {
+	if err := readonly.Rename(newBinaryPath, binaryPath); err != nil {
 		// On some systems, rename across filesystems doesn't work
 		if err := copyFile(newBinaryPath, binaryPath); err != nil {
 			// Restore from backup
@@ -577,7 +578,7 @@ func PerformUpdate(release *Release, skipConfirm bool) (*UpdateResult, error) {
 	}
 
 	// Ensure executable permissions
-	if err := os.Chmod(binaryPath, 0755); err != nil {
+	if err := readonly.Chmod(binaryPath, 0755); err != nil {
 		// Not fatal, but log it
 		fmt.Fprintf(os.Stderr, "Warning: could not set permissions: %v\n", err)
 	}
@@ -600,7 +601,7 @@ func copyFile(src, dst string) error {
 		return err
 	}
 
-	destFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+	destFile, err := readonly.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
 	if err != nil {
 		return err
 	}
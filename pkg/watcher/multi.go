@@ -0,0 +1,98 @@
+package watcher
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// MultiWatcher watches several files at once, one Watcher each, and reports
+// which of them changed by the key they were registered under.
+type MultiWatcher struct {
+	watchers map[string]*Watcher
+
+	mu       sync.Mutex
+	pending  map[string]bool
+	changeCh chan struct{}
+}
+
+// NewMultiWatcher creates a watcher for each path, keyed by name. Options
+// apply to every watcher; WithOnChange is ignored, use Changed instead.
+func NewMultiWatcher(paths map[string]string, opts ...WatcherOption) (*MultiWatcher, error) {
+	mw := &MultiWatcher{
+		watchers: make(map[string]*Watcher, len(paths)),
+		pending:  make(map[string]bool),
+		changeCh: make(chan struct{}, 1),
+	}
+	for key, path := range paths {
+		onChange := WithOnChange(func() { mw.markChanged(key) })
+		w, err := NewWatcher(path, append(opts[:len(opts):len(opts)], onChange)...)
+		if err != nil {
+			return nil, err
+		}
+		mw.watchers[key] = w
+	}
+	return mw, nil
+}
+
+// Start starts every watcher. Files that can't be watched are reported
+// together; the others keep running.
+func (mw *MultiWatcher) Start() error {
+	var errs []error
+	for _, key := range mw.Keys() {
+		if err := mw.watchers[key].Start(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Stop stops every watcher.
+func (mw *MultiWatcher) Stop() {
+	for _, w := range mw.watchers {
+		w.Stop()
+	}
+}
+
+// Keys returns the names of the watched files, sorted.
+func (mw *MultiWatcher) Keys() []string {
+	keys := make([]string, 0, len(mw.watchers))
+	for key := range mw.watchers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Changed returns a channel that receives when any file changes. Call
+// TakeChanged to learn which.
+func (mw *MultiWatcher) Changed() <-chan struct{} {
+	return mw.changeCh
+}
+
+// TakeChanged returns the keys of the files changed since the last call,
+// sorted, and clears them.
+func (mw *MultiWatcher) TakeChanged() []string {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	keys := make([]string, 0, len(mw.pending))
+	for key := range mw.pending {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	mw.pending = make(map[string]bool)
+	return keys
+}
+
+// markChanged records a change and signals the change channel
+func (mw *MultiWatcher) markChanged(key string) {
+	mw.mu.Lock()
+	mw.pending[key] = true
+	mw.mu.Unlock()
+
+	// Non-blocking: one signal covers every change pending
+	select {
+	case mw.changeCh <- struct{}{}:
+	default:
+	}
+}
@@ -310,3 +310,46 @@ func TestWatcher_Path(t *testing.T) {
 		t.Errorf("expected path %s, got %s", absPath, w.Path())
 	}
 }
+
+func TestMultiWatcher_ReportsWhichFileChanged(t *testing.T) {
+	tmpDir := t.TempDir()
+	paths := map[string]string{
+		"api": filepath.Join(tmpDir, "api.jsonl"),
+		"web": filepath.Join(tmpDir, "web.jsonl"),
+	}
+	for _, path := range paths {
+		if err := os.WriteFile(path, []byte("initial"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mw, err := NewMultiWatcher(paths,
+		WithDebounceDuration(50*time.Millisecond),
+		WithPollInterval(50*time.Millisecond),
+		WithForcePoll(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer mw.Stop()
+
+	time.Sleep(100 * time.Millisecond)
+	if err := os.WriteFile(paths["web"], []byte("modified content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-mw.Changed():
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a change to be signalled")
+	}
+	if got := mw.TakeChanged(); len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected only web to have changed, got %v", got)
+	}
+	if got := mw.TakeChanged(); len(got) != 0 {
+		t.Errorf("expected changes to be cleared once taken, got %v", got)
+	}
+}
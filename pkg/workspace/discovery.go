@@ -0,0 +1,151 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DiscoverRepos expands the discovery patterns into repos not already in
+// the config. A pattern matches either a directory holding a beads directory
+// ("services/*") or a beads file itself ("~/code/*/.beads/*.jsonl").
+// Relative patterns are taken from workspaceRoot and limited to MaxDepth
+// directories below it; a leading ~ is the home directory. Directories
+// matching an exclude pattern are skipped, as are repos whose prefix is
+// already taken. Repos come back sorted by path.
+func DiscoverRepos(config *Config, workspaceRoot string) []RepoConfig {
+	if config == nil || !config.Discovery.Enabled {
+		return nil
+	}
+	beadsPath := config.Defaults.BeadsPath
+	if beadsPath == "" {
+		beadsPath = ".beads"
+	}
+
+	// What's already configured, by directory and prefix
+	seenDirs := make(map[string]bool)
+	seenPrefixes := make(map[string]bool)
+	for _, repo := range config.Repos {
+		seenDirs[absRepoPath(repo.Path, workspaceRoot)] = true
+		seenPrefixes[strings.ToLower(repo.GetPrefix())] = true
+	}
+
+	type candidate struct{ dir, beadsPath string }
+	var found []candidate
+	for _, pattern := range config.Discovery.Patterns {
+		relative := true
+		if expanded, ok := expandHome(pattern); ok {
+			pattern, relative = expanded, false
+		} else if filepath.IsAbs(pattern) {
+			relative = false
+		} else {
+			pattern = filepath.Join(workspaceRoot, pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			continue
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				continue
+			}
+			c := candidate{dir: match, beadsPath: beadsPath}
+			if !info.IsDir() {
+				// A beads file: the repo is the directory above its beads directory
+				if filepath.Ext(match) != ".jsonl" {
+					continue
+				}
+				beadsDir := filepath.Dir(match)
+				c = candidate{dir: filepath.Dir(beadsDir), beadsPath: filepath.Base(beadsDir)}
+			} else if st, err := os.Stat(filepath.Join(match, beadsPath)); err != nil || !st.IsDir() {
+				continue
+			}
+			if relative && !withinDepth(c.dir, workspaceRoot, config.Discovery.MaxDepth) {
+				continue
+			}
+			if excluded(c.dir, workspaceRoot, config.Discovery.Exclude) {
+				continue
+			}
+			found = append(found, c)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].dir < found[j].dir })
+
+	var repos []RepoConfig
+	for _, c := range found {
+		abs := absRepoPath(c.dir, workspaceRoot)
+		if seenDirs[abs] {
+			continue
+		}
+		path := c.dir
+		if rel, err := filepath.Rel(workspaceRoot, c.dir); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+		repo := RepoConfig{Path: path}
+		if c.beadsPath != ".beads" {
+			repo.BeadsPath = c.beadsPath
+		}
+		prefix := strings.ToLower(repo.GetPrefix())
+		if seenPrefixes[prefix] {
+			continue // Two repos named alike: the first one wins
+		}
+		seenDirs[abs] = true
+		seenPrefixes[prefix] = true
+		repos = append(repos, repo)
+	}
+	return repos
+}
+
+// absRepoPath resolves a repo path against the workspace root
+func absRepoPath(path, workspaceRoot string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workspaceRoot, path)
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// expandHome replaces a leading ~ with the home directory
+func expandHome(pattern string) (string, bool) {
+	if pattern != "~" && !strings.HasPrefix(pattern, "~/") {
+		return pattern, false
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return pattern, false
+	}
+	return filepath.Join(home, strings.TrimPrefix(pattern, "~")), true
+}
+
+// withinDepth reports whether dir is at most maxDepth directories below root
+func withinDepth(dir, root string, maxDepth int) bool {
+	if maxDepth <= 0 {
+		return true
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	return len(strings.Split(rel, string(filepath.Separator))) <= maxDepth
+}
+
+// excluded reports whether any directory on the way from root to dir
+// matches an exclude pattern
+func excluded(dir, root string, patterns []string) bool {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		rel = dir // Outside the workspace: check every component
+	}
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package workspace_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/workspace"
+)
+
+func TestDiscoverRepos(t *testing.T) {
+	root := t.TempDir()
+	elsewhere := t.TempDir()
+	for _, dir := range []string{
+		filepath.Join(root, "services/api/.beads"), // Already configured
+		filepath.Join(root, "apps/web/.beads"),
+		filepath.Join(root, "vendor/.beads"),     // Excluded
+		filepath.Join(root, "a/b/c/.beads"),      // Too deep
+		filepath.Join(root, "lib"),               // No beads
+		filepath.Join(elsewhere, "tool/.issues"), // Matched by its beads file
+	} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(elsewhere, "tool/.issues/issues.jsonl"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{{Path: "services/api", Prefix: "api-"}},
+		Discovery: workspace.DiscoveryConfig{
+			Enabled:  true,
+			Patterns: []string{"*", "apps/*", "services/*", "a/*/*", "lib", filepath.Join(elsewhere, "*/.issues/*.jsonl")},
+			Exclude:  workspace.DefaultExcludePatterns(),
+			MaxDepth: 2,
+		},
+	}
+
+	got := workspace.DiscoverRepos(config, root)
+	want := []workspace.RepoConfig{
+		{Path: "apps/web"},
+		{Path: filepath.Join(elsewhere, "tool"), BeadsPath: ".issues"},
+	}
+	if root > elsewhere {
+		want[0], want[1] = want[1], want[0]
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiscoverRepos() = %+v, want %+v", got, want)
+	}
+
+	config.Discovery.Enabled = false
+	if got := workspace.DiscoverRepos(config, root); got != nil {
+		t.Errorf("Expected nothing with discovery off, got %+v", got)
+	}
+}
+
+func TestAutoDetectConfig(t *testing.T) {
+	root := t.TempDir()
+	manifest := filepath.Join(root, ".bv", "workspace.yaml")
+	for _, dir := range []string{filepath.Join(root, ".bv"), filepath.Join(root, "services/api/.beads"), filepath.Join(root, "docs/guide")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(manifest, []byte("repos: []\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		dir, want string
+	}{
+		{root, manifest},
+		{filepath.Join(root, "docs/guide"), manifest}, // Found walking up
+		{filepath.Join(root, "services/api"), ""},     // A repo of its own
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := workspace.AutoDetectConfig(tt.dir); got != tt.want {
+			t.Errorf("AutoDetectConfig(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
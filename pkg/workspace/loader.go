@@ -2,9 +2,11 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"golang.org/x/sync/errgroup"
@@ -26,6 +28,25 @@ type LoadResult struct {
 
 	// Error is set if loading failed
 	Error error
+
+	// Hidden is set for hidden repos that were not included, and so not loaded
+	Hidden bool
+
+	// ReadOnly mirrors the repo's read_only setting
+	ReadOnly bool
+
+	// CrossRepoDeps counts dependencies on issues in other repos
+	CrossRepoDeps int
+
+	// BeadsFile is the file the issues were read from, for live reload
+	BeadsFile string
+}
+
+// LoadOptions controls which repos an AggregateLoader loads
+type LoadOptions struct {
+	// IncludeHidden lists hidden repos to load anyway, by name or prefix;
+	// "all" includes every hidden repo
+	IncludeHidden []string
 }
 
 // AggregateLoader loads issues from multiple repositories in a workspace
@@ -33,6 +54,9 @@ type AggregateLoader struct {
 	config        *Config
 	workspaceRoot string
 	logger        *log.Logger
+	options       LoadOptions
+
+	results []LoadResult // From the last LoadAll, updated by ReloadRepos
 }
 
 // NewAggregateLoader creates a new aggregate loader for the given workspace config
@@ -49,6 +73,11 @@ func (l *AggregateLoader) SetLogger(logger *log.Logger) {
 	l.logger = logger
 }
 
+// SetOptions sets which repos to load
+func (l *AggregateLoader) SetOptions(opts LoadOptions) {
+	l.options = opts
+}
+
 // LoadAll loads issues from all enabled repositories in the workspace.
 // Returns the merged list of issues with namespaced IDs.
 // Failed repos are logged but don't break the overall loading process.
@@ -57,21 +86,32 @@ func (l *AggregateLoader) LoadAll(ctx context.Context) ([]model.Issue, []LoadRes
 		return nil, nil, fmt.Errorf("workspace config is nil")
 	}
 
-	// Collect enabled repos
-	enabledRepos := l.getEnabledRepos()
+	// Collect enabled repos, setting aside hidden ones that weren't included
+	enabledRepos, hiddenRepos := l.getEnabledRepos()
 	if len(enabledRepos) == 0 {
+		if len(hiddenRepos) > 0 {
+			return nil, hiddenRepos, fmt.Errorf("all %d enabled repositories are hidden", len(hiddenRepos))
+		}
 		return nil, nil, fmt.Errorf("no enabled repositories in workspace")
 	}
 
 	// Load repos in parallel using errgroup
 	results, err := l.loadReposParallel(ctx, enabledRepos)
+	results = append(results, hiddenRepos...)
 	if err != nil {
 		return nil, results, fmt.Errorf("fatal error during parallel loading: %w", err)
 	}
 
+	// Point references to other repos' issues at them
+	resolveCrossRepoDeps(results, l.config.Repos)
+	l.results = results
+
 	// Merge all successfully loaded issues
 	var allIssues []model.Issue
 	for _, result := range results {
+		if result.Hidden {
+			continue
+		}
 		if result.Error != nil {
 			// Log but continue - individual repo failures don't break the whole load
 			l.logRepoError(result.RepoName, result.Error)
@@ -83,15 +123,102 @@ func (l *AggregateLoader) LoadAll(ctx context.Context) ([]model.Issue, []LoadRes
 	return allIssues, results, nil
 }
 
-// getEnabledRepos returns all enabled repos from the config
-func (l *AggregateLoader) getEnabledRepos() []RepoConfig {
+// BeadsFiles returns the file each loaded repo was read from, by prefix
+func (l *AggregateLoader) BeadsFiles() map[string]string {
+	files := make(map[string]string)
+	for _, r := range l.results {
+		if r.Error == nil && !r.Hidden && r.BeadsFile != "" {
+			files[r.Prefix] = r.BeadsFile
+		}
+	}
+	return files
+}
+
+// ReloadRepos re-reads the named repos (by name or prefix) after a LoadAll,
+// keeping every other repo's issues as they were, and returns the merged
+// issues. A repo that fails to load keeps its previous issues and the error
+// is returned; the others are still reloaded.
+func (l *AggregateLoader) ReloadRepos(names []string) ([]model.Issue, error) {
+	if l.results == nil {
+		return nil, fmt.Errorf("workspace not loaded")
+	}
+	var errs []error
+	for _, name := range names {
+		var repo *RepoConfig
+		for i := range l.config.Repos {
+			if l.config.Repos[i].Matches(name) {
+				repo = &l.config.Repos[i]
+				break
+			}
+		}
+		idx := -1
+		for i, r := range l.results {
+			if repo != nil && !r.Hidden && r.RepoName == repo.GetName() {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			errs = append(errs, fmt.Errorf("repo %q is not loaded", name))
+			continue
+		}
+		issues, file, err := l.loadSingleRepo(*repo)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		l.results[idx].Issues = issues
+		l.results[idx].BeadsFile = file
+		l.results[idx].Error = nil
+	}
+
+	// Recount: references in and out of the reloaded repos may have moved
+	for i := range l.results {
+		l.results[i].CrossRepoDeps = 0
+	}
+	resolveCrossRepoDeps(l.results, l.config.Repos)
+
+	var allIssues []model.Issue
+	for _, result := range l.results {
+		if result.Hidden || result.Error != nil {
+			continue
+		}
+		allIssues = append(allIssues, result.Issues...)
+	}
+	return allIssues, errors.Join(errs...)
+}
+
+// getEnabledRepos returns the enabled repos to load, plus a result for each
+// hidden repo that was not included
+func (l *AggregateLoader) getEnabledRepos() ([]RepoConfig, []LoadResult) {
 	var enabled []RepoConfig
+	var hidden []LoadResult
 	for _, repo := range l.config.Repos {
-		if repo.IsEnabled() {
-			enabled = append(enabled, repo)
+		if !repo.IsEnabled() {
+			continue
+		}
+		if repo.Hidden && !l.includesHidden(repo) {
+			hidden = append(hidden, LoadResult{
+				RepoName: repo.GetName(),
+				Prefix:   repo.GetPrefix(),
+				Hidden:   true,
+				ReadOnly: repo.ReadOnly,
+			})
+			continue
+		}
+		enabled = append(enabled, repo)
+	}
+	return enabled, hidden
+}
+
+// includesHidden reports whether a hidden repo was explicitly included
+func (l *AggregateLoader) includesHidden(repo RepoConfig) bool {
+	for _, name := range l.options.IncludeHidden {
+		if strings.EqualFold(strings.TrimSpace(name), "all") || repo.Matches(name) {
+			return true
 		}
 	}
-	return enabled
+	return false
 }
 
 // loadReposParallel loads issues from all repos concurrently using errgroup
@@ -114,20 +241,23 @@ func (l *AggregateLoader) loadReposParallel(ctx context.Context, repos []RepoCon
 					RepoName: repo.GetName(),
 					Prefix:   repo.GetPrefix(),
 					Error:    ctx.Err(),
+					ReadOnly: repo.ReadOnly,
 				}
 				mu.Unlock()
 				return nil // Don't propagate context errors as fatal
 			default:
 			}
 
-			issues, err := l.loadSingleRepo(repo)
+			issues, file, err := l.loadSingleRepo(repo)
 
 			mu.Lock()
 			results[i] = LoadResult{
-				RepoName: repo.GetName(),
-				Prefix:   repo.GetPrefix(),
-				Issues:   issues,
-				Error:    err,
+				RepoName:  repo.GetName(),
+				Prefix:    repo.GetPrefix(),
+				Issues:    issues,
+				Error:     err,
+				ReadOnly:  repo.ReadOnly,
+				BeadsFile: file,
 			}
 			mu.Unlock()
 
@@ -147,8 +277,9 @@ func (l *AggregateLoader) loadReposParallel(ctx context.Context, repos []RepoCon
 	return results, nil
 }
 
-// loadSingleRepo loads issues from a single repository and namespaces them
-func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error) {
+// loadSingleRepo loads issues from a single repository and namespaces them,
+// returning the beads file they came from
+func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, string, error) {
 	// Resolve the repo path relative to workspace root
 	repoPath := repo.Path
 	if !filepath.IsAbs(repoPath) {
@@ -159,18 +290,18 @@ func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error)
 	beadsDir := filepath.Join(repoPath, repo.GetBeadsPath())
 	jsonlPath, err := loader.FindJSONLPath(beadsDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
+		return nil, "", fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
 	}
 	issues, err := loader.LoadIssuesFromFile(jsonlPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
+		return nil, "", fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
 	}
 
 	// Apply namespacing to all IDs
 	prefix := repo.GetPrefix()
 	namespacedIssues := l.namespaceIssues(issues, prefix)
 
-	return namespacedIssues, nil
+	return namespacedIssues, jsonlPath, nil
 }
 
 // namespaceIssues adds the prefix to all issue IDs and dependency references
@@ -241,15 +372,32 @@ func (l *AggregateLoader) logRepoError(repoName string, err error) {
 
 // LoadAllFromConfig is a convenience function that loads a workspace config and all its repos
 func LoadAllFromConfig(ctx context.Context, configPath string) ([]model.Issue, []LoadResult, error) {
+	return LoadAllFromConfigWithOptions(ctx, configPath, LoadOptions{})
+}
+
+// LoadAllFromConfigWithOptions is LoadAllFromConfig with control over which repos load
+func LoadAllFromConfigWithOptions(ctx context.Context, configPath string, opts LoadOptions) ([]model.Issue, []LoadResult, error) {
+	loader, err := NewAggregateLoaderFromConfig(configPath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return loader.LoadAll(ctx)
+}
+
+// NewAggregateLoaderFromConfig reads a workspace config, adds the repos
+// discovery finds, and returns a loader for them. Keep the loader to
+// reload single repos later.
+func NewAggregateLoaderFromConfig(configPath string, opts LoadOptions) (*AggregateLoader, error) {
 	config, err := LoadConfig(configPath)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load workspace config: %w", err)
+		return nil, fmt.Errorf("failed to load workspace config: %w", err)
 	}
 
 	workspaceRoot := filepath.Dir(filepath.Dir(configPath)) // .bv/workspace.yaml -> workspace root
+	config.Repos = append(config.Repos, DiscoverRepos(config, workspaceRoot)...)
 	loader := NewAggregateLoader(config, workspaceRoot)
-
-	return loader.LoadAll(ctx)
+	loader.SetOptions(opts)
+	return loader, nil
 }
 
 // Summary returns a summary of load results
@@ -260,23 +408,34 @@ type LoadSummary struct {
 	TotalIssues     int
 	FailedRepoNames []string
 	RepoPrefixes    []string // Prefixes of successfully loaded repos
+
+	HiddenRepos      int      // Hidden repos that were not loaded (not counted in TotalRepos)
+	ReadOnlyPrefixes []string // Prefixes of loaded read-only repos
+	CrossRepoDeps    int      // Dependencies spanning repos
 }
 
 // Summarize returns a summary of the load results
 func Summarize(results []LoadResult) LoadSummary {
-	summary := LoadSummary{
-		TotalRepos: len(results),
-	}
+	var summary LoadSummary
 
 	for _, result := range results {
+		if result.Hidden {
+			summary.HiddenRepos++
+			continue
+		}
+		summary.TotalRepos++
 		if result.Error != nil {
 			summary.FailedRepos++
 			summary.FailedRepoNames = append(summary.FailedRepoNames, result.RepoName)
 		} else {
 			summary.SuccessfulRepos++
 			summary.TotalIssues += len(result.Issues)
+			summary.CrossRepoDeps += result.CrossRepoDeps
 			if result.Prefix != "" {
 				summary.RepoPrefixes = append(summary.RepoPrefixes, result.Prefix)
+				if result.ReadOnly {
+					summary.ReadOnlyPrefixes = append(summary.ReadOnlyPrefixes, result.Prefix)
+				}
 			}
 		}
 	}
@@ -235,6 +235,124 @@ func TestAggregateLoaderNamespacesDependencies(t *testing.T) {
 	}
 }
 
+func TestAggregateLoaderResolvesCrossRepoDependencies(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, dir := range []string{"api", "web"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dependsOn := func(id, on string) model.Issue {
+		return model.Issue{
+			ID: id, Title: id, Status: model.StatusOpen, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}},
+		}
+	}
+	createTestBeadsFile(t, filepath.Join(tmpDir, "api"), []model.Issue{
+		{ID: "bd-1", Title: "Auth API", Status: model.StatusOpen, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "bd-2", Title: "Token API", Status: model.StatusOpen, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	})
+	createTestBeadsFile(t, filepath.Join(tmpDir, "web"), []model.Issue{
+		dependsOn("bd-9", "bd-2"),     // Native ID only the api repo has
+		dependsOn("bd-8", "API:bd-1"), // Names the repo
+		dependsOn("bd-7", "bd-404"),   // Nowhere to be found
+		dependsOn("bd-6", "bd-9"),     // Local
+	})
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Name: "api", Path: "api", Prefix: "api-"},
+			{Name: "web", Path: "web", Prefix: "web-"},
+		},
+	}
+	issues, results, err := workspace.NewAggregateLoader(config, tmpDir).LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+
+	want := map[string]string{
+		"web-bd-9": "api-bd-2",
+		"web-bd-8": "api-bd-1",
+		"web-bd-7": "web-bd-404",
+		"web-bd-6": "web-bd-9",
+	}
+	for _, issue := range issues {
+		if w, ok := want[issue.ID]; ok {
+			if got := issue.Dependencies[0].DependsOnID; got != w {
+				t.Errorf("%s depends on %q, want %q", issue.ID, got, w)
+			}
+		}
+	}
+	if got := workspace.Summarize(results).CrossRepoDeps; got != 2 {
+		t.Errorf("CrossRepoDeps = %d, want 2", got)
+	}
+}
+
+func TestAggregateLoaderReloadRepos(t *testing.T) {
+	tmpDir := t.TempDir()
+	apiRepo, webRepo := filepath.Join(tmpDir, "api"), filepath.Join(tmpDir, "web")
+	for _, dir := range []string{apiRepo, webRepo} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now()
+	createTestBeadsFile(t, apiRepo, []model.Issue{
+		{ID: "bd-1", Title: "API", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now},
+	})
+	createTestBeadsFile(t, webRepo, []model.Issue{
+		{ID: "bd-5", Title: "Web", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now},
+	})
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Name: "api", Path: "api", Prefix: "api-"},
+			{Name: "web", Path: "web", Prefix: "web-"},
+		},
+	}
+	loader := workspace.NewAggregateLoader(config, tmpDir)
+	if _, err := loader.ReloadRepos([]string{"api"}); err == nil {
+		t.Error("Expected an error reloading before LoadAll")
+	}
+	if _, _, err := loader.LoadAll(context.Background()); err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	files := loader.BeadsFiles()
+	if len(files) != 2 || filepath.Dir(files["web-"]) != filepath.Join(webRepo, ".beads") {
+		t.Fatalf("BeadsFiles() = %v, want a file per repo", files)
+	}
+
+	// web now depends on api by native ID; api's file is untouched
+	createTestBeadsFile(t, webRepo, []model.Issue{
+		{ID: "bd-5", Title: "Web", Status: model.StatusClosed, CreatedAt: now, UpdatedAt: now},
+		{ID: "bd-6", Title: "Web 2", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "bd-6", DependsOnID: "bd-1", Type: model.DepBlocks}}},
+	})
+	issues, err := loader.ReloadRepos([]string{"web"})
+	if err != nil {
+		t.Fatalf("ReloadRepos() error = %v", err)
+	}
+	byID := make(map[string]model.Issue)
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+	if len(issues) != 3 || byID["api-bd-1"].Title != "API" || byID["web-bd-5"].Status != model.StatusClosed {
+		t.Fatalf("Expected api kept and web reloaded, got %+v", issues)
+	}
+	if got := byID["web-bd-6"].Dependencies[0].DependsOnID; got != "api-bd-1" {
+		t.Errorf("Expected the new cross-repo dependency resolved, got %q", got)
+	}
+
+	// A repo that can't be read keeps its last good issues
+	if err := os.Remove(files["web-"]); err != nil {
+		t.Fatal(err)
+	}
+	issues, err = loader.ReloadRepos([]string{"web", "nope"})
+	if err == nil || len(issues) != 3 {
+		t.Errorf("Expected errors and the previous 3 issues, got %d issues, %v", len(issues), err)
+	}
+}
+
 func TestAggregateLoaderDisabledRepos(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -284,6 +402,72 @@ func TestAggregateLoaderDisabledRepos(t *testing.T) {
 	}
 }
 
+func TestAggregateLoaderHiddenAndReadOnlyRepos(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, repo := range []string{"api", "personal", "vendor"} {
+		path := filepath.Join(tmpDir, repo)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		createTestBeadsFile(t, path, []model.Issue{
+			{ID: "X-1", Title: repo, Status: model.StatusOpen, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		})
+	}
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Path: "api", Prefix: "api-"},
+			{Path: "personal", Prefix: "me-", Hidden: true},
+			{Path: "vendor", Prefix: "ven-", ReadOnly: true},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		include    []string
+		wantIssues int
+		wantHidden int
+	}{
+		{"hidden by default", nil, 2, 1},
+		{"included by name", []string{"personal"}, 3, 0},
+		{"included by prefix", []string{"me"}, 3, 0},
+		{"included with all", []string{"all"}, 3, 0},
+		{"other names don't include", []string{"api"}, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := workspace.NewAggregateLoader(config, tmpDir)
+			loader.SetOptions(workspace.LoadOptions{IncludeHidden: tt.include})
+			issues, results, err := loader.LoadAll(context.Background())
+			if err != nil {
+				t.Fatalf("LoadAll() error = %v", err)
+			}
+			if len(issues) != tt.wantIssues {
+				t.Errorf("len(issues) = %d, want %d", len(issues), tt.wantIssues)
+			}
+			for _, issue := range issues {
+				if issue.ID == "me-X-1" && tt.wantHidden > 0 {
+					t.Error("Hidden repo issues should not be loaded")
+				}
+			}
+
+			summary := workspace.Summarize(results)
+			if summary.HiddenRepos != tt.wantHidden || summary.TotalRepos != 3-tt.wantHidden {
+				t.Errorf("summary hidden=%d total=%d", summary.HiddenRepos, summary.TotalRepos)
+			}
+			if len(summary.ReadOnlyPrefixes) != 1 || summary.ReadOnlyPrefixes[0] != "ven-" {
+				t.Errorf("ReadOnlyPrefixes = %v", summary.ReadOnlyPrefixes)
+			}
+		})
+	}
+
+	// A workspace of only hidden repos is an error unless they're included
+	hiddenOnly := &workspace.Config{Repos: []workspace.RepoConfig{{Path: "personal", Prefix: "me-", Hidden: true}}}
+	if _, _, err := workspace.NewAggregateLoader(hiddenOnly, tmpDir).LoadAll(context.Background()); err == nil {
+		t.Error("Expected an error when every repo is hidden")
+	}
+}
+
 func TestAggregateLoaderEmptyConfig(t *testing.T) {
 	config := &workspace.Config{
 		Repos: []workspace.RepoConfig{},
@@ -0,0 +1,89 @@
+package workspace
+
+import (
+	"strings"
+)
+
+// resolveCrossRepoDeps points dependencies at issues in other repos.
+// namespaceIssues qualifies any reference without a known prefix with the
+// dependent's own prefix, so a reference to another repo's issue by its
+// native ID ("bd-12" rather than "api-bd-12") is left dangling. For each
+// dangling reference, in order:
+//
+//   - the ID matches a loaded issue ignoring case ("API-12" for "api-12")
+//   - the reference names the repo, as "api:bd-12" or "api/bd-12"
+//   - exactly one other repo has an issue with that native ID
+//
+// Ambiguous and unknown references stay as they are. Each result's
+// CrossRepoDeps counts its dependencies that now point into another repo.
+func resolveCrossRepoDeps(results []LoadResult, repos []RepoConfig) {
+	known := make(map[string]bool)
+	byFold := make(map[string]string)     // lowercased ID -> ID
+	byNative := make(map[string][]string) // lowercased native ID -> IDs
+	owner := make(map[string]string)      // ID -> prefix of its repo
+	for _, r := range results {
+		if r.Error != nil || r.Hidden {
+			continue
+		}
+		for _, issue := range r.Issues {
+			known[issue.ID] = true
+			byFold[strings.ToLower(issue.ID)] = issue.ID
+			native := strings.ToLower(UnqualifyID(issue.ID, r.Prefix))
+			byNative[native] = append(byNative[native], issue.ID)
+			owner[issue.ID] = r.Prefix
+		}
+	}
+
+	resolve := func(ref, prefix string) (string, bool) {
+		if id, ok := byFold[strings.ToLower(ref)]; ok {
+			return id, true
+		}
+		written := UnqualifyID(ref, prefix)
+		if id, ok := byFold[strings.ToLower(written)]; ok {
+			return id, true
+		}
+		if sep := strings.IndexAny(written, ":/"); sep > 0 {
+			for _, repo := range repos {
+				if !repo.Matches(written[:sep]) {
+					continue
+				}
+				if id, ok := byFold[strings.ToLower(QualifyID(written[sep+1:], repo.GetPrefix()))]; ok {
+					return id, true
+				}
+			}
+		}
+		var match string
+		for _, id := range byNative[strings.ToLower(written)] {
+			if owner[id] == prefix {
+				continue
+			}
+			if match != "" {
+				return "", false // Ambiguous: several repos have this ID
+			}
+			match = id
+		}
+		return match, match != ""
+	}
+
+	for i := range results {
+		r := &results[i]
+		if r.Error != nil || r.Hidden {
+			continue
+		}
+		for _, issue := range r.Issues {
+			for _, dep := range issue.Dependencies {
+				if dep == nil {
+					continue
+				}
+				if !known[dep.DependsOnID] {
+					if id, ok := resolve(dep.DependsOnID, r.Prefix); ok {
+						dep.DependsOnID = id
+					}
+				}
+				if p, ok := owner[dep.DependsOnID]; ok && p != r.Prefix {
+					r.CrossRepoDeps++
+				}
+			}
+		}
+	}
+}
@@ -41,6 +41,15 @@ type RepoConfig struct {
 
 	// Enabled controls whether this repo is included (default: true)
 	Enabled *bool `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+
+	// Hidden repos are skipped unless explicitly included at load time
+	// (bv --include-hidden), e.g. personal repos that shouldn't show up
+	// in screen-shared sessions
+	Hidden bool `yaml:"hidden,omitempty" json:"hidden,omitempty"`
+
+	// ReadOnly marks the repo's issues as view-only: bv disables actions
+	// that act on them, such as work sessions and opening in an editor
+	ReadOnly bool `yaml:"read_only,omitempty" json:"read_only,omitempty"`
 }
 
 // DiscoveryConfig controls automatic repository discovery
@@ -140,6 +149,17 @@ func (r *RepoConfig) GetBeadsPath() string {
 	return ".beads"
 }
 
+// Matches reports whether name refers to this repo by name or prefix
+// (case-insensitive, trailing separator optional)
+func (r *RepoConfig) Matches(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return false
+	}
+	prefix := strings.ToLower(r.GetPrefix())
+	return name == strings.ToLower(r.GetName()) || name == prefix || name == strings.TrimRight(prefix, "-:_")
+}
+
 // IsEnabled returns whether the repo is enabled
 func (r *RepoConfig) IsEnabled() bool {
 	if r.Enabled == nil {
@@ -208,6 +228,27 @@ func FindWorkspaceConfig(dir string) (string, error) {
 	return "", os.ErrNotExist
 }
 
+// AutoDetectConfig returns the workspace config bv should load on its own
+// from dir: the one in dir itself, else, when dir has no beads directory of
+// its own, the nearest one above it. Empty when there is none.
+func AutoDetectConfig(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	own := filepath.Join(dir, ".bv", "workspace.yaml")
+	if _, err := os.Stat(own); err == nil {
+		return own
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".beads")); err == nil {
+		return "" // Inside a repo: that repo, not the workspace around it
+	}
+	path, err := FindWorkspaceConfig(dir)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
 // DefaultConfig returns a sensible default configuration for a single-repo workspace
 func DefaultConfig() Config {
 	return Config{
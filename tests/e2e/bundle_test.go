@@ -0,0 +1,69 @@
+package main_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestBundleSubcommand checks that "bv bundle <file>" writes the share
+// bundle, with scoping flags after the file name
+func TestBundleSubcommand(t *testing.T) {
+	bv := buildBvBinary(t)
+	stageViewerAssets(t, bv)
+
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"F-1","title":"Frontend work","status":"open","priority":1,"issue_type":"task","labels":["frontend"]}
+{"id":"B-1","title":"Backend secret","status":"open","priority":1,"issue_type":"task","labels":["backend"]}
+`)
+
+	cmd := exec.Command(bv, "bundle", "share.tar.gz", "--label", "frontend")
+	cmd.Dir = env
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("bv bundle failed: %v\n%s", err, out)
+	}
+
+	f, err := os.Open(filepath.Join(env, "share.tar.gz"))
+	if err != nil {
+		t.Fatalf("bundle not written: %v", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	var names []string
+	var triage string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, hdr.Name)
+		if hdr.Name == "share/data/triage.json" {
+			data, _ := io.ReadAll(tr)
+			triage = string(data)
+		}
+	}
+	if !strings.Contains(strings.Join(names, " "), "share/index.html") {
+		t.Errorf("viewer missing from bundle: %v", names)
+	}
+	if !strings.Contains(triage, "F-1") || strings.Contains(triage, "B-1") {
+		t.Errorf("expected only the frontend issue in the bundle triage:\n%s", triage)
+	}
+
+	cmd = exec.Command(bv, "bundle")
+	cmd.Dir = env
+	if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "Usage: bv bundle") {
+		t.Errorf("expected usage without a file name, got %v:\n%s", err, out)
+	}
+}
@@ -0,0 +1,50 @@
+package main_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCompressedBeads_DiscoveryAndStdin checks that a gzip-compressed beads
+// file is found in .beads/ and recognized when piped to "bv -"
+func TestCompressedBeads_DiscoveryAndStdin(t *testing.T) {
+	bv := buildBvBinary(t)
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write([]byte(`{"id":"Z-1","title":"Archived root","status":"open","priority":1,"issue_type":"task"}` + "\n"))
+	gw.Close()
+
+	env := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(env, ".beads"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(env, ".beads", "issues.jsonl.gz"), gz.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(bv, "--robot-triage")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("issues.jsonl.gz: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), `"Z-1"`) {
+		t.Errorf("issues.jsonl.gz: Z-1 missing from triage:\n%s", out)
+	}
+
+	cmd = exec.Command(bv, "-", "--robot-triage")
+	cmd.Dir = t.TempDir()
+	cmd.Stdin = bytes.NewReader(gz.Bytes())
+	out, err = cmd.Output()
+	if err != nil {
+		t.Fatalf("gzip on stdin: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), `"Z-1"`) {
+		t.Errorf("gzip on stdin: Z-1 missing from triage:\n%s", out)
+	}
+}
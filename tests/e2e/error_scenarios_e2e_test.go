@@ -291,6 +291,27 @@ func TestError_ReadOnlyBeadsFile(t *testing.T) {
 	}
 }
 
+// TestError_ReadOnlySQLiteStore checks that --read-only --sqlite-store reads
+// the beads file directly instead of refusing to load
+func TestError_ReadOnlySQLiteStore(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"test-1","title":"Test","status":"open","priority":1,"issue_type":"task"}`)
+
+	cmd := exec.Command(bv, "--read-only", "--sqlite-store", "--robot-triage")
+	cmd.Dir = env
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--read-only --sqlite-store should still load issues: %v\n%s", err, output)
+	}
+	if !strings.Contains(string(output), `"test-1"`) {
+		t.Errorf("expected test-1 in triage output:\n%s", output)
+	}
+	if _, err := os.Stat(filepath.Join(env, ".bv", "issues.db")); !os.IsNotExist(err) {
+		t.Error("read-only mode should not create the SQLite mirror")
+	}
+}
+
 // =============================================================================
 // 3. Git Errors
 // =============================================================================
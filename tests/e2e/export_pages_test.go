@@ -427,6 +427,68 @@ func TestExportPages_MetaJSON(t *testing.T) {
 	}
 }
 
+// TestExportPages_TypeTogglesKeepIssues checks that types excluded from
+// analysis in .bv/analysis.yaml are still exported, just not triaged
+func TestExportPages_TypeTogglesKeepIssues(t *testing.T) {
+	bv := buildBvBinary(t)
+	stageViewerAssets(t, bv)
+
+	repoDir := t.TempDir()
+	for _, dir := range []string{".beads", ".bv"} {
+		if err := os.MkdirAll(filepath.Join(repoDir, dir), 0o755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	issueData := `{"id": "tt-1", "title": "Real work", "status": "open", "priority": 1, "issue_type": "task"}
+{"id": "tt-2", "title": "Container", "status": "open", "priority": 1, "issue_type": "epic"}
+`
+	if err := os.WriteFile(filepath.Join(repoDir, ".beads", "issues.jsonl"), []byte(issueData), 0o644); err != nil {
+		t.Fatalf("write issues.jsonl: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(repoDir, ".bv", "analysis.yaml"), []byte("exclude_types: [epic]\n"), 0o644); err != nil {
+		t.Fatalf("write analysis.yaml: %v", err)
+	}
+
+	exportDir := filepath.Join(repoDir, "bv-pages")
+	cmd := exec.Command(bv, "--export-pages", exportDir)
+	cmd.Dir = repoDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("--export-pages failed: %v\n%s", err, out)
+	}
+
+	var meta struct {
+		IssueCount int `json:"issue_count"`
+	}
+	metaBytes, err := os.ReadFile(filepath.Join(exportDir, "data", "meta.json"))
+	if err != nil {
+		t.Fatalf("meta.json not found: %v", err)
+	}
+	if err := json.Unmarshal(metaBytes, &meta); err != nil {
+		t.Fatalf("parse meta.json: %v", err)
+	}
+	if meta.IssueCount != 2 {
+		t.Errorf("meta.json issue_count = %d, want 2 (the epic is still exported)", meta.IssueCount)
+	}
+
+	var triage struct {
+		Recommendations []struct {
+			ID string `json:"id"`
+		} `json:"recommendations"`
+	}
+	triageBytes, err := os.ReadFile(filepath.Join(exportDir, "data", "triage.json"))
+	if err != nil {
+		t.Fatalf("triage.json not found: %v", err)
+	}
+	if err := json.Unmarshal(triageBytes, &triage); err != nil {
+		t.Fatalf("parse triage.json: %v", err)
+	}
+	for _, rec := range triage.Recommendations {
+		if rec.ID == "tt-2" {
+			t.Errorf("excluded epic tt-2 should not be triaged")
+		}
+	}
+}
+
 // TestExportPages_DependencyGraph validates graph data for issues with deps
 func TestExportPages_DependencyGraph(t *testing.T) {
 	bv := buildBvBinary(t)
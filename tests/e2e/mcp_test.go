@@ -0,0 +1,54 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMCP_UsesTriageProfile(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+
+	writeBeads(t, env, `{"id":"A","title":"Urgent by priority","status":"open","priority":0,"issue_type":"task"}
+{"id":"B","title":"Customer request","status":"open","priority":4,"issue_type":"task","labels":["customer"]}`)
+	if err := os.MkdirAll(filepath.Join(env, ".bv"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(env, ".bv", "triage.yaml"), []byte("label_boosts:\n  customer: 1.0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(bv, "mcp")
+	cmd.Dir = env
+	cmd.Stdin = strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"next_work","arguments":{"limit":1}}}` + "\n")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv mcp failed: %v\n%s", err, out)
+	}
+
+	var resp struct {
+		Result struct {
+			Content []struct {
+				Text string `json:"text"`
+			} `json:"content"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out, &resp); err != nil || len(resp.Result.Content) == 0 {
+		t.Fatalf("bad response: %v\n%s", err, out)
+	}
+	var next struct {
+		TopPicks []struct {
+			ID string `json:"id"`
+		} `json:"top_picks"`
+	}
+	if err := json.Unmarshal([]byte(resp.Result.Content[0].Text), &next); err != nil {
+		t.Fatalf("bad next_work result: %v", err)
+	}
+	if len(next.TopPicks) != 1 || next.TopPicks[0].ID != "B" {
+		t.Errorf("the customer label boost should lead next_work, got %+v", next.TopPicks)
+	}
+}
@@ -0,0 +1,58 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestStdinMode_RobotTriage(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir() // no .beads: everything comes from stdin
+
+	jsonl := `{"id":"P-1","title":"Root","status":"open","priority":1,"issue_type":"task"}
+{"id":"P-2","title":"Child","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"P-2","depends_on_id":"P-1","type":"blocks"}]}
+`
+	array := `[{"id":"P-1","title":"Root","status":"open","priority":1,"issue_type":"task"},
+ {"id":"P-2","title":"Child","status":"closed","priority":2,"issue_type":"task"}]`
+
+	cases := []struct {
+		name  string
+		input string
+		args  []string
+	}{
+		{"jsonl, flags first", jsonl, []string{"--robot-triage", "-"}},
+		{"array, flags after dash", array, []string{"-", "--robot-triage"}},
+	}
+	for _, tc := range cases {
+		cmd := exec.Command(bv, tc.args...)
+		cmd.Dir = env
+		cmd.Stdin = strings.NewReader(tc.input)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", tc.name, err, out)
+		}
+		var payload struct {
+			Triage struct {
+				Meta struct {
+					IssueCount int `json:"issue_count"`
+				} `json:"meta"`
+				QuickRef struct {
+					TopPicks []struct {
+						ID string `json:"id"`
+					} `json:"top_picks"`
+				} `json:"quick_ref"`
+			} `json:"triage"`
+		}
+		if err := json.Unmarshal(out, &payload); err != nil {
+			t.Fatalf("%s: json decode: %v\nout=%s", tc.name, err, out)
+		}
+		if payload.Triage.Meta.IssueCount != 2 {
+			t.Errorf("%s: issue_count = %d, want 2", tc.name, payload.Triage.Meta.IssueCount)
+		}
+		if picks := payload.Triage.QuickRef.TopPicks; len(picks) == 0 || picks[0].ID != "P-1" {
+			t.Errorf("%s: expected P-1 as the top pick, got %+v", tc.name, picks)
+		}
+	}
+}